@@ -79,25 +79,26 @@ func mustStartApp(cfg appconfig.Config) {
 	container.RegisterInitializers(
 		initializer.Locale(container),
 		initializer.Template(container),
+		initializer.PubSub(container),
+		initializer.Metrics(container),
 		initializer.Cache(container),
 		initializer.GormDatabase(container),
 		initializer.S3(container),
 		initializer.SMTP(container),
-		initializer.PubSub(container),
+		initializer.SMS(container),
 		initializer.Websocket(container),
 		initializer.ThirdParty(container),
-		initializer.Metrics(container),
 		initializer.GormRepositories(container),
 		initializer.Services(container),
 		initializer.Handlers(container),
 		initializer.Scheduler(container),
 	)
 	container.RegisterFinalizers(
+		finalizer.Websocket(container),
+		finalizer.Scheduler(container),
 		finalizer.Cache(container),
 		finalizer.GormDatabase(container),
 		finalizer.PubSub(container),
-		finalizer.Websocket(container),
-		finalizer.Scheduler(container),
 	)
 
 	log.Info().Msg("initialize DI container")
@@ -117,6 +118,12 @@ func mustStartApp(cfg appconfig.Config) {
 	// Setup scheduler
 	jobs := []scheduler.Job{
 		job.DeleteExpiredDeletedUsersJob(container.Repos.User),
+		job.DeleteExpiredBannedTokensJob(container.Repos.BannedToken),
+		job.DeleteExpiredSessionsJob(container.Repos.Session),
+		job.RotateSigningKeysJob(container.InfrastructureSVCs.JWK),
+		job.DeleteExpiredSigningKeysJob(container.Repos.SigningKey),
+		job.RelayOutboxEventsJob(container.InfrastructureSVCs.Outbox),
+		job.DeletePublishedOutboxEventsJob(container.InfrastructureSVCs.Outbox),
 	}
 	for _, j := range jobs {
 		_, err = container.Infrastructure.Scheduler.AddJob(j)
@@ -31,7 +31,9 @@ type Config struct {
 	Cache              RedisCacheConfig
 	S3                 MinIOS3Config
 	PubSub             RedisPubSubConfig
+	Outbox             OutboxConfig
 	SMTP               SMTPConfig
+	SMS                SMSConfig
 	Websocket          WebsocketConfig
 	Locale             LocaleConfig
 	Template           TemplateConfig
@@ -50,6 +52,9 @@ type ServerConfig struct {
 	ExternalURL string `default:"http://localhost:8080" validate:"omitempty,http_url"`
 	Mode        Mode   `default:"local" validate:"required,oneof=local development production test"`
 	Version     string `default:"0.0.0"`
+	// TrustedProxies are addresses or CIDRs of reverse proxies, whose X-Forwarded-For header is used to
+	// determine client IP. Header is ignored, if list is empty
+	TrustedProxies []string `validate:"omitempty,dive,ip|cidr"`
 }
 
 ////////// Database //////////
@@ -64,11 +69,19 @@ type PostgresDatabaseConfig struct {
 ////////// Cache //////////
 
 type RedisCacheConfig struct {
+	Type     string `default:"redis" validate:"required,oneof=redis layered"`
 	Address  string `validate:"required"`
 	Username string `default:"default" validate:"required"`
 	Password string `validate:"required"`
 	DBIndex  int    `default:"0" validate:"min=0"`
 	TTL      int    `default:"86400" validate:"required,min=0"`
+	Local    LocalCacheConfig
+}
+
+// LocalCacheConfig configures in-memory tier of layered cache
+type LocalCacheConfig struct {
+	Size int `default:"10000" validate:"min=1"`
+	TTL  int `default:"10" validate:"min=1"`
 }
 
 ////////// S3 //////////
@@ -91,27 +104,68 @@ type SMTPConfig struct {
 	From     string `validate:"required"`
 }
 
+////////// SMS //////////
+
+type SMSConfig struct {
+	Provider string `default:"memory" validate:"required,oneof=http memory"`
+	BaseURL  string `validate:"required_if=Provider http,omitempty,http_url"`
+	APIKey   string
+	From     string
+	Timeout  int `default:"10" validate:"min=1"`
+}
+
 ////////// PubSub //////////
 
 type RedisPubSubConfig struct {
-	Address  string `validate:"required"`
-	Username string
-	Password string
-	DBIndex  int `default:"0" validate:"min=0"`
+	Address           string `validate:"required"`
+	Username          string
+	Password          string
+	DBIndex           int `default:"0" validate:"min=0"`
+	RedeliveryTimeout int `default:"30" validate:"min=1"`
+	MaxDeliveries     int `default:"5" validate:"min=1"`
+}
+
+////////// Outbox //////////
+
+type OutboxConfig struct {
+	BatchSize   int `default:"100" validate:"min=1"`
+	MaxAttempts int `default:"20" validate:"min=1"`
+	MaxBackoff  int `default:"300" validate:"min=1"`
+	Retention   int `default:"168" validate:"min=1"`
 }
 
 ////////// Websocket //////////
 
 type WebsocketConfig struct {
-	PoolSize int `default:"1024" validate:"min=0"`
+	PoolSize           int    `default:"1024" validate:"min=0"`
+	PresenceTTL        int    `default:"90" validate:"min=1"`
+	SendQueueSize      int    `default:"256" validate:"min=1"`
+	SlowConsumerPolicy string `default:"close" validate:"oneof=close drop"`
+	ReplaySize         int    `default:"100" validate:"min=1"`
+	ReplayTTL          int    `default:"300" validate:"min=1"`
 }
 
 ////////// Oauth 2.0 Clients //////////
 
+// OauthProviderItemConfig describes OAuth client. Name is used in routes, Type selects provider implementation
+// and is equal to Name by default. Issuer, Scopes and Claims are settings of OpenID Connect provider (type oidc).
+// Telegram Login identifies bot by token in ClientSecret, so ClientID is not required for it
 type OauthProviderItemConfig struct {
-	Name         string `validate:"required"`
-	ClientID     string `validate:"required"`
-	ClientSecret string `validate:"required"`
+	Name         string   `validate:"required"`
+	Type         string   `validate:"omitempty,oneof=google yandex mailru vk telegram oidc"`
+	ClientID     string   `validate:"required_unless=Type telegram Name telegram"`
+	ClientSecret string   `validate:"required"`
+	Issuer       string   `validate:"required_if=Type oidc,omitempty,http_url"`
+	Scopes       []string `validate:"omitempty,dive,required"`
+	Claims       OIDCClaimsConfig
+}
+
+// OIDCClaimsConfig maps claims of ID token to user info, empty claim name means standard claim
+type OIDCClaimsConfig struct {
+	Subject       string
+	Username      string
+	Email         string
+	EmailVerified string
 }
 
 ////////// Geocoding Clients //////////
@@ -124,19 +178,88 @@ type GeocodingProviderItemConfig struct {
 ////////// Security //////////
 
 type SecurityConfig struct {
-	JWT JWTConfig
-	OTP OTPConfig
+	APIKey     APIKeyConfig
+	BruteForce BruteForceConfig
+	JWT        JWTConfig
+	MagicLink  MagicLinkConfig
+	MFA        MFAConfig
+	OAuthState OAuthStateConfig
+	OTP        OTPConfig
+	RateLimit  RateLimitConfig
+	WebAuthn   WebAuthnConfig
+}
+
+type APIKeyConfig struct {
+	Prefix              string `default:"mnd" validate:"required,alphanum"`
+	RotationGracePeriod int    `default:"86400" validate:"min=0"`
+}
+
+type BruteForceConfig struct {
+	MaxAccountAttempts int `default:"5" validate:"required,min=1"`
+	MaxIPAttempts      int `default:"50" validate:"required,min=1"`
+	AttemptWindow      int `default:"900" validate:"required,min=1"`
+	BaseLockout        int `default:"60" validate:"required,min=1"`
+	MaxLockout         int `default:"3600" validate:"required,gtefield=BaseLockout"`
 }
 
 type JWTConfig struct {
-	Secret          string `validate:"required"`
-	AccessTokenTTL  int    `default:"3600" validate:"required,min=0"`
-	RefreshTokenTTL int    `default:"86400" validate:"required,min=0"`
+	Algorithm          string `default:"HS256" validate:"oneof=HS256 RS256 ES256 EdDSA"`
+	Secret             string `validate:"required_if=Algorithm HS256"`
+	KeyRotationPeriod  int    `default:"2592000" validate:"min=0"`
+	KeyEncryptionKey   string `validate:"required_unless=Algorithm HS256"`
+	AccessTokenTTL     int    `default:"3600" validate:"required,min=0"`
+	RefreshTokenTTL    int    `default:"86400" validate:"required,min=0"`
+	RefreshGracePeriod int    `default:"10" validate:"min=0"`
+	MFATokenTTL        int    `default:"300" validate:"required,min=0"`
+}
+
+// RateLimitConfig describes default policy and policies of specific routes.
+// Route is matched by method and path pattern, e.g. "POST" and "/v0/auth/register"
+type RateLimitConfig struct {
+	Enable    bool   `default:"true"`
+	Algorithm string `default:"sliding_window" validate:"oneof=sliding_window token_bucket"`
+	Default   RateLimitPolicyConfig
+	Routes    []RateLimitRouteConfig `validate:"omitempty,dive"`
+}
+
+type RateLimitPolicyConfig struct {
+	Limit  int    `default:"300" validate:"min=0"`
+	Period int    `default:"60" validate:"required,min=1"`
+	Key    string `default:"ip" validate:"omitempty,oneof=ip user api_key"`
+}
+
+type RateLimitRouteConfig struct {
+	Method string `validate:"required"`
+	Path   string `validate:"required"`
+	Limit  int    `validate:"min=0"`
+	Period int    `validate:"required,min=1"`
+	Key    string `validate:"omitempty,oneof=ip user api_key"`
+}
+
+type MagicLinkConfig struct {
+	TTL int `default:"900" validate:"required,min=0"`
+}
+
+type MFAConfig struct {
+	Issuer            string `default:"Mandarine" validate:"required"`
+	RecoveryCodeCount int    `default:"10" validate:"required,min=1"`
+}
+
+type OAuthStateConfig struct {
+	TTL int `default:"1200" validate:"required,min=0"`
+}
+
+type WebAuthnConfig struct {
+	RPID         string   `default:"localhost" validate:"required"`
+	RPName       string   `default:"Mandarine" validate:"required"`
+	Origins      []string `validate:"omitempty,dive,required"`
+	ChallengeTTL int      `default:"300" validate:"required,min=0"`
 }
 
 type OTPConfig struct {
-	Length int `default:"6" validate:"required,min=4"`
-	TTL    int `default:"600" validate:"required,min=0"`
+	Length      int `default:"6" validate:"required,min=4"`
+	TTL         int `default:"600" validate:"required,min=0"`
+	MaxAttempts int `default:"5" validate:"required,min=1"`
 }
 
 ////////// Locale //////////
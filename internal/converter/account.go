@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"github.com/google/uuid"
 	"github.com/mandarine-io/backend/internal/persistence/entity"
 	"github.com/mandarine-io/backend/pkg/model/v0"
 )
@@ -11,7 +12,38 @@ func MapUserEntityToAccountOutput(userEntity *entity.User) v0.AccountOutput {
 		Email:           userEntity.Email,
 		IsEnabled:       userEntity.IsEnabled,
 		IsEmailVerified: userEntity.IsEmailVerified,
+		Phone:           userEntity.Phone,
+		IsPhoneVerified: userEntity.IsPhoneVerified,
 		IsPasswordTemp:  userEntity.IsPasswordTemp,
 		IsDeleted:       userEntity.DeletedAt != nil,
+		IsMFAEnabled:    userEntity.IsTOTPEnabled,
+	}
+}
+
+func MapEntityToSessionOutput(sessionEntity *entity.Session, currentSessionID uuid.UUID) v0.SessionOutput {
+	return v0.SessionOutput{
+		ID:         sessionEntity.ID.String(),
+		DeviceName: sessionEntity.DeviceName,
+		IP:         sessionEntity.IP,
+		UserAgent:  sessionEntity.UserAgent,
+		Location:   sessionEntity.Location,
+		IsCurrent:  sessionEntity.ID == currentSessionID,
+		CreatedAt:  sessionEntity.CreatedAt,
+		LastUsedAt: sessionEntity.LastUsedAt,
+	}
+}
+
+func MapEntitiesToSessionsOutput(
+	sessionEntities []*entity.Session,
+	currentSessionID uuid.UUID,
+) v0.SessionsOutput {
+	data := make([]v0.SessionOutput, len(sessionEntities))
+	for i, sessionEntity := range sessionEntities {
+		data[i] = MapEntityToSessionOutput(sessionEntity, currentSessionID)
+	}
+
+	return v0.SessionsOutput{
+		Count: len(data),
+		Data:  data,
 	}
 }
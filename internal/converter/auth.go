@@ -22,7 +22,7 @@ func MapUserInfoToUserEntity(userInfo oauth.UserInfo) *entity.User {
 		Username:        userInfo.Username,
 		Email:           userInfo.Email,
 		IsEnabled:       true,
-		IsEmailVerified: userInfo.IsEmailVerified,
+		IsEmailVerified: userInfo.Email != "" && userInfo.IsEmailVerified,
 		IsPasswordTemp:  true,
 	}
 }
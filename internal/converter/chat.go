@@ -0,0 +1,49 @@
+package converter
+
+import (
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+)
+
+func MapConversationEntityToConversationOutput(
+	conversationEntity *entity.Conversation,
+	unreadCount int64,
+) v0.ConversationOutput {
+	var appointmentID *string
+	if conversationEntity.AppointmentID != nil {
+		id := conversationEntity.AppointmentID.String()
+		appointmentID = &id
+	}
+
+	return v0.ConversationOutput{
+		ID: conversationEntity.ID.String(),
+		Client: v0.ConversationParticipantOutput{
+			ID:       conversationEntity.ClientID.String(),
+			Username: conversationEntity.Client.Username,
+		},
+		Master: v0.ConversationParticipantOutput{
+			ID:       conversationEntity.MasterID.String(),
+			Username: conversationEntity.Master.Username,
+		},
+		AppointmentID: appointmentID,
+		UnreadCount:   unreadCount,
+		LastMessageAt: conversationEntity.LastMessageAt,
+		CreatedAt:     conversationEntity.CreatedAt,
+	}
+}
+
+func MapChatMessageEntityToChatMessageOutput(messageEntity *entity.ChatMessage) v0.ChatMessageOutput {
+	attachments := make([]string, len(messageEntity.Attachments))
+	for i, attachment := range messageEntity.Attachments {
+		attachments[i] = attachment.ObjectID
+	}
+
+	return v0.ChatMessageOutput{
+		ID:             messageEntity.ID.String(),
+		ConversationID: messageEntity.ConversationID.String(),
+		SenderID:       messageEntity.SenderID.String(),
+		Text:           messageEntity.Text,
+		Attachments:    attachments,
+		CreatedAt:      messageEntity.CreatedAt,
+	}
+}
@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/mandarine-io/backend/third_party/oauth"
+	"github.com/samber/lo"
+)
+
+func MapUserIdentityEntityToIdentityOutput(identityEntity *entity.UserIdentity) v0.IdentityOutput {
+	return v0.IdentityOutput{
+		Provider:  identityEntity.Provider,
+		Email:     identityEntity.Email,
+		CreatedAt: identityEntity.CreatedAt,
+	}
+}
+
+func MapUserIdentityEntitiesToIdentitiesOutput(identityEntities []*entity.UserIdentity) v0.IdentitiesOutput {
+	data := make([]v0.IdentityOutput, len(identityEntities))
+	for i, identityEntity := range identityEntities {
+		data[i] = MapUserIdentityEntityToIdentityOutput(identityEntity)
+	}
+
+	return v0.IdentitiesOutput{
+		Count: len(data),
+		Data:  data,
+	}
+}
+
+func MapUserInfoToUserIdentityEntity(provider string, userInfo oauth.UserInfo, userID uuid.UUID) *entity.UserIdentity {
+	return &entity.UserIdentity{
+		UserID:   userID,
+		Provider: provider,
+		Subject:  userInfo.ID,
+		Email:    lo.EmptyableToPtr(userInfo.Email),
+	}
+}
@@ -0,0 +1,27 @@
+package converter
+
+import (
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+)
+
+func MapPasskeyEntityToPasskeyOutput(passkeyEntity *entity.Passkey) v0.PasskeyOutput {
+	return v0.PasskeyOutput{
+		ID:         passkeyEntity.ID.String(),
+		Name:       passkeyEntity.Name,
+		CreatedAt:  passkeyEntity.CreatedAt,
+		LastUsedAt: passkeyEntity.LastUsedAt,
+	}
+}
+
+func MapPasskeyEntitiesToPasskeysOutput(passkeyEntities []*entity.Passkey) v0.PasskeysOutput {
+	data := make([]v0.PasskeyOutput, len(passkeyEntities))
+	for i, passkeyEntity := range passkeyEntities {
+		data[i] = MapPasskeyEntityToPasskeyOutput(passkeyEntity)
+	}
+
+	return v0.PasskeysOutput{
+		Count: len(data),
+		Data:  data,
+	}
+}
@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+)
+
+func MapRoleEntityToRoleOutput(roleEntity *entity.RoleEntity) v0.RoleOutput {
+	return v0.RoleOutput{
+		Name:          roleEntity.Name,
+		Description:   roleEntity.Description,
+		IsMFARequired: roleEntity.IsMFARequired,
+	}
+}
+
+func MapRoleEntitiesToRolesOutput(roleEntities []*entity.RoleEntity) v0.RolesOutput {
+	data := make([]v0.RoleOutput, len(roleEntities))
+	for i, roleEntity := range roleEntities {
+		data[i] = MapRoleEntityToRoleOutput(roleEntity)
+	}
+
+	return v0.RolesOutput{
+		Count: len(data),
+		Data:  data,
+	}
+}
@@ -0,0 +1,57 @@
+package converter
+
+import (
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"strings"
+)
+
+func MapServiceAccountEntityToServiceAccountOutput(serviceAccountEntity *entity.ServiceAccount) v0.ServiceAccountOutput {
+	return v0.ServiceAccountOutput{
+		ID:          serviceAccountEntity.ID.String(),
+		Name:        serviceAccountEntity.Name,
+		Description: serviceAccountEntity.Description,
+		CreatedAt:   serviceAccountEntity.CreatedAt,
+	}
+}
+
+func MapServiceAccountEntitiesToServiceAccountsOutput(
+	serviceAccountEntities []*entity.ServiceAccount,
+) v0.ServiceAccountsOutput {
+	data := make([]v0.ServiceAccountOutput, len(serviceAccountEntities))
+	for i, serviceAccountEntity := range serviceAccountEntities {
+		data[i] = MapServiceAccountEntityToServiceAccountOutput(serviceAccountEntity)
+	}
+
+	return v0.ServiceAccountsOutput{
+		Count: len(data),
+		Data:  data,
+	}
+}
+
+func MapAPIKeyEntityToAPIKeyOutput(apiKeyEntity *entity.APIKey) v0.APIKeyOutput {
+	return v0.APIKeyOutput{
+		ID:         apiKeyEntity.ID.String(),
+		Name:       apiKeyEntity.Name,
+		Prefix:     apiKeyEntity.Prefix,
+		Scopes:     strings.Fields(apiKeyEntity.Scopes),
+		ExpiresAt:  apiKeyEntity.ExpiresAt,
+		RevokedAt:  apiKeyEntity.RevokedAt,
+		UsageCount: apiKeyEntity.UsageCount,
+		LastUsedAt: apiKeyEntity.LastUsedAt,
+		LastUsedIP: apiKeyEntity.LastUsedIP,
+		CreatedAt:  apiKeyEntity.CreatedAt,
+	}
+}
+
+func MapAPIKeyEntitiesToAPIKeysOutput(apiKeyEntities []*entity.APIKey) v0.APIKeysOutput {
+	data := make([]v0.APIKeyOutput, len(apiKeyEntities))
+	for i, apiKeyEntity := range apiKeyEntities {
+		data[i] = MapAPIKeyEntityToAPIKeyOutput(apiKeyEntity)
+	}
+
+	return v0.APIKeysOutput{
+		Count: len(data),
+		Data:  data,
+	}
+}
@@ -0,0 +1,58 @@
+package converter
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/wellknown"
+	"math/big"
+)
+
+func MapSigningKeyToJWKOutput(key infrastructure.SigningKey) (wellknown.JWKOutput, bool) {
+	output := wellknown.JWKOutput{
+		Kid: key.ID,
+		Use: "sig",
+		Alg: key.Algorithm,
+	}
+
+	switch publicKey := key.PublicKey.(type) {
+	case *rsa.PublicKey:
+		output.Kty = "RSA"
+		output.N = encodeBase64URL(publicKey.N.Bytes())
+		output.E = encodeBase64URL(big.NewInt(int64(publicKey.E)).Bytes())
+	case *ecdsa.PublicKey:
+		size := (publicKey.Curve.Params().BitSize + 7) / 8
+		output.Kty = "EC"
+		output.Crv = publicKey.Curve.Params().Name
+		output.X = encodeBase64URL(publicKey.X.FillBytes(make([]byte, size)))
+		output.Y = encodeBase64URL(publicKey.Y.FillBytes(make([]byte, size)))
+	case ed25519.PublicKey:
+		output.Kty = "OKP"
+		output.Crv = "Ed25519"
+		output.X = encodeBase64URL(publicKey)
+	default:
+		return wellknown.JWKOutput{}, false
+	}
+
+	return output, true
+}
+
+func MapSigningKeysToJWKSOutput(keys []infrastructure.SigningKey) wellknown.JWKSOutput {
+	outputs := make([]wellknown.JWKOutput, 0, len(keys))
+	for _, key := range keys {
+		output, ok := MapSigningKeyToJWKOutput(key)
+		if ok {
+			outputs = append(outputs, output)
+		}
+	}
+
+	return wellknown.JWKSOutput{
+		Keys: outputs,
+	}
+}
+
+func encodeBase64URL(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
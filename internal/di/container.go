@@ -3,9 +3,12 @@ package di
 import (
 	"github.com/mandarine-io/backend/config"
 	"github.com/mandarine-io/backend/internal/infrastructure/cache"
+	"github.com/mandarine-io/backend/internal/infrastructure/event"
 	"github.com/mandarine-io/backend/internal/infrastructure/locale"
 	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	"github.com/mandarine-io/backend/internal/infrastructure/ratelimit"
 	"github.com/mandarine-io/backend/internal/infrastructure/s3"
+	"github.com/mandarine-io/backend/internal/infrastructure/sms"
 	"github.com/mandarine-io/backend/internal/infrastructure/smtp"
 	"github.com/mandarine-io/backend/internal/infrastructure/template"
 	"github.com/mandarine-io/backend/internal/infrastructure/websocket"
@@ -39,33 +42,61 @@ type Infrastructure struct {
 	LocaleBundle   locale.Bundle
 	TemplateEngine template.Engine
 	CacheManager   cache.Manager
+	RateLimiter    ratelimit.Limiter
 	S3Manager      s3.Manager
 	SMTPSender     smtp.Sender
+	SMSSender      sms.Sender
 	PubSubAgent    pubsub.Agent
+	StreamAgent    pubsub.StreamAgent
+	EventRegistry  *event.Registry
 	Scheduler      *scheduler.Scheduler
 	WSPool         *websocket.Pool
+	WSRouter       *websocket.Router
 }
 
 type Repositories struct {
-	MasterProfile repo.MasterProfileRepository
-	MasterService repo.MasterServiceRepository
-	User          repo.UserRepository
+	APIKey         repo.APIKeyRepository
+	BannedToken    repo.BannedTokenRepository
+	ChatMessage    repo.ChatMessageRepository
+	Conversation   repo.ConversationRepository
+	MasterProfile  repo.MasterProfileRepository
+	MasterService  repo.MasterServiceRepository
+	OutboxEvent    repo.OutboxEventRepository
+	Passkey        repo.PasskeyRepository
+	RecoveryCode   repo.RecoveryCodeRepository
+	Role           repo.RoleRepository
+	ServiceAccount repo.ServiceAccountRepository
+	Session        repo.SessionRepository
+	SigningKey     repo.SigningKeyRepository
+	User           repo.UserRepository
+	UserIdentity   repo.UserIdentityRepository
+	Transactor     repo.Transactor
 }
 
 type InfrastructureServices struct {
-	JWT infrastructure.JWTService
-	OTP infrastructure.OTPService
+	APIKey     infrastructure.APIKeyService
+	BruteForce infrastructure.BruteForceService
+	JWK        infrastructure.JWKService
+	JWT        infrastructure.JWTService
+	OTP        infrastructure.OTPService
+	Outbox     infrastructure.OutboxService
+	TOTP       infrastructure.TOTPService
+	WebAuthn   infrastructure.WebAuthnService
 }
 
 type DomainServices struct {
-	Account       domain.AccountService
-	Auth          domain.AuthService
-	Health        domain.HealthService
-	Geocoding     domain.GeocodingService
-	MasterProfile domain.MasterProfileService
-	MasterService domain.MasterServiceService
-	Resource      domain.ResourceService
-	Websocket     domain.WebsocketService
+	Account        domain.AccountService
+	Auth           domain.AuthService
+	Chat           domain.ChatService
+	Health         domain.HealthService
+	Geocoding      domain.GeocodingService
+	MasterProfile  domain.MasterProfileService
+	MasterService  domain.MasterServiceService
+	Resource       domain.ResourceService
+	Role           domain.RoleService
+	ServiceAccount domain.ServiceAccountService
+	WellKnown      domain.WellKnownService
+	Websocket      domain.WebsocketService
 }
 
 type ThirdParties struct {
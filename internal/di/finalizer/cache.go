@@ -2,6 +2,7 @@ package finalizer
 
 import (
 	"github.com/mandarine-io/backend/internal/di"
+	"io"
 )
 
 func Cache(c *di.Container) di.Finalizer {
@@ -12,6 +13,13 @@ func Cache(c *di.Container) di.Finalizer {
 			return nil
 		}
 
+		// Layered manager stops receiving invalidations before pub/sub agent is closed
+		if closer, ok := c.Infrastructure.CacheManager.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				return err
+			}
+		}
+
 		return c.Infrastructure.CacheRDB.Close()
 	}
 }
@@ -12,6 +12,13 @@ func PubSub(c *di.Container) di.Finalizer {
 			return nil
 		}
 
+		// Consumers of streams are stopped before client is closed
+		if c.Infrastructure.StreamAgent != nil {
+			if err := c.Infrastructure.StreamAgent.Close(); err != nil {
+				return err
+			}
+		}
+
 		err := c.Infrastructure.PubSubRDB.Close()
 		if err != nil {
 			return err
@@ -3,7 +3,9 @@ package initializer
 import (
 	"github.com/mandarine-io/backend/config"
 	"github.com/mandarine-io/backend/internal/di"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache/layered"
 	redis2 "github.com/mandarine-io/backend/internal/infrastructure/cache/redis"
+	redis3 "github.com/mandarine-io/backend/internal/infrastructure/ratelimit/redis"
 	"time"
 )
 
@@ -25,6 +27,32 @@ func Cache(c *di.Container) di.Initializer {
 			redis2.WithTTL(time.Duration(c.Config.Cache.TTL)*time.Second),
 			redis2.WithLogger(c.Logger.With().Str("component", "redis-cache-manager").Logger()),
 		)
+		if err != nil {
+			return err
+		}
+
+		// Layered manager requires pub/sub agent and metrics, so they are set up before cache
+		if c.Config.Cache.Type == "layered" {
+			c.Logger.Debug().Msg("setup layered cache manager")
+			c.Infrastructure.CacheManager, err = layered.NewManager(
+				c.Infrastructure.CacheManager,
+				c.Infrastructure.PubSubAgent,
+				layered.WithSize(c.Config.Cache.Local.Size),
+				layered.WithLocalTTL(time.Duration(c.Config.Cache.Local.TTL)*time.Second),
+				layered.WithMetrics(c.Metrics),
+				layered.WithLogger(c.Logger.With().Str("component", "layered-cache-manager").Logger()),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		c.Logger.Debug().Msg("setup rate limiter")
+		c.Infrastructure.RateLimiter, err = redis3.NewLimiter(
+			c.Infrastructure.CacheRDB,
+			redis3.WithAlgorithm(c.Config.Security.RateLimit.Algorithm),
+			redis3.WithLogger(c.Logger.With().Str("component", "redis-rate-limiter").Logger()),
+		)
 
 		return err
 	}
@@ -6,12 +6,16 @@ import (
 	"github.com/mandarine-io/backend/internal/transport/http/handler/metrics"
 	"github.com/mandarine-io/backend/internal/transport/http/handler/swagger"
 	"github.com/mandarine-io/backend/internal/transport/http/handler/v0/account"
+	adminrole "github.com/mandarine-io/backend/internal/transport/http/handler/v0/admin/role"
 	"github.com/mandarine-io/backend/internal/transport/http/handler/v0/auth"
+	"github.com/mandarine-io/backend/internal/transport/http/handler/v0/chat"
 	"github.com/mandarine-io/backend/internal/transport/http/handler/v0/geocoding"
 	master_profile "github.com/mandarine-io/backend/internal/transport/http/handler/v0/master/profile"
 	master_service "github.com/mandarine-io/backend/internal/transport/http/handler/v0/master/service"
 	"github.com/mandarine-io/backend/internal/transport/http/handler/v0/resource"
+	"github.com/mandarine-io/backend/internal/transport/http/handler/v0/serviceaccount"
 	"github.com/mandarine-io/backend/internal/transport/http/handler/v0/ws"
+	"github.com/mandarine-io/backend/internal/transport/http/handler/wellknown"
 	"github.com/rs/zerolog/log"
 )
 
@@ -24,11 +28,19 @@ func Handlers(c *di.Container) di.Initializer {
 				c.DomainSVCs.Account,
 				account.WithLogger(c.Logger.With().Str("handler", "account").Logger()),
 			),
+			adminrole.NewHandler(
+				c.DomainSVCs.Role,
+				adminrole.WithLogger(c.Logger.With().Str("handler", "admin-role").Logger()),
+			),
 			auth.NewHandler(
 				c.DomainSVCs.Auth,
 				c.Config,
 				auth.WithLogger(c.Logger.With().Str("handler", "auth").Logger()),
 			),
+			chat.NewHandler(
+				c.DomainSVCs.Chat,
+				chat.WithLogger(c.Logger.With().Str("handler", "chat").Logger()),
+			),
 			health.NewHandler(
 				c.DomainSVCs.Health,
 				health.WithLogger(c.Logger.With().Str("handler", "health").Logger()),
@@ -52,9 +64,17 @@ func Handlers(c *di.Container) di.Initializer {
 				c.DomainSVCs.Resource,
 				resource.WithLogger(c.Logger.With().Str("handler", "resource").Logger()),
 			),
+			serviceaccount.NewHandler(
+				c.DomainSVCs.ServiceAccount,
+				serviceaccount.WithLogger(c.Logger.With().Str("handler", "service-account").Logger()),
+			),
 			swagger.NewHandler(
 				swagger.WithLogger(c.Logger.With().Str("handler", "swagger").Logger()),
 			),
+			wellknown.NewHandler(
+				c.DomainSVCs.WellKnown,
+				wellknown.WithLogger(c.Logger.With().Str("handler", "well-known").Logger()),
+			),
 			ws.NewHandler(
 				c.DomainSVCs.Websocket,
 				ws.WithLogger(c.Logger.With().Str("handler", "websocket").Logger()),
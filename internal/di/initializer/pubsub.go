@@ -4,7 +4,10 @@ import (
 	"github.com/mandarine-io/backend/config"
 	"github.com/mandarine-io/backend/internal/di"
 	"github.com/mandarine-io/backend/internal/infrastructure/cache/redis"
+	"github.com/mandarine-io/backend/internal/infrastructure/event"
 	redis2 "github.com/mandarine-io/backend/internal/infrastructure/pubsub/redis"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"time"
 )
 
 func PubSub(c *di.Container) di.Initializer {
@@ -24,8 +27,22 @@ func PubSub(c *di.Container) di.Initializer {
 			c.Infrastructure.PubSubRDB,
 			redis2.WithLogger(c.Logger.With().Str("component", "redis-pubsub").Logger()),
 		)
+		if err != nil {
+			return err
+		}
+
+		c.Infrastructure.StreamAgent, err = redis2.NewStreamAgent(
+			c.Infrastructure.PubSubRDB,
+			redis2.WithRedeliveryTimeout(time.Duration(c.Config.PubSub.RedeliveryTimeout)*time.Second),
+			redis2.WithMaxDeliveries(c.Config.PubSub.MaxDeliveries),
+			redis2.WithStreamLogger(c.Logger.With().Str("component", "redis-streams").Logger()),
+		)
+		if err != nil {
+			return err
+		}
 
-		return err
+		c.Infrastructure.EventRegistry = event.NewRegistry()
+		return domain.RegisterEvents(c.Infrastructure.EventRegistry)
 	}
 }
 
@@ -11,6 +11,22 @@ func GormRepositories(c *di.Container) di.Initializer {
 		log.Debug().Msg("setup gorm repositories")
 
 		c.Repos = di.Repositories{
+			APIKey: gorm.NewAPIKeyRepository(
+				c.Infrastructure.DB,
+				gorm.WithAPIKeyRepoLogger(c.Logger.With().Str("repo", "api_key").Logger()),
+			),
+			BannedToken: gorm.NewBannedTokenRepository(
+				c.Infrastructure.DB,
+				gorm.WithBannedTokenRepoLogger(c.Logger.With().Str("repo", "banned_token").Logger()),
+			),
+			ChatMessage: gorm.NewChatMessageRepository(
+				c.Infrastructure.DB,
+				gorm.WithChatMessageRepoLogger(c.Logger.With().Str("repo", "chat_message").Logger()),
+			),
+			Conversation: gorm.NewConversationRepository(
+				c.Infrastructure.DB,
+				gorm.WithConversationRepoLogger(c.Logger.With().Str("repo", "conversation").Logger()),
+			),
 			MasterProfile: gorm.NewMasterProfileRepository(
 				c.Infrastructure.DB,
 				gorm.WithMasterProfileRepoLogger(c.Logger.With().Str("repo", "master_profile").Logger()),
@@ -19,10 +35,43 @@ func GormRepositories(c *di.Container) di.Initializer {
 				c.Infrastructure.DB,
 				gorm.WithMasterServiceRepoLogger(c.Logger.With().Str("repo", "master_service").Logger()),
 			),
+			OutboxEvent: gorm.NewOutboxEventRepository(
+				c.Infrastructure.DB,
+				gorm.WithOutboxEventRepoLogger(c.Logger.With().Str("repo", "outbox_event").Logger()),
+			),
+			Passkey: gorm.NewPasskeyRepository(
+				c.Infrastructure.DB,
+				gorm.WithPasskeyRepoLogger(c.Logger.With().Str("repo", "passkey").Logger()),
+			),
+			RecoveryCode: gorm.NewRecoveryCodeRepository(
+				c.Infrastructure.DB,
+				gorm.WithRecoveryCodeRepoLogger(c.Logger.With().Str("repo", "recovery_code").Logger()),
+			),
+			Role: gorm.NewRoleRepository(
+				c.Infrastructure.DB,
+				gorm.WithRoleRepoLogger(c.Logger.With().Str("repo", "role").Logger()),
+			),
+			ServiceAccount: gorm.NewServiceAccountRepository(
+				c.Infrastructure.DB,
+				gorm.WithServiceAccountRepoLogger(c.Logger.With().Str("repo", "service_account").Logger()),
+			),
+			Session: gorm.NewSessionRepository(
+				c.Infrastructure.DB,
+				gorm.WithSessionRepoLogger(c.Logger.With().Str("repo", "session").Logger()),
+			),
+			SigningKey: gorm.NewSigningKeyRepository(
+				c.Infrastructure.DB,
+				gorm.WithSigningKeyRepoLogger(c.Logger.With().Str("repo", "signing_key").Logger()),
+			),
 			User: gorm.NewUserRepository(
 				c.Infrastructure.DB,
 				gorm.WithUserRepoLogger(c.Logger.With().Str("repo", "user").Logger()),
 			),
+			UserIdentity: gorm.NewUserIdentityRepository(
+				c.Infrastructure.DB,
+				gorm.WithUserIdentityRepoLogger(c.Logger.With().Str("repo", "user_identity").Logger()),
+			),
+			Transactor: gorm.NewTransactor(c.Infrastructure.DB),
 		}
 
 		return nil
@@ -4,14 +4,24 @@ import (
 	"github.com/mandarine-io/backend/internal/di"
 	"github.com/mandarine-io/backend/internal/service/domain/account"
 	"github.com/mandarine-io/backend/internal/service/domain/auth"
+	"github.com/mandarine-io/backend/internal/service/domain/chat"
 	"github.com/mandarine-io/backend/internal/service/domain/geocoding"
 	"github.com/mandarine-io/backend/internal/service/domain/health"
 	masterprofile "github.com/mandarine-io/backend/internal/service/domain/master/profile"
 	masterservice "github.com/mandarine-io/backend/internal/service/domain/master/service"
 	"github.com/mandarine-io/backend/internal/service/domain/resource"
+	"github.com/mandarine-io/backend/internal/service/domain/role"
+	"github.com/mandarine-io/backend/internal/service/domain/serviceaccount"
+	"github.com/mandarine-io/backend/internal/service/domain/wellknown"
 	"github.com/mandarine-io/backend/internal/service/domain/ws"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/apikey"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/bruteforce"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/jwk"
 	"github.com/mandarine-io/backend/internal/service/infrastructure/jwt"
 	"github.com/mandarine-io/backend/internal/service/infrastructure/otp"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/outbox"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/totp"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/webauthn"
 	geocoding2 "github.com/mandarine-io/backend/third_party/geocoding"
 	"github.com/mandarine-io/backend/third_party/geocoding/factory"
 	"github.com/rs/zerolog/log"
@@ -21,9 +31,31 @@ func Services(c *di.Container) di.Initializer {
 	return func() error {
 		log.Debug().Msg("setup infrastructure services")
 
+		jwkService := jwk.NewService(
+			c.Repos.SigningKey,
+			c.Repos.Transactor,
+			c.Config.Security.JWT,
+			jwk.WithLogger(c.Logger.With().Str("infra-service", "jwk").Logger()),
+		)
+
 		c.InfrastructureSVCs = di.InfrastructureServices{
+			APIKey: apikey.NewService(
+				c.Repos.APIKey,
+				c.Repos.User,
+				c.Config.Security.APIKey,
+				apikey.WithLogger(c.Logger.With().Str("infra-service", "api-key").Logger()),
+			),
+			BruteForce: bruteforce.NewService(
+				c.Infrastructure.CacheManager,
+				c.Config.Security.BruteForce,
+				bruteforce.WithLogger(c.Logger.With().Str("infra-service", "bruteforce").Logger()),
+			),
+			JWK: jwkService,
 			JWT: jwt.NewService(
 				c.Infrastructure.CacheManager,
+				jwkService,
+				c.Repos.Session,
+				c.Repos.BannedToken,
 				c.Config.Security.JWT,
 				jwt.WithLogger(c.Logger.With().Str("infra-service", "jwt").Logger()),
 			),
@@ -32,6 +64,26 @@ func Services(c *di.Container) di.Initializer {
 				c.Config.Security.OTP,
 				otp.WithLogger(c.Logger.With().Str("infra-service", "otp").Logger()),
 			),
+			Outbox: outbox.NewService(
+				c.Repos.OutboxEvent,
+				c.Repos.Transactor,
+				c.Infrastructure.PubSubAgent,
+				c.Infrastructure.EventRegistry,
+				c.Config.Outbox,
+				outbox.WithLogger(c.Logger.With().Str("infra-service", "outbox").Logger()),
+			),
+			TOTP: totp.NewService(
+				c.Repos.User,
+				c.Repos.RecoveryCode,
+				c.Config.Security.MFA,
+				totp.WithLogger(c.Logger.With().Str("infra-service", "totp").Logger()),
+			),
+			WebAuthn: webauthn.NewService(
+				c.Infrastructure.CacheManager,
+				c.Repos.Passkey,
+				c.Config,
+				webauthn.WithLogger(c.Logger.With().Str("infra-service", "webauthn").Logger()),
+			),
 		}
 
 		log.Debug().Msg("setup domain services")
@@ -41,25 +93,57 @@ func Services(c *di.Container) di.Initializer {
 			geocodingProviders = append(geocodingProviders, provider)
 		}
 
+		resourceService := resource.NewService(
+			c.Infrastructure.S3Manager,
+			resource.WithLogger(c.Logger.With().Str("domain-service", "resource").Logger()),
+		)
+
 		c.DomainSVCs = di.DomainServices{
 			Account: account.NewService(
 				c.Config,
 				c.Repos.User,
+				c.Repos.Session,
+				c.Repos.Passkey,
+				c.Repos.UserIdentity,
 				c.Infrastructure.SMTPSender,
+				c.Infrastructure.SMSSender,
 				c.Infrastructure.TemplateEngine,
 				c.InfrastructureSVCs.OTP,
+				c.InfrastructureSVCs.JWT,
+				c.InfrastructureSVCs.TOTP,
+				c.InfrastructureSVCs.WebAuthn,
+				c.InfrastructureSVCs.BruteForce,
+				c.ThirdParties.OAuth,
 				account.WithLogger(c.Logger.With().Str("domain-service", "account").Logger()),
 			),
 			Auth: auth.NewService(
 				c.Config,
 				c.Infrastructure.SMTPSender,
+				c.Infrastructure.SMSSender,
 				c.Infrastructure.TemplateEngine,
 				c.Repos.User,
+				c.Repos.UserIdentity,
+				c.Repos.Transactor,
 				c.InfrastructureSVCs.JWT,
 				c.InfrastructureSVCs.OTP,
+				c.InfrastructureSVCs.TOTP,
+				c.InfrastructureSVCs.WebAuthn,
+				c.InfrastructureSVCs.BruteForce,
 				c.ThirdParties.OAuth,
 				auth.WithLogger(c.Logger.With().Str("domain-service", "auth").Logger()),
 			),
+			Chat: chat.NewService(
+				c.Repos.Conversation,
+				c.Repos.ChatMessage,
+				c.Repos.MasterProfile,
+				resourceService,
+				c.Infrastructure.WSPool,
+				c.Infrastructure.WSRouter,
+				c.Infrastructure.SMTPSender,
+				c.Infrastructure.TemplateEngine,
+				c.Config.SMTP,
+				chat.WithLogger(c.Logger.With().Str("domain-service", "chat").Logger()),
+			),
 			Health: health.NewService(
 				c.Infrastructure.DB,
 				c.Infrastructure.CacheRDB,
@@ -75,6 +159,8 @@ func Services(c *di.Container) di.Initializer {
 			),
 			MasterProfile: masterprofile.NewService(
 				c.Repos.MasterProfile,
+				c.Repos.Transactor,
+				c.InfrastructureSVCs.Outbox,
 				masterprofile.WithLogger(c.Logger.With().Str("domain-service", "master-profile").Logger()),
 			),
 			MasterService: masterservice.NewService(
@@ -82,12 +168,25 @@ func Services(c *di.Container) di.Initializer {
 				c.Repos.MasterService,
 				masterservice.WithLogger(c.Logger.With().Str("domain-service", "master-service").Logger()),
 			),
-			Resource: resource.NewService(
-				c.Infrastructure.S3Manager,
-				resource.WithLogger(c.Logger.With().Str("domain-service", "resource").Logger()),
+			Resource: resourceService,
+			Role: role.NewService(
+				c.Repos.Role,
+				role.WithLogger(c.Logger.With().Str("domain-service", "role").Logger()),
+			),
+			ServiceAccount: serviceaccount.NewService(
+				c.Repos.ServiceAccount,
+				c.Repos.APIKey,
+				c.InfrastructureSVCs.APIKey,
+				c.Config.Security.APIKey,
+				serviceaccount.WithLogger(c.Logger.With().Str("domain-service", "service-account").Logger()),
+			),
+			WellKnown: wellknown.NewService(
+				c.InfrastructureSVCs.JWK,
+				wellknown.WithLogger(c.Logger.With().Str("domain-service", "well-known").Logger()),
 			),
 			Websocket: ws.NewService(
 				c.Infrastructure.WSPool,
+				c.Infrastructure.WSRouter,
 				ws.WithLogger(c.Logger.With().Str("domain-service", "websocket").Logger()),
 			),
 		}
@@ -0,0 +1,40 @@
+package initializer
+
+import (
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/di"
+	"github.com/mandarine-io/backend/internal/infrastructure/sms/http"
+	"github.com/mandarine-io/backend/internal/infrastructure/sms/memory"
+	"time"
+)
+
+func SMS(c *di.Container) di.Initializer {
+	return func() error {
+		c.Logger.Debug().Msg("setup SMS")
+
+		if c.Config.SMS.Provider == "memory" {
+			c.Logger.Warn().Msg("sms messages are not delivered, they are written to log")
+			c.Infrastructure.SMSSender = memory.NewSender(
+				memory.WithLogger(c.Logger.With().Str("component", "memory-sms-sender").Logger()),
+			)
+			return nil
+		}
+
+		var err error
+		c.Infrastructure.SMSSender, err = http.NewSender(
+			toHTTPSMSConfig(c.Config.SMS),
+			http.WithLogger(c.Logger.With().Str("component", "http-sms-sender").Logger()),
+		)
+
+		return err
+	}
+}
+
+func toHTTPSMSConfig(cfg config.SMSConfig) http.Config {
+	return http.Config{
+		BaseURL: cfg.BaseURL,
+		APIKey:  cfg.APIKey,
+		From:    cfg.From,
+		Timeout: time.Duration(cfg.Timeout) * time.Second,
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/mandarine-io/backend/internal/di"
 	geocodingfactory "github.com/mandarine-io/backend/third_party/geocoding/factory"
 	oauthfactory "github.com/mandarine-io/backend/third_party/oauth/factory"
+	"github.com/mandarine-io/backend/third_party/oauth/oidc"
+	"github.com/samber/lo"
 )
 
 func ThirdParty(c *di.Container) di.Initializer {
@@ -12,10 +14,28 @@ func ThirdParty(c *di.Container) di.Initializer {
 
 		var err error
 		for _, p := range c.Config.OAuthProviders {
-			c.ThirdParties.OAuth[p.Name], err = oauthfactory.NewProviderByKey(p.Name, p.ClientID, p.ClientSecret)
+			key := lo.CoalesceOrEmpty(p.Type, p.Name)
+			provider, err := oauthfactory.NewProviderByKey(
+				key,
+				oauthfactory.ProviderConfig{
+					ClientID:     p.ClientID,
+					ClientSecret: p.ClientSecret,
+					Issuer:       p.Issuer,
+					Scopes:       p.Scopes,
+					Claims: oidc.ClaimNames{
+						Subject:       p.Claims.Subject,
+						Username:      p.Claims.Username,
+						Email:         p.Claims.Email,
+						EmailVerified: p.Claims.EmailVerified,
+					},
+				},
+			)
 			if err != nil {
-				c.Logger.Warn().Err(err).Msgf("failed to create OAuth provider by key %s", p.Name)
+				c.Logger.Warn().Err(err).Msgf("failed to create OAuth provider %s by key %s", p.Name, key)
+				continue
 			}
+
+			c.ThirdParties.OAuth[p.Name] = provider
 		}
 
 		for _, p := range c.Config.GeocodingProviders {
@@ -2,19 +2,54 @@ package initializer
 
 import (
 	"github.com/mandarine-io/backend/internal/di"
+	presenceredis "github.com/mandarine-io/backend/internal/infrastructure/presence/redis"
+	replayredis "github.com/mandarine-io/backend/internal/infrastructure/replay/redis"
 	"github.com/mandarine-io/backend/internal/infrastructure/websocket"
+	"time"
 )
 
 func Websocket(c *di.Container) di.Initializer {
 	return func() error {
 		c.Logger.Debug().Msg("setup ws pool")
 
-		var err error
+		registry, err := presenceredis.NewRegistry(
+			c.Infrastructure.CacheRDB,
+			presenceredis.WithTTL(time.Duration(c.Config.Websocket.PresenceTTL)*time.Second),
+			presenceredis.WithLogger(c.Logger.With().Str("component", "ws-presence").Logger()),
+		)
+		if err != nil {
+			return err
+		}
+
+		buffer, err := replayredis.NewBuffer(
+			c.Infrastructure.CacheRDB,
+			replayredis.WithSize(c.Config.Websocket.ReplaySize),
+			replayredis.WithTTL(time.Duration(c.Config.Websocket.ReplayTTL)*time.Second),
+			replayredis.WithLogger(c.Logger.With().Str("component", "ws-replay").Logger()),
+		)
+		if err != nil {
+			return err
+		}
+
 		c.Infrastructure.WSPool, err = websocket.NewPool(
 			c.Config.Websocket.PoolSize,
+			websocket.WithPubSub(c.Infrastructure.PubSubAgent),
+			websocket.WithPresence(registry),
+			websocket.WithReplayBuffer(buffer),
+			websocket.WithSendQueueSize(c.Config.Websocket.SendQueueSize),
+			websocket.WithSlowConsumerPolicy(websocket.SlowConsumerPolicy(c.Config.Websocket.SlowConsumerPolicy)),
+			websocket.WithMetrics(c.Metrics),
 			websocket.WithLogger(c.Logger.With().Str("component", "ws-pool").Logger()),
 		)
+		if err != nil {
+			return err
+		}
+
+		c.Infrastructure.WSRouter = websocket.NewRouter(
+			websocket.WithRouterLogger(c.Logger.With().Str("component", "ws-router").Logger()),
+		)
+		c.Infrastructure.WSPool.RegisterHandler(c.Infrastructure.WSRouter.Serve)
 
-		return err
+		return nil
 	}
 }
@@ -0,0 +1,113 @@
+package layered
+
+import (
+	"container/list"
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	"sync"
+	"time"
+)
+
+type lruEntry struct {
+	key        string
+	data       []byte
+	expiration time.Time
+}
+
+// lru is bounded cache of encoded values. The least recently used entry is evicted, when cache is full
+type lru struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+	// generation is incremented by every removal, so value read from remote tier before removal is not stored
+	generation uint64
+}
+
+func newLRU(size int) *lru {
+	return &lru{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (c *lru) get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	e := el.Value.(*lruEntry)
+	if time.Now().After(e.expiration) {
+		c.removeElement(el)
+		return nil, false
+	}
+
+	c.order.MoveToFront(el)
+	return e.data, true
+}
+
+// set stores value, if no entry is removed since generation
+func (c *lru) set(key string, data []byte, ttl time.Duration, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation {
+		return
+	}
+
+	expiration := time.Now().Add(ttl)
+	if el, ok := c.entries[key]; ok {
+		e := el.Value.(*lruEntry)
+		e.data = data
+		e.expiration = expiration
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&lruEntry{key: key, data: data, expiration: expiration})
+	for c.order.Len() > c.size {
+		c.removeElement(c.order.Back())
+	}
+}
+
+func (c *lru) currentGeneration() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.generation
+}
+
+func (c *lru) remove(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for _, key := range keys {
+		if el, ok := c.entries[key]; ok {
+			c.removeElement(el)
+		}
+	}
+}
+
+// removeMatching removes entries, which keys match glob-style pattern of Redis SCAN
+func (c *lru) removeMatching(pattern string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for key, el := range c.entries {
+		if pubsub.MatchPattern(pattern, key) {
+			c.removeElement(el)
+		}
+	}
+}
+
+// removeElement removes entry. Caller must hold mutex
+func (c *lru) removeElement(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.entries, el.Value.(*lruEntry).key)
+}
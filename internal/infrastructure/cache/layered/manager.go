@@ -0,0 +1,290 @@
+package layered
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache"
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	"github.com/mandarine-io/backend/internal/observability"
+	"github.com/rs/zerolog"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	LocalTier  = "local"
+	RemoteTier = "remote"
+
+	DefaultTopic = "cache:invalidate"
+)
+
+type Option func(*manager) error
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(m *manager) error {
+		m.logger = logger
+		return nil
+	}
+}
+
+// WithSize sets max number of entries of local tier
+func WithSize(size int) Option {
+	return func(m *manager) error {
+		if size <= 0 {
+			return fmt.Errorf("size must be positive")
+		}
+
+		m.size = size
+		return nil
+	}
+}
+
+// WithLocalTTL sets time, during which entry is served by local tier without request to remote tier
+func WithLocalTTL(ttl time.Duration) Option {
+	return func(m *manager) error {
+		if ttl <= 0 {
+			return fmt.Errorf("local ttl must be positive")
+		}
+
+		m.localTTL = ttl
+		return nil
+	}
+}
+
+// WithTopic sets pub/sub topic, through which nodes exchange invalidations of local tier
+func WithTopic(topic string) Option {
+	return func(m *manager) error {
+		m.topic = topic
+		return nil
+	}
+}
+
+func WithMetrics(metrics observability.MetricsAdapter) Option {
+	return func(m *manager) error {
+		m.metrics = metrics
+		return nil
+	}
+}
+
+// invalidation is message, by which node asks other nodes to remove keys from their local tier
+type invalidation struct {
+	NodeID  string   `json:"nodeId"`
+	Keys    []string `json:"keys,omitempty"`
+	Pattern string   `json:"pattern,omitempty"`
+}
+
+// manager is cache manager with bounded in-memory LRU tier in front of remote tier. Values are encoded as JSON
+// in both tiers. Changes of remote tier are broadcast through pub/sub, so stale entries are removed from local tier
+// of every node. Invalidation may be lost, so entries of local tier live only during short TTL
+type manager struct {
+	remote   cache.Manager
+	agent    pubsub.Agent
+	local    *lru
+	nodeID   string
+	size     int
+	localTTL time.Duration
+	topic    string
+	metrics  observability.MetricsAdapter
+	logger   zerolog.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewManager(remote cache.Manager, agent pubsub.Agent, opts ...Option) (cache.Manager, error) {
+	m := &manager{
+		remote:   remote,
+		agent:    agent,
+		nodeID:   uuid.NewString(),
+		size:     10000,
+		localTTL: 30 * time.Second,
+		topic:    DefaultTopic,
+		logger:   zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		if err := opt(m); err != nil {
+			return nil, fmt.Errorf("failed to apply option: %w", err)
+		}
+	}
+
+	m.local = newLRU(m.size)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+
+	invalidations, err := m.agent.Subscribe(ctx, m.topic)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to subscribe to %s: %w", m.topic, err)
+	}
+
+	m.wg.Add(1)
+	go m.receiveInvalidations(ctx, invalidations)
+
+	return m, nil
+}
+
+func (m *manager) Get(ctx context.Context, key string, value any) error {
+	m.logger.Debug().Msgf("get from cache %s", key)
+
+	if data, ok := m.local.get(key); ok {
+		m.observe(LocalTier, true)
+		return json.Unmarshal(data, value)
+	}
+	m.observe(LocalTier, false)
+
+	// Generation is read before remote tier, so value changed during request is not stored in local tier
+	generation := m.local.currentGeneration()
+
+	err := m.remote.Get(ctx, key, value)
+	if errors.Is(err, cache.ErrCacheEntryNotFound) {
+		m.observe(RemoteTier, false)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	m.observe(RemoteTier, true)
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	m.local.set(key, data, m.localTTL, generation)
+
+	return nil
+}
+
+func (m *manager) Set(ctx context.Context, key string, value any) error {
+	m.logger.Debug().Msgf("set to cache %s", key)
+
+	if err := m.remote.Set(ctx, key, value); err != nil {
+		return err
+	}
+
+	m.invalidate(ctx, invalidation{Keys: []string{key}})
+	return nil
+}
+
+func (m *manager) SetWithExpiration(ctx context.Context, key string, value any, expiration time.Duration) error {
+	m.logger.Debug().Msgf("set to cache %s with expiration %s", key, expiration)
+
+	if err := m.remote.SetWithExpiration(ctx, key, value, expiration); err != nil {
+		return err
+	}
+
+	m.invalidate(ctx, invalidation{Keys: []string{key}})
+	return nil
+}
+
+func (m *manager) Increment(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	m.logger.Debug().Msgf("increment in cache %s with expiration %s", key, expiration)
+
+	value, err := m.remote.Increment(ctx, key, expiration)
+	if err != nil {
+		return 0, err
+	}
+
+	m.invalidate(ctx, invalidation{Keys: []string{key}})
+	return value, nil
+}
+
+func (m *manager) Delete(ctx context.Context, keys ...string) error {
+	m.logger.Debug().Msgf("delete from cache %s", strings.Join(keys, ","))
+
+	if err := m.remote.Delete(ctx, keys...); err != nil {
+		return err
+	}
+
+	m.invalidate(ctx, invalidation{Keys: keys})
+	return nil
+}
+
+func (m *manager) Invalidate(ctx context.Context, keyRegex string) error {
+	m.logger.Debug().Msgf("invalidate cache by regex %s", keyRegex)
+
+	if err := m.remote.Invalidate(ctx, keyRegex); err != nil {
+		return err
+	}
+
+	m.invalidate(ctx, invalidation{Pattern: keyRegex})
+	return nil
+}
+
+// Close stops receiving invalidations of other nodes
+func (m *manager) Close() error {
+	m.cancel()
+	err := m.agent.Unsubscribe(context.Background(), m.topic)
+	m.wg.Wait()
+
+	if errors.Is(err, pubsub.ErrTopicNotFound) {
+		return nil
+	}
+	return err
+}
+
+// invalidate removes keys from local tier and asks other nodes to remove them. Remote tier is already changed,
+// so failure of publishing is only logged, stale entries of other nodes expire after local TTL
+func (m *manager) invalidate(ctx context.Context, msg invalidation) {
+	m.apply(msg)
+
+	msg.NodeID = m.nodeID
+	if err := m.agent.Publish(ctx, m.topic, msg); err != nil {
+		m.logger.Warn().Err(err).Msg("failed to publish cache invalidation")
+	}
+}
+
+func (m *manager) apply(msg invalidation) {
+	if len(msg.Keys) > 0 {
+		m.local.remove(msg.Keys...)
+	}
+	if msg.Pattern != "" {
+		m.local.removeMatching(msg.Pattern)
+	}
+}
+
+func (m *manager) receiveInvalidations(ctx context.Context, invalidations <-chan pubsub.Event) {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-invalidations:
+			if !ok {
+				return
+			}
+
+			msg, err := pubsub.DecodeEvent[invalidation](event)
+			if err != nil {
+				m.logger.Error().Stack().Err(err).Msg("failed to decode cache invalidation")
+				continue
+			}
+
+			// Own invalidations are applied before publishing
+			if msg.NodeID == m.nodeID {
+				continue
+			}
+
+			m.logger.Debug().Msgf("apply cache invalidation of node %s", msg.NodeID)
+			m.apply(msg)
+		}
+	}
+}
+
+func (m *manager) observe(tier string, hit bool) {
+	if m.metrics == nil {
+		return
+	}
+
+	if hit {
+		m.metrics.IncrementCacheHit(tier)
+	} else {
+		m.metrics.IncrementCacheMiss(tier)
+	}
+}
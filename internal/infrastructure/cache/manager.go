@@ -14,6 +14,9 @@ type Manager interface {
 	Get(ctx context.Context, key string, value any) error
 	Set(ctx context.Context, key string, value any) error
 	SetWithExpiration(ctx context.Context, key string, value any, expiration time.Duration) error
+	// Increment atomically increases integer value by one and returns the new value.
+	// Missing key is created, expiration is refreshed on every call.
+	Increment(ctx context.Context, key string, expiration time.Duration) (int64, error)
 	Delete(ctx context.Context, keys ...string) error
 	Invalidate(ctx context.Context, keyRegex string) error
 }
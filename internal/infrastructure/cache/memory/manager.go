@@ -101,6 +101,35 @@ func (m *manager) SetWithExpiration(_ context.Context, key string, value any, ex
 	return nil
 }
 
+func (m *manager) Increment(_ context.Context, key string, expiration time.Duration) (int64, error) {
+	m.cleanExpiredEntry()
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.logger.Debug().Msgf("increment in cache: %s with expiration %s", key, expiration)
+
+	var value int64
+	if e, ok := m.storage[key]; ok {
+		current, ok := e.value.(int64)
+		if !ok {
+			return 0, errors.New("value is not an integer")
+		}
+		value = current
+	}
+	value++
+
+	var expiredTime int64 = math.MaxInt64
+	if expiration > 0 {
+		expiredTime = time.Now().Add(expiration).Unix()
+	}
+
+	m.storage[key] = entry{
+		value:      value,
+		expiration: expiredTime,
+	}
+	return value, nil
+}
+
 func (m *manager) Delete(_ context.Context, keys ...string) error {
 	m.cleanExpiredEntry()
 	m.lock.Lock()
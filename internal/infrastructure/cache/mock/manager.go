@@ -131,6 +131,64 @@ func (_c *ManagerMock_Get_Call) RunAndReturn(run func(context.Context, string, a
 	return _c
 }
 
+// Increment provides a mock function with given fields: ctx, key, expiration
+func (_m *ManagerMock) Increment(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	ret := _m.Called(ctx, key, expiration)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Increment")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) (int64, error)); ok {
+		return rf(ctx, key, expiration)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) int64); ok {
+		r0 = rf(ctx, key, expiration)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(ctx, key, expiration)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ManagerMock_Increment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Increment'
+type ManagerMock_Increment_Call struct {
+	*mock.Call
+}
+
+// Increment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - expiration time.Duration
+func (_e *ManagerMock_Expecter) Increment(ctx interface{}, key interface{}, expiration interface{}) *ManagerMock_Increment_Call {
+	return &ManagerMock_Increment_Call{Call: _e.mock.On("Increment", ctx, key, expiration)}
+}
+
+func (_c *ManagerMock_Increment_Call) Run(run func(ctx context.Context, key string, expiration time.Duration)) *ManagerMock_Increment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *ManagerMock_Increment_Call) Return(_a0 int64, _a1 error) *ManagerMock_Increment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ManagerMock_Increment_Call) RunAndReturn(run func(context.Context, string, time.Duration) (int64, error)) *ManagerMock_Increment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Invalidate provides a mock function with given fields: ctx, keyRegex
 func (_m *ManagerMock) Invalidate(ctx context.Context, keyRegex string) error {
 	ret := _m.Called(ctx, keyRegex)
@@ -84,6 +84,24 @@ func (m *manager) SetWithExpiration(ctx context.Context, key string, value any,
 	return m.client.Set(ctx, key, jsonValue, expiration).Err()
 }
 
+func (m *manager) Increment(ctx context.Context, key string, expiration time.Duration) (int64, error) {
+	m.logger.Debug().Msgf("increment in cache %s with expiration %s", key, expiration)
+
+	var incr *redis.IntCmd
+	_, err := m.client.TxPipelined(
+		ctx, func(pipe redis.Pipeliner) error {
+			incr = pipe.Incr(ctx, key)
+			pipe.Expire(ctx, key, expiration)
+			return nil
+		},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
 func (m *manager) Delete(ctx context.Context, keys ...string) error {
 	m.logger.Debug().Msgf("delete from cache %s", strings.Join(keys, ","))
 
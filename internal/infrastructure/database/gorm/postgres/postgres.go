@@ -20,6 +20,7 @@ func NewDb(cfg Config) (*gorm.DB, error) {
 			Logger:          plugin.Logger{},
 			PrepareStmt:     true,
 			CreateBatchSize: 100,
+			TranslateError:  true,
 		},
 	)
 	if err != nil {
@@ -0,0 +1,147 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"sync"
+	"time"
+)
+
+const (
+	topicPrefix = "events:"
+)
+
+var (
+	ErrUnknownEventType   = errors.New("unknown event type")
+	ErrDuplicateEventType = errors.New("duplicate event type")
+	ErrInvalidEvent       = errors.New("invalid event")
+)
+
+// Event is domain event. Events of one aggregate are published in order, in which they are produced
+type Event interface {
+	EventType() string
+	AggregateType() string
+	AggregateID() string
+}
+
+// Codec encodes and decodes events of one type
+type Codec interface {
+	Encode(e Event) ([]byte, error)
+	Decode(data []byte) (Event, error)
+}
+
+// JSONCodec encodes events of type T as JSON
+type JSONCodec[T Event] struct{}
+
+func (JSONCodec[T]) Encode(e Event) ([]byte, error) {
+	typed, ok := e.(T)
+	if !ok {
+		return nil, fmt.Errorf("%w: unexpected type %T", ErrInvalidEvent, e)
+	}
+
+	return json.Marshal(typed)
+}
+
+func (JSONCodec[T]) Decode(data []byte) (Event, error) {
+	var e T
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidEvent, err)
+	}
+
+	return e, nil
+}
+
+// Envelope is published message of event. Payload is encoded by codec of event type
+type Envelope struct {
+	ID            uuid.UUID       `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateId"`
+	OccurredAt    time.Time       `json:"occurredAt"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// Registry contains codecs of event types. It is shared by producers and consumers of events
+type Registry struct {
+	mu     sync.RWMutex
+	codecs map[string]Codec
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		codecs: make(map[string]Codec),
+	}
+}
+
+// Register registers codec of event type
+func (r *Registry) Register(eventType string, codec Codec) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.codecs[eventType]; ok {
+		return fmt.Errorf("%w: %s", ErrDuplicateEventType, eventType)
+	}
+
+	r.codecs[eventType] = codec
+	return nil
+}
+
+// RegisterJSON registers JSON codec of event type T
+func RegisterJSON[T Event](r *Registry) error {
+	var e T
+	return r.Register(e.EventType(), JSONCodec[T]{})
+}
+
+// Encode encodes payload of event
+func (r *Registry) Encode(e Event) ([]byte, error) {
+	codec, err := r.codec(e.EventType())
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.Encode(e)
+}
+
+// Decode decodes payload of event of type
+func (r *Registry) Decode(eventType string, data []byte) (Event, error) {
+	codec, err := r.codec(eventType)
+	if err != nil {
+		return nil, err
+	}
+
+	return codec.Decode(data)
+}
+
+// DecodeEnvelope decodes published message of event
+func (r *Registry) DecodeEnvelope(data []byte) (Envelope, Event, error) {
+	env := Envelope{}
+	if err := json.Unmarshal(data, &env); err != nil {
+		return Envelope{}, nil, fmt.Errorf("%w: %w", ErrInvalidEvent, err)
+	}
+
+	e, err := r.Decode(env.Type, env.Payload)
+	if err != nil {
+		return Envelope{}, nil, err
+	}
+
+	return env, e, nil
+}
+
+func (r *Registry) codec(eventType string) (Codec, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	codec, ok := r.codecs[eventType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEventType, eventType)
+	}
+
+	return codec, nil
+}
+
+// Topic returns pub/sub topic, to which events of type are published
+func Topic(eventType string) string {
+	return topicPrefix + eventType
+}
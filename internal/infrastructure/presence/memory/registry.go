@@ -0,0 +1,106 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"github.com/mandarine-io/backend/internal/infrastructure/presence"
+	"github.com/rs/zerolog"
+	"sync"
+	"time"
+)
+
+type Option func(*registry) error
+
+func WithTTL(ttl time.Duration) Option {
+	return func(r *registry) error {
+		if ttl <= 0 {
+			return fmt.Errorf("invalid presence ttl: %s", ttl)
+		}
+
+		r.ttl = ttl
+		return nil
+	}
+}
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(r *registry) error {
+		r.logger = logger
+		return nil
+	}
+}
+
+type registry struct {
+	mu sync.Mutex
+	// nodes contains expiration of presence by client and node
+	nodes  map[string]map[string]time.Time
+	ttl    time.Duration
+	logger zerolog.Logger
+}
+
+func NewRegistry(opts ...Option) (presence.Registry, error) {
+	r := &registry{
+		nodes:  make(map[string]map[string]time.Time),
+		ttl:    presence.DefaultTTL,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		if err := opt(r); err != nil {
+			return nil, fmt.Errorf("failed to apply option: %w", err)
+		}
+	}
+
+	return r, nil
+}
+
+func (r *registry) Join(_ context.Context, nodeID string, clientIDs ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.logger.Debug().Msgf("join %d clients on node %s", len(clientIDs), nodeID)
+
+	expiration := time.Now().Add(r.ttl)
+	for _, clientID := range clientIDs {
+		if _, ok := r.nodes[clientID]; !ok {
+			r.nodes[clientID] = make(map[string]time.Time)
+		}
+		r.nodes[clientID][nodeID] = expiration
+	}
+
+	return nil
+}
+
+func (r *registry) Leave(_ context.Context, nodeID string, clientIDs ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.logger.Debug().Msgf("leave %d clients on node %s", len(clientIDs), nodeID)
+
+	for _, clientID := range clientIDs {
+		delete(r.nodes[clientID], nodeID)
+		if len(r.nodes[clientID]) == 0 {
+			delete(r.nodes, clientID)
+		}
+	}
+
+	return nil
+}
+
+func (r *registry) IsOnline(_ context.Context, clientID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.logger.Debug().Msgf("check presence of client %s", clientID)
+
+	now := time.Now()
+	for nodeID, expiration := range r.nodes[clientID] {
+		if expiration.After(now) {
+			return true, nil
+		}
+
+		delete(r.nodes[clientID], nodeID)
+	}
+	delete(r.nodes, clientID)
+
+	return false, nil
+}
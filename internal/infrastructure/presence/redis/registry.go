@@ -0,0 +1,116 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"github.com/mandarine-io/backend/internal/infrastructure/presence"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+	"strconv"
+	"time"
+)
+
+const (
+	keyPrefix = "presence"
+)
+
+type Option func(*registry) error
+
+func WithTTL(ttl time.Duration) Option {
+	return func(r *registry) error {
+		if ttl <= 0 {
+			return fmt.Errorf("invalid presence ttl: %s", ttl)
+		}
+
+		r.ttl = ttl
+		return nil
+	}
+}
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(r *registry) error {
+		r.logger = logger
+		return nil
+	}
+}
+
+// registry stores nodes of client in sorted set, score of node is expiration of presence in ms
+type registry struct {
+	client redis.UniversalClient
+	ttl    time.Duration
+	logger zerolog.Logger
+}
+
+func NewRegistry(client redis.UniversalClient, opts ...Option) (presence.Registry, error) {
+	r := &registry{
+		client: client,
+		ttl:    presence.DefaultTTL,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		if err := opt(r); err != nil {
+			return nil, fmt.Errorf("failed to apply option: %w", err)
+		}
+	}
+
+	return r, nil
+}
+
+func (r *registry) Join(ctx context.Context, nodeID string, clientIDs ...string) error {
+	r.logger.Debug().Msgf("join %d clients on node %s", len(clientIDs), nodeID)
+
+	if len(clientIDs) == 0 {
+		return nil
+	}
+
+	expiration := time.Now().Add(r.ttl).UnixMilli()
+	_, err := r.client.Pipelined(
+		ctx, func(pipe redis.Pipeliner) error {
+			for _, clientID := range clientIDs {
+				key := r.key(clientID)
+				pipe.ZAdd(ctx, key, redis.Z{Score: float64(expiration), Member: nodeID})
+				pipe.PExpire(ctx, key, r.ttl)
+			}
+			return nil
+		},
+	)
+
+	return err
+}
+
+func (r *registry) Leave(ctx context.Context, nodeID string, clientIDs ...string) error {
+	r.logger.Debug().Msgf("leave %d clients on node %s", len(clientIDs), nodeID)
+
+	if len(clientIDs) == 0 {
+		return nil
+	}
+
+	_, err := r.client.Pipelined(
+		ctx, func(pipe redis.Pipeliner) error {
+			for _, clientID := range clientIDs {
+				pipe.ZRem(ctx, r.key(clientID), nodeID)
+			}
+			return nil
+		},
+	)
+
+	return err
+}
+
+func (r *registry) IsOnline(ctx context.Context, clientID string) (bool, error) {
+	r.logger.Debug().Msgf("check presence of client %s", clientID)
+
+	// Nodes, which have not refreshed presence in time, are ignored
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	count, err := r.client.ZCount(ctx, r.key(clientID), "("+now, "+inf").Result()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+func (r *registry) key(clientID string) string {
+	return keyPrefix + ":" + clientID
+}
@@ -0,0 +1,18 @@
+package presence
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	DefaultTTL = 90 * time.Second
+)
+
+// Registry tracks on which nodes clients are connected. Node must refresh presence of its clients
+// more often than TTL, so clients of crashed node become offline after TTL
+type Registry interface {
+	Join(ctx context.Context, nodeID string, clientIDs ...string) error
+	Leave(ctx context.Context, nodeID string, clientIDs ...string) error
+	IsOnline(ctx context.Context, clientID string) (bool, error)
+}
@@ -10,14 +10,51 @@ var (
 	ErrTopicNotFound = errors.New("topic not found")
 )
 
+// Event is message received by subscription. Pattern is pattern of subscription, by which event is received,
+// it is empty for subscription to topic. Payload is encoded by codec of agent
 type Event struct {
 	Topic   string
-	Payload any
+	Pattern string
+	Payload []byte
+
+	codec Codec
+}
+
+func NewEvent(topic string, pattern string, payload []byte, codec Codec) Event {
+	return Event{
+		Topic:   topic,
+		Pattern: pattern,
+		Payload: payload,
+		codec:   codec,
+	}
+}
+
+// Decode decodes payload of event into value by codec of agent
+func (e Event) Decode(v any) error {
+	codec := e.codec
+	if codec == nil {
+		codec = DefaultCodec
+	}
+
+	return codec.Unmarshal(e.Payload, v)
+}
+
+// DecodeEvent decodes payload of event into value of type T
+func DecodeEvent[T any](e Event) (T, error) {
+	var v T
+	err := e.Decode(&v)
+	return v, err
 }
 
+// Agent delivers messages published to topic to all its current subscribers. Message is encoded by codec of agent,
+// so subscribers of all backends receive the same payload
 type Agent interface {
 	Publish(ctx context.Context, topic string, msg any) error
 	Subscribe(ctx context.Context, topic string) (<-chan Event, error)
 	Unsubscribe(ctx context.Context, topic string) error
+	// PSubscribe subscribes to all topics, which match glob-style pattern. Pattern supports `*`, `?`, `[...]`
+	// and `\` escaping as Redis PSUBSCRIBE does
+	PSubscribe(ctx context.Context, pattern string) (<-chan Event, error)
+	PUnsubscribe(ctx context.Context, pattern string) error
 	Close() error
 }
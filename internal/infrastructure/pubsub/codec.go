@@ -0,0 +1,26 @@
+package pubsub
+
+import (
+	"github.com/goccy/go-json"
+)
+
+var (
+	DefaultCodec Codec = JSONCodec{}
+)
+
+// Codec encodes messages published to agent and decodes payloads of received events
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// JSONCodec encodes messages as JSON
+type JSONCodec struct{}
+
+func (JSONCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (JSONCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
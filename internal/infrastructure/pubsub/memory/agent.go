@@ -17,15 +17,31 @@ func WithLogger(logger zerolog.Logger) Option {
 	}
 }
 
+// WithCodec sets codec, by which messages are encoded. JSON codec is used by default
+func WithCodec(codec pubsub.Codec) Option {
+	return func(a *agent) error {
+		if codec == nil {
+			return fmt.Errorf("codec is nil")
+		}
+
+		a.codec = codec
+		return nil
+	}
+}
+
 type agent struct {
 	mu     sync.Mutex
 	subs   map[string][]chan pubsub.Event
+	psubs  map[string][]chan pubsub.Event
+	codec  pubsub.Codec
 	logger zerolog.Logger
 }
 
 func NewAgent(opts ...Option) (pubsub.Agent, error) {
 	a := &agent{
 		subs:   make(map[string][]chan pubsub.Event),
+		psubs:  make(map[string][]chan pubsub.Event),
+		codec:  pubsub.DefaultCodec,
 		logger: zerolog.Nop(),
 	}
 
@@ -39,22 +55,29 @@ func NewAgent(opts ...Option) (pubsub.Agent, error) {
 }
 
 func (a *agent) Publish(_ context.Context, topic string, msg any) error {
+	// Message is encoded as Redis agent does, so subscribers do not share it with publisher
+	payload, err := a.codec.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
 	a.logger.Debug().Msgf("publish message to topic: %s", topic)
 
-	if _, ok := a.subs[topic]; !ok {
-		return pubsub.ErrTopicNotFound
-	}
-
 	// Send to subscribers
-	event := pubsub.Event{
-		Topic:   topic,
-		Payload: msg,
-	}
 	for _, ch := range a.subs[topic] {
-		ch <- event
+		ch <- pubsub.NewEvent(topic, "", payload, a.codec)
+	}
+	for pattern, chs := range a.psubs {
+		if !pubsub.MatchPattern(pattern, topic) {
+			continue
+		}
+
+		for _, ch := range chs {
+			ch <- pubsub.NewEvent(topic, pattern, payload, a.codec)
+		}
 	}
 
 	return nil
@@ -78,27 +101,56 @@ func (a *agent) Unsubscribe(_ context.Context, topic string) error {
 
 	a.logger.Debug().Msgf("unsubscribe to topic: %s", topic)
 
-	if _, ok := a.subs[topic]; !ok {
-		return pubsub.ErrTopicNotFound
-	}
+	return unsubscribe(a.subs, topic)
+}
 
-	for _, ch := range a.subs[topic] {
-		close(ch)
-	}
-	delete(a.subs, topic)
+func (a *agent) PSubscribe(_ context.Context, pattern string) (<-chan pubsub.Event, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
 
-	return nil
+	a.logger.Debug().Msgf("subscribe to pattern: %s", pattern)
+
+	ch := make(chan pubsub.Event, 1024)
+	a.psubs[pattern] = append(a.psubs[pattern], ch)
+
+	return ch, nil
+}
+
+func (a *agent) PUnsubscribe(_ context.Context, pattern string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.logger.Debug().Msgf("unsubscribe from pattern: %s", pattern)
+
+	return unsubscribe(a.psubs, pattern)
 }
 
 func (a *agent) Close() error {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
-	for _, ch := range a.subs {
-		for _, sub := range ch {
-			close(sub)
+	for _, subs := range []map[string][]chan pubsub.Event{a.subs, a.psubs} {
+		for key, chs := range subs {
+			for _, ch := range chs {
+				close(ch)
+			}
+			delete(subs, key)
 		}
 	}
 
 	return nil
 }
+
+// unsubscribe closes channels of subscriptions to topic or pattern. Caller must hold mutex
+func unsubscribe(subs map[string][]chan pubsub.Event, key string) error {
+	if _, ok := subs[key]; !ok {
+		return pubsub.ErrTopicNotFound
+	}
+
+	for _, ch := range subs[key] {
+		close(ch)
+	}
+	delete(subs, key)
+
+	return nil
+}
@@ -0,0 +1,353 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	"github.com/rs/zerolog"
+	"sync"
+	"time"
+)
+
+const (
+	maxPollInterval = 100 * time.Millisecond
+)
+
+type StreamOption func(*streamAgent) error
+
+func WithStreamLogger(logger zerolog.Logger) StreamOption {
+	return func(a *streamAgent) error {
+		a.logger = logger
+		return nil
+	}
+}
+
+// WithRedeliveryTimeout sets time, after which unacknowledged message is delivered again
+func WithRedeliveryTimeout(timeout time.Duration) StreamOption {
+	return func(a *streamAgent) error {
+		if timeout <= 0 {
+			return fmt.Errorf("redelivery timeout must be positive")
+		}
+
+		a.redeliveryTimeout = timeout
+		return nil
+	}
+}
+
+// WithMaxDeliveries sets number of deliveries, after which unacknowledged message is moved to dead-letter stream
+func WithMaxDeliveries(n int) StreamOption {
+	return func(a *streamAgent) error {
+		if n <= 0 {
+			return fmt.Errorf("max deliveries must be positive")
+		}
+
+		a.maxDeliveries = n
+		return nil
+	}
+}
+
+type entry struct {
+	id      string
+	payload []byte
+}
+
+type pendingEntry struct {
+	entry
+	consumer    string
+	deliveredAt time.Time
+	deliveries  int
+}
+
+type group struct {
+	// next is index of the first entry of stream, which is not delivered to group
+	next int
+	// pending contains delivered, but not acknowledged entries in order of delivery
+	pending []*pendingEntry
+}
+
+type stream struct {
+	seq     uint64
+	entries []entry
+	groups  map[string]*group
+}
+
+// streamAgent is in-memory stream agent with the same delivery semantics as Redis Streams one.
+// Streams are never trimmed, so it is intended for tests and single node development
+type streamAgent struct {
+	logger            zerolog.Logger
+	redeliveryTimeout time.Duration
+	maxDeliveries     int
+
+	mu      sync.Mutex
+	streams map[string]*stream
+	closed  bool
+	// notify is closed and replaced, when new message is available for delivery
+	notify chan struct{}
+	cancel context.CancelFunc
+	ctx    context.Context
+	wg     sync.WaitGroup
+}
+
+func NewStreamAgent(opts ...StreamOption) (pubsub.StreamAgent, error) {
+	a := &streamAgent{
+		logger:            zerolog.Nop(),
+		redeliveryTimeout: pubsub.DefaultRedeliveryTimeout,
+		maxDeliveries:     pubsub.DefaultMaxDeliveries,
+		streams:           make(map[string]*stream),
+		notify:            make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		if err := opt(a); err != nil {
+			return nil, fmt.Errorf("failed to apply option: %w", err)
+		}
+	}
+
+	a.ctx, a.cancel = context.WithCancel(context.Background())
+
+	return a, nil
+}
+
+func (a *streamAgent) Publish(_ context.Context, streamName string, payload []byte) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.logger.Debug().Msgf("publish message to stream: %s", streamName)
+
+	if a.closed {
+		return "", pubsub.ErrStreamAgentClosed
+	}
+
+	id := a.append(streamName, payload)
+	a.broadcast()
+
+	return id, nil
+}
+
+func (a *streamAgent) Consume(
+	ctx context.Context,
+	streamName string,
+	groupName string,
+	consumer string,
+) (<-chan pubsub.Message, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.closed {
+		return nil, pubsub.ErrStreamAgentClosed
+	}
+
+	a.logger.Debug().Msgf("consume stream %s by consumer %s of group %s", streamName, consumer, groupName)
+
+	s := a.stream(streamName)
+	if _, ok := s.groups[groupName]; !ok {
+		s.groups[groupName] = &group{}
+	}
+
+	ch := make(chan pubsub.Message)
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		defer close(ch)
+
+		a.consume(ctx, streamName, groupName, consumer, ch)
+	}()
+
+	return ch, nil
+}
+
+func (a *streamAgent) Ack(_ context.Context, msg pubsub.Message) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.logger.Debug().Msgf("ack message %s of stream %s by group %s", msg.ID, msg.Stream, msg.Group)
+
+	g, ok := a.group(msg.Stream, msg.Group)
+	if !ok {
+		return nil
+	}
+
+	for i, p := range g.pending {
+		if p.id == msg.ID {
+			g.pending = append(g.pending[:i], g.pending[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (a *streamAgent) Nack(_ context.Context, msg pubsub.Message) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.logger.Debug().Msgf("nack message %s of stream %s by group %s", msg.ID, msg.Stream, msg.Group)
+
+	g, ok := a.group(msg.Stream, msg.Group)
+	if !ok {
+		return nil
+	}
+
+	for _, p := range g.pending {
+		if p.id == msg.ID {
+			// Message is expired, so it is delivered by the next check of pending messages
+			p.deliveredAt = time.Time{}
+			a.broadcast()
+			break
+		}
+	}
+
+	return nil
+}
+
+func (a *streamAgent) Close() error {
+	a.mu.Lock()
+	if a.closed {
+		a.mu.Unlock()
+		return nil
+	}
+	a.closed = true
+	a.cancel()
+	a.mu.Unlock()
+
+	a.wg.Wait()
+	a.logger.Debug().Msg("all stream consumers are stopped")
+
+	return nil
+}
+
+// consume delivers expired pending and new messages of group to consumer until context is canceled
+func (a *streamAgent) consume(
+	ctx context.Context,
+	streamName string,
+	groupName string,
+	consumer string,
+	ch chan<- pubsub.Message,
+) {
+	ticker := time.NewTicker(min(a.redeliveryTimeout, maxPollInterval))
+	defer ticker.Stop()
+
+	for {
+		msg, ok, notify := a.next(streamName, groupName, consumer)
+		if ok {
+			select {
+			case ch <- msg:
+				continue
+			case <-ctx.Done():
+				return
+			case <-a.ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-notify:
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		case <-a.ctx.Done():
+			return
+		}
+	}
+}
+
+// next returns message, which should be delivered to consumer, and channel, which is closed, when new message
+// is available. Expired pending messages are delivered before new ones
+func (a *streamAgent) next(streamName string, groupName string, consumer string) (pubsub.Message, bool, <-chan struct{}) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	s := a.streams[streamName]
+	g := s.groups[groupName]
+	now := time.Now()
+
+	for i := 0; i < len(g.pending); i++ {
+		p := g.pending[i]
+		if now.Sub(p.deliveredAt) < a.redeliveryTimeout {
+			continue
+		}
+
+		if p.deliveries >= a.maxDeliveries {
+			a.logger.Warn().Msgf("move message %s of stream %s to dead-letter stream", p.id, streamName)
+			a.append(pubsub.DeadLetterStream(streamName), p.payload)
+			a.broadcast()
+
+			g.pending = append(g.pending[:i], g.pending[i+1:]...)
+			i--
+			continue
+		}
+
+		a.logger.Debug().Msgf("redeliver message %s of stream %s to consumer %s", p.id, streamName, consumer)
+		p.consumer = consumer
+		p.deliveredAt = now
+		p.deliveries++
+
+		return newMessage(streamName, groupName, p), true, a.notify
+	}
+
+	if g.next < len(s.entries) {
+		p := &pendingEntry{
+			entry:       s.entries[g.next],
+			consumer:    consumer,
+			deliveredAt: now,
+			deliveries:  1,
+		}
+		g.pending = append(g.pending, p)
+		g.next++
+
+		return newMessage(streamName, groupName, p), true, a.notify
+	}
+
+	return pubsub.Message{}, false, a.notify
+}
+
+// append appends entry to stream. Caller must hold mutex
+func (a *streamAgent) append(streamName string, payload []byte) string {
+	s := a.stream(streamName)
+	s.seq++
+
+	id := fmt.Sprintf("%d-0", s.seq)
+	s.entries = append(s.entries, entry{id: id, payload: payload})
+
+	return id
+}
+
+// broadcast wakes up waiting consumers. Caller must hold mutex
+func (a *streamAgent) broadcast() {
+	close(a.notify)
+	a.notify = make(chan struct{})
+}
+
+// stream returns stream by name and creates it, if it does not exist. Caller must hold mutex
+func (a *streamAgent) stream(streamName string) *stream {
+	s, ok := a.streams[streamName]
+	if !ok {
+		s = &stream{groups: make(map[string]*group)}
+		a.streams[streamName] = s
+	}
+
+	return s
+}
+
+// group returns group of stream. Caller must hold mutex
+func (a *streamAgent) group(streamName string, groupName string) (*group, bool) {
+	s, ok := a.streams[streamName]
+	if !ok {
+		return nil, false
+	}
+
+	g, ok := s.groups[groupName]
+	return g, ok
+}
+
+func newMessage(streamName string, groupName string, p *pendingEntry) pubsub.Message {
+	return pubsub.Message{
+		ID:         p.id,
+		Stream:     streamName,
+		Group:      groupName,
+		Consumer:   p.consumer,
+		Payload:    p.payload,
+		Deliveries: p.deliveries,
+	}
+}
@@ -67,6 +67,112 @@ func (_c *AgentMock_Close_Call) RunAndReturn(run func() error) *AgentMock_Close_
 	return _c
 }
 
+// PSubscribe provides a mock function with given fields: ctx, pattern
+func (_m *AgentMock) PSubscribe(ctx context.Context, pattern string) (<-chan pubsub.Event, error) {
+	ret := _m.Called(ctx, pattern)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PSubscribe")
+	}
+
+	var r0 <-chan pubsub.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (<-chan pubsub.Event, error)); ok {
+		return rf(ctx, pattern)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) <-chan pubsub.Event); ok {
+		r0 = rf(ctx, pattern)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan pubsub.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, pattern)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AgentMock_PSubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PSubscribe'
+type AgentMock_PSubscribe_Call struct {
+	*mock.Call
+}
+
+// PSubscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pattern string
+func (_e *AgentMock_Expecter) PSubscribe(ctx interface{}, pattern interface{}) *AgentMock_PSubscribe_Call {
+	return &AgentMock_PSubscribe_Call{Call: _e.mock.On("PSubscribe", ctx, pattern)}
+}
+
+func (_c *AgentMock_PSubscribe_Call) Run(run func(ctx context.Context, pattern string)) *AgentMock_PSubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AgentMock_PSubscribe_Call) Return(_a0 <-chan pubsub.Event, _a1 error) *AgentMock_PSubscribe_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AgentMock_PSubscribe_Call) RunAndReturn(run func(context.Context, string) (<-chan pubsub.Event, error)) *AgentMock_PSubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PUnsubscribe provides a mock function with given fields: ctx, pattern
+func (_m *AgentMock) PUnsubscribe(ctx context.Context, pattern string) error {
+	ret := _m.Called(ctx, pattern)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PUnsubscribe")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, pattern)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AgentMock_PUnsubscribe_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PUnsubscribe'
+type AgentMock_PUnsubscribe_Call struct {
+	*mock.Call
+}
+
+// PUnsubscribe is a helper method to define mock.On call
+//   - ctx context.Context
+//   - pattern string
+func (_e *AgentMock_Expecter) PUnsubscribe(ctx interface{}, pattern interface{}) *AgentMock_PUnsubscribe_Call {
+	return &AgentMock_PUnsubscribe_Call{Call: _e.mock.On("PUnsubscribe", ctx, pattern)}
+}
+
+func (_c *AgentMock_PUnsubscribe_Call) Run(run func(ctx context.Context, pattern string)) *AgentMock_PUnsubscribe_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AgentMock_PUnsubscribe_Call) Return(_a0 error) *AgentMock_PUnsubscribe_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AgentMock_PUnsubscribe_Call) RunAndReturn(run func(context.Context, string) error) *AgentMock_PUnsubscribe_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Publish provides a mock function with given fields: ctx, topic, msg
 func (_m *AgentMock) Publish(ctx context.Context, topic string, msg any) error {
 	ret := _m.Called(ctx, topic, msg)
@@ -0,0 +1,137 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import mock "github.com/stretchr/testify/mock"
+
+// CodecMock is an autogenerated mock type for the Codec type
+type CodecMock struct {
+	mock.Mock
+}
+
+type CodecMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CodecMock) EXPECT() *CodecMock_Expecter {
+	return &CodecMock_Expecter{mock: &_m.Mock}
+}
+
+// Marshal provides a mock function with given fields: v
+func (_m *CodecMock) Marshal(v any) ([]byte, error) {
+	ret := _m.Called(v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Marshal")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(any) ([]byte, error)); ok {
+		return rf(v)
+	}
+	if rf, ok := ret.Get(0).(func(any) []byte); ok {
+		r0 = rf(v)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(any) error); ok {
+		r1 = rf(v)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CodecMock_Marshal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Marshal'
+type CodecMock_Marshal_Call struct {
+	*mock.Call
+}
+
+// Marshal is a helper method to define mock.On call
+//   - v any
+func (_e *CodecMock_Expecter) Marshal(v interface{}) *CodecMock_Marshal_Call {
+	return &CodecMock_Marshal_Call{Call: _e.mock.On("Marshal", v)}
+}
+
+func (_c *CodecMock_Marshal_Call) Run(run func(v any)) *CodecMock_Marshal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(any))
+	})
+	return _c
+}
+
+func (_c *CodecMock_Marshal_Call) Return(_a0 []byte, _a1 error) *CodecMock_Marshal_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CodecMock_Marshal_Call) RunAndReturn(run func(any) ([]byte, error)) *CodecMock_Marshal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Unmarshal provides a mock function with given fields: data, v
+func (_m *CodecMock) Unmarshal(data []byte, v any) error {
+	ret := _m.Called(data, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unmarshal")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte, any) error); ok {
+		r0 = rf(data, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CodecMock_Unmarshal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unmarshal'
+type CodecMock_Unmarshal_Call struct {
+	*mock.Call
+}
+
+// Unmarshal is a helper method to define mock.On call
+//   - data []byte
+//   - v any
+func (_e *CodecMock_Expecter) Unmarshal(data interface{}, v interface{}) *CodecMock_Unmarshal_Call {
+	return &CodecMock_Unmarshal_Call{Call: _e.mock.On("Unmarshal", data, v)}
+}
+
+func (_c *CodecMock_Unmarshal_Call) Run(run func(data []byte, v any)) *CodecMock_Unmarshal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]byte), args[1].(any))
+	})
+	return _c
+}
+
+func (_c *CodecMock_Unmarshal_Call) Return(_a0 error) *CodecMock_Unmarshal_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CodecMock_Unmarshal_Call) RunAndReturn(run func([]byte, any) error) *CodecMock_Unmarshal_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCodecMock creates a new instance of CodecMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCodecMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CodecMock {
+	mock := &CodecMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,295 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	pubsub "github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StreamAgentMock is an autogenerated mock type for the StreamAgent type
+type StreamAgentMock struct {
+	mock.Mock
+}
+
+type StreamAgentMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StreamAgentMock) EXPECT() *StreamAgentMock_Expecter {
+	return &StreamAgentMock_Expecter{mock: &_m.Mock}
+}
+
+// Ack provides a mock function with given fields: ctx, msg
+func (_m *StreamAgentMock) Ack(ctx context.Context, msg pubsub.Message) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ack")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pubsub.Message) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamAgentMock_Ack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ack'
+type StreamAgentMock_Ack_Call struct {
+	*mock.Call
+}
+
+// Ack is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg pubsub.Message
+func (_e *StreamAgentMock_Expecter) Ack(ctx interface{}, msg interface{}) *StreamAgentMock_Ack_Call {
+	return &StreamAgentMock_Ack_Call{Call: _e.mock.On("Ack", ctx, msg)}
+}
+
+func (_c *StreamAgentMock_Ack_Call) Run(run func(ctx context.Context, msg pubsub.Message)) *StreamAgentMock_Ack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pubsub.Message))
+	})
+	return _c
+}
+
+func (_c *StreamAgentMock_Ack_Call) Return(_a0 error) *StreamAgentMock_Ack_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StreamAgentMock_Ack_Call) RunAndReturn(run func(context.Context, pubsub.Message) error) *StreamAgentMock_Ack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Close provides a mock function with no fields
+func (_m *StreamAgentMock) Close() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamAgentMock_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type StreamAgentMock_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+func (_e *StreamAgentMock_Expecter) Close() *StreamAgentMock_Close_Call {
+	return &StreamAgentMock_Close_Call{Call: _e.mock.On("Close")}
+}
+
+func (_c *StreamAgentMock_Close_Call) Run(run func()) *StreamAgentMock_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *StreamAgentMock_Close_Call) Return(_a0 error) *StreamAgentMock_Close_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StreamAgentMock_Close_Call) RunAndReturn(run func() error) *StreamAgentMock_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Consume provides a mock function with given fields: ctx, stream, group, consumer
+func (_m *StreamAgentMock) Consume(ctx context.Context, stream string, group string, consumer string) (<-chan pubsub.Message, error) {
+	ret := _m.Called(ctx, stream, group, consumer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Consume")
+	}
+
+	var r0 <-chan pubsub.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (<-chan pubsub.Message, error)); ok {
+		return rf(ctx, stream, group, consumer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) <-chan pubsub.Message); ok {
+		r0 = rf(ctx, stream, group, consumer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan pubsub.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, stream, group, consumer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamAgentMock_Consume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Consume'
+type StreamAgentMock_Consume_Call struct {
+	*mock.Call
+}
+
+// Consume is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stream string
+//   - group string
+//   - consumer string
+func (_e *StreamAgentMock_Expecter) Consume(ctx interface{}, stream interface{}, group interface{}, consumer interface{}) *StreamAgentMock_Consume_Call {
+	return &StreamAgentMock_Consume_Call{Call: _e.mock.On("Consume", ctx, stream, group, consumer)}
+}
+
+func (_c *StreamAgentMock_Consume_Call) Run(run func(ctx context.Context, stream string, group string, consumer string)) *StreamAgentMock_Consume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *StreamAgentMock_Consume_Call) Return(_a0 <-chan pubsub.Message, _a1 error) *StreamAgentMock_Consume_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StreamAgentMock_Consume_Call) RunAndReturn(run func(context.Context, string, string, string) (<-chan pubsub.Message, error)) *StreamAgentMock_Consume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Nack provides a mock function with given fields: ctx, msg
+func (_m *StreamAgentMock) Nack(ctx context.Context, msg pubsub.Message) error {
+	ret := _m.Called(ctx, msg)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Nack")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, pubsub.Message) error); ok {
+		r0 = rf(ctx, msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StreamAgentMock_Nack_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Nack'
+type StreamAgentMock_Nack_Call struct {
+	*mock.Call
+}
+
+// Nack is a helper method to define mock.On call
+//   - ctx context.Context
+//   - msg pubsub.Message
+func (_e *StreamAgentMock_Expecter) Nack(ctx interface{}, msg interface{}) *StreamAgentMock_Nack_Call {
+	return &StreamAgentMock_Nack_Call{Call: _e.mock.On("Nack", ctx, msg)}
+}
+
+func (_c *StreamAgentMock_Nack_Call) Run(run func(ctx context.Context, msg pubsub.Message)) *StreamAgentMock_Nack_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(pubsub.Message))
+	})
+	return _c
+}
+
+func (_c *StreamAgentMock_Nack_Call) Return(_a0 error) *StreamAgentMock_Nack_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StreamAgentMock_Nack_Call) RunAndReturn(run func(context.Context, pubsub.Message) error) *StreamAgentMock_Nack_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Publish provides a mock function with given fields: ctx, stream, payload
+func (_m *StreamAgentMock) Publish(ctx context.Context, stream string, payload []byte) (string, error) {
+	ret := _m.Called(ctx, stream, payload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) (string, error)); ok {
+		return rf(ctx, stream, payload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) string); ok {
+		r0 = rf(ctx, stream, payload)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) error); ok {
+		r1 = rf(ctx, stream, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StreamAgentMock_Publish_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Publish'
+type StreamAgentMock_Publish_Call struct {
+	*mock.Call
+}
+
+// Publish is a helper method to define mock.On call
+//   - ctx context.Context
+//   - stream string
+//   - payload []byte
+func (_e *StreamAgentMock_Expecter) Publish(ctx interface{}, stream interface{}, payload interface{}) *StreamAgentMock_Publish_Call {
+	return &StreamAgentMock_Publish_Call{Call: _e.mock.On("Publish", ctx, stream, payload)}
+}
+
+func (_c *StreamAgentMock_Publish_Call) Run(run func(ctx context.Context, stream string, payload []byte)) *StreamAgentMock_Publish_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *StreamAgentMock_Publish_Call) Return(_a0 string, _a1 error) *StreamAgentMock_Publish_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StreamAgentMock_Publish_Call) RunAndReturn(run func(context.Context, string, []byte) (string, error)) *StreamAgentMock_Publish_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStreamAgentMock creates a new instance of StreamAgentMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStreamAgentMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StreamAgentMock {
+	mock := &StreamAgentMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,101 @@
+package pubsub
+
+// MatchPattern reports whether topic matches glob-style pattern with the same rules as Redis PSUBSCRIBE:
+// `*` matches any sequence, `?` matches any byte, `[abc]`, `[^abc]` and `[a-z]` match byte of set
+// and `\` escapes special character
+func MatchPattern(pattern string, topic string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 1 && pattern[1] == '*' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 1 {
+				return true
+			}
+
+			for i := 0; i <= len(topic); i++ {
+				if MatchPattern(pattern[1:], topic[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(topic) == 0 {
+				return false
+			}
+			topic = topic[1:]
+		case '[':
+			if len(topic) == 0 {
+				return false
+			}
+
+			var matched bool
+			matched, pattern = matchSet(pattern[1:], topic[0])
+			if !matched {
+				return false
+			}
+			topic = topic[1:]
+
+			// Pattern is positioned at closing bracket of set
+			if len(pattern) == 0 {
+				return len(topic) == 0
+			}
+		case '\\':
+			if len(pattern) >= 2 {
+				pattern = pattern[1:]
+			}
+			fallthrough
+		default:
+			if len(topic) == 0 || pattern[0] != topic[0] {
+				return false
+			}
+			topic = topic[1:]
+		}
+
+		pattern = pattern[1:]
+	}
+
+	return len(topic) == 0
+}
+
+// matchSet reports whether byte matches set, which starts after opening bracket, and returns pattern positioned
+// at closing bracket of set. Unterminated set lasts until end of pattern
+func matchSet(pattern string, c byte) (bool, string) {
+	negate := len(pattern) > 0 && pattern[0] == '^'
+	if negate {
+		pattern = pattern[1:]
+	}
+
+	matched := false
+	for len(pattern) > 0 && pattern[0] != ']' {
+		switch {
+		case pattern[0] == '\\' && len(pattern) >= 2:
+			pattern = pattern[1:]
+			if pattern[0] == c {
+				matched = true
+			}
+		case len(pattern) >= 3 && pattern[1] == '-':
+			start, end := pattern[0], pattern[2]
+			if start > end {
+				start, end = end, start
+			}
+			if c >= start && c <= end {
+				matched = true
+			}
+			pattern = pattern[2:]
+		default:
+			if pattern[0] == c {
+				matched = true
+			}
+		}
+
+		pattern = pattern[1:]
+	}
+
+	if negate {
+		matched = !matched
+	}
+
+	return matched, pattern
+}
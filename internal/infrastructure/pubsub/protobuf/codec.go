@@ -0,0 +1,38 @@
+package protobuf
+
+import (
+	"errors"
+	"fmt"
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrNotProtoMessage = errors.New("message is not protobuf message")
+)
+
+// codec encodes messages in protobuf wire format. Only messages generated by protoc are supported,
+// so it is intended for agents, which publish only protobuf messages
+type codec struct{}
+
+func NewCodec() pubsub.Codec {
+	return codec{}
+}
+
+func (codec) Marshal(v any) ([]byte, error) {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrNotProtoMessage, v)
+	}
+
+	return proto.Marshal(msg)
+}
+
+func (codec) Unmarshal(data []byte, v any) error {
+	msg, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrNotProtoMessage, v)
+	}
+
+	return proto.Unmarshal(data, msg)
+}
@@ -19,19 +19,35 @@ func WithLogger(logger zerolog.Logger) Option {
 	}
 }
 
+// WithCodec sets codec, by which messages are encoded. JSON codec is used by default
+func WithCodec(codec pubsub.Codec) Option {
+	return func(a *agent) error {
+		if codec == nil {
+			return fmt.Errorf("codec is nil")
+		}
+
+		a.codec = codec
+		return nil
+	}
+}
+
 type agent struct {
 	rdb    redis.UniversalClient
+	codec  pubsub.Codec
 	logger zerolog.Logger
 
-	mu   sync.Mutex
-	subs map[string][]*redis.PubSub
+	mu    sync.Mutex
+	subs  map[string][]*redis.PubSub
+	psubs map[string][]*redis.PubSub
 }
 
 func NewAgent(rdb redis.UniversalClient, opts ...Option) (pubsub.Agent, error) {
 	a := &agent{
 		rdb:    rdb,
+		codec:  pubsub.DefaultCodec,
 		logger: zerolog.Nop(),
 		subs:   make(map[string][]*redis.PubSub),
+		psubs:  make(map[string][]*redis.PubSub),
 	}
 
 	for _, opt := range opts {
@@ -45,7 +61,13 @@ func NewAgent(rdb redis.UniversalClient, opts ...Option) (pubsub.Agent, error) {
 
 func (a *agent) Publish(ctx context.Context, topic string, msg any) error {
 	a.logger.Debug().Msgf("publish message to topic: %s", topic)
-	return a.rdb.Publish(ctx, topic, msg).Err()
+
+	payload, err := a.codec.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+
+	return a.rdb.Publish(ctx, topic, payload).Err()
 }
 
 func (a *agent) Subscribe(ctx context.Context, topic string) (<-chan pubsub.Event, error) {
@@ -54,23 +76,8 @@ func (a *agent) Subscribe(ctx context.Context, topic string) (<-chan pubsub.Even
 
 	a.logger.Debug().Msgf("subscribe to topics: %s", topic)
 	p := a.rdb.Subscribe(ctx, topic)
-	a.subs[topic] = append(a.subs[topic], p)
-
-	eventChan := make(chan pubsub.Event, 1024)
-
-	go func() {
-		defer close(eventChan)
-		for msg := range p.Channel() {
-			event := pubsub.Event{
-				Topic:   msg.Channel,
-				Payload: msg.Payload,
-			}
-			eventChan <- event
-		}
-	}()
-
-	return eventChan, nil
 
+	return a.receive(ctx, p, a.subs, topic)
 }
 
 func (a *agent) Unsubscribe(ctx context.Context, topic string) error {
@@ -84,21 +91,41 @@ func (a *agent) Unsubscribe(ctx context.Context, topic string) error {
 
 	errs := make([]error, 0)
 	for _, p := range a.subs[topic] {
-		err := p.Unsubscribe(ctx, topic)
-		if err != nil {
-			errs = append(errs, err)
-		}
-
-		err = p.Close()
-		if err != nil {
-			errs = append(errs, err)
-		}
+		errs = append(errs, closeSubscription(p.Unsubscribe(ctx, topic), p))
 	}
 	delete(a.subs, topic)
 
 	return errors.Join(errs...)
 }
 
+func (a *agent) PSubscribe(ctx context.Context, pattern string) (<-chan pubsub.Event, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.logger.Debug().Msgf("subscribe to pattern: %s", pattern)
+	p := a.rdb.PSubscribe(ctx, pattern)
+
+	return a.receive(ctx, p, a.psubs, pattern)
+}
+
+func (a *agent) PUnsubscribe(ctx context.Context, pattern string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.logger.Debug().Msgf("unsubscribe from pattern: %s", pattern)
+	if _, ok := a.psubs[pattern]; !ok {
+		return pubsub.ErrTopicNotFound
+	}
+
+	errs := make([]error, 0)
+	for _, p := range a.psubs[pattern] {
+		errs = append(errs, closeSubscription(p.PUnsubscribe(ctx, pattern), p))
+	}
+	delete(a.psubs, pattern)
+
+	return errors.Join(errs...)
+}
+
 func (a *agent) Close() error {
 	a.mu.Lock()
 	defer a.mu.Unlock()
@@ -106,18 +133,46 @@ func (a *agent) Close() error {
 	errs := make([]error, 0)
 	for topic, sub := range a.subs {
 		for _, p := range sub {
-			err := p.Unsubscribe(context.Background(), topic)
-			if err != nil {
-				errs = append(errs, err)
-			}
-
-			err = p.Close()
-			if err != nil {
-				errs = append(errs, err)
-			}
+			errs = append(errs, closeSubscription(p.Unsubscribe(context.Background(), topic), p))
 		}
 		delete(a.subs, topic)
 	}
+	for pattern, sub := range a.psubs {
+		for _, p := range sub {
+			errs = append(errs, closeSubscription(p.PUnsubscribe(context.Background(), pattern), p))
+		}
+		delete(a.psubs, pattern)
+	}
 
 	return errors.Join(errs...)
 }
+
+// receive waits for confirmation of subscription, so messages published after return are not lost,
+// and delivers messages of subscription as events. Caller must hold mutex
+func (a *agent) receive(
+	ctx context.Context,
+	p *redis.PubSub,
+	subs map[string][]*redis.PubSub,
+	key string,
+) (<-chan pubsub.Event, error) {
+	if _, err := p.Receive(ctx); err != nil {
+		_ = p.Close()
+		return nil, fmt.Errorf("failed to subscribe to %s: %w", key, err)
+	}
+	subs[key] = append(subs[key], p)
+
+	eventChan := make(chan pubsub.Event, 1024)
+
+	go func() {
+		defer close(eventChan)
+		for msg := range p.Channel() {
+			eventChan <- pubsub.NewEvent(msg.Channel, msg.Pattern, []byte(msg.Payload), a.codec)
+		}
+	}()
+
+	return eventChan, nil
+}
+
+func closeSubscription(unsubscribeErr error, p *redis.PubSub) error {
+	return errors.Join(unsubscribeErr, p.Close())
+}
@@ -0,0 +1,349 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	payloadField = "payload"
+	batchSize    = 64
+	maxBlockTime = time.Second
+)
+
+type StreamOption func(*streamAgent) error
+
+func WithStreamLogger(logger zerolog.Logger) StreamOption {
+	return func(a *streamAgent) error {
+		a.logger = logger
+		return nil
+	}
+}
+
+// WithRedeliveryTimeout sets time, after which unacknowledged message is delivered again
+func WithRedeliveryTimeout(timeout time.Duration) StreamOption {
+	return func(a *streamAgent) error {
+		if timeout <= 0 {
+			return fmt.Errorf("redelivery timeout must be positive")
+		}
+
+		a.redeliveryTimeout = timeout
+		return nil
+	}
+}
+
+// WithMaxDeliveries sets number of deliveries, after which unacknowledged message is moved to dead-letter stream
+func WithMaxDeliveries(n int) StreamOption {
+	return func(a *streamAgent) error {
+		if n <= 0 {
+			return fmt.Errorf("max deliveries must be positive")
+		}
+
+		a.maxDeliveries = n
+		return nil
+	}
+}
+
+// streamAgent is stream agent on Redis Streams. Group of agent is consumer group of stream, pending entries list
+// of group contains delivered, but not acknowledged messages
+type streamAgent struct {
+	rdb               redis.UniversalClient
+	logger            zerolog.Logger
+	redeliveryTimeout time.Duration
+	maxDeliveries     int
+
+	mu     sync.Mutex
+	closed bool
+	cancel context.CancelFunc
+	ctx    context.Context
+	wg     sync.WaitGroup
+}
+
+func NewStreamAgent(rdb redis.UniversalClient, opts ...StreamOption) (pubsub.StreamAgent, error) {
+	a := &streamAgent{
+		rdb:               rdb,
+		logger:            zerolog.Nop(),
+		redeliveryTimeout: pubsub.DefaultRedeliveryTimeout,
+		maxDeliveries:     pubsub.DefaultMaxDeliveries,
+	}
+
+	for _, opt := range opts {
+		if err := opt(a); err != nil {
+			return nil, fmt.Errorf("failed to apply option: %w", err)
+		}
+	}
+
+	a.ctx, a.cancel = context.WithCancel(context.Background())
+
+	return a, nil
+}
+
+func (a *streamAgent) Publish(ctx context.Context, stream string, payload []byte) (string, error) {
+	a.logger.Debug().Msgf("publish message to stream: %s", stream)
+
+	return a.rdb.XAdd(
+		ctx, &redis.XAddArgs{
+			Stream: stream,
+			Values: map[string]any{payloadField: payload},
+		},
+	).Result()
+}
+
+func (a *streamAgent) Consume(
+	ctx context.Context,
+	stream string,
+	group string,
+	consumer string,
+) (<-chan pubsub.Message, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.closed {
+		return nil, pubsub.ErrStreamAgentClosed
+	}
+
+	a.logger.Debug().Msgf("consume stream %s by consumer %s of group %s", stream, consumer, group)
+
+	// Group reads stream from the beginning, so messages published before its creation are not lost
+	err := a.rdb.XGroupCreateMkStream(ctx, stream, group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return nil, fmt.Errorf("failed to create group %s of stream %s: %w", group, stream, err)
+	}
+
+	consumeCtx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-a.ctx.Done():
+			cancel()
+		case <-consumeCtx.Done():
+		}
+	}()
+
+	ch := make(chan pubsub.Message)
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		defer cancel()
+		defer close(ch)
+
+		a.consume(consumeCtx, stream, group, consumer, ch)
+	}()
+
+	return ch, nil
+}
+
+func (a *streamAgent) Ack(ctx context.Context, msg pubsub.Message) error {
+	a.logger.Debug().Msgf("ack message %s of stream %s by group %s", msg.ID, msg.Stream, msg.Group)
+	return a.rdb.XAck(ctx, msg.Stream, msg.Group, msg.ID).Err()
+}
+
+func (a *streamAgent) Nack(ctx context.Context, msg pubsub.Message) error {
+	a.logger.Debug().Msgf("nack message %s of stream %s by group %s", msg.ID, msg.Stream, msg.Group)
+
+	// Idle time of message is set to redelivery timeout, so it is claimed by the next check of pending messages.
+	// JUSTID does not increment delivery counter, it is incremented, when message is claimed
+	return a.rdb.Do(
+		ctx,
+		"XCLAIM", msg.Stream, msg.Group, msg.Consumer, 0, msg.ID,
+		"IDLE", a.redeliveryTimeout.Milliseconds(),
+		"JUSTID",
+	).Err()
+}
+
+func (a *streamAgent) Close() error {
+	a.mu.Lock()
+	if a.closed {
+		a.mu.Unlock()
+		return nil
+	}
+	a.closed = true
+	a.cancel()
+	a.mu.Unlock()
+
+	a.wg.Wait()
+	a.logger.Debug().Msg("all stream consumers are stopped")
+
+	return nil
+}
+
+// consume delivers expired pending and new messages of group to consumer until context is canceled
+func (a *streamAgent) consume(
+	ctx context.Context,
+	stream string,
+	group string,
+	consumer string,
+	ch chan<- pubsub.Message,
+) {
+	block := min(a.redeliveryTimeout, maxBlockTime)
+
+	for ctx.Err() == nil {
+		if err := a.redeliver(ctx, stream, group, consumer, ch); err != nil && ctx.Err() == nil {
+			a.logger.Error().Stack().Err(err).Msgf("failed to redeliver messages of stream %s", stream)
+		}
+
+		streams, err := a.rdb.XReadGroup(
+			ctx, &redis.XReadGroupArgs{
+				Group:    group,
+				Consumer: consumer,
+				Streams:  []string{stream, ">"},
+				Count:    batchSize,
+				Block:    block,
+			},
+		).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			a.logger.Error().Stack().Err(err).Msgf("failed to read stream %s", stream)
+			sleep(ctx, block)
+			continue
+		}
+
+		for _, s := range streams {
+			for _, xmsg := range s.Messages {
+				if !deliver(ctx, ch, newMessage(stream, group, consumer, xmsg, 1)) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// redeliver claims messages of group, which are not acknowledged during redelivery timeout, and delivers them
+// to consumer. Messages, which are delivered max deliveries times, are moved to dead-letter stream
+func (a *streamAgent) redeliver(
+	ctx context.Context,
+	stream string,
+	group string,
+	consumer string,
+	ch chan<- pubsub.Message,
+) error {
+	pending, err := a.rdb.XPendingExt(
+		ctx, &redis.XPendingExtArgs{
+			Stream: stream,
+			Group:  group,
+			Idle:   a.redeliveryTimeout,
+			Start:  "-",
+			End:    "+",
+			Count:  batchSize,
+		},
+	).Result()
+	if err != nil {
+		return fmt.Errorf("failed to read pending messages: %w", err)
+	}
+
+	for _, p := range pending {
+		// Claim resets idle time of message, so other consumers skip it
+		claimArgs := &redis.XClaimArgs{
+			Stream:   stream,
+			Group:    group,
+			Consumer: consumer,
+			MinIdle:  a.redeliveryTimeout,
+			Messages: []string{p.ID},
+		}
+
+		if p.RetryCount >= int64(a.maxDeliveries) {
+			ids, err := a.rdb.XClaimJustID(ctx, claimArgs).Result()
+			if err != nil {
+				return fmt.Errorf("failed to claim message %s: %w", p.ID, err)
+			}
+			if len(ids) == 0 {
+				continue
+			}
+
+			if err := a.deadLetter(ctx, stream, group, p.ID); err != nil {
+				return err
+			}
+			continue
+		}
+
+		xmsgs, err := a.rdb.XClaim(ctx, claimArgs).Result()
+		if err != nil {
+			return fmt.Errorf("failed to claim message %s: %w", p.ID, err)
+		}
+
+		for _, xmsg := range xmsgs {
+			a.logger.Debug().Msgf("redeliver message %s of stream %s to consumer %s", xmsg.ID, stream, consumer)
+			if !deliver(ctx, ch, newMessage(stream, group, consumer, xmsg, int(p.RetryCount)+1)) {
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+// deadLetter moves message to dead-letter stream and acknowledges it
+func (a *streamAgent) deadLetter(ctx context.Context, stream string, group string, id string) error {
+	a.logger.Warn().Msgf("move message %s of stream %s to dead-letter stream", id, stream)
+
+	xmsgs, err := a.rdb.XRange(ctx, stream, id, id).Result()
+	if err != nil {
+		return fmt.Errorf("failed to read message %s: %w", id, err)
+	}
+
+	_, err = a.rdb.TxPipelined(
+		ctx, func(pipe redis.Pipeliner) error {
+			// Message may be trimmed from stream, then it is only acknowledged
+			if len(xmsgs) > 0 {
+				pipe.XAdd(
+					ctx, &redis.XAddArgs{
+						Stream: pubsub.DeadLetterStream(stream),
+						Values: map[string]any{payloadField: xmsgs[0].Values[payloadField]},
+					},
+				)
+			}
+			pipe.XAck(ctx, stream, group, id)
+			return nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to move message %s to dead-letter stream: %w", id, err)
+	}
+
+	return nil
+}
+
+func newMessage(stream string, group string, consumer string, xmsg redis.XMessage, deliveries int) pubsub.Message {
+	payload, _ := xmsg.Values[payloadField].(string)
+
+	return pubsub.Message{
+		ID:         xmsg.ID,
+		Stream:     stream,
+		Group:      group,
+		Consumer:   consumer,
+		Payload:    []byte(payload),
+		Deliveries: deliveries,
+	}
+}
+
+func deliver(ctx context.Context, ch chan<- pubsub.Message, msg pubsub.Message) bool {
+	select {
+	case ch <- msg:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+}
@@ -0,0 +1,51 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	DefaultRedeliveryTimeout = 30 * time.Second
+	DefaultMaxDeliveries     = 5
+
+	deadLetterSuffix = ":dead-letter"
+)
+
+var (
+	ErrStreamAgentClosed = errors.New("stream agent closed")
+)
+
+// Message is message of stream delivered to consumer of group. Deliveries is number of deliveries of message
+// including current one
+type Message struct {
+	ID         string
+	Stream     string
+	Group      string
+	Consumer   string
+	Payload    []byte
+	Deliveries int
+}
+
+// StreamAgent publishes messages to durable streams and delivers them to consumer groups at least once.
+// Each message of stream is delivered to one consumer of every group. Message, which is not acknowledged
+// during redelivery timeout or is negatively acknowledged, is delivered again to any consumer of group.
+// Message, which is delivered max deliveries times without acknowledgement, is moved to dead-letter stream
+type StreamAgent interface {
+	// Publish appends message to stream and returns its identifier
+	Publish(ctx context.Context, stream string, payload []byte) (string, error)
+	// Consume creates group, if it does not exist, and returns channel of messages delivered to consumer.
+	// Channel is closed, when context is canceled or agent is closed
+	Consume(ctx context.Context, stream string, group string, consumer string) (<-chan Message, error)
+	// Ack acknowledges that message is processed, so it is not delivered again
+	Ack(ctx context.Context, msg Message) error
+	// Nack returns message to group, so it is delivered again without waiting for redelivery timeout
+	Nack(ctx context.Context, msg Message) error
+	Close() error
+}
+
+// DeadLetterStream returns name of stream, to which messages of stream are moved after max deliveries
+func DeadLetterStream(stream string) string {
+	return stream + deadLetterSuffix
+}
@@ -0,0 +1,85 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+)
+
+const (
+	TokenBucketAlgorithm   = "token_bucket"
+	SlidingWindowAlgorithm = "sliding_window"
+)
+
+var (
+	ErrUnknownAlgorithm = errors.New("unknown rate limit algorithm")
+)
+
+// Limit allows Rate requests per Period
+type Limit struct {
+	Rate   int
+	Period time.Duration
+}
+
+// Result describes limiter decision, Reset is time until quota is fully restored
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// NewSlidingWindowResult calculates result of sliding window counter algorithm.
+// Requests of previous window are weighted by part of it, which is still covered by sliding window
+func NewSlidingWindowResult(limit Limit, allowed bool, prev int64, cur int64, elapsed time.Duration) Result {
+	period := float64(limit.Period)
+	rate := float64(limit.Rate)
+	count := float64(prev)*(period-float64(elapsed))/period + float64(cur)
+
+	res := Result{
+		Allowed:   allowed,
+		Limit:     limit.Rate,
+		Remaining: max(0, int(rate-math.Ceil(count))),
+		Reset:     limit.Period - elapsed,
+	}
+	if allowed {
+		return res
+	}
+
+	// Wait until weighted count leaves room for one more request
+	var retryAfter float64
+	available := rate - 1
+	switch {
+	case float64(cur) > available:
+		retryAfter = period - float64(elapsed) + period*(1-available/float64(cur))
+	case prev > 0:
+		retryAfter = period*(1-(available-float64(cur))/float64(prev)) - float64(elapsed)
+	}
+	res.RetryAfter = time.Duration(math.Max(math.Ceil(retryAfter), 0))
+
+	return res
+}
+
+// NewTokenBucketResult calculates result of token bucket algorithm.
+// Bucket holds Rate tokens and is fully refilled within Period
+func NewTokenBucketResult(limit Limit, allowed bool, tokens float64) Result {
+	refillRate := float64(limit.Rate) / float64(limit.Period)
+
+	res := Result{
+		Allowed:   allowed,
+		Limit:     limit.Rate,
+		Remaining: int(math.Floor(tokens)),
+		Reset:     time.Duration(math.Ceil((float64(limit.Rate) - tokens) / refillRate)),
+	}
+	if !allowed {
+		res.RetryAfter = time.Duration(math.Ceil((1 - tokens) / refillRate))
+	}
+
+	return res
+}
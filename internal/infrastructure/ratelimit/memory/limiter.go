@@ -0,0 +1,144 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"github.com/mandarine-io/backend/internal/infrastructure/ratelimit"
+	"github.com/rs/zerolog"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	cleanInterval = time.Minute
+)
+
+type Option func(*limiter) error
+
+func WithAlgorithm(algorithm string) Option {
+	return func(l *limiter) error {
+		switch algorithm {
+		case ratelimit.TokenBucketAlgorithm, ratelimit.SlidingWindowAlgorithm:
+			l.algorithm = algorithm
+			return nil
+		default:
+			return ratelimit.ErrUnknownAlgorithm
+		}
+	}
+}
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(l *limiter) error {
+		l.logger = logger
+		return nil
+	}
+}
+
+type entry struct {
+	// Sliding window state
+	window int64
+	prev   int64
+	cur    int64
+
+	// Token bucket state
+	tokens float64
+	ts     time.Time
+
+	expiration time.Time
+}
+
+type limiter struct {
+	lock      sync.Mutex
+	storage   map[string]*entry
+	algorithm string
+	lastClean time.Time
+	logger    zerolog.Logger
+}
+
+func NewLimiter(opts ...Option) (ratelimit.Limiter, error) {
+	l := &limiter{
+		storage:   make(map[string]*entry),
+		algorithm: ratelimit.SlidingWindowAlgorithm,
+		logger:    zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		if err := opt(l); err != nil {
+			return nil, fmt.Errorf("failed to apply option: %w", err)
+		}
+	}
+
+	return l, nil
+}
+
+func (l *limiter) Allow(_ context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	l.logger.Debug().Msgf("check rate limit: %s", key)
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	l.cleanExpiredEntries(now)
+
+	e, ok := l.storage[key]
+	if !ok || now.After(e.expiration) {
+		e = &entry{tokens: float64(limit.Rate), ts: now}
+		l.storage[key] = e
+	}
+
+	if l.algorithm == ratelimit.TokenBucketAlgorithm {
+		return l.allowTokenBucket(e, limit, now), nil
+	}
+	return l.allowSlidingWindow(e, limit, now), nil
+}
+
+func (l *limiter) allowSlidingWindow(e *entry, limit ratelimit.Limit, now time.Time) ratelimit.Result {
+	window := now.UnixNano() / int64(limit.Period)
+	elapsed := time.Duration(now.UnixNano() % int64(limit.Period))
+
+	// Shift windows
+	switch {
+	case window == e.window+1:
+		e.prev, e.cur = e.cur, 0
+	case window != e.window:
+		e.prev, e.cur = 0, 0
+	}
+	e.window = window
+
+	weightedPrev := float64(e.prev) * float64(limit.Period-elapsed) / float64(limit.Period)
+	allowed := weightedPrev+float64(e.cur)+1 <= float64(limit.Rate)
+	if allowed {
+		e.cur++
+		e.expiration = now.Add(2 * limit.Period)
+	}
+
+	return ratelimit.NewSlidingWindowResult(limit, allowed, e.prev, e.cur, elapsed)
+}
+
+func (l *limiter) allowTokenBucket(e *entry, limit ratelimit.Limit, now time.Time) ratelimit.Result {
+	refilled := float64(now.Sub(e.ts)) * float64(limit.Rate) / float64(limit.Period)
+	e.tokens = math.Min(float64(limit.Rate), e.tokens+math.Max(0, refilled))
+	e.ts = now
+	e.expiration = now.Add(limit.Period)
+
+	allowed := e.tokens >= 1
+	if allowed {
+		e.tokens--
+	}
+
+	return ratelimit.NewTokenBucketResult(limit, allowed, e.tokens)
+}
+
+func (l *limiter) cleanExpiredEntries(now time.Time) {
+	if now.Sub(l.lastClean) < cleanInterval {
+		return
+	}
+	l.lastClean = now
+
+	for key, e := range l.storage {
+		if now.After(e.expiration) {
+			delete(l.storage, key)
+		}
+	}
+}
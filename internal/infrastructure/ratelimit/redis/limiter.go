@@ -0,0 +1,150 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"github.com/mandarine-io/backend/internal/infrastructure/ratelimit"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+	"strconv"
+	"time"
+)
+
+// slidingWindowScript counts requests of current and previous fixed windows.
+// KEYS[1] is current window, KEYS[2] is previous window.
+// ARGV[1] is limit, ARGV[2] is period in ms, ARGV[3] is elapsed time of current window in ms
+var slidingWindowScript = redis.NewScript(
+	`
+local limit = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local elapsed = tonumber(ARGV[3])
+local prev = tonumber(redis.call('GET', KEYS[2]) or '0')
+local cur = tonumber(redis.call('GET', KEYS[1]) or '0')
+if prev * (period - elapsed) / period + cur + 1 > limit then
+	return {0, prev, cur}
+end
+cur = redis.call('INCR', KEYS[1])
+redis.call('PEXPIRE', KEYS[1], period * 2)
+return {1, prev, cur}
+`,
+)
+
+// tokenBucketScript refills bucket according to elapsed time and takes one token.
+// KEYS[1] is bucket. ARGV[1] is capacity, ARGV[2] is period of full refill in ms, ARGV[3] is current time in ms
+var tokenBucketScript = redis.NewScript(
+	`
+local capacity = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1]) or capacity
+local ts = tonumber(bucket[2]) or now
+tokens = math.min(capacity, tokens + math.max(0, now - ts) * capacity / period)
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], period)
+return {allowed, tostring(tokens)}
+`,
+)
+
+type Option func(*limiter) error
+
+func WithAlgorithm(algorithm string) Option {
+	return func(l *limiter) error {
+		switch algorithm {
+		case ratelimit.TokenBucketAlgorithm, ratelimit.SlidingWindowAlgorithm:
+			l.algorithm = algorithm
+			return nil
+		default:
+			return ratelimit.ErrUnknownAlgorithm
+		}
+	}
+}
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(l *limiter) error {
+		l.logger = logger
+		return nil
+	}
+}
+
+type limiter struct {
+	client    redis.UniversalClient
+	algorithm string
+	logger    zerolog.Logger
+}
+
+func NewLimiter(client redis.UniversalClient, opts ...Option) (ratelimit.Limiter, error) {
+	l := &limiter{
+		client:    client,
+		algorithm: ratelimit.SlidingWindowAlgorithm,
+		logger:    zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		if err := opt(l); err != nil {
+			return nil, fmt.Errorf("failed to apply option: %w", err)
+		}
+	}
+
+	return l, nil
+}
+
+func (l *limiter) Allow(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	l.logger.Debug().Msgf("check rate limit: %s", key)
+
+	if l.algorithm == ratelimit.TokenBucketAlgorithm {
+		return l.allowTokenBucket(ctx, key, limit)
+	}
+	return l.allowSlidingWindow(ctx, key, limit)
+}
+
+func (l *limiter) allowSlidingWindow(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	now := time.Now()
+	window := now.UnixMilli() / limit.Period.Milliseconds()
+	elapsed := time.Duration(now.UnixMilli()%limit.Period.Milliseconds()) * time.Millisecond
+
+	res, err := slidingWindowScript.Run(
+		ctx,
+		l.client,
+		[]string{key + "." + strconv.FormatInt(window, 10), key + "." + strconv.FormatInt(window-1, 10)},
+		limit.Rate,
+		limit.Period.Milliseconds(),
+		elapsed.Milliseconds(),
+	).Int64Slice()
+	if err != nil {
+		return ratelimit.Result{}, err
+	}
+
+	return ratelimit.NewSlidingWindowResult(limit, res[0] == 1, res[1], res[2], elapsed), nil
+}
+
+func (l *limiter) allowTokenBucket(ctx context.Context, key string, limit ratelimit.Limit) (ratelimit.Result, error) {
+	res, err := tokenBucketScript.Run(
+		ctx,
+		l.client,
+		[]string{key},
+		limit.Rate,
+		limit.Period.Milliseconds(),
+		time.Now().UnixMilli(),
+	).Slice()
+	if err != nil {
+		return ratelimit.Result{}, err
+	}
+	if len(res) != 2 {
+		return ratelimit.Result{}, fmt.Errorf("unexpected token bucket script result: %v", res)
+	}
+
+	allowed, _ := res[0].(int64)
+	tokensStr, _ := res[1].(string)
+	tokens, err := strconv.ParseFloat(tokensStr, 64)
+	if err != nil {
+		return ratelimit.Result{}, err
+	}
+
+	return ratelimit.NewTokenBucketResult(limit, allowed == 1, tokens), nil
+}
@@ -0,0 +1,32 @@
+package replay
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	DefaultSize = 100
+	DefaultTTL  = 5 * time.Minute
+)
+
+var (
+	ErrInvalidEventID = errors.New("invalid event id")
+)
+
+// Event is event addressed to client. Topic is empty for events, which are not bound to topic
+type Event struct {
+	ID      string
+	Topic   string
+	Payload []byte
+}
+
+// Buffer keeps the last events of each client, so client can resume stream after reconnect from the last received
+// event. Buffer keeps at most size events of client and forgets them after TTL since the last appended event
+type Buffer interface {
+	// Append stores event of client and returns its identifier. Identifiers of client grow monotonically
+	Append(ctx context.Context, clientID string, topic string, payload []byte) (string, error)
+	// Since returns stored events of client, which are appended after event with lastID, from oldest to newest
+	Since(ctx context.Context, clientID string, lastID string) ([]Event, error)
+}
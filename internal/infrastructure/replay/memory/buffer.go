@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"github.com/mandarine-io/backend/internal/infrastructure/replay"
+	"github.com/rs/zerolog"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type Option func(*buffer) error
+
+func WithSize(size int) Option {
+	return func(b *buffer) error {
+		if size <= 0 {
+			return fmt.Errorf("invalid replay buffer size: %d", size)
+		}
+
+		b.size = size
+		return nil
+	}
+}
+
+func WithTTL(ttl time.Duration) Option {
+	return func(b *buffer) error {
+		if ttl <= 0 {
+			return fmt.Errorf("invalid replay buffer ttl: %s", ttl)
+		}
+
+		b.ttl = ttl
+		return nil
+	}
+}
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(b *buffer) error {
+		b.logger = logger
+		return nil
+	}
+}
+
+type clientEvents struct {
+	seq       uint64
+	events    []replay.Event
+	expiresAt time.Time
+}
+
+// buffer keeps events of client in memory, identifier of event is sequence number of client
+type buffer struct {
+	mu      sync.Mutex
+	clients map[string]*clientEvents
+	size    int
+	ttl     time.Duration
+	logger  zerolog.Logger
+}
+
+func NewBuffer(opts ...Option) (replay.Buffer, error) {
+	b := &buffer{
+		clients: make(map[string]*clientEvents),
+		size:    replay.DefaultSize,
+		ttl:     replay.DefaultTTL,
+		logger:  zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		if err := opt(b); err != nil {
+			return nil, fmt.Errorf("failed to apply option: %w", err)
+		}
+	}
+
+	return b, nil
+}
+
+func (b *buffer) Append(_ context.Context, clientID string, topic string, payload []byte) (string, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.logger.Debug().Msgf("append event of client %s", clientID)
+
+	now := time.Now()
+	c, ok := b.clients[clientID]
+	if !ok || c.expiresAt.Before(now) {
+		// Sequence continues after expiration, so stale identifier of client never matches new event
+		seq := uint64(0)
+		if ok {
+			seq = c.seq
+		}
+		c = &clientEvents{seq: seq}
+		b.clients[clientID] = c
+	}
+
+	c.seq++
+	id := strconv.FormatUint(c.seq, 10)
+	c.events = append(c.events, replay.Event{ID: id, Topic: topic, Payload: payload})
+	if len(c.events) > b.size {
+		c.events = c.events[len(c.events)-b.size:]
+	}
+	c.expiresAt = now.Add(b.ttl)
+
+	return id, nil
+}
+
+func (b *buffer) Since(_ context.Context, clientID string, lastID string) ([]replay.Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.logger.Debug().Msgf("get events of client %s since %s", clientID, lastID)
+
+	seq, err := strconv.ParseUint(lastID, 10, 64)
+	if err != nil {
+		return nil, replay.ErrInvalidEventID
+	}
+
+	events := make([]replay.Event, 0)
+	c, ok := b.clients[clientID]
+	if !ok || c.expiresAt.Before(time.Now()) {
+		return events, nil
+	}
+
+	for _, event := range c.events {
+		// Identifiers are sequence numbers, so they are compared as numbers
+		eventSeq, _ := strconv.ParseUint(event.ID, 10, 64)
+		if eventSeq > seq {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
@@ -0,0 +1,131 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"github.com/mandarine-io/backend/internal/infrastructure/replay"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
+	"regexp"
+	"time"
+)
+
+const (
+	keyPrefix = "replay"
+
+	topicField   = "topic"
+	payloadField = "payload"
+)
+
+var (
+	// streamIDRegexp matches identifier of Redis stream entry
+	streamIDRegexp = regexp.MustCompile(`^\d+-\d+$`)
+)
+
+type Option func(*buffer) error
+
+func WithSize(size int) Option {
+	return func(b *buffer) error {
+		if size <= 0 {
+			return fmt.Errorf("invalid replay buffer size: %d", size)
+		}
+
+		b.size = int64(size)
+		return nil
+	}
+}
+
+func WithTTL(ttl time.Duration) Option {
+	return func(b *buffer) error {
+		if ttl <= 0 {
+			return fmt.Errorf("invalid replay buffer ttl: %s", ttl)
+		}
+
+		b.ttl = ttl
+		return nil
+	}
+}
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(b *buffer) error {
+		b.logger = logger
+		return nil
+	}
+}
+
+// buffer keeps events of client in capped Redis stream, identifier of event is identifier of stream entry
+type buffer struct {
+	client redis.UniversalClient
+	size   int64
+	ttl    time.Duration
+	logger zerolog.Logger
+}
+
+func NewBuffer(client redis.UniversalClient, opts ...Option) (replay.Buffer, error) {
+	b := &buffer{
+		client: client,
+		size:   replay.DefaultSize,
+		ttl:    replay.DefaultTTL,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		if err := opt(b); err != nil {
+			return nil, fmt.Errorf("failed to apply option: %w", err)
+		}
+	}
+
+	return b, nil
+}
+
+func (b *buffer) Append(ctx context.Context, clientID string, topic string, payload []byte) (string, error) {
+	b.logger.Debug().Msgf("append event of client %s", clientID)
+
+	key := b.key(clientID)
+	var add *redis.StringCmd
+	_, err := b.client.TxPipelined(
+		ctx, func(pipe redis.Pipeliner) error {
+			add = pipe.XAdd(
+				ctx, &redis.XAddArgs{
+					Stream: key,
+					MaxLen: b.size,
+					Values: map[string]any{topicField: topic, payloadField: payload},
+				},
+			)
+			pipe.PExpire(ctx, key, b.ttl)
+			return nil
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return add.Val(), nil
+}
+
+func (b *buffer) Since(ctx context.Context, clientID string, lastID string) ([]replay.Event, error) {
+	b.logger.Debug().Msgf("get events of client %s since %s", clientID, lastID)
+
+	if !streamIDRegexp.MatchString(lastID) {
+		return nil, replay.ErrInvalidEventID
+	}
+
+	// Exclusive range starts right after the last received event
+	entries, err := b.client.XRange(ctx, b.key(clientID), "("+lastID, "+").Result()
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]replay.Event, len(entries))
+	for i, entry := range entries {
+		topic, _ := entry.Values[topicField].(string)
+		payload, _ := entry.Values[payloadField].(string)
+		events[i] = replay.Event{ID: entry.ID, Topic: topic, Payload: []byte(payload)}
+	}
+
+	return events, nil
+}
+
+func (b *buffer) key(clientID string) string {
+	return keyPrefix + ":" + clientID
+}
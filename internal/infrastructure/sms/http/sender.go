@@ -0,0 +1,115 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/goccy/go-json"
+	"github.com/mandarine-io/backend/internal/infrastructure/sms"
+	"github.com/rs/zerolog"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	messagesPath      = "/messages"
+	maxErrorBodyBytes = 1024
+)
+
+var (
+	ErrEmptyBaseURL = errors.New("base url is empty")
+)
+
+type Config struct {
+	BaseURL string
+	APIKey  string
+	From    string
+	Timeout time.Duration
+}
+
+type Option func(s *sender) error
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(s *sender) error {
+		s.logger = logger
+		return nil
+	}
+}
+
+func WithHTTPClient(client *http.Client) Option {
+	return func(s *sender) error {
+		s.client = client
+		return nil
+	}
+}
+
+type sender struct {
+	cfg    Config
+	client *http.Client
+	logger zerolog.Logger
+}
+
+type sendMessageRequest struct {
+	From string `json:"from,omitempty"`
+	To   string `json:"to"`
+	Text string `json:"text"`
+}
+
+// NewSender creates sender, which sends messages with POST request to <base url>/messages
+func NewSender(cfg Config, opts ...Option) (sms.Sender, error) {
+	if cfg.BaseURL == "" {
+		return nil, ErrEmptyBaseURL
+	}
+
+	s := &sender{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			return nil, fmt.Errorf("failed to apply option: %w", err)
+		}
+	}
+
+	return s, nil
+}
+
+func (s *sender) SendMessage(ctx context.Context, to string, content string) error {
+	s.logger.Debug().Msgf("sending sms to %s", to)
+
+	body, err := json.Marshal(sendMessageRequest{From: s.cfg.From, To: to, Text: content})
+	if err != nil {
+		return fmt.Errorf("failed to marshal sms request: %w", err)
+	}
+
+	url := strings.TrimSuffix(s.cfg.BaseURL, "/") + messagesPath
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create sms request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if s.cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.cfg.APIKey)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send sms: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return fmt.Errorf("failed to send sms: status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return nil
+}
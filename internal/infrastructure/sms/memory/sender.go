@@ -0,0 +1,68 @@
+package memory
+
+import (
+	"context"
+	"github.com/rs/zerolog"
+	"sync"
+	"time"
+)
+
+type Message struct {
+	To      string
+	Content string
+	SentAt  time.Time
+}
+
+type Option func(s *Sender)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(s *Sender) {
+		s.logger = logger
+	}
+}
+
+// Sender does not deliver messages, it logs and keeps them in memory, so it is used in local mode and tests
+type Sender struct {
+	lock     sync.RWMutex
+	messages []Message
+	logger   zerolog.Logger
+}
+
+func NewSender(opts ...Option) *Sender {
+	s := &Sender{
+		messages: make([]Message, 0),
+		logger:   zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func (s *Sender) SendMessage(_ context.Context, to string, content string) error {
+	s.logger.Info().Msgf("sms to %s: %s", to, content)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.messages = append(s.messages, Message{To: to, Content: content, SentAt: time.Now()})
+
+	return nil
+}
+
+// Messages returns messages sent to the phone number in order of sending
+func (s *Sender) Messages(to string) []Message {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	messages := make([]Message, 0)
+	for _, message := range s.messages {
+		if message.To == to {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages
+}
@@ -0,0 +1,84 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SenderMock is an autogenerated mock type for the Sender type
+type SenderMock struct {
+	mock.Mock
+}
+
+type SenderMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SenderMock) EXPECT() *SenderMock_Expecter {
+	return &SenderMock_Expecter{mock: &_m.Mock}
+}
+
+// SendMessage provides a mock function with given fields: ctx, to, content
+func (_m *SenderMock) SendMessage(ctx context.Context, to string, content string) error {
+	ret := _m.Called(ctx, to, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMessage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, to, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SenderMock_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
+type SenderMock_SendMessage_Call struct {
+	*mock.Call
+}
+
+// SendMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - to string
+//   - content string
+func (_e *SenderMock_Expecter) SendMessage(ctx interface{}, to interface{}, content interface{}) *SenderMock_SendMessage_Call {
+	return &SenderMock_SendMessage_Call{Call: _e.mock.On("SendMessage", ctx, to, content)}
+}
+
+func (_c *SenderMock_SendMessage_Call) Run(run func(ctx context.Context, to string, content string)) *SenderMock_SendMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SenderMock_SendMessage_Call) Return(_a0 error) *SenderMock_SendMessage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SenderMock_SendMessage_Call) RunAndReturn(run func(context.Context, string, string) error) *SenderMock_SendMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSenderMock creates a new instance of SenderMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSenderMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SenderMock {
+	mock := &SenderMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,9 @@
+package sms
+
+import (
+	"context"
+)
+
+type Sender interface {
+	SendMessage(ctx context.Context, to string, content string) error
+}
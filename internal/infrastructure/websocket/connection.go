@@ -0,0 +1,106 @@
+package websocket
+
+import (
+	"sync"
+	"time"
+)
+
+// frame is message queued for connection. ID is identifier of event in replay buffer, it is empty for messages,
+// which cannot be replayed
+type frame struct {
+	id      string
+	payload []byte
+}
+
+// transport writes frames to client. It is called only by writer goroutine of connection
+type transport interface {
+	write(f frame) error
+	ping() error
+	close() error
+}
+
+// connection is websocket or SSE connection of client with own bounded send queue.
+// Data and ping messages are written only by writer goroutine of the connection
+type connection struct {
+	id        string
+	clientID  string
+	transport transport
+	sendCh    chan frame
+	done      chan struct{}
+	// topics contains topics, to which connection is subscribed. It is guarded by mutex of pool
+	topics map[string]struct{}
+
+	closeOnce sync.Once
+}
+
+func newConnection(id string, clientID string, t transport, queueSize int) *connection {
+	return &connection{
+		id:        id,
+		clientID:  clientID,
+		transport: t,
+		sendCh:    make(chan frame, queueSize),
+		done:      make(chan struct{}),
+		topics:    make(map[string]struct{}),
+	}
+}
+
+// enqueue puts message into send queue without blocking. It returns false, if queue is full
+func (c *connection) enqueue(f frame) bool {
+	select {
+	case <-c.done:
+		// Message for closed connection is discarded
+		return true
+	default:
+	}
+
+	select {
+	case c.sendCh <- f:
+		return true
+	default:
+		return false
+	}
+}
+
+// subscribed checks if connection is subscribed to topic. Caller must hold mutex of pool
+func (c *connection) subscribed(topic string) bool {
+	_, ok := c.topics[topic]
+	return ok
+}
+
+// depth returns number of messages in send queue
+func (c *connection) depth() int {
+	return len(c.sendCh)
+}
+
+func (c *connection) close() error {
+	var err error
+	c.closeOnce.Do(
+		func() {
+			close(c.done)
+			err = c.transport.close()
+		},
+	)
+
+	return err
+}
+
+// writeMessages writes queued messages and pings to connection until it is closed or write is failed
+func (c *connection) writeMessages() error {
+	ticker := time.NewTicker(pingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return nil
+		case f := <-c.sendCh:
+			if err := c.transport.write(f); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := c.transport.ping(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -1,7 +1,13 @@
 package websocket
 
+// ClientMessage is message from or to client. ConnID is set only for messages received from client.
+// If Topic is set, message is delivered only to connections of client subscribed to the topic.
+// EventID is identifier of message in replay buffer, it is sent to SSE connections
 type ClientMessage struct {
 	ClientID string `json:"clientID"`
+	ConnID   string `json:"connID,omitempty"`
+	Topic    string `json:"topic,omitempty"`
+	EventID  string `json:"eventID,omitempty"`
 	Payload  []byte `json:"payload"`
 }
 
@@ -21,3 +27,16 @@ func NewBroadcastMessage(payload []byte) BroadcastMessage {
 		Payload: payload,
 	}
 }
+
+// TopicMessage is message to all clients subscribed to topic
+type TopicMessage struct {
+	Topic   string `json:"topic"`
+	Payload []byte `json:"payload"`
+}
+
+func NewTopicMessage(topic string, payload []byte) TopicMessage {
+	return TopicMessage{
+		Topic:   topic,
+		Payload: payload,
+	}
+}
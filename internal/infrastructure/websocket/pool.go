@@ -5,9 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"github.com/goccy/go-json"
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+	"github.com/mandarine-io/backend/internal/infrastructure/presence"
+	memorypresence "github.com/mandarine-io/backend/internal/infrastructure/presence/memory"
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	memorypubsub "github.com/mandarine-io/backend/internal/infrastructure/pubsub/memory"
+	"github.com/mandarine-io/backend/internal/infrastructure/replay"
+	"github.com/mandarine-io/backend/internal/observability"
 	"github.com/mandarine-io/backend/pkg/model/v0"
 	"github.com/rs/zerolog"
+	"github.com/samber/lo"
 	"net/http"
 	"sync"
 	"time"
@@ -17,6 +25,15 @@ const (
 	pingPeriod = 30 * time.Second
 	writeWait  = 1 * time.Minute
 	readWait   = 1 * time.Minute
+
+	// ConnectionIDHeader is response header of handshake, which contains identifier of created connection
+	ConnectionIDHeader = "X-Connection-ID"
+
+	DefaultSendQueueSize = 256
+
+	directTopic    = "ws.direct"
+	broadcastTopic = "ws.broadcast"
+	topicTopic     = "ws.topic"
 )
 
 var (
@@ -24,6 +41,16 @@ var (
 	ErrClientNotFound = fmt.Errorf("client not found")
 )
 
+// SlowConsumerPolicy defines what pool does, when send queue of connection is full
+type SlowConsumerPolicy string
+
+const (
+	// DropMessagePolicy drops message, which does not fit into send queue of connection
+	DropMessagePolicy SlowConsumerPolicy = "drop"
+	// CloseConnectionPolicy closes connection, whose send queue is full
+	CloseConnectionPolicy SlowConsumerPolicy = "close"
+)
+
 type Option func(*Pool) error
 
 func WithLogger(logger zerolog.Logger) Option {
@@ -33,16 +60,91 @@ func WithLogger(logger zerolog.Logger) Option {
 	}
 }
 
+// WithPubSub sets agent, through which messages are delivered to clients connected to other nodes
+func WithPubSub(agent pubsub.Agent) Option {
+	return func(pool *Pool) error {
+		if agent == nil {
+			return fmt.Errorf("pubsub agent is nil")
+		}
+
+		pool.agent = agent
+		return nil
+	}
+}
+
+// WithPresence sets registry, through which node publishes which clients are connected to it
+func WithPresence(registry presence.Registry) Option {
+	return func(pool *Pool) error {
+		if registry == nil {
+			return fmt.Errorf("presence registry is nil")
+		}
+
+		pool.presence = registry
+		return nil
+	}
+}
+
+// WithSendQueueSize sets maximum number of messages, which are waiting to be written to connection
+func WithSendQueueSize(size int) Option {
+	return func(pool *Pool) error {
+		if size <= 0 {
+			return fmt.Errorf("invalid send queue size: %d", size)
+		}
+
+		pool.queueSize = size
+		return nil
+	}
+}
+
+func WithSlowConsumerPolicy(policy SlowConsumerPolicy) Option {
+	return func(pool *Pool) error {
+		if policy != DropMessagePolicy && policy != CloseConnectionPolicy {
+			return fmt.Errorf("unknown slow consumer policy: %s", policy)
+		}
+
+		pool.policy = policy
+		return nil
+	}
+}
+
+// WithReplayBuffer sets buffer, in which messages addressed to client are stored, so SSE client can resume
+// stream by Last-Event-ID. Broadcast and topic messages are not stored, because their recipients are unknown
+func WithReplayBuffer(buffer replay.Buffer) Option {
+	return func(pool *Pool) error {
+		if buffer == nil {
+			return fmt.Errorf("replay buffer is nil")
+		}
+
+		pool.replay = buffer
+		return nil
+	}
+}
+
+func WithMetrics(metrics observability.MetricsAdapter) Option {
+	return func(pool *Pool) error {
+		pool.metrics = metrics
+		return nil
+	}
+}
+
 type Handler func(pool *Pool, msg ClientMessage)
 
 type Pool struct {
-	upgrader    websocket.Upgrader
-	logger      zerolog.Logger
-	conns       map[string]*websocket.Conn
-	handlers    []Handler
-	msgCh       chan ClientMessage
-	broadcastCh chan BroadcastMessage
-	size        int
+	upgrader  websocket.Upgrader
+	logger    zerolog.Logger
+	handlers  []Handler
+	agent     pubsub.Agent
+	presence  presence.Registry
+	replay    replay.Buffer
+	metrics   observability.MetricsAdapter
+	nodeID    string
+	size      int
+	queueSize int
+	policy    SlowConsumerPolicy
+
+	// conns contains connections by client and connection identifier, one client may have many connections
+	conns map[string]map[string]*connection
+	count int
 
 	mu     sync.RWMutex
 	cancel context.CancelFunc
@@ -50,7 +152,6 @@ type Pool struct {
 }
 
 func NewPool(size int, opts ...Option) (*Pool, error) {
-	ctx, cancel := context.WithCancel(context.Background())
 	pool := &Pool{
 		upgrader: websocket.Upgrader{
 			ReadBufferSize:    1024,
@@ -58,13 +159,13 @@ func NewPool(size int, opts ...Option) (*Pool, error) {
 			Error:             handleError,
 			EnableCompression: true,
 		},
-		logger:      zerolog.Nop(),
-		handlers:    make([]Handler, 0),
-		conns:       make(map[string]*websocket.Conn),
-		msgCh:       make(chan ClientMessage, 1024),
-		broadcastCh: make(chan BroadcastMessage, 1024),
-		size:        size,
-		cancel:      cancel,
+		logger:    zerolog.Nop(),
+		handlers:  make([]Handler, 0),
+		conns:     make(map[string]map[string]*connection),
+		nodeID:    uuid.NewString(),
+		size:      size,
+		queueSize: DefaultSendQueueSize,
+		policy:    CloseConnectionPolicy,
 	}
 
 	for _, opt := range opts {
@@ -73,10 +174,44 @@ func NewPool(size int, opts ...Option) (*Pool, error) {
 		}
 	}
 
-	pool.wg.Add(3)
-	go pool.sendPingMessages(ctx)
-	go pool.sendClientMessages()
-	go pool.sendBroadcastMessages()
+	var err error
+	if pool.agent == nil {
+		pool.agent, err = memorypubsub.NewAgent()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create pubsub agent: %w", err)
+		}
+	}
+	if pool.presence == nil {
+		pool.presence, err = memorypresence.NewRegistry()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create presence registry: %w", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pool.cancel = cancel
+
+	directCh, err := pool.agent.Subscribe(ctx, directTopic)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to subscribe to %s: %w", directTopic, err)
+	}
+	broadcastCh, err := pool.agent.Subscribe(ctx, broadcastTopic)
+	if err != nil {
+		cancel()
+		_ = pool.agent.Unsubscribe(context.Background(), directTopic)
+		return nil, fmt.Errorf("failed to subscribe to %s: %w", broadcastTopic, err)
+	}
+	topicCh, err := pool.agent.Subscribe(ctx, topicTopic)
+	if err != nil {
+		cancel()
+		_ = pool.agent.Unsubscribe(context.Background(), directTopic)
+		_ = pool.agent.Unsubscribe(context.Background(), broadcastTopic)
+		return nil, fmt.Errorf("failed to subscribe to %s: %w", topicTopic, err)
+	}
+
+	pool.wg.Add(1)
+	go pool.run(ctx, directCh, broadcastCh, topicCh)
 
 	return pool, nil
 }
@@ -90,25 +225,32 @@ func handleError(w http.ResponseWriter, r *http.Request, status int, reason erro
 	}
 }
 
-func (p *Pool) Register(id string, r *http.Request, w http.ResponseWriter) error {
-	p.mu.Lock()
-	defer p.mu.Unlock()
+// NodeID returns unique identifier of pool, under which its clients are registered in presence registry
+func (p *Pool) NodeID() string {
+	return p.nodeID
+}
 
-	p.logger.Debug().Msgf("register client %s", id)
+// Register upgrades request to websocket connection of client and returns identifier of the connection.
+// Upgrade and presence registry are slow, so they are done without lock of pool
+func (p *Pool) Register(clientID string, r *http.Request, w http.ResponseWriter) (string, error) {
+	p.logger.Debug().Msgf("register client %s", clientID)
 
-	if len(p.conns) >= p.size {
+	if !p.reserve() {
 		handleError(w, r, http.StatusServiceUnavailable, ErrPoolIsFull)
-		return ErrPoolIsFull
+		return "", ErrPoolIsFull
 	}
 
-	conn, err := p.upgrader.Upgrade(w, r, nil)
+	connID := uuid.NewString()
+	conn, err := p.upgrader.Upgrade(w, r, http.Header{ConnectionIDHeader: []string{connID}})
 	if err != nil {
-		return fmt.Errorf("failed to upgrade connection: %w", err)
+		p.release()
+		return "", fmt.Errorf("failed to upgrade connection: %w", err)
 	}
 
+	// Control messages are written by WriteControl, which is safe to call concurrently with other writes
 	conn.SetPingHandler(
 		func(string) error {
-			_ = conn.WriteMessage(websocket.PongMessage, []byte("pong"))
+			_ = conn.WriteControl(websocket.PongMessage, []byte("pong"), time.Now().Add(writeWait))
 			return nil
 		},
 	)
@@ -120,33 +262,52 @@ func (p *Pool) Register(id string, r *http.Request, w http.ResponseWriter) error
 	)
 	conn.SetCloseHandler(
 		func(int, string) error {
-			p.logger.Debug().Msgf("close client %s", id)
-			_ = p.Unregister(id)
+			p.logger.Debug().Msgf("close connection %s of client %s", connID, clientID)
+			_ = p.Unregister(clientID, connID)
 			return nil
 		},
 	)
 
-	p.conns[id] = conn
+	c := newConnection(connID, clientID, &websocketTransport{conn: conn}, p.queueSize)
+	p.join(r.Context(), clientID)
+	p.add(c)
 
-	go p.receiveClientMessages(id)
+	go p.writeClientMessages(c)
+	go p.receiveClientMessages(c, conn)
 
-	return nil
+	return connID, nil
 }
 
-func (p *Pool) Unregister(id string) error {
-	p.mu.Lock()
-	defer p.mu.Unlock()
-
-	p.logger.Debug().Msgf("unregister client %s", id)
+// Unregister closes connection of client. Client leaves presence registry, when its last connection is closed
+func (p *Pool) Unregister(clientID string, connID string) error {
+	p.logger.Debug().Msgf("unregister connection %s of client %s", connID, clientID)
 
-	conn, ok := p.conns[id]
+	p.mu.Lock()
+	c, ok := p.conns[clientID][connID]
 	if !ok {
+		p.mu.Unlock()
 		return ErrClientNotFound
 	}
 
-	delete(p.conns, id)
+	delete(p.conns[clientID], connID)
+	p.count--
+
+	isLast := len(p.conns[clientID]) == 0
+	if isLast {
+		delete(p.conns, clientID)
+	}
+	p.mu.Unlock()
+
+	// Place and connection are released before slow presence registry is updated
+	err := c.close()
+
+	// Client, which is connected again meanwhile, is joined back by refreshing of presence
+	if isLast {
+		if err := p.presence.Leave(context.Background(), p.nodeID, clientID); err != nil {
+			p.logger.Warn().Err(err).Msgf("failed to leave client %s from presence registry", clientID)
+		}
+	}
 
-	err := conn.Close()
 	if err != nil {
 		return fmt.Errorf("failed to close connection: %w", err)
 	}
@@ -154,11 +315,21 @@ func (p *Pool) Unregister(id string) error {
 	return nil
 }
 
+// Count returns number of connections
 func (p *Pool) Count() int {
 	p.mu.RLock()
 	defer p.mu.RUnlock()
 
-	return len(p.conns)
+	return p.count
+}
+
+// IsOnline checks if client is connected to any node
+func (p *Pool) IsOnline(ctx context.Context, clientID string) (bool, error) {
+	if p.hasClient(clientID) {
+		return true, nil
+	}
+
+	return p.presence.IsOnline(ctx, clientID)
 }
 
 func (p *Pool) RegisterHandler(h Handler) {
@@ -168,45 +339,81 @@ func (p *Pool) RegisterHandler(h Handler) {
 	p.handlers = append(p.handlers, h)
 }
 
-func (p *Pool) Send(clientID string, msg []byte) {
-	p.mu.RLock()
-	defer p.mu.RUnlock()
-
+// Send delivers message to all connections of client regardless of node, to which client is connected
+func (p *Pool) Send(ctx context.Context, clientID string, msg []byte) error {
 	p.logger.Debug().Msg("send client message")
-	p.msgCh <- NewClientMessage(clientID, msg)
-}
 
-func (p *Pool) Broadcast(msg []byte) {
-	p.mu.RLock()
-	defer p.mu.RUnlock()
+	clientMsg := NewClientMessage(clientID, msg)
+	clientMsg.EventID = p.appendReplay(ctx, clientID, "", msg)
+	return p.publish(ctx, directTopic, clientMsg)
+}
 
+// Broadcast delivers message to all clients of all nodes
+func (p *Pool) Broadcast(ctx context.Context, msg []byte) error {
 	p.logger.Debug().Msg("send broadcast message")
-	p.broadcastCh <- NewBroadcastMessage(msg)
+	return p.publish(ctx, broadcastTopic, NewBroadcastMessage(msg))
+}
+
+// SendEvent delivers event to connections of client subscribed to topic regardless of node
+func (p *Pool) SendEvent(ctx context.Context, clientID string, topic string, payload any) error {
+	p.logger.Debug().Msgf("send event to topic %s of client %s", topic, clientID)
+
+	data, err := newEvent(topic, payload)
+	if err != nil {
+		return err
+	}
+
+	msg := NewClientMessage(clientID, data)
+	msg.Topic = topic
+	msg.EventID = p.appendReplay(ctx, clientID, topic, data)
+	return p.publish(ctx, directTopic, msg)
+}
+
+// Publish delivers event to all connections subscribed to topic on all nodes
+func (p *Pool) Publish(ctx context.Context, topic string, payload any) error {
+	p.logger.Debug().Msgf("publish event to topic %s", topic)
+
+	data, err := newEvent(topic, payload)
+	if err != nil {
+		return err
+	}
+
+	return p.publish(ctx, topicTopic, NewTopicMessage(topic, data))
 }
 
 func (p *Pool) Close() error {
-	p.mu.Lock()
-	defer p.mu.Unlock()
+	// Stop delivering messages
+	p.cancel()
 
-	// Close all connections
 	var errs []error
-	for clientID, conn := range p.conns {
-		if err := conn.Close(); err != nil {
+	for _, topic := range []string{directTopic, broadcastTopic, topicTopic} {
+		if err := p.agent.Unsubscribe(context.Background(), topic); err != nil {
 			errs = append(errs, err)
 		}
-
-		delete(p.conns, clientID)
 	}
-	p.logger.Debug().Msg("all websocket connections are closed")
 
-	// Close channels
-	close(p.msgCh)
-	close(p.broadcastCh)
+	// Close all connections, it stops their writers
+	p.mu.Lock()
+	clientIDs := make([]string, 0, len(p.conns))
+	for clientID, conns := range p.conns {
+		for _, c := range conns {
+			if err := c.close(); err != nil {
+				errs = append(errs, err)
+			}
+		}
 
-	// Cancel context
-	p.cancel()
+		delete(p.conns, clientID)
+		clientIDs = append(clientIDs, clientID)
+	}
+	p.count = 0
+	p.mu.Unlock()
 
 	p.wg.Wait()
+	p.logger.Debug().Msg("all websocket connections are closed")
+
+	if err := p.presence.Leave(context.Background(), p.nodeID, clientIDs...); err != nil {
+		errs = append(errs, err)
+	}
 
 	if len(errs) > 0 {
 		return errors.Join(errs...)
@@ -215,14 +422,40 @@ func (p *Pool) Close() error {
 	return nil
 }
 
-func (p *Pool) sendPingMessages(ctx context.Context) {
-	p.logger.Debug().Msg("start sending ping messages")
+func (p *Pool) publish(ctx context.Context, topic string, msg any) error {
+	err := p.agent.Publish(ctx, topic, msg)
+	if err != nil {
+		return fmt.Errorf("failed to publish message: %w", err)
+	}
+
+	return nil
+}
+
+// appendReplay stores message in replay buffer and returns its identifier. Message is delivered without identifier,
+// if it is not stored, so failure of buffer does not break live delivery
+func (p *Pool) appendReplay(ctx context.Context, clientID string, topic string, payload []byte) string {
+	if p.replay == nil {
+		return ""
+	}
+
+	id, err := p.replay.Append(ctx, clientID, topic, payload)
+	if err != nil {
+		p.logger.Warn().Err(err).Msgf("failed to append message of client %s to replay buffer", clientID)
+		return ""
+	}
+
+	return id
+}
+
+// run dispatches messages from pub/sub to send queues of connections and refreshes presence of clients
+func (p *Pool) run(ctx context.Context, directCh, broadcastCh, topicCh <-chan pubsub.Event) {
+	p.logger.Debug().Msg("start websocket pool")
 
 	ticker := time.NewTicker(pingPeriod)
 	defer func() {
 		ticker.Stop()
 		p.wg.Done()
-		p.logger.Debug().Msg("ping message sender is stopped")
+		p.logger.Debug().Msg("websocket pool is stopped")
 	}()
 
 	for {
@@ -230,69 +463,217 @@ func (p *Pool) sendPingMessages(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			for clientID, conn := range p.conns {
-				_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
-
-				err := conn.WriteMessage(websocket.PingMessage, nil)
-				if err != nil {
-					p.logger.Error().Stack().Err(err).Msg("failed to send ping message")
-					_ = p.Unregister(clientID)
-				}
+			p.refreshPresence(ctx)
+		case event, ok := <-directCh:
+			if !ok {
+				return
 			}
+			p.dispatchClientMessage(event)
+		case event, ok := <-broadcastCh:
+			if !ok {
+				return
+			}
+			p.dispatchBroadcastMessage(event)
+		case event, ok := <-topicCh:
+			if !ok {
+				return
+			}
+			p.dispatchTopicMessage(event)
 		}
 	}
 }
 
-func (p *Pool) sendClientMessages() {
-	p.logger.Debug().Msg("start sending client messages")
-	defer func() {
-		p.wg.Done()
-		p.logger.Debug().Msg("client message sender is stopped")
-	}()
+func (p *Pool) refreshPresence(ctx context.Context) {
+	p.mu.RLock()
+	clientIDs := lo.Keys(p.conns)
+	p.mu.RUnlock()
 
-	for clientMsg := range p.msgCh {
-		conn, ok := p.conns[clientMsg.ClientID]
-		if !ok {
-			continue
-		}
+	if err := p.presence.Join(ctx, p.nodeID, clientIDs...); err != nil {
+		p.logger.Warn().Err(err).Msg("failed to refresh presence")
+	}
+}
 
-		_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+func (p *Pool) dispatchClientMessage(event pubsub.Event) {
+	var clientMsg ClientMessage
+	if err := event.Decode(&clientMsg); err != nil {
+		p.logger.Error().Stack().Err(err).Msg("failed to decode client message")
+		return
+	}
 
-		err := conn.WriteMessage(websocket.TextMessage, clientMsg.Payload)
-		if err != nil {
-			p.logger.Error().Stack().Err(err).Msg("failed to send client message")
-			_ = p.Unregister(clientMsg.ClientID)
+	p.mu.RLock()
+	conns := lo.Filter(
+		lo.Values(p.conns[clientMsg.ClientID]), func(c *connection, _ int) bool {
+			return clientMsg.Topic == "" || c.subscribed(clientMsg.Topic)
+		},
+	)
+	p.mu.RUnlock()
+
+	p.enqueue(conns, frame{id: clientMsg.EventID, payload: clientMsg.Payload})
+}
+
+func (p *Pool) dispatchBroadcastMessage(event pubsub.Event) {
+	var broadcastMsg BroadcastMessage
+	if err := event.Decode(&broadcastMsg); err != nil {
+		p.logger.Error().Stack().Err(err).Msg("failed to decode broadcast message")
+		return
+	}
+
+	p.mu.RLock()
+	conns := make([]*connection, 0, p.count)
+	for _, clientConns := range p.conns {
+		conns = append(conns, lo.Values(clientConns)...)
+	}
+	p.mu.RUnlock()
+
+	p.enqueue(conns, frame{payload: broadcastMsg.Payload})
+}
+
+func (p *Pool) dispatchTopicMessage(event pubsub.Event) {
+	var topicMsg TopicMessage
+	if err := event.Decode(&topicMsg); err != nil {
+		p.logger.Error().Stack().Err(err).Msg("failed to decode topic message")
+		return
+	}
+
+	p.mu.RLock()
+	conns := make([]*connection, 0)
+	for _, clientConns := range p.conns {
+		for _, c := range clientConns {
+			if c.subscribed(topicMsg.Topic) {
+				conns = append(conns, c)
+			}
 		}
 	}
+	p.mu.RUnlock()
+
+	p.enqueue(conns, frame{payload: topicMsg.Payload})
 }
 
-func (p *Pool) sendBroadcastMessages() {
-	p.logger.Debug().Msg("start sending broadcast messages")
-	defer func() {
-		p.wg.Done()
-		p.logger.Debug().Msg("broadcast message sender is stopped")
-	}()
+// sendToConnection puts message into send queue of connection of this node
+func (p *Pool) sendToConnection(clientID string, connID string, payload []byte) error {
+	p.mu.RLock()
+	c, ok := p.conns[clientID][connID]
+	p.mu.RUnlock()
+
+	if !ok {
+		return ErrClientNotFound
+	}
+
+	p.enqueue([]*connection{c}, frame{payload: payload})
+	return nil
+}
+
+func (p *Pool) subscribe(clientID string, connID string, topic string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.logger.Debug().Msgf("subscribe connection %s of client %s to topic %s", connID, clientID, topic)
+
+	c, ok := p.conns[clientID][connID]
+	if !ok {
+		return ErrClientNotFound
+	}
+
+	c.topics[topic] = struct{}{}
+	return nil
+}
+
+func (p *Pool) unsubscribe(clientID string, connID string, topic string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 
-	for broadcastMsg := range p.broadcastCh {
-		for clientID, conn := range p.conns {
-			_ = conn.SetWriteDeadline(time.Now().Add(writeWait))
+	p.logger.Debug().Msgf("unsubscribe connection %s of client %s from topic %s", connID, clientID, topic)
+
+	c, ok := p.conns[clientID][connID]
+	if !ok {
+		return ErrClientNotFound
+	}
+
+	delete(c.topics, topic)
+	return nil
+}
 
-			err := conn.WriteMessage(websocket.TextMessage, broadcastMsg.Payload)
-			if err != nil {
-				p.logger.Error().Stack().Err(err).Msg("failed to send broadcast message")
-				_ = p.Unregister(clientID)
+// enqueue puts message into send queues of connections. It never blocks, so slow connection does not delay others
+func (p *Pool) enqueue(conns []*connection, f frame) {
+	for _, c := range conns {
+		if c.enqueue(f) {
+			if p.metrics != nil {
+				p.metrics.ObserveWebsocketQueueDepth(c.depth())
 			}
+			continue
+		}
+
+		p.logger.Warn().Msgf("send queue of connection %s of client %s is full, policy: %s", c.id, c.clientID, p.policy)
+		if p.metrics != nil {
+			p.metrics.IncrementWebsocketDropped(string(p.policy))
+		}
+
+		if p.policy == CloseConnectionPolicy {
+			_ = p.Unregister(c.clientID, c.id)
 		}
 	}
 }
 
-func (p *Pool) receiveClientMessages(clientID string) {
-	for {
-		conn, ok := p.conns[clientID]
-		if !ok {
-			break
-		}
+// reserve takes place of connection in pool, so pool is not overfilled by concurrent registrations.
+// Place is released by release, if connection is not added, or by Unregister
+func (p *Pool) reserve() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.count >= p.size {
+		return false
+	}
 
+	p.count++
+	return true
+}
+
+func (p *Pool) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.count--
+}
+
+// add puts connection with reserved place into pool, connection must be finished by p.wg.Done
+func (p *Pool) add(c *connection) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.conns[c.clientID]; !ok {
+		p.conns[c.clientID] = make(map[string]*connection)
+	}
+	p.conns[c.clientID][c.id] = c
+	p.wg.Add(1)
+}
+
+// join adds client to presence registry. It is called before connection is added,
+// so concurrent Unregister of the last connection cannot leave client later than it is joined
+func (p *Pool) join(ctx context.Context, clientID string) {
+	if err := p.presence.Join(ctx, p.nodeID, clientID); err != nil {
+		p.logger.Warn().Err(err).Msgf("failed to join client %s to presence registry", clientID)
+	}
+}
+
+func (p *Pool) hasClient(clientID string) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	_, ok := p.conns[clientID]
+	return ok
+}
+
+func (p *Pool) writeClientMessages(c *connection) {
+	defer p.wg.Done()
+
+	if err := c.writeMessages(); err != nil {
+		p.logger.Error().Stack().Err(err).Msg("failed to send client message")
+		_ = p.Unregister(c.clientID, c.id)
+	}
+}
+
+func (p *Pool) receiveClientMessages(c *connection, conn *websocket.Conn) {
+	for {
 		_ = conn.SetReadDeadline(time.Now().Add(readWait))
 		_, msg, err := conn.ReadMessage()
 		if err != nil {
@@ -304,13 +685,30 @@ func (p *Pool) receiveClientMessages(clientID string) {
 			}
 
 			p.logger.Error().Stack().Err(err).Msg("failed to receive client message")
-			_ = p.Unregister(clientID)
+			_ = p.Unregister(c.clientID, c.id)
 			break
 		}
 
-		clientMsg := NewClientMessage(clientID, msg)
-		for _, h := range p.handlers {
+		p.mu.RLock()
+		handlers := p.handlers
+		p.mu.RUnlock()
+
+		clientMsg := NewClientMessage(c.clientID, msg)
+		clientMsg.ConnID = c.id
+		for _, h := range handlers {
 			h(p, clientMsg)
 		}
 	}
 }
+
+// newEvent encodes event message of topic
+func newEvent(topic string, payload any) ([]byte, error) {
+	env, err := NewEnvelope(EventMessageType, payload)
+	if err != nil {
+		return nil, err
+	}
+	env.ID = uuid.NewString()
+	env.Topic = topic
+
+	return json.Marshal(env)
+}
@@ -0,0 +1,91 @@
+package websocket
+
+import (
+	"fmt"
+	"github.com/goccy/go-json"
+)
+
+const (
+	// ProtocolVersion is version of envelope, which is supported by server
+	ProtocolVersion = 1
+)
+
+// Types of messages, which are handled by router itself
+const (
+	SubscribeMessageType   = "subscribe"
+	UnsubscribeMessageType = "unsubscribe"
+	AckMessageType         = "ack"
+	ResponseMessageType    = "response"
+	ErrorMessageType       = "error"
+	EventMessageType       = "event"
+)
+
+// Codes of protocol errors
+const (
+	BadRequestErrorCode         = "bad_request"
+	UnsupportedVersionErrorCode = "unsupported_version"
+	UnknownTypeErrorCode        = "unknown_type"
+	UnknownTopicErrorCode       = "unknown_topic"
+	ForbiddenErrorCode          = "forbidden"
+	InternalErrorCode           = "internal"
+)
+
+var (
+	ErrBadRequest         = NewProtocolError(BadRequestErrorCode, "bad request")
+	ErrUnsupportedVersion = NewProtocolError(UnsupportedVersionErrorCode, "unsupported protocol version")
+	ErrUnknownType        = NewProtocolError(UnknownTypeErrorCode, "unknown message type")
+	ErrUnknownTopic       = NewProtocolError(UnknownTopicErrorCode, "unknown topic")
+	ErrForbidden          = NewProtocolError(ForbiddenErrorCode, "forbidden")
+	ErrInternal           = NewProtocolError(InternalErrorCode, "internal error")
+)
+
+// Envelope is message of websocket protocol. ID is set by sender, if it expects acknowledgement or response,
+// ReplyTo contains ID of message, to which acknowledgement, response or error relates
+type Envelope struct {
+	Version int             `json:"v"`
+	Type    string          `json:"type"`
+	ID      string          `json:"id,omitempty"`
+	ReplyTo string          `json:"replyTo,omitempty"`
+	Topic   string          `json:"topic,omitempty"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+func NewEnvelope(msgType string, payload any) (Envelope, error) {
+	env := Envelope{
+		Version: ProtocolVersion,
+		Type:    msgType,
+	}
+
+	if payload != nil {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return Envelope{}, fmt.Errorf("failed to marshal payload: %w", err)
+		}
+		env.Payload = data
+	}
+
+	return env, nil
+}
+
+// ProtocolError is error, which is sent to client in payload of error message
+type ProtocolError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+func NewProtocolError(code string, message string) *ProtocolError {
+	return &ProtocolError{
+		Code:    code,
+		Message: message,
+	}
+}
+
+func (e *ProtocolError) Error() string {
+	return e.Message
+}
+
+// Is reports errors with the same code as equal, so errors with custom message match predefined ones
+func (e *ProtocolError) Is(target error) bool {
+	protocolErr, ok := target.(*ProtocolError)
+	return ok && e.Code == protocolErr.Code
+}
@@ -0,0 +1,219 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"github.com/goccy/go-json"
+	"github.com/rs/zerolog"
+	"strings"
+	"sync"
+)
+
+// HandlerFunc handles client message of specific type. Returned error is sent to client as error message
+type HandlerFunc func(c *Context) error
+
+// Authorizer checks if client may subscribe to topic. Returned error is sent to client as error message
+type Authorizer func(ctx context.Context, clientID string, topic string) error
+
+type RouterOption func(*Router)
+
+func WithRouterLogger(logger zerolog.Logger) RouterOption {
+	return func(r *Router) {
+		r.logger = logger
+	}
+}
+
+// Router dispatches client messages to handlers by message type and authorizes subscriptions to topics
+type Router struct {
+	mu          sync.RWMutex
+	handlers    map[string]HandlerFunc
+	authorizers map[string]Authorizer
+	logger      zerolog.Logger
+}
+
+func NewRouter(opts ...RouterOption) *Router {
+	r := &Router{
+		handlers:    make(map[string]HandlerFunc),
+		authorizers: make(map[string]Authorizer),
+		logger:      zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	r.handlers[SubscribeMessageType] = r.subscribe
+	r.handlers[UnsubscribeMessageType] = r.unsubscribe
+	// Acknowledgements of server messages are accepted without reply
+	r.handlers[AckMessageType] = func(*Context) error { return nil }
+
+	return r
+}
+
+// Handle registers handler of message type
+func (r *Router) Handle(msgType string, h HandlerFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.handlers[msgType] = h
+}
+
+// Topic registers authorizer of topic. Pattern, which ends with `*`, matches all topics with such prefix,
+// e.g. `chat:*` matches `chat:<id>`. Subscription to topic without authorizer is rejected
+func (r *Router) Topic(pattern string, a Authorizer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.authorizers[pattern] = a
+}
+
+// Serve handles message received from client. It is registered in pool by Pool.RegisterHandler
+func (r *Router) Serve(pool *Pool, msg ClientMessage) {
+	c := &Context{
+		ctx:      context.Background(),
+		pool:     pool,
+		ClientID: msg.ClientID,
+		ConnID:   msg.ConnID,
+	}
+
+	if err := json.Unmarshal(msg.Payload, &c.Envelope); err != nil || c.Envelope.Type == "" {
+		r.logger.Debug().Msgf("received malformed message from client %s", msg.ClientID)
+		_ = c.fail(ErrBadRequest)
+		return
+	}
+
+	if c.Envelope.Version != ProtocolVersion {
+		_ = c.fail(ErrUnsupportedVersion)
+		return
+	}
+
+	r.mu.RLock()
+	h, ok := r.handlers[c.Envelope.Type]
+	r.mu.RUnlock()
+
+	if !ok {
+		_ = c.fail(ErrUnknownType)
+		return
+	}
+
+	if err := h(c); err != nil {
+		_ = c.fail(err)
+		return
+	}
+
+	// Message, which expects acknowledgement, is acknowledged, if handler has not replied
+	if c.Envelope.ID != "" && !c.replied && c.Envelope.Type != AckMessageType {
+		_ = c.ack()
+	}
+}
+
+// Authorize checks if client may subscribe to topic by authorizer of topic
+func (r *Router) Authorize(ctx context.Context, clientID string, topic string) error {
+	a, ok := r.authorizer(topic)
+	if !ok {
+		return ErrUnknownTopic
+	}
+
+	return a(ctx, clientID, topic)
+}
+
+func (r *Router) subscribe(c *Context) error {
+	if c.Envelope.Topic == "" {
+		return ErrBadRequest
+	}
+
+	if err := r.Authorize(c.Context(), c.ClientID, c.Envelope.Topic); err != nil {
+		return err
+	}
+
+	return c.pool.subscribe(c.ClientID, c.ConnID, c.Envelope.Topic)
+}
+
+func (r *Router) unsubscribe(c *Context) error {
+	if c.Envelope.Topic == "" {
+		return ErrBadRequest
+	}
+
+	return c.pool.unsubscribe(c.ClientID, c.ConnID, c.Envelope.Topic)
+}
+
+func (r *Router) authorizer(topic string) (Authorizer, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if a, ok := r.authorizers[topic]; ok {
+		return a, true
+	}
+
+	for pattern, a := range r.authorizers {
+		prefix, ok := strings.CutSuffix(pattern, "*")
+		if ok && strings.HasPrefix(topic, prefix) {
+			return a, true
+		}
+	}
+
+	return nil, false
+}
+
+// Context is context of client message handling
+type Context struct {
+	ctx      context.Context
+	pool     *Pool
+	replied  bool
+	ClientID string
+	ConnID   string
+	Envelope Envelope
+}
+
+func (c *Context) Context() context.Context {
+	return c.ctx
+}
+
+func (c *Context) Pool() *Pool {
+	return c.pool
+}
+
+// Bind decodes payload of message
+func (c *Context) Bind(v any) error {
+	if err := json.Unmarshal(c.Envelope.Payload, v); err != nil {
+		return ErrBadRequest
+	}
+
+	return nil
+}
+
+// Reply sends response correlated with message to connection, from which message was received
+func (c *Context) Reply(payload any) error {
+	c.replied = true
+	return c.send(ResponseMessageType, payload)
+}
+
+func (c *Context) ack() error {
+	return c.send(AckMessageType, nil)
+}
+
+func (c *Context) fail(err error) error {
+	var protocolErr *ProtocolError
+	if !errors.As(err, &protocolErr) {
+		c.pool.logger.Error().Stack().Err(err).Msgf("failed to handle %s message", c.Envelope.Type)
+		protocolErr = ErrInternal
+	}
+
+	return c.send(ErrorMessageType, protocolErr)
+}
+
+func (c *Context) send(msgType string, payload any) error {
+	env, err := NewEnvelope(msgType, payload)
+	if err != nil {
+		return err
+	}
+	env.ReplyTo = c.Envelope.ID
+	env.Topic = c.Envelope.Topic
+
+	data, err := json.Marshal(env)
+	if err != nil {
+		return err
+	}
+
+	return c.pool.sendToConnection(c.ClientID, c.ConnID, data)
+}
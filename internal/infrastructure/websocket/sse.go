@@ -0,0 +1,112 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/infrastructure/replay"
+	"net/http"
+)
+
+// Stream streams messages of client to response as Server-Sent Events. Connection is subscribed to topics
+// and receives the same messages as websocket connection, but it cannot send messages to server.
+// If lastEventID is set, events stored in replay buffer after it are sent before new ones.
+// Stream blocks until request is canceled, connection is unregistered or pool is closed
+func (p *Pool) Stream(clientID string, topics []string, lastEventID string, r *http.Request, w http.ResponseWriter) error {
+	p.logger.Debug().Msgf("register event stream of client %s", clientID)
+
+	if !p.reserve() {
+		handleError(w, r, http.StatusServiceUnavailable, ErrPoolIsFull)
+		return ErrPoolIsFull
+	}
+
+	connID := uuid.NewString()
+	t := newSSETransport(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Disable buffering of response by nginx
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.Header().Set(ConnectionIDHeader, connID)
+	w.WriteHeader(http.StatusOK)
+	if err := t.rc.Flush(); err != nil {
+		p.release()
+		return fmt.Errorf("failed to flush response: %w", err)
+	}
+
+	c := newConnection(connID, clientID, t, p.queueSize)
+	for _, topic := range topics {
+		c.topics[topic] = struct{}{}
+	}
+	p.join(r.Context(), clientID)
+	p.add(c)
+	defer p.wg.Done()
+
+	// Connection is registered before replay, so events appended meanwhile are queued and not lost
+	if err := p.replayEvents(r.Context(), c, t, lastEventID); err != nil {
+		p.logger.Error().Stack().Err(err).Msg("failed to replay events")
+		_ = p.Unregister(clientID, connID)
+		return err
+	}
+
+	go func() {
+		select {
+		case <-r.Context().Done():
+			p.logger.Debug().Msgf("close event stream %s of client %s", connID, clientID)
+			_ = p.Unregister(clientID, connID)
+		case <-c.done:
+		}
+	}()
+
+	if err := c.writeMessages(); err != nil {
+		p.logger.Error().Stack().Err(err).Msg("failed to send client message")
+		_ = p.Unregister(clientID, connID)
+		return err
+	}
+
+	return nil
+}
+
+// replayEvents writes events of client stored in replay buffer after lastEventID
+func (p *Pool) replayEvents(ctx context.Context, c *connection, t *sseTransport, lastEventID string) error {
+	if lastEventID == "" || p.replay == nil {
+		return nil
+	}
+
+	events, err := p.replay.Since(ctx, c.clientID, lastEventID)
+	if errors.Is(err, replay.ErrInvalidEventID) {
+		p.logger.Warn().Msgf("client %s resumes event stream from invalid event %s", c.clientID, lastEventID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read replay buffer: %w", err)
+	}
+
+	p.mu.RLock()
+	events = filterReplayEvents(c, events)
+	p.mu.RUnlock()
+
+	p.logger.Debug().Msgf("replay %d events to client %s", len(events), c.clientID)
+
+	for _, event := range events {
+		if err := t.replay(frame{id: event.ID, payload: event.Payload}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// filterReplayEvents returns events, which are delivered to connection. Caller must hold mutex of pool
+func filterReplayEvents(c *connection, events []replay.Event) []replay.Event {
+	filtered := make([]replay.Event, 0, len(events))
+	for _, event := range events {
+		if event.Topic == "" || c.subscribed(event.Topic) {
+			filtered = append(filtered, event)
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,91 @@
+package websocket
+
+import (
+	"bytes"
+	"github.com/gorilla/websocket"
+	"net/http"
+	"time"
+)
+
+// websocketTransport writes frames as text messages of websocket connection
+type websocketTransport struct {
+	conn *websocket.Conn
+}
+
+func (t *websocketTransport) write(f frame) error {
+	_ = t.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return t.conn.WriteMessage(websocket.TextMessage, f.payload)
+}
+
+func (t *websocketTransport) ping() error {
+	return t.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(writeWait))
+}
+
+func (t *websocketTransport) close() error {
+	return t.conn.Close()
+}
+
+// sseTransport writes frames as Server-Sent Events to response of streaming request.
+// Response is completed by handler of request, when writer of connection is stopped
+type sseTransport struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+	// replayed contains identifiers of replayed events, which may also be queued after registration of connection
+	replayed map[string]struct{}
+}
+
+func newSSETransport(w http.ResponseWriter) *sseTransport {
+	return &sseTransport{
+		w:        w,
+		rc:       http.NewResponseController(w),
+		replayed: make(map[string]struct{}),
+	}
+}
+
+func (t *sseTransport) write(f frame) error {
+	if _, ok := t.replayed[f.id]; ok {
+		delete(t.replayed, f.id)
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if f.id != "" {
+		buf.WriteString("id: " + f.id + "\n")
+	}
+	// Each line of data is sent in separate field, client joins them by line feed
+	for _, line := range bytes.Split(f.payload, []byte("\n")) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteString("\n")
+	}
+	buf.WriteString("\n")
+
+	return t.flush(buf.Bytes())
+}
+
+// replay writes event from replay buffer before queued frames
+func (t *sseTransport) replay(f frame) error {
+	if err := t.write(f); err != nil {
+		return err
+	}
+
+	t.replayed[f.id] = struct{}{}
+	return nil
+}
+
+func (t *sseTransport) ping() error {
+	// Comment line keeps connection alive through proxies and is ignored by client
+	return t.flush([]byte(": ping\n\n"))
+}
+
+func (t *sseTransport) close() error {
+	return nil
+}
+
+func (t *sseTransport) flush(data []byte) error {
+	if _, err := t.w.Write(data); err != nil {
+		return err
+	}
+
+	return t.rc.Flush()
+}
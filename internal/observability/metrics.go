@@ -11,6 +11,13 @@ const (
 	subsystem   = "backend"
 	resultKey   = "requests_total"
 	durationKey = "requests_duration_seconds"
+	limitedKey  = "requests_rate_limited_total"
+
+	wsQueueDepthKey = "websocket_send_queue_depth"
+	wsDroppedKey    = "websocket_dropped_messages_total"
+
+	cacheHitsKey   = "cache_hits_total"
+	cacheMissesKey = "cache_misses_total"
 )
 
 var (
@@ -32,12 +39,62 @@ var (
 		[]string{"path", "method"},
 	)
 
+	requestRateLimited = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      limitedKey,
+			Help:      "Number of HTTP requests rejected by rate limiter, partitioned by route, method, and key type.",
+		},
+		[]string{"route", "method", "key"},
+	)
+
+	websocketQueueDepth = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Subsystem: subsystem,
+			Name:      wsQueueDepthKey,
+			Help:      "Depth of websocket connection send queue after enqueuing message.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 11),
+		},
+	)
+
+	websocketDropped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      wsDroppedKey,
+			Help:      "Number of websocket messages dropped because of full send queue, partitioned by slow consumer policy.",
+		},
+		[]string{"policy"},
+	)
+
+	cacheHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      cacheHitsKey,
+			Help:      "Number of cache hits, partitioned by cache tier.",
+		},
+		[]string{"tier"},
+	)
+
+	cacheMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      cacheMissesKey,
+			Help:      "Number of cache misses, partitioned by cache tier.",
+		},
+		[]string{"tier"},
+	)
+
 	once sync.Once
 )
 
 type MetricsAdapter interface {
 	IncrementRequestTotal(r *http.Request)
 	UpdateRequestLatency(r *http.Request, duration float64)
+	IncrementRateLimited(route string, method string, keyType string)
+	ObserveWebsocketQueueDepth(depth int)
+	IncrementWebsocketDropped(policy string)
+	IncrementCacheHit(tier string)
+	IncrementCacheMiss(tier string)
 }
 
 type defaultMetricAdapter struct {
@@ -66,6 +123,11 @@ func NewMetricAdapter(opts ...Option) MetricsAdapter {
 			d.logger.Info().Msg("register custom metrics")
 			prometheus.MustRegister(requestResult)
 			prometheus.MustRegister(requestLatency)
+			prometheus.MustRegister(requestRateLimited)
+			prometheus.MustRegister(websocketQueueDepth)
+			prometheus.MustRegister(websocketDropped)
+			prometheus.MustRegister(cacheHits)
+			prometheus.MustRegister(cacheMisses)
 		},
 	)
 
@@ -86,6 +148,28 @@ func (d *defaultMetricAdapter) UpdateRequestLatency(r *http.Request, duration fl
 	requestLatency.WithLabelValues(path, method).Set(duration)
 }
 
+func (d *defaultMetricAdapter) IncrementRateLimited(route string, method string, keyType string) {
+	d.logger.Debug().Msgf("increment rate limited requests, route: %s, method: %s, key: %s", route, method, keyType)
+	requestRateLimited.WithLabelValues(route, method, keyType).Add(1)
+}
+
+func (d *defaultMetricAdapter) ObserveWebsocketQueueDepth(depth int) {
+	websocketQueueDepth.Observe(float64(depth))
+}
+
+func (d *defaultMetricAdapter) IncrementWebsocketDropped(policy string) {
+	d.logger.Debug().Msgf("increment dropped websocket messages, policy: %s", policy)
+	websocketDropped.WithLabelValues(policy).Add(1)
+}
+
+func (d *defaultMetricAdapter) IncrementCacheHit(tier string) {
+	cacheHits.WithLabelValues(tier).Add(1)
+}
+
+func (d *defaultMetricAdapter) IncrementCacheMiss(tier string) {
+	cacheMisses.WithLabelValues(tier).Add(1)
+}
+
 func (d *defaultMetricAdapter) extractPathAndMethod(req *http.Request) (string, string) {
 	path := "none"
 	method := "none"
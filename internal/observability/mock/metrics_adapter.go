@@ -0,0 +1,270 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	http "net/http"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MetricsAdapterMock is an autogenerated mock type for the MetricsAdapter type
+type MetricsAdapterMock struct {
+	mock.Mock
+}
+
+type MetricsAdapterMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MetricsAdapterMock) EXPECT() *MetricsAdapterMock_Expecter {
+	return &MetricsAdapterMock_Expecter{mock: &_m.Mock}
+}
+
+// IncrementCacheHit provides a mock function with given fields: tier
+func (_m *MetricsAdapterMock) IncrementCacheHit(tier string) {
+	_m.Called(tier)
+}
+
+// MetricsAdapterMock_IncrementCacheHit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementCacheHit'
+type MetricsAdapterMock_IncrementCacheHit_Call struct {
+	*mock.Call
+}
+
+// IncrementCacheHit is a helper method to define mock.On call
+//   - tier string
+func (_e *MetricsAdapterMock_Expecter) IncrementCacheHit(tier interface{}) *MetricsAdapterMock_IncrementCacheHit_Call {
+	return &MetricsAdapterMock_IncrementCacheHit_Call{Call: _e.mock.On("IncrementCacheHit", tier)}
+}
+
+func (_c *MetricsAdapterMock_IncrementCacheHit_Call) Run(run func(tier string)) *MetricsAdapterMock_IncrementCacheHit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MetricsAdapterMock_IncrementCacheHit_Call) Return() *MetricsAdapterMock_IncrementCacheHit_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MetricsAdapterMock_IncrementCacheHit_Call) RunAndReturn(run func(string)) *MetricsAdapterMock_IncrementCacheHit_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IncrementCacheMiss provides a mock function with given fields: tier
+func (_m *MetricsAdapterMock) IncrementCacheMiss(tier string) {
+	_m.Called(tier)
+}
+
+// MetricsAdapterMock_IncrementCacheMiss_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementCacheMiss'
+type MetricsAdapterMock_IncrementCacheMiss_Call struct {
+	*mock.Call
+}
+
+// IncrementCacheMiss is a helper method to define mock.On call
+//   - tier string
+func (_e *MetricsAdapterMock_Expecter) IncrementCacheMiss(tier interface{}) *MetricsAdapterMock_IncrementCacheMiss_Call {
+	return &MetricsAdapterMock_IncrementCacheMiss_Call{Call: _e.mock.On("IncrementCacheMiss", tier)}
+}
+
+func (_c *MetricsAdapterMock_IncrementCacheMiss_Call) Run(run func(tier string)) *MetricsAdapterMock_IncrementCacheMiss_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MetricsAdapterMock_IncrementCacheMiss_Call) Return() *MetricsAdapterMock_IncrementCacheMiss_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MetricsAdapterMock_IncrementCacheMiss_Call) RunAndReturn(run func(string)) *MetricsAdapterMock_IncrementCacheMiss_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IncrementRateLimited provides a mock function with given fields: route, method, keyType
+func (_m *MetricsAdapterMock) IncrementRateLimited(route string, method string, keyType string) {
+	_m.Called(route, method, keyType)
+}
+
+// MetricsAdapterMock_IncrementRateLimited_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementRateLimited'
+type MetricsAdapterMock_IncrementRateLimited_Call struct {
+	*mock.Call
+}
+
+// IncrementRateLimited is a helper method to define mock.On call
+//   - route string
+//   - method string
+//   - keyType string
+func (_e *MetricsAdapterMock_Expecter) IncrementRateLimited(route interface{}, method interface{}, keyType interface{}) *MetricsAdapterMock_IncrementRateLimited_Call {
+	return &MetricsAdapterMock_IncrementRateLimited_Call{Call: _e.mock.On("IncrementRateLimited", route, method, keyType)}
+}
+
+func (_c *MetricsAdapterMock_IncrementRateLimited_Call) Run(run func(route string, method string, keyType string)) *MetricsAdapterMock_IncrementRateLimited_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MetricsAdapterMock_IncrementRateLimited_Call) Return() *MetricsAdapterMock_IncrementRateLimited_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MetricsAdapterMock_IncrementRateLimited_Call) RunAndReturn(run func(string, string, string)) *MetricsAdapterMock_IncrementRateLimited_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IncrementRequestTotal provides a mock function with given fields: r
+func (_m *MetricsAdapterMock) IncrementRequestTotal(r *http.Request) {
+	_m.Called(r)
+}
+
+// MetricsAdapterMock_IncrementRequestTotal_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementRequestTotal'
+type MetricsAdapterMock_IncrementRequestTotal_Call struct {
+	*mock.Call
+}
+
+// IncrementRequestTotal is a helper method to define mock.On call
+//   - r *http.Request
+func (_e *MetricsAdapterMock_Expecter) IncrementRequestTotal(r interface{}) *MetricsAdapterMock_IncrementRequestTotal_Call {
+	return &MetricsAdapterMock_IncrementRequestTotal_Call{Call: _e.mock.On("IncrementRequestTotal", r)}
+}
+
+func (_c *MetricsAdapterMock_IncrementRequestTotal_Call) Run(run func(r *http.Request)) *MetricsAdapterMock_IncrementRequestTotal_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request))
+	})
+	return _c
+}
+
+func (_c *MetricsAdapterMock_IncrementRequestTotal_Call) Return() *MetricsAdapterMock_IncrementRequestTotal_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MetricsAdapterMock_IncrementRequestTotal_Call) RunAndReturn(run func(*http.Request)) *MetricsAdapterMock_IncrementRequestTotal_Call {
+	_c.Run(run)
+	return _c
+}
+
+// IncrementWebsocketDropped provides a mock function with given fields: policy
+func (_m *MetricsAdapterMock) IncrementWebsocketDropped(policy string) {
+	_m.Called(policy)
+}
+
+// MetricsAdapterMock_IncrementWebsocketDropped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IncrementWebsocketDropped'
+type MetricsAdapterMock_IncrementWebsocketDropped_Call struct {
+	*mock.Call
+}
+
+// IncrementWebsocketDropped is a helper method to define mock.On call
+//   - policy string
+func (_e *MetricsAdapterMock_Expecter) IncrementWebsocketDropped(policy interface{}) *MetricsAdapterMock_IncrementWebsocketDropped_Call {
+	return &MetricsAdapterMock_IncrementWebsocketDropped_Call{Call: _e.mock.On("IncrementWebsocketDropped", policy)}
+}
+
+func (_c *MetricsAdapterMock_IncrementWebsocketDropped_Call) Run(run func(policy string)) *MetricsAdapterMock_IncrementWebsocketDropped_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MetricsAdapterMock_IncrementWebsocketDropped_Call) Return() *MetricsAdapterMock_IncrementWebsocketDropped_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MetricsAdapterMock_IncrementWebsocketDropped_Call) RunAndReturn(run func(string)) *MetricsAdapterMock_IncrementWebsocketDropped_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ObserveWebsocketQueueDepth provides a mock function with given fields: depth
+func (_m *MetricsAdapterMock) ObserveWebsocketQueueDepth(depth int) {
+	_m.Called(depth)
+}
+
+// MetricsAdapterMock_ObserveWebsocketQueueDepth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ObserveWebsocketQueueDepth'
+type MetricsAdapterMock_ObserveWebsocketQueueDepth_Call struct {
+	*mock.Call
+}
+
+// ObserveWebsocketQueueDepth is a helper method to define mock.On call
+//   - depth int
+func (_e *MetricsAdapterMock_Expecter) ObserveWebsocketQueueDepth(depth interface{}) *MetricsAdapterMock_ObserveWebsocketQueueDepth_Call {
+	return &MetricsAdapterMock_ObserveWebsocketQueueDepth_Call{Call: _e.mock.On("ObserveWebsocketQueueDepth", depth)}
+}
+
+func (_c *MetricsAdapterMock_ObserveWebsocketQueueDepth_Call) Run(run func(depth int)) *MetricsAdapterMock_ObserveWebsocketQueueDepth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MetricsAdapterMock_ObserveWebsocketQueueDepth_Call) Return() *MetricsAdapterMock_ObserveWebsocketQueueDepth_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MetricsAdapterMock_ObserveWebsocketQueueDepth_Call) RunAndReturn(run func(int)) *MetricsAdapterMock_ObserveWebsocketQueueDepth_Call {
+	_c.Run(run)
+	return _c
+}
+
+// UpdateRequestLatency provides a mock function with given fields: r, duration
+func (_m *MetricsAdapterMock) UpdateRequestLatency(r *http.Request, duration float64) {
+	_m.Called(r, duration)
+}
+
+// MetricsAdapterMock_UpdateRequestLatency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRequestLatency'
+type MetricsAdapterMock_UpdateRequestLatency_Call struct {
+	*mock.Call
+}
+
+// UpdateRequestLatency is a helper method to define mock.On call
+//   - r *http.Request
+//   - duration float64
+func (_e *MetricsAdapterMock_Expecter) UpdateRequestLatency(r interface{}, duration interface{}) *MetricsAdapterMock_UpdateRequestLatency_Call {
+	return &MetricsAdapterMock_UpdateRequestLatency_Call{Call: _e.mock.On("UpdateRequestLatency", r, duration)}
+}
+
+func (_c *MetricsAdapterMock_UpdateRequestLatency_Call) Run(run func(r *http.Request, duration float64)) *MetricsAdapterMock_UpdateRequestLatency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*http.Request), args[1].(float64))
+	})
+	return _c
+}
+
+func (_c *MetricsAdapterMock_UpdateRequestLatency_Call) Return() *MetricsAdapterMock_UpdateRequestLatency_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MetricsAdapterMock_UpdateRequestLatency_Call) RunAndReturn(run func(*http.Request, float64)) *MetricsAdapterMock_UpdateRequestLatency_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMetricsAdapterMock creates a new instance of MetricsAdapterMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMetricsAdapterMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MetricsAdapterMock {
+	mock := &MetricsAdapterMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,28 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// APIKey is credential of service account. Key itself is not stored, only SHA-256 hash of it,
+// prefix is stored in plain form to let user recognize the key. Scopes are separated by space
+type APIKey struct {
+	ID               uuid.UUID      `gorm:"column:id;type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ServiceAccountID uuid.UUID      `gorm:"column:service_account_id;type:uuid;not null;index:service_account_id_api_keys_index"`
+	ServiceAccount   ServiceAccount `gorm:"foreignkey:ServiceAccountID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Name             string         `gorm:"column:name;type:varchar(100);not null"`
+	Prefix           string         `gorm:"column:prefix;type:varchar(32);not null;unique"`
+	KeyHash          string         `gorm:"column:key_hash;type:varchar(64);not null;unique"`
+	Scopes           string         `gorm:"column:scopes;type:text;not null"`
+	ExpiresAt        *time.Time     `gorm:"column:expires_at;type:timestamptz"`
+	RevokedAt        *time.Time     `gorm:"column:revoked_at;type:timestamptz"`
+	UsageCount       int64          `gorm:"column:usage_count;type:bigint;not null;default:0"`
+	LastUsedAt       *time.Time     `gorm:"column:last_used_at;type:timestamptz"`
+	LastUsedIP       *string        `gorm:"column:last_used_ip;type:varchar(45)"`
+	CreatedAt        time.Time      `gorm:"column:created_at;not null;type:timestamptz;default:now();autoCreateTime"`
+}
+
+func (*APIKey) TableName() string {
+	return "api_keys"
+}
@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"time"
+)
+
+type BannedToken struct {
+	ID        int       `gorm:"column:id;type:serial;primaryKey"`
+	JTI       string    `gorm:"column:jti;type:text;not null;unique"`
+	CreatedAt time.Time `gorm:"column:created_at;not null;type:timestamptz;default:now();autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;not null;type:timestamptz;default:now();autoUpdateTime"`
+	ExpiredAt int64     `gorm:"column:expired_at;type:bigint;not null;index:expired_at_banned_tokens_index"`
+}
+
+func (*BannedToken) TableName() string {
+	return "banned_tokens"
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type ChatMessage struct {
+	ID             uuid.UUID               `gorm:"column:id;type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ConversationID uuid.UUID               `gorm:"column:conversation_id;type:uuid;not null"`
+	Conversation   Conversation            `gorm:"foreignkey:ConversationID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	SenderID       uuid.UUID               `gorm:"column:sender_id;type:uuid;not null"`
+	Sender         User                    `gorm:"foreignkey:SenderID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Text           string                  `gorm:"column:text;type:text;not null;default:''"`
+	Attachments    []ChatMessageAttachment `gorm:"foreignkey:MessageID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CreatedAt      time.Time               `gorm:"column:created_at;not null;type:timestamptz;default:now();autoCreateTime"`
+}
+
+func (*ChatMessage) TableName() string {
+	return "chat_messages"
+}
+
+// ChatMessageAttachment is resource in S3 storage attached to chat message
+type ChatMessageAttachment struct {
+	ID        uuid.UUID `gorm:"column:id;type:uuid;primaryKey;default:uuid_generate_v4()"`
+	MessageID uuid.UUID `gorm:"column:message_id;type:uuid;not null;index:message_id_chat_message_attachments_index"`
+	ObjectID  string    `gorm:"column:object_id;type:text;not null"`
+}
+
+func (*ChatMessageAttachment) TableName() string {
+	return "chat_message_attachments"
+}
@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// Conversation is chat between client and master, which may be tied to appointment.
+// MasterID is identifier of user, who owns master profile
+type Conversation struct {
+	ID               uuid.UUID  `gorm:"column:id;type:uuid;primaryKey;default:uuid_generate_v4()"`
+	ClientID         uuid.UUID  `gorm:"column:client_id;type:uuid;not null"`
+	Client           User       `gorm:"foreignkey:ClientID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	MasterID         uuid.UUID  `gorm:"column:master_id;type:uuid;not null;index:master_id_conversations_index"`
+	Master           User       `gorm:"foreignkey:MasterID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	AppointmentID    *uuid.UUID `gorm:"column:appointment_id;type:uuid"`
+	ClientLastReadAt *time.Time `gorm:"column:client_last_read_at;type:timestamptz"`
+	MasterLastReadAt *time.Time `gorm:"column:master_last_read_at;type:timestamptz"`
+	LastMessageAt    *time.Time `gorm:"column:last_message_at;type:timestamptz"`
+	CreatedAt        time.Time  `gorm:"column:created_at;not null;type:timestamptz;default:now();autoCreateTime"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at;not null;type:timestamptz;default:now();autoUpdateTime"`
+}
+
+func (*Conversation) TableName() string {
+	return "conversations"
+}
+
+// IsParticipant checks if user is client or master of conversation
+func (c *Conversation) IsParticipant(userID uuid.UUID) bool {
+	return c.ClientID == userID || c.MasterID == userID
+}
+
+// Interlocutor returns identifier of another participant of conversation
+func (c *Conversation) Interlocutor(userID uuid.UUID) uuid.UUID {
+	if c.ClientID == userID {
+		return c.MasterID
+	}
+
+	return c.ClientID
+}
+
+// LastReadAt returns time, until which user has read messages of conversation
+func (c *Conversation) LastReadAt(userID uuid.UUID) *time.Time {
+	if c.ClientID == userID {
+		return c.ClientLastReadAt
+	}
+
+	return c.MasterLastReadAt
+}
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// OutboxEvent is domain event, which is written in the same transaction as domain change and published later.
+// Seq orders events of aggregate. FailedAt is set, when publishing is given up after max attempts
+type OutboxEvent struct {
+	ID            uuid.UUID  `gorm:"column:id;type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Seq           int64      `gorm:"column:seq;type:bigserial;not null;autoIncrement"`
+	AggregateType string     `gorm:"column:aggregate_type;type:varchar(255);not null"`
+	AggregateID   string     `gorm:"column:aggregate_id;type:varchar(255);not null"`
+	EventType     string     `gorm:"column:event_type;type:varchar(255);not null"`
+	Payload       []byte     `gorm:"column:payload;type:jsonb;not null"`
+	Attempts      int        `gorm:"column:attempts;type:int;not null;default:0"`
+	LastError     *string    `gorm:"column:last_error;type:text"`
+	NextAttemptAt time.Time  `gorm:"column:next_attempt_at;type:timestamptz;not null;default:now()"`
+	PublishedAt   *time.Time `gorm:"column:published_at;type:timestamptz"`
+	FailedAt      *time.Time `gorm:"column:failed_at;type:timestamptz"`
+	CreatedAt     time.Time  `gorm:"column:created_at;type:timestamptz;not null;default:now();autoCreateTime"`
+}
+
+func (*OutboxEvent) TableName() string {
+	return "outbox_events"
+}
@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Passkey struct {
+	ID           uuid.UUID  `gorm:"column:id;type:uuid;primaryKey;default:uuid_generate_v4()"`
+	UserID       uuid.UUID  `gorm:"column:user_id;type:uuid;not null;index:user_id_passkeys_index"`
+	User         User       `gorm:"foreignkey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CredentialID []byte     `gorm:"column:credential_id;type:bytea;not null;unique"`
+	PublicKey    []byte     `gorm:"column:public_key;type:bytea;not null"`
+	Algorithm    int        `gorm:"column:algorithm;type:integer;not null"`
+	SignCount    uint32     `gorm:"column:sign_count;type:bigint;not null;default:0"`
+	AAGUID       *uuid.UUID `gorm:"column:aaguid;type:uuid"`
+	Transports   *string    `gorm:"column:transports;type:text"`
+	Name         string     `gorm:"column:name;type:text;not null"`
+	CreatedAt    time.Time  `gorm:"column:created_at;not null;type:timestamptz;default:now();autoCreateTime"`
+	LastUsedAt   *time.Time `gorm:"column:last_used_at;type:timestamptz"`
+}
+
+func (*Passkey) TableName() string {
+	return "passkeys"
+}
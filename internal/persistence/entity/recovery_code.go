@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type RecoveryCode struct {
+	ID        uuid.UUID  `gorm:"column:id;type:uuid;primaryKey;default:uuid_generate_v4()"`
+	UserID    uuid.UUID  `gorm:"column:user_id;type:uuid;not null;index:user_id_recovery_codes_index"`
+	User      User       `gorm:"foreignkey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	CodeHash  string     `gorm:"column:code_hash;type:text;not null"`
+	CreatedAt time.Time  `gorm:"column:created_at;not null;type:timestamptz;default:now();autoCreateTime"`
+	UsedAt    *time.Time `gorm:"column:used_at;type:timestamptz"`
+}
+
+func (*RecoveryCode) TableName() string {
+	return "recovery_codes"
+}
@@ -10,11 +10,12 @@ const (
 )
 
 type RoleEntity struct {
-	ID          int       `gorm:"column:id;type:serial;primaryKey"`
-	Name        string    `gorm:"column:name;type:text;not null;unique"`
-	Description *string   `gorm:"column:description;type:text"`
-	CreatedAt   time.Time `gorm:"column:created_at;not null;type:timestamptz;default:now();autoCreateTime"`
-	UpdatedAt   time.Time `gorm:"column:updated_at;not null;type:timestamptz;default:now();autoUpdateTime"`
+	ID            int       `gorm:"column:id;type:serial;primaryKey"`
+	Name          string    `gorm:"column:name;type:text;not null;unique"`
+	Description   *string   `gorm:"column:description;type:text"`
+	IsMFARequired bool      `gorm:"column:is_mfa_required;not null;default:false"`
+	CreatedAt     time.Time `gorm:"column:created_at;not null;type:timestamptz;default:now();autoCreateTime"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;not null;type:timestamptz;default:now();autoUpdateTime"`
 }
 
 func (*RoleEntity) TableName() string {
@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// ServiceAccount is non-human account of external integration, which acts on behalf of owner by API keys
+type ServiceAccount struct {
+	ID          uuid.UUID `gorm:"column:id;type:uuid;primaryKey;default:uuid_generate_v4()"`
+	OwnerID     uuid.UUID `gorm:"column:owner_id;type:uuid;not null;uniqueIndex:owner_id_name_service_accounts_index"`
+	Owner       User      `gorm:"foreignkey:OwnerID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Name        string    `gorm:"column:name;type:varchar(100);not null;uniqueIndex:owner_id_name_service_accounts_index"`
+	Description *string   `gorm:"column:description;type:text"`
+	CreatedAt   time.Time `gorm:"column:created_at;not null;type:timestamptz;default:now();autoCreateTime"`
+	UpdatedAt   time.Time `gorm:"column:updated_at;not null;type:timestamptz;default:now();autoUpdateTime"`
+}
+
+func (*ServiceAccount) TableName() string {
+	return "service_accounts"
+}
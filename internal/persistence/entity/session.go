@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+type Session struct {
+	ID          uuid.UUID  `gorm:"column:id;type:uuid;primaryKey;default:uuid_generate_v4()"`
+	UserID      uuid.UUID  `gorm:"column:user_id;type:uuid;not null;index:user_id_sessions_index"`
+	User        User       `gorm:"foreignkey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	JTI         string     `gorm:"column:jti;type:text;not null;unique"`
+	PreviousJTI *string    `gorm:"column:previous_jti;type:text"`
+	DeviceName  *string    `gorm:"column:device_name;type:text"`
+	IP          *string    `gorm:"column:ip;type:text"`
+	UserAgent   *string    `gorm:"column:user_agent;type:text"`
+	Location    *string    `gorm:"column:location;type:text"`
+	CreatedAt   time.Time  `gorm:"column:created_at;not null;type:timestamptz;default:now();autoCreateTime"`
+	LastUsedAt  time.Time  `gorm:"column:last_used_at;not null;type:timestamptz;default:now()"`
+	RotatedAt   *time.Time `gorm:"column:rotated_at;type:timestamptz"`
+	ExpiredAt   time.Time  `gorm:"column:expired_at;not null;type:timestamptz;index:expired_at_sessions_index"`
+}
+
+func (*Session) TableName() string {
+	return "sessions"
+}
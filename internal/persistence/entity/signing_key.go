@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+)
+
+type SigningKey struct {
+	ID         string     `gorm:"column:id;type:text;primaryKey"`
+	Algorithm  string     `gorm:"column:algorithm;type:text;not null"`
+	PrivateKey string     `gorm:"column:private_key;type:text;not null"`
+	PublicKey  string     `gorm:"column:public_key;type:text;not null"`
+	CreatedAt  time.Time  `gorm:"column:created_at;not null;type:timestamptz;default:now();autoCreateTime"`
+	ExpiredAt  *time.Time `gorm:"column:expired_at;type:timestamptz;index:expired_at_signing_keys_index"`
+}
+
+func (*SigningKey) TableName() string {
+	return "signing_keys"
+}
@@ -7,19 +7,25 @@ import (
 	"time"
 )
 
+// User is account of the service. Email is empty, if user is registered by provider, which does not share email
 type User struct {
-	ID              uuid.UUID  `gorm:"column:id;type:uuid;primaryKey;default:uuid_generate_v4()"`
-	Username        string     `gorm:"column:username;type:varchar(255);not null;unique"`
-	Email           string     `gorm:"column:email;type:text;not null;unique"`
-	Password        string     `gorm:"column:password;type:text;not null"`
-	Role            RoleEntity `gorm:"foreignkey:RoleID;references:id;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
-	RoleID          int        `gorm:"column:role_id;not null"`
-	IsEnabled       bool       `gorm:"column:is_enabled;not null;default:true;index:is_enabled_users_index"`
-	IsEmailVerified bool       `gorm:"column:is_email_verified;not null;default:false;index:is_email_verified_users_index"`
-	IsPasswordTemp  bool       `gorm:"column:is_password_temp;not null;default:true;index:is_password_temp_index"`
-	CreatedAt       time.Time  `gorm:"column:created_at;not null;type:timestamptz;default:now();autoCreateTime"`
-	UpdatedAt       time.Time  `gorm:"column:updated_at;not null;type:timestamptz;default:now();autoUpdateTime"`
-	DeletedAt       *time.Time `gorm:"column:deleted_at;type:timestamptz;index:deleted_at_users_index"`
+	ID               uuid.UUID  `gorm:"column:id;type:uuid;primaryKey;default:uuid_generate_v4()"`
+	Username         string     `gorm:"column:username;type:varchar(255);not null;unique"`
+	Email            string     `gorm:"column:email;type:text;not null;uniqueIndex:users_email_unique_index,where:email <> ''"`
+	Phone            *string    `gorm:"column:phone;type:varchar(16);unique"`
+	Password         string     `gorm:"column:password;type:text;not null"`
+	Role             RoleEntity `gorm:"foreignkey:RoleID;references:id;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	RoleID           int        `gorm:"column:role_id;not null"`
+	IsEnabled        bool       `gorm:"column:is_enabled;not null;default:true;index:is_enabled_users_index"`
+	IsEmailVerified  bool       `gorm:"column:is_email_verified;not null;default:false;index:is_email_verified_users_index"`
+	IsPhoneVerified  bool       `gorm:"column:is_phone_verified;not null;default:false"`
+	IsPasswordTemp   bool       `gorm:"column:is_password_temp;not null;default:true;index:is_password_temp_index"`
+	TOTPSecret       *string    `gorm:"column:totp_secret;type:text"`
+	TOTPLastUsedStep *int64     `gorm:"column:totp_last_used_step;type:bigint"`
+	IsTOTPEnabled    bool       `gorm:"column:is_totp_enabled;not null;default:false"`
+	CreatedAt        time.Time  `gorm:"column:created_at;not null;type:timestamptz;default:now();autoCreateTime"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at;not null;type:timestamptz;default:now();autoUpdateTime"`
+	DeletedAt        *time.Time `gorm:"column:deleted_at;type:timestamptz;index:deleted_at_users_index"`
 }
 
 func (*User) TableName() string {
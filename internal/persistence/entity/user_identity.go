@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"github.com/google/uuid"
+	"time"
+)
+
+// UserIdentity binds account of external OAuth provider to user, provider account is identified by subject ID
+type UserIdentity struct {
+	ID        uuid.UUID `gorm:"column:id;type:uuid;primaryKey;default:uuid_generate_v4()"`
+	UserID    uuid.UUID `gorm:"column:user_id;type:uuid;not null;uniqueIndex:user_id_provider_user_identities_index"`
+	User      User      `gorm:"foreignkey:UserID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Provider  string    `gorm:"column:provider;type:varchar(50);not null;uniqueIndex:user_id_provider_user_identities_index;uniqueIndex:provider_subject_user_identities_index"`
+	Subject   string    `gorm:"column:subject;type:varchar(255);not null;uniqueIndex:provider_subject_user_identities_index"`
+	Email     *string   `gorm:"column:email;type:varchar(255)"`
+	CreatedAt time.Time `gorm:"column:created_at;not null;type:timestamptz;default:now();autoCreateTime"`
+}
+
+func (*UserIdentity) TableName() string {
+	return "user_identities"
+}
@@ -0,0 +1,163 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+type apiKeyRepo struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+type APIKeyRepoOption func(*apiKeyRepo)
+
+func WithAPIKeyRepoLogger(logger zerolog.Logger) APIKeyRepoOption {
+	return func(r *apiKeyRepo) {
+		r.logger = logger
+	}
+}
+
+func NewAPIKeyRepository(db *gorm.DB, opts ...APIKeyRepoOption) repo.APIKeyRepository {
+	r := &apiKeyRepo{
+		db:     db,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *apiKeyRepo) CreateAPIKey(ctx context.Context, apiKey *entity.APIKey) (*entity.APIKey, error) {
+	r.logger.Debug().Msg("create API key")
+
+	tx := conn(ctx, r.db).Create(apiKey)
+	if errors.Is(tx.Error, gorm.ErrDuplicatedKey) {
+		return apiKey, repo.ErrDuplicateAPIKey
+	}
+
+	return apiKey, tx.Error
+}
+
+func (r *apiKeyRepo) RotateAPIKey(
+	ctx context.Context,
+	oldAPIKey *entity.APIKey,
+	newAPIKey *entity.APIKey,
+) (*entity.APIKey, error) {
+	r.logger.Debug().Msg("rotate API key")
+
+	// New key is created and old key expiration is shortened atomically
+	err := conn(ctx, r.db).Transaction(
+		func(tx *gorm.DB) error {
+			if err := tx.Create(newAPIKey).Error; err != nil {
+				if errors.Is(err, gorm.ErrDuplicatedKey) {
+					return repo.ErrDuplicateAPIKey
+				}
+				return err
+			}
+
+			return tx.Model(&entity.APIKey{}).
+				Where("id = ?", oldAPIKey.ID).
+				Update("expires_at", oldAPIKey.ExpiresAt).
+				Error
+		},
+	)
+
+	return newAPIKey, err
+}
+
+func (r *apiKeyRepo) FindAPIKeysByServiceAccountID(
+	ctx context.Context,
+	serviceAccountID uuid.UUID,
+) ([]*entity.APIKey, error) {
+	r.logger.Debug().Msg("find API keys by service account id")
+
+	var apiKeys []*entity.APIKey
+	err := conn(ctx, r.db).
+		Where("service_account_id = ?", serviceAccountID).
+		Order("created_at DESC").
+		Find(&apiKeys).
+		Error
+
+	if apiKeys == nil {
+		apiKeys = make([]*entity.APIKey, 0)
+	}
+
+	return apiKeys, err
+}
+
+func (r *apiKeyRepo) FindAPIKeyByID(
+	ctx context.Context,
+	serviceAccountID uuid.UUID,
+	id uuid.UUID,
+) (*entity.APIKey, error) {
+	r.logger.Debug().Msg("find API key by id")
+
+	apiKey := &entity.APIKey{}
+	tx := conn(ctx, r.db).
+		Where("id = ?", id).
+		Where("service_account_id = ?", serviceAccountID).
+		First(apiKey)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	return apiKey, tx.Error
+}
+
+func (r *apiKeyRepo) FindAPIKeyByHash(ctx context.Context, keyHash string) (*entity.APIKey, error) {
+	r.logger.Debug().Msg("find API key by hash")
+
+	apiKey := &entity.APIKey{}
+	tx := conn(ctx, r.db).
+		InnerJoins("ServiceAccount").
+		Where("key_hash = ?", keyHash).
+		First(apiKey)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	return apiKey, tx.Error
+}
+
+func (r *apiKeyRepo) RevokeAPIKey(ctx context.Context, serviceAccountID uuid.UUID, id uuid.UUID) (bool, error) {
+	r.logger.Debug().Msg("revoke API key")
+
+	tx := conn(ctx, r.db).
+		Model(&entity.APIKey{}).
+		Where("id = ?", id).
+		Where("service_account_id = ?", serviceAccountID).
+		Where("revoked_at IS NULL").
+		Update("revoked_at", gorm.Expr("now()"))
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	return tx.RowsAffected > 0, nil
+}
+
+func (r *apiKeyRepo) TrackAPIKeyUsage(ctx context.Context, id uuid.UUID, ip string) error {
+	r.logger.Debug().Msg("track API key usage")
+
+	return conn(ctx, r.db).
+		Model(&entity.APIKey{}).
+		Where("id = ?", id).
+		Updates(
+			map[string]any{
+				"usage_count":  gorm.Expr("usage_count + 1"),
+				"last_used_at": gorm.Expr("now()"),
+				"last_used_ip": ip,
+			},
+		).
+		Error
+}
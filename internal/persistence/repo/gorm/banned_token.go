@@ -0,0 +1,72 @@
+package gorm
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type bannedTokenRepo struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+type BannedTokenRepoOption func(*bannedTokenRepo)
+
+func WithBannedTokenRepoLogger(logger zerolog.Logger) BannedTokenRepoOption {
+	return func(r *bannedTokenRepo) {
+		r.logger = logger
+	}
+}
+
+func NewBannedTokenRepository(db *gorm.DB, opts ...BannedTokenRepoOption) repo.BannedTokenRepository {
+	r := &bannedTokenRepo{
+		db:     db,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *bannedTokenRepo) CreateBannedToken(
+	ctx context.Context,
+	bannedToken *entity.BannedToken,
+) (*entity.BannedToken, error) {
+	r.logger.Debug().Msg("create banned token")
+
+	// Banning the same token twice is not an error
+	tx := conn(ctx, r.db).
+		Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "jti"}}, DoNothing: true}).
+		Create(bannedToken)
+
+	return bannedToken, tx.Error
+}
+
+func (r *bannedTokenRepo) ExistsBannedTokenByJTI(ctx context.Context, jti string) (bool, error) {
+	r.logger.Debug().Msg("exists banned token by jti")
+
+	var exists bool
+	tx := conn(ctx, r.db).
+		Model(&entity.BannedToken{}).
+		Select("count(*) > 0").
+		Where("jti = ?", jti).
+		Where("expired_at >= extract(epoch from now())").
+		Find(&exists)
+	return exists, tx.Error
+}
+
+func (r *bannedTokenRepo) DeleteExpiredBannedTokens(ctx context.Context) error {
+	r.logger.Debug().Msg("delete expired banned tokens")
+
+	tx := conn(ctx, r.db).
+		Where("expired_at < extract(epoch from now())").
+		Delete(&entity.BannedToken{})
+	return tx.Error
+}
@@ -0,0 +1,134 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+type chatMessageRepo struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+type ChatMessageRepoOption func(*chatMessageRepo)
+
+func WithChatMessageRepoLogger(logger zerolog.Logger) ChatMessageRepoOption {
+	return func(r *chatMessageRepo) {
+		r.logger = logger
+	}
+}
+
+func NewChatMessageRepository(db *gorm.DB, opts ...ChatMessageRepoOption) repo.ChatMessageRepository {
+	r := &chatMessageRepo{
+		db:     db,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *chatMessageRepo) CreateChatMessage(
+	ctx context.Context,
+	message *entity.ChatMessage,
+) (*entity.ChatMessage, error) {
+	r.logger.Debug().Msg("create chat message")
+
+	// Message and last message time of conversation are updated atomically
+	err := conn(ctx, r.db).Transaction(
+		func(tx *gorm.DB) error {
+			if err := tx.Omit("Conversation", "Sender").Create(message).Error; err != nil {
+				return err
+			}
+
+			return tx.Model(&entity.Conversation{}).
+				Where("id = ?", message.ConversationID).
+				Update("last_message_at", message.CreatedAt).
+				Error
+		},
+	)
+
+	return message, err
+}
+
+func (r *chatMessageRepo) FindChatMessageByID(
+	ctx context.Context,
+	conversationID uuid.UUID,
+	id uuid.UUID,
+) (*entity.ChatMessage, error) {
+	r.logger.Debug().Msg("find chat message by id")
+
+	message := &entity.ChatMessage{}
+	tx := conn(ctx, r.db).
+		Preload("Attachments").
+		Where("id = ?", id).
+		Where("conversation_id = ?", conversationID).
+		First(message)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	return message, tx.Error
+}
+
+func (r *chatMessageRepo) FindChatMessages(
+	ctx context.Context,
+	conversationID uuid.UUID,
+	cursor *repo.ChatMessageCursor,
+	limit int,
+) ([]*entity.ChatMessage, error) {
+	r.logger.Debug().Msg("find chat messages")
+
+	tx := conn(ctx, r.db).
+		Preload("Attachments").
+		Where("conversation_id = ?", conversationID)
+
+	if cursor != nil {
+		tx = tx.Where("(created_at, id) < (?, ?)", cursor.CreatedAt, cursor.ID)
+	}
+
+	var messages []*entity.ChatMessage
+	err := tx.
+		Order("created_at DESC").
+		Order("id DESC").
+		Limit(limit).
+		Find(&messages).
+		Error
+
+	if messages == nil {
+		messages = make([]*entity.ChatMessage, 0)
+	}
+
+	return messages, err
+}
+
+func (r *chatMessageRepo) CountUnreadChatMessages(
+	ctx context.Context,
+	conversation *entity.Conversation,
+	userID uuid.UUID,
+) (int64, error) {
+	r.logger.Debug().Msg("count unread chat messages")
+
+	tx := conn(ctx, r.db).
+		Model(&entity.ChatMessage{}).
+		Where("conversation_id = ?", conversation.ID).
+		Where("sender_id <> ?", userID)
+
+	if lastReadAt := conversation.LastReadAt(userID); lastReadAt != nil {
+		tx = tx.Where("created_at > ?", *lastReadAt)
+	}
+
+	var count int64
+	err := tx.Count(&count).Error
+
+	return count, err
+}
@@ -0,0 +1,147 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+	"time"
+)
+
+type conversationRepo struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+type ConversationRepoOption func(*conversationRepo)
+
+func WithConversationRepoLogger(logger zerolog.Logger) ConversationRepoOption {
+	return func(r *conversationRepo) {
+		r.logger = logger
+	}
+}
+
+func NewConversationRepository(db *gorm.DB, opts ...ConversationRepoOption) repo.ConversationRepository {
+	r := &conversationRepo{
+		db:     db,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *conversationRepo) CreateConversation(
+	ctx context.Context,
+	conversation *entity.Conversation,
+) (*entity.Conversation, error) {
+	r.logger.Debug().Msg("create conversation")
+
+	tx := conn(ctx, r.db).Create(conversation)
+	if errors.Is(tx.Error, gorm.ErrDuplicatedKey) {
+		return conversation, repo.ErrDuplicateConversation
+	}
+
+	return conversation, tx.Error
+}
+
+func (r *conversationRepo) FindConversationByID(ctx context.Context, id uuid.UUID) (*entity.Conversation, error) {
+	r.logger.Debug().Msg("find conversation by id")
+
+	conversation := &entity.Conversation{}
+	tx := conn(ctx, r.db).
+		Preload("Client").
+		Preload("Master").
+		Where("id = ?", id).
+		First(conversation)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	return conversation, tx.Error
+}
+
+func (r *conversationRepo) FindConversationByParticipants(
+	ctx context.Context,
+	clientID uuid.UUID,
+	masterID uuid.UUID,
+	appointmentID *uuid.UUID,
+) (*entity.Conversation, error) {
+	r.logger.Debug().Msg("find conversation by participants")
+
+	conversation := &entity.Conversation{}
+	tx := conn(ctx, r.db).
+		Preload("Client").
+		Preload("Master").
+		Where("client_id = ?", clientID).
+		Where("master_id = ?", masterID)
+
+	if appointmentID == nil {
+		tx = tx.Where("appointment_id IS NULL")
+	} else {
+		tx = tx.Where("appointment_id = ?", *appointmentID)
+	}
+
+	tx = tx.First(conversation)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	return conversation, tx.Error
+}
+
+func (r *conversationRepo) FindConversationsByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]*entity.Conversation, error) {
+	r.logger.Debug().Msg("find conversations by user id")
+
+	var conversations []*entity.Conversation
+	err := conn(ctx, r.db).
+		Preload("Client").
+		Preload("Master").
+		Where("client_id = ? OR master_id = ?", userID, userID).
+		Order("COALESCE(last_message_at, created_at) DESC").
+		Find(&conversations).
+		Error
+
+	if conversations == nil {
+		conversations = make([]*entity.Conversation, 0)
+	}
+
+	return conversations, err
+}
+
+func (r *conversationRepo) UpdateConversationReadAt(
+	ctx context.Context,
+	id uuid.UUID,
+	userID uuid.UUID,
+	readAt time.Time,
+) error {
+	r.logger.Debug().Msg("update conversation read at")
+
+	// Read marker only moves forward, so late requests do not mark messages as unread again
+	err := conn(ctx, r.db).
+		Model(&entity.Conversation{}).
+		Where("id = ? AND client_id = ?", id, userID).
+		Where("client_last_read_at IS NULL OR client_last_read_at < ?", readAt).
+		Update("client_last_read_at", readAt).
+		Error
+	if err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).
+		Model(&entity.Conversation{}).
+		Where("id = ? AND master_id = ?", id, userID).
+		Where("master_last_read_at IS NULL OR master_last_read_at < ?", readAt).
+		Update("master_last_read_at", readAt).
+		Error
+}
@@ -47,7 +47,7 @@ func (r *masterProfileRepo) CreateMasterProfile(
 ) (*entity.MasterProfile, error) {
 	r.logger.Debug().Msg("create master profile")
 
-	tx := r.db.WithContext(ctx).Create(masterProfile)
+	tx := conn(ctx, r.db).Create(masterProfile)
 
 	err := tx.Error
 	if errors.Is(err, gorm.ErrDuplicatedKey) {
@@ -66,7 +66,7 @@ func (r *masterProfileRepo) UpdateMasterProfile(
 ) (*entity.MasterProfile, error) {
 	r.logger.Debug().Msg("update master profile")
 
-	tx := r.db.WithContext(ctx).Save(masterProfile)
+	tx := conn(ctx, r.db).Save(masterProfile)
 
 	return masterProfile, tx.Error
 }
@@ -77,7 +77,7 @@ func (r *masterProfileRepo) FindMasterProfiles(ctx context.Context, scopes ...re
 ) {
 	r.logger.Debug().Msg("find master profiles")
 
-	tx := r.db.WithContext(ctx)
+	tx := conn(ctx, r.db)
 
 	for _, scope := range scopes {
 		tx.Scopes(scope)
@@ -96,7 +96,7 @@ func (r *masterProfileRepo) FindMasterProfiles(ctx context.Context, scopes ...re
 func (r *masterProfileRepo) CountMasterProfiles(ctx context.Context, scopes ...repo.Scope) (int64, error) {
 	r.logger.Debug().Msg("count master profiles")
 
-	tx := r.db.WithContext(ctx)
+	tx := conn(ctx, r.db)
 
 	for _, scope := range scopes {
 		tx.Scopes(scope)
@@ -119,8 +119,7 @@ func (r *masterProfileRepo) FindMasterProfileByUserID(ctx context.Context, userI
 	r.logger.Debug().Msg("find master profile by user id")
 
 	masterProfile := &entity.MasterProfile{}
-	tx := r.db.
-		WithContext(ctx).
+	tx := conn(ctx, r.db).
 		Where("user_id = ?", userID).
 		First(masterProfile)
 	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
@@ -137,8 +136,7 @@ func (r *masterProfileRepo) FindMasterProfileByUsername(ctx context.Context, use
 	r.logger.Debug().Msg("find master profile by username")
 
 	masterProfile := &entity.MasterProfile{}
-	tx := r.db.
-		WithContext(ctx).
+	tx := conn(ctx, r.db).
 		Joins("join users on users.id = master_profiles.user_id").
 		Where("users.username = ?", username).
 		First(masterProfile)
@@ -157,8 +155,7 @@ func (r *masterProfileRepo) FindEnabledMasterProfileByUsername(
 	r.logger.Debug().Msg("find enabled master profile by username")
 
 	masterProfile := &entity.MasterProfile{}
-	tx := r.db.
-		WithContext(ctx).
+	tx := conn(ctx, r.db).
 		Joins("join users on users.id = master_profiles.user_id").
 		Where("users.username = ?", username).
 		Where("master_profiles.is_enabled = ?", true).
@@ -174,7 +171,7 @@ func (r *masterProfileRepo) ExistsMasterProfileByUserID(ctx context.Context, id
 	r.logger.Debug().Msg("exists master profile by user id")
 
 	var exists bool
-	tx := r.db.WithContext(ctx).
+	tx := conn(ctx, r.db).
 		Model(&entity.MasterProfile{}).
 		Select("count(*) > 0").
 		Where("user_id = ?", id).
@@ -187,8 +184,7 @@ func (r *masterProfileRepo) ExistsMasterProfileByUsername(ctx context.Context, u
 	r.logger.Debug().Msg("exists master profile by user id")
 
 	var exists bool
-	tx := r.db.
-		WithContext(ctx).
+	tx := conn(ctx, r.db).
 		Model(&entity.MasterProfile{}).
 		Joins("join users on users.id = master_profiles.user_id").
 		Select("count(*) > 0").
@@ -46,7 +46,7 @@ func (r *masterServiceRepo) CreateMasterService(
 ) (*entity.MasterService, error) {
 	r.logger.Debug().Msg("create master service")
 
-	tx := r.db.WithContext(ctx).Create(masterService)
+	tx := conn(ctx, r.db).Create(masterService)
 
 	err := tx.Error
 	if errors.Is(err, gorm.ErrDuplicatedKey) {
@@ -65,7 +65,7 @@ func (r *masterServiceRepo) UpdateMasterService(
 ) (*entity.MasterService, error) {
 	r.logger.Debug().Msg("update master service")
 
-	tx := r.db.WithContext(ctx).Save(masterService)
+	tx := conn(ctx, r.db).Save(masterService)
 
 	return masterService, tx.Error
 }
@@ -77,8 +77,7 @@ func (r *masterServiceRepo) DeleteMasterServiceByID(
 ) error {
 	r.logger.Debug().Msg("delete master service")
 
-	tx := r.db.
-		WithContext(ctx).
+	tx := conn(ctx, r.db).
 		Where("id = ?", id).
 		Where("master_profile_id = ?", masterProfileID).
 		Delete(&entity.MasterService{})
@@ -92,7 +91,7 @@ func (r *masterServiceRepo) FindMasterServices(ctx context.Context, scopes ...re
 ) {
 	r.logger.Debug().Msg("find master services")
 
-	tx := r.db.WithContext(ctx)
+	tx := conn(ctx, r.db)
 
 	for _, scope := range scopes {
 		tx = tx.Scopes(scope)
@@ -114,7 +113,7 @@ func (r *masterServiceRepo) FindMasterServices(ctx context.Context, scopes ...re
 func (r *masterServiceRepo) CountMasterServices(ctx context.Context, scopes ...repo.Scope) (int64, error) {
 	r.logger.Debug().Msg("count master services")
 
-	tx := r.db.WithContext(ctx)
+	tx := conn(ctx, r.db)
 
 	for _, scope := range scopes {
 		tx = tx.Scopes(scope)
@@ -136,7 +135,7 @@ func (r *masterServiceRepo) FindMasterServicesByMasterProfileID(
 ) ([]*entity.MasterService, error) {
 	r.logger.Debug().Msg("find master services by master profile ID")
 
-	tx := r.db.WithContext(ctx)
+	tx := conn(ctx, r.db)
 
 	for _, scope := range scopes {
 		tx = tx.Scopes(scope)
@@ -162,7 +161,7 @@ func (r *masterServiceRepo) CountMasterServicesByMasterProfileID(
 ) (int64, error) {
 	r.logger.Debug().Msg("count master services by master profile ID")
 
-	tx := r.db.WithContext(ctx)
+	tx := conn(ctx, r.db)
 
 	for _, scope := range scopes {
 		tx = tx.Scopes(scope)
@@ -187,8 +186,7 @@ func (r *masterServiceRepo) FindMasterServiceByID(
 
 	masterService := &entity.MasterService{}
 
-	tx := r.db.
-		WithContext(ctx).
+	tx := conn(ctx, r.db).
 		Where("id = ?", id).
 		Where("master_profile_id = ?", masterProfileID).
 		First(masterService)
@@ -204,7 +202,7 @@ func (r *masterServiceRepo) ExistsMasterServiceByMasterID(ctx context.Context, m
 	r.logger.Debug().Msg("exists master profile by master id")
 
 	var exists bool
-	tx := r.db.WithContext(ctx).
+	tx := conn(ctx, r.db).
 		Model(&entity.MasterProfile{}).
 		Select("count(*) > 0").
 		Where("master_id = ?", masterID).
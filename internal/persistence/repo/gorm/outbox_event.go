@@ -0,0 +1,143 @@
+package gorm
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type outboxEventRepo struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+type OutboxEventRepoOption func(*outboxEventRepo)
+
+func WithOutboxEventRepoLogger(logger zerolog.Logger) OutboxEventRepoOption {
+	return func(r *outboxEventRepo) {
+		r.logger = logger
+	}
+}
+
+func NewOutboxEventRepository(db *gorm.DB, opts ...OutboxEventRepoOption) repo.OutboxEventRepository {
+	r := &outboxEventRepo{
+		db:     db,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *outboxEventRepo) CreateOutboxEvents(ctx context.Context, events []*entity.OutboxEvent) error {
+	r.logger.Debug().Msg("create outbox events")
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	return conn(ctx, r.db).Create(events).Error
+}
+
+func (r *outboxEventRepo) FindPendingOutboxEvents(ctx context.Context, limit int) ([]*entity.OutboxEvent, error) {
+	r.logger.Debug().Msg("find pending outbox events")
+
+	// Locked events are skipped, so several relays publish different events concurrently
+	events := make([]*entity.OutboxEvent, 0)
+	tx := conn(ctx, r.db).
+		Where("published_at IS NULL").
+		Where("failed_at IS NULL").
+		Where("next_attempt_at <= now()").
+		Where(
+			"NOT EXISTS (?)",
+			conn(ctx, r.db).
+				Table("outbox_events AS earlier").
+				Select("1").
+				Where("earlier.published_at IS NULL").
+				Where("earlier.failed_at IS NULL").
+				Where("earlier.aggregate_type = outbox_events.aggregate_type").
+				Where("earlier.aggregate_id = outbox_events.aggregate_id").
+				Where("earlier.seq < outbox_events.seq"),
+		).
+		Order("seq").
+		Limit(limit).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Find(&events)
+
+	return events, tx.Error
+}
+
+func (r *outboxEventRepo) MarkOutboxEventPublished(ctx context.Context, id uuid.UUID, publishedAt time.Time) error {
+	r.logger.Debug().Msg("mark outbox event published")
+
+	return conn(ctx, r.db).
+		Model(&entity.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(
+			map[string]any{
+				"published_at": publishedAt,
+				"last_error":   nil,
+			},
+		).
+		Error
+}
+
+func (r *outboxEventRepo) MarkOutboxEventFailed(
+	ctx context.Context,
+	id uuid.UUID,
+	lastError string,
+	nextAttemptAt time.Time,
+) error {
+	r.logger.Debug().Msg("mark outbox event failed")
+
+	return conn(ctx, r.db).
+		Model(&entity.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(
+			map[string]any{
+				"attempts":        gorm.Expr("attempts + 1"),
+				"last_error":      lastError,
+				"next_attempt_at": nextAttemptAt,
+			},
+		).
+		Error
+}
+
+func (r *outboxEventRepo) MarkOutboxEventFailedPermanently(
+	ctx context.Context,
+	id uuid.UUID,
+	lastError string,
+	failedAt time.Time,
+) error {
+	r.logger.Debug().Msg("mark outbox event failed permanently")
+
+	return conn(ctx, r.db).
+		Model(&entity.OutboxEvent{}).
+		Where("id = ?", id).
+		Updates(
+			map[string]any{
+				"attempts":   gorm.Expr("attempts + 1"),
+				"last_error": lastError,
+				"failed_at":  failedAt,
+			},
+		).
+		Error
+}
+
+func (r *outboxEventRepo) DeleteOutboxEventsPublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	r.logger.Debug().Msg("delete published outbox events")
+
+	tx := conn(ctx, r.db).
+		Where("published_at < ?", before).
+		Delete(&entity.OutboxEvent{})
+
+	return tx.RowsAffected, tx.Error
+}
@@ -0,0 +1,113 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+type passkeyRepo struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+type PasskeyRepoOption func(*passkeyRepo)
+
+func WithPasskeyRepoLogger(logger zerolog.Logger) PasskeyRepoOption {
+	return func(r *passkeyRepo) {
+		r.logger = logger
+	}
+}
+
+func NewPasskeyRepository(db *gorm.DB, opts ...PasskeyRepoOption) repo.PasskeyRepository {
+	r := &passkeyRepo{
+		db:     db,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *passkeyRepo) CreatePasskey(ctx context.Context, passkey *entity.Passkey) (*entity.Passkey, error) {
+	r.logger.Debug().Msg("create passkey")
+
+	tx := conn(ctx, r.db).Create(passkey)
+	if errors.Is(tx.Error, gorm.ErrDuplicatedKey) {
+		return passkey, repo.ErrDuplicatePasskey
+	}
+
+	return passkey, tx.Error
+}
+
+func (r *passkeyRepo) FindPasskeysByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Passkey, error) {
+	r.logger.Debug().Msg("find passkeys by user id")
+
+	var passkeys []*entity.Passkey
+	err := conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Find(&passkeys).
+		Error
+
+	if passkeys == nil {
+		passkeys = make([]*entity.Passkey, 0)
+	}
+
+	return passkeys, err
+}
+
+func (r *passkeyRepo) FindPasskeyByCredentialID(ctx context.Context, credentialID []byte) (*entity.Passkey, error) {
+	r.logger.Debug().Msg("find passkey by credential id")
+
+	passkey := &entity.Passkey{}
+	tx := conn(ctx, r.db).Where("credential_id = ?", credentialID).First(passkey)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	return passkey, tx.Error
+}
+
+func (r *passkeyRepo) UpdatePasskeySignCount(
+	ctx context.Context,
+	id uuid.UUID,
+	oldSignCount uint32,
+	newSignCount uint32,
+) (bool, error) {
+	r.logger.Debug().Msg("update passkey sign count")
+
+	// Update only if passkey has not been used concurrently
+	tx := conn(ctx, r.db).
+		Model(&entity.Passkey{}).
+		Where("id = ?", id).
+		Where("sign_count = ?", oldSignCount).
+		Updates(map[string]any{"sign_count": newSignCount, "last_used_at": gorm.Expr("now()")})
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	return tx.RowsAffected > 0, nil
+}
+
+func (r *passkeyRepo) DeletePasskey(ctx context.Context, id uuid.UUID, userID uuid.UUID) (bool, error) {
+	r.logger.Debug().Msg("delete passkey")
+
+	tx := conn(ctx, r.db).
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		Delete(&entity.Passkey{})
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	return tx.RowsAffected > 0, nil
+}
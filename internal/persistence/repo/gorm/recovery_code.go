@@ -0,0 +1,92 @@
+package gorm
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+type recoveryCodeRepo struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+type RecoveryCodeRepoOption func(*recoveryCodeRepo)
+
+func WithRecoveryCodeRepoLogger(logger zerolog.Logger) RecoveryCodeRepoOption {
+	return func(r *recoveryCodeRepo) {
+		r.logger = logger
+	}
+}
+
+func NewRecoveryCodeRepository(db *gorm.DB, opts ...RecoveryCodeRepoOption) repo.RecoveryCodeRepository {
+	r := &recoveryCodeRepo{
+		db:     db,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *recoveryCodeRepo) CreateRecoveryCodes(ctx context.Context, recoveryCodes []*entity.RecoveryCode) error {
+	r.logger.Debug().Msg("create recovery codes")
+
+	if len(recoveryCodes) == 0 {
+		return nil
+	}
+
+	tx := conn(ctx, r.db).Create(recoveryCodes)
+	return tx.Error
+}
+
+func (r *recoveryCodeRepo) FindUnusedRecoveryCodesByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]*entity.RecoveryCode, error) {
+	r.logger.Debug().Msg("find unused recovery codes by user id")
+
+	var recoveryCodes []*entity.RecoveryCode
+	err := conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Where("used_at IS NULL").
+		Find(&recoveryCodes).
+		Error
+
+	if recoveryCodes == nil {
+		recoveryCodes = make([]*entity.RecoveryCode, 0)
+	}
+
+	return recoveryCodes, err
+}
+
+func (r *recoveryCodeRepo) UseRecoveryCode(ctx context.Context, id uuid.UUID) (bool, error) {
+	r.logger.Debug().Msg("use recovery code")
+
+	// Update only if code has not been used concurrently
+	tx := conn(ctx, r.db).
+		Model(&entity.RecoveryCode{}).
+		Where("id = ?", id).
+		Where("used_at IS NULL").
+		Update("used_at", gorm.Expr("now()"))
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	return tx.RowsAffected > 0, nil
+}
+
+func (r *recoveryCodeRepo) DeleteRecoveryCodesByUserID(ctx context.Context, userID uuid.UUID) error {
+	r.logger.Debug().Msg("delete recovery codes by user id")
+
+	tx := conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Delete(&entity.RecoveryCode{})
+	return tx.Error
+}
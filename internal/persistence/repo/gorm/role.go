@@ -0,0 +1,73 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+type roleRepo struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+type RoleRepoOption func(*roleRepo)
+
+func WithRoleRepoLogger(logger zerolog.Logger) RoleRepoOption {
+	return func(r *roleRepo) {
+		r.logger = logger
+	}
+}
+
+func NewRoleRepository(db *gorm.DB, opts ...RoleRepoOption) repo.RoleRepository {
+	r := &roleRepo{
+		db:     db,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *roleRepo) FindRoles(ctx context.Context) ([]*entity.RoleEntity, error) {
+	r.logger.Debug().Msg("find roles")
+
+	var roles []*entity.RoleEntity
+	err := conn(ctx, r.db).
+		Order("id ASC").
+		Find(&roles).
+		Error
+
+	if roles == nil {
+		roles = make([]*entity.RoleEntity, 0)
+	}
+
+	return roles, err
+}
+
+func (r *roleRepo) FindRoleByName(ctx context.Context, name string) (*entity.RoleEntity, error) {
+	r.logger.Debug().Msg("find role by name")
+
+	role := &entity.RoleEntity{}
+	tx := conn(ctx, r.db).
+		Where("name = ?", name).
+		First(role)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	return role, tx.Error
+}
+
+func (r *roleRepo) UpdateRole(ctx context.Context, role *entity.RoleEntity) (*entity.RoleEntity, error) {
+	r.logger.Debug().Msg("update role")
+
+	tx := conn(ctx, r.db).Save(role)
+	return role, tx.Error
+}
@@ -0,0 +1,106 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+type serviceAccountRepo struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+type ServiceAccountRepoOption func(*serviceAccountRepo)
+
+func WithServiceAccountRepoLogger(logger zerolog.Logger) ServiceAccountRepoOption {
+	return func(r *serviceAccountRepo) {
+		r.logger = logger
+	}
+}
+
+func NewServiceAccountRepository(db *gorm.DB, opts ...ServiceAccountRepoOption) repo.ServiceAccountRepository {
+	r := &serviceAccountRepo{
+		db:     db,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *serviceAccountRepo) CreateServiceAccount(
+	ctx context.Context,
+	serviceAccount *entity.ServiceAccount,
+) (*entity.ServiceAccount, error) {
+	r.logger.Debug().Msg("create service account")
+
+	tx := conn(ctx, r.db).Create(serviceAccount)
+	if errors.Is(tx.Error, gorm.ErrDuplicatedKey) {
+		return serviceAccount, repo.ErrDuplicateServiceAccount
+	}
+
+	return serviceAccount, tx.Error
+}
+
+func (r *serviceAccountRepo) FindServiceAccountsByOwnerID(
+	ctx context.Context,
+	ownerID uuid.UUID,
+) ([]*entity.ServiceAccount, error) {
+	r.logger.Debug().Msg("find service accounts by owner id")
+
+	var serviceAccounts []*entity.ServiceAccount
+	err := conn(ctx, r.db).
+		Where("owner_id = ?", ownerID).
+		Order("created_at DESC").
+		Find(&serviceAccounts).
+		Error
+
+	if serviceAccounts == nil {
+		serviceAccounts = make([]*entity.ServiceAccount, 0)
+	}
+
+	return serviceAccounts, err
+}
+
+func (r *serviceAccountRepo) FindServiceAccountByID(
+	ctx context.Context,
+	ownerID uuid.UUID,
+	id uuid.UUID,
+) (*entity.ServiceAccount, error) {
+	r.logger.Debug().Msg("find service account by id")
+
+	serviceAccount := &entity.ServiceAccount{}
+	tx := conn(ctx, r.db).
+		Where("id = ?", id).
+		Where("owner_id = ?", ownerID).
+		First(serviceAccount)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	return serviceAccount, tx.Error
+}
+
+func (r *serviceAccountRepo) DeleteServiceAccount(ctx context.Context, ownerID uuid.UUID, id uuid.UUID) (bool, error) {
+	r.logger.Debug().Msg("delete service account")
+
+	// API keys of service account are deleted by cascade
+	tx := conn(ctx, r.db).
+		Where("id = ?", id).
+		Where("owner_id = ?", ownerID).
+		Delete(&entity.ServiceAccount{})
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	return tx.RowsAffected > 0, nil
+}
@@ -0,0 +1,122 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+type sessionRepo struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+type SessionRepoOption func(*sessionRepo)
+
+func WithSessionRepoLogger(logger zerolog.Logger) SessionRepoOption {
+	return func(r *sessionRepo) {
+		r.logger = logger
+	}
+}
+
+func NewSessionRepository(db *gorm.DB, opts ...SessionRepoOption) repo.SessionRepository {
+	r := &sessionRepo{
+		db:     db,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *sessionRepo) CreateSession(ctx context.Context, session *entity.Session) (*entity.Session, error) {
+	r.logger.Debug().Msg("create session")
+
+	tx := conn(ctx, r.db).Create(session)
+	if errors.Is(tx.Error, gorm.ErrForeignKeyViolated) {
+		return session, repo.ErrUserForSessionNotExist
+	}
+
+	return session, tx.Error
+}
+
+func (r *sessionRepo) RotateSession(ctx context.Context, session *entity.Session, oldJTI string) (bool, error) {
+	r.logger.Debug().Msg("rotate session")
+
+	// Update only if session has not been rotated concurrently
+	tx := conn(ctx, r.db).
+		Model(session).
+		Where("jti = ?", oldJTI).
+		Select(
+			"jti", "previous_jti", "device_name", "ip", "user_agent", "location",
+			"last_used_at", "rotated_at", "expired_at",
+		).
+		Updates(session)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	return tx.RowsAffected > 0, nil
+}
+
+func (r *sessionRepo) FindSessionByID(ctx context.Context, id uuid.UUID) (*entity.Session, error) {
+	r.logger.Debug().Msg("find session by id")
+
+	session := &entity.Session{}
+	tx := conn(ctx, r.db).
+		Scopes(notExpiredSessions).
+		Where("id = ?", id).
+		First(session)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	return session, tx.Error
+}
+
+func (r *sessionRepo) FindSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	r.logger.Debug().Msg("find sessions by user id")
+
+	var sessions []*entity.Session
+	err := conn(ctx, r.db).
+		Scopes(notExpiredSessions).
+		Where("user_id = ?", userID).
+		Order("last_used_at DESC").
+		Find(&sessions).
+		Error
+
+	if sessions == nil {
+		sessions = make([]*entity.Session, 0)
+	}
+
+	return sessions, err
+}
+
+func (r *sessionRepo) DeleteSessionByID(ctx context.Context, id uuid.UUID) error {
+	r.logger.Debug().Msg("delete session by id")
+
+	tx := conn(ctx, r.db).
+		Where("id = ?", id).
+		Delete(&entity.Session{})
+	return tx.Error
+}
+
+func (r *sessionRepo) DeleteExpiredSessions(ctx context.Context) error {
+	r.logger.Debug().Msg("delete expired sessions")
+
+	tx := conn(ctx, r.db).
+		Where("expired_at < now()").
+		Delete(&entity.Session{})
+	return tx.Error
+}
+
+func notExpiredSessions(db *gorm.DB) *gorm.DB {
+	return db.Where("expired_at >= now()")
+}
@@ -0,0 +1,92 @@
+package gorm
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+	"time"
+)
+
+type signingKeyRepo struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+type SigningKeyRepoOption func(*signingKeyRepo)
+
+func WithSigningKeyRepoLogger(logger zerolog.Logger) SigningKeyRepoOption {
+	return func(r *signingKeyRepo) {
+		r.logger = logger
+	}
+}
+
+func NewSigningKeyRepository(db *gorm.DB, opts ...SigningKeyRepoOption) repo.SigningKeyRepository {
+	r := &signingKeyRepo{
+		db:     db,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *signingKeyRepo) CreateSigningKey(
+	ctx context.Context,
+	signingKey *entity.SigningKey,
+) (*entity.SigningKey, error) {
+	r.logger.Debug().Msg("create signing key")
+
+	tx := conn(ctx, r.db).Create(signingKey)
+
+	return signingKey, tx.Error
+}
+
+func (r *signingKeyRepo) LockSigningKeys(ctx context.Context) error {
+	r.logger.Debug().Msg("lock signing keys")
+
+	// Advisory lock is released at the end of transaction
+	tx := conn(ctx, r.db).Exec("SELECT pg_advisory_xact_lock(hashtext(?))", (&entity.SigningKey{}).TableName())
+	return tx.Error
+}
+
+func (r *signingKeyRepo) FindSigningKeys(ctx context.Context) ([]*entity.SigningKey, error) {
+	r.logger.Debug().Msg("find signing keys")
+
+	var signingKeys []*entity.SigningKey
+	err := conn(ctx, r.db).
+		Where("expired_at IS NULL OR expired_at >= now()").
+		Order("created_at DESC").
+		Find(&signingKeys).
+		Error
+
+	if signingKeys == nil {
+		signingKeys = make([]*entity.SigningKey, 0)
+	}
+
+	return signingKeys, err
+}
+
+func (r *signingKeyRepo) ExpireSigningKeys(ctx context.Context, exceptID string, expiredAt time.Time) error {
+	r.logger.Debug().Msg("expire signing keys")
+
+	tx := conn(ctx, r.db).
+		Model(&entity.SigningKey{}).
+		Where("id <> ?", exceptID).
+		Where("expired_at IS NULL").
+		Update("expired_at", expiredAt)
+	return tx.Error
+}
+
+func (r *signingKeyRepo) DeleteExpiredSigningKeys(ctx context.Context) error {
+	r.logger.Debug().Msg("delete expired signing keys")
+
+	tx := conn(ctx, r.db).
+		Where("expired_at < now()").
+		Delete(&entity.SigningKey{})
+	return tx.Error
+}
@@ -0,0 +1,35 @@
+package gorm
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"gorm.io/gorm"
+)
+
+type txContextKey struct{}
+
+type transactor struct {
+	db *gorm.DB
+}
+
+func NewTransactor(db *gorm.DB) repo.Transactor {
+	return &transactor{db: db}
+}
+
+func (t *transactor) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Nested transaction is run in savepoint of outer one
+	return conn(ctx, t.db).Transaction(
+		func(tx *gorm.DB) error {
+			return fn(context.WithValue(ctx, txContextKey{}, tx))
+		},
+	)
+}
+
+// conn returns transaction started by transactor, if context contains it, otherwise database
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+
+	return db.WithContext(ctx)
+}
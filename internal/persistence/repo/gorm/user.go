@@ -39,7 +39,7 @@ func NewUserRepository(db *gorm.DB, opts ...UserRepoOption) repo.UserRepository
 func (r *userRepo) CreateUser(ctx context.Context, user *entity.User) (*entity.User, error) {
 	r.logger.Debug().Msg("create user")
 
-	tx := r.db.WithContext(ctx).Create(user)
+	tx := conn(ctx, r.db).Create(user)
 	if errors.Is(tx.Error, gorm.ErrDuplicatedKey) {
 		return user, repo.ErrDuplicateUser
 	}
@@ -50,7 +50,7 @@ func (r *userRepo) CreateUser(ctx context.Context, user *entity.User) (*entity.U
 func (r *userRepo) UpdateUser(ctx context.Context, user *entity.User) (*entity.User, error) {
 	r.logger.Debug().Msg("update user")
 
-	tx := r.db.WithContext(ctx).Save(user)
+	tx := conn(ctx, r.db).Save(user)
 	if errors.Is(tx.Error, gorm.ErrDuplicatedKey) {
 		return user, repo.ErrDuplicateUser
 	}
@@ -61,7 +61,7 @@ func (r *userRepo) UpdateUser(ctx context.Context, user *entity.User) (*entity.U
 func (r *userRepo) FindUserByID(ctx context.Context, id uuid.UUID, scopes ...repo.Scope) (*entity.User, error) {
 	r.logger.Debug().Msg("find user by id")
 
-	tx := r.db.WithContext(ctx)
+	tx := conn(ctx, r.db)
 
 	for _, option := range scopes {
 		tx = tx.Scopes(option)
@@ -84,7 +84,7 @@ func (r *userRepo) FindUserByUsername(ctx context.Context, username string, scop
 ) {
 	r.logger.Debug().Msg("find user by username")
 
-	tx := r.db.WithContext(ctx)
+	tx := conn(ctx, r.db)
 
 	for _, option := range scopes {
 		tx = tx.Scopes(option)
@@ -104,7 +104,7 @@ func (r *userRepo) FindUserByUsername(ctx context.Context, username string, scop
 func (r *userRepo) FindUserByEmail(ctx context.Context, email string, scopes ...repo.Scope) (*entity.User, error) {
 	r.logger.Debug().Msg("find user by email")
 
-	tx := r.db.WithContext(ctx)
+	tx := conn(ctx, r.db)
 
 	for _, option := range scopes {
 		tx = tx.Scopes(option)
@@ -127,7 +127,7 @@ func (r *userRepo) FindUserByUsernameOrEmail(ctx context.Context, login string,
 ) {
 	r.logger.Debug().Msg("find user by username or email")
 
-	tx := r.db.WithContext(ctx)
+	tx := conn(ctx, r.db)
 
 	for _, option := range scopes {
 		tx = tx.Scopes(option)
@@ -145,11 +145,31 @@ func (r *userRepo) FindUserByUsernameOrEmail(ctx context.Context, login string,
 	return user, tx.Error
 }
 
+func (r *userRepo) FindUserByPhone(ctx context.Context, phone string, scopes ...repo.Scope) (*entity.User, error) {
+	r.logger.Debug().Msg("find user by phone")
+
+	tx := conn(ctx, r.db)
+
+	for _, option := range scopes {
+		tx = tx.Scopes(option)
+	}
+
+	user := &entity.User{}
+	tx = tx.Scopes(notDeletedUsers).
+		Where("phone = ?", phone).
+		First(user)
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	return user, tx.Error
+}
+
 func (r *userRepo) ExistsUserByID(ctx context.Context, id uuid.UUID) (bool, error) {
 	r.logger.Debug().Msg("exists user by id")
 
 	var exists bool
-	tx := r.db.WithContext(ctx).
+	tx := conn(ctx, r.db).
 		Model(&entity.User{}).
 		Scopes(notDeletedUsers).
 		Select("count(*) > 0").
@@ -162,7 +182,7 @@ func (r *userRepo) ExistsUserByUsername(ctx context.Context, username string) (b
 	r.logger.Debug().Msg("exists user by username")
 
 	var exists bool
-	tx := r.db.WithContext(ctx).
+	tx := conn(ctx, r.db).
 		Model(&entity.User{}).
 		Scopes(notDeletedUsers).
 		Select("count(*) > 0").
@@ -175,7 +195,7 @@ func (r *userRepo) ExistsUserByEmail(ctx context.Context, email string) (bool, e
 	r.logger.Debug().Msg("exists user by email")
 
 	var exists bool
-	tx := r.db.WithContext(ctx).
+	tx := conn(ctx, r.db).
 		Model(&entity.User{}).
 		Scopes(notDeletedUsers).
 		Select("count(*) > 0").
@@ -188,7 +208,7 @@ func (r *userRepo) ExistsUserByUsernameOrEmail(ctx context.Context, username str
 	r.logger.Debug().Msg("exists user by username or email")
 
 	var exists bool
-	tx := r.db.WithContext(ctx).
+	tx := conn(ctx, r.db).
 		Model(&entity.User{}).
 		Scopes(notDeletedUsers).
 		Select("count(*) > 0").
@@ -198,11 +218,40 @@ func (r *userRepo) ExistsUserByUsernameOrEmail(ctx context.Context, username str
 	return exists, tx.Error
 }
 
+func (r *userRepo) ExistsUserByPhone(ctx context.Context, phone string) (bool, error) {
+	r.logger.Debug().Msg("exists user by phone")
+
+	var exists bool
+	tx := conn(ctx, r.db).
+		Model(&entity.User{}).
+		Scopes(notDeletedUsers).
+		Select("count(*) > 0").
+		Where("phone = ?", phone).
+		Find(&exists)
+	return exists, tx.Error
+}
+
+func (r *userRepo) UpdateTOTPLastUsedStep(ctx context.Context, id uuid.UUID, step int64) (bool, error) {
+	r.logger.Debug().Msg("update totp last used step")
+
+	// Update only if step has not been used yet, so that one code cannot be used twice
+	tx := conn(ctx, r.db).
+		Model(&entity.User{}).
+		Where("id = ?", id).
+		Where("totp_last_used_step IS NULL OR totp_last_used_step < ?", step).
+		Update("totp_last_used_step", step)
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	return tx.RowsAffected > 0, nil
+}
+
 func (r *userRepo) DeleteExpiredUser(ctx context.Context) (*entity.User, error) {
 	r.logger.Debug().Msg("delete expired user")
 
 	user := &entity.User{}
-	tx := r.db.WithContext(ctx).
+	tx := conn(ctx, r.db).
 		Scopes(deletedUsers).
 		Delete(user)
 	return user, tx.Error
@@ -0,0 +1,105 @@
+package gorm
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/rs/zerolog"
+	"gorm.io/gorm"
+)
+
+type userIdentityRepo struct {
+	db     *gorm.DB
+	logger zerolog.Logger
+}
+
+type UserIdentityRepoOption func(*userIdentityRepo)
+
+func WithUserIdentityRepoLogger(logger zerolog.Logger) UserIdentityRepoOption {
+	return func(r *userIdentityRepo) {
+		r.logger = logger
+	}
+}
+
+func NewUserIdentityRepository(db *gorm.DB, opts ...UserIdentityRepoOption) repo.UserIdentityRepository {
+	r := &userIdentityRepo{
+		db:     db,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+func (r *userIdentityRepo) CreateUserIdentity(
+	ctx context.Context,
+	identity *entity.UserIdentity,
+) (*entity.UserIdentity, error) {
+	r.logger.Debug().Msg("create user identity")
+
+	tx := conn(ctx, r.db).Create(identity)
+	if errors.Is(tx.Error, gorm.ErrDuplicatedKey) {
+		return identity, repo.ErrDuplicateUserIdentity
+	}
+
+	return identity, tx.Error
+}
+
+func (r *userIdentityRepo) FindUserIdentityByProviderAndSubject(
+	ctx context.Context,
+	provider string,
+	subject string,
+) (*entity.UserIdentity, error) {
+	r.logger.Debug().Msg("find user identity by provider and subject")
+
+	identity := &entity.UserIdentity{}
+	tx := conn(ctx, r.db).
+		Where("provider = ?", provider).
+		Where("subject = ?", subject).
+		First(identity)
+
+	if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+
+	return identity, tx.Error
+}
+
+func (r *userIdentityRepo) FindUserIdentitiesByUserID(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]*entity.UserIdentity, error) {
+	r.logger.Debug().Msg("find user identities by user id")
+
+	var identities []*entity.UserIdentity
+	err := conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("created_at ASC").
+		Find(&identities).
+		Error
+
+	if identities == nil {
+		identities = make([]*entity.UserIdentity, 0)
+	}
+
+	return identities, err
+}
+
+func (r *userIdentityRepo) DeleteUserIdentity(ctx context.Context, userID uuid.UUID, provider string) (bool, error) {
+	r.logger.Debug().Msg("delete user identity")
+
+	tx := conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Where("provider = ?", provider).
+		Delete(&entity.UserIdentity{})
+	if tx.Error != nil {
+		return false, tx.Error
+	}
+
+	return tx.RowsAffected > 0, nil
+}
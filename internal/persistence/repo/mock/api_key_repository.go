@@ -0,0 +1,442 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	entity "github.com/mandarine-io/backend/internal/persistence/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// APIKeyRepositoryMock is an autogenerated mock type for the APIKeyRepository type
+type APIKeyRepositoryMock struct {
+	mock.Mock
+}
+
+type APIKeyRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *APIKeyRepositoryMock) EXPECT() *APIKeyRepositoryMock_Expecter {
+	return &APIKeyRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateAPIKey provides a mock function with given fields: ctx, apiKey
+func (_m *APIKeyRepositoryMock) CreateAPIKey(ctx context.Context, apiKey *entity.APIKey) (*entity.APIKey, error) {
+	ret := _m.Called(ctx, apiKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 *entity.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.APIKey) (*entity.APIKey, error)); ok {
+		return rf(ctx, apiKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.APIKey) *entity.APIKey); ok {
+		r0 = rf(ctx, apiKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.APIKey) error); ok {
+		r1 = rf(ctx, apiKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyRepositoryMock_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type APIKeyRepositoryMock_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKey *entity.APIKey
+func (_e *APIKeyRepositoryMock_Expecter) CreateAPIKey(ctx interface{}, apiKey interface{}) *APIKeyRepositoryMock_CreateAPIKey_Call {
+	return &APIKeyRepositoryMock_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", ctx, apiKey)}
+}
+
+func (_c *APIKeyRepositoryMock_CreateAPIKey_Call) Run(run func(ctx context.Context, apiKey *entity.APIKey)) *APIKeyRepositoryMock_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.APIKey))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_CreateAPIKey_Call) Return(_a0 *entity.APIKey, _a1 error) *APIKeyRepositoryMock_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_CreateAPIKey_Call) RunAndReturn(run func(context.Context, *entity.APIKey) (*entity.APIKey, error)) *APIKeyRepositoryMock_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAPIKeyByHash provides a mock function with given fields: ctx, keyHash
+func (_m *APIKeyRepositoryMock) FindAPIKeyByHash(ctx context.Context, keyHash string) (*entity.APIKey, error) {
+	ret := _m.Called(ctx, keyHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAPIKeyByHash")
+	}
+
+	var r0 *entity.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.APIKey, error)); ok {
+		return rf(ctx, keyHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.APIKey); ok {
+		r0 = rf(ctx, keyHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, keyHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyRepositoryMock_FindAPIKeyByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAPIKeyByHash'
+type APIKeyRepositoryMock_FindAPIKeyByHash_Call struct {
+	*mock.Call
+}
+
+// FindAPIKeyByHash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - keyHash string
+func (_e *APIKeyRepositoryMock_Expecter) FindAPIKeyByHash(ctx interface{}, keyHash interface{}) *APIKeyRepositoryMock_FindAPIKeyByHash_Call {
+	return &APIKeyRepositoryMock_FindAPIKeyByHash_Call{Call: _e.mock.On("FindAPIKeyByHash", ctx, keyHash)}
+}
+
+func (_c *APIKeyRepositoryMock_FindAPIKeyByHash_Call) Run(run func(ctx context.Context, keyHash string)) *APIKeyRepositoryMock_FindAPIKeyByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_FindAPIKeyByHash_Call) Return(_a0 *entity.APIKey, _a1 error) *APIKeyRepositoryMock_FindAPIKeyByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_FindAPIKeyByHash_Call) RunAndReturn(run func(context.Context, string) (*entity.APIKey, error)) *APIKeyRepositoryMock_FindAPIKeyByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAPIKeyByID provides a mock function with given fields: ctx, serviceAccountID, id
+func (_m *APIKeyRepositoryMock) FindAPIKeyByID(ctx context.Context, serviceAccountID uuid.UUID, id uuid.UUID) (*entity.APIKey, error) {
+	ret := _m.Called(ctx, serviceAccountID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAPIKeyByID")
+	}
+
+	var r0 *entity.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*entity.APIKey, error)); ok {
+		return rf(ctx, serviceAccountID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *entity.APIKey); ok {
+		r0 = rf(ctx, serviceAccountID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, serviceAccountID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyRepositoryMock_FindAPIKeyByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAPIKeyByID'
+type APIKeyRepositoryMock_FindAPIKeyByID_Call struct {
+	*mock.Call
+}
+
+// FindAPIKeyByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccountID uuid.UUID
+//   - id uuid.UUID
+func (_e *APIKeyRepositoryMock_Expecter) FindAPIKeyByID(ctx interface{}, serviceAccountID interface{}, id interface{}) *APIKeyRepositoryMock_FindAPIKeyByID_Call {
+	return &APIKeyRepositoryMock_FindAPIKeyByID_Call{Call: _e.mock.On("FindAPIKeyByID", ctx, serviceAccountID, id)}
+}
+
+func (_c *APIKeyRepositoryMock_FindAPIKeyByID_Call) Run(run func(ctx context.Context, serviceAccountID uuid.UUID, id uuid.UUID)) *APIKeyRepositoryMock_FindAPIKeyByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_FindAPIKeyByID_Call) Return(_a0 *entity.APIKey, _a1 error) *APIKeyRepositoryMock_FindAPIKeyByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_FindAPIKeyByID_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*entity.APIKey, error)) *APIKeyRepositoryMock_FindAPIKeyByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAPIKeysByServiceAccountID provides a mock function with given fields: ctx, serviceAccountID
+func (_m *APIKeyRepositoryMock) FindAPIKeysByServiceAccountID(ctx context.Context, serviceAccountID uuid.UUID) ([]*entity.APIKey, error) {
+	ret := _m.Called(ctx, serviceAccountID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAPIKeysByServiceAccountID")
+	}
+
+	var r0 []*entity.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.APIKey, error)); ok {
+		return rf(ctx, serviceAccountID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.APIKey); ok {
+		r0 = rf(ctx, serviceAccountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, serviceAccountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyRepositoryMock_FindAPIKeysByServiceAccountID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAPIKeysByServiceAccountID'
+type APIKeyRepositoryMock_FindAPIKeysByServiceAccountID_Call struct {
+	*mock.Call
+}
+
+// FindAPIKeysByServiceAccountID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccountID uuid.UUID
+func (_e *APIKeyRepositoryMock_Expecter) FindAPIKeysByServiceAccountID(ctx interface{}, serviceAccountID interface{}) *APIKeyRepositoryMock_FindAPIKeysByServiceAccountID_Call {
+	return &APIKeyRepositoryMock_FindAPIKeysByServiceAccountID_Call{Call: _e.mock.On("FindAPIKeysByServiceAccountID", ctx, serviceAccountID)}
+}
+
+func (_c *APIKeyRepositoryMock_FindAPIKeysByServiceAccountID_Call) Run(run func(ctx context.Context, serviceAccountID uuid.UUID)) *APIKeyRepositoryMock_FindAPIKeysByServiceAccountID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_FindAPIKeysByServiceAccountID_Call) Return(_a0 []*entity.APIKey, _a1 error) *APIKeyRepositoryMock_FindAPIKeysByServiceAccountID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_FindAPIKeysByServiceAccountID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.APIKey, error)) *APIKeyRepositoryMock_FindAPIKeysByServiceAccountID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function with given fields: ctx, serviceAccountID, id
+func (_m *APIKeyRepositoryMock) RevokeAPIKey(ctx context.Context, serviceAccountID uuid.UUID, id uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, serviceAccountID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, serviceAccountID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, serviceAccountID, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, serviceAccountID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyRepositoryMock_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type APIKeyRepositoryMock_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccountID uuid.UUID
+//   - id uuid.UUID
+func (_e *APIKeyRepositoryMock_Expecter) RevokeAPIKey(ctx interface{}, serviceAccountID interface{}, id interface{}) *APIKeyRepositoryMock_RevokeAPIKey_Call {
+	return &APIKeyRepositoryMock_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", ctx, serviceAccountID, id)}
+}
+
+func (_c *APIKeyRepositoryMock_RevokeAPIKey_Call) Run(run func(ctx context.Context, serviceAccountID uuid.UUID, id uuid.UUID)) *APIKeyRepositoryMock_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_RevokeAPIKey_Call) Return(_a0 bool, _a1 error) *APIKeyRepositoryMock_RevokeAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_RevokeAPIKey_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *APIKeyRepositoryMock_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateAPIKey provides a mock function with given fields: ctx, oldAPIKey, newAPIKey
+func (_m *APIKeyRepositoryMock) RotateAPIKey(ctx context.Context, oldAPIKey *entity.APIKey, newAPIKey *entity.APIKey) (*entity.APIKey, error) {
+	ret := _m.Called(ctx, oldAPIKey, newAPIKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateAPIKey")
+	}
+
+	var r0 *entity.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.APIKey, *entity.APIKey) (*entity.APIKey, error)); ok {
+		return rf(ctx, oldAPIKey, newAPIKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.APIKey, *entity.APIKey) *entity.APIKey); ok {
+		r0 = rf(ctx, oldAPIKey, newAPIKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.APIKey, *entity.APIKey) error); ok {
+		r1 = rf(ctx, oldAPIKey, newAPIKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyRepositoryMock_RotateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateAPIKey'
+type APIKeyRepositoryMock_RotateAPIKey_Call struct {
+	*mock.Call
+}
+
+// RotateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldAPIKey *entity.APIKey
+//   - newAPIKey *entity.APIKey
+func (_e *APIKeyRepositoryMock_Expecter) RotateAPIKey(ctx interface{}, oldAPIKey interface{}, newAPIKey interface{}) *APIKeyRepositoryMock_RotateAPIKey_Call {
+	return &APIKeyRepositoryMock_RotateAPIKey_Call{Call: _e.mock.On("RotateAPIKey", ctx, oldAPIKey, newAPIKey)}
+}
+
+func (_c *APIKeyRepositoryMock_RotateAPIKey_Call) Run(run func(ctx context.Context, oldAPIKey *entity.APIKey, newAPIKey *entity.APIKey)) *APIKeyRepositoryMock_RotateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.APIKey), args[2].(*entity.APIKey))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_RotateAPIKey_Call) Return(_a0 *entity.APIKey, _a1 error) *APIKeyRepositoryMock_RotateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_RotateAPIKey_Call) RunAndReturn(run func(context.Context, *entity.APIKey, *entity.APIKey) (*entity.APIKey, error)) *APIKeyRepositoryMock_RotateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TrackAPIKeyUsage provides a mock function with given fields: ctx, id, ip
+func (_m *APIKeyRepositoryMock) TrackAPIKeyUsage(ctx context.Context, id uuid.UUID, ip string) error {
+	ret := _m.Called(ctx, id, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrackAPIKeyUsage")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, id, ip)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// APIKeyRepositoryMock_TrackAPIKeyUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrackAPIKeyUsage'
+type APIKeyRepositoryMock_TrackAPIKeyUsage_Call struct {
+	*mock.Call
+}
+
+// TrackAPIKeyUsage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - ip string
+func (_e *APIKeyRepositoryMock_Expecter) TrackAPIKeyUsage(ctx interface{}, id interface{}, ip interface{}) *APIKeyRepositoryMock_TrackAPIKeyUsage_Call {
+	return &APIKeyRepositoryMock_TrackAPIKeyUsage_Call{Call: _e.mock.On("TrackAPIKeyUsage", ctx, id, ip)}
+}
+
+func (_c *APIKeyRepositoryMock_TrackAPIKeyUsage_Call) Run(run func(ctx context.Context, id uuid.UUID, ip string)) *APIKeyRepositoryMock_TrackAPIKeyUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_TrackAPIKeyUsage_Call) Return(_a0 error) *APIKeyRepositoryMock_TrackAPIKeyUsage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *APIKeyRepositoryMock_TrackAPIKeyUsage_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *APIKeyRepositoryMock_TrackAPIKeyUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAPIKeyRepositoryMock creates a new instance of APIKeyRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyRepositoryMock {
+	mock := &APIKeyRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
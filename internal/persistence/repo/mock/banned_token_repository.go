@@ -0,0 +1,199 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	entity "github.com/mandarine-io/backend/internal/persistence/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BannedTokenRepositoryMock is an autogenerated mock type for the BannedTokenRepository type
+type BannedTokenRepositoryMock struct {
+	mock.Mock
+}
+
+type BannedTokenRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BannedTokenRepositoryMock) EXPECT() *BannedTokenRepositoryMock_Expecter {
+	return &BannedTokenRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateBannedToken provides a mock function with given fields: ctx, bannedToken
+func (_m *BannedTokenRepositoryMock) CreateBannedToken(ctx context.Context, bannedToken *entity.BannedToken) (*entity.BannedToken, error) {
+	ret := _m.Called(ctx, bannedToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBannedToken")
+	}
+
+	var r0 *entity.BannedToken
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.BannedToken) (*entity.BannedToken, error)); ok {
+		return rf(ctx, bannedToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.BannedToken) *entity.BannedToken); ok {
+		r0 = rf(ctx, bannedToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.BannedToken)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.BannedToken) error); ok {
+		r1 = rf(ctx, bannedToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BannedTokenRepositoryMock_CreateBannedToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBannedToken'
+type BannedTokenRepositoryMock_CreateBannedToken_Call struct {
+	*mock.Call
+}
+
+// CreateBannedToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - bannedToken *entity.BannedToken
+func (_e *BannedTokenRepositoryMock_Expecter) CreateBannedToken(ctx interface{}, bannedToken interface{}) *BannedTokenRepositoryMock_CreateBannedToken_Call {
+	return &BannedTokenRepositoryMock_CreateBannedToken_Call{Call: _e.mock.On("CreateBannedToken", ctx, bannedToken)}
+}
+
+func (_c *BannedTokenRepositoryMock_CreateBannedToken_Call) Run(run func(ctx context.Context, bannedToken *entity.BannedToken)) *BannedTokenRepositoryMock_CreateBannedToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.BannedToken))
+	})
+	return _c
+}
+
+func (_c *BannedTokenRepositoryMock_CreateBannedToken_Call) Return(_a0 *entity.BannedToken, _a1 error) *BannedTokenRepositoryMock_CreateBannedToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BannedTokenRepositoryMock_CreateBannedToken_Call) RunAndReturn(run func(context.Context, *entity.BannedToken) (*entity.BannedToken, error)) *BannedTokenRepositoryMock_CreateBannedToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredBannedTokens provides a mock function with given fields: ctx
+func (_m *BannedTokenRepositoryMock) DeleteExpiredBannedTokens(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredBannedTokens")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BannedTokenRepositoryMock_DeleteExpiredBannedTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredBannedTokens'
+type BannedTokenRepositoryMock_DeleteExpiredBannedTokens_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredBannedTokens is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *BannedTokenRepositoryMock_Expecter) DeleteExpiredBannedTokens(ctx interface{}) *BannedTokenRepositoryMock_DeleteExpiredBannedTokens_Call {
+	return &BannedTokenRepositoryMock_DeleteExpiredBannedTokens_Call{Call: _e.mock.On("DeleteExpiredBannedTokens", ctx)}
+}
+
+func (_c *BannedTokenRepositoryMock_DeleteExpiredBannedTokens_Call) Run(run func(ctx context.Context)) *BannedTokenRepositoryMock_DeleteExpiredBannedTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *BannedTokenRepositoryMock_DeleteExpiredBannedTokens_Call) Return(_a0 error) *BannedTokenRepositoryMock_DeleteExpiredBannedTokens_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BannedTokenRepositoryMock_DeleteExpiredBannedTokens_Call) RunAndReturn(run func(context.Context) error) *BannedTokenRepositoryMock_DeleteExpiredBannedTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExistsBannedTokenByJTI provides a mock function with given fields: ctx, jti
+func (_m *BannedTokenRepositoryMock) ExistsBannedTokenByJTI(ctx context.Context, jti string) (bool, error) {
+	ret := _m.Called(ctx, jti)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistsBannedTokenByJTI")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, jti)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, jti)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, jti)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BannedTokenRepositoryMock_ExistsBannedTokenByJTI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistsBannedTokenByJTI'
+type BannedTokenRepositoryMock_ExistsBannedTokenByJTI_Call struct {
+	*mock.Call
+}
+
+// ExistsBannedTokenByJTI is a helper method to define mock.On call
+//   - ctx context.Context
+//   - jti string
+func (_e *BannedTokenRepositoryMock_Expecter) ExistsBannedTokenByJTI(ctx interface{}, jti interface{}) *BannedTokenRepositoryMock_ExistsBannedTokenByJTI_Call {
+	return &BannedTokenRepositoryMock_ExistsBannedTokenByJTI_Call{Call: _e.mock.On("ExistsBannedTokenByJTI", ctx, jti)}
+}
+
+func (_c *BannedTokenRepositoryMock_ExistsBannedTokenByJTI_Call) Run(run func(ctx context.Context, jti string)) *BannedTokenRepositoryMock_ExistsBannedTokenByJTI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *BannedTokenRepositoryMock_ExistsBannedTokenByJTI_Call) Return(_a0 bool, _a1 error) *BannedTokenRepositoryMock_ExistsBannedTokenByJTI_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BannedTokenRepositoryMock_ExistsBannedTokenByJTI_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *BannedTokenRepositoryMock_ExistsBannedTokenByJTI_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBannedTokenRepositoryMock creates a new instance of BannedTokenRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBannedTokenRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BannedTokenRepositoryMock {
+	mock := &BannedTokenRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
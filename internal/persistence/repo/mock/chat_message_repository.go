@@ -0,0 +1,279 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	entity "github.com/mandarine-io/backend/internal/persistence/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	repo "github.com/mandarine-io/backend/internal/persistence/repo"
+
+	uuid "github.com/google/uuid"
+)
+
+// ChatMessageRepositoryMock is an autogenerated mock type for the ChatMessageRepository type
+type ChatMessageRepositoryMock struct {
+	mock.Mock
+}
+
+type ChatMessageRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ChatMessageRepositoryMock) EXPECT() *ChatMessageRepositoryMock_Expecter {
+	return &ChatMessageRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CountUnreadChatMessages provides a mock function with given fields: ctx, conversation, userID
+func (_m *ChatMessageRepositoryMock) CountUnreadChatMessages(ctx context.Context, conversation *entity.Conversation, userID uuid.UUID) (int64, error) {
+	ret := _m.Called(ctx, conversation, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountUnreadChatMessages")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Conversation, uuid.UUID) (int64, error)); ok {
+		return rf(ctx, conversation, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Conversation, uuid.UUID) int64); ok {
+		r0 = rf(ctx, conversation, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Conversation, uuid.UUID) error); ok {
+		r1 = rf(ctx, conversation, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChatMessageRepositoryMock_CountUnreadChatMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountUnreadChatMessages'
+type ChatMessageRepositoryMock_CountUnreadChatMessages_Call struct {
+	*mock.Call
+}
+
+// CountUnreadChatMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversation *entity.Conversation
+//   - userID uuid.UUID
+func (_e *ChatMessageRepositoryMock_Expecter) CountUnreadChatMessages(ctx interface{}, conversation interface{}, userID interface{}) *ChatMessageRepositoryMock_CountUnreadChatMessages_Call {
+	return &ChatMessageRepositoryMock_CountUnreadChatMessages_Call{Call: _e.mock.On("CountUnreadChatMessages", ctx, conversation, userID)}
+}
+
+func (_c *ChatMessageRepositoryMock_CountUnreadChatMessages_Call) Run(run func(ctx context.Context, conversation *entity.Conversation, userID uuid.UUID)) *ChatMessageRepositoryMock_CountUnreadChatMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Conversation), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ChatMessageRepositoryMock_CountUnreadChatMessages_Call) Return(_a0 int64, _a1 error) *ChatMessageRepositoryMock_CountUnreadChatMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChatMessageRepositoryMock_CountUnreadChatMessages_Call) RunAndReturn(run func(context.Context, *entity.Conversation, uuid.UUID) (int64, error)) *ChatMessageRepositoryMock_CountUnreadChatMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateChatMessage provides a mock function with given fields: ctx, message
+func (_m *ChatMessageRepositoryMock) CreateChatMessage(ctx context.Context, message *entity.ChatMessage) (*entity.ChatMessage, error) {
+	ret := _m.Called(ctx, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChatMessage")
+	}
+
+	var r0 *entity.ChatMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ChatMessage) (*entity.ChatMessage, error)); ok {
+		return rf(ctx, message)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ChatMessage) *entity.ChatMessage); ok {
+		r0 = rf(ctx, message)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ChatMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.ChatMessage) error); ok {
+		r1 = rf(ctx, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChatMessageRepositoryMock_CreateChatMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateChatMessage'
+type ChatMessageRepositoryMock_CreateChatMessage_Call struct {
+	*mock.Call
+}
+
+// CreateChatMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - message *entity.ChatMessage
+func (_e *ChatMessageRepositoryMock_Expecter) CreateChatMessage(ctx interface{}, message interface{}) *ChatMessageRepositoryMock_CreateChatMessage_Call {
+	return &ChatMessageRepositoryMock_CreateChatMessage_Call{Call: _e.mock.On("CreateChatMessage", ctx, message)}
+}
+
+func (_c *ChatMessageRepositoryMock_CreateChatMessage_Call) Run(run func(ctx context.Context, message *entity.ChatMessage)) *ChatMessageRepositoryMock_CreateChatMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.ChatMessage))
+	})
+	return _c
+}
+
+func (_c *ChatMessageRepositoryMock_CreateChatMessage_Call) Return(_a0 *entity.ChatMessage, _a1 error) *ChatMessageRepositoryMock_CreateChatMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChatMessageRepositoryMock_CreateChatMessage_Call) RunAndReturn(run func(context.Context, *entity.ChatMessage) (*entity.ChatMessage, error)) *ChatMessageRepositoryMock_CreateChatMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindChatMessageByID provides a mock function with given fields: ctx, conversationID, id
+func (_m *ChatMessageRepositoryMock) FindChatMessageByID(ctx context.Context, conversationID uuid.UUID, id uuid.UUID) (*entity.ChatMessage, error) {
+	ret := _m.Called(ctx, conversationID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindChatMessageByID")
+	}
+
+	var r0 *entity.ChatMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*entity.ChatMessage, error)); ok {
+		return rf(ctx, conversationID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *entity.ChatMessage); ok {
+		r0 = rf(ctx, conversationID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ChatMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, conversationID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChatMessageRepositoryMock_FindChatMessageByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindChatMessageByID'
+type ChatMessageRepositoryMock_FindChatMessageByID_Call struct {
+	*mock.Call
+}
+
+// FindChatMessageByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+//   - id uuid.UUID
+func (_e *ChatMessageRepositoryMock_Expecter) FindChatMessageByID(ctx interface{}, conversationID interface{}, id interface{}) *ChatMessageRepositoryMock_FindChatMessageByID_Call {
+	return &ChatMessageRepositoryMock_FindChatMessageByID_Call{Call: _e.mock.On("FindChatMessageByID", ctx, conversationID, id)}
+}
+
+func (_c *ChatMessageRepositoryMock_FindChatMessageByID_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, id uuid.UUID)) *ChatMessageRepositoryMock_FindChatMessageByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ChatMessageRepositoryMock_FindChatMessageByID_Call) Return(_a0 *entity.ChatMessage, _a1 error) *ChatMessageRepositoryMock_FindChatMessageByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChatMessageRepositoryMock_FindChatMessageByID_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*entity.ChatMessage, error)) *ChatMessageRepositoryMock_FindChatMessageByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindChatMessages provides a mock function with given fields: ctx, conversationID, cursor, limit
+func (_m *ChatMessageRepositoryMock) FindChatMessages(ctx context.Context, conversationID uuid.UUID, cursor *repo.ChatMessageCursor, limit int) ([]*entity.ChatMessage, error) {
+	ret := _m.Called(ctx, conversationID, cursor, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindChatMessages")
+	}
+
+	var r0 []*entity.ChatMessage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *repo.ChatMessageCursor, int) ([]*entity.ChatMessage, error)); ok {
+		return rf(ctx, conversationID, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, *repo.ChatMessageCursor, int) []*entity.ChatMessage); ok {
+		r0 = rf(ctx, conversationID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ChatMessage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, *repo.ChatMessageCursor, int) error); ok {
+		r1 = rf(ctx, conversationID, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChatMessageRepositoryMock_FindChatMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindChatMessages'
+type ChatMessageRepositoryMock_FindChatMessages_Call struct {
+	*mock.Call
+}
+
+// FindChatMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversationID uuid.UUID
+//   - cursor *repo.ChatMessageCursor
+//   - limit int
+func (_e *ChatMessageRepositoryMock_Expecter) FindChatMessages(ctx interface{}, conversationID interface{}, cursor interface{}, limit interface{}) *ChatMessageRepositoryMock_FindChatMessages_Call {
+	return &ChatMessageRepositoryMock_FindChatMessages_Call{Call: _e.mock.On("FindChatMessages", ctx, conversationID, cursor, limit)}
+}
+
+func (_c *ChatMessageRepositoryMock_FindChatMessages_Call) Run(run func(ctx context.Context, conversationID uuid.UUID, cursor *repo.ChatMessageCursor, limit int)) *ChatMessageRepositoryMock_FindChatMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(*repo.ChatMessageCursor), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ChatMessageRepositoryMock_FindChatMessages_Call) Return(_a0 []*entity.ChatMessage, _a1 error) *ChatMessageRepositoryMock_FindChatMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChatMessageRepositoryMock_FindChatMessages_Call) RunAndReturn(run func(context.Context, uuid.UUID, *repo.ChatMessageCursor, int) ([]*entity.ChatMessage, error)) *ChatMessageRepositoryMock_FindChatMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewChatMessageRepositoryMock creates a new instance of ChatMessageRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChatMessageRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChatMessageRepositoryMock {
+	mock := &ChatMessageRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
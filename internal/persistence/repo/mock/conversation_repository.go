@@ -0,0 +1,328 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	entity "github.com/mandarine-io/backend/internal/persistence/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// ConversationRepositoryMock is an autogenerated mock type for the ConversationRepository type
+type ConversationRepositoryMock struct {
+	mock.Mock
+}
+
+type ConversationRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ConversationRepositoryMock) EXPECT() *ConversationRepositoryMock_Expecter {
+	return &ConversationRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateConversation provides a mock function with given fields: ctx, conversation
+func (_m *ConversationRepositoryMock) CreateConversation(ctx context.Context, conversation *entity.Conversation) (*entity.Conversation, error) {
+	ret := _m.Called(ctx, conversation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConversation")
+	}
+
+	var r0 *entity.Conversation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Conversation) (*entity.Conversation, error)); ok {
+		return rf(ctx, conversation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Conversation) *entity.Conversation); ok {
+		r0 = rf(ctx, conversation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Conversation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Conversation) error); ok {
+		r1 = rf(ctx, conversation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConversationRepositoryMock_CreateConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConversation'
+type ConversationRepositoryMock_CreateConversation_Call struct {
+	*mock.Call
+}
+
+// CreateConversation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - conversation *entity.Conversation
+func (_e *ConversationRepositoryMock_Expecter) CreateConversation(ctx interface{}, conversation interface{}) *ConversationRepositoryMock_CreateConversation_Call {
+	return &ConversationRepositoryMock_CreateConversation_Call{Call: _e.mock.On("CreateConversation", ctx, conversation)}
+}
+
+func (_c *ConversationRepositoryMock_CreateConversation_Call) Run(run func(ctx context.Context, conversation *entity.Conversation)) *ConversationRepositoryMock_CreateConversation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Conversation))
+	})
+	return _c
+}
+
+func (_c *ConversationRepositoryMock_CreateConversation_Call) Return(_a0 *entity.Conversation, _a1 error) *ConversationRepositoryMock_CreateConversation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConversationRepositoryMock_CreateConversation_Call) RunAndReturn(run func(context.Context, *entity.Conversation) (*entity.Conversation, error)) *ConversationRepositoryMock_CreateConversation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindConversationByID provides a mock function with given fields: ctx, id
+func (_m *ConversationRepositoryMock) FindConversationByID(ctx context.Context, id uuid.UUID) (*entity.Conversation, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindConversationByID")
+	}
+
+	var r0 *entity.Conversation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Conversation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Conversation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Conversation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConversationRepositoryMock_FindConversationByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindConversationByID'
+type ConversationRepositoryMock_FindConversationByID_Call struct {
+	*mock.Call
+}
+
+// FindConversationByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *ConversationRepositoryMock_Expecter) FindConversationByID(ctx interface{}, id interface{}) *ConversationRepositoryMock_FindConversationByID_Call {
+	return &ConversationRepositoryMock_FindConversationByID_Call{Call: _e.mock.On("FindConversationByID", ctx, id)}
+}
+
+func (_c *ConversationRepositoryMock_FindConversationByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *ConversationRepositoryMock_FindConversationByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ConversationRepositoryMock_FindConversationByID_Call) Return(_a0 *entity.Conversation, _a1 error) *ConversationRepositoryMock_FindConversationByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConversationRepositoryMock_FindConversationByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.Conversation, error)) *ConversationRepositoryMock_FindConversationByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindConversationByParticipants provides a mock function with given fields: ctx, clientID, masterID, appointmentID
+func (_m *ConversationRepositoryMock) FindConversationByParticipants(ctx context.Context, clientID uuid.UUID, masterID uuid.UUID, appointmentID *uuid.UUID) (*entity.Conversation, error) {
+	ret := _m.Called(ctx, clientID, masterID, appointmentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindConversationByParticipants")
+	}
+
+	var r0 *entity.Conversation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *uuid.UUID) (*entity.Conversation, error)); ok {
+		return rf(ctx, clientID, masterID, appointmentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *uuid.UUID) *entity.Conversation); ok {
+		r0 = rf(ctx, clientID, masterID, appointmentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Conversation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(ctx, clientID, masterID, appointmentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConversationRepositoryMock_FindConversationByParticipants_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindConversationByParticipants'
+type ConversationRepositoryMock_FindConversationByParticipants_Call struct {
+	*mock.Call
+}
+
+// FindConversationByParticipants is a helper method to define mock.On call
+//   - ctx context.Context
+//   - clientID uuid.UUID
+//   - masterID uuid.UUID
+//   - appointmentID *uuid.UUID
+func (_e *ConversationRepositoryMock_Expecter) FindConversationByParticipants(ctx interface{}, clientID interface{}, masterID interface{}, appointmentID interface{}) *ConversationRepositoryMock_FindConversationByParticipants_Call {
+	return &ConversationRepositoryMock_FindConversationByParticipants_Call{Call: _e.mock.On("FindConversationByParticipants", ctx, clientID, masterID, appointmentID)}
+}
+
+func (_c *ConversationRepositoryMock_FindConversationByParticipants_Call) Run(run func(ctx context.Context, clientID uuid.UUID, masterID uuid.UUID, appointmentID *uuid.UUID)) *ConversationRepositoryMock_FindConversationByParticipants_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(*uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ConversationRepositoryMock_FindConversationByParticipants_Call) Return(_a0 *entity.Conversation, _a1 error) *ConversationRepositoryMock_FindConversationByParticipants_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConversationRepositoryMock_FindConversationByParticipants_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, *uuid.UUID) (*entity.Conversation, error)) *ConversationRepositoryMock_FindConversationByParticipants_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindConversationsByUserID provides a mock function with given fields: ctx, userID
+func (_m *ConversationRepositoryMock) FindConversationsByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Conversation, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindConversationsByUserID")
+	}
+
+	var r0 []*entity.Conversation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.Conversation, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.Conversation); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Conversation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConversationRepositoryMock_FindConversationsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindConversationsByUserID'
+type ConversationRepositoryMock_FindConversationsByUserID_Call struct {
+	*mock.Call
+}
+
+// FindConversationsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ConversationRepositoryMock_Expecter) FindConversationsByUserID(ctx interface{}, userID interface{}) *ConversationRepositoryMock_FindConversationsByUserID_Call {
+	return &ConversationRepositoryMock_FindConversationsByUserID_Call{Call: _e.mock.On("FindConversationsByUserID", ctx, userID)}
+}
+
+func (_c *ConversationRepositoryMock_FindConversationsByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ConversationRepositoryMock_FindConversationsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ConversationRepositoryMock_FindConversationsByUserID_Call) Return(_a0 []*entity.Conversation, _a1 error) *ConversationRepositoryMock_FindConversationsByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ConversationRepositoryMock_FindConversationsByUserID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.Conversation, error)) *ConversationRepositoryMock_FindConversationsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateConversationReadAt provides a mock function with given fields: ctx, id, userID, readAt
+func (_m *ConversationRepositoryMock) UpdateConversationReadAt(ctx context.Context, id uuid.UUID, userID uuid.UUID, readAt time.Time) error {
+	ret := _m.Called(ctx, id, userID, readAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateConversationReadAt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, id, userID, readAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConversationRepositoryMock_UpdateConversationReadAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateConversationReadAt'
+type ConversationRepositoryMock_UpdateConversationReadAt_Call struct {
+	*mock.Call
+}
+
+// UpdateConversationReadAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - userID uuid.UUID
+//   - readAt time.Time
+func (_e *ConversationRepositoryMock_Expecter) UpdateConversationReadAt(ctx interface{}, id interface{}, userID interface{}, readAt interface{}) *ConversationRepositoryMock_UpdateConversationReadAt_Call {
+	return &ConversationRepositoryMock_UpdateConversationReadAt_Call{Call: _e.mock.On("UpdateConversationReadAt", ctx, id, userID, readAt)}
+}
+
+func (_c *ConversationRepositoryMock_UpdateConversationReadAt_Call) Run(run func(ctx context.Context, id uuid.UUID, userID uuid.UUID, readAt time.Time)) *ConversationRepositoryMock_UpdateConversationReadAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *ConversationRepositoryMock_UpdateConversationReadAt_Call) Return(_a0 error) *ConversationRepositoryMock_UpdateConversationReadAt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ConversationRepositoryMock_UpdateConversationReadAt_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, time.Time) error) *ConversationRepositoryMock_UpdateConversationReadAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewConversationRepositoryMock creates a new instance of ConversationRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConversationRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ConversationRepositoryMock {
+	mock := &ConversationRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,350 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	entity "github.com/mandarine-io/backend/internal/persistence/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// OutboxEventRepositoryMock is an autogenerated mock type for the OutboxEventRepository type
+type OutboxEventRepositoryMock struct {
+	mock.Mock
+}
+
+type OutboxEventRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OutboxEventRepositoryMock) EXPECT() *OutboxEventRepositoryMock_Expecter {
+	return &OutboxEventRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateOutboxEvents provides a mock function with given fields: ctx, events
+func (_m *OutboxEventRepositoryMock) CreateOutboxEvents(ctx context.Context, events []*entity.OutboxEvent) error {
+	ret := _m.Called(ctx, events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateOutboxEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*entity.OutboxEvent) error); ok {
+		r0 = rf(ctx, events)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxEventRepositoryMock_CreateOutboxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateOutboxEvents'
+type OutboxEventRepositoryMock_CreateOutboxEvents_Call struct {
+	*mock.Call
+}
+
+// CreateOutboxEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []*entity.OutboxEvent
+func (_e *OutboxEventRepositoryMock_Expecter) CreateOutboxEvents(ctx interface{}, events interface{}) *OutboxEventRepositoryMock_CreateOutboxEvents_Call {
+	return &OutboxEventRepositoryMock_CreateOutboxEvents_Call{Call: _e.mock.On("CreateOutboxEvents", ctx, events)}
+}
+
+func (_c *OutboxEventRepositoryMock_CreateOutboxEvents_Call) Run(run func(ctx context.Context, events []*entity.OutboxEvent)) *OutboxEventRepositoryMock_CreateOutboxEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*entity.OutboxEvent))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepositoryMock_CreateOutboxEvents_Call) Return(_a0 error) *OutboxEventRepositoryMock_CreateOutboxEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxEventRepositoryMock_CreateOutboxEvents_Call) RunAndReturn(run func(context.Context, []*entity.OutboxEvent) error) *OutboxEventRepositoryMock_CreateOutboxEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteOutboxEventsPublishedBefore provides a mock function with given fields: ctx, before
+func (_m *OutboxEventRepositoryMock) DeleteOutboxEventsPublishedBefore(ctx context.Context, before time.Time) (int64, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteOutboxEventsPublishedBefore")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxEventRepositoryMock_DeleteOutboxEventsPublishedBefore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteOutboxEventsPublishedBefore'
+type OutboxEventRepositoryMock_DeleteOutboxEventsPublishedBefore_Call struct {
+	*mock.Call
+}
+
+// DeleteOutboxEventsPublishedBefore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+func (_e *OutboxEventRepositoryMock_Expecter) DeleteOutboxEventsPublishedBefore(ctx interface{}, before interface{}) *OutboxEventRepositoryMock_DeleteOutboxEventsPublishedBefore_Call {
+	return &OutboxEventRepositoryMock_DeleteOutboxEventsPublishedBefore_Call{Call: _e.mock.On("DeleteOutboxEventsPublishedBefore", ctx, before)}
+}
+
+func (_c *OutboxEventRepositoryMock_DeleteOutboxEventsPublishedBefore_Call) Run(run func(ctx context.Context, before time.Time)) *OutboxEventRepositoryMock_DeleteOutboxEventsPublishedBefore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepositoryMock_DeleteOutboxEventsPublishedBefore_Call) Return(_a0 int64, _a1 error) *OutboxEventRepositoryMock_DeleteOutboxEventsPublishedBefore_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxEventRepositoryMock_DeleteOutboxEventsPublishedBefore_Call) RunAndReturn(run func(context.Context, time.Time) (int64, error)) *OutboxEventRepositoryMock_DeleteOutboxEventsPublishedBefore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPendingOutboxEvents provides a mock function with given fields: ctx, limit
+func (_m *OutboxEventRepositoryMock) FindPendingOutboxEvents(ctx context.Context, limit int) ([]*entity.OutboxEvent, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPendingOutboxEvents")
+	}
+
+	var r0 []*entity.OutboxEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*entity.OutboxEvent, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*entity.OutboxEvent); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.OutboxEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxEventRepositoryMock_FindPendingOutboxEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPendingOutboxEvents'
+type OutboxEventRepositoryMock_FindPendingOutboxEvents_Call struct {
+	*mock.Call
+}
+
+// FindPendingOutboxEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+func (_e *OutboxEventRepositoryMock_Expecter) FindPendingOutboxEvents(ctx interface{}, limit interface{}) *OutboxEventRepositoryMock_FindPendingOutboxEvents_Call {
+	return &OutboxEventRepositoryMock_FindPendingOutboxEvents_Call{Call: _e.mock.On("FindPendingOutboxEvents", ctx, limit)}
+}
+
+func (_c *OutboxEventRepositoryMock_FindPendingOutboxEvents_Call) Run(run func(ctx context.Context, limit int)) *OutboxEventRepositoryMock_FindPendingOutboxEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepositoryMock_FindPendingOutboxEvents_Call) Return(_a0 []*entity.OutboxEvent, _a1 error) *OutboxEventRepositoryMock_FindPendingOutboxEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxEventRepositoryMock_FindPendingOutboxEvents_Call) RunAndReturn(run func(context.Context, int) ([]*entity.OutboxEvent, error)) *OutboxEventRepositoryMock_FindPendingOutboxEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkOutboxEventFailed provides a mock function with given fields: ctx, id, lastError, nextAttemptAt
+func (_m *OutboxEventRepositoryMock) MarkOutboxEventFailed(ctx context.Context, id uuid.UUID, lastError string, nextAttemptAt time.Time) error {
+	ret := _m.Called(ctx, id, lastError, nextAttemptAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOutboxEventFailed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time) error); ok {
+		r0 = rf(ctx, id, lastError, nextAttemptAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxEventRepositoryMock_MarkOutboxEventFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxEventFailed'
+type OutboxEventRepositoryMock_MarkOutboxEventFailed_Call struct {
+	*mock.Call
+}
+
+// MarkOutboxEventFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - lastError string
+//   - nextAttemptAt time.Time
+func (_e *OutboxEventRepositoryMock_Expecter) MarkOutboxEventFailed(ctx interface{}, id interface{}, lastError interface{}, nextAttemptAt interface{}) *OutboxEventRepositoryMock_MarkOutboxEventFailed_Call {
+	return &OutboxEventRepositoryMock_MarkOutboxEventFailed_Call{Call: _e.mock.On("MarkOutboxEventFailed", ctx, id, lastError, nextAttemptAt)}
+}
+
+func (_c *OutboxEventRepositoryMock_MarkOutboxEventFailed_Call) Run(run func(ctx context.Context, id uuid.UUID, lastError string, nextAttemptAt time.Time)) *OutboxEventRepositoryMock_MarkOutboxEventFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepositoryMock_MarkOutboxEventFailed_Call) Return(_a0 error) *OutboxEventRepositoryMock_MarkOutboxEventFailed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxEventRepositoryMock_MarkOutboxEventFailed_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, time.Time) error) *OutboxEventRepositoryMock_MarkOutboxEventFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkOutboxEventFailedPermanently provides a mock function with given fields: ctx, id, lastError, failedAt
+func (_m *OutboxEventRepositoryMock) MarkOutboxEventFailedPermanently(ctx context.Context, id uuid.UUID, lastError string, failedAt time.Time) error {
+	ret := _m.Called(ctx, id, lastError, failedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOutboxEventFailedPermanently")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, time.Time) error); ok {
+		r0 = rf(ctx, id, lastError, failedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxEventRepositoryMock_MarkOutboxEventFailedPermanently_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxEventFailedPermanently'
+type OutboxEventRepositoryMock_MarkOutboxEventFailedPermanently_Call struct {
+	*mock.Call
+}
+
+// MarkOutboxEventFailedPermanently is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - lastError string
+//   - failedAt time.Time
+func (_e *OutboxEventRepositoryMock_Expecter) MarkOutboxEventFailedPermanently(ctx interface{}, id interface{}, lastError interface{}, failedAt interface{}) *OutboxEventRepositoryMock_MarkOutboxEventFailedPermanently_Call {
+	return &OutboxEventRepositoryMock_MarkOutboxEventFailedPermanently_Call{Call: _e.mock.On("MarkOutboxEventFailedPermanently", ctx, id, lastError, failedAt)}
+}
+
+func (_c *OutboxEventRepositoryMock_MarkOutboxEventFailedPermanently_Call) Run(run func(ctx context.Context, id uuid.UUID, lastError string, failedAt time.Time)) *OutboxEventRepositoryMock_MarkOutboxEventFailedPermanently_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepositoryMock_MarkOutboxEventFailedPermanently_Call) Return(_a0 error) *OutboxEventRepositoryMock_MarkOutboxEventFailedPermanently_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxEventRepositoryMock_MarkOutboxEventFailedPermanently_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, time.Time) error) *OutboxEventRepositoryMock_MarkOutboxEventFailedPermanently_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkOutboxEventPublished provides a mock function with given fields: ctx, id, publishedAt
+func (_m *OutboxEventRepositoryMock) MarkOutboxEventPublished(ctx context.Context, id uuid.UUID, publishedAt time.Time) error {
+	ret := _m.Called(ctx, id, publishedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOutboxEventPublished")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, id, publishedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxEventRepositoryMock_MarkOutboxEventPublished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOutboxEventPublished'
+type OutboxEventRepositoryMock_MarkOutboxEventPublished_Call struct {
+	*mock.Call
+}
+
+// MarkOutboxEventPublished is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - publishedAt time.Time
+func (_e *OutboxEventRepositoryMock_Expecter) MarkOutboxEventPublished(ctx interface{}, id interface{}, publishedAt interface{}) *OutboxEventRepositoryMock_MarkOutboxEventPublished_Call {
+	return &OutboxEventRepositoryMock_MarkOutboxEventPublished_Call{Call: _e.mock.On("MarkOutboxEventPublished", ctx, id, publishedAt)}
+}
+
+func (_c *OutboxEventRepositoryMock_MarkOutboxEventPublished_Call) Run(run func(ctx context.Context, id uuid.UUID, publishedAt time.Time)) *OutboxEventRepositoryMock_MarkOutboxEventPublished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *OutboxEventRepositoryMock_MarkOutboxEventPublished_Call) Return(_a0 error) *OutboxEventRepositoryMock_MarkOutboxEventPublished_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxEventRepositoryMock_MarkOutboxEventPublished_Call) RunAndReturn(run func(context.Context, uuid.UUID, time.Time) error) *OutboxEventRepositoryMock_MarkOutboxEventPublished_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOutboxEventRepositoryMock creates a new instance of OutboxEventRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxEventRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxEventRepositoryMock {
+	mock := &OutboxEventRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,333 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	entity "github.com/mandarine-io/backend/internal/persistence/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// PasskeyRepositoryMock is an autogenerated mock type for the PasskeyRepository type
+type PasskeyRepositoryMock struct {
+	mock.Mock
+}
+
+type PasskeyRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PasskeyRepositoryMock) EXPECT() *PasskeyRepositoryMock_Expecter {
+	return &PasskeyRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreatePasskey provides a mock function with given fields: ctx, passkey
+func (_m *PasskeyRepositoryMock) CreatePasskey(ctx context.Context, passkey *entity.Passkey) (*entity.Passkey, error) {
+	ret := _m.Called(ctx, passkey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePasskey")
+	}
+
+	var r0 *entity.Passkey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Passkey) (*entity.Passkey, error)); ok {
+		return rf(ctx, passkey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Passkey) *entity.Passkey); ok {
+		r0 = rf(ctx, passkey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Passkey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Passkey) error); ok {
+		r1 = rf(ctx, passkey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PasskeyRepositoryMock_CreatePasskey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePasskey'
+type PasskeyRepositoryMock_CreatePasskey_Call struct {
+	*mock.Call
+}
+
+// CreatePasskey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - passkey *entity.Passkey
+func (_e *PasskeyRepositoryMock_Expecter) CreatePasskey(ctx interface{}, passkey interface{}) *PasskeyRepositoryMock_CreatePasskey_Call {
+	return &PasskeyRepositoryMock_CreatePasskey_Call{Call: _e.mock.On("CreatePasskey", ctx, passkey)}
+}
+
+func (_c *PasskeyRepositoryMock_CreatePasskey_Call) Run(run func(ctx context.Context, passkey *entity.Passkey)) *PasskeyRepositoryMock_CreatePasskey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Passkey))
+	})
+	return _c
+}
+
+func (_c *PasskeyRepositoryMock_CreatePasskey_Call) Return(_a0 *entity.Passkey, _a1 error) *PasskeyRepositoryMock_CreatePasskey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PasskeyRepositoryMock_CreatePasskey_Call) RunAndReturn(run func(context.Context, *entity.Passkey) (*entity.Passkey, error)) *PasskeyRepositoryMock_CreatePasskey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePasskey provides a mock function with given fields: ctx, id, userID
+func (_m *PasskeyRepositoryMock) DeletePasskey(ctx context.Context, id uuid.UUID, userID uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePasskey")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, id, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, id, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PasskeyRepositoryMock_DeletePasskey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePasskey'
+type PasskeyRepositoryMock_DeletePasskey_Call struct {
+	*mock.Call
+}
+
+// DeletePasskey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - userID uuid.UUID
+func (_e *PasskeyRepositoryMock_Expecter) DeletePasskey(ctx interface{}, id interface{}, userID interface{}) *PasskeyRepositoryMock_DeletePasskey_Call {
+	return &PasskeyRepositoryMock_DeletePasskey_Call{Call: _e.mock.On("DeletePasskey", ctx, id, userID)}
+}
+
+func (_c *PasskeyRepositoryMock_DeletePasskey_Call) Run(run func(ctx context.Context, id uuid.UUID, userID uuid.UUID)) *PasskeyRepositoryMock_DeletePasskey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PasskeyRepositoryMock_DeletePasskey_Call) Return(_a0 bool, _a1 error) *PasskeyRepositoryMock_DeletePasskey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PasskeyRepositoryMock_DeletePasskey_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *PasskeyRepositoryMock_DeletePasskey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPasskeyByCredentialID provides a mock function with given fields: ctx, credentialID
+func (_m *PasskeyRepositoryMock) FindPasskeyByCredentialID(ctx context.Context, credentialID []byte) (*entity.Passkey, error) {
+	ret := _m.Called(ctx, credentialID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPasskeyByCredentialID")
+	}
+
+	var r0 *entity.Passkey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) (*entity.Passkey, error)); ok {
+		return rf(ctx, credentialID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) *entity.Passkey); ok {
+		r0 = rf(ctx, credentialID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Passkey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, credentialID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PasskeyRepositoryMock_FindPasskeyByCredentialID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPasskeyByCredentialID'
+type PasskeyRepositoryMock_FindPasskeyByCredentialID_Call struct {
+	*mock.Call
+}
+
+// FindPasskeyByCredentialID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - credentialID []byte
+func (_e *PasskeyRepositoryMock_Expecter) FindPasskeyByCredentialID(ctx interface{}, credentialID interface{}) *PasskeyRepositoryMock_FindPasskeyByCredentialID_Call {
+	return &PasskeyRepositoryMock_FindPasskeyByCredentialID_Call{Call: _e.mock.On("FindPasskeyByCredentialID", ctx, credentialID)}
+}
+
+func (_c *PasskeyRepositoryMock_FindPasskeyByCredentialID_Call) Run(run func(ctx context.Context, credentialID []byte)) *PasskeyRepositoryMock_FindPasskeyByCredentialID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte))
+	})
+	return _c
+}
+
+func (_c *PasskeyRepositoryMock_FindPasskeyByCredentialID_Call) Return(_a0 *entity.Passkey, _a1 error) *PasskeyRepositoryMock_FindPasskeyByCredentialID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PasskeyRepositoryMock_FindPasskeyByCredentialID_Call) RunAndReturn(run func(context.Context, []byte) (*entity.Passkey, error)) *PasskeyRepositoryMock_FindPasskeyByCredentialID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindPasskeysByUserID provides a mock function with given fields: ctx, userID
+func (_m *PasskeyRepositoryMock) FindPasskeysByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Passkey, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindPasskeysByUserID")
+	}
+
+	var r0 []*entity.Passkey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.Passkey, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.Passkey); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Passkey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PasskeyRepositoryMock_FindPasskeysByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindPasskeysByUserID'
+type PasskeyRepositoryMock_FindPasskeysByUserID_Call struct {
+	*mock.Call
+}
+
+// FindPasskeysByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *PasskeyRepositoryMock_Expecter) FindPasskeysByUserID(ctx interface{}, userID interface{}) *PasskeyRepositoryMock_FindPasskeysByUserID_Call {
+	return &PasskeyRepositoryMock_FindPasskeysByUserID_Call{Call: _e.mock.On("FindPasskeysByUserID", ctx, userID)}
+}
+
+func (_c *PasskeyRepositoryMock_FindPasskeysByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *PasskeyRepositoryMock_FindPasskeysByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *PasskeyRepositoryMock_FindPasskeysByUserID_Call) Return(_a0 []*entity.Passkey, _a1 error) *PasskeyRepositoryMock_FindPasskeysByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PasskeyRepositoryMock_FindPasskeysByUserID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.Passkey, error)) *PasskeyRepositoryMock_FindPasskeysByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePasskeySignCount provides a mock function with given fields: ctx, id, oldSignCount, newSignCount
+func (_m *PasskeyRepositoryMock) UpdatePasskeySignCount(ctx context.Context, id uuid.UUID, oldSignCount uint32, newSignCount uint32) (bool, error) {
+	ret := _m.Called(ctx, id, oldSignCount, newSignCount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePasskeySignCount")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uint32, uint32) (bool, error)); ok {
+		return rf(ctx, id, oldSignCount, newSignCount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uint32, uint32) bool); ok {
+		r0 = rf(ctx, id, oldSignCount, newSignCount)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uint32, uint32) error); ok {
+		r1 = rf(ctx, id, oldSignCount, newSignCount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PasskeyRepositoryMock_UpdatePasskeySignCount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePasskeySignCount'
+type PasskeyRepositoryMock_UpdatePasskeySignCount_Call struct {
+	*mock.Call
+}
+
+// UpdatePasskeySignCount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - oldSignCount uint32
+//   - newSignCount uint32
+func (_e *PasskeyRepositoryMock_Expecter) UpdatePasskeySignCount(ctx interface{}, id interface{}, oldSignCount interface{}, newSignCount interface{}) *PasskeyRepositoryMock_UpdatePasskeySignCount_Call {
+	return &PasskeyRepositoryMock_UpdatePasskeySignCount_Call{Call: _e.mock.On("UpdatePasskeySignCount", ctx, id, oldSignCount, newSignCount)}
+}
+
+func (_c *PasskeyRepositoryMock_UpdatePasskeySignCount_Call) Run(run func(ctx context.Context, id uuid.UUID, oldSignCount uint32, newSignCount uint32)) *PasskeyRepositoryMock_UpdatePasskeySignCount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uint32), args[3].(uint32))
+	})
+	return _c
+}
+
+func (_c *PasskeyRepositoryMock_UpdatePasskeySignCount_Call) Return(_a0 bool, _a1 error) *PasskeyRepositoryMock_UpdatePasskeySignCount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PasskeyRepositoryMock_UpdatePasskeySignCount_Call) RunAndReturn(run func(context.Context, uuid.UUID, uint32, uint32) (bool, error)) *PasskeyRepositoryMock_UpdatePasskeySignCount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPasskeyRepositoryMock creates a new instance of PasskeyRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPasskeyRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PasskeyRepositoryMock {
+	mock := &PasskeyRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,249 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	entity "github.com/mandarine-io/backend/internal/persistence/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// RecoveryCodeRepositoryMock is an autogenerated mock type for the RecoveryCodeRepository type
+type RecoveryCodeRepositoryMock struct {
+	mock.Mock
+}
+
+type RecoveryCodeRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RecoveryCodeRepositoryMock) EXPECT() *RecoveryCodeRepositoryMock_Expecter {
+	return &RecoveryCodeRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateRecoveryCodes provides a mock function with given fields: ctx, recoveryCodes
+func (_m *RecoveryCodeRepositoryMock) CreateRecoveryCodes(ctx context.Context, recoveryCodes []*entity.RecoveryCode) error {
+	ret := _m.Called(ctx, recoveryCodes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRecoveryCodes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*entity.RecoveryCode) error); ok {
+		r0 = rf(ctx, recoveryCodes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecoveryCodeRepositoryMock_CreateRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRecoveryCodes'
+type RecoveryCodeRepositoryMock_CreateRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// CreateRecoveryCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - recoveryCodes []*entity.RecoveryCode
+func (_e *RecoveryCodeRepositoryMock_Expecter) CreateRecoveryCodes(ctx interface{}, recoveryCodes interface{}) *RecoveryCodeRepositoryMock_CreateRecoveryCodes_Call {
+	return &RecoveryCodeRepositoryMock_CreateRecoveryCodes_Call{Call: _e.mock.On("CreateRecoveryCodes", ctx, recoveryCodes)}
+}
+
+func (_c *RecoveryCodeRepositoryMock_CreateRecoveryCodes_Call) Run(run func(ctx context.Context, recoveryCodes []*entity.RecoveryCode)) *RecoveryCodeRepositoryMock_CreateRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]*entity.RecoveryCode))
+	})
+	return _c
+}
+
+func (_c *RecoveryCodeRepositoryMock_CreateRecoveryCodes_Call) Return(_a0 error) *RecoveryCodeRepositoryMock_CreateRecoveryCodes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RecoveryCodeRepositoryMock_CreateRecoveryCodes_Call) RunAndReturn(run func(context.Context, []*entity.RecoveryCode) error) *RecoveryCodeRepositoryMock_CreateRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRecoveryCodesByUserID provides a mock function with given fields: ctx, userID
+func (_m *RecoveryCodeRepositoryMock) DeleteRecoveryCodesByUserID(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRecoveryCodesByUserID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecoveryCodeRepositoryMock_DeleteRecoveryCodesByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRecoveryCodesByUserID'
+type RecoveryCodeRepositoryMock_DeleteRecoveryCodesByUserID_Call struct {
+	*mock.Call
+}
+
+// DeleteRecoveryCodesByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *RecoveryCodeRepositoryMock_Expecter) DeleteRecoveryCodesByUserID(ctx interface{}, userID interface{}) *RecoveryCodeRepositoryMock_DeleteRecoveryCodesByUserID_Call {
+	return &RecoveryCodeRepositoryMock_DeleteRecoveryCodesByUserID_Call{Call: _e.mock.On("DeleteRecoveryCodesByUserID", ctx, userID)}
+}
+
+func (_c *RecoveryCodeRepositoryMock_DeleteRecoveryCodesByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *RecoveryCodeRepositoryMock_DeleteRecoveryCodesByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RecoveryCodeRepositoryMock_DeleteRecoveryCodesByUserID_Call) Return(_a0 error) *RecoveryCodeRepositoryMock_DeleteRecoveryCodesByUserID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RecoveryCodeRepositoryMock_DeleteRecoveryCodesByUserID_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *RecoveryCodeRepositoryMock_DeleteRecoveryCodesByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUnusedRecoveryCodesByUserID provides a mock function with given fields: ctx, userID
+func (_m *RecoveryCodeRepositoryMock) FindUnusedRecoveryCodesByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.RecoveryCode, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUnusedRecoveryCodesByUserID")
+	}
+
+	var r0 []*entity.RecoveryCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.RecoveryCode, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.RecoveryCode); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.RecoveryCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecoveryCodeRepositoryMock_FindUnusedRecoveryCodesByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUnusedRecoveryCodesByUserID'
+type RecoveryCodeRepositoryMock_FindUnusedRecoveryCodesByUserID_Call struct {
+	*mock.Call
+}
+
+// FindUnusedRecoveryCodesByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *RecoveryCodeRepositoryMock_Expecter) FindUnusedRecoveryCodesByUserID(ctx interface{}, userID interface{}) *RecoveryCodeRepositoryMock_FindUnusedRecoveryCodesByUserID_Call {
+	return &RecoveryCodeRepositoryMock_FindUnusedRecoveryCodesByUserID_Call{Call: _e.mock.On("FindUnusedRecoveryCodesByUserID", ctx, userID)}
+}
+
+func (_c *RecoveryCodeRepositoryMock_FindUnusedRecoveryCodesByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *RecoveryCodeRepositoryMock_FindUnusedRecoveryCodesByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RecoveryCodeRepositoryMock_FindUnusedRecoveryCodesByUserID_Call) Return(_a0 []*entity.RecoveryCode, _a1 error) *RecoveryCodeRepositoryMock_FindUnusedRecoveryCodesByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RecoveryCodeRepositoryMock_FindUnusedRecoveryCodesByUserID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.RecoveryCode, error)) *RecoveryCodeRepositoryMock_FindUnusedRecoveryCodesByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UseRecoveryCode provides a mock function with given fields: ctx, id
+func (_m *RecoveryCodeRepositoryMock) UseRecoveryCode(ctx context.Context, id uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseRecoveryCode")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) bool); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecoveryCodeRepositoryMock_UseRecoveryCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseRecoveryCode'
+type RecoveryCodeRepositoryMock_UseRecoveryCode_Call struct {
+	*mock.Call
+}
+
+// UseRecoveryCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *RecoveryCodeRepositoryMock_Expecter) UseRecoveryCode(ctx interface{}, id interface{}) *RecoveryCodeRepositoryMock_UseRecoveryCode_Call {
+	return &RecoveryCodeRepositoryMock_UseRecoveryCode_Call{Call: _e.mock.On("UseRecoveryCode", ctx, id)}
+}
+
+func (_c *RecoveryCodeRepositoryMock_UseRecoveryCode_Call) Run(run func(ctx context.Context, id uuid.UUID)) *RecoveryCodeRepositoryMock_UseRecoveryCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *RecoveryCodeRepositoryMock_UseRecoveryCode_Call) Return(_a0 bool, _a1 error) *RecoveryCodeRepositoryMock_UseRecoveryCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RecoveryCodeRepositoryMock_UseRecoveryCode_Call) RunAndReturn(run func(context.Context, uuid.UUID) (bool, error)) *RecoveryCodeRepositoryMock_UseRecoveryCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRecoveryCodeRepositoryMock creates a new instance of RecoveryCodeRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRecoveryCodeRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RecoveryCodeRepositoryMock {
+	mock := &RecoveryCodeRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,213 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	entity "github.com/mandarine-io/backend/internal/persistence/entity"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RoleRepositoryMock is an autogenerated mock type for the RoleRepository type
+type RoleRepositoryMock struct {
+	mock.Mock
+}
+
+type RoleRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RoleRepositoryMock) EXPECT() *RoleRepositoryMock_Expecter {
+	return &RoleRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// FindRoleByName provides a mock function with given fields: ctx, name
+func (_m *RoleRepositoryMock) FindRoleByName(ctx context.Context, name string) (*entity.RoleEntity, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRoleByName")
+	}
+
+	var r0 *entity.RoleEntity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.RoleEntity, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.RoleEntity); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.RoleEntity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RoleRepositoryMock_FindRoleByName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRoleByName'
+type RoleRepositoryMock_FindRoleByName_Call struct {
+	*mock.Call
+}
+
+// FindRoleByName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *RoleRepositoryMock_Expecter) FindRoleByName(ctx interface{}, name interface{}) *RoleRepositoryMock_FindRoleByName_Call {
+	return &RoleRepositoryMock_FindRoleByName_Call{Call: _e.mock.On("FindRoleByName", ctx, name)}
+}
+
+func (_c *RoleRepositoryMock_FindRoleByName_Call) Run(run func(ctx context.Context, name string)) *RoleRepositoryMock_FindRoleByName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RoleRepositoryMock_FindRoleByName_Call) Return(_a0 *entity.RoleEntity, _a1 error) *RoleRepositoryMock_FindRoleByName_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RoleRepositoryMock_FindRoleByName_Call) RunAndReturn(run func(context.Context, string) (*entity.RoleEntity, error)) *RoleRepositoryMock_FindRoleByName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindRoles provides a mock function with given fields: ctx
+func (_m *RoleRepositoryMock) FindRoles(ctx context.Context) ([]*entity.RoleEntity, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindRoles")
+	}
+
+	var r0 []*entity.RoleEntity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.RoleEntity, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.RoleEntity); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.RoleEntity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RoleRepositoryMock_FindRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindRoles'
+type RoleRepositoryMock_FindRoles_Call struct {
+	*mock.Call
+}
+
+// FindRoles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RoleRepositoryMock_Expecter) FindRoles(ctx interface{}) *RoleRepositoryMock_FindRoles_Call {
+	return &RoleRepositoryMock_FindRoles_Call{Call: _e.mock.On("FindRoles", ctx)}
+}
+
+func (_c *RoleRepositoryMock_FindRoles_Call) Run(run func(ctx context.Context)) *RoleRepositoryMock_FindRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RoleRepositoryMock_FindRoles_Call) Return(_a0 []*entity.RoleEntity, _a1 error) *RoleRepositoryMock_FindRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RoleRepositoryMock_FindRoles_Call) RunAndReturn(run func(context.Context) ([]*entity.RoleEntity, error)) *RoleRepositoryMock_FindRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRole provides a mock function with given fields: ctx, role
+func (_m *RoleRepositoryMock) UpdateRole(ctx context.Context, role *entity.RoleEntity) (*entity.RoleEntity, error) {
+	ret := _m.Called(ctx, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 *entity.RoleEntity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.RoleEntity) (*entity.RoleEntity, error)); ok {
+		return rf(ctx, role)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.RoleEntity) *entity.RoleEntity); ok {
+		r0 = rf(ctx, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.RoleEntity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.RoleEntity) error); ok {
+		r1 = rf(ctx, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RoleRepositoryMock_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type RoleRepositoryMock_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - role *entity.RoleEntity
+func (_e *RoleRepositoryMock_Expecter) UpdateRole(ctx interface{}, role interface{}) *RoleRepositoryMock_UpdateRole_Call {
+	return &RoleRepositoryMock_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, role)}
+}
+
+func (_c *RoleRepositoryMock_UpdateRole_Call) Run(run func(ctx context.Context, role *entity.RoleEntity)) *RoleRepositoryMock_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.RoleEntity))
+	})
+	return _c
+}
+
+func (_c *RoleRepositoryMock_UpdateRole_Call) Return(_a0 *entity.RoleEntity, _a1 error) *RoleRepositoryMock_UpdateRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RoleRepositoryMock_UpdateRole_Call) RunAndReturn(run func(context.Context, *entity.RoleEntity) (*entity.RoleEntity, error)) *RoleRepositoryMock_UpdateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRoleRepositoryMock creates a new instance of RoleRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRoleRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RoleRepositoryMock {
+	mock := &RoleRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
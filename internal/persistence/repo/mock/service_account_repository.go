@@ -0,0 +1,275 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	entity "github.com/mandarine-io/backend/internal/persistence/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ServiceAccountRepositoryMock is an autogenerated mock type for the ServiceAccountRepository type
+type ServiceAccountRepositoryMock struct {
+	mock.Mock
+}
+
+type ServiceAccountRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ServiceAccountRepositoryMock) EXPECT() *ServiceAccountRepositoryMock_Expecter {
+	return &ServiceAccountRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateServiceAccount provides a mock function with given fields: ctx, serviceAccount
+func (_m *ServiceAccountRepositoryMock) CreateServiceAccount(ctx context.Context, serviceAccount *entity.ServiceAccount) (*entity.ServiceAccount, error) {
+	ret := _m.Called(ctx, serviceAccount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateServiceAccount")
+	}
+
+	var r0 *entity.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ServiceAccount) (*entity.ServiceAccount, error)); ok {
+		return rf(ctx, serviceAccount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.ServiceAccount) *entity.ServiceAccount); ok {
+		r0 = rf(ctx, serviceAccount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ServiceAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.ServiceAccount) error); ok {
+		r1 = rf(ctx, serviceAccount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepositoryMock_CreateServiceAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateServiceAccount'
+type ServiceAccountRepositoryMock_CreateServiceAccount_Call struct {
+	*mock.Call
+}
+
+// CreateServiceAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceAccount *entity.ServiceAccount
+func (_e *ServiceAccountRepositoryMock_Expecter) CreateServiceAccount(ctx interface{}, serviceAccount interface{}) *ServiceAccountRepositoryMock_CreateServiceAccount_Call {
+	return &ServiceAccountRepositoryMock_CreateServiceAccount_Call{Call: _e.mock.On("CreateServiceAccount", ctx, serviceAccount)}
+}
+
+func (_c *ServiceAccountRepositoryMock_CreateServiceAccount_Call) Run(run func(ctx context.Context, serviceAccount *entity.ServiceAccount)) *ServiceAccountRepositoryMock_CreateServiceAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.ServiceAccount))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepositoryMock_CreateServiceAccount_Call) Return(_a0 *entity.ServiceAccount, _a1 error) *ServiceAccountRepositoryMock_CreateServiceAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepositoryMock_CreateServiceAccount_Call) RunAndReturn(run func(context.Context, *entity.ServiceAccount) (*entity.ServiceAccount, error)) *ServiceAccountRepositoryMock_CreateServiceAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteServiceAccount provides a mock function with given fields: ctx, ownerID, id
+func (_m *ServiceAccountRepositoryMock) DeleteServiceAccount(ctx context.Context, ownerID uuid.UUID, id uuid.UUID) (bool, error) {
+	ret := _m.Called(ctx, ownerID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteServiceAccount")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return rf(ctx, ownerID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = rf(ctx, ownerID, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, ownerID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepositoryMock_DeleteServiceAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteServiceAccount'
+type ServiceAccountRepositoryMock_DeleteServiceAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteServiceAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID uuid.UUID
+//   - id uuid.UUID
+func (_e *ServiceAccountRepositoryMock_Expecter) DeleteServiceAccount(ctx interface{}, ownerID interface{}, id interface{}) *ServiceAccountRepositoryMock_DeleteServiceAccount_Call {
+	return &ServiceAccountRepositoryMock_DeleteServiceAccount_Call{Call: _e.mock.On("DeleteServiceAccount", ctx, ownerID, id)}
+}
+
+func (_c *ServiceAccountRepositoryMock_DeleteServiceAccount_Call) Run(run func(ctx context.Context, ownerID uuid.UUID, id uuid.UUID)) *ServiceAccountRepositoryMock_DeleteServiceAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepositoryMock_DeleteServiceAccount_Call) Return(_a0 bool, _a1 error) *ServiceAccountRepositoryMock_DeleteServiceAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepositoryMock_DeleteServiceAccount_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (bool, error)) *ServiceAccountRepositoryMock_DeleteServiceAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindServiceAccountByID provides a mock function with given fields: ctx, ownerID, id
+func (_m *ServiceAccountRepositoryMock) FindServiceAccountByID(ctx context.Context, ownerID uuid.UUID, id uuid.UUID) (*entity.ServiceAccount, error) {
+	ret := _m.Called(ctx, ownerID, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindServiceAccountByID")
+	}
+
+	var r0 *entity.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (*entity.ServiceAccount, error)); ok {
+		return rf(ctx, ownerID, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) *entity.ServiceAccount); ok {
+		r0 = rf(ctx, ownerID, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.ServiceAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, ownerID, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepositoryMock_FindServiceAccountByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindServiceAccountByID'
+type ServiceAccountRepositoryMock_FindServiceAccountByID_Call struct {
+	*mock.Call
+}
+
+// FindServiceAccountByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID uuid.UUID
+//   - id uuid.UUID
+func (_e *ServiceAccountRepositoryMock_Expecter) FindServiceAccountByID(ctx interface{}, ownerID interface{}, id interface{}) *ServiceAccountRepositoryMock_FindServiceAccountByID_Call {
+	return &ServiceAccountRepositoryMock_FindServiceAccountByID_Call{Call: _e.mock.On("FindServiceAccountByID", ctx, ownerID, id)}
+}
+
+func (_c *ServiceAccountRepositoryMock_FindServiceAccountByID_Call) Run(run func(ctx context.Context, ownerID uuid.UUID, id uuid.UUID)) *ServiceAccountRepositoryMock_FindServiceAccountByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepositoryMock_FindServiceAccountByID_Call) Return(_a0 *entity.ServiceAccount, _a1 error) *ServiceAccountRepositoryMock_FindServiceAccountByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepositoryMock_FindServiceAccountByID_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (*entity.ServiceAccount, error)) *ServiceAccountRepositoryMock_FindServiceAccountByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindServiceAccountsByOwnerID provides a mock function with given fields: ctx, ownerID
+func (_m *ServiceAccountRepositoryMock) FindServiceAccountsByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*entity.ServiceAccount, error) {
+	ret := _m.Called(ctx, ownerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindServiceAccountsByOwnerID")
+	}
+
+	var r0 []*entity.ServiceAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.ServiceAccount, error)); ok {
+		return rf(ctx, ownerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.ServiceAccount); ok {
+		r0 = rf(ctx, ownerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.ServiceAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, ownerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ServiceAccountRepositoryMock_FindServiceAccountsByOwnerID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindServiceAccountsByOwnerID'
+type ServiceAccountRepositoryMock_FindServiceAccountsByOwnerID_Call struct {
+	*mock.Call
+}
+
+// FindServiceAccountsByOwnerID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ownerID uuid.UUID
+func (_e *ServiceAccountRepositoryMock_Expecter) FindServiceAccountsByOwnerID(ctx interface{}, ownerID interface{}) *ServiceAccountRepositoryMock_FindServiceAccountsByOwnerID_Call {
+	return &ServiceAccountRepositoryMock_FindServiceAccountsByOwnerID_Call{Call: _e.mock.On("FindServiceAccountsByOwnerID", ctx, ownerID)}
+}
+
+func (_c *ServiceAccountRepositoryMock_FindServiceAccountsByOwnerID_Call) Run(run func(ctx context.Context, ownerID uuid.UUID)) *ServiceAccountRepositoryMock_FindServiceAccountsByOwnerID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ServiceAccountRepositoryMock_FindServiceAccountsByOwnerID_Call) Return(_a0 []*entity.ServiceAccount, _a1 error) *ServiceAccountRepositoryMock_FindServiceAccountsByOwnerID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ServiceAccountRepositoryMock_FindServiceAccountsByOwnerID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.ServiceAccount, error)) *ServiceAccountRepositoryMock_FindServiceAccountsByOwnerID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewServiceAccountRepositoryMock creates a new instance of ServiceAccountRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServiceAccountRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServiceAccountRepositoryMock {
+	mock := &ServiceAccountRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,367 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	entity "github.com/mandarine-io/backend/internal/persistence/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// SessionRepositoryMock is an autogenerated mock type for the SessionRepository type
+type SessionRepositoryMock struct {
+	mock.Mock
+}
+
+type SessionRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SessionRepositoryMock) EXPECT() *SessionRepositoryMock_Expecter {
+	return &SessionRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateSession provides a mock function with given fields: ctx, session
+func (_m *SessionRepositoryMock) CreateSession(ctx context.Context, session *entity.Session) (*entity.Session, error) {
+	ret := _m.Called(ctx, session)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSession")
+	}
+
+	var r0 *entity.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Session) (*entity.Session, error)); ok {
+		return rf(ctx, session)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Session) *entity.Session); ok {
+		r0 = rf(ctx, session)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Session) error); ok {
+		r1 = rf(ctx, session)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionRepositoryMock_CreateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSession'
+type SessionRepositoryMock_CreateSession_Call struct {
+	*mock.Call
+}
+
+// CreateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session *entity.Session
+func (_e *SessionRepositoryMock_Expecter) CreateSession(ctx interface{}, session interface{}) *SessionRepositoryMock_CreateSession_Call {
+	return &SessionRepositoryMock_CreateSession_Call{Call: _e.mock.On("CreateSession", ctx, session)}
+}
+
+func (_c *SessionRepositoryMock_CreateSession_Call) Run(run func(ctx context.Context, session *entity.Session)) *SessionRepositoryMock_CreateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Session))
+	})
+	return _c
+}
+
+func (_c *SessionRepositoryMock_CreateSession_Call) Return(_a0 *entity.Session, _a1 error) *SessionRepositoryMock_CreateSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionRepositoryMock_CreateSession_Call) RunAndReturn(run func(context.Context, *entity.Session) (*entity.Session, error)) *SessionRepositoryMock_CreateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredSessions provides a mock function with given fields: ctx
+func (_m *SessionRepositoryMock) DeleteExpiredSessions(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionRepositoryMock_DeleteExpiredSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredSessions'
+type SessionRepositoryMock_DeleteExpiredSessions_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredSessions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SessionRepositoryMock_Expecter) DeleteExpiredSessions(ctx interface{}) *SessionRepositoryMock_DeleteExpiredSessions_Call {
+	return &SessionRepositoryMock_DeleteExpiredSessions_Call{Call: _e.mock.On("DeleteExpiredSessions", ctx)}
+}
+
+func (_c *SessionRepositoryMock_DeleteExpiredSessions_Call) Run(run func(ctx context.Context)) *SessionRepositoryMock_DeleteExpiredSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SessionRepositoryMock_DeleteExpiredSessions_Call) Return(_a0 error) *SessionRepositoryMock_DeleteExpiredSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionRepositoryMock_DeleteExpiredSessions_Call) RunAndReturn(run func(context.Context) error) *SessionRepositoryMock_DeleteExpiredSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteSessionByID provides a mock function with given fields: ctx, id
+func (_m *SessionRepositoryMock) DeleteSessionByID(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSessionByID")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SessionRepositoryMock_DeleteSessionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSessionByID'
+type SessionRepositoryMock_DeleteSessionByID_Call struct {
+	*mock.Call
+}
+
+// DeleteSessionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *SessionRepositoryMock_Expecter) DeleteSessionByID(ctx interface{}, id interface{}) *SessionRepositoryMock_DeleteSessionByID_Call {
+	return &SessionRepositoryMock_DeleteSessionByID_Call{Call: _e.mock.On("DeleteSessionByID", ctx, id)}
+}
+
+func (_c *SessionRepositoryMock_DeleteSessionByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *SessionRepositoryMock_DeleteSessionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SessionRepositoryMock_DeleteSessionByID_Call) Return(_a0 error) *SessionRepositoryMock_DeleteSessionByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SessionRepositoryMock_DeleteSessionByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *SessionRepositoryMock_DeleteSessionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSessionByID provides a mock function with given fields: ctx, id
+func (_m *SessionRepositoryMock) FindSessionByID(ctx context.Context, id uuid.UUID) (*entity.Session, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSessionByID")
+	}
+
+	var r0 *entity.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*entity.Session, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *entity.Session); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionRepositoryMock_FindSessionByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSessionByID'
+type SessionRepositoryMock_FindSessionByID_Call struct {
+	*mock.Call
+}
+
+// FindSessionByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *SessionRepositoryMock_Expecter) FindSessionByID(ctx interface{}, id interface{}) *SessionRepositoryMock_FindSessionByID_Call {
+	return &SessionRepositoryMock_FindSessionByID_Call{Call: _e.mock.On("FindSessionByID", ctx, id)}
+}
+
+func (_c *SessionRepositoryMock_FindSessionByID_Call) Run(run func(ctx context.Context, id uuid.UUID)) *SessionRepositoryMock_FindSessionByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SessionRepositoryMock_FindSessionByID_Call) Return(_a0 *entity.Session, _a1 error) *SessionRepositoryMock_FindSessionByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionRepositoryMock_FindSessionByID_Call) RunAndReturn(run func(context.Context, uuid.UUID) (*entity.Session, error)) *SessionRepositoryMock_FindSessionByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSessionsByUserID provides a mock function with given fields: ctx, userID
+func (_m *SessionRepositoryMock) FindSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSessionsByUserID")
+	}
+
+	var r0 []*entity.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.Session, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.Session); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionRepositoryMock_FindSessionsByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSessionsByUserID'
+type SessionRepositoryMock_FindSessionsByUserID_Call struct {
+	*mock.Call
+}
+
+// FindSessionsByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *SessionRepositoryMock_Expecter) FindSessionsByUserID(ctx interface{}, userID interface{}) *SessionRepositoryMock_FindSessionsByUserID_Call {
+	return &SessionRepositoryMock_FindSessionsByUserID_Call{Call: _e.mock.On("FindSessionsByUserID", ctx, userID)}
+}
+
+func (_c *SessionRepositoryMock_FindSessionsByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *SessionRepositoryMock_FindSessionsByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *SessionRepositoryMock_FindSessionsByUserID_Call) Return(_a0 []*entity.Session, _a1 error) *SessionRepositoryMock_FindSessionsByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionRepositoryMock_FindSessionsByUserID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.Session, error)) *SessionRepositoryMock_FindSessionsByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateSession provides a mock function with given fields: ctx, session, oldJTI
+func (_m *SessionRepositoryMock) RotateSession(ctx context.Context, session *entity.Session, oldJTI string) (bool, error) {
+	ret := _m.Called(ctx, session, oldJTI)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateSession")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Session, string) (bool, error)); ok {
+		return rf(ctx, session, oldJTI)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.Session, string) bool); ok {
+		r0 = rf(ctx, session, oldJTI)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.Session, string) error); ok {
+		r1 = rf(ctx, session, oldJTI)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SessionRepositoryMock_RotateSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateSession'
+type SessionRepositoryMock_RotateSession_Call struct {
+	*mock.Call
+}
+
+// RotateSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - session *entity.Session
+//   - oldJTI string
+func (_e *SessionRepositoryMock_Expecter) RotateSession(ctx interface{}, session interface{}, oldJTI interface{}) *SessionRepositoryMock_RotateSession_Call {
+	return &SessionRepositoryMock_RotateSession_Call{Call: _e.mock.On("RotateSession", ctx, session, oldJTI)}
+}
+
+func (_c *SessionRepositoryMock_RotateSession_Call) Run(run func(ctx context.Context, session *entity.Session, oldJTI string)) *SessionRepositoryMock_RotateSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.Session), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *SessionRepositoryMock_RotateSession_Call) Return(_a0 bool, _a1 error) *SessionRepositoryMock_RotateSession_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SessionRepositoryMock_RotateSession_Call) RunAndReturn(run func(context.Context, *entity.Session, string) (bool, error)) *SessionRepositoryMock_RotateSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSessionRepositoryMock creates a new instance of SessionRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSessionRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SessionRepositoryMock {
+	mock := &SessionRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,296 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	entity "github.com/mandarine-io/backend/internal/persistence/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SigningKeyRepositoryMock is an autogenerated mock type for the SigningKeyRepository type
+type SigningKeyRepositoryMock struct {
+	mock.Mock
+}
+
+type SigningKeyRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SigningKeyRepositoryMock) EXPECT() *SigningKeyRepositoryMock_Expecter {
+	return &SigningKeyRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateSigningKey provides a mock function with given fields: ctx, signingKey
+func (_m *SigningKeyRepositoryMock) CreateSigningKey(ctx context.Context, signingKey *entity.SigningKey) (*entity.SigningKey, error) {
+	ret := _m.Called(ctx, signingKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSigningKey")
+	}
+
+	var r0 *entity.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.SigningKey) (*entity.SigningKey, error)); ok {
+		return rf(ctx, signingKey)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.SigningKey) *entity.SigningKey); ok {
+		r0 = rf(ctx, signingKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.SigningKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.SigningKey) error); ok {
+		r1 = rf(ctx, signingKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SigningKeyRepositoryMock_CreateSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSigningKey'
+type SigningKeyRepositoryMock_CreateSigningKey_Call struct {
+	*mock.Call
+}
+
+// CreateSigningKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - signingKey *entity.SigningKey
+func (_e *SigningKeyRepositoryMock_Expecter) CreateSigningKey(ctx interface{}, signingKey interface{}) *SigningKeyRepositoryMock_CreateSigningKey_Call {
+	return &SigningKeyRepositoryMock_CreateSigningKey_Call{Call: _e.mock.On("CreateSigningKey", ctx, signingKey)}
+}
+
+func (_c *SigningKeyRepositoryMock_CreateSigningKey_Call) Run(run func(ctx context.Context, signingKey *entity.SigningKey)) *SigningKeyRepositoryMock_CreateSigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.SigningKey))
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_CreateSigningKey_Call) Return(_a0 *entity.SigningKey, _a1 error) *SigningKeyRepositoryMock_CreateSigningKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_CreateSigningKey_Call) RunAndReturn(run func(context.Context, *entity.SigningKey) (*entity.SigningKey, error)) *SigningKeyRepositoryMock_CreateSigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteExpiredSigningKeys provides a mock function with given fields: ctx
+func (_m *SigningKeyRepositoryMock) DeleteExpiredSigningKeys(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredSigningKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SigningKeyRepositoryMock_DeleteExpiredSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteExpiredSigningKeys'
+type SigningKeyRepositoryMock_DeleteExpiredSigningKeys_Call struct {
+	*mock.Call
+}
+
+// DeleteExpiredSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SigningKeyRepositoryMock_Expecter) DeleteExpiredSigningKeys(ctx interface{}) *SigningKeyRepositoryMock_DeleteExpiredSigningKeys_Call {
+	return &SigningKeyRepositoryMock_DeleteExpiredSigningKeys_Call{Call: _e.mock.On("DeleteExpiredSigningKeys", ctx)}
+}
+
+func (_c *SigningKeyRepositoryMock_DeleteExpiredSigningKeys_Call) Run(run func(ctx context.Context)) *SigningKeyRepositoryMock_DeleteExpiredSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_DeleteExpiredSigningKeys_Call) Return(_a0 error) *SigningKeyRepositoryMock_DeleteExpiredSigningKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_DeleteExpiredSigningKeys_Call) RunAndReturn(run func(context.Context) error) *SigningKeyRepositoryMock_DeleteExpiredSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpireSigningKeys provides a mock function with given fields: ctx, exceptID, expiredAt
+func (_m *SigningKeyRepositoryMock) ExpireSigningKeys(ctx context.Context, exceptID string, expiredAt time.Time) error {
+	ret := _m.Called(ctx, exceptID, expiredAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpireSigningKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, exceptID, expiredAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SigningKeyRepositoryMock_ExpireSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpireSigningKeys'
+type SigningKeyRepositoryMock_ExpireSigningKeys_Call struct {
+	*mock.Call
+}
+
+// ExpireSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - exceptID string
+//   - expiredAt time.Time
+func (_e *SigningKeyRepositoryMock_Expecter) ExpireSigningKeys(ctx interface{}, exceptID interface{}, expiredAt interface{}) *SigningKeyRepositoryMock_ExpireSigningKeys_Call {
+	return &SigningKeyRepositoryMock_ExpireSigningKeys_Call{Call: _e.mock.On("ExpireSigningKeys", ctx, exceptID, expiredAt)}
+}
+
+func (_c *SigningKeyRepositoryMock_ExpireSigningKeys_Call) Run(run func(ctx context.Context, exceptID string, expiredAt time.Time)) *SigningKeyRepositoryMock_ExpireSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_ExpireSigningKeys_Call) Return(_a0 error) *SigningKeyRepositoryMock_ExpireSigningKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_ExpireSigningKeys_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *SigningKeyRepositoryMock_ExpireSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindSigningKeys provides a mock function with given fields: ctx
+func (_m *SigningKeyRepositoryMock) FindSigningKeys(ctx context.Context) ([]*entity.SigningKey, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSigningKeys")
+	}
+
+	var r0 []*entity.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*entity.SigningKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*entity.SigningKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.SigningKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SigningKeyRepositoryMock_FindSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindSigningKeys'
+type SigningKeyRepositoryMock_FindSigningKeys_Call struct {
+	*mock.Call
+}
+
+// FindSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SigningKeyRepositoryMock_Expecter) FindSigningKeys(ctx interface{}) *SigningKeyRepositoryMock_FindSigningKeys_Call {
+	return &SigningKeyRepositoryMock_FindSigningKeys_Call{Call: _e.mock.On("FindSigningKeys", ctx)}
+}
+
+func (_c *SigningKeyRepositoryMock_FindSigningKeys_Call) Run(run func(ctx context.Context)) *SigningKeyRepositoryMock_FindSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_FindSigningKeys_Call) Return(_a0 []*entity.SigningKey, _a1 error) *SigningKeyRepositoryMock_FindSigningKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_FindSigningKeys_Call) RunAndReturn(run func(context.Context) ([]*entity.SigningKey, error)) *SigningKeyRepositoryMock_FindSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockSigningKeys provides a mock function with given fields: ctx
+func (_m *SigningKeyRepositoryMock) LockSigningKeys(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockSigningKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SigningKeyRepositoryMock_LockSigningKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockSigningKeys'
+type SigningKeyRepositoryMock_LockSigningKeys_Call struct {
+	*mock.Call
+}
+
+// LockSigningKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *SigningKeyRepositoryMock_Expecter) LockSigningKeys(ctx interface{}) *SigningKeyRepositoryMock_LockSigningKeys_Call {
+	return &SigningKeyRepositoryMock_LockSigningKeys_Call{Call: _e.mock.On("LockSigningKeys", ctx)}
+}
+
+func (_c *SigningKeyRepositoryMock_LockSigningKeys_Call) Run(run func(ctx context.Context)) *SigningKeyRepositoryMock_LockSigningKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_LockSigningKeys_Call) Return(_a0 error) *SigningKeyRepositoryMock_LockSigningKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SigningKeyRepositoryMock_LockSigningKeys_Call) RunAndReturn(run func(context.Context) error) *SigningKeyRepositoryMock_LockSigningKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewSigningKeyRepositoryMock creates a new instance of SigningKeyRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSigningKeyRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SigningKeyRepositoryMock {
+	mock := &SigningKeyRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
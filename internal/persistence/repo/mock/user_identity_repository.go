@@ -0,0 +1,275 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	entity "github.com/mandarine-io/backend/internal/persistence/entity"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// UserIdentityRepositoryMock is an autogenerated mock type for the UserIdentityRepository type
+type UserIdentityRepositoryMock struct {
+	mock.Mock
+}
+
+type UserIdentityRepositoryMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *UserIdentityRepositoryMock) EXPECT() *UserIdentityRepositoryMock_Expecter {
+	return &UserIdentityRepositoryMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateUserIdentity provides a mock function with given fields: ctx, identity
+func (_m *UserIdentityRepositoryMock) CreateUserIdentity(ctx context.Context, identity *entity.UserIdentity) (*entity.UserIdentity, error) {
+	ret := _m.Called(ctx, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateUserIdentity")
+	}
+
+	var r0 *entity.UserIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.UserIdentity) (*entity.UserIdentity, error)); ok {
+		return rf(ctx, identity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.UserIdentity) *entity.UserIdentity); ok {
+		r0 = rf(ctx, identity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.UserIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.UserIdentity) error); ok {
+		r1 = rf(ctx, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserIdentityRepositoryMock_CreateUserIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateUserIdentity'
+type UserIdentityRepositoryMock_CreateUserIdentity_Call struct {
+	*mock.Call
+}
+
+// CreateUserIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - identity *entity.UserIdentity
+func (_e *UserIdentityRepositoryMock_Expecter) CreateUserIdentity(ctx interface{}, identity interface{}) *UserIdentityRepositoryMock_CreateUserIdentity_Call {
+	return &UserIdentityRepositoryMock_CreateUserIdentity_Call{Call: _e.mock.On("CreateUserIdentity", ctx, identity)}
+}
+
+func (_c *UserIdentityRepositoryMock_CreateUserIdentity_Call) Run(run func(ctx context.Context, identity *entity.UserIdentity)) *UserIdentityRepositoryMock_CreateUserIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.UserIdentity))
+	})
+	return _c
+}
+
+func (_c *UserIdentityRepositoryMock_CreateUserIdentity_Call) Return(_a0 *entity.UserIdentity, _a1 error) *UserIdentityRepositoryMock_CreateUserIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserIdentityRepositoryMock_CreateUserIdentity_Call) RunAndReturn(run func(context.Context, *entity.UserIdentity) (*entity.UserIdentity, error)) *UserIdentityRepositoryMock_CreateUserIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteUserIdentity provides a mock function with given fields: ctx, userID, provider
+func (_m *UserIdentityRepositoryMock) DeleteUserIdentity(ctx context.Context, userID uuid.UUID, provider string) (bool, error) {
+	ret := _m.Called(ctx, userID, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteUserIdentity")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) (bool, error)); ok {
+		return rf(ctx, userID, provider)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) bool); ok {
+		r0 = rf(ctx, userID, provider)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userID, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserIdentityRepositoryMock_DeleteUserIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteUserIdentity'
+type UserIdentityRepositoryMock_DeleteUserIdentity_Call struct {
+	*mock.Call
+}
+
+// DeleteUserIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - provider string
+func (_e *UserIdentityRepositoryMock_Expecter) DeleteUserIdentity(ctx interface{}, userID interface{}, provider interface{}) *UserIdentityRepositoryMock_DeleteUserIdentity_Call {
+	return &UserIdentityRepositoryMock_DeleteUserIdentity_Call{Call: _e.mock.On("DeleteUserIdentity", ctx, userID, provider)}
+}
+
+func (_c *UserIdentityRepositoryMock_DeleteUserIdentity_Call) Run(run func(ctx context.Context, userID uuid.UUID, provider string)) *UserIdentityRepositoryMock_DeleteUserIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserIdentityRepositoryMock_DeleteUserIdentity_Call) Return(_a0 bool, _a1 error) *UserIdentityRepositoryMock_DeleteUserIdentity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserIdentityRepositoryMock_DeleteUserIdentity_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) (bool, error)) *UserIdentityRepositoryMock_DeleteUserIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserIdentitiesByUserID provides a mock function with given fields: ctx, userID
+func (_m *UserIdentityRepositoryMock) FindUserIdentitiesByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.UserIdentity, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserIdentitiesByUserID")
+	}
+
+	var r0 []*entity.UserIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.UserIdentity, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.UserIdentity); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.UserIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserIdentityRepositoryMock_FindUserIdentitiesByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserIdentitiesByUserID'
+type UserIdentityRepositoryMock_FindUserIdentitiesByUserID_Call struct {
+	*mock.Call
+}
+
+// FindUserIdentitiesByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *UserIdentityRepositoryMock_Expecter) FindUserIdentitiesByUserID(ctx interface{}, userID interface{}) *UserIdentityRepositoryMock_FindUserIdentitiesByUserID_Call {
+	return &UserIdentityRepositoryMock_FindUserIdentitiesByUserID_Call{Call: _e.mock.On("FindUserIdentitiesByUserID", ctx, userID)}
+}
+
+func (_c *UserIdentityRepositoryMock_FindUserIdentitiesByUserID_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *UserIdentityRepositoryMock_FindUserIdentitiesByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *UserIdentityRepositoryMock_FindUserIdentitiesByUserID_Call) Return(_a0 []*entity.UserIdentity, _a1 error) *UserIdentityRepositoryMock_FindUserIdentitiesByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserIdentityRepositoryMock_FindUserIdentitiesByUserID_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]*entity.UserIdentity, error)) *UserIdentityRepositoryMock_FindUserIdentitiesByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindUserIdentityByProviderAndSubject provides a mock function with given fields: ctx, provider, subject
+func (_m *UserIdentityRepositoryMock) FindUserIdentityByProviderAndSubject(ctx context.Context, provider string, subject string) (*entity.UserIdentity, error) {
+	ret := _m.Called(ctx, provider, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserIdentityByProviderAndSubject")
+	}
+
+	var r0 *entity.UserIdentity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entity.UserIdentity, error)); ok {
+		return rf(ctx, provider, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entity.UserIdentity); ok {
+		r0 = rf(ctx, provider, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.UserIdentity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, provider, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserIdentityRepositoryMock_FindUserIdentityByProviderAndSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserIdentityByProviderAndSubject'
+type UserIdentityRepositoryMock_FindUserIdentityByProviderAndSubject_Call struct {
+	*mock.Call
+}
+
+// FindUserIdentityByProviderAndSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - provider string
+//   - subject string
+func (_e *UserIdentityRepositoryMock_Expecter) FindUserIdentityByProviderAndSubject(ctx interface{}, provider interface{}, subject interface{}) *UserIdentityRepositoryMock_FindUserIdentityByProviderAndSubject_Call {
+	return &UserIdentityRepositoryMock_FindUserIdentityByProviderAndSubject_Call{Call: _e.mock.On("FindUserIdentityByProviderAndSubject", ctx, provider, subject)}
+}
+
+func (_c *UserIdentityRepositoryMock_FindUserIdentityByProviderAndSubject_Call) Run(run func(ctx context.Context, provider string, subject string)) *UserIdentityRepositoryMock_FindUserIdentityByProviderAndSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *UserIdentityRepositoryMock_FindUserIdentityByProviderAndSubject_Call) Return(_a0 *entity.UserIdentity, _a1 error) *UserIdentityRepositoryMock_FindUserIdentityByProviderAndSubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserIdentityRepositoryMock_FindUserIdentityByProviderAndSubject_Call) RunAndReturn(run func(context.Context, string, string) (*entity.UserIdentity, error)) *UserIdentityRepositoryMock_FindUserIdentityByProviderAndSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewUserIdentityRepositoryMock creates a new instance of UserIdentityRepositoryMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserIdentityRepositoryMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserIdentityRepositoryMock {
+	mock := &UserIdentityRepositoryMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -257,6 +257,63 @@ func (_c *UserRepositoryMock_ExistsUserByID_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// ExistsUserByPhone provides a mock function with given fields: ctx, phone
+func (_m *UserRepositoryMock) ExistsUserByPhone(ctx context.Context, phone string) (bool, error) {
+	ret := _m.Called(ctx, phone)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistsUserByPhone")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, phone)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, phone)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, phone)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepositoryMock_ExistsUserByPhone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExistsUserByPhone'
+type UserRepositoryMock_ExistsUserByPhone_Call struct {
+	*mock.Call
+}
+
+// ExistsUserByPhone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - phone string
+func (_e *UserRepositoryMock_Expecter) ExistsUserByPhone(ctx interface{}, phone interface{}) *UserRepositoryMock_ExistsUserByPhone_Call {
+	return &UserRepositoryMock_ExistsUserByPhone_Call{Call: _e.mock.On("ExistsUserByPhone", ctx, phone)}
+}
+
+func (_c *UserRepositoryMock_ExistsUserByPhone_Call) Run(run func(ctx context.Context, phone string)) *UserRepositoryMock_ExistsUserByPhone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_ExistsUserByPhone_Call) Return(_a0 bool, _a1 error) *UserRepositoryMock_ExistsUserByPhone_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepositoryMock_ExistsUserByPhone_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *UserRepositoryMock_ExistsUserByPhone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExistsUserByUsername provides a mock function with given fields: ctx, username
 func (_m *UserRepositoryMock) ExistsUserByUsername(ctx context.Context, username string) (bool, error) {
 	ret := _m.Called(ctx, username)
@@ -520,6 +577,80 @@ func (_c *UserRepositoryMock_FindUserByID_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// FindUserByPhone provides a mock function with given fields: ctx, phone, scopes
+func (_m *UserRepositoryMock) FindUserByPhone(ctx context.Context, phone string, scopes ...repo.Scope) (*entity.User, error) {
+	_va := make([]interface{}, len(scopes))
+	for _i := range scopes {
+		_va[_i] = scopes[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, phone)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindUserByPhone")
+	}
+
+	var r0 *entity.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...repo.Scope) (*entity.User, error)); ok {
+		return rf(ctx, phone, scopes...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...repo.Scope) *entity.User); ok {
+		r0 = rf(ctx, phone, scopes...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, ...repo.Scope) error); ok {
+		r1 = rf(ctx, phone, scopes...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepositoryMock_FindUserByPhone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindUserByPhone'
+type UserRepositoryMock_FindUserByPhone_Call struct {
+	*mock.Call
+}
+
+// FindUserByPhone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - phone string
+//   - scopes ...repo.Scope
+func (_e *UserRepositoryMock_Expecter) FindUserByPhone(ctx interface{}, phone interface{}, scopes ...interface{}) *UserRepositoryMock_FindUserByPhone_Call {
+	return &UserRepositoryMock_FindUserByPhone_Call{Call: _e.mock.On("FindUserByPhone",
+		append([]interface{}{ctx, phone}, scopes...)...)}
+}
+
+func (_c *UserRepositoryMock_FindUserByPhone_Call) Run(run func(ctx context.Context, phone string, scopes ...repo.Scope)) *UserRepositoryMock_FindUserByPhone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]repo.Scope, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(repo.Scope)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_FindUserByPhone_Call) Return(_a0 *entity.User, _a1 error) *UserRepositoryMock_FindUserByPhone_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepositoryMock_FindUserByPhone_Call) RunAndReturn(run func(context.Context, string, ...repo.Scope) (*entity.User, error)) *UserRepositoryMock_FindUserByPhone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindUserByUsername provides a mock function with given fields: ctx, username, scopes
 func (_m *UserRepositoryMock) FindUserByUsername(ctx context.Context, username string, scopes ...repo.Scope) (*entity.User, error) {
 	_va := make([]interface{}, len(scopes))
@@ -668,6 +799,64 @@ func (_c *UserRepositoryMock_FindUserByUsernameOrEmail_Call) RunAndReturn(run fu
 	return _c
 }
 
+// UpdateTOTPLastUsedStep provides a mock function with given fields: ctx, id, step
+func (_m *UserRepositoryMock) UpdateTOTPLastUsedStep(ctx context.Context, id uuid.UUID, step int64) (bool, error) {
+	ret := _m.Called(ctx, id, step)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTOTPLastUsedStep")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) (bool, error)); ok {
+		return rf(ctx, id, step)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) bool); ok {
+		r0 = rf(ctx, id, step)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64) error); ok {
+		r1 = rf(ctx, id, step)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepositoryMock_UpdateTOTPLastUsedStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTOTPLastUsedStep'
+type UserRepositoryMock_UpdateTOTPLastUsedStep_Call struct {
+	*mock.Call
+}
+
+// UpdateTOTPLastUsedStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - step int64
+func (_e *UserRepositoryMock_Expecter) UpdateTOTPLastUsedStep(ctx interface{}, id interface{}, step interface{}) *UserRepositoryMock_UpdateTOTPLastUsedStep_Call {
+	return &UserRepositoryMock_UpdateTOTPLastUsedStep_Call{Call: _e.mock.On("UpdateTOTPLastUsedStep", ctx, id, step)}
+}
+
+func (_c *UserRepositoryMock_UpdateTOTPLastUsedStep_Call) Run(run func(ctx context.Context, id uuid.UUID, step int64)) *UserRepositoryMock_UpdateTOTPLastUsedStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *UserRepositoryMock_UpdateTOTPLastUsedStep_Call) Return(_a0 bool, _a1 error) *UserRepositoryMock_UpdateTOTPLastUsedStep_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepositoryMock_UpdateTOTPLastUsedStep_Call) RunAndReturn(run func(context.Context, uuid.UUID, int64) (bool, error)) *UserRepositoryMock_UpdateTOTPLastUsedStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateUser provides a mock function with given fields: ctx, user
 func (_m *UserRepositoryMock) UpdateUser(ctx context.Context, user *entity.User) (*entity.User, error) {
 	ret := _m.Called(ctx, user)
@@ -21,10 +21,33 @@ var (
 	// Master Service errors
 	ErrDuplicateMasterService       = errors.New("duplicate master service")
 	ErrUserForMasterServiceNotExist = errors.New("user for master service does not exist")
+
+	// Session errors
+	ErrUserForSessionNotExist = errors.New("user for session does not exist")
+
+	// Passkey errors
+	ErrDuplicatePasskey = errors.New("duplicate passkey")
+
+	// User identity errors
+	ErrDuplicateUserIdentity = errors.New("duplicate user identity")
+
+	// Service account errors
+	ErrDuplicateServiceAccount = errors.New("duplicate service account")
+
+	// API key errors
+	ErrDuplicateAPIKey = errors.New("duplicate API key")
+
+	// Conversation errors
+	ErrDuplicateConversation = errors.New("duplicate conversation")
 )
 
 type Scope func(db *gorm.DB) *gorm.DB
 
+// Transactor runs function in transaction. Repositories called with context of the function use the transaction
+type Transactor interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 type UserRepository interface {
 	CreateUser(ctx context.Context, user *entity.User) (*entity.User, error)
 	UpdateUser(ctx context.Context, user *entity.User) (*entity.User, error)
@@ -32,10 +55,13 @@ type UserRepository interface {
 	FindUserByUsername(ctx context.Context, username string, scopes ...Scope) (*entity.User, error)
 	FindUserByEmail(ctx context.Context, email string, scopes ...Scope) (*entity.User, error)
 	FindUserByUsernameOrEmail(ctx context.Context, login string, scopes ...Scope) (*entity.User, error)
+	FindUserByPhone(ctx context.Context, phone string, scopes ...Scope) (*entity.User, error)
 	ExistsUserByID(ctx context.Context, id uuid.UUID) (bool, error)
 	ExistsUserByUsername(ctx context.Context, username string) (bool, error)
 	ExistsUserByEmail(ctx context.Context, email string) (bool, error)
 	ExistsUserByUsernameOrEmail(ctx context.Context, username string, email string) (bool, error)
+	ExistsUserByPhone(ctx context.Context, phone string) (bool, error)
+	UpdateTOTPLastUsedStep(ctx context.Context, id uuid.UUID, step int64) (bool, error)
 	DeleteExpiredUser(ctx context.Context) (*entity.User, error)
 
 	WithRolePreload() Scope
@@ -84,3 +110,119 @@ type MasterServiceRepository interface {
 	WithMinIntervalFilter(minDuration time.Duration) Scope
 	WithMaxIntervalFilter(maxDuration time.Duration) Scope
 }
+
+type SessionRepository interface {
+	CreateSession(ctx context.Context, session *entity.Session) (*entity.Session, error)
+	RotateSession(ctx context.Context, session *entity.Session, oldJTI string) (bool, error)
+	FindSessionByID(ctx context.Context, id uuid.UUID) (*entity.Session, error)
+	FindSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error)
+	DeleteSessionByID(ctx context.Context, id uuid.UUID) error
+	DeleteExpiredSessions(ctx context.Context) error
+}
+
+type BannedTokenRepository interface {
+	CreateBannedToken(ctx context.Context, bannedToken *entity.BannedToken) (*entity.BannedToken, error)
+	ExistsBannedTokenByJTI(ctx context.Context, jti string) (bool, error)
+	DeleteExpiredBannedTokens(ctx context.Context) error
+}
+
+type SigningKeyRepository interface {
+	CreateSigningKey(ctx context.Context, signingKey *entity.SigningKey) (*entity.SigningKey, error)
+	// LockSigningKeys serializes rotation of signing keys between instances. It must be called in transaction
+	LockSigningKeys(ctx context.Context) error
+	FindSigningKeys(ctx context.Context) ([]*entity.SigningKey, error)
+	ExpireSigningKeys(ctx context.Context, exceptID string, expiredAt time.Time) error
+	DeleteExpiredSigningKeys(ctx context.Context) error
+}
+
+type RecoveryCodeRepository interface {
+	CreateRecoveryCodes(ctx context.Context, recoveryCodes []*entity.RecoveryCode) error
+	FindUnusedRecoveryCodesByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.RecoveryCode, error)
+	UseRecoveryCode(ctx context.Context, id uuid.UUID) (bool, error)
+	DeleteRecoveryCodesByUserID(ctx context.Context, userID uuid.UUID) error
+}
+
+type PasskeyRepository interface {
+	CreatePasskey(ctx context.Context, passkey *entity.Passkey) (*entity.Passkey, error)
+	FindPasskeysByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Passkey, error)
+	FindPasskeyByCredentialID(ctx context.Context, credentialID []byte) (*entity.Passkey, error)
+	UpdatePasskeySignCount(ctx context.Context, id uuid.UUID, oldSignCount uint32, newSignCount uint32) (bool, error)
+	DeletePasskey(ctx context.Context, id uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+type UserIdentityRepository interface {
+	CreateUserIdentity(ctx context.Context, identity *entity.UserIdentity) (*entity.UserIdentity, error)
+	FindUserIdentityByProviderAndSubject(
+		ctx context.Context,
+		provider string,
+		subject string,
+	) (*entity.UserIdentity, error)
+	FindUserIdentitiesByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.UserIdentity, error)
+	DeleteUserIdentity(ctx context.Context, userID uuid.UUID, provider string) (bool, error)
+}
+
+type ServiceAccountRepository interface {
+	CreateServiceAccount(ctx context.Context, serviceAccount *entity.ServiceAccount) (*entity.ServiceAccount, error)
+	FindServiceAccountsByOwnerID(ctx context.Context, ownerID uuid.UUID) ([]*entity.ServiceAccount, error)
+	FindServiceAccountByID(ctx context.Context, ownerID uuid.UUID, id uuid.UUID) (*entity.ServiceAccount, error)
+	DeleteServiceAccount(ctx context.Context, ownerID uuid.UUID, id uuid.UUID) (bool, error)
+}
+
+type APIKeyRepository interface {
+	CreateAPIKey(ctx context.Context, apiKey *entity.APIKey) (*entity.APIKey, error)
+	RotateAPIKey(ctx context.Context, oldAPIKey *entity.APIKey, newAPIKey *entity.APIKey) (*entity.APIKey, error)
+	FindAPIKeysByServiceAccountID(ctx context.Context, serviceAccountID uuid.UUID) ([]*entity.APIKey, error)
+	FindAPIKeyByID(ctx context.Context, serviceAccountID uuid.UUID, id uuid.UUID) (*entity.APIKey, error)
+	FindAPIKeyByHash(ctx context.Context, keyHash string) (*entity.APIKey, error)
+	RevokeAPIKey(ctx context.Context, serviceAccountID uuid.UUID, id uuid.UUID) (bool, error)
+	TrackAPIKeyUsage(ctx context.Context, id uuid.UUID, ip string) error
+}
+
+type ConversationRepository interface {
+	CreateConversation(ctx context.Context, conversation *entity.Conversation) (*entity.Conversation, error)
+	FindConversationByID(ctx context.Context, id uuid.UUID) (*entity.Conversation, error)
+	FindConversationByParticipants(
+		ctx context.Context,
+		clientID uuid.UUID,
+		masterID uuid.UUID,
+		appointmentID *uuid.UUID,
+	) (*entity.Conversation, error)
+	FindConversationsByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Conversation, error)
+	UpdateConversationReadAt(ctx context.Context, id uuid.UUID, userID uuid.UUID, readAt time.Time) error
+}
+
+// ChatMessageCursor points to chat message, after which older messages are fetched
+type ChatMessageCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+type ChatMessageRepository interface {
+	CreateChatMessage(ctx context.Context, message *entity.ChatMessage) (*entity.ChatMessage, error)
+	FindChatMessageByID(ctx context.Context, conversationID uuid.UUID, id uuid.UUID) (*entity.ChatMessage, error)
+	FindChatMessages(
+		ctx context.Context,
+		conversationID uuid.UUID,
+		cursor *ChatMessageCursor,
+		limit int,
+	) ([]*entity.ChatMessage, error)
+	CountUnreadChatMessages(ctx context.Context, conversation *entity.Conversation, userID uuid.UUID) (int64, error)
+}
+
+type OutboxEventRepository interface {
+	CreateOutboxEvents(ctx context.Context, events []*entity.OutboxEvent) error
+	// FindPendingOutboxEvents locks pending events, which are ready for publishing, until end of transaction.
+	// Only the earliest pending event of aggregate is returned, so events of aggregate are published in order.
+	// Permanently failed events are not pending and do not block later events of aggregate
+	FindPendingOutboxEvents(ctx context.Context, limit int) ([]*entity.OutboxEvent, error)
+	MarkOutboxEventPublished(ctx context.Context, id uuid.UUID, publishedAt time.Time) error
+	MarkOutboxEventFailed(ctx context.Context, id uuid.UUID, lastError string, nextAttemptAt time.Time) error
+	MarkOutboxEventFailedPermanently(ctx context.Context, id uuid.UUID, lastError string, failedAt time.Time) error
+	DeleteOutboxEventsPublishedBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+type RoleRepository interface {
+	FindRoles(ctx context.Context) ([]*entity.RoleEntity, error)
+	FindRoleByName(ctx context.Context, name string) (*entity.RoleEntity, error)
+	UpdateRole(ctx context.Context, role *entity.RoleEntity) (*entity.RoleEntity, error)
+}
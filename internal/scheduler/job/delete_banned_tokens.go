@@ -0,0 +1,18 @@
+package job
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/scheduler"
+)
+
+func DeleteExpiredBannedTokensJob(bannedTokenRepo repo.BannedTokenRepository) scheduler.Job {
+	return scheduler.Job{
+		Ctx:            context.Background(),
+		Name:           "delete-expired-banned-tokens",
+		CronExpression: "0 * * * *",
+		Action: func(ctx context.Context) error {
+			return bannedTokenRepo.DeleteExpiredBannedTokens(ctx)
+		},
+	}
+}
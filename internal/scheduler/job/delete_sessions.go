@@ -0,0 +1,18 @@
+package job
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/scheduler"
+)
+
+func DeleteExpiredSessionsJob(sessionRepo repo.SessionRepository) scheduler.Job {
+	return scheduler.Job{
+		Ctx:            context.Background(),
+		Name:           "delete-expired-sessions",
+		CronExpression: "0 * * * *",
+		Action: func(ctx context.Context) error {
+			return sessionRepo.DeleteExpiredSessions(ctx)
+		},
+	}
+}
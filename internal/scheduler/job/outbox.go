@@ -0,0 +1,31 @@
+package job
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/scheduler"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+)
+
+func RelayOutboxEventsJob(outboxService infrastructure.OutboxService) scheduler.Job {
+	return scheduler.Job{
+		Ctx:            context.Background(),
+		Name:           "relay-outbox-events",
+		CronExpression: "* * * * * *",
+		Action: func(ctx context.Context) error {
+			_, err := outboxService.Relay(ctx)
+			return err
+		},
+	}
+}
+
+func DeletePublishedOutboxEventsJob(outboxService infrastructure.OutboxService) scheduler.Job {
+	return scheduler.Job{
+		Ctx:            context.Background(),
+		Name:           "delete-published-outbox-events",
+		CronExpression: "0 * * * *",
+		Action: func(ctx context.Context) error {
+			_, err := outboxService.Cleanup(ctx)
+			return err
+		},
+	}
+}
@@ -0,0 +1,30 @@
+package job
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/scheduler"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+)
+
+func RotateSigningKeysJob(jwkService infrastructure.JWKService) scheduler.Job {
+	return scheduler.Job{
+		Ctx:            context.Background(),
+		Name:           "rotate-signing-keys",
+		CronExpression: "0 * * * *",
+		Action: func(ctx context.Context) error {
+			return jwkService.RotateKeys(ctx)
+		},
+	}
+}
+
+func DeleteExpiredSigningKeysJob(signingKeyRepo repo.SigningKeyRepository) scheduler.Job {
+	return scheduler.Job{
+		Ctx:            context.Background(),
+		Name:           "delete-expired-signing-keys",
+		CronExpression: "0 * * * *",
+		Action: func(ctx context.Context) error {
+			return signingKeyRepo.DeleteExpiredSigningKeys(ctx)
+		},
+	}
+}
@@ -2,33 +2,57 @@ package account
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/google/uuid"
 	"github.com/mandarine-io/backend/config"
 	"github.com/mandarine-io/backend/internal/converter"
 	"github.com/mandarine-io/backend/internal/infrastructure/locale"
+	"github.com/mandarine-io/backend/internal/infrastructure/sms"
 	"github.com/mandarine-io/backend/internal/infrastructure/smtp"
 	"github.com/mandarine-io/backend/internal/infrastructure/template"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
 	"github.com/mandarine-io/backend/internal/persistence/repo"
 	"github.com/mandarine-io/backend/internal/service/domain"
 	infra "github.com/mandarine-io/backend/internal/service/infrastructure"
 	"github.com/mandarine-io/backend/internal/util/security"
 	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/mandarine-io/backend/third_party/oauth"
 	"github.com/rs/zerolog"
+	"github.com/samber/lo"
 	"time"
 )
 
 const (
 	emailVerifyCachePrefix = "email_verify"
 	emailDefaultTitle      = "Verify email"
+
+	phoneVerifyCachePrefix       = "phone_verify"
+	phoneVerifySMSDefaultContent = "Mandarine: your phone confirmation code is %s. It is valid for %d min."
+
+	identityLinkCachePrefix = "identity_link"
+	oauthStateCachePrefix   = "oauth_state"
+	oauthTokenCachePrefix   = "oauth_token"
+
+	totpAttemptAction = "totp"
 )
 
 type svc struct {
-	userRepo       repo.UserRepository
-	smtpSender     smtp.Sender
-	templateEngine template.Engine
-	otpService     infra.OTPService
-	cfg            config.Config
-	logger         zerolog.Logger
+	userRepo          repo.UserRepository
+	sessionRepo       repo.SessionRepository
+	passkeyRepo       repo.PasskeyRepository
+	identityRepo      repo.UserIdentityRepository
+	oauthProviders    map[string]oauth.Provider
+	smtpSender        smtp.Sender
+	smsSender         sms.Sender
+	templateEngine    template.Engine
+	otpService        infra.OTPService
+	jwtService        infra.JWTService
+	totpService       infra.TOTPService
+	webAuthnService   infra.WebAuthnService
+	bruteForceService infra.BruteForceService
+	cfg               config.Config
+	logger            zerolog.Logger
 }
 
 type Option func(*svc)
@@ -42,18 +66,36 @@ func WithLogger(logger zerolog.Logger) Option {
 func NewService(
 	cfg config.Config,
 	userRepo repo.UserRepository,
+	sessionRepo repo.SessionRepository,
+	passkeyRepo repo.PasskeyRepository,
+	identityRepo repo.UserIdentityRepository,
 	smtpSender smtp.Sender,
+	smsSender sms.Sender,
 	templateEngine template.Engine,
 	otpService infra.OTPService,
+	jwtService infra.JWTService,
+	totpService infra.TOTPService,
+	webAuthnService infra.WebAuthnService,
+	bruteForceService infra.BruteForceService,
+	oauthProviders map[string]oauth.Provider,
 	opts ...Option,
 ) domain.AccountService {
 	s := &svc{
-		userRepo:       userRepo,
-		smtpSender:     smtpSender,
-		templateEngine: templateEngine,
-		otpService:     otpService,
-		cfg:            cfg,
-		logger:         zerolog.Nop(),
+		userRepo:          userRepo,
+		sessionRepo:       sessionRepo,
+		passkeyRepo:       passkeyRepo,
+		identityRepo:      identityRepo,
+		oauthProviders:    oauthProviders,
+		smtpSender:        smtpSender,
+		smsSender:         smsSender,
+		templateEngine:    templateEngine,
+		otpService:        otpService,
+		jwtService:        jwtService,
+		totpService:       totpService,
+		webAuthnService:   webAuthnService,
+		bruteForceService: bruteForceService,
+		cfg:               cfg,
+		logger:            zerolog.Nop(),
 	}
 
 	for _, opt := range opts {
@@ -162,8 +204,8 @@ func (s *svc) UpdateEmail(
 		return v0.AccountOutput{}, domain.ErrDuplicateEmail
 	}
 
-	// Create and save OTP
-	otp, err := s.otpService.GenerateAndSaveWithCode(ctx, emailVerifyCachePrefix, input.Email)
+	// Create and save OTP, it is bound to the user
+	otp, err := s.otpService.GenerateAndSaveWithSubject(ctx, emailVerifyCachePrefix, input.Email, id.String())
 	if err != nil {
 		s.logger.Error().Stack().Err(err).Msg("failed to create and save OTP code")
 		return v0.AccountOutput{}, err
@@ -212,16 +254,16 @@ func (s *svc) VerifyEmail(ctx context.Context, id uuid.UUID, input v0.VerifyEmai
 	s.logger.Info().Msgf("verify email: %s", id.String())
 
 	// Get entry from cache
-	var email string
-	err := s.otpService.GetDataByCode(ctx, emailVerifyCachePrefix, input.OTP, &email)
+	var userID string
+	err := s.otpService.GetDataBySubjectAndCode(ctx, emailVerifyCachePrefix, input.Email, input.OTP, &userID)
 	if err != nil {
 		s.logger.Error().Stack().Err(err).Msg("failed to get data by OTP")
 		return err
 	}
 
-	// Check OTP
-	if input.Email != email {
-		s.logger.Error().Stack().Err(infra.ErrInvalidOrExpiredOTP).Msg("dont match emails in request and OTP data")
+	// Check OTP owner
+	if userID != id.String() {
+		s.logger.Error().Stack().Err(infra.ErrInvalidOrExpiredOTP).Msg("dont match users in request and OTP data")
 		return infra.ErrInvalidOrExpiredOTP
 	}
 
@@ -237,7 +279,7 @@ func (s *svc) VerifyEmail(ctx context.Context, id uuid.UUID, input v0.VerifyEmai
 	}
 
 	// Check email
-	if userEntity.Email != email {
+	if userEntity.Email != input.Email {
 		s.logger.Error().Stack().Err(infra.ErrInvalidOrExpiredOTP).Msg("dont match emails in request and user data")
 		return infra.ErrInvalidOrExpiredOTP
 	}
@@ -252,7 +294,138 @@ func (s *svc) VerifyEmail(ctx context.Context, id uuid.UUID, input v0.VerifyEmai
 	}
 
 	// Delete cache entry
-	err = s.otpService.DeleteDataByCode(ctx, emailVerifyCachePrefix, input.OTP)
+	err = s.otpService.DeleteDataBySubject(ctx, emailVerifyCachePrefix, input.Email)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to delete OTP data")
+	}
+
+	return nil
+}
+
+//////////////////// Update phone ////////////////////
+
+func (s *svc) UpdatePhone(
+	ctx context.Context, id uuid.UUID, input v0.UpdatePhoneInput, localizer locale.Localizer,
+) (v0.AccountOutput, error) {
+	s.logger.Info().Msgf("update phone: %s", id.String())
+
+	// Get user entity
+	userEntity, err := s.userRepo.FindUserByID(ctx, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return v0.AccountOutput{}, err
+	}
+	if userEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return v0.AccountOutput{}, domain.ErrUserNotFound
+	}
+
+	// Check if phone not changed, unverified phone gets a new code
+	isSamePhone := userEntity.Phone != nil && *userEntity.Phone == input.Phone
+	if isSamePhone && userEntity.IsPhoneVerified {
+		return converter.MapUserEntityToAccountOutput(userEntity), nil
+	}
+
+	// Check if phone is already in use
+	if !isSamePhone {
+		exists, err := s.userRepo.ExistsUserByPhone(ctx, input.Phone)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to check exist user by phone")
+			return v0.AccountOutput{}, err
+		}
+		if exists {
+			s.logger.Error().Stack().Err(domain.ErrDuplicatePhone).Msg("user with such phone already exists")
+			return v0.AccountOutput{}, domain.ErrDuplicatePhone
+		}
+	}
+
+	// Create and save OTP, it is bound to the user
+	otp, err := s.otpService.GenerateAndSaveWithSubject(ctx, phoneVerifyCachePrefix, input.Phone, id.String())
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to create and save OTP code")
+		return v0.AccountOutput{}, err
+	}
+
+	// Send SMS
+	args := v0.OTPSMSArgs{
+		OTP: otp,
+		TTL: s.cfg.Security.OTP.TTL / 60,
+	}
+	content := fmt.Sprintf(phoneVerifySMSDefaultContent, args.OTP, args.TTL)
+	if localizer != nil {
+		content = localizer.Localize("sms.phone-verify.content", args, 0)
+	}
+
+	err = s.smsSender.SendMessage(ctx, input.Phone, content)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to send sms")
+		return v0.AccountOutput{}, domain.ErrSendSMS
+	}
+
+	// Update phone
+	userEntity.Phone = &input.Phone
+	userEntity.IsPhoneVerified = false
+
+	userEntity, err = s.userRepo.UpdateUser(ctx, userEntity)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to update user")
+
+		if errors.Is(err, repo.ErrDuplicateUser) {
+			return v0.AccountOutput{}, domain.ErrDuplicatePhone
+		}
+		return v0.AccountOutput{}, err
+	}
+
+	return converter.MapUserEntityToAccountOutput(userEntity), nil
+}
+
+//////////////////// Verify phone ////////////////////
+
+func (s *svc) VerifyPhone(ctx context.Context, id uuid.UUID, input v0.VerifyPhoneInput) error {
+	s.logger.Info().Msgf("verify phone: %s", id.String())
+
+	// Get entry from cache
+	var userID string
+	err := s.otpService.GetDataBySubjectAndCode(ctx, phoneVerifyCachePrefix, input.Phone, input.OTP, &userID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to get data by OTP")
+		return err
+	}
+
+	// Check OTP owner
+	if userID != id.String() {
+		s.logger.Error().Stack().Err(infra.ErrInvalidOrExpiredOTP).Msg("dont match users in request and OTP data")
+		return infra.ErrInvalidOrExpiredOTP
+	}
+
+	// Get user entity by id
+	userEntity, err := s.userRepo.FindUserByID(ctx, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return err
+	}
+	if userEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return domain.ErrUserNotFound
+	}
+
+	// Check phone
+	if userEntity.Phone == nil || *userEntity.Phone != input.Phone {
+		s.logger.Error().Stack().Err(infra.ErrInvalidOrExpiredOTP).Msg("dont match phones in request and user data")
+		return infra.ErrInvalidOrExpiredOTP
+	}
+
+	// Verify phone
+	userEntity.IsPhoneVerified = true
+
+	_, err = s.userRepo.UpdateUser(ctx, userEntity)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to update user")
+		return err
+	}
+
+	// Delete cache entry
+	err = s.otpService.DeleteDataBySubject(ctx, phoneVerifyCachePrefix, input.Phone)
 	if err != nil {
 		s.logger.Warn().Err(err).Msg("failed to delete OTP data")
 	}
@@ -303,7 +476,9 @@ func (s *svc) SetPassword(ctx context.Context, id uuid.UUID, input v0.SetPasswor
 
 //////////////////// Update password ////////////////////
 
-func (s *svc) UpdatePassword(ctx context.Context, id uuid.UUID, input v0.UpdatePasswordInput) error {
+func (s *svc) UpdatePassword(
+	ctx context.Context, id uuid.UUID, sessionID uuid.UUID, input v0.UpdatePasswordInput,
+) error {
 	s.logger.Info().Msgf("update password: %s", id.String())
 
 	// Get user entity
@@ -338,9 +513,568 @@ func (s *svc) UpdatePassword(ctx context.Context, id uuid.UUID, input v0.UpdateP
 		return err
 	}
 
+	// Revoke other sessions
+	if input.RevokeOtherSessions {
+		err = s.jwtService.RevokeSessions(ctx, id, sessionID)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to revoke other sessions")
+			return err
+		}
+	}
+
 	return nil
 }
 
+//////////////////// Sessions ////////////////////
+
+func (s *svc) GetSessions(ctx context.Context, id uuid.UUID, currentSessionID uuid.UUID) (v0.SessionsOutput, error) {
+	s.logger.Info().Msgf("get sessions: %s", id.String())
+
+	sessionEntities, err := s.sessionRepo.FindSessionsByUserID(ctx, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find sessions")
+		return v0.SessionsOutput{}, err
+	}
+
+	return converter.MapEntitiesToSessionsOutput(sessionEntities, currentSessionID), nil
+}
+
+func (s *svc) RevokeSession(ctx context.Context, id uuid.UUID, sessionID uuid.UUID) error {
+	s.logger.Info().Msgf("revoke session: %s", id.String())
+
+	err := s.jwtService.RevokeSession(ctx, id, sessionID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to revoke session")
+		return err
+	}
+
+	return nil
+}
+
+func (s *svc) RevokeOtherSessions(ctx context.Context, id uuid.UUID, currentSessionID uuid.UUID) error {
+	s.logger.Info().Msgf("revoke other sessions: %s", id.String())
+
+	err := s.jwtService.RevokeSessions(ctx, id, currentSessionID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to revoke other sessions")
+		return err
+	}
+
+	return nil
+}
+
+//////////////////// Two-factor authentication ////////////////////
+
+func (s *svc) SetupTOTP(ctx context.Context, id uuid.UUID) (v0.TOTPSetupOutput, error) {
+	s.logger.Info().Msgf("setup totp: %s", id.String())
+
+	// Get user entity
+	userEntity, err := s.userRepo.FindUserByID(ctx, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return v0.TOTPSetupOutput{}, err
+	}
+	if userEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return v0.TOTPSetupOutput{}, domain.ErrUserNotFound
+	}
+
+	// Check if 2FA is enabled
+	if userEntity.IsTOTPEnabled {
+		s.logger.Error().Stack().Err(domain.ErrMFAAlreadyEnabled).Msg("2FA is already enabled")
+		return v0.TOTPSetupOutput{}, domain.ErrMFAAlreadyEnabled
+	}
+
+	// Generate secret, which becomes active after confirmation
+	secret, err := s.totpService.GenerateSecret(ctx)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to generate TOTP secret")
+		return v0.TOTPSetupOutput{}, err
+	}
+
+	userEntity.TOTPSecret = &secret
+	userEntity.TOTPLastUsedStep = nil
+
+	_, err = s.userRepo.UpdateUser(ctx, userEntity)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to update user")
+		return v0.TOTPSetupOutput{}, err
+	}
+
+	return v0.TOTPSetupOutput{
+		Secret: secret,
+		ProvisioningURI: s.totpService.GetProvisioningURI(
+			ctx,
+			secret,
+			lo.CoalesceOrEmpty(userEntity.Email, userEntity.Username),
+		),
+	}, nil
+}
+
+func (s *svc) EnableTOTP(
+	ctx context.Context,
+	id uuid.UUID,
+	input v0.TOTPCodeInput,
+	clientInfo infra.ClientInfo,
+) (v0.RecoveryCodesOutput, error) {
+	s.logger.Info().Msgf("enable totp: %s", id.String())
+
+	// Get user entity
+	userEntity, err := s.userRepo.FindUserByID(ctx, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return v0.RecoveryCodesOutput{}, err
+	}
+	if userEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return v0.RecoveryCodesOutput{}, domain.ErrUserNotFound
+	}
+
+	// Check 2FA state
+	if userEntity.IsTOTPEnabled {
+		s.logger.Error().Stack().Err(domain.ErrMFAAlreadyEnabled).Msg("2FA is already enabled")
+		return v0.RecoveryCodesOutput{}, domain.ErrMFAAlreadyEnabled
+	}
+	if userEntity.TOTPSecret == nil {
+		s.logger.Error().Stack().Err(domain.ErrMFASetupNotStarted).Msg("2FA setup is not started")
+		return v0.RecoveryCodesOutput{}, domain.ErrMFASetupNotStarted
+	}
+
+	// Check code from authenticator app
+	err = s.verifyTOTPCode(ctx, userEntity, input.Code, clientInfo)
+	if err != nil {
+		return v0.RecoveryCodesOutput{}, err
+	}
+
+	// Enable 2FA
+	userEntity.IsTOTPEnabled = true
+
+	_, err = s.userRepo.UpdateUser(ctx, userEntity)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to update user")
+		return v0.RecoveryCodesOutput{}, err
+	}
+
+	// Generate recovery codes
+	codes, err := s.totpService.GenerateRecoveryCodes(ctx, userEntity.ID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to generate recovery codes")
+		return v0.RecoveryCodesOutput{}, err
+	}
+
+	return v0.RecoveryCodesOutput{Codes: codes}, nil
+}
+
+func (s *svc) DisableTOTP(
+	ctx context.Context,
+	id uuid.UUID,
+	input v0.TOTPCodeInput,
+	clientInfo infra.ClientInfo,
+) error {
+	s.logger.Info().Msgf("disable totp: %s", id.String())
+
+	// Get user entity
+	userEntity, err := s.userRepo.FindUserByID(ctx, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return err
+	}
+	if userEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return domain.ErrUserNotFound
+	}
+
+	// Check if 2FA is enabled
+	if !userEntity.IsTOTPEnabled {
+		s.logger.Error().Stack().Err(domain.ErrMFANotEnabled).Msg("2FA is not enabled")
+		return domain.ErrMFANotEnabled
+	}
+
+	// Check TOTP or recovery code
+	err = s.verifyTOTPCode(ctx, userEntity, input.Code, clientInfo)
+	if err != nil {
+		return err
+	}
+
+	// Disable 2FA
+	userEntity.IsTOTPEnabled = false
+	userEntity.TOTPSecret = nil
+	userEntity.TOTPLastUsedStep = nil
+
+	_, err = s.userRepo.UpdateUser(ctx, userEntity)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to update user")
+		return err
+	}
+
+	// Delete recovery codes
+	err = s.totpService.DeleteRecoveryCodes(ctx, userEntity.ID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to delete recovery codes")
+		return err
+	}
+
+	return nil
+}
+
+func (s *svc) RegenerateRecoveryCodes(
+	ctx context.Context,
+	id uuid.UUID,
+	input v0.TOTPCodeInput,
+	clientInfo infra.ClientInfo,
+) (v0.RecoveryCodesOutput, error) {
+	s.logger.Info().Msgf("regenerate recovery codes: %s", id.String())
+
+	// Get user entity
+	userEntity, err := s.userRepo.FindUserByID(ctx, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return v0.RecoveryCodesOutput{}, err
+	}
+	if userEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return v0.RecoveryCodesOutput{}, domain.ErrUserNotFound
+	}
+
+	// Check if 2FA is enabled
+	if !userEntity.IsTOTPEnabled {
+		s.logger.Error().Stack().Err(domain.ErrMFANotEnabled).Msg("2FA is not enabled")
+		return v0.RecoveryCodesOutput{}, domain.ErrMFANotEnabled
+	}
+
+	// Check TOTP or recovery code
+	err = s.verifyTOTPCode(ctx, userEntity, input.Code, clientInfo)
+	if err != nil {
+		return v0.RecoveryCodesOutput{}, err
+	}
+
+	// Generate recovery codes
+	codes, err := s.totpService.GenerateRecoveryCodes(ctx, userEntity.ID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to generate recovery codes")
+		return v0.RecoveryCodesOutput{}, err
+	}
+
+	return v0.RecoveryCodesOutput{Codes: codes}, nil
+}
+
+// verifyTOTPCode checks TOTP or recovery code with brute-force protection, which is shared by all 2FA management
+// requests of user
+func (s *svc) verifyTOTPCode(
+	ctx context.Context,
+	userEntity *entity.User,
+	code string,
+	clientInfo infra.ClientInfo,
+) error {
+	attempt := infra.Attempt{Action: totpAttemptAction, Account: userEntity.ID.String(), IP: clientInfo.IP}
+	err := s.bruteForceService.CheckAttempt(ctx, attempt)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("attempts are locked")
+		return err
+	}
+
+	err = s.totpService.VerifyCode(ctx, userEntity, code)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to verify TOTP code")
+
+		if !errors.Is(err, infra.ErrInvalidTOTPCode) {
+			return err
+		}
+
+		lockErr := s.bruteForceService.FailAttempt(ctx, attempt)
+		if errors.As(lockErr, &infra.LockoutError{}) {
+			s.logger.Error().Stack().Err(lockErr).Msg("attempts are locked")
+			return lockErr
+		}
+		if lockErr != nil {
+			s.logger.Warn().Err(lockErr).Msg("failed to register failed attempt")
+		}
+
+		return err
+	}
+
+	err = s.bruteForceService.ResetAttempts(ctx, attempt)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to reset attempts")
+	}
+
+	return nil
+}
+
+//////////////////// Passkeys ////////////////////
+
+func (s *svc) BeginPasskeyRegistration(ctx context.Context, id uuid.UUID) (v0.PasskeyCreationOptionsOutput, error) {
+	s.logger.Info().Msgf("begin passkey registration: %s", id.String())
+
+	// Get user entity
+	userEntity, err := s.userRepo.FindUserByID(ctx, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return v0.PasskeyCreationOptionsOutput{}, err
+	}
+	if userEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return v0.PasskeyCreationOptionsOutput{}, domain.ErrUserNotFound
+	}
+
+	// Create registration challenge
+	output, err := s.webAuthnService.BeginRegistration(ctx, userEntity)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to begin passkey registration")
+		return v0.PasskeyCreationOptionsOutput{}, err
+	}
+
+	return output, nil
+}
+
+func (s *svc) FinishPasskeyRegistration(
+	ctx context.Context,
+	id uuid.UUID,
+	input v0.PasskeyRegistrationInput,
+) (v0.PasskeyOutput, error) {
+	s.logger.Info().Msgf("finish passkey registration: %s", id.String())
+
+	// Get user entity
+	userEntity, err := s.userRepo.FindUserByID(ctx, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return v0.PasskeyOutput{}, err
+	}
+	if userEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return v0.PasskeyOutput{}, domain.ErrUserNotFound
+	}
+
+	// Verify attestation and save passkey
+	passkeyEntity, err := s.webAuthnService.FinishRegistration(ctx, userEntity, input)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to finish passkey registration")
+		return v0.PasskeyOutput{}, err
+	}
+
+	return converter.MapPasskeyEntityToPasskeyOutput(passkeyEntity), nil
+}
+
+func (s *svc) GetPasskeys(ctx context.Context, id uuid.UUID) (v0.PasskeysOutput, error) {
+	s.logger.Info().Msgf("get passkeys: %s", id.String())
+
+	passkeyEntities, err := s.passkeyRepo.FindPasskeysByUserID(ctx, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find passkeys")
+		return v0.PasskeysOutput{}, err
+	}
+
+	return converter.MapPasskeyEntitiesToPasskeysOutput(passkeyEntities), nil
+}
+
+func (s *svc) DeletePasskey(ctx context.Context, id uuid.UUID, passkeyID uuid.UUID) error {
+	s.logger.Info().Msgf("delete passkey: %s", id.String())
+
+	deleted, err := s.passkeyRepo.DeletePasskey(ctx, passkeyID, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to delete passkey")
+		return err
+	}
+	if !deleted {
+		s.logger.Error().Stack().Err(domain.ErrPasskeyNotFound).Msg("passkey not found")
+		return domain.ErrPasskeyNotFound
+	}
+
+	return nil
+}
+
+//////////////////// Identities ////////////////////
+
+func (s *svc) GetIdentities(ctx context.Context, id uuid.UUID) (v0.IdentitiesOutput, error) {
+	s.logger.Info().Msgf("get identities: %s", id.String())
+
+	identityEntities, err := s.identityRepo.FindUserIdentitiesByUserID(ctx, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find identities")
+		return v0.IdentitiesOutput{}, err
+	}
+
+	return converter.MapUserIdentityEntitiesToIdentitiesOutput(identityEntities), nil
+}
+
+func (s *svc) LinkIdentity(
+	ctx context.Context,
+	id uuid.UUID,
+	provider string,
+	input v0.LinkIdentityInput,
+	clientInfo infra.ClientInfo,
+) (v0.IdentityOutput, error) {
+	s.logger.Info().Msgf("link identity: %s, provider=%s", id.String(), provider)
+
+	// Get oauth provider
+	oauthProvider, ok := s.oauthProviders[provider]
+	if !ok {
+		s.logger.Error().Stack().Err(domain.ErrInvalidProvider).Msg("provider not found")
+		return v0.IdentityOutput{}, domain.ErrInvalidProvider
+	}
+
+	// Consume state, which is issued by social login consent page request
+	var stateData v0.OAuthStateData
+	err := s.otpService.ConsumeDataByToken(ctx, oauthStateCachePrefix, input.State, &stateData)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to consume oauth state")
+
+		if errors.Is(err, infra.ErrInvalidOrExpiredOTP) {
+			return v0.IdentityOutput{}, domain.ErrInvalidState
+		}
+		return v0.IdentityOutput{}, err
+	}
+	if stateData.Provider != provider || stateData.UserAgent != clientInfo.UserAgent {
+		s.logger.Error().Stack().Err(domain.ErrInvalidState).Msg("oauth state is issued for another provider or client")
+		return v0.IdentityOutput{}, domain.ErrInvalidState
+	}
+
+	// Exchange code to token, the same callback page is used as for social login
+	socialLoginCallbackURL := fmt.Sprintf("%s/auth/social/%s/callback/", s.cfg.Server.ExternalURL, provider)
+	token, err := oauthProvider.ExchangeCodeToToken(
+		ctx,
+		input.Code,
+		socialLoginCallbackURL,
+		stateData.CodeVerifier,
+		oauth.WithState(input.State),
+		oauth.WithStateIssuedAt(stateData.IssuedAt),
+		oauth.WithDeviceID(input.DeviceID),
+	)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to exchange code to token")
+		return v0.IdentityOutput{}, err
+	}
+
+	// Token is accepted once. Signed auth data of some providers (e.g. Telegram) is verified locally
+	// and can be sent in several encodings, so it is identified by token normalized by provider
+	err = s.otpService.ConsumeToken(ctx, oauthTokenCachePrefix, token.AccessToken, oauth.MaxCodeAge)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to consume oauth token")
+
+		if errors.Is(err, infra.ErrInvalidOrExpiredOTP) {
+			return v0.IdentityOutput{}, domain.ErrInvalidState
+		}
+		return v0.IdentityOutput{}, err
+	}
+
+	// Get user info
+	userInfo, err := oauthProvider.GetUserInfo(ctx, token)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to get user info")
+
+		if errors.Is(err, oauth.ErrUserInfoNotReceived) {
+			return v0.IdentityOutput{}, domain.ErrUserInfoNotReceived
+		}
+		return v0.IdentityOutput{}, err
+	}
+	if userInfo.ID == "" {
+		s.logger.Error().Stack().Err(domain.ErrUserInfoNotReceived).Msg("subject is empty")
+		return v0.IdentityOutput{}, domain.ErrUserInfoNotReceived
+	}
+
+	// Check if identity is already linked
+	identityEntity, err := s.identityRepo.FindUserIdentityByProviderAndSubject(ctx, provider, userInfo.ID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find identity")
+		return v0.IdentityOutput{}, err
+	}
+	// Accounts are not merged implicitly, identity of another account must be unlinked there first
+	if identityEntity != nil {
+		if identityEntity.UserID != id {
+			s.logger.Error().Stack().Err(domain.ErrIdentityLinked).Msg("identity is linked to another user")
+			return v0.IdentityOutput{}, domain.ErrIdentityLinked
+		}
+
+		return converter.MapUserIdentityEntityToIdentityOutput(identityEntity), nil
+	}
+
+	return s.createIdentity(ctx, converter.MapUserInfoToUserIdentityEntity(provider, userInfo, id))
+}
+
+func (s *svc) ConfirmIdentityLink(
+	ctx context.Context,
+	id uuid.UUID,
+	input v0.ConfirmIdentityLinkInput,
+) (v0.IdentityOutput, error) {
+	s.logger.Info().Msgf("confirm identity link: %s", id.String())
+
+	// Get data by link token
+	var data v0.IdentityLinkData
+	err := s.otpService.ConsumeDataByToken(ctx, identityLinkCachePrefix, input.Token, &data)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to consume identity link token")
+		return v0.IdentityOutput{}, err
+	}
+
+	// Link token is issued for the account with the same email, only its owner can confirm linking
+	if data.UserID != id.String() {
+		s.logger.Error().Stack().Err(infra.ErrInvalidOrExpiredOTP).Msg("dont match users in request and token data")
+		return v0.IdentityOutput{}, infra.ErrInvalidOrExpiredOTP
+	}
+
+	userInfo := oauth.UserInfo{ID: data.Subject, Email: data.Email}
+	return s.createIdentity(ctx, converter.MapUserInfoToUserIdentityEntity(data.Provider, userInfo, id))
+}
+
+func (s *svc) UnlinkIdentity(ctx context.Context, id uuid.UUID, provider string) error {
+	s.logger.Info().Msgf("unlink identity: %s, provider=%s", id.String(), provider)
+
+	// Get user entity
+	userEntity, err := s.userRepo.FindUserByID(ctx, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return err
+	}
+	if userEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return domain.ErrUserNotFound
+	}
+
+	identityEntities, err := s.identityRepo.FindUserIdentitiesByUserID(ctx, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find identities")
+		return err
+	}
+	if !lo.ContainsBy(identityEntities, func(item *entity.UserIdentity) bool { return item.Provider == provider }) {
+		s.logger.Error().Stack().Err(domain.ErrIdentityNotFound).Msg("identity not found")
+		return domain.ErrIdentityNotFound
+	}
+
+	// User without password, verified email and phone could sign in only with the identity
+	hasOtherLoginMethod := !userEntity.IsPasswordTemp || userEntity.IsEmailVerified || userEntity.IsPhoneVerified ||
+		len(identityEntities) > 1
+	if !hasOtherLoginMethod {
+		s.logger.Error().Stack().Err(domain.ErrLastLoginMethod).Msg("identity is the last login method")
+		return domain.ErrLastLoginMethod
+	}
+
+	deleted, err := s.identityRepo.DeleteUserIdentity(ctx, id, provider)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to delete identity")
+		return err
+	}
+	if !deleted {
+		s.logger.Error().Stack().Err(domain.ErrIdentityNotFound).Msg("identity not found")
+		return domain.ErrIdentityNotFound
+	}
+
+	return nil
+}
+
+func (s *svc) createIdentity(ctx context.Context, identityEntity *entity.UserIdentity) (v0.IdentityOutput, error) {
+	identityEntity, err := s.identityRepo.CreateUserIdentity(ctx, identityEntity)
+	if errors.Is(err, repo.ErrDuplicateUserIdentity) {
+		s.logger.Error().Stack().Err(domain.ErrIdentityLinked).Msg("identity is already linked")
+		return v0.IdentityOutput{}, domain.ErrIdentityLinked
+	}
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to create identity")
+		return v0.IdentityOutput{}, err
+	}
+
+	return converter.MapUserIdentityEntityToIdentityOutput(identityEntity), nil
+}
+
 //////////////////// Restore account ////////////////////
 
 func (s *svc) RestoreAccount(ctx context.Context, id uuid.UUID) (v0.AccountOutput, error) {
@@ -2,14 +2,21 @@ package auth
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/mandarine-io/backend/config"
 	"github.com/mandarine-io/backend/internal/converter"
 	"github.com/mandarine-io/backend/internal/infrastructure/locale"
+	"github.com/mandarine-io/backend/internal/infrastructure/sms"
 	"github.com/mandarine-io/backend/internal/infrastructure/smtp"
 	"github.com/mandarine-io/backend/internal/infrastructure/template"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
 	"github.com/mandarine-io/backend/internal/persistence/repo"
 	"github.com/mandarine-io/backend/internal/service/domain"
 	infra "github.com/mandarine-io/backend/internal/service/infrastructure"
@@ -17,6 +24,8 @@ import (
 	"github.com/mandarine-io/backend/pkg/model/v0"
 	"github.com/mandarine-io/backend/third_party/oauth"
 	"github.com/rs/zerolog"
+	"net/url"
+	"time"
 )
 
 const (
@@ -25,17 +34,49 @@ const (
 
 	recoveryPasswordCachePrefix = "recovery_password"
 	recoveryEmailDefaultTitle   = "Recovery password"
+
+	sessionCompromisedEmailDefaultTitle = "Security alert"
+
+	loginPhoneCachePrefix       = "login_phone"
+	loginPhoneSMSDefaultContent = "Mandarine: your sign in code is %s. It is valid for %d min. " +
+		"Do not share it with anyone."
+
+	identityLinkCachePrefix = "identity_link"
+	oauthStateCachePrefix   = "oauth_state"
+	oauthTokenCachePrefix   = "oauth_token"
+
+	magicLinkCachePrefix       = "magic_link"
+	magicLinkEmailDefaultTitle = "Sign in link"
+	magicLinkDeviceSecretSize  = 32
+
+	loginAttemptAction            = "login"
+	loginPhoneAttemptAction       = "login_phone"
+	loginMFAAttemptAction         = "login_mfa"
+	registerConfirmAttemptAction  = "register_confirm"
+	recoveryPasswordAttemptAction = "recovery_password"
 )
 
+// magicLinkData keeps only hash of device secret, the secret itself is stored in the browser cookie
+type magicLinkData struct {
+	UserID     string `json:"userId"`
+	DeviceHash string `json:"deviceHash"`
+}
+
 type svc struct {
-	cfg            config.Config
-	userRepo       repo.UserRepository
-	oauthProviders map[string]oauth.Provider
-	smtpSender     smtp.Sender
-	templateEngine template.Engine
-	jwtService     infra.JWTService
-	otpService     infra.OTPService
-	logger         zerolog.Logger
+	cfg               config.Config
+	userRepo          repo.UserRepository
+	identityRepo      repo.UserIdentityRepository
+	transactor        repo.Transactor
+	oauthProviders    map[string]oauth.Provider
+	smtpSender        smtp.Sender
+	smsSender         sms.Sender
+	templateEngine    template.Engine
+	jwtService        infra.JWTService
+	otpService        infra.OTPService
+	totpService       infra.TOTPService
+	webAuthnService   infra.WebAuthnService
+	bruteForceService infra.BruteForceService
+	logger            zerolog.Logger
 }
 
 type Option func(*svc)
@@ -49,22 +90,34 @@ func WithLogger(logger zerolog.Logger) Option {
 func NewService(
 	cfg config.Config,
 	smtpSender smtp.Sender,
+	smsSender sms.Sender,
 	templateEngine template.Engine,
 	userRepo repo.UserRepository,
+	identityRepo repo.UserIdentityRepository,
+	transactor repo.Transactor,
 	jwtService infra.JWTService,
 	otpService infra.OTPService,
+	totpService infra.TOTPService,
+	webAuthnService infra.WebAuthnService,
+	bruteForceService infra.BruteForceService,
 	oauthProviders map[string]oauth.Provider,
 	opts ...Option,
 ) domain.AuthService {
 	s := &svc{
-		userRepo:       userRepo,
-		oauthProviders: oauthProviders,
-		smtpSender:     smtpSender,
-		templateEngine: templateEngine,
-		jwtService:     jwtService,
-		otpService:     otpService,
-		cfg:            cfg,
-		logger:         zerolog.Nop(),
+		userRepo:          userRepo,
+		identityRepo:      identityRepo,
+		transactor:        transactor,
+		oauthProviders:    oauthProviders,
+		smtpSender:        smtpSender,
+		smsSender:         smsSender,
+		templateEngine:    templateEngine,
+		jwtService:        jwtService,
+		otpService:        otpService,
+		totpService:       totpService,
+		webAuthnService:   webAuthnService,
+		bruteForceService: bruteForceService,
+		cfg:               cfg,
+		logger:            zerolog.Nop(),
 	}
 
 	for _, opt := range opts {
@@ -98,7 +151,7 @@ func (s *svc) Register(ctx context.Context, input v0.RegisterInput, localizer lo
 	}
 
 	// Create and save OTP
-	otp, err := s.otpService.GenerateAndSaveWithCode(ctx, registerCachePrefix, input)
+	otp, err := s.otpService.GenerateAndSaveWithSubject(ctx, registerCachePrefix, input.Email, input)
 	if err != nil {
 		s.logger.Error().Stack().Err(err).Msg("failed to create and save OTP")
 		return err
@@ -133,21 +186,32 @@ func (s *svc) Register(ctx context.Context, input v0.RegisterInput, localizer lo
 
 //////////////////// Register confirmation ////////////////////
 
-func (s *svc) RegisterConfirm(ctx context.Context, input v0.RegisterConfirmInput) error {
+func (s *svc) RegisterConfirm(
+	ctx context.Context,
+	input v0.RegisterConfirmInput,
+	clientInfo infra.ClientInfo,
+) error {
 	s.logger.Info().Msg("register confirm")
 
+	// Check brute-force lock
+	attempt := infra.Attempt{Action: registerConfirmAttemptAction, Account: input.Email, IP: clientInfo.IP}
+	err := s.bruteForceService.CheckAttempt(ctx, attempt)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("attempts are locked")
+		return err
+	}
+
 	// Get data by OTP
 	var registerInput v0.RegisterInput
-	err := s.otpService.GetDataByCode(ctx, registerCachePrefix, input.OTP, &registerInput)
+	err = s.otpService.GetDataBySubjectAndCode(ctx, registerCachePrefix, input.Email, input.OTP, &registerInput)
 	if err != nil {
 		s.logger.Error().Stack().Err(err).Msg("failed to get data by OTP")
-		return err
+		return s.failAttempt(ctx, attempt, err)
 	}
 
-	// Check email
-	if input.Email != registerInput.Email {
-		s.logger.Error().Stack().Err(infra.ErrInvalidOrExpiredOTP).Msg("dont match emails in request and OTP data")
-		return infra.ErrInvalidOrExpiredOTP
+	err = s.bruteForceService.ResetAttempts(ctx, attempt)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to reset attempts")
 	}
 
 	// Check if user exists
@@ -175,7 +239,7 @@ func (s *svc) RegisterConfirm(ctx context.Context, input v0.RegisterConfirmInput
 	}
 
 	// Delete cache
-	err = s.otpService.DeleteDataByCode(ctx, registerCachePrefix, input.OTP)
+	err = s.otpService.DeleteDataBySubject(ctx, registerCachePrefix, input.Email)
 	if err != nil {
 		s.logger.Warn().Err(err).Msg("failed to delete OTP data")
 	}
@@ -185,24 +249,401 @@ func (s *svc) RegisterConfirm(ctx context.Context, input v0.RegisterConfirmInput
 
 //////////////////// Login ////////////////////
 
-func (s *svc) Login(ctx context.Context, input v0.LoginInput) (v0.JwtTokensOutput, error) {
+func (s *svc) Login(ctx context.Context, input v0.LoginInput, clientInfo infra.ClientInfo) (
+	v0.LoginOutput,
+	error,
+) {
 	s.logger.Info().Msg("login")
 
+	// Check brute-force lock
+	attempt := infra.Attempt{Action: loginAttemptAction, Account: input.Login, IP: clientInfo.IP}
+	err := s.bruteForceService.CheckAttempt(ctx, attempt)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("attempts are locked")
+		return v0.LoginOutput{}, err
+	}
+
 	// Get user entity
 	user, err := s.userRepo.FindUserByUsernameOrEmail(ctx, input.Login, s.userRepo.WithRolePreload())
 	if err != nil {
 		s.logger.Error().Stack().Err(err).Msg("failed to find user")
-		return v0.JwtTokensOutput{}, err
+		return v0.LoginOutput{}, err
 	}
 	if user == nil {
 		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
-		return v0.JwtTokensOutput{}, domain.ErrUserNotFound
+		return v0.LoginOutput{}, s.failAttempt(ctx, attempt, domain.ErrUserNotFound)
 	}
 
 	// Check password
 	if !security.CheckPasswordHash(input.Password, user.Password) {
 		s.logger.Error().Stack().Err(domain.ErrBadCredentials).Msg("failed to check hash password")
-		return v0.JwtTokensOutput{}, domain.ErrBadCredentials
+		return v0.LoginOutput{}, s.failAttempt(ctx, attempt, domain.ErrBadCredentials)
+	}
+
+	err = s.bruteForceService.ResetAttempts(ctx, attempt)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to reset attempts")
+	}
+
+	// Check if user is blocked
+	if !user.IsEnabled {
+		s.logger.Error().Stack().Err(domain.ErrUserIsBlocked).Msg("user is blocked")
+		return v0.LoginOutput{}, domain.ErrUserIsBlocked
+	}
+
+	return s.login(ctx, user, clientInfo)
+}
+
+//////////////////// Login MFA ////////////////////
+
+func (s *svc) LoginMFA(ctx context.Context, input v0.LoginMFAInput, clientInfo infra.ClientInfo) (
+	v0.JwtTokensOutput,
+	error,
+) {
+	s.logger.Info().Msg("login mfa")
+
+	claims, err := s.jwtService.GetMFATokenClaims(ctx, input.MFAToken)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to getting mfa token claims")
+		return v0.JwtTokensOutput{}, err
+	}
+
+	// Get user entity
+	user, err := s.userRepo.FindUserByID(ctx, claims.UserID, s.userRepo.WithRolePreload())
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return v0.JwtTokensOutput{}, err
+	}
+	if user == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return v0.JwtTokensOutput{}, domain.ErrUserNotFound
+	}
+
+	// Check if user is blocked
+	if !user.IsEnabled {
+		s.logger.Error().Stack().Err(domain.ErrUserIsBlocked).Msg("user is blocked")
+		return v0.JwtTokensOutput{}, domain.ErrUserIsBlocked
+	}
+
+	// 2FA may have been disabled after issuing MFA token
+	if !user.IsTOTPEnabled {
+		s.logger.Error().Stack().Err(domain.ErrMFANotEnabled).Msg("2FA is not enabled")
+		return v0.JwtTokensOutput{}, domain.ErrMFANotEnabled
+	}
+
+	// Check brute-force lock, counters are bound to user, so new MFA tokens do not give new attempts
+	attempt := infra.Attempt{Action: loginMFAAttemptAction, Account: user.ID.String(), IP: clientInfo.IP}
+	err = s.bruteForceService.CheckAttempt(ctx, attempt)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("attempts are locked")
+		return v0.JwtTokensOutput{}, err
+	}
+
+	// Check TOTP or recovery code
+	err = s.totpService.VerifyCode(ctx, user, input.Code)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to verify TOTP code")
+		return v0.JwtTokensOutput{}, s.failAttempt(ctx, attempt, err)
+	}
+
+	err = s.bruteForceService.ResetAttempts(ctx, attempt)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to reset attempts")
+	}
+
+	// MFA token is single-use
+	err = s.jwtService.BanToken(ctx, claims.JTI)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to ban mfa token")
+		return v0.JwtTokensOutput{}, err
+	}
+
+	// Create JWT tokens
+	accessToken, refreshToken, err := s.jwtService.GenerateTokens(ctx, user, clientInfo)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to generate JWT tokens")
+		return v0.JwtTokensOutput{}, err
+	}
+
+	return v0.JwtTokensOutput{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+//////////////////// Login by phone ////////////////////
+
+func (s *svc) LoginPhone(ctx context.Context, input v0.PhoneLoginInput, localizer locale.Localizer) error {
+	s.logger.Info().Msg("login phone")
+
+	// Get user by phone
+	user, err := s.userRepo.FindUserByPhone(ctx, input.Phone)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return err
+	}
+	if user == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return domain.ErrUserNotFound
+	}
+
+	// Only verified phone can be used for login
+	if !user.IsPhoneVerified {
+		s.logger.Error().Stack().Err(domain.ErrPhoneNotVerified).Msg("phone is not verified")
+		return domain.ErrPhoneNotVerified
+	}
+
+	// Create and save OTP, it is bound to the user
+	otp, err := s.otpService.GenerateAndSaveWithSubject(ctx, loginPhoneCachePrefix, input.Phone, user.ID.String())
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to create and save OTP code")
+		return err
+	}
+
+	// Send SMS
+	args := v0.OTPSMSArgs{
+		OTP: otp,
+		TTL: s.cfg.Security.OTP.TTL / 60,
+	}
+	content := fmt.Sprintf(loginPhoneSMSDefaultContent, args.OTP, args.TTL)
+	if localizer != nil {
+		content = localizer.Localize("sms.login.content", args, 0)
+	}
+
+	err = s.smsSender.SendMessage(ctx, input.Phone, content)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to send sms")
+		return domain.ErrSendSMS
+	}
+
+	return nil
+}
+
+func (s *svc) LoginPhoneConfirm(
+	ctx context.Context,
+	input v0.PhoneLoginConfirmInput,
+	clientInfo infra.ClientInfo,
+) (v0.LoginOutput, error) {
+	s.logger.Info().Msg("login phone confirm")
+
+	// Check brute-force lock
+	attempt := infra.Attempt{Action: loginPhoneAttemptAction, Account: input.Phone, IP: clientInfo.IP}
+	err := s.bruteForceService.CheckAttempt(ctx, attempt)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("attempts are locked")
+		return v0.LoginOutput{}, err
+	}
+
+	// Get data by OTP
+	var userID string
+	err = s.otpService.GetDataBySubjectAndCode(ctx, loginPhoneCachePrefix, input.Phone, input.OTP, &userID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to get data by OTP")
+		return v0.LoginOutput{}, s.failAttempt(ctx, attempt, err)
+	}
+
+	err = s.bruteForceService.ResetAttempts(ctx, attempt)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to reset attempts")
+	}
+
+	// OTP is single-use
+	err = s.otpService.DeleteDataBySubject(ctx, loginPhoneCachePrefix, input.Phone)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to delete OTP data")
+	}
+
+	// Get user entity
+	user, err := s.userRepo.FindUserByPhone(ctx, input.Phone, s.userRepo.WithRolePreload())
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return v0.LoginOutput{}, err
+	}
+	if user == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return v0.LoginOutput{}, domain.ErrUserNotFound
+	}
+
+	// Phone may have been moved to another account after sending OTP
+	if user.ID.String() != userID || !user.IsPhoneVerified {
+		s.logger.Error().Stack().Err(infra.ErrInvalidOrExpiredOTP).Msg("dont match users in request and OTP data")
+		return v0.LoginOutput{}, infra.ErrInvalidOrExpiredOTP
+	}
+
+	// Check if user is blocked
+	if !user.IsEnabled {
+		s.logger.Error().Stack().Err(domain.ErrUserIsBlocked).Msg("user is blocked")
+		return v0.LoginOutput{}, domain.ErrUserIsBlocked
+	}
+
+	return s.login(ctx, user, clientInfo)
+}
+
+//////////////////// Login by magic link ////////////////////
+
+func (s *svc) SendMagicLink(
+	ctx context.Context,
+	input v0.MagicLinkInput,
+	localizer locale.Localizer,
+) (v0.MagicLinkOutput, error) {
+	s.logger.Info().Msg("send magic link")
+
+	// Get user by email
+	user, err := s.userRepo.FindUserByEmail(ctx, input.Email)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return v0.MagicLinkOutput{}, err
+	}
+	if user == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return v0.MagicLinkOutput{}, domain.ErrUserNotFound
+	}
+
+	// Check if user is blocked
+	if !user.IsEnabled {
+		s.logger.Error().Stack().Err(domain.ErrUserIsBlocked).Msg("user is blocked")
+		return v0.MagicLinkOutput{}, domain.ErrUserIsBlocked
+	}
+
+	// Create device secret, link can be used only with it
+	secretBytes := make([]byte, magicLinkDeviceSecretSize)
+	if _, err = rand.Read(secretBytes); err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to generate device secret")
+		return v0.MagicLinkOutput{}, err
+	}
+	deviceSecret := base64.RawURLEncoding.EncodeToString(secretBytes)
+
+	// Create and save single-use token
+	data := magicLinkData{UserID: user.ID.String(), DeviceHash: hashMagicLinkDeviceSecret(deviceSecret)}
+	ttl := time.Duration(s.cfg.Security.MagicLink.TTL) * time.Second
+	token, err := s.otpService.GenerateAndSaveWithToken(ctx, magicLinkCachePrefix, data, ttl)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to create and save magic link token")
+		return v0.MagicLinkOutput{}, err
+	}
+
+	// Localize email title
+	emailTitle := magicLinkEmailDefaultTitle
+	if localizer != nil {
+		emailTitle = localizer.Localize("email.magic-link.title", nil, 0)
+	}
+
+	// Send email
+	args := v0.MagicLinkTemplateArgs{
+		Email: user.Email,
+		TTL:   s.cfg.Security.MagicLink.TTL / 60,
+		Link:  fmt.Sprintf("%s/auth/magic-link?token=%s", s.cfg.Server.ExternalURL, url.QueryEscape(token)),
+	}
+	content, err := s.templateEngine.RenderHTML("magic-link", args)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to render HTML email template")
+		return v0.MagicLinkOutput{}, err
+	}
+
+	err = s.smtpSender.SendHTMLMessage(emailTitle, content, s.cfg.SMTP.From, user.Email)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to send HTML message")
+		return v0.MagicLinkOutput{}, domain.ErrSendEmail
+	}
+
+	return v0.MagicLinkOutput{DeviceSecret: deviceSecret}, nil
+}
+
+func (s *svc) VerifyMagicLink(
+	ctx context.Context,
+	input v0.VerifyMagicLinkInput,
+	deviceSecret string,
+	clientInfo infra.ClientInfo,
+) (v0.LoginOutput, error) {
+	s.logger.Info().Msg("verify magic link")
+
+	// Token is consumed at once, so it cannot be reused even from the right device
+	var data magicLinkData
+	err := s.otpService.ConsumeDataByToken(ctx, magicLinkCachePrefix, input.Token, &data)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to consume magic link token")
+		return v0.LoginOutput{}, err
+	}
+
+	// Check that link is opened in the browser requested it
+	deviceHash := hashMagicLinkDeviceSecret(deviceSecret)
+	if deviceSecret == "" || subtle.ConstantTimeCompare([]byte(deviceHash), []byte(data.DeviceHash)) != 1 {
+		s.logger.Error().Stack().Err(domain.ErrMagicLinkDeviceMismatch).Msg("device secret mismatch")
+		return v0.LoginOutput{}, domain.ErrMagicLinkDeviceMismatch
+	}
+
+	// Get user entity
+	userID, err := uuid.Parse(data.UserID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to parse user id")
+		return v0.LoginOutput{}, err
+	}
+
+	user, err := s.userRepo.FindUserByID(ctx, userID, s.userRepo.WithRolePreload())
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return v0.LoginOutput{}, err
+	}
+	if user == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return v0.LoginOutput{}, domain.ErrUserNotFound
+	}
+
+	// Check if user is blocked
+	if !user.IsEnabled {
+		s.logger.Error().Stack().Err(domain.ErrUserIsBlocked).Msg("user is blocked")
+		return v0.LoginOutput{}, domain.ErrUserIsBlocked
+	}
+
+	// Opened link proves that user owns email
+	if !user.IsEmailVerified {
+		user.IsEmailVerified = true
+		user, err = s.userRepo.UpdateUser(ctx, user)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to update user")
+			return v0.LoginOutput{}, err
+		}
+	}
+
+	return s.login(ctx, user, clientInfo)
+}
+
+//////////////////// Login with passkey ////////////////////
+
+func (s *svc) BeginPasskeyLogin(ctx context.Context) (v0.PasskeyRequestOptionsOutput, error) {
+	s.logger.Info().Msg("begin passkey login")
+
+	output, err := s.webAuthnService.BeginLogin(ctx)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to begin passkey login")
+		return v0.PasskeyRequestOptionsOutput{}, err
+	}
+
+	return output, nil
+}
+
+// FinishPasskeyLogin issues tokens without 2FA check, because passkey requires user verification
+// on the authenticator and therefore is a multi-factor credential itself
+func (s *svc) FinishPasskeyLogin(
+	ctx context.Context,
+	input v0.PasskeyLoginInput,
+	clientInfo infra.ClientInfo,
+) (v0.JwtTokensOutput, error) {
+	s.logger.Info().Msg("finish passkey login")
+
+	// Verify assertion
+	passkey, err := s.webAuthnService.FinishLogin(ctx, input)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to finish passkey login")
+		return v0.JwtTokensOutput{}, err
+	}
+
+	// Get user entity
+	user, err := s.userRepo.FindUserByID(ctx, passkey.UserID, s.userRepo.WithRolePreload())
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find user")
+		return v0.JwtTokensOutput{}, err
+	}
+	if user == nil {
+		s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+		return v0.JwtTokensOutput{}, domain.ErrUserNotFound
 	}
 
 	// Check if user is blocked
@@ -212,7 +653,7 @@ func (s *svc) Login(ctx context.Context, input v0.LoginInput) (v0.JwtTokensOutpu
 	}
 
 	// Create JWT tokens
-	accessToken, refreshToken, err := s.jwtService.GenerateTokens(ctx, user)
+	accessToken, refreshToken, err := s.jwtService.GenerateTokens(ctx, user, clientInfo)
 	if err != nil {
 		s.logger.Error().Stack().Err(err).Msg("failed to generate JWT tokens")
 		return v0.JwtTokensOutput{}, err
@@ -223,7 +664,11 @@ func (s *svc) Login(ctx context.Context, input v0.LoginInput) (v0.JwtTokensOutpu
 
 //////////////////// Refresh Tokens ////////////////////
 
-func (s *svc) RefreshTokens(ctx context.Context, input v0.RefreshTokensInput) (v0.JwtTokensOutput, error) {
+func (s *svc) RefreshTokens(
+	ctx context.Context,
+	input v0.RefreshTokensInput,
+	clientInfo infra.ClientInfo,
+) (v0.JwtTokensOutput, error) {
 	s.logger.Info().Msg("refresh tokens")
 
 	claims, err := s.jwtService.GetRefreshTokenClaims(ctx, input.RefreshToken)
@@ -250,7 +695,12 @@ func (s *svc) RefreshTokens(ctx context.Context, input v0.RefreshTokensInput) (v
 	}
 
 	// Create JWT tokens
-	accessToken, refreshToken, err := s.jwtService.GenerateTokens(ctx, user)
+	accessToken, refreshToken, err := s.jwtService.RotateTokens(ctx, user, claims, clientInfo)
+	if errors.Is(err, infra.ErrReusedJWTToken) {
+		s.logger.Error().Stack().Err(err).Msg("refresh token reuse detected")
+		s.notifySessionCompromised(user.Email, clientInfo)
+		return v0.JwtTokensOutput{}, err
+	}
 	if err != nil {
 		s.logger.Error().Stack().Err(err).Msg("failed to generate JWT tokens")
 		return v0.JwtTokensOutput{}, err
@@ -259,14 +709,49 @@ func (s *svc) RefreshTokens(ctx context.Context, input v0.RefreshTokensInput) (v
 	return v0.JwtTokensOutput{AccessToken: accessToken, RefreshToken: refreshToken}, nil
 }
 
+func (s *svc) notifySessionCompromised(email string, clientInfo infra.ClientInfo) {
+	if email == "" {
+		s.logger.Warn().Msg("user has no email to notify about compromised session")
+		return
+	}
+
+	args := v0.SessionCompromisedTemplateArgs{
+		Email:     email,
+		IP:        clientInfo.IP,
+		UserAgent: clientInfo.UserAgent,
+		Time:      time.Now().UTC().Format(time.RFC1123),
+	}
+	content, err := s.templateEngine.RenderHTML("session-compromised", args)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to render HTML email template")
+		return
+	}
+
+	err = s.smtpSender.SendHTMLMessage(sessionCompromisedEmailDefaultTitle, content, s.cfg.SMTP.From, email)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to send HTML message")
+	}
+}
+
 //////////////////// Logout ////////////////////
 
-func (s *svc) Logout(ctx context.Context, jti string) error {
+func (s *svc) Logout(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, jti string) error {
 	s.logger.Info().Msg("logout")
 
-	err := s.jwtService.BanToken(ctx, jti)
+	// Tokens issued before session tracking can only be banned
+	if sessionID == uuid.Nil {
+		err := s.jwtService.BanToken(ctx, jti)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to ban token")
+			return err
+		}
+
+		return nil
+	}
+
+	err := s.jwtService.RevokeSession(ctx, userID, sessionID)
 	if err != nil {
-		s.logger.Error().Stack().Err(err).Msg("failed to ban token")
+		s.logger.Error().Stack().Err(err).Msg("failed to revoke session")
 		return err
 	}
 
@@ -294,7 +779,7 @@ func (s *svc) RecoveryPassword(
 	}
 
 	// Create and save OTP
-	otp, err := s.otpService.GenerateAndSaveWithCode(ctx, recoveryPasswordCachePrefix, input.Email)
+	otp, err := s.otpService.GenerateAndSaveWithSubject(ctx, recoveryPasswordCachePrefix, input.Email, input.Email)
 	if err != nil {
 		s.logger.Error().Stack().Err(err).Msg("failed to create and save OTP code")
 		return err
@@ -329,45 +814,27 @@ func (s *svc) RecoveryPassword(
 
 //////////////////// Verify recovery password ////////////////////
 
-func (s *svc) VerifyRecoveryCode(ctx context.Context, input v0.VerifyRecoveryCodeInput) error {
+func (s *svc) VerifyRecoveryCode(
+	ctx context.Context,
+	input v0.VerifyRecoveryCodeInput,
+	clientInfo infra.ClientInfo,
+) error {
 	s.logger.Info().Msg("verify recovery password")
 
-	// Get data by OTP
-	var email string
-	err := s.otpService.GetDataByCode(ctx, recoveryPasswordCachePrefix, input.OTP, &email)
-	if err != nil {
-		s.logger.Error().Stack().Err(err).Msg("failed to get data by OTP")
-		return err
-	}
-
-	// Check OTP
-	if input.Email != email {
-		s.logger.Error().Stack().Err(infra.ErrInvalidOrExpiredOTP).Msg("dont match emails in request and OTP data")
-		return infra.ErrInvalidOrExpiredOTP
-	}
-
-	return nil
+	return s.checkRecoveryCode(ctx, input.Email, input.OTP, clientInfo)
 }
 
 //////////////////// Reset password ////////////////////
 
-func (s *svc) ResetPassword(ctx context.Context, input v0.ResetPasswordInput) error {
+func (s *svc) ResetPassword(ctx context.Context, input v0.ResetPasswordInput, clientInfo infra.ClientInfo) error {
 	s.logger.Info().Msg("reset password")
 
-	// Get entry from cache
-	var email string
-	err := s.otpService.GetDataByCode(ctx, recoveryPasswordCachePrefix, input.OTP, &email)
+	// Check OTP
+	err := s.checkRecoveryCode(ctx, input.Email, input.OTP, clientInfo)
 	if err != nil {
-		s.logger.Error().Stack().Err(err).Msg("failed to get data by OTP")
 		return err
 	}
 
-	// Check OTP
-	if input.Email != email {
-		s.logger.Error().Stack().Err(infra.ErrInvalidOrExpiredOTP).Msg("dont match emails in request and OTP data")
-		return infra.ErrInvalidOrExpiredOTP
-	}
-
 	// Get user by email
 	user, err := s.userRepo.FindUserByEmail(ctx, input.Email)
 	if err != nil {
@@ -393,8 +860,17 @@ func (s *svc) ResetPassword(ctx context.Context, input v0.ResetPasswordInput) er
 		return err
 	}
 
+	// Revoke all sessions
+	if input.RevokeSessions {
+		err = s.jwtService.RevokeSessions(ctx, user.ID, uuid.Nil)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to revoke sessions")
+			return err
+		}
+	}
+
 	// Delete cache
-	err = s.otpService.DeleteDataByCode(ctx, recoveryPasswordCachePrefix, input.OTP)
+	err = s.otpService.DeleteDataBySubject(ctx, recoveryPasswordCachePrefix, input.Email)
 	if err != nil {
 		s.logger.Warn().Err(err).Msg("failed to delete OTP data")
 	}
@@ -402,12 +878,39 @@ func (s *svc) ResetPassword(ctx context.Context, input v0.ResetPasswordInput) er
 	return nil
 }
 
+func (s *svc) checkRecoveryCode(ctx context.Context, email string, otp string, clientInfo infra.ClientInfo) error {
+	// Check brute-force lock
+	attempt := infra.Attempt{Action: recoveryPasswordAttemptAction, Account: email, IP: clientInfo.IP}
+	err := s.bruteForceService.CheckAttempt(ctx, attempt)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("attempts are locked")
+		return err
+	}
+
+	// Get data by OTP
+	var otpEmail string
+	err = s.otpService.GetDataBySubjectAndCode(ctx, recoveryPasswordCachePrefix, email, otp, &otpEmail)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to get data by OTP")
+		return s.failAttempt(ctx, attempt, err)
+	}
+
+	err = s.bruteForceService.ResetAttempts(ctx, attempt)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to reset attempts")
+	}
+
+	return nil
+}
+
 //////////////////// Get consent page url ////////////////////
 
-func (s *svc) GetConsentPageURL(_ context.Context, provider string, redirectURL string) (
-	v0.GetConsentPageURLOutput,
-	error,
-) {
+func (s *svc) GetConsentPageURL(
+	ctx context.Context,
+	provider string,
+	redirectURL string,
+	clientInfo infra.ClientInfo,
+) (v0.GetConsentPageURLOutput, error) {
 	s.logger.Info().Msgf("get consent page url: provider=%s", provider)
 
 	// Get oauth provider
@@ -417,15 +920,41 @@ func (s *svc) GetConsentPageURL(_ context.Context, provider string, redirectURL
 		return v0.GetConsentPageURLOutput{}, domain.ErrInvalidProvider
 	}
 
-	consentPageURL, oauthState := oauthProvider.GetConsentPageURL(redirectURL)
+	// Save state and PKCE verifier, state is checked by callback
+	codeVerifier := oauth.GenerateCodeVerifier()
+	oauthState, err := s.otpService.GenerateAndSaveWithToken(
+		ctx,
+		oauthStateCachePrefix,
+		v0.OAuthStateData{
+			Provider:     provider,
+			CodeVerifier: codeVerifier,
+			UserAgent:    clientInfo.UserAgent,
+			IssuedAt:     time.Now(),
+		},
+		time.Duration(s.cfg.Security.OAuthState.TTL)*time.Second,
+	)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to save oauth state")
+		return v0.GetConsentPageURLOutput{}, err
+	}
+
+	consentPageURL, err := oauthProvider.GetConsentPageURL(ctx, redirectURL, oauthState, codeVerifier)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to get consent page url")
+		return v0.GetConsentPageURLOutput{}, err
+	}
+
 	return v0.GetConsentPageURLOutput{ConsentPageURL: consentPageURL, OauthState: oauthState}, nil
 }
 
 //////////////////// Fetch user info ////////////////////
 
-func (s *svc) FetchUserInfo(ctx context.Context, provider string, input v0.FetchUserInfoInput) (
-	oauth.UserInfo, error,
-) {
+func (s *svc) FetchUserInfo(
+	ctx context.Context,
+	provider string,
+	input v0.FetchUserInfoInput,
+	clientInfo infra.ClientInfo,
+) (oauth.UserInfo, error) {
 	s.logger.Info().Msgf("fetch user info: provider=%s", provider)
 
 	// Get oauth provider
@@ -434,15 +963,41 @@ func (s *svc) FetchUserInfo(ctx context.Context, provider string, input v0.Fetch
 		return oauth.UserInfo{}, domain.ErrInvalidProvider
 	}
 
+	// Consume state
+	stateData, err := s.consumeOAuthState(ctx, provider, input.State, clientInfo)
+	if err != nil {
+		return oauth.UserInfo{}, err
+	}
+
 	// Exchange code to token
 	s.logger.Info().Msg("exchange code to token")
 	socialLoginCallbackURL := fmt.Sprintf("%s/auth/social/%s/callback/", s.cfg.Server.ExternalURL, provider)
-	token, err := oauthProvider.ExchangeCodeToToken(ctx, input.Code, socialLoginCallbackURL)
+	token, err := oauthProvider.ExchangeCodeToToken(
+		ctx,
+		input.Code,
+		socialLoginCallbackURL,
+		stateData.CodeVerifier,
+		oauth.WithState(input.State),
+		oauth.WithStateIssuedAt(stateData.IssuedAt),
+		oauth.WithDeviceID(input.DeviceID),
+	)
 	if err != nil {
 		s.logger.Error().Stack().Err(err).Msg("failed to exchange code to token")
 		return oauth.UserInfo{}, err
 	}
 
+	// Token is accepted once. Signed auth data of some providers (e.g. Telegram) is verified locally
+	// and can be sent in several encodings, so it is identified by token normalized by provider
+	err = s.otpService.ConsumeToken(ctx, oauthTokenCachePrefix, token.AccessToken, oauth.MaxCodeAge)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to consume oauth token")
+
+		if errors.Is(err, infra.ErrInvalidOrExpiredOTP) {
+			return oauth.UserInfo{}, domain.ErrInvalidState
+		}
+		return oauth.UserInfo{}, err
+	}
+
 	// Get user info
 	s.logger.Info().Msg("get user info")
 	userInfo, err := oauthProvider.GetUserInfo(ctx, token)
@@ -458,49 +1013,189 @@ func (s *svc) FetchUserInfo(ctx context.Context, provider string, input v0.Fetch
 	return userInfo, nil
 }
 
+func (s *svc) consumeOAuthState(
+	ctx context.Context,
+	provider string,
+	state string,
+	clientInfo infra.ClientInfo,
+) (v0.OAuthStateData, error) {
+	var data v0.OAuthStateData
+	err := s.otpService.ConsumeDataByToken(ctx, oauthStateCachePrefix, state, &data)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to consume oauth state")
+
+		if errors.Is(err, infra.ErrInvalidOrExpiredOTP) {
+			return v0.OAuthStateData{}, domain.ErrInvalidState
+		}
+		return v0.OAuthStateData{}, err
+	}
+
+	// State must be issued for the same provider and client
+	if data.Provider != provider || data.UserAgent != clientInfo.UserAgent {
+		s.logger.Error().Stack().Err(domain.ErrInvalidState).Msg("oauth state is issued for another provider or client")
+		return v0.OAuthStateData{}, domain.ErrInvalidState
+	}
+
+	return data, nil
+}
+
 //////////////////// Register or login ////////////////////
 
-func (s *svc) RegisterOrLogin(ctx context.Context, userInfo oauth.UserInfo) (v0.JwtTokensOutput, error) {
-	s.logger.Info().Msg("register or login")
+func (s *svc) RegisterOrLogin(
+	ctx context.Context,
+	provider string,
+	userInfo oauth.UserInfo,
+	clientInfo infra.ClientInfo,
+) (v0.LoginOutput, error) {
+	s.logger.Info().Msgf("register or login: provider=%s", provider)
+
+	// Identity cannot be bound without subject
+	if userInfo.ID == "" {
+		s.logger.Error().Stack().Err(domain.ErrUserInfoNotReceived).Msg("subject is empty")
+		return v0.LoginOutput{}, domain.ErrUserInfoNotReceived
+	}
 
-	// Get user by email
-	user, err := s.userRepo.FindUserByEmail(ctx, userInfo.Email, s.userRepo.WithRolePreload())
+	// Get linked identity
+	identity, err := s.identityRepo.FindUserIdentityByProviderAndSubject(ctx, provider, userInfo.ID)
 	if err != nil {
-		s.logger.Error().Stack().Err(err).Msg("failed to find user")
-		return v0.JwtTokensOutput{}, err
+		s.logger.Error().Stack().Err(err).Msg("failed to find user identity")
+		return v0.LoginOutput{}, err
 	}
 
-	// Save user
-	if user == nil {
-		s.logger.Info().Msg("create new user")
-		userInfo.Username, err = s.searchUniqueUsername(ctx, userInfo.Username)
+	if identity != nil {
+		user, err := s.userRepo.FindUserByID(ctx, identity.UserID, s.userRepo.WithRolePreload())
 		if err != nil {
-			return v0.JwtTokensOutput{}, err
+			s.logger.Error().Stack().Err(err).Msg("failed to find user")
+			return v0.LoginOutput{}, err
+		}
+		if user == nil {
+			s.logger.Error().Stack().Err(domain.ErrUserNotFound).Msg("user not found")
+			return v0.LoginOutput{}, domain.ErrUserNotFound
+		}
+		if !user.IsEnabled {
+			s.logger.Error().Stack().Err(domain.ErrUserIsBlocked).Msg("user is banned")
+			return v0.LoginOutput{}, domain.ErrUserIsBlocked
 		}
 
-		user = converter.MapUserInfoToUserEntity(userInfo)
-		user, err = s.userRepo.CreateUser(ctx, user)
+		return s.login(ctx, user, clientInfo)
+	}
+
+	// Get user by email, some providers (e.g. Telegram) do not share it
+	var user *entity.User
+	if userInfo.Email != "" {
+		user, err = s.userRepo.FindUserByEmail(ctx, userInfo.Email, s.userRepo.WithRolePreload())
 		if err != nil {
-			s.logger.Error().Stack().Err(err).Msg("failed to create user")
-			return v0.JwtTokensOutput{}, err
+			s.logger.Error().Stack().Err(err).Msg("failed to find user")
+			return v0.LoginOutput{}, err
 		}
-	} else if !user.IsEnabled {
-		s.logger.Error().Stack().Err(domain.ErrUserIsBlocked).Msg("user is banned")
-		return v0.JwtTokensOutput{}, domain.ErrUserIsBlocked
 	}
 
-	// Create JWT tokens
-	accessToken, refreshToken, err := s.jwtService.GenerateTokens(ctx, user)
+	// Identity is linked to the existing account without confirmation only if provider verified email,
+	// otherwise unverified email could take over the account
+	if user != nil && userInfo.IsEmailVerified {
+		s.logger.Info().Msg("link identity by verified email")
+
+		if !user.IsEnabled {
+			s.logger.Error().Stack().Err(domain.ErrUserIsBlocked).Msg("user is banned")
+			return v0.LoginOutput{}, domain.ErrUserIsBlocked
+		}
+
+		_, err = s.identityRepo.CreateUserIdentity(ctx, converter.MapUserInfoToUserIdentityEntity(provider, userInfo, user.ID))
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to create user identity")
+			return v0.LoginOutput{}, err
+		}
+
+		return s.login(ctx, user, clientInfo)
+	}
+
+	// Otherwise identity is linked to the existing account only after user signs in to it and confirms linking
+	if user != nil {
+		s.logger.Info().Msg("identity link required")
+
+		data := v0.IdentityLinkData{
+			UserID:   user.ID.String(),
+			Provider: provider,
+			Subject:  userInfo.ID,
+			Email:    userInfo.Email,
+		}
+		token, err := s.otpService.GenerateAndSaveWithToken(
+			ctx,
+			identityLinkCachePrefix,
+			data,
+			time.Duration(s.cfg.Security.OTP.TTL)*time.Second,
+		)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to create and save identity link token")
+			return v0.LoginOutput{}, err
+		}
+
+		return v0.LoginOutput{IdentityLinkRequired: true, IdentityLinkToken: token}, nil
+	}
+
+	// Save user
+	s.logger.Info().Msg("create new user")
+	if userInfo.Username == "" {
+		userInfo.Username = fmt.Sprintf("%s_%s", provider, userInfo.ID)
+	}
+	userInfo.Username, err = s.searchUniqueUsername(ctx, userInfo.Username)
 	if err != nil {
-		s.logger.Error().Stack().Err(err).Msg("failed to generate tokens")
-		return v0.JwtTokensOutput{}, err
+		return v0.LoginOutput{}, err
 	}
 
-	return v0.JwtTokensOutput{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+	// User and identity are saved in the same transaction, so user without identity is not left on failure
+	user = converter.MapUserInfoToUserEntity(userInfo)
+	err = s.transactor.Transaction(
+		ctx, func(ctx context.Context) error {
+			user, err = s.userRepo.CreateUser(ctx, user)
+			if err != nil {
+				return err
+			}
+
+			_, err = s.identityRepo.CreateUserIdentity(
+				ctx,
+				converter.MapUserInfoToUserIdentityEntity(provider, userInfo, user.ID),
+			)
+			return err
+		},
+	)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to create user and identity")
+		return v0.LoginOutput{}, err
+	}
+
+	return s.login(ctx, user, clientInfo)
 }
 
 //////////////////// Additional helpers ////////////////////
 
+// login issues JWT tokens or, if user has enabled 2FA, MFA token for the second step
+func (s *svc) login(ctx context.Context, user *entity.User, clientInfo infra.ClientInfo) (v0.LoginOutput, error) {
+	if user.IsTOTPEnabled {
+		mfaToken, err := s.jwtService.GenerateMFAToken(ctx, user)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to generate mfa token")
+			return v0.LoginOutput{}, err
+		}
+
+		return v0.LoginOutput{MFARequired: true, MFAToken: mfaToken}, nil
+	}
+
+	// Create JWT tokens
+	accessToken, refreshToken, err := s.jwtService.GenerateTokens(ctx, user, clientInfo)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to generate JWT tokens")
+		return v0.LoginOutput{}, err
+	}
+
+	return v0.LoginOutput{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+func hashMagicLinkDeviceSecret(deviceSecret string) string {
+	hash := sha256.Sum256([]byte(deviceSecret))
+	return hex.EncodeToString(hash[:])
+}
+
 func (s *svc) searchUniqueUsername(ctx context.Context, defaultUsername string) (string, error) {
 	s.logger.Debug().Msg("search unique username")
 
@@ -531,3 +1226,25 @@ func (s *svc) searchUniqueUsername(ctx context.Context, defaultUsername string)
 		s.logger.Debug().Msgf("username %s already exists", username)
 	}
 }
+
+// failAttempt registers failed attempt and returns lockout error, if the failure has locked attempts
+func (s *svc) failAttempt(ctx context.Context, attempt infra.Attempt, err error) error {
+	if !errors.Is(err, infra.ErrInvalidOrExpiredOTP) &&
+		!errors.Is(err, infra.ErrOTPAttemptsExceeded) &&
+		!errors.Is(err, infra.ErrInvalidTOTPCode) &&
+		!errors.Is(err, domain.ErrUserNotFound) &&
+		!errors.Is(err, domain.ErrBadCredentials) {
+		return err
+	}
+
+	lockErr := s.bruteForceService.FailAttempt(ctx, attempt)
+	if errors.As(lockErr, &infra.LockoutError{}) {
+		s.logger.Error().Stack().Err(lockErr).Msg("attempts are locked")
+		return lockErr
+	}
+	if lockErr != nil {
+		s.logger.Warn().Err(lockErr).Msg("failed to register failed attempt")
+	}
+
+	return err
+}
@@ -0,0 +1,615 @@
+package chat
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/converter"
+	"github.com/mandarine-io/backend/internal/infrastructure/s3"
+	"github.com/mandarine-io/backend/internal/infrastructure/smtp"
+	"github.com/mandarine-io/backend/internal/infrastructure/template"
+	"github.com/mandarine-io/backend/internal/infrastructure/websocket"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/domain/ws"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/rs/zerolog"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ConversationTopicPrefix is prefix of websocket topic, which contains events of one conversation
+	ConversationTopicPrefix = "chat:"
+
+	TypingMessageType = "chat.typing"
+
+	// attachmentFolderPrefix is prefix of folder with attachments of one conversation
+	attachmentFolderPrefix = "chat/"
+
+	chatMessageEmailDefaultTitle = "New message"
+)
+
+type svc struct {
+	conversationRepo  repo.ConversationRepository
+	chatMessageRepo   repo.ChatMessageRepository
+	masterProfileRepo repo.MasterProfileRepository
+	resourceService   domain.ResourceService
+	pool              *websocket.Pool
+	smtpSender        smtp.Sender
+	templateEngine    template.Engine
+	cfg               config.SMTPConfig
+	logger            zerolog.Logger
+}
+
+type Option func(*svc)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(p *svc) {
+		p.logger = logger
+	}
+}
+
+func NewService(
+	conversationRepo repo.ConversationRepository,
+	chatMessageRepo repo.ChatMessageRepository,
+	masterProfileRepo repo.MasterProfileRepository,
+	resourceService domain.ResourceService,
+	pool *websocket.Pool,
+	router *websocket.Router,
+	smtpSender smtp.Sender,
+	templateEngine template.Engine,
+	cfg config.SMTPConfig,
+	opts ...Option,
+) domain.ChatService {
+	s := &svc{
+		conversationRepo:  conversationRepo,
+		chatMessageRepo:   chatMessageRepo,
+		masterProfileRepo: masterProfileRepo,
+		resourceService:   resourceService,
+		pool:              pool,
+		smtpSender:        smtpSender,
+		templateEngine:    templateEngine,
+		cfg:               cfg,
+		logger:            zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	router.Topic(ConversationTopicPrefix+"*", s.authorizeConversationTopic)
+	router.Handle(TypingMessageType, s.typing)
+
+	return s
+}
+
+//////////////////// Conversations ////////////////////
+
+func (s *svc) CreateConversation(
+	ctx context.Context,
+	userID uuid.UUID,
+	input v0.CreateConversationInput,
+) (v0.ConversationOutput, error) {
+	s.logger.Info().Msgf("create conversation: %s", userID.String())
+
+	masterProfileEntity, err := s.masterProfileRepo.FindEnabledMasterProfileByUsername(ctx, input.MasterUsername)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find master profile")
+		return v0.ConversationOutput{}, err
+	}
+	if masterProfileEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrMasterProfileNotFound).Msg("master profile not found")
+		return v0.ConversationOutput{}, domain.ErrMasterProfileNotFound
+	}
+	if masterProfileEntity.UserID == userID {
+		s.logger.Error().Stack().Err(domain.ErrChatWithSelf).Msg("user cannot chat with self")
+		return v0.ConversationOutput{}, domain.ErrChatWithSelf
+	}
+
+	var appointmentID *uuid.UUID
+	if input.AppointmentID != nil {
+		id, err := uuid.Parse(*input.AppointmentID)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to parse appointment id")
+			return v0.ConversationOutput{}, err
+		}
+		appointmentID = &id
+	}
+
+	// Conversation is started only once for the same participants and appointment
+	conversationEntity, err := s.conversationRepo.FindConversationByParticipants(
+		ctx,
+		userID,
+		masterProfileEntity.UserID,
+		appointmentID,
+	)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find conversation")
+		return v0.ConversationOutput{}, err
+	}
+	if conversationEntity != nil {
+		return s.mapConversation(ctx, conversationEntity, userID)
+	}
+
+	conversationEntity = &entity.Conversation{
+		ClientID:      userID,
+		MasterID:      masterProfileEntity.UserID,
+		AppointmentID: appointmentID,
+	}
+	conversationEntity, err = s.conversationRepo.CreateConversation(ctx, conversationEntity)
+	if err != nil && !errors.Is(err, repo.ErrDuplicateConversation) {
+		s.logger.Error().Stack().Err(err).Msg("failed to create conversation")
+		return v0.ConversationOutput{}, err
+	}
+
+	// Conversation is reloaded with participants, it may be created concurrently too
+	conversationEntity, err = s.conversationRepo.FindConversationByParticipants(
+		ctx,
+		userID,
+		masterProfileEntity.UserID,
+		appointmentID,
+	)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find conversation")
+		return v0.ConversationOutput{}, err
+	}
+	if conversationEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrConversationNotFound).Msg("conversation not found")
+		return v0.ConversationOutput{}, domain.ErrConversationNotFound
+	}
+
+	return s.mapConversation(ctx, conversationEntity, userID)
+}
+
+func (s *svc) GetConversations(ctx context.Context, userID uuid.UUID) (v0.ConversationsOutput, error) {
+	s.logger.Info().Msgf("get conversations: %s", userID.String())
+
+	conversationEntities, err := s.conversationRepo.FindConversationsByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find conversations")
+		return v0.ConversationsOutput{}, err
+	}
+
+	output := v0.ConversationsOutput{
+		Count: len(conversationEntities),
+		Data:  make([]v0.ConversationOutput, len(conversationEntities)),
+	}
+	for i, conversationEntity := range conversationEntities {
+		conversation, err := s.mapConversation(ctx, conversationEntity, userID)
+		if err != nil {
+			return v0.ConversationsOutput{}, err
+		}
+
+		output.Data[i] = conversation
+		output.UnreadCount += conversation.UnreadCount
+	}
+
+	return output, nil
+}
+
+func (s *svc) GetConversation(
+	ctx context.Context,
+	userID uuid.UUID,
+	conversationID uuid.UUID,
+) (v0.ConversationOutput, error) {
+	s.logger.Info().Msgf("get conversation: %s", conversationID.String())
+
+	conversationEntity, err := s.findConversation(ctx, userID, conversationID)
+	if err != nil {
+		return v0.ConversationOutput{}, err
+	}
+
+	return s.mapConversation(ctx, conversationEntity, userID)
+}
+
+//////////////////// Messages ////////////////////
+
+func (s *svc) GetMessages(
+	ctx context.Context,
+	userID uuid.UUID,
+	conversationID uuid.UUID,
+	input v0.FindChatMessagesInput,
+) (v0.ChatMessagesOutput, error) {
+	s.logger.Info().Msgf("get chat messages: %s", conversationID.String())
+
+	var cursor *repo.ChatMessageCursor
+	if input.Cursor != "" {
+		var err error
+		cursor, err = decodeCursor(input.Cursor)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to decode cursor")
+			return v0.ChatMessagesOutput{}, domain.ErrInvalidCursor
+		}
+	}
+
+	if _, err := s.findConversation(ctx, userID, conversationID); err != nil {
+		return v0.ChatMessagesOutput{}, err
+	}
+
+	// One extra message is fetched to find out if there is next page
+	messageEntities, err := s.chatMessageRepo.FindChatMessages(ctx, conversationID, cursor, input.Limit+1)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find chat messages")
+		return v0.ChatMessagesOutput{}, err
+	}
+
+	output := v0.ChatMessagesOutput{}
+	if len(messageEntities) > input.Limit {
+		messageEntities = messageEntities[:input.Limit]
+		last := messageEntities[len(messageEntities)-1]
+		nextCursor := encodeCursor(repo.ChatMessageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+		output.NextCursor = &nextCursor
+	}
+
+	output.Data = make([]v0.ChatMessageOutput, len(messageEntities))
+	for i, messageEntity := range messageEntities {
+		output.Data[i] = converter.MapChatMessageEntityToChatMessageOutput(messageEntity)
+	}
+
+	return output, nil
+}
+
+func (s *svc) SendMessage(
+	ctx context.Context,
+	userID uuid.UUID,
+	conversationID uuid.UUID,
+	input v0.SendChatMessageInput,
+) (v0.ChatMessageOutput, error) {
+	s.logger.Info().Msgf("send chat message: %s", conversationID.String())
+
+	conversationEntity, err := s.findConversation(ctx, userID, conversationID)
+	if err != nil {
+		return v0.ChatMessageOutput{}, err
+	}
+
+	// Only own attachments uploaded to this conversation can be sent
+	senderFolder := senderAttachmentFolder(conversationID, userID) + "/"
+	for _, objectID := range input.Attachments {
+		if !strings.HasPrefix(objectID, senderFolder) {
+			s.logger.Error().Stack().Err(domain.ErrInvalidAttachment).Msgf("invalid attachment: %s", objectID)
+			return v0.ChatMessageOutput{}, domain.ErrInvalidAttachment
+		}
+	}
+
+	recipientID := conversationEntity.Interlocutor(userID)
+	unreadCount, err := s.chatMessageRepo.CountUnreadChatMessages(ctx, conversationEntity, recipientID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to count unread chat messages")
+		return v0.ChatMessageOutput{}, err
+	}
+
+	messageEntity := &entity.ChatMessage{
+		ConversationID: conversationID,
+		SenderID:       userID,
+		Text:           input.Text,
+		Attachments:    make([]entity.ChatMessageAttachment, len(input.Attachments)),
+	}
+	for i, objectID := range input.Attachments {
+		messageEntity.Attachments[i] = entity.ChatMessageAttachment{ObjectID: objectID}
+	}
+
+	messageEntity, err = s.chatMessageRepo.CreateChatMessage(ctx, messageEntity)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to create chat message")
+		return v0.ChatMessageOutput{}, err
+	}
+
+	output := converter.MapChatMessageEntityToChatMessageOutput(messageEntity)
+
+	// Delivery is best effort, message is already persisted and can be fetched with history
+	s.publishEvent(ctx, conversationID, v0.ChatMessageEventType, output)
+	s.sendUnreadEvent(ctx, recipientID, conversationID, unreadCount+1)
+
+	// Email is sent only for the first unread message, so offline recipient is not flooded
+	if unreadCount == 0 {
+		s.notifyOfflineRecipient(ctx, conversationEntity, userID, input.Text)
+	}
+
+	return output, nil
+}
+
+func (s *svc) MarkRead(
+	ctx context.Context,
+	userID uuid.UUID,
+	conversationID uuid.UUID,
+	input v0.MarkChatReadInput,
+) error {
+	s.logger.Info().Msgf("mark chat as read: %s", conversationID.String())
+
+	conversationEntity, err := s.findConversation(ctx, userID, conversationID)
+	if err != nil {
+		return err
+	}
+
+	readAt := time.Now().UTC()
+	if input.MessageID != nil {
+		messageID, err := uuid.Parse(*input.MessageID)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to parse message id")
+			return err
+		}
+
+		messageEntity, err := s.chatMessageRepo.FindChatMessageByID(ctx, conversationID, messageID)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to find chat message")
+			return err
+		}
+		if messageEntity == nil {
+			s.logger.Error().Stack().Err(domain.ErrChatMessageNotFound).Msg("chat message not found")
+			return domain.ErrChatMessageNotFound
+		}
+
+		readAt = messageEntity.CreatedAt
+	}
+
+	err = s.conversationRepo.UpdateConversationReadAt(ctx, conversationID, userID, readAt)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to update conversation read at")
+		return err
+	}
+
+	// Read marker does not move back, so unread count is calculated from stored conversation
+	conversationEntity, err = s.conversationRepo.FindConversationByID(ctx, conversationID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find conversation")
+		return err
+	}
+	if conversationEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrConversationNotFound).Msg("conversation not found")
+		return domain.ErrConversationNotFound
+	}
+
+	unreadCount, err := s.chatMessageRepo.CountUnreadChatMessages(ctx, conversationEntity, userID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to count unread chat messages")
+		return err
+	}
+
+	s.publishEvent(
+		ctx, conversationID, v0.ChatReadEventType, v0.ChatReadEventOutput{
+			ConversationID: conversationID.String(),
+			UserID:         userID.String(),
+			ReadAt:         readAt,
+		},
+	)
+	s.sendUnreadEvent(ctx, userID, conversationID, unreadCount)
+
+	return nil
+}
+
+func (s *svc) UploadAttachments(
+	ctx context.Context,
+	userID uuid.UUID,
+	conversationID uuid.UUID,
+	input *v0.UploadResourcesInput,
+) (v0.UploadResourcesOutput, error) {
+	s.logger.Info().Msgf("upload chat attachments: %s", conversationID.String())
+
+	if _, err := s.findConversation(ctx, userID, conversationID); err != nil {
+		return v0.UploadResourcesOutput{}, err
+	}
+
+	return s.resourceService.UploadResourcesToFolder(ctx, senderAttachmentFolder(conversationID, userID), input)
+}
+
+func (s *svc) DownloadAttachment(
+	ctx context.Context,
+	userID uuid.UUID,
+	conversationID uuid.UUID,
+	objectID string,
+) (*s3.FileData, error) {
+	s.logger.Info().Msgf("download chat attachment: %s", conversationID.String())
+
+	if _, err := s.findConversation(ctx, userID, conversationID); err != nil {
+		return nil, err
+	}
+
+	return s.resourceService.DownloadResourceFromFolder(ctx, attachmentFolder(conversationID), objectID)
+}
+
+//////////////////// Websocket ////////////////////
+
+// authorizeConversationTopic allows subscription to conversation topic for its participants only
+func (s *svc) authorizeConversationTopic(ctx context.Context, clientID string, topic string) error {
+	s.logger.Debug().Msgf("authorize subscription of client %s to topic %s", clientID, topic)
+
+	userID, err := uuid.Parse(clientID)
+	if err != nil {
+		return websocket.ErrForbidden
+	}
+
+	conversationID, err := uuid.Parse(strings.TrimPrefix(topic, ConversationTopicPrefix))
+	if err != nil {
+		return websocket.ErrUnknownTopic
+	}
+
+	_, err = s.findConversation(ctx, userID, conversationID)
+	if errors.Is(err, domain.ErrConversationNotFound) {
+		return websocket.ErrForbidden
+	}
+
+	return err
+}
+
+func (s *svc) typing(c *websocket.Context) error {
+	input := v0.ChatTypingInput{}
+	if err := c.Bind(&input); err != nil {
+		return websocket.ErrBadRequest
+	}
+
+	userID, err := uuid.Parse(c.ClientID)
+	if err != nil {
+		return websocket.ErrForbidden
+	}
+
+	conversationID, err := uuid.Parse(input.ConversationID)
+	if err != nil {
+		return websocket.ErrBadRequest
+	}
+
+	_, err = s.findConversation(c.Context(), userID, conversationID)
+	if errors.Is(err, domain.ErrConversationNotFound) {
+		return websocket.ErrForbidden
+	}
+	if err != nil {
+		return err
+	}
+
+	s.publishEvent(
+		c.Context(), conversationID, v0.ChatTypingEventType, v0.ChatTypingEventOutput{
+			ConversationID: conversationID.String(),
+			UserID:         userID.String(),
+		},
+	)
+
+	return nil
+}
+
+func (s *svc) publishEvent(ctx context.Context, conversationID uuid.UUID, eventType string, data any) {
+	topic := ConversationTopicPrefix + conversationID.String()
+	err := s.pool.Publish(ctx, topic, v0.ChatEventOutput{Type: eventType, Data: data})
+	if err != nil {
+		s.logger.Warn().Err(err).Msgf("failed to publish %s event to topic %s", eventType, topic)
+	}
+}
+
+func (s *svc) sendUnreadEvent(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID, unreadCount int64) {
+	event := v0.ChatEventOutput{
+		Type: v0.ChatUnreadEventType,
+		Data: v0.ChatUnreadEventOutput{
+			ConversationID: conversationID.String(),
+			UnreadCount:    unreadCount,
+		},
+	}
+
+	err := s.pool.SendEvent(ctx, userID.String(), ws.NotificationsTopic, event)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to send unread event")
+	}
+}
+
+//////////////////// Offline notification ////////////////////
+
+func (s *svc) notifyOfflineRecipient(
+	ctx context.Context,
+	conversationEntity *entity.Conversation,
+	senderID uuid.UUID,
+	text string,
+) {
+	recipient, sender := conversationEntity.Master, conversationEntity.Client
+	if conversationEntity.MasterID == senderID {
+		recipient, sender = conversationEntity.Client, conversationEntity.Master
+	}
+
+	online, err := s.pool.IsOnline(ctx, recipient.ID.String())
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to check if recipient is online")
+		return
+	}
+	if online {
+		return
+	}
+
+	if recipient.Email == "" {
+		s.logger.Warn().Msg("recipient has no email to notify about chat message")
+		return
+	}
+
+	args := v0.ChatMessageTemplateArgs{
+		Email:    recipient.Email,
+		Username: sender.Username,
+		Text:     text,
+	}
+	content, err := s.templateEngine.RenderHTML("chat-message", args)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to render HTML email template")
+		return
+	}
+
+	err = s.smtpSender.SendHTMLMessage(chatMessageEmailDefaultTitle, content, s.cfg.From, recipient.Email)
+	if err != nil {
+		s.logger.Warn().Err(err).Msg("failed to send HTML message")
+	}
+}
+
+//////////////////// Helpers ////////////////////
+
+// findConversation returns conversation, if user is its participant. Otherwise, conversation is hidden as not found
+func (s *svc) findConversation(
+	ctx context.Context,
+	userID uuid.UUID,
+	conversationID uuid.UUID,
+) (*entity.Conversation, error) {
+	conversationEntity, err := s.conversationRepo.FindConversationByID(ctx, conversationID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find conversation")
+		return nil, err
+	}
+	if conversationEntity == nil || !conversationEntity.IsParticipant(userID) {
+		s.logger.Error().Stack().Err(domain.ErrConversationNotFound).Msg("conversation not found")
+		return nil, domain.ErrConversationNotFound
+	}
+
+	return conversationEntity, nil
+}
+
+func (s *svc) mapConversation(
+	ctx context.Context,
+	conversationEntity *entity.Conversation,
+	userID uuid.UUID,
+) (v0.ConversationOutput, error) {
+	unreadCount, err := s.chatMessageRepo.CountUnreadChatMessages(ctx, conversationEntity, userID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to count unread chat messages")
+		return v0.ConversationOutput{}, err
+	}
+
+	return converter.MapConversationEntityToConversationOutput(conversationEntity, unreadCount), nil
+}
+
+// Cursor is opaque for clients and contains creation time and id of the last message of page
+
+func encodeCursor(cursor repo.ChatMessageCursor) string {
+	raw := fmt.Sprintf("%d_%s", cursor.CreatedAt.UnixNano(), cursor.ID.String())
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursor string) (*repo.ChatMessageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	nanos, id, found := strings.Cut(string(raw), "_")
+	if !found {
+		return nil, errors.New("malformed cursor")
+	}
+
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repo.ChatMessageCursor{CreatedAt: time.Unix(0, unixNano).UTC(), ID: messageID}, nil
+}
+
+// attachmentFolder returns folder with attachments of conversation, which are available to its participants only
+func attachmentFolder(conversationID uuid.UUID) string {
+	return attachmentFolderPrefix + conversationID.String()
+}
+
+// senderAttachmentFolder returns folder with attachments uploaded by participant of conversation
+func senderAttachmentFolder(conversationID uuid.UUID, userID uuid.UUID) string {
+	return attachmentFolder(conversationID) + "/" + userID.String()
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/infrastructure/event"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"time"
+)
+
+const (
+	MasterProfileAggregateType = "master_profile"
+
+	MasterProfileUpdatedEventType = "MasterProfileUpdated"
+)
+
+// MasterProfileUpdatedEvent is produced, when master changes own profile
+type MasterProfileUpdatedEvent struct {
+	UserID      uuid.UUID `json:"userId"`
+	DisplayName string    `json:"displayName"`
+	Job         string    `json:"job"`
+	IsEnabled   bool      `json:"isEnabled"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+func NewMasterProfileUpdatedEvent(profileEntity *entity.MasterProfile) MasterProfileUpdatedEvent {
+	return MasterProfileUpdatedEvent{
+		UserID:      profileEntity.UserID,
+		DisplayName: profileEntity.DisplayName,
+		Job:         profileEntity.Job,
+		IsEnabled:   profileEntity.IsEnabled,
+		UpdatedAt:   profileEntity.UpdatedAt,
+	}
+}
+
+func (MasterProfileUpdatedEvent) EventType() string {
+	return MasterProfileUpdatedEventType
+}
+
+func (MasterProfileUpdatedEvent) AggregateType() string {
+	return MasterProfileAggregateType
+}
+
+func (e MasterProfileUpdatedEvent) AggregateID() string {
+	return e.UserID.String()
+}
+
+// RegisterEvents registers codecs of domain events
+func RegisterEvents(registry *event.Registry) error {
+	return event.RegisterJSON[MasterProfileUpdatedEvent](registry)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/mandarine-io/backend/internal/persistence/entity"
 	"github.com/mandarine-io/backend/internal/persistence/repo"
 	"github.com/mandarine-io/backend/internal/service/domain"
+	infra "github.com/mandarine-io/backend/internal/service/infrastructure"
 	"github.com/mandarine-io/backend/pkg/model/v0"
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
@@ -15,8 +16,10 @@ import (
 )
 
 type svc struct {
-	repo   repo.MasterProfileRepository
-	logger zerolog.Logger
+	repo          repo.MasterProfileRepository
+	transactor    repo.Transactor
+	outboxService infra.OutboxService
+	logger        zerolog.Logger
 }
 
 type Option func(*svc)
@@ -27,8 +30,17 @@ func WithLogger(logger zerolog.Logger) Option {
 	}
 }
 
-func NewService(repo repo.MasterProfileRepository, opts ...Option) domain.MasterProfileService {
-	s := &svc{repo: repo}
+func NewService(
+	repo repo.MasterProfileRepository,
+	transactor repo.Transactor,
+	outboxService infra.OutboxService,
+	opts ...Option,
+) domain.MasterProfileService {
+	s := &svc{
+		repo:          repo,
+		transactor:    transactor,
+		outboxService: outboxService,
+	}
 
 	for _, opt := range opts {
 		opt(s)
@@ -187,8 +199,18 @@ func (s *svc) UpdateMasterProfile(
 		return v0.MasterProfileOutput{}, domain.ErrMasterProfileNotExist
 	}
 
+	// Event is written in the same transaction, so it is published only if profile is updated
 	profileEntity = converter.MapUpdateMasterProfileInputToEntity(profileEntity, input)
-	profileEntity, err = s.repo.UpdateMasterProfile(ctx, profileEntity)
+	err = s.transactor.Transaction(
+		ctx, func(ctx context.Context) error {
+			profileEntity, err = s.repo.UpdateMasterProfile(ctx, profileEntity)
+			if err != nil {
+				return err
+			}
+
+			return s.outboxService.Add(ctx, domain.NewMasterProfileUpdatedEvent(profileEntity))
+		},
+	)
 	if err != nil {
 		s.logger.Error().Stack().Err(err).Msg("failed to update master profile")
 		return v0.MasterProfileOutput{}, err
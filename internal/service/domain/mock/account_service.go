@@ -4,13 +4,16 @@ package mock
 
 import (
 	context "context"
-	model "github.com/mandarine-io/backend/pkg/model/v0"
+
+	infrastructure "github.com/mandarine-io/backend/internal/service/infrastructure"
 
 	locale "github.com/mandarine-io/backend/internal/infrastructure/locale"
 
 	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
+
+	v0 "github.com/mandarine-io/backend/pkg/model/v0"
 )
 
 // AccountServiceMock is an autogenerated mock type for the AccountService type
@@ -26,74 +29,692 @@ func (_m *AccountServiceMock) EXPECT() *AccountServiceMock_Expecter {
 	return &AccountServiceMock_Expecter{mock: &_m.Mock}
 }
 
-// DeleteAccount provides a mock function with given fields: ctx, id
-func (_m *AccountServiceMock) DeleteAccount(ctx context.Context, id uuid.UUID) error {
-	ret := _m.Called(ctx, id)
+// BeginPasskeyRegistration provides a mock function with given fields: ctx, id
+func (_m *AccountServiceMock) BeginPasskeyRegistration(ctx context.Context, id uuid.UUID) (v0.PasskeyCreationOptionsOutput, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginPasskeyRegistration")
+	}
+
+	var r0 v0.PasskeyCreationOptionsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (v0.PasskeyCreationOptionsOutput, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) v0.PasskeyCreationOptionsOutput); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(v0.PasskeyCreationOptionsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountServiceMock_BeginPasskeyRegistration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginPasskeyRegistration'
+type AccountServiceMock_BeginPasskeyRegistration_Call struct {
+	*mock.Call
+}
+
+// BeginPasskeyRegistration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *AccountServiceMock_Expecter) BeginPasskeyRegistration(ctx interface{}, id interface{}) *AccountServiceMock_BeginPasskeyRegistration_Call {
+	return &AccountServiceMock_BeginPasskeyRegistration_Call{Call: _e.mock.On("BeginPasskeyRegistration", ctx, id)}
+}
+
+func (_c *AccountServiceMock_BeginPasskeyRegistration_Call) Run(run func(ctx context.Context, id uuid.UUID)) *AccountServiceMock_BeginPasskeyRegistration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_BeginPasskeyRegistration_Call) Return(_a0 v0.PasskeyCreationOptionsOutput, _a1 error) *AccountServiceMock_BeginPasskeyRegistration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountServiceMock_BeginPasskeyRegistration_Call) RunAndReturn(run func(context.Context, uuid.UUID) (v0.PasskeyCreationOptionsOutput, error)) *AccountServiceMock_BeginPasskeyRegistration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmIdentityLink provides a mock function with given fields: ctx, id, input
+func (_m *AccountServiceMock) ConfirmIdentityLink(ctx context.Context, id uuid.UUID, input v0.ConfirmIdentityLinkInput) (v0.IdentityOutput, error) {
+	ret := _m.Called(ctx, id, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmIdentityLink")
+	}
+
+	var r0 v0.IdentityOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.ConfirmIdentityLinkInput) (v0.IdentityOutput, error)); ok {
+		return rf(ctx, id, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.ConfirmIdentityLinkInput) v0.IdentityOutput); ok {
+		r0 = rf(ctx, id, input)
+	} else {
+		r0 = ret.Get(0).(v0.IdentityOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, v0.ConfirmIdentityLinkInput) error); ok {
+		r1 = rf(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountServiceMock_ConfirmIdentityLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmIdentityLink'
+type AccountServiceMock_ConfirmIdentityLink_Call struct {
+	*mock.Call
+}
+
+// ConfirmIdentityLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - input v0.ConfirmIdentityLinkInput
+func (_e *AccountServiceMock_Expecter) ConfirmIdentityLink(ctx interface{}, id interface{}, input interface{}) *AccountServiceMock_ConfirmIdentityLink_Call {
+	return &AccountServiceMock_ConfirmIdentityLink_Call{Call: _e.mock.On("ConfirmIdentityLink", ctx, id, input)}
+}
+
+func (_c *AccountServiceMock_ConfirmIdentityLink_Call) Run(run func(ctx context.Context, id uuid.UUID, input v0.ConfirmIdentityLinkInput)) *AccountServiceMock_ConfirmIdentityLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(v0.ConfirmIdentityLinkInput))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_ConfirmIdentityLink_Call) Return(_a0 v0.IdentityOutput, _a1 error) *AccountServiceMock_ConfirmIdentityLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountServiceMock_ConfirmIdentityLink_Call) RunAndReturn(run func(context.Context, uuid.UUID, v0.ConfirmIdentityLinkInput) (v0.IdentityOutput, error)) *AccountServiceMock_ConfirmIdentityLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAccount provides a mock function with given fields: ctx, id
+func (_m *AccountServiceMock) DeleteAccount(ctx context.Context, id uuid.UUID) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAccount")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountServiceMock_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
+type AccountServiceMock_DeleteAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *AccountServiceMock_Expecter) DeleteAccount(ctx interface{}, id interface{}) *AccountServiceMock_DeleteAccount_Call {
+	return &AccountServiceMock_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", ctx, id)}
+}
+
+func (_c *AccountServiceMock_DeleteAccount_Call) Run(run func(ctx context.Context, id uuid.UUID)) *AccountServiceMock_DeleteAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_DeleteAccount_Call) Return(_a0 error) *AccountServiceMock_DeleteAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountServiceMock_DeleteAccount_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *AccountServiceMock_DeleteAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePasskey provides a mock function with given fields: ctx, id, passkeyID
+func (_m *AccountServiceMock) DeletePasskey(ctx context.Context, id uuid.UUID, passkeyID uuid.UUID) error {
+	ret := _m.Called(ctx, id, passkeyID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePasskey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, id, passkeyID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountServiceMock_DeletePasskey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePasskey'
+type AccountServiceMock_DeletePasskey_Call struct {
+	*mock.Call
+}
+
+// DeletePasskey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - passkeyID uuid.UUID
+func (_e *AccountServiceMock_Expecter) DeletePasskey(ctx interface{}, id interface{}, passkeyID interface{}) *AccountServiceMock_DeletePasskey_Call {
+	return &AccountServiceMock_DeletePasskey_Call{Call: _e.mock.On("DeletePasskey", ctx, id, passkeyID)}
+}
+
+func (_c *AccountServiceMock_DeletePasskey_Call) Run(run func(ctx context.Context, id uuid.UUID, passkeyID uuid.UUID)) *AccountServiceMock_DeletePasskey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_DeletePasskey_Call) Return(_a0 error) *AccountServiceMock_DeletePasskey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountServiceMock_DeletePasskey_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *AccountServiceMock_DeletePasskey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DisableTOTP provides a mock function with given fields: ctx, id, input, clientInfo
+func (_m *AccountServiceMock) DisableTOTP(ctx context.Context, id uuid.UUID, input v0.TOTPCodeInput, clientInfo infrastructure.ClientInfo) error {
+	ret := _m.Called(ctx, id, input, clientInfo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisableTOTP")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.TOTPCodeInput, infrastructure.ClientInfo) error); ok {
+		r0 = rf(ctx, id, input, clientInfo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountServiceMock_DisableTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableTOTP'
+type AccountServiceMock_DisableTOTP_Call struct {
+	*mock.Call
+}
+
+// DisableTOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - input v0.TOTPCodeInput
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AccountServiceMock_Expecter) DisableTOTP(ctx interface{}, id interface{}, input interface{}, clientInfo interface{}) *AccountServiceMock_DisableTOTP_Call {
+	return &AccountServiceMock_DisableTOTP_Call{Call: _e.mock.On("DisableTOTP", ctx, id, input, clientInfo)}
+}
+
+func (_c *AccountServiceMock_DisableTOTP_Call) Run(run func(ctx context.Context, id uuid.UUID, input v0.TOTPCodeInput, clientInfo infrastructure.ClientInfo)) *AccountServiceMock_DisableTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(v0.TOTPCodeInput), args[3].(infrastructure.ClientInfo))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_DisableTOTP_Call) Return(_a0 error) *AccountServiceMock_DisableTOTP_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountServiceMock_DisableTOTP_Call) RunAndReturn(run func(context.Context, uuid.UUID, v0.TOTPCodeInput, infrastructure.ClientInfo) error) *AccountServiceMock_DisableTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnableTOTP provides a mock function with given fields: ctx, id, input, clientInfo
+func (_m *AccountServiceMock) EnableTOTP(ctx context.Context, id uuid.UUID, input v0.TOTPCodeInput, clientInfo infrastructure.ClientInfo) (v0.RecoveryCodesOutput, error) {
+	ret := _m.Called(ctx, id, input, clientInfo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnableTOTP")
+	}
+
+	var r0 v0.RecoveryCodesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.TOTPCodeInput, infrastructure.ClientInfo) (v0.RecoveryCodesOutput, error)); ok {
+		return rf(ctx, id, input, clientInfo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.TOTPCodeInput, infrastructure.ClientInfo) v0.RecoveryCodesOutput); ok {
+		r0 = rf(ctx, id, input, clientInfo)
+	} else {
+		r0 = ret.Get(0).(v0.RecoveryCodesOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, v0.TOTPCodeInput, infrastructure.ClientInfo) error); ok {
+		r1 = rf(ctx, id, input, clientInfo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountServiceMock_EnableTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableTOTP'
+type AccountServiceMock_EnableTOTP_Call struct {
+	*mock.Call
+}
+
+// EnableTOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - input v0.TOTPCodeInput
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AccountServiceMock_Expecter) EnableTOTP(ctx interface{}, id interface{}, input interface{}, clientInfo interface{}) *AccountServiceMock_EnableTOTP_Call {
+	return &AccountServiceMock_EnableTOTP_Call{Call: _e.mock.On("EnableTOTP", ctx, id, input, clientInfo)}
+}
+
+func (_c *AccountServiceMock_EnableTOTP_Call) Run(run func(ctx context.Context, id uuid.UUID, input v0.TOTPCodeInput, clientInfo infrastructure.ClientInfo)) *AccountServiceMock_EnableTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(v0.TOTPCodeInput), args[3].(infrastructure.ClientInfo))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_EnableTOTP_Call) Return(_a0 v0.RecoveryCodesOutput, _a1 error) *AccountServiceMock_EnableTOTP_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountServiceMock_EnableTOTP_Call) RunAndReturn(run func(context.Context, uuid.UUID, v0.TOTPCodeInput, infrastructure.ClientInfo) (v0.RecoveryCodesOutput, error)) *AccountServiceMock_EnableTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishPasskeyRegistration provides a mock function with given fields: ctx, id, input
+func (_m *AccountServiceMock) FinishPasskeyRegistration(ctx context.Context, id uuid.UUID, input v0.PasskeyRegistrationInput) (v0.PasskeyOutput, error) {
+	ret := _m.Called(ctx, id, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishPasskeyRegistration")
+	}
+
+	var r0 v0.PasskeyOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.PasskeyRegistrationInput) (v0.PasskeyOutput, error)); ok {
+		return rf(ctx, id, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.PasskeyRegistrationInput) v0.PasskeyOutput); ok {
+		r0 = rf(ctx, id, input)
+	} else {
+		r0 = ret.Get(0).(v0.PasskeyOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, v0.PasskeyRegistrationInput) error); ok {
+		r1 = rf(ctx, id, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountServiceMock_FinishPasskeyRegistration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishPasskeyRegistration'
+type AccountServiceMock_FinishPasskeyRegistration_Call struct {
+	*mock.Call
+}
+
+// FinishPasskeyRegistration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - input v0.PasskeyRegistrationInput
+func (_e *AccountServiceMock_Expecter) FinishPasskeyRegistration(ctx interface{}, id interface{}, input interface{}) *AccountServiceMock_FinishPasskeyRegistration_Call {
+	return &AccountServiceMock_FinishPasskeyRegistration_Call{Call: _e.mock.On("FinishPasskeyRegistration", ctx, id, input)}
+}
+
+func (_c *AccountServiceMock_FinishPasskeyRegistration_Call) Run(run func(ctx context.Context, id uuid.UUID, input v0.PasskeyRegistrationInput)) *AccountServiceMock_FinishPasskeyRegistration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(v0.PasskeyRegistrationInput))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_FinishPasskeyRegistration_Call) Return(_a0 v0.PasskeyOutput, _a1 error) *AccountServiceMock_FinishPasskeyRegistration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountServiceMock_FinishPasskeyRegistration_Call) RunAndReturn(run func(context.Context, uuid.UUID, v0.PasskeyRegistrationInput) (v0.PasskeyOutput, error)) *AccountServiceMock_FinishPasskeyRegistration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAccount provides a mock function with given fields: ctx, id
+func (_m *AccountServiceMock) GetAccount(ctx context.Context, id uuid.UUID) (v0.AccountOutput, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccount")
+	}
+
+	var r0 v0.AccountOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (v0.AccountOutput, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) v0.AccountOutput); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(v0.AccountOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountServiceMock_GetAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccount'
+type AccountServiceMock_GetAccount_Call struct {
+	*mock.Call
+}
+
+// GetAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *AccountServiceMock_Expecter) GetAccount(ctx interface{}, id interface{}) *AccountServiceMock_GetAccount_Call {
+	return &AccountServiceMock_GetAccount_Call{Call: _e.mock.On("GetAccount", ctx, id)}
+}
+
+func (_c *AccountServiceMock_GetAccount_Call) Run(run func(ctx context.Context, id uuid.UUID)) *AccountServiceMock_GetAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_GetAccount_Call) Return(_a0 v0.AccountOutput, _a1 error) *AccountServiceMock_GetAccount_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountServiceMock_GetAccount_Call) RunAndReturn(run func(context.Context, uuid.UUID) (v0.AccountOutput, error)) *AccountServiceMock_GetAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIdentities provides a mock function with given fields: ctx, id
+func (_m *AccountServiceMock) GetIdentities(ctx context.Context, id uuid.UUID) (v0.IdentitiesOutput, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetIdentities")
+	}
+
+	var r0 v0.IdentitiesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (v0.IdentitiesOutput, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) v0.IdentitiesOutput); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(v0.IdentitiesOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountServiceMock_GetIdentities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIdentities'
+type AccountServiceMock_GetIdentities_Call struct {
+	*mock.Call
+}
+
+// GetIdentities is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *AccountServiceMock_Expecter) GetIdentities(ctx interface{}, id interface{}) *AccountServiceMock_GetIdentities_Call {
+	return &AccountServiceMock_GetIdentities_Call{Call: _e.mock.On("GetIdentities", ctx, id)}
+}
+
+func (_c *AccountServiceMock_GetIdentities_Call) Run(run func(ctx context.Context, id uuid.UUID)) *AccountServiceMock_GetIdentities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_GetIdentities_Call) Return(_a0 v0.IdentitiesOutput, _a1 error) *AccountServiceMock_GetIdentities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountServiceMock_GetIdentities_Call) RunAndReturn(run func(context.Context, uuid.UUID) (v0.IdentitiesOutput, error)) *AccountServiceMock_GetIdentities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPasskeys provides a mock function with given fields: ctx, id
+func (_m *AccountServiceMock) GetPasskeys(ctx context.Context, id uuid.UUID) (v0.PasskeysOutput, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPasskeys")
+	}
+
+	var r0 v0.PasskeysOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (v0.PasskeysOutput, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) v0.PasskeysOutput); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(v0.PasskeysOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountServiceMock_GetPasskeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPasskeys'
+type AccountServiceMock_GetPasskeys_Call struct {
+	*mock.Call
+}
+
+// GetPasskeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *AccountServiceMock_Expecter) GetPasskeys(ctx interface{}, id interface{}) *AccountServiceMock_GetPasskeys_Call {
+	return &AccountServiceMock_GetPasskeys_Call{Call: _e.mock.On("GetPasskeys", ctx, id)}
+}
+
+func (_c *AccountServiceMock_GetPasskeys_Call) Run(run func(ctx context.Context, id uuid.UUID)) *AccountServiceMock_GetPasskeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_GetPasskeys_Call) Return(_a0 v0.PasskeysOutput, _a1 error) *AccountServiceMock_GetPasskeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountServiceMock_GetPasskeys_Call) RunAndReturn(run func(context.Context, uuid.UUID) (v0.PasskeysOutput, error)) *AccountServiceMock_GetPasskeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSessions provides a mock function with given fields: ctx, id, currentSessionID
+func (_m *AccountServiceMock) GetSessions(ctx context.Context, id uuid.UUID, currentSessionID uuid.UUID) (v0.SessionsOutput, error) {
+	ret := _m.Called(ctx, id, currentSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessions")
+	}
+
+	var r0 v0.SessionsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (v0.SessionsOutput, error)); ok {
+		return rf(ctx, id, currentSessionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) v0.SessionsOutput); ok {
+		r0 = rf(ctx, id, currentSessionID)
+	} else {
+		r0 = ret.Get(0).(v0.SessionsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, id, currentSessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountServiceMock_GetSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessions'
+type AccountServiceMock_GetSessions_Call struct {
+	*mock.Call
+}
+
+// GetSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - currentSessionID uuid.UUID
+func (_e *AccountServiceMock_Expecter) GetSessions(ctx interface{}, id interface{}, currentSessionID interface{}) *AccountServiceMock_GetSessions_Call {
+	return &AccountServiceMock_GetSessions_Call{Call: _e.mock.On("GetSessions", ctx, id, currentSessionID)}
+}
+
+func (_c *AccountServiceMock_GetSessions_Call) Run(run func(ctx context.Context, id uuid.UUID, currentSessionID uuid.UUID)) *AccountServiceMock_GetSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_GetSessions_Call) Return(_a0 v0.SessionsOutput, _a1 error) *AccountServiceMock_GetSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountServiceMock_GetSessions_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (v0.SessionsOutput, error)) *AccountServiceMock_GetSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkIdentity provides a mock function with given fields: ctx, id, provider, input, clientInfo
+func (_m *AccountServiceMock) LinkIdentity(ctx context.Context, id uuid.UUID, provider string, input v0.LinkIdentityInput, clientInfo infrastructure.ClientInfo) (v0.IdentityOutput, error) {
+	ret := _m.Called(ctx, id, provider, input, clientInfo)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteAccount")
+		panic("no return value specified for LinkIdentity")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
-		r0 = rf(ctx, id)
+	var r0 v0.IdentityOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, v0.LinkIdentityInput, infrastructure.ClientInfo) (v0.IdentityOutput, error)); ok {
+		return rf(ctx, id, provider, input, clientInfo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, v0.LinkIdentityInput, infrastructure.ClientInfo) v0.IdentityOutput); ok {
+		r0 = rf(ctx, id, provider, input, clientInfo)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(v0.IdentityOutput)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, v0.LinkIdentityInput, infrastructure.ClientInfo) error); ok {
+		r1 = rf(ctx, id, provider, input, clientInfo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// AccountServiceMock_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
-type AccountServiceMock_DeleteAccount_Call struct {
+// AccountServiceMock_LinkIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkIdentity'
+type AccountServiceMock_LinkIdentity_Call struct {
 	*mock.Call
 }
 
-// DeleteAccount is a helper method to define mock.On call
+// LinkIdentity is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *AccountServiceMock_Expecter) DeleteAccount(ctx interface{}, id interface{}) *AccountServiceMock_DeleteAccount_Call {
-	return &AccountServiceMock_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", ctx, id)}
+//   - provider string
+//   - input v0.LinkIdentityInput
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AccountServiceMock_Expecter) LinkIdentity(ctx interface{}, id interface{}, provider interface{}, input interface{}, clientInfo interface{}) *AccountServiceMock_LinkIdentity_Call {
+	return &AccountServiceMock_LinkIdentity_Call{Call: _e.mock.On("LinkIdentity", ctx, id, provider, input, clientInfo)}
 }
 
-func (_c *AccountServiceMock_DeleteAccount_Call) Run(run func(ctx context.Context, id uuid.UUID)) *AccountServiceMock_DeleteAccount_Call {
+func (_c *AccountServiceMock_LinkIdentity_Call) Run(run func(ctx context.Context, id uuid.UUID, provider string, input v0.LinkIdentityInput, clientInfo infrastructure.ClientInfo)) *AccountServiceMock_LinkIdentity_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string), args[3].(v0.LinkIdentityInput), args[4].(infrastructure.ClientInfo))
 	})
 	return _c
 }
 
-func (_c *AccountServiceMock_DeleteAccount_Call) Return(_a0 error) *AccountServiceMock_DeleteAccount_Call {
-	_c.Call.Return(_a0)
+func (_c *AccountServiceMock_LinkIdentity_Call) Return(_a0 v0.IdentityOutput, _a1 error) *AccountServiceMock_LinkIdentity_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *AccountServiceMock_DeleteAccount_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *AccountServiceMock_DeleteAccount_Call {
+func (_c *AccountServiceMock_LinkIdentity_Call) RunAndReturn(run func(context.Context, uuid.UUID, string, v0.LinkIdentityInput, infrastructure.ClientInfo) (v0.IdentityOutput, error)) *AccountServiceMock_LinkIdentity_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAccount provides a mock function with given fields: ctx, id
-func (_m *AccountServiceMock) GetAccount(ctx context.Context, id uuid.UUID) (model.AccountOutput, error) {
-	ret := _m.Called(ctx, id)
+// RegenerateRecoveryCodes provides a mock function with given fields: ctx, id, input, clientInfo
+func (_m *AccountServiceMock) RegenerateRecoveryCodes(ctx context.Context, id uuid.UUID, input v0.TOTPCodeInput, clientInfo infrastructure.ClientInfo) (v0.RecoveryCodesOutput, error) {
+	ret := _m.Called(ctx, id, input, clientInfo)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAccount")
+		panic("no return value specified for RegenerateRecoveryCodes")
 	}
 
-	var r0 model.AccountOutput
+	var r0 v0.RecoveryCodesOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.AccountOutput, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.TOTPCodeInput, infrastructure.ClientInfo) (v0.RecoveryCodesOutput, error)); ok {
+		return rf(ctx, id, input, clientInfo)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.AccountOutput); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.TOTPCodeInput, infrastructure.ClientInfo) v0.RecoveryCodesOutput); ok {
+		r0 = rf(ctx, id, input, clientInfo)
 	} else {
-		r0 = ret.Get(0).(model.AccountOutput)
+		r0 = ret.Get(0).(v0.RecoveryCodesOutput)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, v0.TOTPCodeInput, infrastructure.ClientInfo) error); ok {
+		r1 = rf(ctx, id, input, clientInfo)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -101,52 +722,54 @@ func (_m *AccountServiceMock) GetAccount(ctx context.Context, id uuid.UUID) (mod
 	return r0, r1
 }
 
-// AccountServiceMock_GetAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccount'
-type AccountServiceMock_GetAccount_Call struct {
+// AccountServiceMock_RegenerateRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegenerateRecoveryCodes'
+type AccountServiceMock_RegenerateRecoveryCodes_Call struct {
 	*mock.Call
 }
 
-// GetAccount is a helper method to define mock.On call
+// RegenerateRecoveryCodes is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-func (_e *AccountServiceMock_Expecter) GetAccount(ctx interface{}, id interface{}) *AccountServiceMock_GetAccount_Call {
-	return &AccountServiceMock_GetAccount_Call{Call: _e.mock.On("GetAccount", ctx, id)}
+//   - input v0.TOTPCodeInput
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AccountServiceMock_Expecter) RegenerateRecoveryCodes(ctx interface{}, id interface{}, input interface{}, clientInfo interface{}) *AccountServiceMock_RegenerateRecoveryCodes_Call {
+	return &AccountServiceMock_RegenerateRecoveryCodes_Call{Call: _e.mock.On("RegenerateRecoveryCodes", ctx, id, input, clientInfo)}
 }
 
-func (_c *AccountServiceMock_GetAccount_Call) Run(run func(ctx context.Context, id uuid.UUID)) *AccountServiceMock_GetAccount_Call {
+func (_c *AccountServiceMock_RegenerateRecoveryCodes_Call) Run(run func(ctx context.Context, id uuid.UUID, input v0.TOTPCodeInput, clientInfo infrastructure.ClientInfo)) *AccountServiceMock_RegenerateRecoveryCodes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(v0.TOTPCodeInput), args[3].(infrastructure.ClientInfo))
 	})
 	return _c
 }
 
-func (_c *AccountServiceMock_GetAccount_Call) Return(_a0 model.AccountOutput, _a1 error) *AccountServiceMock_GetAccount_Call {
+func (_c *AccountServiceMock_RegenerateRecoveryCodes_Call) Return(_a0 v0.RecoveryCodesOutput, _a1 error) *AccountServiceMock_RegenerateRecoveryCodes_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *AccountServiceMock_GetAccount_Call) RunAndReturn(run func(context.Context, uuid.UUID) (model.AccountOutput, error)) *AccountServiceMock_GetAccount_Call {
+func (_c *AccountServiceMock_RegenerateRecoveryCodes_Call) RunAndReturn(run func(context.Context, uuid.UUID, v0.TOTPCodeInput, infrastructure.ClientInfo) (v0.RecoveryCodesOutput, error)) *AccountServiceMock_RegenerateRecoveryCodes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // RestoreAccount provides a mock function with given fields: ctx, id
-func (_m *AccountServiceMock) RestoreAccount(ctx context.Context, id uuid.UUID) (model.AccountOutput, error) {
+func (_m *AccountServiceMock) RestoreAccount(ctx context.Context, id uuid.UUID) (v0.AccountOutput, error) {
 	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RestoreAccount")
 	}
 
-	var r0 model.AccountOutput
+	var r0 v0.AccountOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (model.AccountOutput, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (v0.AccountOutput, error)); ok {
 		return rf(ctx, id)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) model.AccountOutput); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) v0.AccountOutput); ok {
 		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Get(0).(model.AccountOutput)
+		r0 = ret.Get(0).(v0.AccountOutput)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
@@ -177,18 +800,114 @@ func (_c *AccountServiceMock_RestoreAccount_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *AccountServiceMock_RestoreAccount_Call) Return(_a0 model.AccountOutput, _a1 error) *AccountServiceMock_RestoreAccount_Call {
+func (_c *AccountServiceMock_RestoreAccount_Call) Return(_a0 v0.AccountOutput, _a1 error) *AccountServiceMock_RestoreAccount_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *AccountServiceMock_RestoreAccount_Call) RunAndReturn(run func(context.Context, uuid.UUID) (model.AccountOutput, error)) *AccountServiceMock_RestoreAccount_Call {
+func (_c *AccountServiceMock_RestoreAccount_Call) RunAndReturn(run func(context.Context, uuid.UUID) (v0.AccountOutput, error)) *AccountServiceMock_RestoreAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeOtherSessions provides a mock function with given fields: ctx, id, currentSessionID
+func (_m *AccountServiceMock) RevokeOtherSessions(ctx context.Context, id uuid.UUID, currentSessionID uuid.UUID) error {
+	ret := _m.Called(ctx, id, currentSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeOtherSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, id, currentSessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountServiceMock_RevokeOtherSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeOtherSessions'
+type AccountServiceMock_RevokeOtherSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeOtherSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - currentSessionID uuid.UUID
+func (_e *AccountServiceMock_Expecter) RevokeOtherSessions(ctx interface{}, id interface{}, currentSessionID interface{}) *AccountServiceMock_RevokeOtherSessions_Call {
+	return &AccountServiceMock_RevokeOtherSessions_Call{Call: _e.mock.On("RevokeOtherSessions", ctx, id, currentSessionID)}
+}
+
+func (_c *AccountServiceMock_RevokeOtherSessions_Call) Run(run func(ctx context.Context, id uuid.UUID, currentSessionID uuid.UUID)) *AccountServiceMock_RevokeOtherSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_RevokeOtherSessions_Call) Return(_a0 error) *AccountServiceMock_RevokeOtherSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountServiceMock_RevokeOtherSessions_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *AccountServiceMock_RevokeOtherSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function with given fields: ctx, id, sessionID
+func (_m *AccountServiceMock) RevokeSession(ctx context.Context, id uuid.UUID, sessionID uuid.UUID) error {
+	ret := _m.Called(ctx, id, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, id, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountServiceMock_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type AccountServiceMock_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - sessionID uuid.UUID
+func (_e *AccountServiceMock_Expecter) RevokeSession(ctx interface{}, id interface{}, sessionID interface{}) *AccountServiceMock_RevokeSession_Call {
+	return &AccountServiceMock_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, id, sessionID)}
+}
+
+func (_c *AccountServiceMock_RevokeSession_Call) Run(run func(ctx context.Context, id uuid.UUID, sessionID uuid.UUID)) *AccountServiceMock_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_RevokeSession_Call) Return(_a0 error) *AccountServiceMock_RevokeSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountServiceMock_RevokeSession_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *AccountServiceMock_RevokeSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // SetPassword provides a mock function with given fields: ctx, id, input
-func (_m *AccountServiceMock) SetPassword(ctx context.Context, id uuid.UUID, input model.SetPasswordInput) error {
+func (_m *AccountServiceMock) SetPassword(ctx context.Context, id uuid.UUID, input v0.SetPasswordInput) error {
 	ret := _m.Called(ctx, id, input)
 
 	if len(ret) == 0 {
@@ -196,7 +915,7 @@ func (_m *AccountServiceMock) SetPassword(ctx context.Context, id uuid.UUID, inp
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.SetPasswordInput) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.SetPasswordInput) error); ok {
 		r0 = rf(ctx, id, input)
 	} else {
 		r0 = ret.Error(0)
@@ -213,14 +932,14 @@ type AccountServiceMock_SetPassword_Call struct {
 // SetPassword is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-//   - input model.SetPasswordInput
+//   - input v0.SetPasswordInput
 func (_e *AccountServiceMock_Expecter) SetPassword(ctx interface{}, id interface{}, input interface{}) *AccountServiceMock_SetPassword_Call {
 	return &AccountServiceMock_SetPassword_Call{Call: _e.mock.On("SetPassword", ctx, id, input)}
 }
 
-func (_c *AccountServiceMock_SetPassword_Call) Run(run func(ctx context.Context, id uuid.UUID, input model.SetPasswordInput)) *AccountServiceMock_SetPassword_Call {
+func (_c *AccountServiceMock_SetPassword_Call) Run(run func(ctx context.Context, id uuid.UUID, input v0.SetPasswordInput)) *AccountServiceMock_SetPassword_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(model.SetPasswordInput))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(v0.SetPasswordInput))
 	})
 	return _c
 }
@@ -230,31 +949,136 @@ func (_c *AccountServiceMock_SetPassword_Call) Return(_a0 error) *AccountService
 	return _c
 }
 
-func (_c *AccountServiceMock_SetPassword_Call) RunAndReturn(run func(context.Context, uuid.UUID, model.SetPasswordInput) error) *AccountServiceMock_SetPassword_Call {
+func (_c *AccountServiceMock_SetPassword_Call) RunAndReturn(run func(context.Context, uuid.UUID, v0.SetPasswordInput) error) *AccountServiceMock_SetPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetupTOTP provides a mock function with given fields: ctx, id
+func (_m *AccountServiceMock) SetupTOTP(ctx context.Context, id uuid.UUID) (v0.TOTPSetupOutput, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetupTOTP")
+	}
+
+	var r0 v0.TOTPSetupOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (v0.TOTPSetupOutput, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) v0.TOTPSetupOutput); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(v0.TOTPSetupOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountServiceMock_SetupTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetupTOTP'
+type AccountServiceMock_SetupTOTP_Call struct {
+	*mock.Call
+}
+
+// SetupTOTP is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *AccountServiceMock_Expecter) SetupTOTP(ctx interface{}, id interface{}) *AccountServiceMock_SetupTOTP_Call {
+	return &AccountServiceMock_SetupTOTP_Call{Call: _e.mock.On("SetupTOTP", ctx, id)}
+}
+
+func (_c *AccountServiceMock_SetupTOTP_Call) Run(run func(ctx context.Context, id uuid.UUID)) *AccountServiceMock_SetupTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_SetupTOTP_Call) Return(_a0 v0.TOTPSetupOutput, _a1 error) *AccountServiceMock_SetupTOTP_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountServiceMock_SetupTOTP_Call) RunAndReturn(run func(context.Context, uuid.UUID) (v0.TOTPSetupOutput, error)) *AccountServiceMock_SetupTOTP_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlinkIdentity provides a mock function with given fields: ctx, id, provider
+func (_m *AccountServiceMock) UnlinkIdentity(ctx context.Context, id uuid.UUID, provider string) error {
+	ret := _m.Called(ctx, id, provider)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlinkIdentity")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, id, provider)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountServiceMock_UnlinkIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlinkIdentity'
+type AccountServiceMock_UnlinkIdentity_Call struct {
+	*mock.Call
+}
+
+// UnlinkIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - provider string
+func (_e *AccountServiceMock_Expecter) UnlinkIdentity(ctx interface{}, id interface{}, provider interface{}) *AccountServiceMock_UnlinkIdentity_Call {
+	return &AccountServiceMock_UnlinkIdentity_Call{Call: _e.mock.On("UnlinkIdentity", ctx, id, provider)}
+}
+
+func (_c *AccountServiceMock_UnlinkIdentity_Call) Run(run func(ctx context.Context, id uuid.UUID, provider string)) *AccountServiceMock_UnlinkIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_UnlinkIdentity_Call) Return(_a0 error) *AccountServiceMock_UnlinkIdentity_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountServiceMock_UnlinkIdentity_Call) RunAndReturn(run func(context.Context, uuid.UUID, string) error) *AccountServiceMock_UnlinkIdentity_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateEmail provides a mock function with given fields: ctx, id, input, localizer
-func (_m *AccountServiceMock) UpdateEmail(ctx context.Context, id uuid.UUID, input model.UpdateEmailInput, localizer locale.Localizer) (model.AccountOutput, error) {
+func (_m *AccountServiceMock) UpdateEmail(ctx context.Context, id uuid.UUID, input v0.UpdateEmailInput, localizer locale.Localizer) (v0.AccountOutput, error) {
 	ret := _m.Called(ctx, id, input, localizer)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateEmail")
 	}
 
-	var r0 model.AccountOutput
+	var r0 v0.AccountOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.UpdateEmailInput, locale.Localizer) (model.AccountOutput, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.UpdateEmailInput, locale.Localizer) (v0.AccountOutput, error)); ok {
 		return rf(ctx, id, input, localizer)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.UpdateEmailInput, locale.Localizer) model.AccountOutput); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.UpdateEmailInput, locale.Localizer) v0.AccountOutput); ok {
 		r0 = rf(ctx, id, input, localizer)
 	} else {
-		r0 = ret.Get(0).(model.AccountOutput)
+		r0 = ret.Get(0).(v0.AccountOutput)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, model.UpdateEmailInput, locale.Localizer) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, v0.UpdateEmailInput, locale.Localizer) error); ok {
 		r1 = rf(ctx, id, input, localizer)
 	} else {
 		r1 = ret.Error(1)
@@ -271,40 +1095,40 @@ type AccountServiceMock_UpdateEmail_Call struct {
 // UpdateEmail is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-//   - input model.UpdateEmailInput
+//   - input v0.UpdateEmailInput
 //   - localizer locale.Localizer
 func (_e *AccountServiceMock_Expecter) UpdateEmail(ctx interface{}, id interface{}, input interface{}, localizer interface{}) *AccountServiceMock_UpdateEmail_Call {
 	return &AccountServiceMock_UpdateEmail_Call{Call: _e.mock.On("UpdateEmail", ctx, id, input, localizer)}
 }
 
-func (_c *AccountServiceMock_UpdateEmail_Call) Run(run func(ctx context.Context, id uuid.UUID, input model.UpdateEmailInput, localizer locale.Localizer)) *AccountServiceMock_UpdateEmail_Call {
+func (_c *AccountServiceMock_UpdateEmail_Call) Run(run func(ctx context.Context, id uuid.UUID, input v0.UpdateEmailInput, localizer locale.Localizer)) *AccountServiceMock_UpdateEmail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(model.UpdateEmailInput), args[3].(locale.Localizer))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(v0.UpdateEmailInput), args[3].(locale.Localizer))
 	})
 	return _c
 }
 
-func (_c *AccountServiceMock_UpdateEmail_Call) Return(_a0 model.AccountOutput, _a1 error) *AccountServiceMock_UpdateEmail_Call {
+func (_c *AccountServiceMock_UpdateEmail_Call) Return(_a0 v0.AccountOutput, _a1 error) *AccountServiceMock_UpdateEmail_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *AccountServiceMock_UpdateEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, model.UpdateEmailInput, locale.Localizer) (model.AccountOutput, error)) *AccountServiceMock_UpdateEmail_Call {
+func (_c *AccountServiceMock_UpdateEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, v0.UpdateEmailInput, locale.Localizer) (v0.AccountOutput, error)) *AccountServiceMock_UpdateEmail_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePassword provides a mock function with given fields: ctx, id, input
-func (_m *AccountServiceMock) UpdatePassword(ctx context.Context, id uuid.UUID, input model.UpdatePasswordInput) error {
-	ret := _m.Called(ctx, id, input)
+// UpdatePassword provides a mock function with given fields: ctx, id, sessionID, input
+func (_m *AccountServiceMock) UpdatePassword(ctx context.Context, id uuid.UUID, sessionID uuid.UUID, input v0.UpdatePasswordInput) error {
+	ret := _m.Called(ctx, id, sessionID, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdatePassword")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.UpdatePasswordInput) error); ok {
-		r0 = rf(ctx, id, input)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, v0.UpdatePasswordInput) error); ok {
+		r0 = rf(ctx, id, sessionID, input)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -320,14 +1144,15 @@ type AccountServiceMock_UpdatePassword_Call struct {
 // UpdatePassword is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-//   - input model.UpdatePasswordInput
-func (_e *AccountServiceMock_Expecter) UpdatePassword(ctx interface{}, id interface{}, input interface{}) *AccountServiceMock_UpdatePassword_Call {
-	return &AccountServiceMock_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", ctx, id, input)}
+//   - sessionID uuid.UUID
+//   - input v0.UpdatePasswordInput
+func (_e *AccountServiceMock_Expecter) UpdatePassword(ctx interface{}, id interface{}, sessionID interface{}, input interface{}) *AccountServiceMock_UpdatePassword_Call {
+	return &AccountServiceMock_UpdatePassword_Call{Call: _e.mock.On("UpdatePassword", ctx, id, sessionID, input)}
 }
 
-func (_c *AccountServiceMock_UpdatePassword_Call) Run(run func(ctx context.Context, id uuid.UUID, input model.UpdatePasswordInput)) *AccountServiceMock_UpdatePassword_Call {
+func (_c *AccountServiceMock_UpdatePassword_Call) Run(run func(ctx context.Context, id uuid.UUID, sessionID uuid.UUID, input v0.UpdatePasswordInput)) *AccountServiceMock_UpdatePassword_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(model.UpdatePasswordInput))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(v0.UpdatePasswordInput))
 	})
 	return _c
 }
@@ -337,31 +1162,90 @@ func (_c *AccountServiceMock_UpdatePassword_Call) Return(_a0 error) *AccountServ
 	return _c
 }
 
-func (_c *AccountServiceMock_UpdatePassword_Call) RunAndReturn(run func(context.Context, uuid.UUID, model.UpdatePasswordInput) error) *AccountServiceMock_UpdatePassword_Call {
+func (_c *AccountServiceMock_UpdatePassword_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, v0.UpdatePasswordInput) error) *AccountServiceMock_UpdatePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePhone provides a mock function with given fields: ctx, id, input, localizer
+func (_m *AccountServiceMock) UpdatePhone(ctx context.Context, id uuid.UUID, input v0.UpdatePhoneInput, localizer locale.Localizer) (v0.AccountOutput, error) {
+	ret := _m.Called(ctx, id, input, localizer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePhone")
+	}
+
+	var r0 v0.AccountOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.UpdatePhoneInput, locale.Localizer) (v0.AccountOutput, error)); ok {
+		return rf(ctx, id, input, localizer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.UpdatePhoneInput, locale.Localizer) v0.AccountOutput); ok {
+		r0 = rf(ctx, id, input, localizer)
+	} else {
+		r0 = ret.Get(0).(v0.AccountOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, v0.UpdatePhoneInput, locale.Localizer) error); ok {
+		r1 = rf(ctx, id, input, localizer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccountServiceMock_UpdatePhone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePhone'
+type AccountServiceMock_UpdatePhone_Call struct {
+	*mock.Call
+}
+
+// UpdatePhone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - input v0.UpdatePhoneInput
+//   - localizer locale.Localizer
+func (_e *AccountServiceMock_Expecter) UpdatePhone(ctx interface{}, id interface{}, input interface{}, localizer interface{}) *AccountServiceMock_UpdatePhone_Call {
+	return &AccountServiceMock_UpdatePhone_Call{Call: _e.mock.On("UpdatePhone", ctx, id, input, localizer)}
+}
+
+func (_c *AccountServiceMock_UpdatePhone_Call) Run(run func(ctx context.Context, id uuid.UUID, input v0.UpdatePhoneInput, localizer locale.Localizer)) *AccountServiceMock_UpdatePhone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(v0.UpdatePhoneInput), args[3].(locale.Localizer))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_UpdatePhone_Call) Return(_a0 v0.AccountOutput, _a1 error) *AccountServiceMock_UpdatePhone_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AccountServiceMock_UpdatePhone_Call) RunAndReturn(run func(context.Context, uuid.UUID, v0.UpdatePhoneInput, locale.Localizer) (v0.AccountOutput, error)) *AccountServiceMock_UpdatePhone_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UpdateUsername provides a mock function with given fields: ctx, id, input
-func (_m *AccountServiceMock) UpdateUsername(ctx context.Context, id uuid.UUID, input model.UpdateUsernameInput) (model.AccountOutput, error) {
+func (_m *AccountServiceMock) UpdateUsername(ctx context.Context, id uuid.UUID, input v0.UpdateUsernameInput) (v0.AccountOutput, error) {
 	ret := _m.Called(ctx, id, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UpdateUsername")
 	}
 
-	var r0 model.AccountOutput
+	var r0 v0.AccountOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.UpdateUsernameInput) (model.AccountOutput, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.UpdateUsernameInput) (v0.AccountOutput, error)); ok {
 		return rf(ctx, id, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.UpdateUsernameInput) model.AccountOutput); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.UpdateUsernameInput) v0.AccountOutput); ok {
 		r0 = rf(ctx, id, input)
 	} else {
-		r0 = ret.Get(0).(model.AccountOutput)
+		r0 = ret.Get(0).(v0.AccountOutput)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, model.UpdateUsernameInput) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, v0.UpdateUsernameInput) error); ok {
 		r1 = rf(ctx, id, input)
 	} else {
 		r1 = ret.Error(1)
@@ -378,30 +1262,30 @@ type AccountServiceMock_UpdateUsername_Call struct {
 // UpdateUsername is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-//   - input model.UpdateUsernameInput
+//   - input v0.UpdateUsernameInput
 func (_e *AccountServiceMock_Expecter) UpdateUsername(ctx interface{}, id interface{}, input interface{}) *AccountServiceMock_UpdateUsername_Call {
 	return &AccountServiceMock_UpdateUsername_Call{Call: _e.mock.On("UpdateUsername", ctx, id, input)}
 }
 
-func (_c *AccountServiceMock_UpdateUsername_Call) Run(run func(ctx context.Context, id uuid.UUID, input model.UpdateUsernameInput)) *AccountServiceMock_UpdateUsername_Call {
+func (_c *AccountServiceMock_UpdateUsername_Call) Run(run func(ctx context.Context, id uuid.UUID, input v0.UpdateUsernameInput)) *AccountServiceMock_UpdateUsername_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(model.UpdateUsernameInput))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(v0.UpdateUsernameInput))
 	})
 	return _c
 }
 
-func (_c *AccountServiceMock_UpdateUsername_Call) Return(_a0 model.AccountOutput, _a1 error) *AccountServiceMock_UpdateUsername_Call {
+func (_c *AccountServiceMock_UpdateUsername_Call) Return(_a0 v0.AccountOutput, _a1 error) *AccountServiceMock_UpdateUsername_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *AccountServiceMock_UpdateUsername_Call) RunAndReturn(run func(context.Context, uuid.UUID, model.UpdateUsernameInput) (model.AccountOutput, error)) *AccountServiceMock_UpdateUsername_Call {
+func (_c *AccountServiceMock_UpdateUsername_Call) RunAndReturn(run func(context.Context, uuid.UUID, v0.UpdateUsernameInput) (v0.AccountOutput, error)) *AccountServiceMock_UpdateUsername_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // VerifyEmail provides a mock function with given fields: ctx, id, input
-func (_m *AccountServiceMock) VerifyEmail(ctx context.Context, id uuid.UUID, input model.VerifyEmailInput) error {
+func (_m *AccountServiceMock) VerifyEmail(ctx context.Context, id uuid.UUID, input v0.VerifyEmailInput) error {
 	ret := _m.Called(ctx, id, input)
 
 	if len(ret) == 0 {
@@ -409,7 +1293,7 @@ func (_m *AccountServiceMock) VerifyEmail(ctx context.Context, id uuid.UUID, inp
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, model.VerifyEmailInput) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.VerifyEmailInput) error); ok {
 		r0 = rf(ctx, id, input)
 	} else {
 		r0 = ret.Error(0)
@@ -426,14 +1310,14 @@ type AccountServiceMock_VerifyEmail_Call struct {
 // VerifyEmail is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id uuid.UUID
-//   - input model.VerifyEmailInput
+//   - input v0.VerifyEmailInput
 func (_e *AccountServiceMock_Expecter) VerifyEmail(ctx interface{}, id interface{}, input interface{}) *AccountServiceMock_VerifyEmail_Call {
 	return &AccountServiceMock_VerifyEmail_Call{Call: _e.mock.On("VerifyEmail", ctx, id, input)}
 }
 
-func (_c *AccountServiceMock_VerifyEmail_Call) Run(run func(ctx context.Context, id uuid.UUID, input model.VerifyEmailInput)) *AccountServiceMock_VerifyEmail_Call {
+func (_c *AccountServiceMock_VerifyEmail_Call) Run(run func(ctx context.Context, id uuid.UUID, input v0.VerifyEmailInput)) *AccountServiceMock_VerifyEmail_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(model.VerifyEmailInput))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(v0.VerifyEmailInput))
 	})
 	return _c
 }
@@ -443,7 +1327,55 @@ func (_c *AccountServiceMock_VerifyEmail_Call) Return(_a0 error) *AccountService
 	return _c
 }
 
-func (_c *AccountServiceMock_VerifyEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, model.VerifyEmailInput) error) *AccountServiceMock_VerifyEmail_Call {
+func (_c *AccountServiceMock_VerifyEmail_Call) RunAndReturn(run func(context.Context, uuid.UUID, v0.VerifyEmailInput) error) *AccountServiceMock_VerifyEmail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyPhone provides a mock function with given fields: ctx, id, input
+func (_m *AccountServiceMock) VerifyPhone(ctx context.Context, id uuid.UUID, input v0.VerifyPhoneInput) error {
+	ret := _m.Called(ctx, id, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyPhone")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.VerifyPhoneInput) error); ok {
+		r0 = rf(ctx, id, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AccountServiceMock_VerifyPhone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyPhone'
+type AccountServiceMock_VerifyPhone_Call struct {
+	*mock.Call
+}
+
+// VerifyPhone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - input v0.VerifyPhoneInput
+func (_e *AccountServiceMock_Expecter) VerifyPhone(ctx interface{}, id interface{}, input interface{}) *AccountServiceMock_VerifyPhone_Call {
+	return &AccountServiceMock_VerifyPhone_Call{Call: _e.mock.On("VerifyPhone", ctx, id, input)}
+}
+
+func (_c *AccountServiceMock_VerifyPhone_Call) Run(run func(ctx context.Context, id uuid.UUID, input v0.VerifyPhoneInput)) *AccountServiceMock_VerifyPhone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(v0.VerifyPhoneInput))
+	})
+	return _c
+}
+
+func (_c *AccountServiceMock_VerifyPhone_Call) Return(_a0 error) *AccountServiceMock_VerifyPhone_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AccountServiceMock_VerifyPhone_Call) RunAndReturn(run func(context.Context, uuid.UUID, v0.VerifyPhoneInput) error) *AccountServiceMock_VerifyPhone_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4,13 +4,18 @@ package mock
 
 import (
 	context "context"
-	model "github.com/mandarine-io/backend/pkg/model/v0"
+
+	infrastructure "github.com/mandarine-io/backend/internal/service/infrastructure"
 
 	locale "github.com/mandarine-io/backend/internal/infrastructure/locale"
 
 	mock "github.com/stretchr/testify/mock"
 
 	oauth "github.com/mandarine-io/backend/third_party/oauth"
+
+	uuid "github.com/google/uuid"
+
+	v0 "github.com/mandarine-io/backend/pkg/model/v0"
 )
 
 // AuthServiceMock is an autogenerated mock type for the AuthService type
@@ -26,9 +31,65 @@ func (_m *AuthServiceMock) EXPECT() *AuthServiceMock_Expecter {
 	return &AuthServiceMock_Expecter{mock: &_m.Mock}
 }
 
-// FetchUserInfo provides a mock function with given fields: ctx, provider, input
-func (_m *AuthServiceMock) FetchUserInfo(ctx context.Context, provider string, input model.FetchUserInfoInput) (oauth.UserInfo, error) {
-	ret := _m.Called(ctx, provider, input)
+// BeginPasskeyLogin provides a mock function with given fields: ctx
+func (_m *AuthServiceMock) BeginPasskeyLogin(ctx context.Context) (v0.PasskeyRequestOptionsOutput, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginPasskeyLogin")
+	}
+
+	var r0 v0.PasskeyRequestOptionsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (v0.PasskeyRequestOptionsOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) v0.PasskeyRequestOptionsOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(v0.PasskeyRequestOptionsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthServiceMock_BeginPasskeyLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginPasskeyLogin'
+type AuthServiceMock_BeginPasskeyLogin_Call struct {
+	*mock.Call
+}
+
+// BeginPasskeyLogin is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *AuthServiceMock_Expecter) BeginPasskeyLogin(ctx interface{}) *AuthServiceMock_BeginPasskeyLogin_Call {
+	return &AuthServiceMock_BeginPasskeyLogin_Call{Call: _e.mock.On("BeginPasskeyLogin", ctx)}
+}
+
+func (_c *AuthServiceMock_BeginPasskeyLogin_Call) Run(run func(ctx context.Context)) *AuthServiceMock_BeginPasskeyLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_BeginPasskeyLogin_Call) Return(_a0 v0.PasskeyRequestOptionsOutput, _a1 error) *AuthServiceMock_BeginPasskeyLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthServiceMock_BeginPasskeyLogin_Call) RunAndReturn(run func(context.Context) (v0.PasskeyRequestOptionsOutput, error)) *AuthServiceMock_BeginPasskeyLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FetchUserInfo provides a mock function with given fields: ctx, provider, input, clientInfo
+func (_m *AuthServiceMock) FetchUserInfo(ctx context.Context, provider string, input v0.FetchUserInfoInput, clientInfo infrastructure.ClientInfo) (oauth.UserInfo, error) {
+	ret := _m.Called(ctx, provider, input, clientInfo)
 
 	if len(ret) == 0 {
 		panic("no return value specified for FetchUserInfo")
@@ -36,17 +97,17 @@ func (_m *AuthServiceMock) FetchUserInfo(ctx context.Context, provider string, i
 
 	var r0 oauth.UserInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, model.FetchUserInfoInput) (oauth.UserInfo, error)); ok {
-		return rf(ctx, provider, input)
+	if rf, ok := ret.Get(0).(func(context.Context, string, v0.FetchUserInfoInput, infrastructure.ClientInfo) (oauth.UserInfo, error)); ok {
+		return rf(ctx, provider, input, clientInfo)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, model.FetchUserInfoInput) oauth.UserInfo); ok {
-		r0 = rf(ctx, provider, input)
+	if rf, ok := ret.Get(0).(func(context.Context, string, v0.FetchUserInfoInput, infrastructure.ClientInfo) oauth.UserInfo); ok {
+		r0 = rf(ctx, provider, input, clientInfo)
 	} else {
 		r0 = ret.Get(0).(oauth.UserInfo)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, model.FetchUserInfoInput) error); ok {
-		r1 = rf(ctx, provider, input)
+	if rf, ok := ret.Get(1).(func(context.Context, string, v0.FetchUserInfoInput, infrastructure.ClientInfo) error); ok {
+		r1 = rf(ctx, provider, input, clientInfo)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,14 +123,15 @@ type AuthServiceMock_FetchUserInfo_Call struct {
 // FetchUserInfo is a helper method to define mock.On call
 //   - ctx context.Context
 //   - provider string
-//   - input model.FetchUserInfoInput
-func (_e *AuthServiceMock_Expecter) FetchUserInfo(ctx interface{}, provider interface{}, input interface{}) *AuthServiceMock_FetchUserInfo_Call {
-	return &AuthServiceMock_FetchUserInfo_Call{Call: _e.mock.On("FetchUserInfo", ctx, provider, input)}
+//   - input v0.FetchUserInfoInput
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AuthServiceMock_Expecter) FetchUserInfo(ctx interface{}, provider interface{}, input interface{}, clientInfo interface{}) *AuthServiceMock_FetchUserInfo_Call {
+	return &AuthServiceMock_FetchUserInfo_Call{Call: _e.mock.On("FetchUserInfo", ctx, provider, input, clientInfo)}
 }
 
-func (_c *AuthServiceMock_FetchUserInfo_Call) Run(run func(ctx context.Context, provider string, input model.FetchUserInfoInput)) *AuthServiceMock_FetchUserInfo_Call {
+func (_c *AuthServiceMock_FetchUserInfo_Call) Run(run func(ctx context.Context, provider string, input v0.FetchUserInfoInput, clientInfo infrastructure.ClientInfo)) *AuthServiceMock_FetchUserInfo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(model.FetchUserInfoInput))
+		run(args[0].(context.Context), args[1].(string), args[2].(v0.FetchUserInfoInput), args[3].(infrastructure.ClientInfo))
 	})
 	return _c
 }
@@ -79,32 +141,90 @@ func (_c *AuthServiceMock_FetchUserInfo_Call) Return(_a0 oauth.UserInfo, _a1 err
 	return _c
 }
 
-func (_c *AuthServiceMock_FetchUserInfo_Call) RunAndReturn(run func(context.Context, string, model.FetchUserInfoInput) (oauth.UserInfo, error)) *AuthServiceMock_FetchUserInfo_Call {
+func (_c *AuthServiceMock_FetchUserInfo_Call) RunAndReturn(run func(context.Context, string, v0.FetchUserInfoInput, infrastructure.ClientInfo) (oauth.UserInfo, error)) *AuthServiceMock_FetchUserInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishPasskeyLogin provides a mock function with given fields: ctx, input, clientInfo
+func (_m *AuthServiceMock) FinishPasskeyLogin(ctx context.Context, input v0.PasskeyLoginInput, clientInfo infrastructure.ClientInfo) (v0.JwtTokensOutput, error) {
+	ret := _m.Called(ctx, input, clientInfo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishPasskeyLogin")
+	}
+
+	var r0 v0.JwtTokensOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, v0.PasskeyLoginInput, infrastructure.ClientInfo) (v0.JwtTokensOutput, error)); ok {
+		return rf(ctx, input, clientInfo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, v0.PasskeyLoginInput, infrastructure.ClientInfo) v0.JwtTokensOutput); ok {
+		r0 = rf(ctx, input, clientInfo)
+	} else {
+		r0 = ret.Get(0).(v0.JwtTokensOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, v0.PasskeyLoginInput, infrastructure.ClientInfo) error); ok {
+		r1 = rf(ctx, input, clientInfo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthServiceMock_FinishPasskeyLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishPasskeyLogin'
+type AuthServiceMock_FinishPasskeyLogin_Call struct {
+	*mock.Call
+}
+
+// FinishPasskeyLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input v0.PasskeyLoginInput
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AuthServiceMock_Expecter) FinishPasskeyLogin(ctx interface{}, input interface{}, clientInfo interface{}) *AuthServiceMock_FinishPasskeyLogin_Call {
+	return &AuthServiceMock_FinishPasskeyLogin_Call{Call: _e.mock.On("FinishPasskeyLogin", ctx, input, clientInfo)}
+}
+
+func (_c *AuthServiceMock_FinishPasskeyLogin_Call) Run(run func(ctx context.Context, input v0.PasskeyLoginInput, clientInfo infrastructure.ClientInfo)) *AuthServiceMock_FinishPasskeyLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(v0.PasskeyLoginInput), args[2].(infrastructure.ClientInfo))
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_FinishPasskeyLogin_Call) Return(_a0 v0.JwtTokensOutput, _a1 error) *AuthServiceMock_FinishPasskeyLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthServiceMock_FinishPasskeyLogin_Call) RunAndReturn(run func(context.Context, v0.PasskeyLoginInput, infrastructure.ClientInfo) (v0.JwtTokensOutput, error)) *AuthServiceMock_FinishPasskeyLogin_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConsentPageURL provides a mock function with given fields: _a0, provider, redirectURL
-func (_m *AuthServiceMock) GetConsentPageURL(_a0 context.Context, provider string, redirectURL string) (model.GetConsentPageURLOutput, error) {
-	ret := _m.Called(_a0, provider, redirectURL)
+// GetConsentPageURL provides a mock function with given fields: ctx, provider, redirectURL, clientInfo
+func (_m *AuthServiceMock) GetConsentPageURL(ctx context.Context, provider string, redirectURL string, clientInfo infrastructure.ClientInfo) (v0.GetConsentPageURLOutput, error) {
+	ret := _m.Called(ctx, provider, redirectURL, clientInfo)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetConsentPageURL")
 	}
 
-	var r0 model.GetConsentPageURLOutput
+	var r0 v0.GetConsentPageURLOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (model.GetConsentPageURLOutput, error)); ok {
-		return rf(_a0, provider, redirectURL)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, infrastructure.ClientInfo) (v0.GetConsentPageURLOutput, error)); ok {
+		return rf(ctx, provider, redirectURL, clientInfo)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) model.GetConsentPageURLOutput); ok {
-		r0 = rf(_a0, provider, redirectURL)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, infrastructure.ClientInfo) v0.GetConsentPageURLOutput); ok {
+		r0 = rf(ctx, provider, redirectURL, clientInfo)
 	} else {
-		r0 = ret.Get(0).(model.GetConsentPageURLOutput)
+		r0 = ret.Get(0).(v0.GetConsentPageURLOutput)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(_a0, provider, redirectURL)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, infrastructure.ClientInfo) error); ok {
+		r1 = rf(ctx, provider, redirectURL, clientInfo)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -118,51 +238,52 @@ type AuthServiceMock_GetConsentPageURL_Call struct {
 }
 
 // GetConsentPageURL is a helper method to define mock.On call
-//   - _a0 context.Context
+//   - ctx context.Context
 //   - provider string
 //   - redirectURL string
-func (_e *AuthServiceMock_Expecter) GetConsentPageURL(_a0 interface{}, provider interface{}, redirectURL interface{}) *AuthServiceMock_GetConsentPageURL_Call {
-	return &AuthServiceMock_GetConsentPageURL_Call{Call: _e.mock.On("GetConsentPageURL", _a0, provider, redirectURL)}
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AuthServiceMock_Expecter) GetConsentPageURL(ctx interface{}, provider interface{}, redirectURL interface{}, clientInfo interface{}) *AuthServiceMock_GetConsentPageURL_Call {
+	return &AuthServiceMock_GetConsentPageURL_Call{Call: _e.mock.On("GetConsentPageURL", ctx, provider, redirectURL, clientInfo)}
 }
 
-func (_c *AuthServiceMock_GetConsentPageURL_Call) Run(run func(_a0 context.Context, provider string, redirectURL string)) *AuthServiceMock_GetConsentPageURL_Call {
+func (_c *AuthServiceMock_GetConsentPageURL_Call) Run(run func(ctx context.Context, provider string, redirectURL string, clientInfo infrastructure.ClientInfo)) *AuthServiceMock_GetConsentPageURL_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(infrastructure.ClientInfo))
 	})
 	return _c
 }
 
-func (_c *AuthServiceMock_GetConsentPageURL_Call) Return(_a0 model.GetConsentPageURLOutput, _a1 error) *AuthServiceMock_GetConsentPageURL_Call {
+func (_c *AuthServiceMock_GetConsentPageURL_Call) Return(_a0 v0.GetConsentPageURLOutput, _a1 error) *AuthServiceMock_GetConsentPageURL_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *AuthServiceMock_GetConsentPageURL_Call) RunAndReturn(run func(context.Context, string, string) (model.GetConsentPageURLOutput, error)) *AuthServiceMock_GetConsentPageURL_Call {
+func (_c *AuthServiceMock_GetConsentPageURL_Call) RunAndReturn(run func(context.Context, string, string, infrastructure.ClientInfo) (v0.GetConsentPageURLOutput, error)) *AuthServiceMock_GetConsentPageURL_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Login provides a mock function with given fields: ctx, input
-func (_m *AuthServiceMock) Login(ctx context.Context, input model.LoginInput) (model.JwtTokensOutput, error) {
-	ret := _m.Called(ctx, input)
+// Login provides a mock function with given fields: ctx, input, clientInfo
+func (_m *AuthServiceMock) Login(ctx context.Context, input v0.LoginInput, clientInfo infrastructure.ClientInfo) (v0.LoginOutput, error) {
+	ret := _m.Called(ctx, input, clientInfo)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Login")
 	}
 
-	var r0 model.JwtTokensOutput
+	var r0 v0.LoginOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.LoginInput) (model.JwtTokensOutput, error)); ok {
-		return rf(ctx, input)
+	if rf, ok := ret.Get(0).(func(context.Context, v0.LoginInput, infrastructure.ClientInfo) (v0.LoginOutput, error)); ok {
+		return rf(ctx, input, clientInfo)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.LoginInput) model.JwtTokensOutput); ok {
-		r0 = rf(ctx, input)
+	if rf, ok := ret.Get(0).(func(context.Context, v0.LoginInput, infrastructure.ClientInfo) v0.LoginOutput); ok {
+		r0 = rf(ctx, input, clientInfo)
 	} else {
-		r0 = ret.Get(0).(model.JwtTokensOutput)
+		r0 = ret.Get(0).(v0.LoginOutput)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, model.LoginInput) error); ok {
-		r1 = rf(ctx, input)
+	if rf, ok := ret.Get(1).(func(context.Context, v0.LoginInput, infrastructure.ClientInfo) error); ok {
+		r1 = rf(ctx, input, clientInfo)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -177,39 +298,204 @@ type AuthServiceMock_Login_Call struct {
 
 // Login is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input model.LoginInput
-func (_e *AuthServiceMock_Expecter) Login(ctx interface{}, input interface{}) *AuthServiceMock_Login_Call {
-	return &AuthServiceMock_Login_Call{Call: _e.mock.On("Login", ctx, input)}
+//   - input v0.LoginInput
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AuthServiceMock_Expecter) Login(ctx interface{}, input interface{}, clientInfo interface{}) *AuthServiceMock_Login_Call {
+	return &AuthServiceMock_Login_Call{Call: _e.mock.On("Login", ctx, input, clientInfo)}
+}
+
+func (_c *AuthServiceMock_Login_Call) Run(run func(ctx context.Context, input v0.LoginInput, clientInfo infrastructure.ClientInfo)) *AuthServiceMock_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(v0.LoginInput), args[2].(infrastructure.ClientInfo))
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_Login_Call) Return(_a0 v0.LoginOutput, _a1 error) *AuthServiceMock_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthServiceMock_Login_Call) RunAndReturn(run func(context.Context, v0.LoginInput, infrastructure.ClientInfo) (v0.LoginOutput, error)) *AuthServiceMock_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginMFA provides a mock function with given fields: ctx, input, clientInfo
+func (_m *AuthServiceMock) LoginMFA(ctx context.Context, input v0.LoginMFAInput, clientInfo infrastructure.ClientInfo) (v0.JwtTokensOutput, error) {
+	ret := _m.Called(ctx, input, clientInfo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginMFA")
+	}
+
+	var r0 v0.JwtTokensOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, v0.LoginMFAInput, infrastructure.ClientInfo) (v0.JwtTokensOutput, error)); ok {
+		return rf(ctx, input, clientInfo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, v0.LoginMFAInput, infrastructure.ClientInfo) v0.JwtTokensOutput); ok {
+		r0 = rf(ctx, input, clientInfo)
+	} else {
+		r0 = ret.Get(0).(v0.JwtTokensOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, v0.LoginMFAInput, infrastructure.ClientInfo) error); ok {
+		r1 = rf(ctx, input, clientInfo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthServiceMock_LoginMFA_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginMFA'
+type AuthServiceMock_LoginMFA_Call struct {
+	*mock.Call
+}
+
+// LoginMFA is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input v0.LoginMFAInput
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AuthServiceMock_Expecter) LoginMFA(ctx interface{}, input interface{}, clientInfo interface{}) *AuthServiceMock_LoginMFA_Call {
+	return &AuthServiceMock_LoginMFA_Call{Call: _e.mock.On("LoginMFA", ctx, input, clientInfo)}
+}
+
+func (_c *AuthServiceMock_LoginMFA_Call) Run(run func(ctx context.Context, input v0.LoginMFAInput, clientInfo infrastructure.ClientInfo)) *AuthServiceMock_LoginMFA_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(v0.LoginMFAInput), args[2].(infrastructure.ClientInfo))
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_LoginMFA_Call) Return(_a0 v0.JwtTokensOutput, _a1 error) *AuthServiceMock_LoginMFA_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthServiceMock_LoginMFA_Call) RunAndReturn(run func(context.Context, v0.LoginMFAInput, infrastructure.ClientInfo) (v0.JwtTokensOutput, error)) *AuthServiceMock_LoginMFA_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginPhone provides a mock function with given fields: ctx, input, localizer
+func (_m *AuthServiceMock) LoginPhone(ctx context.Context, input v0.PhoneLoginInput, localizer locale.Localizer) error {
+	ret := _m.Called(ctx, input, localizer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginPhone")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, v0.PhoneLoginInput, locale.Localizer) error); ok {
+		r0 = rf(ctx, input, localizer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthServiceMock_LoginPhone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginPhone'
+type AuthServiceMock_LoginPhone_Call struct {
+	*mock.Call
+}
+
+// LoginPhone is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input v0.PhoneLoginInput
+//   - localizer locale.Localizer
+func (_e *AuthServiceMock_Expecter) LoginPhone(ctx interface{}, input interface{}, localizer interface{}) *AuthServiceMock_LoginPhone_Call {
+	return &AuthServiceMock_LoginPhone_Call{Call: _e.mock.On("LoginPhone", ctx, input, localizer)}
 }
 
-func (_c *AuthServiceMock_Login_Call) Run(run func(ctx context.Context, input model.LoginInput)) *AuthServiceMock_Login_Call {
+func (_c *AuthServiceMock_LoginPhone_Call) Run(run func(ctx context.Context, input v0.PhoneLoginInput, localizer locale.Localizer)) *AuthServiceMock_LoginPhone_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.LoginInput))
+		run(args[0].(context.Context), args[1].(v0.PhoneLoginInput), args[2].(locale.Localizer))
 	})
 	return _c
 }
 
-func (_c *AuthServiceMock_Login_Call) Return(_a0 model.JwtTokensOutput, _a1 error) *AuthServiceMock_Login_Call {
+func (_c *AuthServiceMock_LoginPhone_Call) Return(_a0 error) *AuthServiceMock_LoginPhone_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AuthServiceMock_LoginPhone_Call) RunAndReturn(run func(context.Context, v0.PhoneLoginInput, locale.Localizer) error) *AuthServiceMock_LoginPhone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LoginPhoneConfirm provides a mock function with given fields: ctx, input, clientInfo
+func (_m *AuthServiceMock) LoginPhoneConfirm(ctx context.Context, input v0.PhoneLoginConfirmInput, clientInfo infrastructure.ClientInfo) (v0.LoginOutput, error) {
+	ret := _m.Called(ctx, input, clientInfo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LoginPhoneConfirm")
+	}
+
+	var r0 v0.LoginOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, v0.PhoneLoginConfirmInput, infrastructure.ClientInfo) (v0.LoginOutput, error)); ok {
+		return rf(ctx, input, clientInfo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, v0.PhoneLoginConfirmInput, infrastructure.ClientInfo) v0.LoginOutput); ok {
+		r0 = rf(ctx, input, clientInfo)
+	} else {
+		r0 = ret.Get(0).(v0.LoginOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, v0.PhoneLoginConfirmInput, infrastructure.ClientInfo) error); ok {
+		r1 = rf(ctx, input, clientInfo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthServiceMock_LoginPhoneConfirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoginPhoneConfirm'
+type AuthServiceMock_LoginPhoneConfirm_Call struct {
+	*mock.Call
+}
+
+// LoginPhoneConfirm is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input v0.PhoneLoginConfirmInput
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AuthServiceMock_Expecter) LoginPhoneConfirm(ctx interface{}, input interface{}, clientInfo interface{}) *AuthServiceMock_LoginPhoneConfirm_Call {
+	return &AuthServiceMock_LoginPhoneConfirm_Call{Call: _e.mock.On("LoginPhoneConfirm", ctx, input, clientInfo)}
+}
+
+func (_c *AuthServiceMock_LoginPhoneConfirm_Call) Run(run func(ctx context.Context, input v0.PhoneLoginConfirmInput, clientInfo infrastructure.ClientInfo)) *AuthServiceMock_LoginPhoneConfirm_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(v0.PhoneLoginConfirmInput), args[2].(infrastructure.ClientInfo))
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_LoginPhoneConfirm_Call) Return(_a0 v0.LoginOutput, _a1 error) *AuthServiceMock_LoginPhoneConfirm_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *AuthServiceMock_Login_Call) RunAndReturn(run func(context.Context, model.LoginInput) (model.JwtTokensOutput, error)) *AuthServiceMock_Login_Call {
+func (_c *AuthServiceMock_LoginPhoneConfirm_Call) RunAndReturn(run func(context.Context, v0.PhoneLoginConfirmInput, infrastructure.ClientInfo) (v0.LoginOutput, error)) *AuthServiceMock_LoginPhoneConfirm_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Logout provides a mock function with given fields: ctx, jti
-func (_m *AuthServiceMock) Logout(ctx context.Context, jti string) error {
-	ret := _m.Called(ctx, jti)
+// Logout provides a mock function with given fields: ctx, userID, sessionID, jti
+func (_m *AuthServiceMock) Logout(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, jti string) error {
+	ret := _m.Called(ctx, userID, sessionID, jti)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Logout")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, jti)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r0 = rf(ctx, userID, sessionID, jti)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -224,14 +510,16 @@ type AuthServiceMock_Logout_Call struct {
 
 // Logout is a helper method to define mock.On call
 //   - ctx context.Context
+//   - userID uuid.UUID
+//   - sessionID uuid.UUID
 //   - jti string
-func (_e *AuthServiceMock_Expecter) Logout(ctx interface{}, jti interface{}) *AuthServiceMock_Logout_Call {
-	return &AuthServiceMock_Logout_Call{Call: _e.mock.On("Logout", ctx, jti)}
+func (_e *AuthServiceMock_Expecter) Logout(ctx interface{}, userID interface{}, sessionID interface{}, jti interface{}) *AuthServiceMock_Logout_Call {
+	return &AuthServiceMock_Logout_Call{Call: _e.mock.On("Logout", ctx, userID, sessionID, jti)}
 }
 
-func (_c *AuthServiceMock_Logout_Call) Run(run func(ctx context.Context, jti string)) *AuthServiceMock_Logout_Call {
+func (_c *AuthServiceMock_Logout_Call) Run(run func(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, jti string)) *AuthServiceMock_Logout_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
 	})
 	return _c
 }
@@ -241,13 +529,13 @@ func (_c *AuthServiceMock_Logout_Call) Return(_a0 error) *AuthServiceMock_Logout
 	return _c
 }
 
-func (_c *AuthServiceMock_Logout_Call) RunAndReturn(run func(context.Context, string) error) *AuthServiceMock_Logout_Call {
+func (_c *AuthServiceMock_Logout_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) error) *AuthServiceMock_Logout_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // RecoveryPassword provides a mock function with given fields: ctx, input, localizer
-func (_m *AuthServiceMock) RecoveryPassword(ctx context.Context, input model.RecoveryPasswordInput, localizer locale.Localizer) error {
+func (_m *AuthServiceMock) RecoveryPassword(ctx context.Context, input v0.RecoveryPasswordInput, localizer locale.Localizer) error {
 	ret := _m.Called(ctx, input, localizer)
 
 	if len(ret) == 0 {
@@ -255,7 +543,7 @@ func (_m *AuthServiceMock) RecoveryPassword(ctx context.Context, input model.Rec
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.RecoveryPasswordInput, locale.Localizer) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, v0.RecoveryPasswordInput, locale.Localizer) error); ok {
 		r0 = rf(ctx, input, localizer)
 	} else {
 		r0 = ret.Error(0)
@@ -271,15 +559,15 @@ type AuthServiceMock_RecoveryPassword_Call struct {
 
 // RecoveryPassword is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input model.RecoveryPasswordInput
+//   - input v0.RecoveryPasswordInput
 //   - localizer locale.Localizer
 func (_e *AuthServiceMock_Expecter) RecoveryPassword(ctx interface{}, input interface{}, localizer interface{}) *AuthServiceMock_RecoveryPassword_Call {
 	return &AuthServiceMock_RecoveryPassword_Call{Call: _e.mock.On("RecoveryPassword", ctx, input, localizer)}
 }
 
-func (_c *AuthServiceMock_RecoveryPassword_Call) Run(run func(ctx context.Context, input model.RecoveryPasswordInput, localizer locale.Localizer)) *AuthServiceMock_RecoveryPassword_Call {
+func (_c *AuthServiceMock_RecoveryPassword_Call) Run(run func(ctx context.Context, input v0.RecoveryPasswordInput, localizer locale.Localizer)) *AuthServiceMock_RecoveryPassword_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.RecoveryPasswordInput), args[2].(locale.Localizer))
+		run(args[0].(context.Context), args[1].(v0.RecoveryPasswordInput), args[2].(locale.Localizer))
 	})
 	return _c
 }
@@ -289,32 +577,32 @@ func (_c *AuthServiceMock_RecoveryPassword_Call) Return(_a0 error) *AuthServiceM
 	return _c
 }
 
-func (_c *AuthServiceMock_RecoveryPassword_Call) RunAndReturn(run func(context.Context, model.RecoveryPasswordInput, locale.Localizer) error) *AuthServiceMock_RecoveryPassword_Call {
+func (_c *AuthServiceMock_RecoveryPassword_Call) RunAndReturn(run func(context.Context, v0.RecoveryPasswordInput, locale.Localizer) error) *AuthServiceMock_RecoveryPassword_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RefreshTokens provides a mock function with given fields: ctx, input
-func (_m *AuthServiceMock) RefreshTokens(ctx context.Context, input model.RefreshTokensInput) (model.JwtTokensOutput, error) {
-	ret := _m.Called(ctx, input)
+// RefreshTokens provides a mock function with given fields: ctx, input, clientInfo
+func (_m *AuthServiceMock) RefreshTokens(ctx context.Context, input v0.RefreshTokensInput, clientInfo infrastructure.ClientInfo) (v0.JwtTokensOutput, error) {
+	ret := _m.Called(ctx, input, clientInfo)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RefreshTokens")
 	}
 
-	var r0 model.JwtTokensOutput
+	var r0 v0.JwtTokensOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.RefreshTokensInput) (model.JwtTokensOutput, error)); ok {
-		return rf(ctx, input)
+	if rf, ok := ret.Get(0).(func(context.Context, v0.RefreshTokensInput, infrastructure.ClientInfo) (v0.JwtTokensOutput, error)); ok {
+		return rf(ctx, input, clientInfo)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.RefreshTokensInput) model.JwtTokensOutput); ok {
-		r0 = rf(ctx, input)
+	if rf, ok := ret.Get(0).(func(context.Context, v0.RefreshTokensInput, infrastructure.ClientInfo) v0.JwtTokensOutput); ok {
+		r0 = rf(ctx, input, clientInfo)
 	} else {
-		r0 = ret.Get(0).(model.JwtTokensOutput)
+		r0 = ret.Get(0).(v0.JwtTokensOutput)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, model.RefreshTokensInput) error); ok {
-		r1 = rf(ctx, input)
+	if rf, ok := ret.Get(1).(func(context.Context, v0.RefreshTokensInput, infrastructure.ClientInfo) error); ok {
+		r1 = rf(ctx, input, clientInfo)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -329,30 +617,31 @@ type AuthServiceMock_RefreshTokens_Call struct {
 
 // RefreshTokens is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input model.RefreshTokensInput
-func (_e *AuthServiceMock_Expecter) RefreshTokens(ctx interface{}, input interface{}) *AuthServiceMock_RefreshTokens_Call {
-	return &AuthServiceMock_RefreshTokens_Call{Call: _e.mock.On("RefreshTokens", ctx, input)}
+//   - input v0.RefreshTokensInput
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AuthServiceMock_Expecter) RefreshTokens(ctx interface{}, input interface{}, clientInfo interface{}) *AuthServiceMock_RefreshTokens_Call {
+	return &AuthServiceMock_RefreshTokens_Call{Call: _e.mock.On("RefreshTokens", ctx, input, clientInfo)}
 }
 
-func (_c *AuthServiceMock_RefreshTokens_Call) Run(run func(ctx context.Context, input model.RefreshTokensInput)) *AuthServiceMock_RefreshTokens_Call {
+func (_c *AuthServiceMock_RefreshTokens_Call) Run(run func(ctx context.Context, input v0.RefreshTokensInput, clientInfo infrastructure.ClientInfo)) *AuthServiceMock_RefreshTokens_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.RefreshTokensInput))
+		run(args[0].(context.Context), args[1].(v0.RefreshTokensInput), args[2].(infrastructure.ClientInfo))
 	})
 	return _c
 }
 
-func (_c *AuthServiceMock_RefreshTokens_Call) Return(_a0 model.JwtTokensOutput, _a1 error) *AuthServiceMock_RefreshTokens_Call {
+func (_c *AuthServiceMock_RefreshTokens_Call) Return(_a0 v0.JwtTokensOutput, _a1 error) *AuthServiceMock_RefreshTokens_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *AuthServiceMock_RefreshTokens_Call) RunAndReturn(run func(context.Context, model.RefreshTokensInput) (model.JwtTokensOutput, error)) *AuthServiceMock_RefreshTokens_Call {
+func (_c *AuthServiceMock_RefreshTokens_Call) RunAndReturn(run func(context.Context, v0.RefreshTokensInput, infrastructure.ClientInfo) (v0.JwtTokensOutput, error)) *AuthServiceMock_RefreshTokens_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // Register provides a mock function with given fields: ctx, input, localizer
-func (_m *AuthServiceMock) Register(ctx context.Context, input model.RegisterInput, localizer locale.Localizer) error {
+func (_m *AuthServiceMock) Register(ctx context.Context, input v0.RegisterInput, localizer locale.Localizer) error {
 	ret := _m.Called(ctx, input, localizer)
 
 	if len(ret) == 0 {
@@ -360,7 +649,7 @@ func (_m *AuthServiceMock) Register(ctx context.Context, input model.RegisterInp
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.RegisterInput, locale.Localizer) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, v0.RegisterInput, locale.Localizer) error); ok {
 		r0 = rf(ctx, input, localizer)
 	} else {
 		r0 = ret.Error(0)
@@ -376,15 +665,15 @@ type AuthServiceMock_Register_Call struct {
 
 // Register is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input model.RegisterInput
+//   - input v0.RegisterInput
 //   - localizer locale.Localizer
 func (_e *AuthServiceMock_Expecter) Register(ctx interface{}, input interface{}, localizer interface{}) *AuthServiceMock_Register_Call {
 	return &AuthServiceMock_Register_Call{Call: _e.mock.On("Register", ctx, input, localizer)}
 }
 
-func (_c *AuthServiceMock_Register_Call) Run(run func(ctx context.Context, input model.RegisterInput, localizer locale.Localizer)) *AuthServiceMock_Register_Call {
+func (_c *AuthServiceMock_Register_Call) Run(run func(ctx context.Context, input v0.RegisterInput, localizer locale.Localizer)) *AuthServiceMock_Register_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.RegisterInput), args[2].(locale.Localizer))
+		run(args[0].(context.Context), args[1].(v0.RegisterInput), args[2].(locale.Localizer))
 	})
 	return _c
 }
@@ -394,22 +683,22 @@ func (_c *AuthServiceMock_Register_Call) Return(_a0 error) *AuthServiceMock_Regi
 	return _c
 }
 
-func (_c *AuthServiceMock_Register_Call) RunAndReturn(run func(context.Context, model.RegisterInput, locale.Localizer) error) *AuthServiceMock_Register_Call {
+func (_c *AuthServiceMock_Register_Call) RunAndReturn(run func(context.Context, v0.RegisterInput, locale.Localizer) error) *AuthServiceMock_Register_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegisterConfirm provides a mock function with given fields: ctx, input
-func (_m *AuthServiceMock) RegisterConfirm(ctx context.Context, input model.RegisterConfirmInput) error {
-	ret := _m.Called(ctx, input)
+// RegisterConfirm provides a mock function with given fields: ctx, input, clientInfo
+func (_m *AuthServiceMock) RegisterConfirm(ctx context.Context, input v0.RegisterConfirmInput, clientInfo infrastructure.ClientInfo) error {
+	ret := _m.Called(ctx, input, clientInfo)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RegisterConfirm")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.RegisterConfirmInput) error); ok {
-		r0 = rf(ctx, input)
+	if rf, ok := ret.Get(0).(func(context.Context, v0.RegisterConfirmInput, infrastructure.ClientInfo) error); ok {
+		r0 = rf(ctx, input, clientInfo)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -424,14 +713,15 @@ type AuthServiceMock_RegisterConfirm_Call struct {
 
 // RegisterConfirm is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input model.RegisterConfirmInput
-func (_e *AuthServiceMock_Expecter) RegisterConfirm(ctx interface{}, input interface{}) *AuthServiceMock_RegisterConfirm_Call {
-	return &AuthServiceMock_RegisterConfirm_Call{Call: _e.mock.On("RegisterConfirm", ctx, input)}
+//   - input v0.RegisterConfirmInput
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AuthServiceMock_Expecter) RegisterConfirm(ctx interface{}, input interface{}, clientInfo interface{}) *AuthServiceMock_RegisterConfirm_Call {
+	return &AuthServiceMock_RegisterConfirm_Call{Call: _e.mock.On("RegisterConfirm", ctx, input, clientInfo)}
 }
 
-func (_c *AuthServiceMock_RegisterConfirm_Call) Run(run func(ctx context.Context, input model.RegisterConfirmInput)) *AuthServiceMock_RegisterConfirm_Call {
+func (_c *AuthServiceMock_RegisterConfirm_Call) Run(run func(ctx context.Context, input v0.RegisterConfirmInput, clientInfo infrastructure.ClientInfo)) *AuthServiceMock_RegisterConfirm_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.RegisterConfirmInput))
+		run(args[0].(context.Context), args[1].(v0.RegisterConfirmInput), args[2].(infrastructure.ClientInfo))
 	})
 	return _c
 }
@@ -441,32 +731,32 @@ func (_c *AuthServiceMock_RegisterConfirm_Call) Return(_a0 error) *AuthServiceMo
 	return _c
 }
 
-func (_c *AuthServiceMock_RegisterConfirm_Call) RunAndReturn(run func(context.Context, model.RegisterConfirmInput) error) *AuthServiceMock_RegisterConfirm_Call {
+func (_c *AuthServiceMock_RegisterConfirm_Call) RunAndReturn(run func(context.Context, v0.RegisterConfirmInput, infrastructure.ClientInfo) error) *AuthServiceMock_RegisterConfirm_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RegisterOrLogin provides a mock function with given fields: ctx, userInfo
-func (_m *AuthServiceMock) RegisterOrLogin(ctx context.Context, userInfo oauth.UserInfo) (model.JwtTokensOutput, error) {
-	ret := _m.Called(ctx, userInfo)
+// RegisterOrLogin provides a mock function with given fields: ctx, provider, userInfo, clientInfo
+func (_m *AuthServiceMock) RegisterOrLogin(ctx context.Context, provider string, userInfo oauth.UserInfo, clientInfo infrastructure.ClientInfo) (v0.LoginOutput, error) {
+	ret := _m.Called(ctx, provider, userInfo, clientInfo)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RegisterOrLogin")
 	}
 
-	var r0 model.JwtTokensOutput
+	var r0 v0.LoginOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, oauth.UserInfo) (model.JwtTokensOutput, error)); ok {
-		return rf(ctx, userInfo)
+	if rf, ok := ret.Get(0).(func(context.Context, string, oauth.UserInfo, infrastructure.ClientInfo) (v0.LoginOutput, error)); ok {
+		return rf(ctx, provider, userInfo, clientInfo)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, oauth.UserInfo) model.JwtTokensOutput); ok {
-		r0 = rf(ctx, userInfo)
+	if rf, ok := ret.Get(0).(func(context.Context, string, oauth.UserInfo, infrastructure.ClientInfo) v0.LoginOutput); ok {
+		r0 = rf(ctx, provider, userInfo, clientInfo)
 	} else {
-		r0 = ret.Get(0).(model.JwtTokensOutput)
+		r0 = ret.Get(0).(v0.LoginOutput)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, oauth.UserInfo) error); ok {
-		r1 = rf(ctx, userInfo)
+	if rf, ok := ret.Get(1).(func(context.Context, string, oauth.UserInfo, infrastructure.ClientInfo) error); ok {
+		r1 = rf(ctx, provider, userInfo, clientInfo)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -481,39 +771,41 @@ type AuthServiceMock_RegisterOrLogin_Call struct {
 
 // RegisterOrLogin is a helper method to define mock.On call
 //   - ctx context.Context
+//   - provider string
 //   - userInfo oauth.UserInfo
-func (_e *AuthServiceMock_Expecter) RegisterOrLogin(ctx interface{}, userInfo interface{}) *AuthServiceMock_RegisterOrLogin_Call {
-	return &AuthServiceMock_RegisterOrLogin_Call{Call: _e.mock.On("RegisterOrLogin", ctx, userInfo)}
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AuthServiceMock_Expecter) RegisterOrLogin(ctx interface{}, provider interface{}, userInfo interface{}, clientInfo interface{}) *AuthServiceMock_RegisterOrLogin_Call {
+	return &AuthServiceMock_RegisterOrLogin_Call{Call: _e.mock.On("RegisterOrLogin", ctx, provider, userInfo, clientInfo)}
 }
 
-func (_c *AuthServiceMock_RegisterOrLogin_Call) Run(run func(ctx context.Context, userInfo oauth.UserInfo)) *AuthServiceMock_RegisterOrLogin_Call {
+func (_c *AuthServiceMock_RegisterOrLogin_Call) Run(run func(ctx context.Context, provider string, userInfo oauth.UserInfo, clientInfo infrastructure.ClientInfo)) *AuthServiceMock_RegisterOrLogin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(oauth.UserInfo))
+		run(args[0].(context.Context), args[1].(string), args[2].(oauth.UserInfo), args[3].(infrastructure.ClientInfo))
 	})
 	return _c
 }
 
-func (_c *AuthServiceMock_RegisterOrLogin_Call) Return(_a0 model.JwtTokensOutput, _a1 error) *AuthServiceMock_RegisterOrLogin_Call {
+func (_c *AuthServiceMock_RegisterOrLogin_Call) Return(_a0 v0.LoginOutput, _a1 error) *AuthServiceMock_RegisterOrLogin_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *AuthServiceMock_RegisterOrLogin_Call) RunAndReturn(run func(context.Context, oauth.UserInfo) (model.JwtTokensOutput, error)) *AuthServiceMock_RegisterOrLogin_Call {
+func (_c *AuthServiceMock_RegisterOrLogin_Call) RunAndReturn(run func(context.Context, string, oauth.UserInfo, infrastructure.ClientInfo) (v0.LoginOutput, error)) *AuthServiceMock_RegisterOrLogin_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ResetPassword provides a mock function with given fields: ctx, input
-func (_m *AuthServiceMock) ResetPassword(ctx context.Context, input model.ResetPasswordInput) error {
-	ret := _m.Called(ctx, input)
+// ResetPassword provides a mock function with given fields: ctx, input, clientInfo
+func (_m *AuthServiceMock) ResetPassword(ctx context.Context, input v0.ResetPasswordInput, clientInfo infrastructure.ClientInfo) error {
+	ret := _m.Called(ctx, input, clientInfo)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ResetPassword")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.ResetPasswordInput) error); ok {
-		r0 = rf(ctx, input)
+	if rf, ok := ret.Get(0).(func(context.Context, v0.ResetPasswordInput, infrastructure.ClientInfo) error); ok {
+		r0 = rf(ctx, input, clientInfo)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -528,14 +820,15 @@ type AuthServiceMock_ResetPassword_Call struct {
 
 // ResetPassword is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input model.ResetPasswordInput
-func (_e *AuthServiceMock_Expecter) ResetPassword(ctx interface{}, input interface{}) *AuthServiceMock_ResetPassword_Call {
-	return &AuthServiceMock_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, input)}
+//   - input v0.ResetPasswordInput
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AuthServiceMock_Expecter) ResetPassword(ctx interface{}, input interface{}, clientInfo interface{}) *AuthServiceMock_ResetPassword_Call {
+	return &AuthServiceMock_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, input, clientInfo)}
 }
 
-func (_c *AuthServiceMock_ResetPassword_Call) Run(run func(ctx context.Context, input model.ResetPasswordInput)) *AuthServiceMock_ResetPassword_Call {
+func (_c *AuthServiceMock_ResetPassword_Call) Run(run func(ctx context.Context, input v0.ResetPasswordInput, clientInfo infrastructure.ClientInfo)) *AuthServiceMock_ResetPassword_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.ResetPasswordInput))
+		run(args[0].(context.Context), args[1].(v0.ResetPasswordInput), args[2].(infrastructure.ClientInfo))
 	})
 	return _c
 }
@@ -545,22 +838,139 @@ func (_c *AuthServiceMock_ResetPassword_Call) Return(_a0 error) *AuthServiceMock
 	return _c
 }
 
-func (_c *AuthServiceMock_ResetPassword_Call) RunAndReturn(run func(context.Context, model.ResetPasswordInput) error) *AuthServiceMock_ResetPassword_Call {
+func (_c *AuthServiceMock_ResetPassword_Call) RunAndReturn(run func(context.Context, v0.ResetPasswordInput, infrastructure.ClientInfo) error) *AuthServiceMock_ResetPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMagicLink provides a mock function with given fields: ctx, input, localizer
+func (_m *AuthServiceMock) SendMagicLink(ctx context.Context, input v0.MagicLinkInput, localizer locale.Localizer) (v0.MagicLinkOutput, error) {
+	ret := _m.Called(ctx, input, localizer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMagicLink")
+	}
+
+	var r0 v0.MagicLinkOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, v0.MagicLinkInput, locale.Localizer) (v0.MagicLinkOutput, error)); ok {
+		return rf(ctx, input, localizer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, v0.MagicLinkInput, locale.Localizer) v0.MagicLinkOutput); ok {
+		r0 = rf(ctx, input, localizer)
+	} else {
+		r0 = ret.Get(0).(v0.MagicLinkOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, v0.MagicLinkInput, locale.Localizer) error); ok {
+		r1 = rf(ctx, input, localizer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthServiceMock_SendMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMagicLink'
+type AuthServiceMock_SendMagicLink_Call struct {
+	*mock.Call
+}
+
+// SendMagicLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input v0.MagicLinkInput
+//   - localizer locale.Localizer
+func (_e *AuthServiceMock_Expecter) SendMagicLink(ctx interface{}, input interface{}, localizer interface{}) *AuthServiceMock_SendMagicLink_Call {
+	return &AuthServiceMock_SendMagicLink_Call{Call: _e.mock.On("SendMagicLink", ctx, input, localizer)}
+}
+
+func (_c *AuthServiceMock_SendMagicLink_Call) Run(run func(ctx context.Context, input v0.MagicLinkInput, localizer locale.Localizer)) *AuthServiceMock_SendMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(v0.MagicLinkInput), args[2].(locale.Localizer))
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_SendMagicLink_Call) Return(_a0 v0.MagicLinkOutput, _a1 error) *AuthServiceMock_SendMagicLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthServiceMock_SendMagicLink_Call) RunAndReturn(run func(context.Context, v0.MagicLinkInput, locale.Localizer) (v0.MagicLinkOutput, error)) *AuthServiceMock_SendMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyMagicLink provides a mock function with given fields: ctx, input, deviceSecret, clientInfo
+func (_m *AuthServiceMock) VerifyMagicLink(ctx context.Context, input v0.VerifyMagicLinkInput, deviceSecret string, clientInfo infrastructure.ClientInfo) (v0.LoginOutput, error) {
+	ret := _m.Called(ctx, input, deviceSecret, clientInfo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyMagicLink")
+	}
+
+	var r0 v0.LoginOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, v0.VerifyMagicLinkInput, string, infrastructure.ClientInfo) (v0.LoginOutput, error)); ok {
+		return rf(ctx, input, deviceSecret, clientInfo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, v0.VerifyMagicLinkInput, string, infrastructure.ClientInfo) v0.LoginOutput); ok {
+		r0 = rf(ctx, input, deviceSecret, clientInfo)
+	} else {
+		r0 = ret.Get(0).(v0.LoginOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, v0.VerifyMagicLinkInput, string, infrastructure.ClientInfo) error); ok {
+		r1 = rf(ctx, input, deviceSecret, clientInfo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthServiceMock_VerifyMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyMagicLink'
+type AuthServiceMock_VerifyMagicLink_Call struct {
+	*mock.Call
+}
+
+// VerifyMagicLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input v0.VerifyMagicLinkInput
+//   - deviceSecret string
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AuthServiceMock_Expecter) VerifyMagicLink(ctx interface{}, input interface{}, deviceSecret interface{}, clientInfo interface{}) *AuthServiceMock_VerifyMagicLink_Call {
+	return &AuthServiceMock_VerifyMagicLink_Call{Call: _e.mock.On("VerifyMagicLink", ctx, input, deviceSecret, clientInfo)}
+}
+
+func (_c *AuthServiceMock_VerifyMagicLink_Call) Run(run func(ctx context.Context, input v0.VerifyMagicLinkInput, deviceSecret string, clientInfo infrastructure.ClientInfo)) *AuthServiceMock_VerifyMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(v0.VerifyMagicLinkInput), args[2].(string), args[3].(infrastructure.ClientInfo))
+	})
+	return _c
+}
+
+func (_c *AuthServiceMock_VerifyMagicLink_Call) Return(_a0 v0.LoginOutput, _a1 error) *AuthServiceMock_VerifyMagicLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthServiceMock_VerifyMagicLink_Call) RunAndReturn(run func(context.Context, v0.VerifyMagicLinkInput, string, infrastructure.ClientInfo) (v0.LoginOutput, error)) *AuthServiceMock_VerifyMagicLink_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// VerifyRecoveryCode provides a mock function with given fields: ctx, input
-func (_m *AuthServiceMock) VerifyRecoveryCode(ctx context.Context, input model.VerifyRecoveryCodeInput) error {
-	ret := _m.Called(ctx, input)
+// VerifyRecoveryCode provides a mock function with given fields: ctx, input, clientInfo
+func (_m *AuthServiceMock) VerifyRecoveryCode(ctx context.Context, input v0.VerifyRecoveryCodeInput, clientInfo infrastructure.ClientInfo) error {
+	ret := _m.Called(ctx, input, clientInfo)
 
 	if len(ret) == 0 {
 		panic("no return value specified for VerifyRecoveryCode")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.VerifyRecoveryCodeInput) error); ok {
-		r0 = rf(ctx, input)
+	if rf, ok := ret.Get(0).(func(context.Context, v0.VerifyRecoveryCodeInput, infrastructure.ClientInfo) error); ok {
+		r0 = rf(ctx, input, clientInfo)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -575,14 +985,15 @@ type AuthServiceMock_VerifyRecoveryCode_Call struct {
 
 // VerifyRecoveryCode is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input model.VerifyRecoveryCodeInput
-func (_e *AuthServiceMock_Expecter) VerifyRecoveryCode(ctx interface{}, input interface{}) *AuthServiceMock_VerifyRecoveryCode_Call {
-	return &AuthServiceMock_VerifyRecoveryCode_Call{Call: _e.mock.On("VerifyRecoveryCode", ctx, input)}
+//   - input v0.VerifyRecoveryCodeInput
+//   - clientInfo infrastructure.ClientInfo
+func (_e *AuthServiceMock_Expecter) VerifyRecoveryCode(ctx interface{}, input interface{}, clientInfo interface{}) *AuthServiceMock_VerifyRecoveryCode_Call {
+	return &AuthServiceMock_VerifyRecoveryCode_Call{Call: _e.mock.On("VerifyRecoveryCode", ctx, input, clientInfo)}
 }
 
-func (_c *AuthServiceMock_VerifyRecoveryCode_Call) Run(run func(ctx context.Context, input model.VerifyRecoveryCodeInput)) *AuthServiceMock_VerifyRecoveryCode_Call {
+func (_c *AuthServiceMock_VerifyRecoveryCode_Call) Run(run func(ctx context.Context, input v0.VerifyRecoveryCodeInput, clientInfo infrastructure.ClientInfo)) *AuthServiceMock_VerifyRecoveryCode_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(model.VerifyRecoveryCodeInput))
+		run(args[0].(context.Context), args[1].(v0.VerifyRecoveryCodeInput), args[2].(infrastructure.ClientInfo))
 	})
 	return _c
 }
@@ -592,7 +1003,7 @@ func (_c *AuthServiceMock_VerifyRecoveryCode_Call) Return(_a0 error) *AuthServic
 	return _c
 }
 
-func (_c *AuthServiceMock_VerifyRecoveryCode_Call) RunAndReturn(run func(context.Context, model.VerifyRecoveryCodeInput) error) *AuthServiceMock_VerifyRecoveryCode_Call {
+func (_c *AuthServiceMock_VerifyRecoveryCode_Call) RunAndReturn(run func(context.Context, v0.VerifyRecoveryCodeInput, infrastructure.ClientInfo) error) *AuthServiceMock_VerifyRecoveryCode_Call {
 	_c.Call.Return(run)
 	return _c
 }
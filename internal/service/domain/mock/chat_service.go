@@ -0,0 +1,502 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	s3 "github.com/mandarine-io/backend/internal/infrastructure/s3"
+
+	uuid "github.com/google/uuid"
+
+	v0 "github.com/mandarine-io/backend/pkg/model/v0"
+)
+
+// ChatServiceMock is an autogenerated mock type for the ChatService type
+type ChatServiceMock struct {
+	mock.Mock
+}
+
+type ChatServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ChatServiceMock) EXPECT() *ChatServiceMock_Expecter {
+	return &ChatServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// CreateConversation provides a mock function with given fields: ctx, userID, input
+func (_m *ChatServiceMock) CreateConversation(ctx context.Context, userID uuid.UUID, input v0.CreateConversationInput) (v0.ConversationOutput, error) {
+	ret := _m.Called(ctx, userID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateConversation")
+	}
+
+	var r0 v0.ConversationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.CreateConversationInput) (v0.ConversationOutput, error)); ok {
+		return rf(ctx, userID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, v0.CreateConversationInput) v0.ConversationOutput); ok {
+		r0 = rf(ctx, userID, input)
+	} else {
+		r0 = ret.Get(0).(v0.ConversationOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, v0.CreateConversationInput) error); ok {
+		r1 = rf(ctx, userID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChatServiceMock_CreateConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateConversation'
+type ChatServiceMock_CreateConversation_Call struct {
+	*mock.Call
+}
+
+// CreateConversation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - input v0.CreateConversationInput
+func (_e *ChatServiceMock_Expecter) CreateConversation(ctx interface{}, userID interface{}, input interface{}) *ChatServiceMock_CreateConversation_Call {
+	return &ChatServiceMock_CreateConversation_Call{Call: _e.mock.On("CreateConversation", ctx, userID, input)}
+}
+
+func (_c *ChatServiceMock_CreateConversation_Call) Run(run func(ctx context.Context, userID uuid.UUID, input v0.CreateConversationInput)) *ChatServiceMock_CreateConversation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(v0.CreateConversationInput))
+	})
+	return _c
+}
+
+func (_c *ChatServiceMock_CreateConversation_Call) Return(_a0 v0.ConversationOutput, _a1 error) *ChatServiceMock_CreateConversation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChatServiceMock_CreateConversation_Call) RunAndReturn(run func(context.Context, uuid.UUID, v0.CreateConversationInput) (v0.ConversationOutput, error)) *ChatServiceMock_CreateConversation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DownloadAttachment provides a mock function with given fields: ctx, userID, conversationID, objectID
+func (_m *ChatServiceMock) DownloadAttachment(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID, objectID string) (*s3.FileData, error) {
+	ret := _m.Called(ctx, userID, conversationID, objectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadAttachment")
+	}
+
+	var r0 *s3.FileData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) (*s3.FileData, error)); ok {
+		return rf(ctx, userID, conversationID, objectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) *s3.FileData); ok {
+		r0 = rf(ctx, userID, conversationID, objectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.FileData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
+		r1 = rf(ctx, userID, conversationID, objectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChatServiceMock_DownloadAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadAttachment'
+type ChatServiceMock_DownloadAttachment_Call struct {
+	*mock.Call
+}
+
+// DownloadAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - conversationID uuid.UUID
+//   - objectID string
+func (_e *ChatServiceMock_Expecter) DownloadAttachment(ctx interface{}, userID interface{}, conversationID interface{}, objectID interface{}) *ChatServiceMock_DownloadAttachment_Call {
+	return &ChatServiceMock_DownloadAttachment_Call{Call: _e.mock.On("DownloadAttachment", ctx, userID, conversationID, objectID)}
+}
+
+func (_c *ChatServiceMock_DownloadAttachment_Call) Run(run func(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID, objectID string)) *ChatServiceMock_DownloadAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ChatServiceMock_DownloadAttachment_Call) Return(_a0 *s3.FileData, _a1 error) *ChatServiceMock_DownloadAttachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChatServiceMock_DownloadAttachment_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, string) (*s3.FileData, error)) *ChatServiceMock_DownloadAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConversation provides a mock function with given fields: ctx, userID, conversationID
+func (_m *ChatServiceMock) GetConversation(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID) (v0.ConversationOutput, error) {
+	ret := _m.Called(ctx, userID, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConversation")
+	}
+
+	var r0 v0.ConversationOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (v0.ConversationOutput, error)); ok {
+		return rf(ctx, userID, conversationID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) v0.ConversationOutput); ok {
+		r0 = rf(ctx, userID, conversationID)
+	} else {
+		r0 = ret.Get(0).(v0.ConversationOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID, conversationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChatServiceMock_GetConversation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConversation'
+type ChatServiceMock_GetConversation_Call struct {
+	*mock.Call
+}
+
+// GetConversation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - conversationID uuid.UUID
+func (_e *ChatServiceMock_Expecter) GetConversation(ctx interface{}, userID interface{}, conversationID interface{}) *ChatServiceMock_GetConversation_Call {
+	return &ChatServiceMock_GetConversation_Call{Call: _e.mock.On("GetConversation", ctx, userID, conversationID)}
+}
+
+func (_c *ChatServiceMock_GetConversation_Call) Run(run func(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID)) *ChatServiceMock_GetConversation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ChatServiceMock_GetConversation_Call) Return(_a0 v0.ConversationOutput, _a1 error) *ChatServiceMock_GetConversation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChatServiceMock_GetConversation_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) (v0.ConversationOutput, error)) *ChatServiceMock_GetConversation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetConversations provides a mock function with given fields: ctx, userID
+func (_m *ChatServiceMock) GetConversations(ctx context.Context, userID uuid.UUID) (v0.ConversationsOutput, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetConversations")
+	}
+
+	var r0 v0.ConversationsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (v0.ConversationsOutput, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) v0.ConversationsOutput); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(v0.ConversationsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChatServiceMock_GetConversations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetConversations'
+type ChatServiceMock_GetConversations_Call struct {
+	*mock.Call
+}
+
+// GetConversations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *ChatServiceMock_Expecter) GetConversations(ctx interface{}, userID interface{}) *ChatServiceMock_GetConversations_Call {
+	return &ChatServiceMock_GetConversations_Call{Call: _e.mock.On("GetConversations", ctx, userID)}
+}
+
+func (_c *ChatServiceMock_GetConversations_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *ChatServiceMock_GetConversations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *ChatServiceMock_GetConversations_Call) Return(_a0 v0.ConversationsOutput, _a1 error) *ChatServiceMock_GetConversations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChatServiceMock_GetConversations_Call) RunAndReturn(run func(context.Context, uuid.UUID) (v0.ConversationsOutput, error)) *ChatServiceMock_GetConversations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMessages provides a mock function with given fields: ctx, userID, conversationID, input
+func (_m *ChatServiceMock) GetMessages(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID, input v0.FindChatMessagesInput) (v0.ChatMessagesOutput, error) {
+	ret := _m.Called(ctx, userID, conversationID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMessages")
+	}
+
+	var r0 v0.ChatMessagesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, v0.FindChatMessagesInput) (v0.ChatMessagesOutput, error)); ok {
+		return rf(ctx, userID, conversationID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, v0.FindChatMessagesInput) v0.ChatMessagesOutput); ok {
+		r0 = rf(ctx, userID, conversationID, input)
+	} else {
+		r0 = ret.Get(0).(v0.ChatMessagesOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, v0.FindChatMessagesInput) error); ok {
+		r1 = rf(ctx, userID, conversationID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChatServiceMock_GetMessages_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMessages'
+type ChatServiceMock_GetMessages_Call struct {
+	*mock.Call
+}
+
+// GetMessages is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - conversationID uuid.UUID
+//   - input v0.FindChatMessagesInput
+func (_e *ChatServiceMock_Expecter) GetMessages(ctx interface{}, userID interface{}, conversationID interface{}, input interface{}) *ChatServiceMock_GetMessages_Call {
+	return &ChatServiceMock_GetMessages_Call{Call: _e.mock.On("GetMessages", ctx, userID, conversationID, input)}
+}
+
+func (_c *ChatServiceMock_GetMessages_Call) Run(run func(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID, input v0.FindChatMessagesInput)) *ChatServiceMock_GetMessages_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(v0.FindChatMessagesInput))
+	})
+	return _c
+}
+
+func (_c *ChatServiceMock_GetMessages_Call) Return(_a0 v0.ChatMessagesOutput, _a1 error) *ChatServiceMock_GetMessages_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChatServiceMock_GetMessages_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, v0.FindChatMessagesInput) (v0.ChatMessagesOutput, error)) *ChatServiceMock_GetMessages_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkRead provides a mock function with given fields: ctx, userID, conversationID, input
+func (_m *ChatServiceMock) MarkRead(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID, input v0.MarkChatReadInput) error {
+	ret := _m.Called(ctx, userID, conversationID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, v0.MarkChatReadInput) error); ok {
+		r0 = rf(ctx, userID, conversationID, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ChatServiceMock_MarkRead_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkRead'
+type ChatServiceMock_MarkRead_Call struct {
+	*mock.Call
+}
+
+// MarkRead is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - conversationID uuid.UUID
+//   - input v0.MarkChatReadInput
+func (_e *ChatServiceMock_Expecter) MarkRead(ctx interface{}, userID interface{}, conversationID interface{}, input interface{}) *ChatServiceMock_MarkRead_Call {
+	return &ChatServiceMock_MarkRead_Call{Call: _e.mock.On("MarkRead", ctx, userID, conversationID, input)}
+}
+
+func (_c *ChatServiceMock_MarkRead_Call) Run(run func(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID, input v0.MarkChatReadInput)) *ChatServiceMock_MarkRead_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(v0.MarkChatReadInput))
+	})
+	return _c
+}
+
+func (_c *ChatServiceMock_MarkRead_Call) Return(_a0 error) *ChatServiceMock_MarkRead_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ChatServiceMock_MarkRead_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, v0.MarkChatReadInput) error) *ChatServiceMock_MarkRead_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendMessage provides a mock function with given fields: ctx, userID, conversationID, input
+func (_m *ChatServiceMock) SendMessage(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID, input v0.SendChatMessageInput) (v0.ChatMessageOutput, error) {
+	ret := _m.Called(ctx, userID, conversationID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendMessage")
+	}
+
+	var r0 v0.ChatMessageOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, v0.SendChatMessageInput) (v0.ChatMessageOutput, error)); ok {
+		return rf(ctx, userID, conversationID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, v0.SendChatMessageInput) v0.ChatMessageOutput); ok {
+		r0 = rf(ctx, userID, conversationID, input)
+	} else {
+		r0 = ret.Get(0).(v0.ChatMessageOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, v0.SendChatMessageInput) error); ok {
+		r1 = rf(ctx, userID, conversationID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChatServiceMock_SendMessage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendMessage'
+type ChatServiceMock_SendMessage_Call struct {
+	*mock.Call
+}
+
+// SendMessage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - conversationID uuid.UUID
+//   - input v0.SendChatMessageInput
+func (_e *ChatServiceMock_Expecter) SendMessage(ctx interface{}, userID interface{}, conversationID interface{}, input interface{}) *ChatServiceMock_SendMessage_Call {
+	return &ChatServiceMock_SendMessage_Call{Call: _e.mock.On("SendMessage", ctx, userID, conversationID, input)}
+}
+
+func (_c *ChatServiceMock_SendMessage_Call) Run(run func(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID, input v0.SendChatMessageInput)) *ChatServiceMock_SendMessage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(v0.SendChatMessageInput))
+	})
+	return _c
+}
+
+func (_c *ChatServiceMock_SendMessage_Call) Return(_a0 v0.ChatMessageOutput, _a1 error) *ChatServiceMock_SendMessage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChatServiceMock_SendMessage_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, v0.SendChatMessageInput) (v0.ChatMessageOutput, error)) *ChatServiceMock_SendMessage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadAttachments provides a mock function with given fields: ctx, userID, conversationID, input
+func (_m *ChatServiceMock) UploadAttachments(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID, input *v0.UploadResourcesInput) (v0.UploadResourcesOutput, error) {
+	ret := _m.Called(ctx, userID, conversationID, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadAttachments")
+	}
+
+	var r0 v0.UploadResourcesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *v0.UploadResourcesInput) (v0.UploadResourcesOutput, error)); ok {
+		return rf(ctx, userID, conversationID, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, *v0.UploadResourcesInput) v0.UploadResourcesOutput); ok {
+		r0 = rf(ctx, userID, conversationID, input)
+	} else {
+		r0 = ret.Get(0).(v0.UploadResourcesOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID, *v0.UploadResourcesInput) error); ok {
+		r1 = rf(ctx, userID, conversationID, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChatServiceMock_UploadAttachments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadAttachments'
+type ChatServiceMock_UploadAttachments_Call struct {
+	*mock.Call
+}
+
+// UploadAttachments is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - conversationID uuid.UUID
+//   - input *v0.UploadResourcesInput
+func (_e *ChatServiceMock_Expecter) UploadAttachments(ctx interface{}, userID interface{}, conversationID interface{}, input interface{}) *ChatServiceMock_UploadAttachments_Call {
+	return &ChatServiceMock_UploadAttachments_Call{Call: _e.mock.On("UploadAttachments", ctx, userID, conversationID, input)}
+}
+
+func (_c *ChatServiceMock_UploadAttachments_Call) Run(run func(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID, input *v0.UploadResourcesInput)) *ChatServiceMock_UploadAttachments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID), args[3].(*v0.UploadResourcesInput))
+	})
+	return _c
+}
+
+func (_c *ChatServiceMock_UploadAttachments_Call) Return(_a0 v0.UploadResourcesOutput, _a1 error) *ChatServiceMock_UploadAttachments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ChatServiceMock_UploadAttachments_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID, *v0.UploadResourcesInput) (v0.UploadResourcesOutput, error)) *ChatServiceMock_UploadAttachments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewChatServiceMock creates a new instance of ChatServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewChatServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ChatServiceMock {
+	mock := &ChatServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
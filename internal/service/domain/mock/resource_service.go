@@ -4,11 +4,12 @@ package mock
 
 import (
 	context "context"
-	model "github.com/mandarine-io/backend/pkg/model/v0"
 
 	mock "github.com/stretchr/testify/mock"
 
 	s3 "github.com/mandarine-io/backend/internal/infrastructure/s3"
+
+	v0 "github.com/mandarine-io/backend/pkg/model/v0"
 )
 
 // ResourceServiceMock is an autogenerated mock type for the ResourceService type
@@ -83,26 +84,86 @@ func (_c *ResourceServiceMock_DownloadResource_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// DownloadResourceFromFolder provides a mock function with given fields: ctx, folder, objectID
+func (_m *ResourceServiceMock) DownloadResourceFromFolder(ctx context.Context, folder string, objectID string) (*s3.FileData, error) {
+	ret := _m.Called(ctx, folder, objectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DownloadResourceFromFolder")
+	}
+
+	var r0 *s3.FileData
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*s3.FileData, error)); ok {
+		return rf(ctx, folder, objectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *s3.FileData); ok {
+		r0 = rf(ctx, folder, objectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*s3.FileData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, folder, objectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceServiceMock_DownloadResourceFromFolder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DownloadResourceFromFolder'
+type ResourceServiceMock_DownloadResourceFromFolder_Call struct {
+	*mock.Call
+}
+
+// DownloadResourceFromFolder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - folder string
+//   - objectID string
+func (_e *ResourceServiceMock_Expecter) DownloadResourceFromFolder(ctx interface{}, folder interface{}, objectID interface{}) *ResourceServiceMock_DownloadResourceFromFolder_Call {
+	return &ResourceServiceMock_DownloadResourceFromFolder_Call{Call: _e.mock.On("DownloadResourceFromFolder", ctx, folder, objectID)}
+}
+
+func (_c *ResourceServiceMock_DownloadResourceFromFolder_Call) Run(run func(ctx context.Context, folder string, objectID string)) *ResourceServiceMock_DownloadResourceFromFolder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ResourceServiceMock_DownloadResourceFromFolder_Call) Return(_a0 *s3.FileData, _a1 error) *ResourceServiceMock_DownloadResourceFromFolder_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceServiceMock_DownloadResourceFromFolder_Call) RunAndReturn(run func(context.Context, string, string) (*s3.FileData, error)) *ResourceServiceMock_DownloadResourceFromFolder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UploadResource provides a mock function with given fields: ctx, input
-func (_m *ResourceServiceMock) UploadResource(ctx context.Context, input *model.UploadResourceInput) (model.UploadResourceOutput, error) {
+func (_m *ResourceServiceMock) UploadResource(ctx context.Context, input *v0.UploadResourceInput) (v0.UploadResourceOutput, error) {
 	ret := _m.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UploadResource")
 	}
 
-	var r0 model.UploadResourceOutput
+	var r0 v0.UploadResourceOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.UploadResourceInput) (model.UploadResourceOutput, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v0.UploadResourceInput) (v0.UploadResourceOutput, error)); ok {
 		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.UploadResourceInput) model.UploadResourceOutput); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v0.UploadResourceInput) v0.UploadResourceOutput); ok {
 		r0 = rf(ctx, input)
 	} else {
-		r0 = ret.Get(0).(model.UploadResourceOutput)
+		r0 = ret.Get(0).(v0.UploadResourceOutput)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.UploadResourceInput) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v0.UploadResourceInput) error); ok {
 		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
@@ -118,48 +179,48 @@ type ResourceServiceMock_UploadResource_Call struct {
 
 // UploadResource is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input *model.UploadResourceInput
+//   - input *v0.UploadResourceInput
 func (_e *ResourceServiceMock_Expecter) UploadResource(ctx interface{}, input interface{}) *ResourceServiceMock_UploadResource_Call {
 	return &ResourceServiceMock_UploadResource_Call{Call: _e.mock.On("UploadResource", ctx, input)}
 }
 
-func (_c *ResourceServiceMock_UploadResource_Call) Run(run func(ctx context.Context, input *model.UploadResourceInput)) *ResourceServiceMock_UploadResource_Call {
+func (_c *ResourceServiceMock_UploadResource_Call) Run(run func(ctx context.Context, input *v0.UploadResourceInput)) *ResourceServiceMock_UploadResource_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.UploadResourceInput))
+		run(args[0].(context.Context), args[1].(*v0.UploadResourceInput))
 	})
 	return _c
 }
 
-func (_c *ResourceServiceMock_UploadResource_Call) Return(_a0 model.UploadResourceOutput, _a1 error) *ResourceServiceMock_UploadResource_Call {
+func (_c *ResourceServiceMock_UploadResource_Call) Return(_a0 v0.UploadResourceOutput, _a1 error) *ResourceServiceMock_UploadResource_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ResourceServiceMock_UploadResource_Call) RunAndReturn(run func(context.Context, *model.UploadResourceInput) (model.UploadResourceOutput, error)) *ResourceServiceMock_UploadResource_Call {
+func (_c *ResourceServiceMock_UploadResource_Call) RunAndReturn(run func(context.Context, *v0.UploadResourceInput) (v0.UploadResourceOutput, error)) *ResourceServiceMock_UploadResource_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // UploadResources provides a mock function with given fields: ctx, input
-func (_m *ResourceServiceMock) UploadResources(ctx context.Context, input *model.UploadResourcesInput) (model.UploadResourcesOutput, error) {
+func (_m *ResourceServiceMock) UploadResources(ctx context.Context, input *v0.UploadResourcesInput) (v0.UploadResourcesOutput, error) {
 	ret := _m.Called(ctx, input)
 
 	if len(ret) == 0 {
 		panic("no return value specified for UploadResources")
 	}
 
-	var r0 model.UploadResourcesOutput
+	var r0 v0.UploadResourcesOutput
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.UploadResourcesInput) (model.UploadResourcesOutput, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v0.UploadResourcesInput) (v0.UploadResourcesOutput, error)); ok {
 		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.UploadResourcesInput) model.UploadResourcesOutput); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *v0.UploadResourcesInput) v0.UploadResourcesOutput); ok {
 		r0 = rf(ctx, input)
 	} else {
-		r0 = ret.Get(0).(model.UploadResourcesOutput)
+		r0 = ret.Get(0).(v0.UploadResourcesOutput)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.UploadResourcesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *v0.UploadResourcesInput) error); ok {
 		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
@@ -175,24 +236,82 @@ type ResourceServiceMock_UploadResources_Call struct {
 
 // UploadResources is a helper method to define mock.On call
 //   - ctx context.Context
-//   - input *model.UploadResourcesInput
+//   - input *v0.UploadResourcesInput
 func (_e *ResourceServiceMock_Expecter) UploadResources(ctx interface{}, input interface{}) *ResourceServiceMock_UploadResources_Call {
 	return &ResourceServiceMock_UploadResources_Call{Call: _e.mock.On("UploadResources", ctx, input)}
 }
 
-func (_c *ResourceServiceMock_UploadResources_Call) Run(run func(ctx context.Context, input *model.UploadResourcesInput)) *ResourceServiceMock_UploadResources_Call {
+func (_c *ResourceServiceMock_UploadResources_Call) Run(run func(ctx context.Context, input *v0.UploadResourcesInput)) *ResourceServiceMock_UploadResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*v0.UploadResourcesInput))
+	})
+	return _c
+}
+
+func (_c *ResourceServiceMock_UploadResources_Call) Return(_a0 v0.UploadResourcesOutput, _a1 error) *ResourceServiceMock_UploadResources_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceServiceMock_UploadResources_Call) RunAndReturn(run func(context.Context, *v0.UploadResourcesInput) (v0.UploadResourcesOutput, error)) *ResourceServiceMock_UploadResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadResourcesToFolder provides a mock function with given fields: ctx, folder, input
+func (_m *ResourceServiceMock) UploadResourcesToFolder(ctx context.Context, folder string, input *v0.UploadResourcesInput) (v0.UploadResourcesOutput, error) {
+	ret := _m.Called(ctx, folder, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadResourcesToFolder")
+	}
+
+	var r0 v0.UploadResourcesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v0.UploadResourcesInput) (v0.UploadResourcesOutput, error)); ok {
+		return rf(ctx, folder, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *v0.UploadResourcesInput) v0.UploadResourcesOutput); ok {
+		r0 = rf(ctx, folder, input)
+	} else {
+		r0 = ret.Get(0).(v0.UploadResourcesOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *v0.UploadResourcesInput) error); ok {
+		r1 = rf(ctx, folder, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceServiceMock_UploadResourcesToFolder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadResourcesToFolder'
+type ResourceServiceMock_UploadResourcesToFolder_Call struct {
+	*mock.Call
+}
+
+// UploadResourcesToFolder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - folder string
+//   - input *v0.UploadResourcesInput
+func (_e *ResourceServiceMock_Expecter) UploadResourcesToFolder(ctx interface{}, folder interface{}, input interface{}) *ResourceServiceMock_UploadResourcesToFolder_Call {
+	return &ResourceServiceMock_UploadResourcesToFolder_Call{Call: _e.mock.On("UploadResourcesToFolder", ctx, folder, input)}
+}
+
+func (_c *ResourceServiceMock_UploadResourcesToFolder_Call) Run(run func(ctx context.Context, folder string, input *v0.UploadResourcesInput)) *ResourceServiceMock_UploadResourcesToFolder_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.UploadResourcesInput))
+		run(args[0].(context.Context), args[1].(string), args[2].(*v0.UploadResourcesInput))
 	})
 	return _c
 }
 
-func (_c *ResourceServiceMock_UploadResources_Call) Return(_a0 model.UploadResourcesOutput, _a1 error) *ResourceServiceMock_UploadResources_Call {
+func (_c *ResourceServiceMock_UploadResourcesToFolder_Call) Return(_a0 v0.UploadResourcesOutput, _a1 error) *ResourceServiceMock_UploadResourcesToFolder_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ResourceServiceMock_UploadResources_Call) RunAndReturn(run func(context.Context, *model.UploadResourcesInput) (model.UploadResourcesOutput, error)) *ResourceServiceMock_UploadResources_Call {
+func (_c *ResourceServiceMock_UploadResourcesToFolder_Call) RunAndReturn(run func(context.Context, string, *v0.UploadResourcesInput) (v0.UploadResourcesOutput, error)) *ResourceServiceMock_UploadResourcesToFolder_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,152 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	v0 "github.com/mandarine-io/backend/pkg/model/v0"
+)
+
+// RoleServiceMock is an autogenerated mock type for the RoleService type
+type RoleServiceMock struct {
+	mock.Mock
+}
+
+type RoleServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RoleServiceMock) EXPECT() *RoleServiceMock_Expecter {
+	return &RoleServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// GetRoles provides a mock function with given fields: ctx
+func (_m *RoleServiceMock) GetRoles(ctx context.Context) (v0.RolesOutput, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRoles")
+	}
+
+	var r0 v0.RolesOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (v0.RolesOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) v0.RolesOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(v0.RolesOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RoleServiceMock_GetRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRoles'
+type RoleServiceMock_GetRoles_Call struct {
+	*mock.Call
+}
+
+// GetRoles is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RoleServiceMock_Expecter) GetRoles(ctx interface{}) *RoleServiceMock_GetRoles_Call {
+	return &RoleServiceMock_GetRoles_Call{Call: _e.mock.On("GetRoles", ctx)}
+}
+
+func (_c *RoleServiceMock_GetRoles_Call) Run(run func(ctx context.Context)) *RoleServiceMock_GetRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RoleServiceMock_GetRoles_Call) Return(_a0 v0.RolesOutput, _a1 error) *RoleServiceMock_GetRoles_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RoleServiceMock_GetRoles_Call) RunAndReturn(run func(context.Context) (v0.RolesOutput, error)) *RoleServiceMock_GetRoles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRole provides a mock function with given fields: ctx, name, input
+func (_m *RoleServiceMock) UpdateRole(ctx context.Context, name string, input v0.UpdateRoleInput) (v0.RoleOutput, error) {
+	ret := _m.Called(ctx, name, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRole")
+	}
+
+	var r0 v0.RoleOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, v0.UpdateRoleInput) (v0.RoleOutput, error)); ok {
+		return rf(ctx, name, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, v0.UpdateRoleInput) v0.RoleOutput); ok {
+		r0 = rf(ctx, name, input)
+	} else {
+		r0 = ret.Get(0).(v0.RoleOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, v0.UpdateRoleInput) error); ok {
+		r1 = rf(ctx, name, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RoleServiceMock_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type RoleServiceMock_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - input v0.UpdateRoleInput
+func (_e *RoleServiceMock_Expecter) UpdateRole(ctx interface{}, name interface{}, input interface{}) *RoleServiceMock_UpdateRole_Call {
+	return &RoleServiceMock_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, name, input)}
+}
+
+func (_c *RoleServiceMock_UpdateRole_Call) Run(run func(ctx context.Context, name string, input v0.UpdateRoleInput)) *RoleServiceMock_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(v0.UpdateRoleInput))
+	})
+	return _c
+}
+
+func (_c *RoleServiceMock_UpdateRole_Call) Return(_a0 v0.RoleOutput, _a1 error) *RoleServiceMock_UpdateRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RoleServiceMock_UpdateRole_Call) RunAndReturn(run func(context.Context, string, v0.UpdateRoleInput) (v0.RoleOutput, error)) *RoleServiceMock_UpdateRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRoleServiceMock creates a new instance of RoleServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRoleServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RoleServiceMock {
+	mock := &RoleServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -70,6 +70,56 @@ func (_c *WebsocketServiceMock_RegisterClient_Call) RunAndReturn(run func(uuid.U
 	return _c
 }
 
+// StreamEvents provides a mock function with given fields: userID, topics, lastEventID, r, w
+func (_m *WebsocketServiceMock) StreamEvents(userID uuid.UUID, topics []string, lastEventID string, r *http.Request, w http.ResponseWriter) error {
+	ret := _m.Called(userID, topics, lastEventID, r, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uuid.UUID, []string, string, *http.Request, http.ResponseWriter) error); ok {
+		r0 = rf(userID, topics, lastEventID, r, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WebsocketServiceMock_StreamEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamEvents'
+type WebsocketServiceMock_StreamEvents_Call struct {
+	*mock.Call
+}
+
+// StreamEvents is a helper method to define mock.On call
+//   - userID uuid.UUID
+//   - topics []string
+//   - lastEventID string
+//   - r *http.Request
+//   - w http.ResponseWriter
+func (_e *WebsocketServiceMock_Expecter) StreamEvents(userID interface{}, topics interface{}, lastEventID interface{}, r interface{}, w interface{}) *WebsocketServiceMock_StreamEvents_Call {
+	return &WebsocketServiceMock_StreamEvents_Call{Call: _e.mock.On("StreamEvents", userID, topics, lastEventID, r, w)}
+}
+
+func (_c *WebsocketServiceMock_StreamEvents_Call) Run(run func(userID uuid.UUID, topics []string, lastEventID string, r *http.Request, w http.ResponseWriter)) *WebsocketServiceMock_StreamEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(uuid.UUID), args[1].([]string), args[2].(string), args[3].(*http.Request), args[4].(http.ResponseWriter))
+	})
+	return _c
+}
+
+func (_c *WebsocketServiceMock_StreamEvents_Call) Return(_a0 error) *WebsocketServiceMock_StreamEvents_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *WebsocketServiceMock_StreamEvents_Call) RunAndReturn(run func(uuid.UUID, []string, string, *http.Request, http.ResponseWriter) error) *WebsocketServiceMock_StreamEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewWebsocketServiceMock creates a new instance of WebsocketServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewWebsocketServiceMock(t interface {
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	wellknown "github.com/mandarine-io/backend/pkg/model/wellknown"
+)
+
+// WellKnownServiceMock is an autogenerated mock type for the WellKnownService type
+type WellKnownServiceMock struct {
+	mock.Mock
+}
+
+type WellKnownServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WellKnownServiceMock) EXPECT() *WellKnownServiceMock_Expecter {
+	return &WellKnownServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// GetJWKS provides a mock function with given fields: ctx
+func (_m *WellKnownServiceMock) GetJWKS(ctx context.Context) (wellknown.JWKSOutput, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetJWKS")
+	}
+
+	var r0 wellknown.JWKSOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (wellknown.JWKSOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) wellknown.JWKSOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(wellknown.JWKSOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WellKnownServiceMock_GetJWKS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetJWKS'
+type WellKnownServiceMock_GetJWKS_Call struct {
+	*mock.Call
+}
+
+// GetJWKS is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WellKnownServiceMock_Expecter) GetJWKS(ctx interface{}) *WellKnownServiceMock_GetJWKS_Call {
+	return &WellKnownServiceMock_GetJWKS_Call{Call: _e.mock.On("GetJWKS", ctx)}
+}
+
+func (_c *WellKnownServiceMock_GetJWKS_Call) Run(run func(ctx context.Context)) *WellKnownServiceMock_GetJWKS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WellKnownServiceMock_GetJWKS_Call) Return(_a0 wellknown.JWKSOutput, _a1 error) *WellKnownServiceMock_GetJWKS_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WellKnownServiceMock_GetJWKS_Call) RunAndReturn(run func(context.Context) (wellknown.JWKSOutput, error)) *WellKnownServiceMock_GetJWKS_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWellKnownServiceMock creates a new instance of WellKnownServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWellKnownServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WellKnownServiceMock {
+	mock := &WellKnownServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -12,8 +12,13 @@ import (
 	"io"
 	"mime/multipart"
 	"os"
+	"strings"
 )
 
+// folderSeparator separates folder and name of object. Objects in folders are private and are served by
+// services owning the folder only
+const folderSeparator = "/"
+
 type svc struct {
 	s3Manager s3.Manager
 	logger    zerolog.Logger
@@ -100,7 +105,21 @@ func (s *svc) UploadResources(ctx context.Context, input *v0.UploadResourcesInpu
 	error,
 ) {
 	s.logger.Info().Msg("upload resources")
+	return s.uploadResources(ctx, "", input)
+}
+
+func (s *svc) UploadResourcesToFolder(ctx context.Context, folder string, input *v0.UploadResourcesInput) (
+	v0.UploadResourcesOutput,
+	error,
+) {
+	s.logger.Info().Msgf("upload resources to folder: %s", folder)
+	return s.uploadResources(ctx, folder+folderSeparator, input)
+}
 
+func (s *svc) uploadResources(ctx context.Context, prefix string, input *v0.UploadResourcesInput) (
+	v0.UploadResourcesOutput,
+	error,
+) {
 	files := input.Resources
 
 	// Open files
@@ -134,7 +153,7 @@ func (s *svc) UploadResources(ctx context.Context, input *v0.UploadResourcesInpu
 
 		fileData := &s3.FileData{
 			Reader:      f,
-			ID:          fmt.Sprintf("%s-%s", hash, file.Filename),
+			ID:          fmt.Sprintf("%s%s-%s", prefix, hash, file.Filename),
 			Size:        file.Size,
 			ContentType: file.Header.Get("Content-Type"),
 			UserMetadata: map[string]string{
@@ -170,6 +189,25 @@ func (s *svc) UploadResources(ctx context.Context, input *v0.UploadResourcesInpu
 
 func (s *svc) DownloadResource(ctx context.Context, objectID string) (*s3.FileData, error) {
 	s.logger.Info().Msg("download resource")
+
+	// Objects in folders are not public
+	if strings.Contains(objectID, folderSeparator) {
+		s.logger.Error().Stack().Err(s3.ErrObjectNotFound).Msg("resource is private")
+		return nil, s3.ErrObjectNotFound
+	}
+
+	getDto := s.s3Manager.GetOne(ctx, objectID)
+	return getDto.Data, getDto.Error
+}
+
+func (s *svc) DownloadResourceFromFolder(ctx context.Context, folder string, objectID string) (*s3.FileData, error) {
+	s.logger.Info().Msgf("download resource from folder: %s", folder)
+
+	if !strings.HasPrefix(objectID, folder+folderSeparator) {
+		s.logger.Error().Stack().Err(s3.ErrObjectNotFound).Msg("resource is not in folder")
+		return nil, s3.ErrObjectNotFound
+	}
+
 	getDto := s.s3Manager.GetOne(ctx, objectID)
 	return getDto.Data, getDto.Error
 }
@@ -0,0 +1,80 @@
+package role
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/converter"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/rs/zerolog"
+)
+
+type svc struct {
+	roleRepo repo.RoleRepository
+	logger   zerolog.Logger
+}
+
+type Option func(*svc)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(p *svc) {
+		p.logger = logger
+	}
+}
+
+func NewService(roleRepo repo.RoleRepository, opts ...Option) domain.RoleService {
+	s := &svc{
+		roleRepo: roleRepo,
+		logger:   zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+//////////////////// Get roles ////////////////////
+
+func (s *svc) GetRoles(ctx context.Context) (v0.RolesOutput, error) {
+	s.logger.Info().Msg("get roles")
+
+	roleEntities, err := s.roleRepo.FindRoles(ctx)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find roles")
+		return v0.RolesOutput{}, err
+	}
+
+	return converter.MapRoleEntitiesToRolesOutput(roleEntities), nil
+}
+
+//////////////////// Update role ////////////////////
+
+func (s *svc) UpdateRole(ctx context.Context, name string, input v0.UpdateRoleInput) (v0.RoleOutput, error) {
+	s.logger.Info().Msgf("update role: %s", name)
+
+	// Get role entity
+	roleEntity, err := s.roleRepo.FindRoleByName(ctx, name)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find role")
+		return v0.RoleOutput{}, err
+	}
+	if roleEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrRoleNotFound).Msg("role not found")
+		return v0.RoleOutput{}, domain.ErrRoleNotFound
+	}
+
+	// Update role
+	if input.IsMFARequired != nil {
+		roleEntity.IsMFARequired = *input.IsMFARequired
+	}
+
+	roleEntity, err = s.roleRepo.UpdateRole(ctx, roleEntity)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to update role")
+		return v0.RoleOutput{}, err
+	}
+
+	return converter.MapRoleEntityToRoleOutput(roleEntity), nil
+}
@@ -5,8 +5,10 @@ import (
 	"github.com/google/uuid"
 	"github.com/mandarine-io/backend/internal/infrastructure/locale"
 	"github.com/mandarine-io/backend/internal/infrastructure/s3"
+	infra "github.com/mandarine-io/backend/internal/service/infrastructure"
 	"github.com/mandarine-io/backend/pkg/model/health"
 	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/mandarine-io/backend/pkg/model/wellknown"
 	"github.com/mandarine-io/backend/third_party/oauth"
 	"golang.org/x/text/language"
 	"net/http"
@@ -23,14 +25,29 @@ var (
 	ErrUserNotDeleted       = v0.NewI18nError("user not deleted", "errors.user_not_deleted")
 	ErrUserAlreadyDeleted   = v0.NewI18nError("user already deleted", "errors.user_already_deleted")
 	ErrSendEmail            = v0.NewI18nError("failed to send email", "errors.failed_to_send_email")
+	ErrDuplicatePhone       = v0.NewI18nError("phone already in use", "errors.duplicate_phone")
+	ErrSendSMS              = v0.NewI18nError("failed to send sms", "errors.failed_to_send_sms")
+	ErrPhoneNotVerified     = v0.NewI18nError("phone is not verified", "errors.phone_not_verified")
+	ErrMFAAlreadyEnabled    = v0.NewI18nError("2FA is already enabled", "errors.mfa_already_enabled")
+	ErrMFANotEnabled        = v0.NewI18nError("2FA is not enabled", "errors.mfa_not_enabled")
+	ErrMFASetupNotStarted   = v0.NewI18nError("2FA setup is not started", "errors.mfa_setup_not_started")
+	ErrPasskeyNotFound      = v0.NewI18nError("passkey not found", "errors.passkey_not_found")
+	ErrIdentityNotFound     = v0.NewI18nError("identity not found", "errors.identity_not_found")
+	ErrIdentityLinked       = v0.NewI18nError("identity is linked to another user", "errors.identity_linked")
+	ErrLastLoginMethod      = v0.NewI18nError("last login method cannot be removed", "errors.last_login_method")
 
 	// Auth error
 
-	ErrDuplicateUser       = v0.NewI18nError("duplicate user", "errors.duplicate_user")
-	ErrBadCredentials      = v0.NewI18nError("bad credentials", "errors.bad_credentials")
-	ErrUserIsBlocked       = v0.NewI18nError("user is blocked", "errors.user_is_blocked")
-	ErrUserInfoNotReceived = v0.NewI18nError("user info not received", "errors.userinfo_not_received")
-	ErrInvalidProvider     = v0.NewI18nError("invalid provider", "errors.invalid_provider")
+	ErrDuplicateUser           = v0.NewI18nError("duplicate user", "errors.duplicate_user")
+	ErrBadCredentials          = v0.NewI18nError("bad credentials", "errors.bad_credentials")
+	ErrUserIsBlocked           = v0.NewI18nError("user is blocked", "errors.user_is_blocked")
+	ErrUserInfoNotReceived     = v0.NewI18nError("user info not received", "errors.userinfo_not_received")
+	ErrInvalidProvider         = v0.NewI18nError("invalid provider", "errors.invalid_provider")
+	ErrInvalidState            = v0.NewI18nError("invalid state", "errors.invalid_state")
+	ErrMagicLinkDeviceMismatch = v0.NewI18nError(
+		"magic link is requested from another device",
+		"errors.magic_link_device_mismatch",
+	)
 
 	// Geocoding error
 
@@ -58,6 +75,33 @@ var (
 	ErrMasterServiceDeletion = v0.NewI18nError("master service deletion", "errors.master_service_deletion")
 	ErrMasterServiceNotExist = v0.NewI18nError("master service not exist", "errors.master_service_not_exist")
 
+	// Service account error
+
+	ErrServiceAccountNotFound  = v0.NewI18nError("service account not found", "errors.service_account_not_found")
+	ErrDuplicateServiceAccount = v0.NewI18nError(
+		"service account already exists",
+		"errors.duplicate_service_account",
+	)
+	ErrAPIKeyNotFound  = v0.NewI18nError("API key not found", "errors.api_key_not_found")
+	ErrAPIKeyNotActive = v0.NewI18nError("API key is revoked or expired", "errors.api_key_not_active")
+
+	// Chat error
+
+	ErrConversationNotFound = v0.NewI18nError("conversation not found", "errors.conversation_not_found")
+	ErrChatWithSelf         = v0.NewI18nError("chat with self", "errors.chat_with_self")
+	ErrChatMessageNotFound  = v0.NewI18nError("chat message not found", "errors.chat_message_not_found")
+	ErrInvalidCursor        = v0.NewI18nError("invalid cursor", "errors.invalid_cursor")
+	ErrInvalidAttachment    = v0.NewI18nError("invalid chat attachment", "errors.invalid_attachment")
+
+	// Websocket error
+
+	ErrUnknownTopic   = v0.NewI18nError("unknown topic", "errors.unknown_topic")
+	ErrTopicForbidden = v0.NewI18nError("subscription to topic is forbidden", "errors.topic_forbidden")
+
+	// Role error
+
+	ErrRoleNotFound = v0.NewI18nError("role not found", "errors.role_not_found")
+
 	// Resource error
 
 	ErrResourceNotUploaded = v0.NewI18nError("resource not uploaded", "errors.resource_not_uploaded")
@@ -73,24 +117,140 @@ type AccountService interface {
 		localizer locale.Localizer,
 	) (v0.AccountOutput, error)
 	VerifyEmail(ctx context.Context, id uuid.UUID, input v0.VerifyEmailInput) error
+	UpdatePhone(
+		ctx context.Context,
+		id uuid.UUID,
+		input v0.UpdatePhoneInput,
+		localizer locale.Localizer,
+	) (v0.AccountOutput, error)
+	VerifyPhone(ctx context.Context, id uuid.UUID, input v0.VerifyPhoneInput) error
 	SetPassword(ctx context.Context, id uuid.UUID, input v0.SetPasswordInput) error
-	UpdatePassword(ctx context.Context, id uuid.UUID, input v0.UpdatePasswordInput) error
+	UpdatePassword(ctx context.Context, id uuid.UUID, sessionID uuid.UUID, input v0.UpdatePasswordInput) error
 	RestoreAccount(ctx context.Context, id uuid.UUID) (v0.AccountOutput, error)
 	DeleteAccount(ctx context.Context, id uuid.UUID) error
+	GetSessions(ctx context.Context, id uuid.UUID, currentSessionID uuid.UUID) (v0.SessionsOutput, error)
+	RevokeSession(ctx context.Context, id uuid.UUID, sessionID uuid.UUID) error
+	RevokeOtherSessions(ctx context.Context, id uuid.UUID, currentSessionID uuid.UUID) error
+	SetupTOTP(ctx context.Context, id uuid.UUID) (v0.TOTPSetupOutput, error)
+	EnableTOTP(
+		ctx context.Context,
+		id uuid.UUID,
+		input v0.TOTPCodeInput,
+		clientInfo infra.ClientInfo,
+	) (v0.RecoveryCodesOutput, error)
+	DisableTOTP(ctx context.Context, id uuid.UUID, input v0.TOTPCodeInput, clientInfo infra.ClientInfo) error
+	RegenerateRecoveryCodes(
+		ctx context.Context,
+		id uuid.UUID,
+		input v0.TOTPCodeInput,
+		clientInfo infra.ClientInfo,
+	) (v0.RecoveryCodesOutput, error)
+	BeginPasskeyRegistration(ctx context.Context, id uuid.UUID) (v0.PasskeyCreationOptionsOutput, error)
+	FinishPasskeyRegistration(
+		ctx context.Context,
+		id uuid.UUID,
+		input v0.PasskeyRegistrationInput,
+	) (v0.PasskeyOutput, error)
+	GetPasskeys(ctx context.Context, id uuid.UUID) (v0.PasskeysOutput, error)
+	DeletePasskey(ctx context.Context, id uuid.UUID, passkeyID uuid.UUID) error
+	GetIdentities(ctx context.Context, id uuid.UUID) (v0.IdentitiesOutput, error)
+	LinkIdentity(
+		ctx context.Context,
+		id uuid.UUID,
+		provider string,
+		input v0.LinkIdentityInput,
+		clientInfo infra.ClientInfo,
+	) (v0.IdentityOutput, error)
+	ConfirmIdentityLink(ctx context.Context, id uuid.UUID, input v0.ConfirmIdentityLinkInput) (v0.IdentityOutput, error)
+	UnlinkIdentity(ctx context.Context, id uuid.UUID, provider string) error
 }
 
 type AuthService interface {
 	Register(ctx context.Context, input v0.RegisterInput, localizer locale.Localizer) error
-	RegisterConfirm(ctx context.Context, input v0.RegisterConfirmInput) error
-	Login(ctx context.Context, input v0.LoginInput) (v0.JwtTokensOutput, error)
-	RefreshTokens(ctx context.Context, input v0.RefreshTokensInput) (v0.JwtTokensOutput, error)
-	Logout(ctx context.Context, jti string) error
+	RegisterConfirm(ctx context.Context, input v0.RegisterConfirmInput, clientInfo infra.ClientInfo) error
+	Login(ctx context.Context, input v0.LoginInput, clientInfo infra.ClientInfo) (v0.LoginOutput, error)
+	LoginMFA(ctx context.Context, input v0.LoginMFAInput, clientInfo infra.ClientInfo) (v0.JwtTokensOutput, error)
+	LoginPhone(ctx context.Context, input v0.PhoneLoginInput, localizer locale.Localizer) error
+	LoginPhoneConfirm(
+		ctx context.Context,
+		input v0.PhoneLoginConfirmInput,
+		clientInfo infra.ClientInfo,
+	) (v0.LoginOutput, error)
+	SendMagicLink(ctx context.Context, input v0.MagicLinkInput, localizer locale.Localizer) (v0.MagicLinkOutput, error)
+	VerifyMagicLink(
+		ctx context.Context,
+		input v0.VerifyMagicLinkInput,
+		deviceSecret string,
+		clientInfo infra.ClientInfo,
+	) (v0.LoginOutput, error)
+	BeginPasskeyLogin(ctx context.Context) (v0.PasskeyRequestOptionsOutput, error)
+	FinishPasskeyLogin(
+		ctx context.Context,
+		input v0.PasskeyLoginInput,
+		clientInfo infra.ClientInfo,
+	) (v0.JwtTokensOutput, error)
+	RefreshTokens(
+		ctx context.Context,
+		input v0.RefreshTokensInput,
+		clientInfo infra.ClientInfo,
+	) (v0.JwtTokensOutput, error)
+	Logout(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID, jti string) error
 	RecoveryPassword(ctx context.Context, input v0.RecoveryPasswordInput, localizer locale.Localizer) error
-	VerifyRecoveryCode(ctx context.Context, input v0.VerifyRecoveryCodeInput) error
-	ResetPassword(ctx context.Context, input v0.ResetPasswordInput) error
-	GetConsentPageURL(_ context.Context, provider string, redirectURL string) (v0.GetConsentPageURLOutput, error)
-	FetchUserInfo(ctx context.Context, provider string, input v0.FetchUserInfoInput) (oauth.UserInfo, error)
-	RegisterOrLogin(ctx context.Context, userInfo oauth.UserInfo) (v0.JwtTokensOutput, error)
+	VerifyRecoveryCode(ctx context.Context, input v0.VerifyRecoveryCodeInput, clientInfo infra.ClientInfo) error
+	ResetPassword(ctx context.Context, input v0.ResetPasswordInput, clientInfo infra.ClientInfo) error
+	GetConsentPageURL(
+		ctx context.Context,
+		provider string,
+		redirectURL string,
+		clientInfo infra.ClientInfo,
+	) (v0.GetConsentPageURLOutput, error)
+	FetchUserInfo(
+		ctx context.Context,
+		provider string,
+		input v0.FetchUserInfoInput,
+		clientInfo infra.ClientInfo,
+	) (oauth.UserInfo, error)
+	RegisterOrLogin(
+		ctx context.Context,
+		provider string,
+		userInfo oauth.UserInfo,
+		clientInfo infra.ClientInfo,
+	) (v0.LoginOutput, error)
+}
+
+type ChatService interface {
+	CreateConversation(
+		ctx context.Context,
+		userID uuid.UUID,
+		input v0.CreateConversationInput,
+	) (v0.ConversationOutput, error)
+	GetConversations(ctx context.Context, userID uuid.UUID) (v0.ConversationsOutput, error)
+	GetConversation(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID) (v0.ConversationOutput, error)
+	GetMessages(
+		ctx context.Context,
+		userID uuid.UUID,
+		conversationID uuid.UUID,
+		input v0.FindChatMessagesInput,
+	) (v0.ChatMessagesOutput, error)
+	SendMessage(
+		ctx context.Context,
+		userID uuid.UUID,
+		conversationID uuid.UUID,
+		input v0.SendChatMessageInput,
+	) (v0.ChatMessageOutput, error)
+	MarkRead(ctx context.Context, userID uuid.UUID, conversationID uuid.UUID, input v0.MarkChatReadInput) error
+	UploadAttachments(
+		ctx context.Context,
+		userID uuid.UUID,
+		conversationID uuid.UUID,
+		input *v0.UploadResourcesInput,
+	) (v0.UploadResourcesOutput, error)
+	DownloadAttachment(
+		ctx context.Context,
+		userID uuid.UUID,
+		conversationID uuid.UUID,
+		objectID string,
+	) (*s3.FileData, error)
 }
 
 type GeocodingService interface {
@@ -150,12 +310,52 @@ type MasterServiceService interface {
 	GetOwnMasterServiceByID(ctx context.Context, userID uuid.UUID, id uuid.UUID) (v0.MasterServiceOutput, error)
 }
 
+type RoleService interface {
+	GetRoles(ctx context.Context) (v0.RolesOutput, error)
+	UpdateRole(ctx context.Context, name string, input v0.UpdateRoleInput) (v0.RoleOutput, error)
+}
+
 type ResourceService interface {
 	UploadResource(ctx context.Context, input *v0.UploadResourceInput) (v0.UploadResourceOutput, error)
 	UploadResources(ctx context.Context, input *v0.UploadResourcesInput) (v0.UploadResourcesOutput, error)
+	UploadResourcesToFolder(
+		ctx context.Context,
+		folder string,
+		input *v0.UploadResourcesInput,
+	) (v0.UploadResourcesOutput, error)
 	DownloadResource(ctx context.Context, objectID string) (*s3.FileData, error)
+	DownloadResourceFromFolder(ctx context.Context, folder string, objectID string) (*s3.FileData, error)
+}
+
+type ServiceAccountService interface {
+	CreateServiceAccount(
+		ctx context.Context,
+		id uuid.UUID,
+		input v0.CreateServiceAccountInput,
+	) (v0.ServiceAccountOutput, error)
+	GetServiceAccounts(ctx context.Context, id uuid.UUID) (v0.ServiceAccountsOutput, error)
+	DeleteServiceAccount(ctx context.Context, id uuid.UUID, serviceAccountID uuid.UUID) error
+	CreateAPIKey(
+		ctx context.Context,
+		id uuid.UUID,
+		serviceAccountID uuid.UUID,
+		input v0.CreateAPIKeyInput,
+	) (v0.CreatedAPIKeyOutput, error)
+	GetAPIKeys(ctx context.Context, id uuid.UUID, serviceAccountID uuid.UUID) (v0.APIKeysOutput, error)
+	RotateAPIKey(
+		ctx context.Context,
+		id uuid.UUID,
+		serviceAccountID uuid.UUID,
+		apiKeyID uuid.UUID,
+	) (v0.CreatedAPIKeyOutput, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID, serviceAccountID uuid.UUID, apiKeyID uuid.UUID) error
+}
+
+type WellKnownService interface {
+	GetJWKS(ctx context.Context) (wellknown.JWKSOutput, error)
 }
 
 type WebsocketService interface {
 	RegisterClient(userID uuid.UUID, r *http.Request, w http.ResponseWriter) error
+	StreamEvents(userID uuid.UUID, topics []string, lastEventID string, r *http.Request, w http.ResponseWriter) error
 }
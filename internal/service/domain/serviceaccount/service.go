@@ -0,0 +1,275 @@
+package serviceaccount
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/converter"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	infra "github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+	"strings"
+	"time"
+)
+
+type svc struct {
+	serviceAccountRepo repo.ServiceAccountRepository
+	apiKeyRepo         repo.APIKeyRepository
+	apiKeyService      infra.APIKeyService
+	cfg                config.APIKeyConfig
+	logger             zerolog.Logger
+}
+
+type Option func(*svc)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(p *svc) {
+		p.logger = logger
+	}
+}
+
+func NewService(
+	serviceAccountRepo repo.ServiceAccountRepository,
+	apiKeyRepo repo.APIKeyRepository,
+	apiKeyService infra.APIKeyService,
+	cfg config.APIKeyConfig,
+	opts ...Option,
+) domain.ServiceAccountService {
+	s := &svc{
+		serviceAccountRepo: serviceAccountRepo,
+		apiKeyRepo:         apiKeyRepo,
+		apiKeyService:      apiKeyService,
+		cfg:                cfg,
+		logger:             zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+//////////////////// Service accounts ////////////////////
+
+func (s *svc) CreateServiceAccount(
+	ctx context.Context,
+	id uuid.UUID,
+	input v0.CreateServiceAccountInput,
+) (v0.ServiceAccountOutput, error) {
+	s.logger.Info().Msgf("create service account: %s", id.String())
+
+	serviceAccountEntity := &entity.ServiceAccount{
+		OwnerID:     id,
+		Name:        input.Name,
+		Description: input.Description,
+	}
+
+	serviceAccountEntity, err := s.serviceAccountRepo.CreateServiceAccount(ctx, serviceAccountEntity)
+	if err != nil {
+		if errors.Is(err, repo.ErrDuplicateServiceAccount) {
+			s.logger.Error().Stack().Err(err).Msg("service account already exists")
+			return v0.ServiceAccountOutput{}, domain.ErrDuplicateServiceAccount
+		}
+
+		s.logger.Error().Stack().Err(err).Msg("failed to create service account")
+		return v0.ServiceAccountOutput{}, err
+	}
+
+	return converter.MapServiceAccountEntityToServiceAccountOutput(serviceAccountEntity), nil
+}
+
+func (s *svc) GetServiceAccounts(ctx context.Context, id uuid.UUID) (v0.ServiceAccountsOutput, error) {
+	s.logger.Info().Msgf("get service accounts: %s", id.String())
+
+	serviceAccountEntities, err := s.serviceAccountRepo.FindServiceAccountsByOwnerID(ctx, id)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find service accounts")
+		return v0.ServiceAccountsOutput{}, err
+	}
+
+	return converter.MapServiceAccountEntitiesToServiceAccountsOutput(serviceAccountEntities), nil
+}
+
+func (s *svc) DeleteServiceAccount(ctx context.Context, id uuid.UUID, serviceAccountID uuid.UUID) error {
+	s.logger.Info().Msgf("delete service account: %s", id.String())
+
+	deleted, err := s.serviceAccountRepo.DeleteServiceAccount(ctx, id, serviceAccountID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to delete service account")
+		return err
+	}
+	if !deleted {
+		s.logger.Error().Stack().Err(domain.ErrServiceAccountNotFound).Msg("service account not found")
+		return domain.ErrServiceAccountNotFound
+	}
+
+	return nil
+}
+
+//////////////////// API keys ////////////////////
+
+func (s *svc) CreateAPIKey(
+	ctx context.Context,
+	id uuid.UUID,
+	serviceAccountID uuid.UUID,
+	input v0.CreateAPIKeyInput,
+) (v0.CreatedAPIKeyOutput, error) {
+	s.logger.Info().Msgf("create API key: %s", id.String())
+
+	if err := s.checkServiceAccount(ctx, id, serviceAccountID); err != nil {
+		return v0.CreatedAPIKeyOutput{}, err
+	}
+
+	generatedKey, err := s.apiKeyService.GenerateKey(ctx)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to generate API key")
+		return v0.CreatedAPIKeyOutput{}, err
+	}
+
+	apiKeyEntity := &entity.APIKey{
+		ServiceAccountID: serviceAccountID,
+		Name:             input.Name,
+		Prefix:           generatedKey.Prefix,
+		KeyHash:          generatedKey.Hash,
+		Scopes:           strings.Join(input.Scopes, " "),
+	}
+	if input.ExpiresIn != nil {
+		apiKeyEntity.ExpiresAt = lo.ToPtr(time.Now().Add(time.Duration(*input.ExpiresIn) * time.Second))
+	}
+
+	apiKeyEntity, err = s.apiKeyRepo.CreateAPIKey(ctx, apiKeyEntity)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to create API key")
+		return v0.CreatedAPIKeyOutput{}, err
+	}
+
+	return v0.CreatedAPIKeyOutput{
+		Key:    generatedKey.Key,
+		APIKey: converter.MapAPIKeyEntityToAPIKeyOutput(apiKeyEntity),
+	}, nil
+}
+
+func (s *svc) GetAPIKeys(
+	ctx context.Context,
+	id uuid.UUID,
+	serviceAccountID uuid.UUID,
+) (v0.APIKeysOutput, error) {
+	s.logger.Info().Msgf("get API keys: %s", id.String())
+
+	if err := s.checkServiceAccount(ctx, id, serviceAccountID); err != nil {
+		return v0.APIKeysOutput{}, err
+	}
+
+	apiKeyEntities, err := s.apiKeyRepo.FindAPIKeysByServiceAccountID(ctx, serviceAccountID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find API keys")
+		return v0.APIKeysOutput{}, err
+	}
+
+	return converter.MapAPIKeyEntitiesToAPIKeysOutput(apiKeyEntities), nil
+}
+
+func (s *svc) RotateAPIKey(
+	ctx context.Context,
+	id uuid.UUID,
+	serviceAccountID uuid.UUID,
+	apiKeyID uuid.UUID,
+) (v0.CreatedAPIKeyOutput, error) {
+	s.logger.Info().Msgf("rotate API key: %s", id.String())
+
+	if err := s.checkServiceAccount(ctx, id, serviceAccountID); err != nil {
+		return v0.CreatedAPIKeyOutput{}, err
+	}
+
+	oldAPIKeyEntity, err := s.apiKeyRepo.FindAPIKeyByID(ctx, serviceAccountID, apiKeyID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find API key")
+		return v0.CreatedAPIKeyOutput{}, err
+	}
+	if oldAPIKeyEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrAPIKeyNotFound).Msg("API key not found")
+		return v0.CreatedAPIKeyOutput{}, domain.ErrAPIKeyNotFound
+	}
+
+	now := time.Now()
+	if oldAPIKeyEntity.RevokedAt != nil || (oldAPIKeyEntity.ExpiresAt != nil && !oldAPIKeyEntity.ExpiresAt.After(now)) {
+		s.logger.Error().Stack().Err(domain.ErrAPIKeyNotActive).Msg("API key is revoked or expired")
+		return v0.CreatedAPIKeyOutput{}, domain.ErrAPIKeyNotActive
+	}
+
+	generatedKey, err := s.apiKeyService.GenerateKey(ctx)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to generate API key")
+		return v0.CreatedAPIKeyOutput{}, err
+	}
+
+	// New key inherits scopes and lifetime of the old one
+	newAPIKeyEntity := &entity.APIKey{
+		ServiceAccountID: serviceAccountID,
+		Name:             oldAPIKeyEntity.Name,
+		Prefix:           generatedKey.Prefix,
+		KeyHash:          generatedKey.Hash,
+		Scopes:           oldAPIKeyEntity.Scopes,
+	}
+	if oldAPIKeyEntity.ExpiresAt != nil {
+		newAPIKeyEntity.ExpiresAt = lo.ToPtr(now.Add(oldAPIKeyEntity.ExpiresAt.Sub(oldAPIKeyEntity.CreatedAt)))
+	}
+
+	// Old key remains valid during grace period, so integration can switch to the new key without downtime
+	graceExpiresAt := now.Add(time.Duration(s.cfg.RotationGracePeriod) * time.Second)
+	if oldAPIKeyEntity.ExpiresAt == nil || oldAPIKeyEntity.ExpiresAt.After(graceExpiresAt) {
+		oldAPIKeyEntity.ExpiresAt = &graceExpiresAt
+	}
+
+	newAPIKeyEntity, err = s.apiKeyRepo.RotateAPIKey(ctx, oldAPIKeyEntity, newAPIKeyEntity)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to rotate API key")
+		return v0.CreatedAPIKeyOutput{}, err
+	}
+
+	return v0.CreatedAPIKeyOutput{
+		Key:    generatedKey.Key,
+		APIKey: converter.MapAPIKeyEntityToAPIKeyOutput(newAPIKeyEntity),
+	}, nil
+}
+
+func (s *svc) RevokeAPIKey(ctx context.Context, id uuid.UUID, serviceAccountID uuid.UUID, apiKeyID uuid.UUID) error {
+	s.logger.Info().Msgf("revoke API key: %s", id.String())
+
+	if err := s.checkServiceAccount(ctx, id, serviceAccountID); err != nil {
+		return err
+	}
+
+	revoked, err := s.apiKeyRepo.RevokeAPIKey(ctx, serviceAccountID, apiKeyID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to revoke API key")
+		return err
+	}
+	if !revoked {
+		s.logger.Error().Stack().Err(domain.ErrAPIKeyNotFound).Msg("API key not found")
+		return domain.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+// checkServiceAccount checks that service account belongs to user
+func (s *svc) checkServiceAccount(ctx context.Context, id uuid.UUID, serviceAccountID uuid.UUID) error {
+	serviceAccountEntity, err := s.serviceAccountRepo.FindServiceAccountByID(ctx, id, serviceAccountID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find service account")
+		return err
+	}
+	if serviceAccountEntity == nil {
+		s.logger.Error().Stack().Err(domain.ErrServiceAccountNotFound).Msg("service account not found")
+		return domain.ErrServiceAccountNotFound
+	}
+
+	return nil
+}
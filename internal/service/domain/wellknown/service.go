@@ -0,0 +1,48 @@
+package wellknown
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/converter"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/wellknown"
+	"github.com/rs/zerolog"
+)
+
+type svc struct {
+	jwkService infrastructure.JWKService
+	logger     zerolog.Logger
+}
+
+type Option func(*svc)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(p *svc) {
+		p.logger = logger
+	}
+}
+
+func NewService(jwkService infrastructure.JWKService, opts ...Option) domain.WellKnownService {
+	s := &svc{
+		jwkService: jwkService,
+		logger:     zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func (s *svc) GetJWKS(ctx context.Context) (wellknown.JWKSOutput, error) {
+	s.logger.Info().Msg("get jwks")
+
+	keys, err := s.jwkService.GetPublicKeys(ctx)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to get public keys")
+		return wellknown.JWKSOutput{}, err
+	}
+
+	return converter.MapSigningKeysToJWKSOutput(keys), nil
+}
@@ -1,16 +1,34 @@
 package ws
 
 import (
+	"context"
+	"errors"
 	"github.com/google/uuid"
 	"github.com/mandarine-io/backend/internal/infrastructure/websocket"
 	"github.com/mandarine-io/backend/internal/service/domain"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"net/http"
+	"time"
 )
 
+// Topics, which contain events of the subscribed user only
+const (
+	NotificationsTopic = "notifications"
+	AppointmentsTopic  = "appointments"
+)
+
+const (
+	PingMessageType = "ping"
+)
+
+type PongOutput struct {
+	ServerTime time.Time `json:"serverTime"`
+}
+
 type svc struct {
 	pool   *websocket.Pool
+	router *websocket.Router
 	logger zerolog.Logger
 }
 
@@ -22,9 +40,10 @@ func WithLogger(logger zerolog.Logger) Option {
 	}
 }
 
-func NewService(pool *websocket.Pool, opts ...Option) domain.WebsocketService {
+func NewService(pool *websocket.Pool, router *websocket.Router, opts ...Option) domain.WebsocketService {
 	s := &svc{
 		pool:   pool,
+		router: router,
 		logger: zerolog.Nop(),
 	}
 
@@ -32,17 +51,72 @@ func NewService(pool *websocket.Pool, opts ...Option) domain.WebsocketService {
 		opt(s)
 	}
 
+	router.Topic(NotificationsTopic, s.authorizePersonalTopic)
+	router.Topic(AppointmentsTopic, s.authorizePersonalTopic)
+	router.Handle(PingMessageType, s.ping)
+
 	return s
 }
 
 func (s *svc) RegisterClient(userID uuid.UUID, r *http.Request, w http.ResponseWriter) error {
 	s.logger.Info().Msg("register websocket client")
 
-	err := s.pool.Register(userID.String(), r, w)
+	connID, err := s.pool.Register(userID.String(), r, w)
 	if err != nil {
 		log.Error().Stack().Err(err).Msg("failed to register websocket client")
 		return err
 	}
 
+	s.logger.Debug().Msgf("websocket connection %s is registered", connID)
+
+	return nil
+}
+
+func (s *svc) StreamEvents(
+	userID uuid.UUID,
+	topics []string,
+	lastEventID string,
+	r *http.Request,
+	w http.ResponseWriter,
+) error {
+	s.logger.Info().Msg("stream events")
+
+	clientID := userID.String()
+	for _, topic := range topics {
+		err := s.router.Authorize(r.Context(), clientID, topic)
+		if errors.Is(err, websocket.ErrUnknownTopic) || errors.Is(err, websocket.ErrBadRequest) {
+			return domain.ErrUnknownTopic
+		}
+		if errors.Is(err, websocket.ErrForbidden) {
+			return domain.ErrTopicForbidden
+		}
+		if err != nil {
+			log.Error().Stack().Err(err).Msgf("failed to authorize subscription to topic %s", topic)
+			return err
+		}
+	}
+
+	err := s.pool.Stream(clientID, topics, lastEventID, r, w)
+	if err != nil {
+		log.Error().Stack().Err(err).Msg("failed to stream events")
+		return err
+	}
+
 	return nil
 }
+
+// authorizePersonalTopic allows subscription of any user, because events of personal topic are sent
+// only to connections of its user by Pool.SendEvent
+func (s *svc) authorizePersonalTopic(_ context.Context, clientID string, topic string) error {
+	s.logger.Debug().Msgf("authorize subscription of client %s to topic %s", clientID, topic)
+
+	if _, err := uuid.Parse(clientID); err != nil {
+		return websocket.ErrForbidden
+	}
+
+	return nil
+}
+
+func (s *svc) ping(c *websocket.Context) error {
+	return c.Reply(PongOutput{ServerTime: time.Now().UTC()})
+}
@@ -0,0 +1,144 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/rs/zerolog"
+	"strings"
+	"time"
+)
+
+const (
+	idLength     = 4
+	secretLength = 32
+	separator    = "_"
+)
+
+type svc struct {
+	apiKeyRepo repo.APIKeyRepository
+	userRepo   repo.UserRepository
+	cfg        config.APIKeyConfig
+	logger     zerolog.Logger
+}
+
+type Option func(*svc)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(p *svc) {
+		p.logger = logger
+	}
+}
+
+func NewService(
+	apiKeyRepo repo.APIKeyRepository,
+	userRepo repo.UserRepository,
+	cfg config.APIKeyConfig,
+	opts ...Option,
+) infrastructure.APIKeyService {
+	p := &svc{
+		apiKeyRepo: apiKeyRepo,
+		userRepo:   userRepo,
+		cfg:        cfg,
+		logger:     zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+func (s *svc) GenerateKey(_ context.Context) (infrastructure.GeneratedAPIKey, error) {
+	s.logger.Debug().Msg("generate API key")
+
+	id := make([]byte, idLength)
+	if _, err := rand.Read(id); err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to generate API key id")
+		return infrastructure.GeneratedAPIKey{}, err
+	}
+
+	secret := make([]byte, secretLength)
+	if _, err := rand.Read(secret); err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to generate API key secret")
+		return infrastructure.GeneratedAPIKey{}, err
+	}
+
+	// Key looks like <prefix>_<id>_<secret>, prefix and id are public part of the key
+	prefix := s.cfg.Prefix + separator + hex.EncodeToString(id)
+	key := prefix + separator + base64.RawURLEncoding.EncodeToString(secret)
+
+	return infrastructure.GeneratedAPIKey{
+		Key:    key,
+		Prefix: prefix,
+		Hash:   hashKey(key),
+	}, nil
+}
+
+func (s *svc) GetAPIKeyClaims(ctx context.Context, key string, ip string) (infrastructure.APIKeyClaims, error) {
+	s.logger.Debug().Msg("get API key claims")
+
+	// Foreign keys are rejected without database lookup
+	if !strings.HasPrefix(key, s.cfg.Prefix+separator) {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidAPIKey).Msg("unknown API key prefix")
+		return infrastructure.APIKeyClaims{}, infrastructure.ErrInvalidAPIKey
+	}
+
+	apiKeyEntity, err := s.apiKeyRepo.FindAPIKeyByHash(ctx, hashKey(key))
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find API key")
+		return infrastructure.APIKeyClaims{}, err
+	}
+	if apiKeyEntity == nil {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidAPIKey).Msg("API key not found")
+		return infrastructure.APIKeyClaims{}, infrastructure.ErrInvalidAPIKey
+	}
+
+	if apiKeyEntity.RevokedAt != nil {
+		s.logger.Error().Stack().Err(infrastructure.ErrRevokedAPIKey).Msg("API key is revoked")
+		return infrastructure.APIKeyClaims{}, infrastructure.ErrRevokedAPIKey
+	}
+	if apiKeyEntity.ExpiresAt != nil && !apiKeyEntity.ExpiresAt.After(time.Now()) {
+		s.logger.Error().Stack().Err(infrastructure.ErrExpiredAPIKey).Msg("API key is expired")
+		return infrastructure.APIKeyClaims{}, infrastructure.ErrExpiredAPIKey
+	}
+
+	// Owner is loaded on every request, so blocking or deleting of owner takes effect immediately
+	userEntity, err := s.userRepo.FindUserByID(ctx, apiKeyEntity.ServiceAccount.OwnerID, s.userRepo.WithRolePreload())
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find owner of service account")
+		return infrastructure.APIKeyClaims{}, err
+	}
+	if userEntity == nil {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidAPIKey).Msg("owner of service account not found")
+		return infrastructure.APIKeyClaims{}, infrastructure.ErrInvalidAPIKey
+	}
+
+	// Failed tracking must not break request of integration
+	if err := s.apiKeyRepo.TrackAPIKeyUsage(ctx, apiKeyEntity.ID, ip); err != nil {
+		s.logger.Warn().Err(err).Msg("failed to track API key usage")
+	}
+
+	return infrastructure.APIKeyClaims{
+		APIKeyID:         apiKeyEntity.ID,
+		ServiceAccountID: apiKeyEntity.ServiceAccountID,
+		UserID:           userEntity.ID,
+		Username:         userEntity.Username,
+		Email:            userEntity.Email,
+		Role:             userEntity.Role.Name,
+		IsEnabled:        userEntity.IsEnabled,
+		IsDeleted:        userEntity.DeletedAt != nil,
+		Scopes:           strings.Fields(apiKeyEntity.Scopes),
+	}, nil
+}
+
+func hashKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
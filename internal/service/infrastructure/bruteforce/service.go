@@ -0,0 +1,212 @@
+package bruteforce
+
+import (
+	"context"
+	"errors"
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	cachehelper "github.com/mandarine-io/backend/internal/util/cache"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/rs/zerolog"
+	"strings"
+	"time"
+)
+
+const (
+	cachePrefix = "bruteforce"
+
+	accountScope = "account"
+	ipScope      = "ip"
+)
+
+type svc struct {
+	manager cache.Manager
+	cfg     config.BruteForceConfig
+	logger  zerolog.Logger
+	now     func() time.Time
+}
+
+type Option func(*svc)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(s *svc) {
+		s.logger = logger
+	}
+}
+
+func NewService(
+	manager cache.Manager,
+	cfg config.BruteForceConfig,
+	opts ...Option,
+) infrastructure.BruteForceService {
+	s := &svc{
+		manager: manager,
+		cfg:     cfg,
+		logger:  zerolog.Nop(),
+		now:     time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func (s *svc) CheckAttempt(ctx context.Context, attempt infrastructure.Attempt) error {
+	s.logger.Debug().Msgf("check attempt: %s", attempt.Action)
+
+	// IP lock is checked first to not disclose whether account exists
+	if attempt.IP != "" {
+		err := s.checkLock(ctx, attempt.Action, ipScope, attempt.IP, infrastructure.ErrTooManyAttempts)
+		if err != nil {
+			return err
+		}
+	}
+
+	account := normalizeAccount(attempt.Account)
+	if account != "" {
+		err := s.checkLock(ctx, attempt.Action, accountScope, account, infrastructure.ErrAccountTemporarilyLocked)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *svc) FailAttempt(ctx context.Context, attempt infrastructure.Attempt) error {
+	s.logger.Debug().Msgf("fail attempt: %s", attempt.Action)
+
+	var lockoutErr error
+
+	if attempt.IP != "" {
+		err := s.registerFailure(
+			ctx,
+			attempt.Action,
+			ipScope,
+			attempt.IP,
+			s.cfg.MaxIPAttempts,
+			infrastructure.ErrTooManyAttempts,
+		)
+		if err != nil {
+			lockoutErr = err
+		}
+	}
+
+	account := normalizeAccount(attempt.Account)
+	if account != "" {
+		err := s.registerFailure(
+			ctx,
+			attempt.Action,
+			accountScope,
+			account,
+			s.cfg.MaxAccountAttempts,
+			infrastructure.ErrAccountTemporarilyLocked,
+		)
+		if err != nil {
+			lockoutErr = err
+		}
+	}
+
+	return lockoutErr
+}
+
+func (s *svc) ResetAttempts(ctx context.Context, attempt infrastructure.Attempt) error {
+	s.logger.Debug().Msgf("reset attempts: %s", attempt.Action)
+
+	// IP counter is not reset, otherwise attacker could reset it with own account
+	account := normalizeAccount(attempt.Account)
+	if account == "" {
+		return nil
+	}
+
+	return s.manager.Delete(
+		ctx,
+		createCounterKey(attempt.Action, accountScope, account),
+		createLockKey(attempt.Action, accountScope, account),
+	)
+}
+
+func (s *svc) checkLock(ctx context.Context, action, scope, value string, lockErr v0.I18nError) error {
+	var lockedUntil int64
+	err := s.manager.Get(ctx, createLockKey(action, scope, value), &lockedUntil)
+	if errors.Is(err, cache.ErrCacheEntryNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	retryAfter := time.Unix(lockedUntil, 0).Sub(s.now())
+	if retryAfter <= 0 {
+		return nil
+	}
+
+	return infrastructure.LockoutError{Err: lockErr, RetryAfter: retryAfter}
+}
+
+func (s *svc) registerFailure(
+	ctx context.Context,
+	action, scope, value string,
+	maxAttempts int,
+	lockErr v0.I18nError,
+) error {
+	// Counter lives longer than the longest lock, so lockout keeps escalating after lock is released
+	failures, err := s.manager.Increment(
+		ctx,
+		createCounterKey(action, scope, value),
+		time.Duration(s.cfg.AttemptWindow+s.cfg.MaxLockout)*time.Second,
+	)
+	if err != nil {
+		return err
+	}
+
+	if failures < int64(maxAttempts) {
+		return nil
+	}
+
+	lockout := s.calculateLockout(failures - int64(maxAttempts))
+	lockedUntil := s.now().Add(lockout).Unix()
+
+	err = s.manager.SetWithExpiration(ctx, createLockKey(action, scope, value), lockedUntil, lockout)
+	if err != nil {
+		return err
+	}
+
+	s.logger.Warn().
+		Str("event", scope+"_locked").
+		Str("action", action).
+		Str(scope, value).
+		Int64("failures", failures).
+		Dur("lockout", lockout).
+		Msgf("security event: %s is locked", scope)
+
+	return infrastructure.LockoutError{Err: lockErr, RetryAfter: lockout}
+}
+
+// calculateLockout doubles base lockout for every failure over the limit
+func (s *svc) calculateLockout(exceeded int64) time.Duration {
+	base := time.Duration(s.cfg.BaseLockout) * time.Second
+	maxLockout := time.Duration(s.cfg.MaxLockout) * time.Second
+
+	lockout := base
+	for i := int64(0); i < exceeded && lockout < maxLockout; i++ {
+		lockout *= 2
+	}
+
+	return min(lockout, maxLockout)
+}
+
+func createCounterKey(action, scope, value string) string {
+	return cachehelper.CreateCacheKey(cachePrefix, action, scope, value)
+}
+
+func createLockKey(action, scope, value string) string {
+	return cachehelper.CreateCacheKey(cachePrefix, action, scope, value, "lock")
+}
+
+func normalizeAccount(account string) string {
+	return strings.ToLower(strings.TrimSpace(account))
+}
@@ -0,0 +1,72 @@
+package jwk
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// encryptedKeyPrefix marks private keys encrypted by key encryption key. Keys without it are stored as plain PEM
+// by previous versions and are still accepted
+const encryptedKeyPrefix = "enc:v1:"
+
+var (
+	ErrInvalidEncryptedKey = errors.New("invalid encrypted private key")
+)
+
+// keyCipher encrypts private keys with AES-256-GCM. AES key is derived from configured key encryption key by SHA-256
+type keyCipher struct {
+	aead cipher.AEAD
+}
+
+func newKeyCipher(kek string) (*keyCipher, error) {
+	key := sha256.Sum256([]byte(kek))
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keyCipher{aead: aead}, nil
+}
+
+func (c *keyCipher) encrypt(privateKeyPEM []byte) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := c.aead.Seal(nonce, nonce, privateKeyPEM, nil)
+	return encryptedKeyPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *keyCipher) decrypt(privateKey string) ([]byte, error) {
+	if !strings.HasPrefix(privateKey, encryptedKeyPrefix) {
+		return []byte(privateKey), nil
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(privateKey, encryptedKeyPrefix))
+	if err != nil {
+		return nil, ErrInvalidEncryptedKey
+	}
+	if len(sealed) < c.aead.NonceSize() {
+		return nil, ErrInvalidEncryptedKey
+	}
+
+	nonce, ciphertext := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	privateKeyPEM, err := c.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, ErrInvalidEncryptedKey
+	}
+
+	return privateKeyPEM, nil
+}
@@ -0,0 +1,386 @@
+package jwk
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/rs/zerolog"
+	"sync"
+	"time"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+	AlgorithmEdDSA = "EdDSA"
+
+	rsaKeySize         = 2048
+	keysReloadInterval = time.Minute
+
+	// unknownKeyReloadInterval limits reloads of keys forced by tokens with unknown kid
+	unknownKeyReloadInterval = 10 * time.Second
+)
+
+var (
+	ErrSigningKeyNotFound   = errors.New("signing key not found")
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+)
+
+type svc struct {
+	signingKeyRepo repo.SigningKeyRepository
+	transactor     repo.Transactor
+	cfg            config.JWTConfig
+	logger         zerolog.Logger
+
+	mu              sync.RWMutex
+	keys            []infrastructure.SigningKey
+	loadedAt        time.Time
+	unknownLoadedAt time.Time
+}
+
+type Option func(*svc)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(p *svc) {
+		p.logger = logger
+	}
+}
+
+func NewService(
+	signingKeyRepo repo.SigningKeyRepository,
+	transactor repo.Transactor,
+	cfg config.JWTConfig,
+	opts ...Option,
+) infrastructure.JWKService {
+	s := &svc{
+		signingKeyRepo: signingKeyRepo,
+		transactor:     transactor,
+		cfg:            cfg,
+		logger:         zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func (s *svc) GetSigningKey(ctx context.Context) (infrastructure.SigningKey, error) {
+	s.logger.Debug().Msg("get signing key")
+
+	keys, err := s.getKeys(ctx, false)
+	if err != nil {
+		return infrastructure.SigningKey{}, err
+	}
+
+	// Keys are sorted from newest to oldest, so the first suitable key is the current one
+	key, ok := s.findCurrentKey(keys)
+	if ok {
+		return key, nil
+	}
+
+	// There are no keys yet, so generate the first one
+	err = s.RotateKeys(ctx)
+	if err != nil {
+		return infrastructure.SigningKey{}, err
+	}
+
+	keys, err = s.getKeys(ctx, true)
+	if err != nil {
+		return infrastructure.SigningKey{}, err
+	}
+
+	key, ok = s.findCurrentKey(keys)
+	if ok {
+		return key, nil
+	}
+
+	return infrastructure.SigningKey{}, ErrSigningKeyNotFound
+}
+
+func (s *svc) GetVerificationKey(ctx context.Context, kid string) (infrastructure.SigningKey, error) {
+	s.logger.Debug().Msg("get verification key")
+
+	keys, err := s.getKeys(ctx, false)
+	if err != nil {
+		return infrastructure.SigningKey{}, err
+	}
+
+	key, ok := findKey(keys, kid)
+	if ok {
+		return key, nil
+	}
+
+	// Key may have been created by another instance after the last reload. Reloads are limited,
+	// so tokens with random kid do not query database on every request
+	s.mu.Lock()
+	if time.Since(s.unknownLoadedAt) < unknownKeyReloadInterval {
+		s.mu.Unlock()
+		return infrastructure.SigningKey{}, ErrSigningKeyNotFound
+	}
+	s.unknownLoadedAt = time.Now()
+	s.mu.Unlock()
+
+	keys, err = s.getKeys(ctx, true)
+	if err != nil {
+		return infrastructure.SigningKey{}, err
+	}
+
+	key, ok = findKey(keys, kid)
+	if ok {
+		return key, nil
+	}
+
+	return infrastructure.SigningKey{}, ErrSigningKeyNotFound
+}
+
+func (s *svc) GetPublicKeys(ctx context.Context) ([]infrastructure.SigningKey, error) {
+	s.logger.Debug().Msg("get public keys")
+
+	keys, err := s.getKeys(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeys := make([]infrastructure.SigningKey, len(keys))
+	for i, key := range keys {
+		publicKeys[i] = infrastructure.SigningKey{
+			ID:        key.ID,
+			Algorithm: key.Algorithm,
+			PublicKey: key.PublicKey,
+			CreatedAt: key.CreatedAt,
+		}
+	}
+
+	return publicKeys, nil
+}
+
+func (s *svc) RotateKeys(ctx context.Context) error {
+	s.logger.Debug().Msg("rotate signing keys")
+
+	// Symmetric tokens are signed with the configured secret
+	if s.cfg.Algorithm == AlgorithmHS256 {
+		return nil
+	}
+
+	// Rotation is serialized between instances, otherwise concurrent rotations expire fresh keys of each other
+	rotated := false
+	err := s.transactor.Transaction(
+		ctx, func(ctx context.Context) error {
+			err := s.signingKeyRepo.LockSigningKeys(ctx)
+			if err != nil {
+				s.logger.Error().Stack().Err(err).Msg("failed to lock signing keys")
+				return err
+			}
+
+			signingKeyEntities, err := s.signingKeyRepo.FindSigningKeys(ctx)
+			if err != nil {
+				s.logger.Error().Stack().Err(err).Msg("failed to find signing keys")
+				return err
+			}
+
+			// Check if current key is still fresh
+			rotationPeriod := time.Duration(s.cfg.KeyRotationPeriod) * time.Second
+			for _, signingKeyEntity := range signingKeyEntities {
+				if signingKeyEntity.Algorithm != s.cfg.Algorithm || signingKeyEntity.ExpiredAt != nil {
+					continue
+				}
+
+				if time.Since(signingKeyEntity.CreatedAt) < rotationPeriod {
+					return nil
+				}
+				break
+			}
+
+			// Generate new key
+			signingKeyEntity, err := generateSigningKey(s.cfg.Algorithm, s.cfg.KeyEncryptionKey)
+			if err != nil {
+				s.logger.Error().Stack().Err(err).Msg("failed to generate signing key")
+				return err
+			}
+
+			signingKeyEntity, err = s.signingKeyRepo.CreateSigningKey(ctx, signingKeyEntity)
+			if err != nil {
+				s.logger.Error().Stack().Err(err).Msg("failed to create signing key")
+				return err
+			}
+
+			// Previous keys keep validating until all tokens signed by them expire
+			expiredAt := time.Now().Add(time.Duration(max(s.cfg.AccessTokenTTL, s.cfg.RefreshTokenTTL)) * time.Second)
+			err = s.signingKeyRepo.ExpireSigningKeys(ctx, signingKeyEntity.ID, expiredAt)
+			if err != nil {
+				s.logger.Error().Stack().Err(err).Msg("failed to expire previous signing keys")
+				return err
+			}
+
+			s.logger.Info().Msgf("signing key rotated: kid=%s", signingKeyEntity.ID)
+			rotated = true
+
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if !rotated {
+		return nil
+	}
+
+	_, err = s.getKeys(ctx, true)
+	return err
+}
+
+func (s *svc) getKeys(ctx context.Context, force bool) ([]infrastructure.SigningKey, error) {
+	s.mu.RLock()
+	keys, loadedAt := s.keys, s.loadedAt
+	s.mu.RUnlock()
+
+	if !force && time.Since(loadedAt) < keysReloadInterval {
+		return keys, nil
+	}
+
+	signingKeyEntities, err := s.signingKeyRepo.FindSigningKeys(ctx)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find signing keys")
+		return nil, err
+	}
+
+	keys = make([]infrastructure.SigningKey, 0, len(signingKeyEntities))
+	for _, signingKeyEntity := range signingKeyEntities {
+		key, err := parseSigningKey(signingKeyEntity, s.cfg.KeyEncryptionKey)
+		if err != nil {
+			s.logger.Warn().Err(err).Msgf("failed to parse signing key: kid=%s", signingKeyEntity.ID)
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	s.mu.Lock()
+	s.keys, s.loadedAt = keys, time.Now()
+	s.mu.Unlock()
+
+	return keys, nil
+}
+
+// findCurrentKey returns the newest not expired key of configured algorithm
+func (s *svc) findCurrentKey(keys []infrastructure.SigningKey) (infrastructure.SigningKey, bool) {
+	for _, key := range keys {
+		if key.Algorithm == s.cfg.Algorithm && key.ExpiredAt == nil {
+			return key, true
+		}
+	}
+
+	return infrastructure.SigningKey{}, false
+}
+
+func findKey(keys []infrastructure.SigningKey, kid string) (infrastructure.SigningKey, bool) {
+	for _, key := range keys {
+		if key.ID == kid {
+			return key, true
+		}
+	}
+
+	return infrastructure.SigningKey{}, false
+}
+
+func generateSigningKey(algorithm string, kek string) (*entity.SigningKey, error) {
+	var (
+		privateKey crypto.Signer
+		err        error
+	)
+
+	switch algorithm {
+	case AlgorithmRS256:
+		privateKey, err = rsa.GenerateKey(rand.Reader, rsaKeySize)
+	case AlgorithmES256:
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case AlgorithmEdDSA:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	// Private key is stored encrypted, so leak of database does not disclose it
+	keyCipher, err := newKeyCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedPrivateKey, err := keyCipher.encrypt(
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyBytes}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.SigningKey{
+		ID:         uuid.New().String(),
+		Algorithm:  algorithm,
+		PrivateKey: encryptedPrivateKey,
+		PublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes})),
+	}, nil
+}
+
+func parseSigningKey(signingKeyEntity *entity.SigningKey, kek string) (infrastructure.SigningKey, error) {
+	keyCipher, err := newKeyCipher(kek)
+	if err != nil {
+		return infrastructure.SigningKey{}, err
+	}
+
+	privateKeyPEM, err := keyCipher.decrypt(signingKeyEntity.PrivateKey)
+	if err != nil {
+		return infrastructure.SigningKey{}, err
+	}
+
+	privateKeyBlock, _ := pem.Decode(privateKeyPEM)
+	if privateKeyBlock == nil {
+		return infrastructure.SigningKey{}, errors.New("invalid private key PEM")
+	}
+
+	privateKey, err := x509.ParsePKCS8PrivateKey(privateKeyBlock.Bytes)
+	if err != nil {
+		return infrastructure.SigningKey{}, err
+	}
+
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return infrastructure.SigningKey{}, errors.New("private key is not a signer")
+	}
+
+	return infrastructure.SigningKey{
+		ID:         signingKeyEntity.ID,
+		Algorithm:  signingKeyEntity.Algorithm,
+		PrivateKey: signer,
+		PublicKey:  signer.Public(),
+		CreatedAt:  signingKeyEntity.CreatedAt,
+		ExpiredAt:  signingKeyEntity.ExpiredAt,
+	}, nil
+}
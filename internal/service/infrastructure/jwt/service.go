@@ -8,9 +8,11 @@ import (
 	"github.com/mandarine-io/backend/config"
 	"github.com/mandarine-io/backend/internal/infrastructure/cache"
 	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
 	"github.com/mandarine-io/backend/internal/service/infrastructure"
 	cachehelper "github.com/mandarine-io/backend/internal/util/cache"
 	"github.com/rs/zerolog"
+	"github.com/samber/lo"
 	"time"
 )
 
@@ -20,9 +22,12 @@ const (
 )
 
 type svc struct {
-	manager cache.Manager
-	cfg     config.JWTConfig
-	logger  zerolog.Logger
+	manager         cache.Manager
+	jwkService      infrastructure.JWKService
+	sessionRepo     repo.SessionRepository
+	bannedTokenRepo repo.BannedTokenRepository
+	cfg             config.JWTConfig
+	logger          zerolog.Logger
 }
 
 type Option func(*svc)
@@ -33,11 +38,21 @@ func WithLogger(logger zerolog.Logger) Option {
 	}
 }
 
-func NewService(manager cache.Manager, cfg config.JWTConfig, opts ...Option) infrastructure.JWTService {
+func NewService(
+	manager cache.Manager,
+	jwkService infrastructure.JWKService,
+	sessionRepo repo.SessionRepository,
+	bannedTokenRepo repo.BannedTokenRepository,
+	cfg config.JWTConfig,
+	opts ...Option,
+) infrastructure.JWTService {
 	p := &svc{
-		manager: manager,
-		cfg:     cfg,
-		logger:  zerolog.Nop(),
+		manager:         manager,
+		jwkService:      jwkService,
+		sessionRepo:     sessionRepo,
+		bannedTokenRepo: bannedTokenRepo,
+		cfg:             cfg,
+		logger:          zerolog.Nop(),
 	}
 
 	for _, opt := range opts {
@@ -47,11 +62,11 @@ func NewService(manager cache.Manager, cfg config.JWTConfig, opts ...Option) inf
 	return p
 }
 
-func (s *svc) GetTypeToken(_ context.Context, token string) (string, error) {
+func (s *svc) GetTypeToken(ctx context.Context, token string) (string, error) {
 	s.logger.Debug().Msg("get type JWT token")
 
 	// Check token
-	jwtToken, err := s.decodeAndValidateJWTToken(token)
+	jwtToken, err := s.decodeAndValidateJWTToken(ctx, token)
 	if err != nil {
 		s.logger.Error().Stack().Err(err).Msg("failed to decode and validate token")
 
@@ -82,7 +97,7 @@ func (s *svc) GetAccessTokenClaims(ctx context.Context, token string) (infrastru
 	s.logger.Debug().Msg("get access token claims")
 
 	// Check token
-	jwtToken, err := s.decodeAndValidateJWTToken(token)
+	jwtToken, err := s.decodeAndValidateJWTToken(ctx, token)
 	if err != nil {
 		s.logger.Error().Stack().Err(err).Msg("failed to decode and validate token")
 
@@ -172,6 +187,15 @@ func (s *svc) GetAccessTokenClaims(ctx context.Context, token string) (infrastru
 		return infrastructure.AccessTokenClaims{}, infrastructure.ErrInvalidJWTToken
 	}
 
+	sessionID, err := s.getSessionIDFromClaims(claims)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to getting sid claims")
+		return infrastructure.AccessTokenClaims{}, infrastructure.ErrInvalidJWTToken
+	}
+
+	// Tokens issued before 2FA support do not contain this claim
+	isMFASetupRequired, _ := claims["isMfaSetupRequired"].(bool)
+
 	// Check if token has expired
 	if exp.Unix() < time.Now().Unix() {
 		s.logger.Error().Stack().Err(infrastructure.ErrExpiredJWTToken).Msg("expired jwt token")
@@ -179,21 +203,20 @@ func (s *svc) GetAccessTokenClaims(ctx context.Context, token string) (infrastru
 	}
 
 	// Check if token is banned
-	var bannedTokenJTI string
-	err = s.manager.Get(ctx, cachehelper.CreateCacheKey(bannedTokenCachePrefix, jti), &bannedTokenJTI)
-
-	if err != nil && !errors.Is(err, cache.ErrCacheEntryNotFound) {
-		s.logger.Error().Stack().Err(err).Msg("failed to get banned token from cache")
+	exists, err := s.existsBannedToken(ctx, jti)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to check banned token")
 		return infrastructure.AccessTokenClaims{}, err
 	}
 
-	if bannedTokenJTI == jti {
+	if exists {
 		s.logger.Error().Stack().Err(infrastructure.ErrBannedJWTToken).Msg("banned jwt token")
 		return infrastructure.AccessTokenClaims{}, infrastructure.ErrBannedJWTToken
 	}
 
 	return infrastructure.AccessTokenClaims{
 		UserID:         userID,
+		SessionID:      sessionID,
 		Username:       username,
 		Email:          email,
 		Role:           role,
@@ -202,6 +225,8 @@ func (s *svc) GetAccessTokenClaims(ctx context.Context, token string) (infrastru
 		IsDeleted:      isDeleted,
 		JTI:            jti,
 		Exp:            exp.Unix(),
+
+		IsMFASetupRequired: isMFASetupRequired,
 	}, nil
 }
 
@@ -209,7 +234,7 @@ func (s *svc) GetRefreshTokenClaims(ctx context.Context, token string) (infrastr
 	s.logger.Debug().Msg("get refresh token claims")
 
 	// Check token
-	jwtToken, err := s.decodeAndValidateJWTToken(token)
+	jwtToken, err := s.decodeAndValidateJWTToken(ctx, token)
 	if err != nil {
 		s.logger.Error().Stack().Err(err).Msg("failed to decode and validate token")
 
@@ -263,10 +288,16 @@ func (s *svc) GetRefreshTokenClaims(ctx context.Context, token string) (infrastr
 		return infrastructure.RefreshTokenClaims{}, infrastructure.ErrInvalidJWTToken
 	}
 
+	sessionID, err := s.getSessionIDFromClaims(claims)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to getting sid claims")
+		return infrastructure.RefreshTokenClaims{}, infrastructure.ErrInvalidJWTToken
+	}
+
 	// Check if token is banned
 	exists, err := s.existsBannedToken(ctx, jti)
 	if err != nil {
-		s.logger.Error().Stack().Err(err).Msg("failed to get banned token from cache")
+		s.logger.Error().Stack().Err(err).Msg("failed to check banned token")
 		return infrastructure.RefreshTokenClaims{}, err
 	}
 
@@ -276,35 +307,349 @@ func (s *svc) GetRefreshTokenClaims(ctx context.Context, token string) (infrastr
 	}
 
 	return infrastructure.RefreshTokenClaims{
-		UserID: userID,
-		JTI:    jti,
-		Exp:    exp.Unix(),
+		UserID:    userID,
+		SessionID: sessionID,
+		JTI:       jti,
+		Exp:       exp.Unix(),
 	}, nil
 }
 
 func (s *svc) BanToken(ctx context.Context, jti string) error {
 	s.logger.Debug().Msg("ban jwt token")
 
-	return s.manager.SetWithExpiration(
-		ctx,
-		cachehelper.CreateCacheKey(bannedTokenCachePrefix, jti),
-		jti,
-		time.Duration(s.cfg.RefreshTokenTTL)*time.Second,
+	ttl := time.Duration(s.cfg.RefreshTokenTTL) * time.Second
+
+	// Save banned token in DB
+	_, err := s.bannedTokenRepo.CreateBannedToken(
+		ctx, &entity.BannedToken{
+			JTI:       jti,
+			ExpiredAt: time.Now().Add(ttl).Unix(),
+		},
 	)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to save banned token")
+		return err
+	}
+
+	// Save banned token in cache
+	return s.manager.SetWithExpiration(ctx, cachehelper.CreateCacheKey(bannedTokenCachePrefix, jti), jti, ttl)
 }
 
-func (s *svc) GenerateTokens(_ context.Context, userEntity *entity.User) (string, string, error) {
+func (s *svc) GenerateTokens(
+	ctx context.Context,
+	userEntity *entity.User,
+	clientInfo infrastructure.ClientInfo,
+) (string, string, error) {
 	s.logger.Debug().Msg("generate jwt tokens")
 
-	jti := uuid.New().String()
+	// Create session
+	now := time.Now()
+	session := &entity.Session{
+		ID:         uuid.New(),
+		UserID:     userEntity.ID,
+		JTI:        uuid.New().String(),
+		LastUsedAt: now,
+		ExpiredAt:  now.Add(time.Duration(s.cfg.RefreshTokenTTL) * time.Second),
+	}
+	applyClientInfo(session, clientInfo)
+
+	session, err := s.sessionRepo.CreateSession(ctx, session)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to create session")
+		return "", "", err
+	}
+
+	return s.signTokens(ctx, userEntity, session, now)
+}
+
+func (s *svc) RotateTokens(
+	ctx context.Context,
+	userEntity *entity.User,
+	claims infrastructure.RefreshTokenClaims,
+	clientInfo infrastructure.ClientInfo,
+) (string, string, error) {
+	s.logger.Debug().Msg("rotate jwt tokens")
+
+	// Tokens issued before session tracking do not belong to any session,
+	// so the refresh token is banned to keep it single-use
+	if claims.SessionID == uuid.Nil {
+		s.logger.Debug().Msg("refresh token without session, start new session")
+
+		err := s.BanToken(ctx, claims.JTI)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to ban refresh token")
+			return "", "", err
+		}
+
+		return s.GenerateTokens(ctx, userEntity, clientInfo)
+	}
+
+	// Get session
+	session, err := s.findUserSession(ctx, userEntity.ID, claims.SessionID)
+	if err != nil {
+		return "", "", err
+	}
+
+	if session.JTI == claims.JTI {
+		// Rotate session
+		now := time.Now()
+		rotated, err := s.rotateSession(ctx, session, clientInfo, now)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to rotate session")
+			return "", "", err
+		}
+		if rotated {
+			return s.signTokens(ctx, userEntity, session, now)
+		}
+
+		// Session has been rotated by concurrent request
+		session, err = s.findUserSession(ctx, userEntity.ID, claims.SessionID)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	// Concurrent requests with the same token receive the current token pair
+	if s.isRotatedRecently(session, claims.JTI) {
+		s.logger.Debug().Msg("refresh token has been rotated recently")
+		return s.signTokens(ctx, userEntity, session, time.Now())
+	}
+
+	// Refresh token has been already rotated, so it is stolen or replayed
+	s.logger.Warn().Msgf("reused refresh token, revoke session: %s", session.ID.String())
+
+	err = s.revokeSession(ctx, session)
+	if err != nil {
+		return "", "", err
+	}
+
+	return "", "", infrastructure.ErrReusedJWTToken
+}
+
+func (s *svc) RevokeSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error {
+	s.logger.Debug().Msg("revoke session")
+
+	// Get session
+	session, err := s.sessionRepo.FindSessionByID(ctx, sessionID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find session")
+		return err
+	}
+	if session == nil || session.UserID != userID {
+		s.logger.Error().Stack().Err(infrastructure.ErrSessionNotFound).Msg("session not found")
+		return infrastructure.ErrSessionNotFound
+	}
+
+	return s.revokeSession(ctx, session)
+}
+
+func (s *svc) RevokeSessions(ctx context.Context, userID uuid.UUID, exceptSessionID uuid.UUID) error {
+	s.logger.Debug().Msg("revoke sessions")
+
+	// Get sessions
+	sessions, err := s.sessionRepo.FindSessionsByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find sessions")
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.ID == exceptSessionID {
+			continue
+		}
+
+		err = s.revokeSession(ctx, session)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *svc) GenerateMFAToken(ctx context.Context, userEntity *entity.User) (string, error) {
+	s.logger.Debug().Msg("generate mfa token")
+
+	signingMethod, signingKey, kid, err := s.getSigningKey(ctx)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to get signing key")
+		return "", err
+	}
+
+	now := time.Now()
+	mfaToken := jwt.NewWithClaims(
+		signingMethod,
+		jwt.MapClaims{
+			"iss":  jwtIssuer,
+			"sub":  userEntity.ID.String(),
+			"iat":  now.Unix(),
+			"exp":  now.Add(time.Duration(s.cfg.MFATokenTTL) * time.Second).Unix(),
+			"jti":  uuid.New().String(),
+			"type": "mfa",
+		},
+	)
+	if kid != "" {
+		mfaToken.Header["kid"] = kid
+	}
+
+	return mfaToken.SignedString(signingKey)
+}
+
+func (s *svc) GetMFATokenClaims(ctx context.Context, token string) (infrastructure.MFATokenClaims, error) {
+	s.logger.Debug().Msg("get mfa token claims")
+
+	// Check token
+	jwtToken, err := s.decodeAndValidateJWTToken(ctx, token)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to decode and validate token")
+
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return infrastructure.MFATokenClaims{}, infrastructure.ErrExpiredJWTToken
+		}
+		return infrastructure.MFATokenClaims{}, infrastructure.ErrInvalidJWTToken
+	}
+
+	// Get claims
+	claims, err := s.getClaimsFromJWTToken(jwtToken)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to get token claims")
+		return infrastructure.MFATokenClaims{}, infrastructure.ErrInvalidJWTToken
+	}
+
+	// Get token type
+	tokenType, ok := claims["type"].(string)
+	if !ok || tokenType != "mfa" {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidJWTToken).Msg("incorrect token type")
+		return infrastructure.MFATokenClaims{}, infrastructure.ErrInvalidJWTToken
+	}
+
+	// Get all claims
+	sub, err := claims.GetSubject()
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to getting sub claims")
+		return infrastructure.MFATokenClaims{}, infrastructure.ErrInvalidJWTToken
+	}
+
+	userID, err := uuid.Parse(sub)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("invalid UUID sub")
+		return infrastructure.MFATokenClaims{}, infrastructure.ErrInvalidJWTToken
+	}
+
+	exp, err := claims.GetExpirationTime()
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to getting exp claims")
+		return infrastructure.MFATokenClaims{}, infrastructure.ErrInvalidJWTToken
+	}
+
+	jti, ok := claims["jti"].(string)
+	if !ok {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidJWTToken).Msg("failed to getting jti claims")
+		return infrastructure.MFATokenClaims{}, infrastructure.ErrInvalidJWTToken
+	}
+
+	// Check if token is banned, i.e. has been already used
+	exists, err := s.existsBannedToken(ctx, jti)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to check banned token")
+		return infrastructure.MFATokenClaims{}, err
+	}
+
+	if exists {
+		s.logger.Error().Stack().Err(infrastructure.ErrBannedJWTToken).Msg("banned jwt token")
+		return infrastructure.MFATokenClaims{}, infrastructure.ErrBannedJWTToken
+	}
+
+	return infrastructure.MFATokenClaims{
+		UserID: userID,
+		JTI:    jti,
+		Exp:    exp.Unix(),
+	}, nil
+}
+
+// revokeSession bans the current and the previous token pairs of the session and removes it,
+// so that neither access nor refresh token can be used any more
+func (s *svc) revokeSession(ctx context.Context, session *entity.Session) error {
+	err := s.BanToken(ctx, session.JTI)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to ban session token")
+		return err
+	}
+
+	// Access token issued before the last rotation is still valid until it expires
+	if session.PreviousJTI != nil {
+		err = s.BanToken(ctx, *session.PreviousJTI)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to ban previous session token")
+			return err
+		}
+	}
+
+	err = s.sessionRepo.DeleteSessionByID(ctx, session.ID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to delete session")
+		return err
+	}
+
+	return nil
+}
+
+func (s *svc) findUserSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) (*entity.Session, error) {
+	session, err := s.sessionRepo.FindSessionByID(ctx, sessionID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find session")
+		return nil, err
+	}
+	if session == nil || session.UserID != userID {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidJWTToken).Msg("session not found")
+		return nil, infrastructure.ErrInvalidJWTToken
+	}
+
+	return session, nil
+}
+
+func (s *svc) rotateSession(
+	ctx context.Context,
+	session *entity.Session,
+	clientInfo infrastructure.ClientInfo,
+	now time.Time,
+) (bool, error) {
+	oldJTI := session.JTI
+
+	session.PreviousJTI = lo.ToPtr(oldJTI)
+	session.JTI = uuid.New().String()
+	session.LastUsedAt = now
+	session.RotatedAt = lo.ToPtr(now)
+	session.ExpiredAt = now.Add(time.Duration(s.cfg.RefreshTokenTTL) * time.Second)
+	applyClientInfo(session, clientInfo)
+
+	return s.sessionRepo.RotateSession(ctx, session, oldJTI)
+}
+
+func (s *svc) isRotatedRecently(session *entity.Session, jti string) bool {
+	if session.PreviousJTI == nil || *session.PreviousJTI != jti || session.RotatedAt == nil {
+		return false
+	}
+
+	return time.Since(*session.RotatedAt) <= time.Duration(s.cfg.RefreshGracePeriod)*time.Second
+}
+
+func (s *svc) signTokens(ctx context.Context, userEntity *entity.User, session *entity.Session, now time.Time) (string, string, error) {
+	signingMethod, signingKey, kid, err := s.getSigningKey(ctx)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to get signing key")
+		return "", "", err
+	}
+
 	accessToken := jwt.NewWithClaims(
-		jwt.SigningMethodHS256,
+		signingMethod,
 		jwt.MapClaims{
 			"iss":            jwtIssuer,
 			"sub":            userEntity.ID.String(),
-			"iat":            time.Now().Unix(),
-			"exp":            time.Now().Add(time.Duration(s.cfg.AccessTokenTTL) * time.Second).Unix(),
-			"jti":            jti,
+			"sid":            session.ID.String(),
+			"iat":            now.Unix(),
+			"exp":            now.Add(time.Duration(s.cfg.AccessTokenTTL) * time.Second).Unix(),
+			"jti":            session.JTI,
 			"type":           "access",
 			"username":       userEntity.Username,
 			"email":          userEntity.Email,
@@ -312,25 +657,33 @@ func (s *svc) GenerateTokens(_ context.Context, userEntity *entity.User) (string
 			"IsPasswordTemp": userEntity.IsPasswordTemp,
 			"isEnabled":      userEntity.IsEnabled,
 			"isDeleted":      userEntity.DeletedAt != nil,
+
+			"isMfaSetupRequired": userEntity.Role.IsMFARequired && !userEntity.IsTOTPEnabled,
 		},
 	)
 	refreshToken := jwt.NewWithClaims(
-		jwt.SigningMethodHS256,
+		signingMethod,
 		jwt.MapClaims{
 			"iss":  jwtIssuer,
 			"sub":  userEntity.ID.String(),
-			"iat":  time.Now().Unix(),
-			"exp":  time.Now().Add(time.Duration(s.cfg.RefreshTokenTTL) * time.Second).Unix(),
-			"jti":  jti,
+			"sid":  session.ID.String(),
+			"iat":  now.Unix(),
+			"exp":  session.ExpiredAt.Unix(),
+			"jti":  session.JTI,
 			"type": "refresh",
 		},
 	)
-	accessTokenSigned, err := accessToken.SignedString([]byte(s.cfg.Secret))
+	if kid != "" {
+		accessToken.Header["kid"] = kid
+		refreshToken.Header["kid"] = kid
+	}
+
+	accessTokenSigned, err := accessToken.SignedString(signingKey)
 	if err != nil {
 		return "", "", err
 	}
 
-	refreshTokenSigned, err := refreshToken.SignedString([]byte(s.cfg.Secret))
+	refreshTokenSigned, err := refreshToken.SignedString(signingKey)
 	if err != nil {
 		return "", "", err
 	}
@@ -338,15 +691,21 @@ func (s *svc) GenerateTokens(_ context.Context, userEntity *entity.User) (string
 	return accessTokenSigned, refreshTokenSigned, nil
 }
 
-func (s *svc) decodeAndValidateJWTToken(token string) (*jwt.Token, error) {
+func (s *svc) decodeAndValidateJWTToken(ctx context.Context, token string) (*jwt.Token, error) {
+	// Tokens signed with the secret remain valid after switching to asymmetric keys
+	validMethods := []string{s.cfg.Algorithm}
+	if s.cfg.Algorithm != jwt.SigningMethodHS256.Alg() && s.cfg.Secret != "" {
+		validMethods = append(validMethods, jwt.SigningMethodHS256.Alg())
+	}
+
 	jwtToken, err := jwt.Parse(
 		token,
-		s.getJWTToken(),
+		s.getJWTToken(ctx),
 		jwt.WithIssuer(jwtIssuer),
 		jwt.WithIssuedAt(),
 		jwt.WithExpirationRequired(),
 		jwt.WithStrictDecoding(),
-		jwt.WithValidMethods([]string{"HS256"}),
+		jwt.WithValidMethods(validMethods),
 	)
 	if err != nil {
 		return nil, err
@@ -390,26 +749,105 @@ func (s *svc) getClaimsFromJWTToken(token *jwt.Token) (jwt.MapClaims, error) {
 	return claims, nil
 }
 
-func (s *svc) getJWTToken() jwt.Keyfunc {
+func (s *svc) getJWTToken(ctx context.Context) jwt.Keyfunc {
 	return func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+			return []byte(s.cfg.Secret), nil
+		}
+
+		kid, ok := token.Header["kid"].(string)
+		if !ok || kid == "" {
+			return nil, errors.New("kid is empty")
+		}
+
+		key, err := s.jwkService.GetVerificationKey(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if key.Algorithm != token.Method.Alg() {
 			return nil, infrastructure.ErrInvalidJWTToken
 		}
-		return []byte(s.cfg.Secret), nil
+
+		return key.PublicKey, nil
+	}
+}
+
+// getSigningKey returns signing method, key and key ID for configured algorithm.
+// Key ID is empty for symmetric algorithm
+func (s *svc) getSigningKey(ctx context.Context) (jwt.SigningMethod, any, string, error) {
+	if s.cfg.Algorithm == jwt.SigningMethodHS256.Alg() {
+		return jwt.SigningMethodHS256, []byte(s.cfg.Secret), "", nil
+	}
+
+	signingMethod := jwt.GetSigningMethod(s.cfg.Algorithm)
+	if signingMethod == nil {
+		return nil, nil, "", errors.New("unsupported signing algorithm")
 	}
+
+	key, err := s.jwkService.GetSigningKey(ctx)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return signingMethod, key.PrivateKey, key.ID, nil
+}
+
+func (s *svc) getSessionIDFromClaims(claims jwt.MapClaims) (uuid.UUID, error) {
+	sid, ok := claims["sid"]
+	if !ok {
+		return uuid.Nil, nil
+	}
+
+	sidStr, ok := sid.(string)
+	if !ok {
+		return uuid.Nil, errors.New("sid is not a string")
+	}
+
+	return uuid.Parse(sidStr)
 }
 
 func (s *svc) existsBannedToken(ctx context.Context, jti string) (bool, error) {
+	cacheKey := cachehelper.CreateCacheKey(bannedTokenCachePrefix, jti)
+
+	// Check cache
 	var bannedTokenJTI string
-	err := s.manager.Get(ctx, cachehelper.CreateCacheKey(bannedTokenCachePrefix, jti), &bannedTokenJTI)
+	err := s.manager.Get(ctx, cacheKey, &bannedTokenJTI)
+	if err == nil {
+		return bannedTokenJTI == jti, nil
+	}
+	if !errors.Is(err, cache.ErrCacheEntryNotFound) {
+		return false, err
+	}
 
-	if errors.Is(err, cache.ErrCacheEntryNotFound) {
+	// Check DB
+	exists, err := s.bannedTokenRepo.ExistsBannedTokenByJTI(ctx, jti)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
 		return false, nil
 	}
 
+	// Restore cache entry
+	err = s.manager.SetWithExpiration(ctx, cacheKey, jti, time.Duration(s.cfg.RefreshTokenTTL)*time.Second)
 	if err != nil {
-		return false, err
+		s.logger.Warn().Err(err).Msg("failed to save banned token in cache")
 	}
 
 	return true, nil
 }
+
+func applyClientInfo(session *entity.Session, clientInfo infrastructure.ClientInfo) {
+	if clientInfo.IP != "" {
+		session.IP = lo.ToPtr(clientInfo.IP)
+	}
+	if clientInfo.UserAgent != "" {
+		session.UserAgent = lo.ToPtr(clientInfo.UserAgent)
+	}
+	if clientInfo.DeviceName != "" {
+		session.DeviceName = lo.ToPtr(clientInfo.DeviceName)
+	}
+	if clientInfo.Location != "" {
+		session.Location = lo.ToPtr(clientInfo.Location)
+	}
+}
@@ -0,0 +1,151 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	infrastructure "github.com/mandarine-io/backend/internal/service/infrastructure"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// APIKeyServiceMock is an autogenerated mock type for the APIKeyService type
+type APIKeyServiceMock struct {
+	mock.Mock
+}
+
+type APIKeyServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *APIKeyServiceMock) EXPECT() *APIKeyServiceMock_Expecter {
+	return &APIKeyServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// GenerateKey provides a mock function with given fields: ctx
+func (_m *APIKeyServiceMock) GenerateKey(ctx context.Context) (infrastructure.GeneratedAPIKey, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateKey")
+	}
+
+	var r0 infrastructure.GeneratedAPIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (infrastructure.GeneratedAPIKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) infrastructure.GeneratedAPIKey); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(infrastructure.GeneratedAPIKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyServiceMock_GenerateKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateKey'
+type APIKeyServiceMock_GenerateKey_Call struct {
+	*mock.Call
+}
+
+// GenerateKey is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *APIKeyServiceMock_Expecter) GenerateKey(ctx interface{}) *APIKeyServiceMock_GenerateKey_Call {
+	return &APIKeyServiceMock_GenerateKey_Call{Call: _e.mock.On("GenerateKey", ctx)}
+}
+
+func (_c *APIKeyServiceMock_GenerateKey_Call) Run(run func(ctx context.Context)) *APIKeyServiceMock_GenerateKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *APIKeyServiceMock_GenerateKey_Call) Return(_a0 infrastructure.GeneratedAPIKey, _a1 error) *APIKeyServiceMock_GenerateKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyServiceMock_GenerateKey_Call) RunAndReturn(run func(context.Context) (infrastructure.GeneratedAPIKey, error)) *APIKeyServiceMock_GenerateKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPIKeyClaims provides a mock function with given fields: ctx, key, ip
+func (_m *APIKeyServiceMock) GetAPIKeyClaims(ctx context.Context, key string, ip string) (infrastructure.APIKeyClaims, error) {
+	ret := _m.Called(ctx, key, ip)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAPIKeyClaims")
+	}
+
+	var r0 infrastructure.APIKeyClaims
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (infrastructure.APIKeyClaims, error)); ok {
+		return rf(ctx, key, ip)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) infrastructure.APIKeyClaims); ok {
+		r0 = rf(ctx, key, ip)
+	} else {
+		r0 = ret.Get(0).(infrastructure.APIKeyClaims)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, key, ip)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIKeyServiceMock_GetAPIKeyClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKeyClaims'
+type APIKeyServiceMock_GetAPIKeyClaims_Call struct {
+	*mock.Call
+}
+
+// GetAPIKeyClaims is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+//   - ip string
+func (_e *APIKeyServiceMock_Expecter) GetAPIKeyClaims(ctx interface{}, key interface{}, ip interface{}) *APIKeyServiceMock_GetAPIKeyClaims_Call {
+	return &APIKeyServiceMock_GetAPIKeyClaims_Call{Call: _e.mock.On("GetAPIKeyClaims", ctx, key, ip)}
+}
+
+func (_c *APIKeyServiceMock_GetAPIKeyClaims_Call) Run(run func(ctx context.Context, key string, ip string)) *APIKeyServiceMock_GetAPIKeyClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *APIKeyServiceMock_GetAPIKeyClaims_Call) Return(_a0 infrastructure.APIKeyClaims, _a1 error) *APIKeyServiceMock_GetAPIKeyClaims_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *APIKeyServiceMock_GetAPIKeyClaims_Call) RunAndReturn(run func(context.Context, string, string) (infrastructure.APIKeyClaims, error)) *APIKeyServiceMock_GetAPIKeyClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAPIKeyServiceMock creates a new instance of APIKeyServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIKeyServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIKeyServiceMock {
+	mock := &APIKeyServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
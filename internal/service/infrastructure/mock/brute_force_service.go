@@ -0,0 +1,178 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	infrastructure "github.com/mandarine-io/backend/internal/service/infrastructure"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BruteForceServiceMock is an autogenerated mock type for the BruteForceService type
+type BruteForceServiceMock struct {
+	mock.Mock
+}
+
+type BruteForceServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *BruteForceServiceMock) EXPECT() *BruteForceServiceMock_Expecter {
+	return &BruteForceServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// CheckAttempt provides a mock function with given fields: ctx, attempt
+func (_m *BruteForceServiceMock) CheckAttempt(ctx context.Context, attempt infrastructure.Attempt) error {
+	ret := _m.Called(ctx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckAttempt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, infrastructure.Attempt) error); ok {
+		r0 = rf(ctx, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BruteForceServiceMock_CheckAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckAttempt'
+type BruteForceServiceMock_CheckAttempt_Call struct {
+	*mock.Call
+}
+
+// CheckAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attempt infrastructure.Attempt
+func (_e *BruteForceServiceMock_Expecter) CheckAttempt(ctx interface{}, attempt interface{}) *BruteForceServiceMock_CheckAttempt_Call {
+	return &BruteForceServiceMock_CheckAttempt_Call{Call: _e.mock.On("CheckAttempt", ctx, attempt)}
+}
+
+func (_c *BruteForceServiceMock_CheckAttempt_Call) Run(run func(ctx context.Context, attempt infrastructure.Attempt)) *BruteForceServiceMock_CheckAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(infrastructure.Attempt))
+	})
+	return _c
+}
+
+func (_c *BruteForceServiceMock_CheckAttempt_Call) Return(_a0 error) *BruteForceServiceMock_CheckAttempt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BruteForceServiceMock_CheckAttempt_Call) RunAndReturn(run func(context.Context, infrastructure.Attempt) error) *BruteForceServiceMock_CheckAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FailAttempt provides a mock function with given fields: ctx, attempt
+func (_m *BruteForceServiceMock) FailAttempt(ctx context.Context, attempt infrastructure.Attempt) error {
+	ret := _m.Called(ctx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailAttempt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, infrastructure.Attempt) error); ok {
+		r0 = rf(ctx, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BruteForceServiceMock_FailAttempt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FailAttempt'
+type BruteForceServiceMock_FailAttempt_Call struct {
+	*mock.Call
+}
+
+// FailAttempt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attempt infrastructure.Attempt
+func (_e *BruteForceServiceMock_Expecter) FailAttempt(ctx interface{}, attempt interface{}) *BruteForceServiceMock_FailAttempt_Call {
+	return &BruteForceServiceMock_FailAttempt_Call{Call: _e.mock.On("FailAttempt", ctx, attempt)}
+}
+
+func (_c *BruteForceServiceMock_FailAttempt_Call) Run(run func(ctx context.Context, attempt infrastructure.Attempt)) *BruteForceServiceMock_FailAttempt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(infrastructure.Attempt))
+	})
+	return _c
+}
+
+func (_c *BruteForceServiceMock_FailAttempt_Call) Return(_a0 error) *BruteForceServiceMock_FailAttempt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BruteForceServiceMock_FailAttempt_Call) RunAndReturn(run func(context.Context, infrastructure.Attempt) error) *BruteForceServiceMock_FailAttempt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetAttempts provides a mock function with given fields: ctx, attempt
+func (_m *BruteForceServiceMock) ResetAttempts(ctx context.Context, attempt infrastructure.Attempt) error {
+	ret := _m.Called(ctx, attempt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetAttempts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, infrastructure.Attempt) error); ok {
+		r0 = rf(ctx, attempt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BruteForceServiceMock_ResetAttempts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetAttempts'
+type BruteForceServiceMock_ResetAttempts_Call struct {
+	*mock.Call
+}
+
+// ResetAttempts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attempt infrastructure.Attempt
+func (_e *BruteForceServiceMock_Expecter) ResetAttempts(ctx interface{}, attempt interface{}) *BruteForceServiceMock_ResetAttempts_Call {
+	return &BruteForceServiceMock_ResetAttempts_Call{Call: _e.mock.On("ResetAttempts", ctx, attempt)}
+}
+
+func (_c *BruteForceServiceMock_ResetAttempts_Call) Run(run func(ctx context.Context, attempt infrastructure.Attempt)) *BruteForceServiceMock_ResetAttempts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(infrastructure.Attempt))
+	})
+	return _c
+}
+
+func (_c *BruteForceServiceMock_ResetAttempts_Call) Return(_a0 error) *BruteForceServiceMock_ResetAttempts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *BruteForceServiceMock_ResetAttempts_Call) RunAndReturn(run func(context.Context, infrastructure.Attempt) error) *BruteForceServiceMock_ResetAttempts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewBruteForceServiceMock creates a new instance of BruteForceServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBruteForceServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BruteForceServiceMock {
+	mock := &BruteForceServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,254 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	infrastructure "github.com/mandarine-io/backend/internal/service/infrastructure"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// JWKServiceMock is an autogenerated mock type for the JWKService type
+type JWKServiceMock struct {
+	mock.Mock
+}
+
+type JWKServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *JWKServiceMock) EXPECT() *JWKServiceMock_Expecter {
+	return &JWKServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// GetPublicKeys provides a mock function with given fields: ctx
+func (_m *JWKServiceMock) GetPublicKeys(ctx context.Context) ([]infrastructure.SigningKey, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPublicKeys")
+	}
+
+	var r0 []infrastructure.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]infrastructure.SigningKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []infrastructure.SigningKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]infrastructure.SigningKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JWKServiceMock_GetPublicKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPublicKeys'
+type JWKServiceMock_GetPublicKeys_Call struct {
+	*mock.Call
+}
+
+// GetPublicKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *JWKServiceMock_Expecter) GetPublicKeys(ctx interface{}) *JWKServiceMock_GetPublicKeys_Call {
+	return &JWKServiceMock_GetPublicKeys_Call{Call: _e.mock.On("GetPublicKeys", ctx)}
+}
+
+func (_c *JWKServiceMock_GetPublicKeys_Call) Run(run func(ctx context.Context)) *JWKServiceMock_GetPublicKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *JWKServiceMock_GetPublicKeys_Call) Return(_a0 []infrastructure.SigningKey, _a1 error) *JWKServiceMock_GetPublicKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JWKServiceMock_GetPublicKeys_Call) RunAndReturn(run func(context.Context) ([]infrastructure.SigningKey, error)) *JWKServiceMock_GetPublicKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSigningKey provides a mock function with given fields: ctx
+func (_m *JWKServiceMock) GetSigningKey(ctx context.Context) (infrastructure.SigningKey, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSigningKey")
+	}
+
+	var r0 infrastructure.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (infrastructure.SigningKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) infrastructure.SigningKey); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(infrastructure.SigningKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JWKServiceMock_GetSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSigningKey'
+type JWKServiceMock_GetSigningKey_Call struct {
+	*mock.Call
+}
+
+// GetSigningKey is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *JWKServiceMock_Expecter) GetSigningKey(ctx interface{}) *JWKServiceMock_GetSigningKey_Call {
+	return &JWKServiceMock_GetSigningKey_Call{Call: _e.mock.On("GetSigningKey", ctx)}
+}
+
+func (_c *JWKServiceMock_GetSigningKey_Call) Run(run func(ctx context.Context)) *JWKServiceMock_GetSigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *JWKServiceMock_GetSigningKey_Call) Return(_a0 infrastructure.SigningKey, _a1 error) *JWKServiceMock_GetSigningKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JWKServiceMock_GetSigningKey_Call) RunAndReturn(run func(context.Context) (infrastructure.SigningKey, error)) *JWKServiceMock_GetSigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVerificationKey provides a mock function with given fields: ctx, kid
+func (_m *JWKServiceMock) GetVerificationKey(ctx context.Context, kid string) (infrastructure.SigningKey, error) {
+	ret := _m.Called(ctx, kid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVerificationKey")
+	}
+
+	var r0 infrastructure.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (infrastructure.SigningKey, error)); ok {
+		return rf(ctx, kid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) infrastructure.SigningKey); ok {
+		r0 = rf(ctx, kid)
+	} else {
+		r0 = ret.Get(0).(infrastructure.SigningKey)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, kid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JWKServiceMock_GetVerificationKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVerificationKey'
+type JWKServiceMock_GetVerificationKey_Call struct {
+	*mock.Call
+}
+
+// GetVerificationKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - kid string
+func (_e *JWKServiceMock_Expecter) GetVerificationKey(ctx interface{}, kid interface{}) *JWKServiceMock_GetVerificationKey_Call {
+	return &JWKServiceMock_GetVerificationKey_Call{Call: _e.mock.On("GetVerificationKey", ctx, kid)}
+}
+
+func (_c *JWKServiceMock_GetVerificationKey_Call) Run(run func(ctx context.Context, kid string)) *JWKServiceMock_GetVerificationKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *JWKServiceMock_GetVerificationKey_Call) Return(_a0 infrastructure.SigningKey, _a1 error) *JWKServiceMock_GetVerificationKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JWKServiceMock_GetVerificationKey_Call) RunAndReturn(run func(context.Context, string) (infrastructure.SigningKey, error)) *JWKServiceMock_GetVerificationKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateKeys provides a mock function with given fields: ctx
+func (_m *JWKServiceMock) RotateKeys(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JWKServiceMock_RotateKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateKeys'
+type JWKServiceMock_RotateKeys_Call struct {
+	*mock.Call
+}
+
+// RotateKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *JWKServiceMock_Expecter) RotateKeys(ctx interface{}) *JWKServiceMock_RotateKeys_Call {
+	return &JWKServiceMock_RotateKeys_Call{Call: _e.mock.On("RotateKeys", ctx)}
+}
+
+func (_c *JWKServiceMock_RotateKeys_Call) Run(run func(ctx context.Context)) *JWKServiceMock_RotateKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *JWKServiceMock_RotateKeys_Call) Return(_a0 error) *JWKServiceMock_RotateKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JWKServiceMock_RotateKeys_Call) RunAndReturn(run func(context.Context) error) *JWKServiceMock_RotateKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewJWKServiceMock creates a new instance of JWKServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJWKServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JWKServiceMock {
+	mock := &JWKServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
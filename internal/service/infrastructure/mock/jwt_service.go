@@ -9,6 +9,8 @@ import (
 	infrastructure "github.com/mandarine-io/backend/internal/service/infrastructure"
 
 	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
 )
 
 // JWTServiceMock is an autogenerated mock type for the JWTService type
@@ -71,18 +73,17 @@ func (_c *JWTServiceMock_BanToken_Call) RunAndReturn(run func(context.Context, s
 	return _c
 }
 
-// GenerateTokens provides a mock function with given fields: ctx, userEntity
-func (_m *JWTServiceMock) GenerateTokens(ctx context.Context, userEntity *entity.User) (string, string, error) {
+// GenerateMFAToken provides a mock function with given fields: ctx, userEntity
+func (_m *JWTServiceMock) GenerateMFAToken(ctx context.Context, userEntity *entity.User) (string, error) {
 	ret := _m.Called(ctx, userEntity)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GenerateTokens")
+		panic("no return value specified for GenerateMFAToken")
 	}
 
 	var r0 string
-	var r1 string
-	var r2 error
-	if rf, ok := ret.Get(0).(func(context.Context, *entity.User) (string, string, error)); ok {
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.User) (string, error)); ok {
 		return rf(ctx, userEntity)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, *entity.User) string); ok {
@@ -91,14 +92,72 @@ func (_m *JWTServiceMock) GenerateTokens(ctx context.Context, userEntity *entity
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *entity.User) string); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.User) error); ok {
 		r1 = rf(ctx, userEntity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JWTServiceMock_GenerateMFAToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateMFAToken'
+type JWTServiceMock_GenerateMFAToken_Call struct {
+	*mock.Call
+}
+
+// GenerateMFAToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userEntity *entity.User
+func (_e *JWTServiceMock_Expecter) GenerateMFAToken(ctx interface{}, userEntity interface{}) *JWTServiceMock_GenerateMFAToken_Call {
+	return &JWTServiceMock_GenerateMFAToken_Call{Call: _e.mock.On("GenerateMFAToken", ctx, userEntity)}
+}
+
+func (_c *JWTServiceMock_GenerateMFAToken_Call) Run(run func(ctx context.Context, userEntity *entity.User)) *JWTServiceMock_GenerateMFAToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.User))
+	})
+	return _c
+}
+
+func (_c *JWTServiceMock_GenerateMFAToken_Call) Return(_a0 string, _a1 error) *JWTServiceMock_GenerateMFAToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JWTServiceMock_GenerateMFAToken_Call) RunAndReturn(run func(context.Context, *entity.User) (string, error)) *JWTServiceMock_GenerateMFAToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateTokens provides a mock function with given fields: ctx, userEntity, clientInfo
+func (_m *JWTServiceMock) GenerateTokens(ctx context.Context, userEntity *entity.User, clientInfo infrastructure.ClientInfo) (string, string, error) {
+	ret := _m.Called(ctx, userEntity, clientInfo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateTokens")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.User, infrastructure.ClientInfo) (string, string, error)); ok {
+		return rf(ctx, userEntity, clientInfo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.User, infrastructure.ClientInfo) string); ok {
+		r0 = rf(ctx, userEntity, clientInfo)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.User, infrastructure.ClientInfo) string); ok {
+		r1 = rf(ctx, userEntity, clientInfo)
 	} else {
 		r1 = ret.Get(1).(string)
 	}
 
-	if rf, ok := ret.Get(2).(func(context.Context, *entity.User) error); ok {
-		r2 = rf(ctx, userEntity)
+	if rf, ok := ret.Get(2).(func(context.Context, *entity.User, infrastructure.ClientInfo) error); ok {
+		r2 = rf(ctx, userEntity, clientInfo)
 	} else {
 		r2 = ret.Error(2)
 	}
@@ -114,13 +173,14 @@ type JWTServiceMock_GenerateTokens_Call struct {
 // GenerateTokens is a helper method to define mock.On call
 //   - ctx context.Context
 //   - userEntity *entity.User
-func (_e *JWTServiceMock_Expecter) GenerateTokens(ctx interface{}, userEntity interface{}) *JWTServiceMock_GenerateTokens_Call {
-	return &JWTServiceMock_GenerateTokens_Call{Call: _e.mock.On("GenerateTokens", ctx, userEntity)}
+//   - clientInfo infrastructure.ClientInfo
+func (_e *JWTServiceMock_Expecter) GenerateTokens(ctx interface{}, userEntity interface{}, clientInfo interface{}) *JWTServiceMock_GenerateTokens_Call {
+	return &JWTServiceMock_GenerateTokens_Call{Call: _e.mock.On("GenerateTokens", ctx, userEntity, clientInfo)}
 }
 
-func (_c *JWTServiceMock_GenerateTokens_Call) Run(run func(ctx context.Context, userEntity *entity.User)) *JWTServiceMock_GenerateTokens_Call {
+func (_c *JWTServiceMock_GenerateTokens_Call) Run(run func(ctx context.Context, userEntity *entity.User, clientInfo infrastructure.ClientInfo)) *JWTServiceMock_GenerateTokens_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*entity.User))
+		run(args[0].(context.Context), args[1].(*entity.User), args[2].(infrastructure.ClientInfo))
 	})
 	return _c
 }
@@ -130,7 +190,7 @@ func (_c *JWTServiceMock_GenerateTokens_Call) Return(_a0 string, _a1 string, _a2
 	return _c
 }
 
-func (_c *JWTServiceMock_GenerateTokens_Call) RunAndReturn(run func(context.Context, *entity.User) (string, string, error)) *JWTServiceMock_GenerateTokens_Call {
+func (_c *JWTServiceMock_GenerateTokens_Call) RunAndReturn(run func(context.Context, *entity.User, infrastructure.ClientInfo) (string, string, error)) *JWTServiceMock_GenerateTokens_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -192,6 +252,63 @@ func (_c *JWTServiceMock_GetAccessTokenClaims_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// GetMFATokenClaims provides a mock function with given fields: ctx, token
+func (_m *JWTServiceMock) GetMFATokenClaims(ctx context.Context, token string) (infrastructure.MFATokenClaims, error) {
+	ret := _m.Called(ctx, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMFATokenClaims")
+	}
+
+	var r0 infrastructure.MFATokenClaims
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (infrastructure.MFATokenClaims, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) infrastructure.MFATokenClaims); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(infrastructure.MFATokenClaims)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// JWTServiceMock_GetMFATokenClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMFATokenClaims'
+type JWTServiceMock_GetMFATokenClaims_Call struct {
+	*mock.Call
+}
+
+// GetMFATokenClaims is a helper method to define mock.On call
+//   - ctx context.Context
+//   - token string
+func (_e *JWTServiceMock_Expecter) GetMFATokenClaims(ctx interface{}, token interface{}) *JWTServiceMock_GetMFATokenClaims_Call {
+	return &JWTServiceMock_GetMFATokenClaims_Call{Call: _e.mock.On("GetMFATokenClaims", ctx, token)}
+}
+
+func (_c *JWTServiceMock_GetMFATokenClaims_Call) Run(run func(ctx context.Context, token string)) *JWTServiceMock_GetMFATokenClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *JWTServiceMock_GetMFATokenClaims_Call) Return(_a0 infrastructure.MFATokenClaims, _a1 error) *JWTServiceMock_GetMFATokenClaims_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *JWTServiceMock_GetMFATokenClaims_Call) RunAndReturn(run func(context.Context, string) (infrastructure.MFATokenClaims, error)) *JWTServiceMock_GetMFATokenClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRefreshTokenClaims provides a mock function with given fields: ctx, token
 func (_m *JWTServiceMock) GetRefreshTokenClaims(ctx context.Context, token string) (infrastructure.RefreshTokenClaims, error) {
 	ret := _m.Called(ctx, token)
@@ -306,6 +423,168 @@ func (_c *JWTServiceMock_GetTypeToken_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// RevokeSession provides a mock function with given fields: ctx, userID, sessionID
+func (_m *JWTServiceMock) RevokeSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JWTServiceMock_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type JWTServiceMock_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - sessionID uuid.UUID
+func (_e *JWTServiceMock_Expecter) RevokeSession(ctx interface{}, userID interface{}, sessionID interface{}) *JWTServiceMock_RevokeSession_Call {
+	return &JWTServiceMock_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, userID, sessionID)}
+}
+
+func (_c *JWTServiceMock_RevokeSession_Call) Run(run func(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID)) *JWTServiceMock_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *JWTServiceMock_RevokeSession_Call) Return(_a0 error) *JWTServiceMock_RevokeSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JWTServiceMock_RevokeSession_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *JWTServiceMock_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSessions provides a mock function with given fields: ctx, userID, exceptSessionID
+func (_m *JWTServiceMock) RevokeSessions(ctx context.Context, userID uuid.UUID, exceptSessionID uuid.UUID) error {
+	ret := _m.Called(ctx, userID, exceptSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSessions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID, exceptSessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// JWTServiceMock_RevokeSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSessions'
+type JWTServiceMock_RevokeSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeSessions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+//   - exceptSessionID uuid.UUID
+func (_e *JWTServiceMock_Expecter) RevokeSessions(ctx interface{}, userID interface{}, exceptSessionID interface{}) *JWTServiceMock_RevokeSessions_Call {
+	return &JWTServiceMock_RevokeSessions_Call{Call: _e.mock.On("RevokeSessions", ctx, userID, exceptSessionID)}
+}
+
+func (_c *JWTServiceMock_RevokeSessions_Call) Run(run func(ctx context.Context, userID uuid.UUID, exceptSessionID uuid.UUID)) *JWTServiceMock_RevokeSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID), args[2].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *JWTServiceMock_RevokeSessions_Call) Return(_a0 error) *JWTServiceMock_RevokeSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *JWTServiceMock_RevokeSessions_Call) RunAndReturn(run func(context.Context, uuid.UUID, uuid.UUID) error) *JWTServiceMock_RevokeSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateTokens provides a mock function with given fields: ctx, userEntity, claims, clientInfo
+func (_m *JWTServiceMock) RotateTokens(ctx context.Context, userEntity *entity.User, claims infrastructure.RefreshTokenClaims, clientInfo infrastructure.ClientInfo) (string, string, error) {
+	ret := _m.Called(ctx, userEntity, claims, clientInfo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RotateTokens")
+	}
+
+	var r0 string
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.User, infrastructure.RefreshTokenClaims, infrastructure.ClientInfo) (string, string, error)); ok {
+		return rf(ctx, userEntity, claims, clientInfo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.User, infrastructure.RefreshTokenClaims, infrastructure.ClientInfo) string); ok {
+		r0 = rf(ctx, userEntity, claims, clientInfo)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.User, infrastructure.RefreshTokenClaims, infrastructure.ClientInfo) string); ok {
+		r1 = rf(ctx, userEntity, claims, clientInfo)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *entity.User, infrastructure.RefreshTokenClaims, infrastructure.ClientInfo) error); ok {
+		r2 = rf(ctx, userEntity, claims, clientInfo)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// JWTServiceMock_RotateTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateTokens'
+type JWTServiceMock_RotateTokens_Call struct {
+	*mock.Call
+}
+
+// RotateTokens is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userEntity *entity.User
+//   - claims infrastructure.RefreshTokenClaims
+//   - clientInfo infrastructure.ClientInfo
+func (_e *JWTServiceMock_Expecter) RotateTokens(ctx interface{}, userEntity interface{}, claims interface{}, clientInfo interface{}) *JWTServiceMock_RotateTokens_Call {
+	return &JWTServiceMock_RotateTokens_Call{Call: _e.mock.On("RotateTokens", ctx, userEntity, claims, clientInfo)}
+}
+
+func (_c *JWTServiceMock_RotateTokens_Call) Run(run func(ctx context.Context, userEntity *entity.User, claims infrastructure.RefreshTokenClaims, clientInfo infrastructure.ClientInfo)) *JWTServiceMock_RotateTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.User), args[2].(infrastructure.RefreshTokenClaims), args[3].(infrastructure.ClientInfo))
+	})
+	return _c
+}
+
+func (_c *JWTServiceMock_RotateTokens_Call) Return(_a0 string, _a1 string, _a2 error) *JWTServiceMock_RotateTokens_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *JWTServiceMock_RotateTokens_Call) RunAndReturn(run func(context.Context, *entity.User, infrastructure.RefreshTokenClaims, infrastructure.ClientInfo) (string, string, error)) *JWTServiceMock_RotateTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewJWTServiceMock creates a new instance of JWTServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewJWTServiceMock(t interface {
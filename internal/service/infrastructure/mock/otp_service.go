@@ -6,6 +6,8 @@ import (
 	context "context"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // OTPServiceMock is an autogenerated mock type for the OTPService type
@@ -21,6 +23,104 @@ func (_m *OTPServiceMock) EXPECT() *OTPServiceMock_Expecter {
 	return &OTPServiceMock_Expecter{mock: &_m.Mock}
 }
 
+// ConsumeDataByToken provides a mock function with given fields: ctx, prefix, token, data
+func (_m *OTPServiceMock) ConsumeDataByToken(ctx context.Context, prefix string, token string, data any) error {
+	ret := _m.Called(ctx, prefix, token, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeDataByToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, any) error); ok {
+		r0 = rf(ctx, prefix, token, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OTPServiceMock_ConsumeDataByToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeDataByToken'
+type OTPServiceMock_ConsumeDataByToken_Call struct {
+	*mock.Call
+}
+
+// ConsumeDataByToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - token string
+//   - data any
+func (_e *OTPServiceMock_Expecter) ConsumeDataByToken(ctx interface{}, prefix interface{}, token interface{}, data interface{}) *OTPServiceMock_ConsumeDataByToken_Call {
+	return &OTPServiceMock_ConsumeDataByToken_Call{Call: _e.mock.On("ConsumeDataByToken", ctx, prefix, token, data)}
+}
+
+func (_c *OTPServiceMock_ConsumeDataByToken_Call) Run(run func(ctx context.Context, prefix string, token string, data any)) *OTPServiceMock_ConsumeDataByToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(any))
+	})
+	return _c
+}
+
+func (_c *OTPServiceMock_ConsumeDataByToken_Call) Return(_a0 error) *OTPServiceMock_ConsumeDataByToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OTPServiceMock_ConsumeDataByToken_Call) RunAndReturn(run func(context.Context, string, string, any) error) *OTPServiceMock_ConsumeDataByToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsumeToken provides a mock function with given fields: ctx, prefix, token, ttl
+func (_m *OTPServiceMock) ConsumeToken(ctx context.Context, prefix string, token string, ttl time.Duration) error {
+	ret := _m.Called(ctx, prefix, token, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) error); ok {
+		r0 = rf(ctx, prefix, token, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OTPServiceMock_ConsumeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeToken'
+type OTPServiceMock_ConsumeToken_Call struct {
+	*mock.Call
+}
+
+// ConsumeToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - token string
+//   - ttl time.Duration
+func (_e *OTPServiceMock_Expecter) ConsumeToken(ctx interface{}, prefix interface{}, token interface{}, ttl interface{}) *OTPServiceMock_ConsumeToken_Call {
+	return &OTPServiceMock_ConsumeToken_Call{Call: _e.mock.On("ConsumeToken", ctx, prefix, token, ttl)}
+}
+
+func (_c *OTPServiceMock_ConsumeToken_Call) Run(run func(ctx context.Context, prefix string, token string, ttl time.Duration)) *OTPServiceMock_ConsumeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *OTPServiceMock_ConsumeToken_Call) Return(_a0 error) *OTPServiceMock_ConsumeToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OTPServiceMock_ConsumeToken_Call) RunAndReturn(run func(context.Context, string, string, time.Duration) error) *OTPServiceMock_ConsumeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteDataByCode provides a mock function with given fields: ctx, prefix, code
 func (_m *OTPServiceMock) DeleteDataByCode(ctx context.Context, prefix string, code string) error {
 	ret := _m.Called(ctx, prefix, code)
@@ -69,6 +169,54 @@ func (_c *OTPServiceMock_DeleteDataByCode_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// DeleteDataBySubject provides a mock function with given fields: ctx, prefix, subject
+func (_m *OTPServiceMock) DeleteDataBySubject(ctx context.Context, prefix string, subject string) error {
+	ret := _m.Called(ctx, prefix, subject)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteDataBySubject")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, prefix, subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OTPServiceMock_DeleteDataBySubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteDataBySubject'
+type OTPServiceMock_DeleteDataBySubject_Call struct {
+	*mock.Call
+}
+
+// DeleteDataBySubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - subject string
+func (_e *OTPServiceMock_Expecter) DeleteDataBySubject(ctx interface{}, prefix interface{}, subject interface{}) *OTPServiceMock_DeleteDataBySubject_Call {
+	return &OTPServiceMock_DeleteDataBySubject_Call{Call: _e.mock.On("DeleteDataBySubject", ctx, prefix, subject)}
+}
+
+func (_c *OTPServiceMock_DeleteDataBySubject_Call) Run(run func(ctx context.Context, prefix string, subject string)) *OTPServiceMock_DeleteDataBySubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OTPServiceMock_DeleteDataBySubject_Call) Return(_a0 error) *OTPServiceMock_DeleteDataBySubject_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OTPServiceMock_DeleteDataBySubject_Call) RunAndReturn(run func(context.Context, string, string) error) *OTPServiceMock_DeleteDataBySubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenerateAndSaveWithCode provides a mock function with given fields: ctx, prefix, data
 func (_m *OTPServiceMock) GenerateAndSaveWithCode(ctx context.Context, prefix string, data any) (string, error) {
 	ret := _m.Called(ctx, prefix, data)
@@ -127,6 +275,124 @@ func (_c *OTPServiceMock_GenerateAndSaveWithCode_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// GenerateAndSaveWithSubject provides a mock function with given fields: ctx, prefix, subject, data
+func (_m *OTPServiceMock) GenerateAndSaveWithSubject(ctx context.Context, prefix string, subject string, data any) (string, error) {
+	ret := _m.Called(ctx, prefix, subject, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateAndSaveWithSubject")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, any) (string, error)); ok {
+		return rf(ctx, prefix, subject, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, any) string); ok {
+		r0 = rf(ctx, prefix, subject, data)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, any) error); ok {
+		r1 = rf(ctx, prefix, subject, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OTPServiceMock_GenerateAndSaveWithSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateAndSaveWithSubject'
+type OTPServiceMock_GenerateAndSaveWithSubject_Call struct {
+	*mock.Call
+}
+
+// GenerateAndSaveWithSubject is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - subject string
+//   - data any
+func (_e *OTPServiceMock_Expecter) GenerateAndSaveWithSubject(ctx interface{}, prefix interface{}, subject interface{}, data interface{}) *OTPServiceMock_GenerateAndSaveWithSubject_Call {
+	return &OTPServiceMock_GenerateAndSaveWithSubject_Call{Call: _e.mock.On("GenerateAndSaveWithSubject", ctx, prefix, subject, data)}
+}
+
+func (_c *OTPServiceMock_GenerateAndSaveWithSubject_Call) Run(run func(ctx context.Context, prefix string, subject string, data any)) *OTPServiceMock_GenerateAndSaveWithSubject_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(any))
+	})
+	return _c
+}
+
+func (_c *OTPServiceMock_GenerateAndSaveWithSubject_Call) Return(_a0 string, _a1 error) *OTPServiceMock_GenerateAndSaveWithSubject_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OTPServiceMock_GenerateAndSaveWithSubject_Call) RunAndReturn(run func(context.Context, string, string, any) (string, error)) *OTPServiceMock_GenerateAndSaveWithSubject_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateAndSaveWithToken provides a mock function with given fields: ctx, prefix, data, ttl
+func (_m *OTPServiceMock) GenerateAndSaveWithToken(ctx context.Context, prefix string, data any, ttl time.Duration) (string, error) {
+	ret := _m.Called(ctx, prefix, data, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateAndSaveWithToken")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, any, time.Duration) (string, error)); ok {
+		return rf(ctx, prefix, data, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, any, time.Duration) string); ok {
+		r0 = rf(ctx, prefix, data, ttl)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, any, time.Duration) error); ok {
+		r1 = rf(ctx, prefix, data, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OTPServiceMock_GenerateAndSaveWithToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateAndSaveWithToken'
+type OTPServiceMock_GenerateAndSaveWithToken_Call struct {
+	*mock.Call
+}
+
+// GenerateAndSaveWithToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - data any
+//   - ttl time.Duration
+func (_e *OTPServiceMock_Expecter) GenerateAndSaveWithToken(ctx interface{}, prefix interface{}, data interface{}, ttl interface{}) *OTPServiceMock_GenerateAndSaveWithToken_Call {
+	return &OTPServiceMock_GenerateAndSaveWithToken_Call{Call: _e.mock.On("GenerateAndSaveWithToken", ctx, prefix, data, ttl)}
+}
+
+func (_c *OTPServiceMock_GenerateAndSaveWithToken_Call) Run(run func(ctx context.Context, prefix string, data any, ttl time.Duration)) *OTPServiceMock_GenerateAndSaveWithToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(any), args[3].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *OTPServiceMock_GenerateAndSaveWithToken_Call) Return(_a0 string, _a1 error) *OTPServiceMock_GenerateAndSaveWithToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OTPServiceMock_GenerateAndSaveWithToken_Call) RunAndReturn(run func(context.Context, string, any, time.Duration) (string, error)) *OTPServiceMock_GenerateAndSaveWithToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GenerateCode provides a mock function with given fields: ctx
 func (_m *OTPServiceMock) GenerateCode(ctx context.Context) (string, error) {
 	ret := _m.Called(ctx)
@@ -232,6 +498,56 @@ func (_c *OTPServiceMock_GetDataByCode_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetDataBySubjectAndCode provides a mock function with given fields: ctx, prefix, subject, code, data
+func (_m *OTPServiceMock) GetDataBySubjectAndCode(ctx context.Context, prefix string, subject string, code string, data any) error {
+	ret := _m.Called(ctx, prefix, subject, code, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDataBySubjectAndCode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, any) error); ok {
+		r0 = rf(ctx, prefix, subject, code, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OTPServiceMock_GetDataBySubjectAndCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDataBySubjectAndCode'
+type OTPServiceMock_GetDataBySubjectAndCode_Call struct {
+	*mock.Call
+}
+
+// GetDataBySubjectAndCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - prefix string
+//   - subject string
+//   - code string
+//   - data any
+func (_e *OTPServiceMock_Expecter) GetDataBySubjectAndCode(ctx interface{}, prefix interface{}, subject interface{}, code interface{}, data interface{}) *OTPServiceMock_GetDataBySubjectAndCode_Call {
+	return &OTPServiceMock_GetDataBySubjectAndCode_Call{Call: _e.mock.On("GetDataBySubjectAndCode", ctx, prefix, subject, code, data)}
+}
+
+func (_c *OTPServiceMock_GetDataBySubjectAndCode_Call) Run(run func(ctx context.Context, prefix string, subject string, code string, data any)) *OTPServiceMock_GetDataBySubjectAndCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(any))
+	})
+	return _c
+}
+
+func (_c *OTPServiceMock_GetDataBySubjectAndCode_Call) Return(_a0 error) *OTPServiceMock_GetDataBySubjectAndCode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OTPServiceMock_GetDataBySubjectAndCode_Call) RunAndReturn(run func(context.Context, string, string, string, any) error) *OTPServiceMock_GetDataBySubjectAndCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveWithCode provides a mock function with given fields: ctx, prefix, code, data
 func (_m *OTPServiceMock) SaveWithCode(ctx context.Context, prefix string, code string, data any) error {
 	ret := _m.Called(ctx, prefix, code, data)
@@ -0,0 +1,211 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	event "github.com/mandarine-io/backend/internal/infrastructure/event"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OutboxServiceMock is an autogenerated mock type for the OutboxService type
+type OutboxServiceMock struct {
+	mock.Mock
+}
+
+type OutboxServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OutboxServiceMock) EXPECT() *OutboxServiceMock_Expecter {
+	return &OutboxServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: ctx, events
+func (_m *OutboxServiceMock) Add(ctx context.Context, events ...event.Event) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...event.Event) error); ok {
+		r0 = rf(ctx, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OutboxServiceMock_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type OutboxServiceMock_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events ...event.Event
+func (_e *OutboxServiceMock_Expecter) Add(ctx interface{}, events ...interface{}) *OutboxServiceMock_Add_Call {
+	return &OutboxServiceMock_Add_Call{Call: _e.mock.On("Add",
+		append([]interface{}{ctx}, events...)...)}
+}
+
+func (_c *OutboxServiceMock_Add_Call) Run(run func(ctx context.Context, events ...event.Event)) *OutboxServiceMock_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]event.Event, len(args)-1)
+		for i, a := range args[1:] {
+			if a != nil {
+				variadicArgs[i] = a.(event.Event)
+			}
+		}
+		run(args[0].(context.Context), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *OutboxServiceMock_Add_Call) Return(_a0 error) *OutboxServiceMock_Add_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OutboxServiceMock_Add_Call) RunAndReturn(run func(context.Context, ...event.Event) error) *OutboxServiceMock_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cleanup provides a mock function with given fields: ctx
+func (_m *OutboxServiceMock) Cleanup(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cleanup")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxServiceMock_Cleanup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cleanup'
+type OutboxServiceMock_Cleanup_Call struct {
+	*mock.Call
+}
+
+// Cleanup is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OutboxServiceMock_Expecter) Cleanup(ctx interface{}) *OutboxServiceMock_Cleanup_Call {
+	return &OutboxServiceMock_Cleanup_Call{Call: _e.mock.On("Cleanup", ctx)}
+}
+
+func (_c *OutboxServiceMock_Cleanup_Call) Run(run func(ctx context.Context)) *OutboxServiceMock_Cleanup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OutboxServiceMock_Cleanup_Call) Return(_a0 int64, _a1 error) *OutboxServiceMock_Cleanup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxServiceMock_Cleanup_Call) RunAndReturn(run func(context.Context) (int64, error)) *OutboxServiceMock_Cleanup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Relay provides a mock function with given fields: ctx
+func (_m *OutboxServiceMock) Relay(ctx context.Context) (int, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Relay")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (int, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) int); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OutboxServiceMock_Relay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Relay'
+type OutboxServiceMock_Relay_Call struct {
+	*mock.Call
+}
+
+// Relay is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *OutboxServiceMock_Expecter) Relay(ctx interface{}) *OutboxServiceMock_Relay_Call {
+	return &OutboxServiceMock_Relay_Call{Call: _e.mock.On("Relay", ctx)}
+}
+
+func (_c *OutboxServiceMock_Relay_Call) Run(run func(ctx context.Context)) *OutboxServiceMock_Relay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *OutboxServiceMock_Relay_Call) Return(_a0 int, _a1 error) *OutboxServiceMock_Relay_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OutboxServiceMock_Relay_Call) RunAndReturn(run func(context.Context) (int, error)) *OutboxServiceMock_Relay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOutboxServiceMock creates a new instance of OutboxServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxServiceMock {
+	mock := &OutboxServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,298 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	entity "github.com/mandarine-io/backend/internal/persistence/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// TOTPServiceMock is an autogenerated mock type for the TOTPService type
+type TOTPServiceMock struct {
+	mock.Mock
+}
+
+type TOTPServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TOTPServiceMock) EXPECT() *TOTPServiceMock_Expecter {
+	return &TOTPServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// DeleteRecoveryCodes provides a mock function with given fields: ctx, userID
+func (_m *TOTPServiceMock) DeleteRecoveryCodes(ctx context.Context, userID uuid.UUID) error {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRecoveryCodes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TOTPServiceMock_DeleteRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRecoveryCodes'
+type TOTPServiceMock_DeleteRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// DeleteRecoveryCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *TOTPServiceMock_Expecter) DeleteRecoveryCodes(ctx interface{}, userID interface{}) *TOTPServiceMock_DeleteRecoveryCodes_Call {
+	return &TOTPServiceMock_DeleteRecoveryCodes_Call{Call: _e.mock.On("DeleteRecoveryCodes", ctx, userID)}
+}
+
+func (_c *TOTPServiceMock_DeleteRecoveryCodes_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *TOTPServiceMock_DeleteRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TOTPServiceMock_DeleteRecoveryCodes_Call) Return(_a0 error) *TOTPServiceMock_DeleteRecoveryCodes_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TOTPServiceMock_DeleteRecoveryCodes_Call) RunAndReturn(run func(context.Context, uuid.UUID) error) *TOTPServiceMock_DeleteRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateRecoveryCodes provides a mock function with given fields: ctx, userID
+func (_m *TOTPServiceMock) GenerateRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateRecoveryCodes")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]string, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) []string); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TOTPServiceMock_GenerateRecoveryCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateRecoveryCodes'
+type TOTPServiceMock_GenerateRecoveryCodes_Call struct {
+	*mock.Call
+}
+
+// GenerateRecoveryCodes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID uuid.UUID
+func (_e *TOTPServiceMock_Expecter) GenerateRecoveryCodes(ctx interface{}, userID interface{}) *TOTPServiceMock_GenerateRecoveryCodes_Call {
+	return &TOTPServiceMock_GenerateRecoveryCodes_Call{Call: _e.mock.On("GenerateRecoveryCodes", ctx, userID)}
+}
+
+func (_c *TOTPServiceMock_GenerateRecoveryCodes_Call) Run(run func(ctx context.Context, userID uuid.UUID)) *TOTPServiceMock_GenerateRecoveryCodes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uuid.UUID))
+	})
+	return _c
+}
+
+func (_c *TOTPServiceMock_GenerateRecoveryCodes_Call) Return(_a0 []string, _a1 error) *TOTPServiceMock_GenerateRecoveryCodes_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TOTPServiceMock_GenerateRecoveryCodes_Call) RunAndReturn(run func(context.Context, uuid.UUID) ([]string, error)) *TOTPServiceMock_GenerateRecoveryCodes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GenerateSecret provides a mock function with given fields: ctx
+func (_m *TOTPServiceMock) GenerateSecret(ctx context.Context) (string, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateSecret")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TOTPServiceMock_GenerateSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateSecret'
+type TOTPServiceMock_GenerateSecret_Call struct {
+	*mock.Call
+}
+
+// GenerateSecret is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TOTPServiceMock_Expecter) GenerateSecret(ctx interface{}) *TOTPServiceMock_GenerateSecret_Call {
+	return &TOTPServiceMock_GenerateSecret_Call{Call: _e.mock.On("GenerateSecret", ctx)}
+}
+
+func (_c *TOTPServiceMock_GenerateSecret_Call) Run(run func(ctx context.Context)) *TOTPServiceMock_GenerateSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TOTPServiceMock_GenerateSecret_Call) Return(_a0 string, _a1 error) *TOTPServiceMock_GenerateSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TOTPServiceMock_GenerateSecret_Call) RunAndReturn(run func(context.Context) (string, error)) *TOTPServiceMock_GenerateSecret_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProvisioningURI provides a mock function with given fields: ctx, secret, accountName
+func (_m *TOTPServiceMock) GetProvisioningURI(ctx context.Context, secret string, accountName string) string {
+	ret := _m.Called(ctx, secret, accountName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProvisioningURI")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, secret, accountName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TOTPServiceMock_GetProvisioningURI_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProvisioningURI'
+type TOTPServiceMock_GetProvisioningURI_Call struct {
+	*mock.Call
+}
+
+// GetProvisioningURI is a helper method to define mock.On call
+//   - ctx context.Context
+//   - secret string
+//   - accountName string
+func (_e *TOTPServiceMock_Expecter) GetProvisioningURI(ctx interface{}, secret interface{}, accountName interface{}) *TOTPServiceMock_GetProvisioningURI_Call {
+	return &TOTPServiceMock_GetProvisioningURI_Call{Call: _e.mock.On("GetProvisioningURI", ctx, secret, accountName)}
+}
+
+func (_c *TOTPServiceMock_GetProvisioningURI_Call) Run(run func(ctx context.Context, secret string, accountName string)) *TOTPServiceMock_GetProvisioningURI_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TOTPServiceMock_GetProvisioningURI_Call) Return(_a0 string) *TOTPServiceMock_GetProvisioningURI_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TOTPServiceMock_GetProvisioningURI_Call) RunAndReturn(run func(context.Context, string, string) string) *TOTPServiceMock_GetProvisioningURI_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyCode provides a mock function with given fields: ctx, userEntity, code
+func (_m *TOTPServiceMock) VerifyCode(ctx context.Context, userEntity *entity.User, code string) error {
+	ret := _m.Called(ctx, userEntity, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyCode")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.User, string) error); ok {
+		r0 = rf(ctx, userEntity, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TOTPServiceMock_VerifyCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyCode'
+type TOTPServiceMock_VerifyCode_Call struct {
+	*mock.Call
+}
+
+// VerifyCode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userEntity *entity.User
+//   - code string
+func (_e *TOTPServiceMock_Expecter) VerifyCode(ctx interface{}, userEntity interface{}, code interface{}) *TOTPServiceMock_VerifyCode_Call {
+	return &TOTPServiceMock_VerifyCode_Call{Call: _e.mock.On("VerifyCode", ctx, userEntity, code)}
+}
+
+func (_c *TOTPServiceMock_VerifyCode_Call) Run(run func(ctx context.Context, userEntity *entity.User, code string)) *TOTPServiceMock_VerifyCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.User), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TOTPServiceMock_VerifyCode_Call) Return(_a0 error) *TOTPServiceMock_VerifyCode_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TOTPServiceMock_VerifyCode_Call) RunAndReturn(run func(context.Context, *entity.User, string) error) *TOTPServiceMock_VerifyCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTOTPServiceMock creates a new instance of TOTPServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTOTPServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TOTPServiceMock {
+	mock := &TOTPServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
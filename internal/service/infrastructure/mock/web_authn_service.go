@@ -0,0 +1,272 @@
+// Code generated by mockery v2.51.1. DO NOT EDIT.
+
+package mock
+
+import (
+	context "context"
+
+	entity "github.com/mandarine-io/backend/internal/persistence/entity"
+
+	mock "github.com/stretchr/testify/mock"
+
+	v0 "github.com/mandarine-io/backend/pkg/model/v0"
+)
+
+// WebAuthnServiceMock is an autogenerated mock type for the WebAuthnService type
+type WebAuthnServiceMock struct {
+	mock.Mock
+}
+
+type WebAuthnServiceMock_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WebAuthnServiceMock) EXPECT() *WebAuthnServiceMock_Expecter {
+	return &WebAuthnServiceMock_Expecter{mock: &_m.Mock}
+}
+
+// BeginLogin provides a mock function with given fields: ctx
+func (_m *WebAuthnServiceMock) BeginLogin(ctx context.Context) (v0.PasskeyRequestOptionsOutput, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginLogin")
+	}
+
+	var r0 v0.PasskeyRequestOptionsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (v0.PasskeyRequestOptionsOutput, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) v0.PasskeyRequestOptionsOutput); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(v0.PasskeyRequestOptionsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebAuthnServiceMock_BeginLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginLogin'
+type WebAuthnServiceMock_BeginLogin_Call struct {
+	*mock.Call
+}
+
+// BeginLogin is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WebAuthnServiceMock_Expecter) BeginLogin(ctx interface{}) *WebAuthnServiceMock_BeginLogin_Call {
+	return &WebAuthnServiceMock_BeginLogin_Call{Call: _e.mock.On("BeginLogin", ctx)}
+}
+
+func (_c *WebAuthnServiceMock_BeginLogin_Call) Run(run func(ctx context.Context)) *WebAuthnServiceMock_BeginLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WebAuthnServiceMock_BeginLogin_Call) Return(_a0 v0.PasskeyRequestOptionsOutput, _a1 error) *WebAuthnServiceMock_BeginLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebAuthnServiceMock_BeginLogin_Call) RunAndReturn(run func(context.Context) (v0.PasskeyRequestOptionsOutput, error)) *WebAuthnServiceMock_BeginLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BeginRegistration provides a mock function with given fields: ctx, userEntity
+func (_m *WebAuthnServiceMock) BeginRegistration(ctx context.Context, userEntity *entity.User) (v0.PasskeyCreationOptionsOutput, error) {
+	ret := _m.Called(ctx, userEntity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BeginRegistration")
+	}
+
+	var r0 v0.PasskeyCreationOptionsOutput
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.User) (v0.PasskeyCreationOptionsOutput, error)); ok {
+		return rf(ctx, userEntity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.User) v0.PasskeyCreationOptionsOutput); ok {
+		r0 = rf(ctx, userEntity)
+	} else {
+		r0 = ret.Get(0).(v0.PasskeyCreationOptionsOutput)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.User) error); ok {
+		r1 = rf(ctx, userEntity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebAuthnServiceMock_BeginRegistration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BeginRegistration'
+type WebAuthnServiceMock_BeginRegistration_Call struct {
+	*mock.Call
+}
+
+// BeginRegistration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userEntity *entity.User
+func (_e *WebAuthnServiceMock_Expecter) BeginRegistration(ctx interface{}, userEntity interface{}) *WebAuthnServiceMock_BeginRegistration_Call {
+	return &WebAuthnServiceMock_BeginRegistration_Call{Call: _e.mock.On("BeginRegistration", ctx, userEntity)}
+}
+
+func (_c *WebAuthnServiceMock_BeginRegistration_Call) Run(run func(ctx context.Context, userEntity *entity.User)) *WebAuthnServiceMock_BeginRegistration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.User))
+	})
+	return _c
+}
+
+func (_c *WebAuthnServiceMock_BeginRegistration_Call) Return(_a0 v0.PasskeyCreationOptionsOutput, _a1 error) *WebAuthnServiceMock_BeginRegistration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebAuthnServiceMock_BeginRegistration_Call) RunAndReturn(run func(context.Context, *entity.User) (v0.PasskeyCreationOptionsOutput, error)) *WebAuthnServiceMock_BeginRegistration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishLogin provides a mock function with given fields: ctx, input
+func (_m *WebAuthnServiceMock) FinishLogin(ctx context.Context, input v0.PasskeyLoginInput) (*entity.Passkey, error) {
+	ret := _m.Called(ctx, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishLogin")
+	}
+
+	var r0 *entity.Passkey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, v0.PasskeyLoginInput) (*entity.Passkey, error)); ok {
+		return rf(ctx, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, v0.PasskeyLoginInput) *entity.Passkey); ok {
+		r0 = rf(ctx, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Passkey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, v0.PasskeyLoginInput) error); ok {
+		r1 = rf(ctx, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebAuthnServiceMock_FinishLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishLogin'
+type WebAuthnServiceMock_FinishLogin_Call struct {
+	*mock.Call
+}
+
+// FinishLogin is a helper method to define mock.On call
+//   - ctx context.Context
+//   - input v0.PasskeyLoginInput
+func (_e *WebAuthnServiceMock_Expecter) FinishLogin(ctx interface{}, input interface{}) *WebAuthnServiceMock_FinishLogin_Call {
+	return &WebAuthnServiceMock_FinishLogin_Call{Call: _e.mock.On("FinishLogin", ctx, input)}
+}
+
+func (_c *WebAuthnServiceMock_FinishLogin_Call) Run(run func(ctx context.Context, input v0.PasskeyLoginInput)) *WebAuthnServiceMock_FinishLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(v0.PasskeyLoginInput))
+	})
+	return _c
+}
+
+func (_c *WebAuthnServiceMock_FinishLogin_Call) Return(_a0 *entity.Passkey, _a1 error) *WebAuthnServiceMock_FinishLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebAuthnServiceMock_FinishLogin_Call) RunAndReturn(run func(context.Context, v0.PasskeyLoginInput) (*entity.Passkey, error)) *WebAuthnServiceMock_FinishLogin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FinishRegistration provides a mock function with given fields: ctx, userEntity, input
+func (_m *WebAuthnServiceMock) FinishRegistration(ctx context.Context, userEntity *entity.User, input v0.PasskeyRegistrationInput) (*entity.Passkey, error) {
+	ret := _m.Called(ctx, userEntity, input)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishRegistration")
+	}
+
+	var r0 *entity.Passkey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.User, v0.PasskeyRegistrationInput) (*entity.Passkey, error)); ok {
+		return rf(ctx, userEntity, input)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *entity.User, v0.PasskeyRegistrationInput) *entity.Passkey); ok {
+		r0 = rf(ctx, userEntity, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Passkey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *entity.User, v0.PasskeyRegistrationInput) error); ok {
+		r1 = rf(ctx, userEntity, input)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WebAuthnServiceMock_FinishRegistration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FinishRegistration'
+type WebAuthnServiceMock_FinishRegistration_Call struct {
+	*mock.Call
+}
+
+// FinishRegistration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userEntity *entity.User
+//   - input v0.PasskeyRegistrationInput
+func (_e *WebAuthnServiceMock_Expecter) FinishRegistration(ctx interface{}, userEntity interface{}, input interface{}) *WebAuthnServiceMock_FinishRegistration_Call {
+	return &WebAuthnServiceMock_FinishRegistration_Call{Call: _e.mock.On("FinishRegistration", ctx, userEntity, input)}
+}
+
+func (_c *WebAuthnServiceMock_FinishRegistration_Call) Run(run func(ctx context.Context, userEntity *entity.User, input v0.PasskeyRegistrationInput)) *WebAuthnServiceMock_FinishRegistration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*entity.User), args[2].(v0.PasskeyRegistrationInput))
+	})
+	return _c
+}
+
+func (_c *WebAuthnServiceMock_FinishRegistration_Call) Return(_a0 *entity.Passkey, _a1 error) *WebAuthnServiceMock_FinishRegistration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WebAuthnServiceMock_FinishRegistration_Call) RunAndReturn(run func(context.Context, *entity.User, v0.PasskeyRegistrationInput) (*entity.Passkey, error)) *WebAuthnServiceMock_FinishRegistration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWebAuthnServiceMock creates a new instance of WebAuthnServiceMock. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebAuthnServiceMock(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebAuthnServiceMock {
+	mock := &WebAuthnServiceMock{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
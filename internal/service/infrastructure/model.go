@@ -1,11 +1,15 @@
 package infrastructure
 
 import (
+	"crypto"
 	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"time"
 )
 
 type AccessTokenClaims struct {
 	UserID         uuid.UUID
+	SessionID      uuid.UUID
 	Username       string
 	Email          string
 	Role           string
@@ -14,10 +18,83 @@ type AccessTokenClaims struct {
 	IsDeleted      bool
 	JTI            string
 	Exp            int64
+
+	// IsMFASetupRequired is set when role of user requires 2FA, but user has not enabled it yet
+	IsMFASetupRequired bool
 }
 
 type RefreshTokenClaims struct {
+	UserID    uuid.UUID
+	SessionID uuid.UUID
+	JTI       string
+	Exp       int64
+}
+
+type MFATokenClaims struct {
 	UserID uuid.UUID
 	JTI    string
 	Exp    int64
 }
+
+// APIKeyClaims describes service account, which is authenticated by API key. Service account acts on behalf of owner,
+// so user fields are filled from owner, but access is limited by scopes of the key
+type APIKeyClaims struct {
+	APIKeyID         uuid.UUID
+	ServiceAccountID uuid.UUID
+	UserID           uuid.UUID
+	Username         string
+	Email            string
+	Role             string
+	IsEnabled        bool
+	IsDeleted        bool
+	Scopes           []string
+}
+
+// GeneratedAPIKey is new API key. Key is shown to user only once, then only prefix and hash are stored
+type GeneratedAPIKey struct {
+	Key    string
+	Prefix string
+	Hash   string
+}
+
+// ClientInfo describes the client that owns the session. IP is determined by server,
+// device name and location are reported by client and are not verified
+type ClientInfo struct {
+	IP         string
+	UserAgent  string
+	DeviceName string
+	Location   string
+}
+
+// SigningKey is an asymmetric key pair identified by kid, which is used to sign and verify JWT tokens
+type SigningKey struct {
+	ID         string
+	Algorithm  string
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+	CreatedAt  time.Time
+	// ExpiredAt is set after rotation, expired key only validates tokens, which are signed before rotation
+	ExpiredAt *time.Time
+}
+
+// Attempt describes guess of secret, which is limited by brute-force protection.
+// Action separates counters of different endpoints, Account is login or email entered by client
+type Attempt struct {
+	Action  string
+	Account string
+	IP      string
+}
+
+// LockoutError is returned while attempts are blocked, RetryAfter is time until the lock is released
+type LockoutError struct {
+	Err        v0.I18nError
+	RetryAfter time.Duration
+}
+
+func (e LockoutError) Error() string {
+	return e.Err.Error()
+}
+
+func (e LockoutError) Unwrap() error {
+	return e.Err
+}
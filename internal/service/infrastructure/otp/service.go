@@ -3,6 +3,11 @@ package otp
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"github.com/mandarine-io/backend/config"
 	"github.com/mandarine-io/backend/internal/infrastructure/cache"
@@ -13,10 +18,28 @@ import (
 	"time"
 )
 
+const (
+	attemptsCachePrefix = "otp_attempts"
+	consumedCachePrefix = "otp_consumed"
+	tokenLength         = 32
+)
+
 var (
 	ErrNegativeOTPLength = errors.New("OTP length is negative")
 )
 
+// subjectEntry binds code to subject (e.g. email), so wrong codes can be counted per subject
+type subjectEntry struct {
+	Code string          `json:"code"`
+	Data json.RawMessage `json:"data"`
+}
+
+// tokenEntry keeps expiration time, so consumption marker lives no longer than token itself
+type tokenEntry struct {
+	Data      json.RawMessage `json:"data"`
+	ExpiresAt time.Time       `json:"expiresAt"`
+}
+
 type svc struct {
 	manager cache.Manager
 	cfg     config.OTPConfig
@@ -91,6 +114,181 @@ func (s *svc) DeleteDataByCode(ctx context.Context, prefix string, code string)
 	return s.manager.Delete(ctx, cachehelper.CreateCacheKey(prefix, code))
 }
 
+func (s *svc) GenerateAndSaveWithSubject(ctx context.Context, prefix string, subject string, data any) (string, error) {
+	s.logger.Debug().Msg("create OTP code for subject")
+
+	code, err := s.GenerateCode(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	// New code replaces previous one and resets wrong attempts
+	err = s.manager.SetWithExpiration(
+		ctx,
+		cachehelper.CreateCacheKey(prefix, subject),
+		subjectEntry{Code: code, Data: dataBytes},
+		time.Duration(s.cfg.TTL)*time.Second,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.manager.Delete(ctx, cachehelper.CreateCacheKey(attemptsCachePrefix, prefix, subject))
+	if err != nil {
+		return "", err
+	}
+
+	return code, nil
+}
+
+func (s *svc) GetDataBySubjectAndCode(
+	ctx context.Context,
+	prefix string,
+	subject string,
+	code string,
+	data any,
+) error {
+	s.logger.Debug().Msg("get OTP code for subject")
+
+	var entry subjectEntry
+	err := s.manager.Get(ctx, cachehelper.CreateCacheKey(prefix, subject), &entry)
+	if errors.Is(err, cache.ErrCacheEntryNotFound) {
+		return infrastructure.ErrInvalidOrExpiredOTP
+	}
+	if err != nil {
+		return err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(entry.Code), []byte(code)) != 1 {
+		attempts, err := s.manager.Increment(
+			ctx,
+			cachehelper.CreateCacheKey(attemptsCachePrefix, prefix, subject),
+			time.Duration(s.cfg.TTL)*time.Second,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Code is invalidated, so it cannot be guessed within its lifetime
+		if attempts >= int64(s.cfg.MaxAttempts) {
+			s.logger.Warn().Msgf("OTP code is invalidated after %d wrong attempts", attempts)
+
+			err = s.DeleteDataBySubject(ctx, prefix, subject)
+			if err != nil {
+				return err
+			}
+
+			return infrastructure.ErrOTPAttemptsExceeded
+		}
+
+		return infrastructure.ErrInvalidOrExpiredOTP
+	}
+
+	return json.Unmarshal(entry.Data, data)
+}
+
+func (s *svc) DeleteDataBySubject(ctx context.Context, prefix string, subject string) error {
+	s.logger.Debug().Msg("delete OTP code for subject")
+
+	return s.manager.Delete(
+		ctx,
+		cachehelper.CreateCacheKey(prefix, subject),
+		cachehelper.CreateCacheKey(attemptsCachePrefix, prefix, subject),
+	)
+}
+
+func (s *svc) GenerateAndSaveWithToken(ctx context.Context, prefix string, data any, ttl time.Duration) (string, error) {
+	s.logger.Debug().Msg("create OTP token")
+
+	tokenBytes := make([]byte, tokenLength)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(tokenBytes)
+
+	dataBytes, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	// Only token hash is stored, so leaked cache does not reveal valid tokens
+	err = s.manager.SetWithExpiration(
+		ctx,
+		cachehelper.CreateCacheKey(prefix, hashToken(token)),
+		tokenEntry{Data: dataBytes, ExpiresAt: time.Now().Add(ttl)},
+		ttl,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (s *svc) ConsumeDataByToken(ctx context.Context, prefix string, token string, data any) error {
+	s.logger.Debug().Msg("consume OTP token")
+
+	tokenHash := hashToken(token)
+	key := cachehelper.CreateCacheKey(prefix, tokenHash)
+
+	var entry tokenEntry
+	err := s.manager.Get(ctx, key, &entry)
+	if errors.Is(err, cache.ErrCacheEntryNotFound) {
+		return infrastructure.ErrInvalidOrExpiredOTP
+	}
+	if err != nil {
+		return err
+	}
+
+	ttl := time.Until(entry.ExpiresAt)
+	if ttl <= 0 {
+		return infrastructure.ErrInvalidOrExpiredOTP
+	}
+
+	// Atomic increment guarantees that only one of concurrent requests consumes token
+	consumed, err := s.manager.Increment(ctx, cachehelper.CreateCacheKey(consumedCachePrefix, prefix, tokenHash), ttl)
+	if err != nil {
+		return err
+	}
+	if consumed > 1 {
+		s.logger.Warn().Msg("OTP token is already consumed")
+		return infrastructure.ErrInvalidOrExpiredOTP
+	}
+
+	err = s.manager.Delete(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(entry.Data, data)
+}
+
+func (s *svc) ConsumeToken(ctx context.Context, prefix string, token string, ttl time.Duration) error {
+	s.logger.Debug().Msg("consume token")
+
+	key := cachehelper.CreateCacheKey(consumedCachePrefix, prefix, hashToken(token))
+	consumed, err := s.manager.Increment(ctx, key, ttl)
+	if err != nil {
+		return err
+	}
+	if consumed > 1 {
+		s.logger.Warn().Msg("token is already consumed")
+		return infrastructure.ErrInvalidOrExpiredOTP
+	}
+
+	return nil
+}
+
+func hashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
 func generateRandomNumber(length int) (string, error) {
 	if length < 0 {
 		return "", ErrNegativeOTPLength
@@ -0,0 +1,187 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/infrastructure/event"
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/rs/zerolog"
+	"time"
+)
+
+const (
+	baseBackoff = time.Second
+)
+
+type svc struct {
+	outboxEventRepo repo.OutboxEventRepository
+	transactor      repo.Transactor
+	agent           pubsub.Agent
+	registry        *event.Registry
+	cfg             config.OutboxConfig
+	logger          zerolog.Logger
+}
+
+type Option func(*svc)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(p *svc) {
+		p.logger = logger
+	}
+}
+
+func NewService(
+	outboxEventRepo repo.OutboxEventRepository,
+	transactor repo.Transactor,
+	agent pubsub.Agent,
+	registry *event.Registry,
+	cfg config.OutboxConfig,
+	opts ...Option,
+) infrastructure.OutboxService {
+	s := &svc{
+		outboxEventRepo: outboxEventRepo,
+		transactor:      transactor,
+		agent:           agent,
+		registry:        registry,
+		cfg:             cfg,
+		logger:          zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func (s *svc) Add(ctx context.Context, events ...event.Event) error {
+	s.logger.Debug().Msgf("add %d events to outbox", len(events))
+
+	eventEntities := make([]*entity.OutboxEvent, 0, len(events))
+	for _, e := range events {
+		payload, err := s.registry.Encode(e)
+		if err != nil {
+			return fmt.Errorf("failed to encode event %s: %w", e.EventType(), err)
+		}
+
+		eventEntities = append(
+			eventEntities, &entity.OutboxEvent{
+				AggregateType: e.AggregateType(),
+				AggregateID:   e.AggregateID(),
+				EventType:     e.EventType(),
+				Payload:       payload,
+			},
+		)
+	}
+
+	return s.outboxEventRepo.CreateOutboxEvents(ctx, eventEntities)
+}
+
+func (s *svc) Relay(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		processed, published, err := s.relayBatch(ctx)
+		total += published
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to relay outbox events")
+			return total, err
+		}
+
+		// Batch contains only the earliest pending event of each aggregate, so next events are read by next batch
+		if processed == 0 {
+			return total, nil
+		}
+	}
+}
+
+func (s *svc) Cleanup(ctx context.Context) (int64, error) {
+	s.logger.Debug().Msg("cleanup outbox events")
+
+	before := time.Now().Add(-time.Duration(s.cfg.Retention) * time.Hour)
+	deleted, err := s.outboxEventRepo.DeleteOutboxEventsPublishedBefore(ctx, before)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to delete published outbox events")
+		return 0, err
+	}
+
+	return deleted, nil
+}
+
+// relayBatch publishes batch of pending events. Events stay locked until they are marked, so other relays skip them.
+// Event may be published twice, if transaction is not committed after publishing, so consumers must be idempotent
+func (s *svc) relayBatch(ctx context.Context) (int, int, error) {
+	processed, published := 0, 0
+
+	err := s.transactor.Transaction(
+		ctx, func(ctx context.Context) error {
+			events, err := s.outboxEventRepo.FindPendingOutboxEvents(ctx, s.cfg.BatchSize)
+			if err != nil {
+				return fmt.Errorf("failed to find pending outbox events: %w", err)
+			}
+			processed = len(events)
+
+			for _, e := range events {
+				if err := s.publish(ctx, e); err != nil {
+					// Event, which cannot be published, is given up, so it does not block aggregate forever
+					if e.Attempts+1 >= s.cfg.MaxAttempts {
+						s.logger.Error().Err(err).
+							Msgf("failed to publish outbox event %s, give up after %d attempts", e.ID, e.Attempts+1)
+
+						err = s.outboxEventRepo.MarkOutboxEventFailedPermanently(ctx, e.ID, err.Error(), time.Now())
+						if err != nil {
+							return fmt.Errorf("failed to mark outbox event %s as failed permanently: %w", e.ID, err)
+						}
+						continue
+					}
+
+					backoff := s.backoff(e.Attempts + 1)
+					s.logger.Warn().Err(err).Msgf("failed to publish outbox event %s, retry in %s", e.ID, backoff)
+
+					err = s.outboxEventRepo.MarkOutboxEventFailed(ctx, e.ID, err.Error(), time.Now().Add(backoff))
+					if err != nil {
+						return fmt.Errorf("failed to mark outbox event %s as failed: %w", e.ID, err)
+					}
+					continue
+				}
+
+				if err := s.outboxEventRepo.MarkOutboxEventPublished(ctx, e.ID, time.Now()); err != nil {
+					return fmt.Errorf("failed to mark outbox event %s as published: %w", e.ID, err)
+				}
+				published++
+			}
+
+			return nil
+		},
+	)
+
+	return processed, published, err
+}
+
+func (s *svc) publish(ctx context.Context, e *entity.OutboxEvent) error {
+	env := event.Envelope{
+		ID:            e.ID,
+		Type:          e.EventType,
+		AggregateType: e.AggregateType,
+		AggregateID:   e.AggregateID,
+		OccurredAt:    e.CreatedAt,
+		Payload:       e.Payload,
+	}
+
+	return s.agent.Publish(ctx, event.Topic(e.EventType), env)
+}
+
+// backoff returns delay before attempt, it grows exponentially up to max backoff
+func (s *svc) backoff(attempt int) time.Duration {
+	maxBackoff := time.Duration(s.cfg.MaxBackoff) * time.Second
+
+	backoff := baseBackoff
+	for i := 1; i < attempt && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, maxBackoff)
+}
@@ -2,28 +2,89 @@ package infrastructure
 
 import (
 	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/infrastructure/event"
 	"github.com/mandarine-io/backend/internal/persistence/entity"
 	"github.com/mandarine-io/backend/pkg/model/v0"
+	"time"
 )
 
 var (
+	// API key error
+
+	ErrInvalidAPIKey = v0.NewI18nError("invalid API key", "errors.api_key_invalid")
+	ErrExpiredAPIKey = v0.NewI18nError("expired API key", "errors.api_key_expired")
+	ErrRevokedAPIKey = v0.NewI18nError("revoked API key", "errors.api_key_revoked")
+
+	// Brute-force error
+
+	ErrAccountTemporarilyLocked = v0.NewI18nError(
+		"account is temporarily locked",
+		"errors.account_temporarily_locked",
+	)
+	ErrTooManyAttempts = v0.NewI18nError("too many attempts", "errors.too_many_attempts")
+
 	// JWT error
 
 	ErrInvalidJWTToken = v0.NewI18nError("invalid JWT token", "errors.session_invalid")
 	ErrExpiredJWTToken = v0.NewI18nError("expired JWT token", "errors.session_expired")
 	ErrBannedJWTToken  = v0.NewI18nError("banned JWT token", "errors.session_banned")
+	ErrSessionNotFound = v0.NewI18nError("session not found", "errors.session_not_found")
+	ErrReusedJWTToken  = v0.NewI18nError("reused JWT token", "errors.session_reused")
 
 	// OTP error
 
 	ErrInvalidOrExpiredOTP = v0.NewI18nError("invalid or expired otp", "errors.invalid_or_expired_otp")
+	ErrOTPAttemptsExceeded = v0.NewI18nError("otp attempts exceeded", "errors.otp_attempts_exceeded")
+
+	// TOTP error
+
+	ErrInvalidTOTPCode = v0.NewI18nError("invalid TOTP code", "errors.invalid_totp_code")
+
+	// WebAuthn error
+
+	ErrInvalidPasskey            = v0.NewI18nError("invalid passkey", "errors.invalid_passkey")
+	ErrDuplicatePasskey          = v0.NewI18nError("duplicate passkey", "errors.duplicate_passkey")
+	ErrInvalidOrExpiredChallenge = v0.NewI18nError(
+		"invalid or expired passkey challenge",
+		"errors.invalid_or_expired_passkey_challenge",
+	)
 )
 
+type APIKeyService interface {
+	GenerateKey(ctx context.Context) (GeneratedAPIKey, error)
+	GetAPIKeyClaims(ctx context.Context, key string, ip string) (APIKeyClaims, error)
+}
+
+type BruteForceService interface {
+	CheckAttempt(ctx context.Context, attempt Attempt) error
+	FailAttempt(ctx context.Context, attempt Attempt) error
+	ResetAttempts(ctx context.Context, attempt Attempt) error
+}
+
+type JWKService interface {
+	GetSigningKey(ctx context.Context) (SigningKey, error)
+	GetVerificationKey(ctx context.Context, kid string) (SigningKey, error)
+	GetPublicKeys(ctx context.Context) ([]SigningKey, error)
+	RotateKeys(ctx context.Context) error
+}
+
 type JWTService interface {
 	GetTypeToken(ctx context.Context, token string) (string, error)
 	GetAccessTokenClaims(ctx context.Context, token string) (AccessTokenClaims, error)
 	GetRefreshTokenClaims(ctx context.Context, token string) (RefreshTokenClaims, error)
 	BanToken(ctx context.Context, jti string) error
-	GenerateTokens(ctx context.Context, userEntity *entity.User) (string, string, error)
+	GenerateTokens(ctx context.Context, userEntity *entity.User, clientInfo ClientInfo) (string, string, error)
+	RotateTokens(
+		ctx context.Context,
+		userEntity *entity.User,
+		claims RefreshTokenClaims,
+		clientInfo ClientInfo,
+	) (string, string, error)
+	RevokeSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error
+	RevokeSessions(ctx context.Context, userID uuid.UUID, exceptSessionID uuid.UUID) error
+	GenerateMFAToken(ctx context.Context, userEntity *entity.User) (string, error)
+	GetMFATokenClaims(ctx context.Context, token string) (MFATokenClaims, error)
 }
 
 type OTPService interface {
@@ -32,4 +93,40 @@ type OTPService interface {
 	GenerateAndSaveWithCode(ctx context.Context, prefix string, data any) (string, error)
 	GetDataByCode(ctx context.Context, prefix string, code string, data any) error
 	DeleteDataByCode(ctx context.Context, prefix string, code string) error
+	GenerateAndSaveWithSubject(ctx context.Context, prefix string, subject string, data any) (string, error)
+	GetDataBySubjectAndCode(ctx context.Context, prefix string, subject string, code string, data any) error
+	DeleteDataBySubject(ctx context.Context, prefix string, subject string) error
+	GenerateAndSaveWithToken(ctx context.Context, prefix string, data any, ttl time.Duration) (string, error)
+	ConsumeDataByToken(ctx context.Context, prefix string, token string, data any) error
+	// ConsumeToken marks token without stored data as used, repeated consumption within ttl fails
+	ConsumeToken(ctx context.Context, prefix string, token string, ttl time.Duration) error
+}
+
+type OutboxService interface {
+	// Add writes events to outbox. It is called in transaction of domain change, so events are published
+	// only if the change is committed
+	Add(ctx context.Context, events ...event.Event) error
+	// Relay publishes pending events and returns number of published events
+	Relay(ctx context.Context) (int, error)
+	// Cleanup deletes events published before retention period and returns number of deleted events
+	Cleanup(ctx context.Context) (int64, error)
+}
+
+type TOTPService interface {
+	GenerateSecret(ctx context.Context) (string, error)
+	GetProvisioningURI(ctx context.Context, secret string, accountName string) string
+	VerifyCode(ctx context.Context, userEntity *entity.User, code string) error
+	GenerateRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]string, error)
+	DeleteRecoveryCodes(ctx context.Context, userID uuid.UUID) error
+}
+
+type WebAuthnService interface {
+	BeginRegistration(ctx context.Context, userEntity *entity.User) (v0.PasskeyCreationOptionsOutput, error)
+	FinishRegistration(
+		ctx context.Context,
+		userEntity *entity.User,
+		input v0.PasskeyRegistrationInput,
+	) (*entity.Passkey, error)
+	BeginLogin(ctx context.Context) (v0.PasskeyRequestOptionsOutput, error)
+	FinishLogin(ctx context.Context, input v0.PasskeyLoginInput) (*entity.Passkey, error)
 }
@@ -0,0 +1,284 @@
+package totp
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/internal/util/security"
+	"github.com/rs/zerolog"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// Parameters are fixed, because most authenticator apps support only default ones
+	secretSize = 20
+	digits     = 6
+	period     = 30
+	skew       = 1
+
+	recoveryCodeAlphabet = "abcdefghijkmnpqrstuvwxyz23456789"
+	recoveryCodeLength   = 10
+)
+
+var (
+	secretEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+)
+
+type svc struct {
+	userRepo         repo.UserRepository
+	recoveryCodeRepo repo.RecoveryCodeRepository
+	cfg              config.MFAConfig
+	logger           zerolog.Logger
+}
+
+type Option func(*svc)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(p *svc) {
+		p.logger = logger
+	}
+}
+
+func NewService(
+	userRepo repo.UserRepository,
+	recoveryCodeRepo repo.RecoveryCodeRepository,
+	cfg config.MFAConfig,
+	opts ...Option,
+) infrastructure.TOTPService {
+	s := &svc{
+		userRepo:         userRepo,
+		recoveryCodeRepo: recoveryCodeRepo,
+		cfg:              cfg,
+		logger:           zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func (s *svc) GenerateSecret(_ context.Context) (string, error) {
+	s.logger.Debug().Msg("generate TOTP secret")
+
+	secret := make([]byte, secretSize)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return secretEncoding.EncodeToString(secret), nil
+}
+
+func (s *svc) GetProvisioningURI(_ context.Context, secret string, accountName string) string {
+	s.logger.Debug().Msg("get TOTP provisioning URI")
+
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", s.cfg.Issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(digits))
+	query.Set("period", fmt.Sprint(period))
+
+	provisioningURL := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + s.cfg.Issuer + ":" + accountName,
+		RawQuery: query.Encode(),
+	}
+
+	return provisioningURL.String()
+}
+
+func (s *svc) VerifyCode(ctx context.Context, userEntity *entity.User, code string) error {
+	s.logger.Debug().Msg("verify TOTP code")
+
+	if userEntity.TOTPSecret == nil {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidTOTPCode).Msg("TOTP secret is not set")
+		return infrastructure.ErrInvalidTOTPCode
+	}
+
+	code = strings.TrimSpace(code)
+
+	// Check TOTP code
+	if len(code) == digits {
+		step, ok := validateCode(*userEntity.TOTPSecret, code, time.Now())
+		if !ok {
+			s.logger.Error().Stack().Err(infrastructure.ErrInvalidTOTPCode).Msg("invalid TOTP code")
+			return infrastructure.ErrInvalidTOTPCode
+		}
+
+		// Each code can be used only once
+		updated, err := s.userRepo.UpdateTOTPLastUsedStep(ctx, userEntity.ID, step)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to update TOTP last used step")
+			return err
+		}
+		if !updated {
+			s.logger.Error().Stack().Err(infrastructure.ErrInvalidTOTPCode).Msg("TOTP code has been already used")
+			return infrastructure.ErrInvalidTOTPCode
+		}
+
+		userEntity.TOTPLastUsedStep = &step
+		return nil
+	}
+
+	// Check recovery code
+	recoveryCodes, err := s.recoveryCodeRepo.FindUnusedRecoveryCodesByUserID(ctx, userEntity.ID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find recovery codes")
+		return err
+	}
+
+	code = normalizeRecoveryCode(code)
+	for _, recoveryCode := range recoveryCodes {
+		if !security.CheckPasswordHash(code, recoveryCode.CodeHash) {
+			continue
+		}
+
+		used, err := s.recoveryCodeRepo.UseRecoveryCode(ctx, recoveryCode.ID)
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to use recovery code")
+			return err
+		}
+		if !used {
+			break
+		}
+
+		s.logger.Info().Msgf("recovery code used: user=%s", userEntity.ID.String())
+		return nil
+	}
+
+	s.logger.Error().Stack().Err(infrastructure.ErrInvalidTOTPCode).Msg("invalid recovery code")
+	return infrastructure.ErrInvalidTOTPCode
+}
+
+func (s *svc) GenerateRecoveryCodes(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	s.logger.Debug().Msg("generate recovery codes")
+
+	codes := make([]string, s.cfg.RecoveryCodeCount)
+	recoveryCodes := make([]*entity.RecoveryCode, s.cfg.RecoveryCodeCount)
+	for i := range codes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to generate recovery code")
+			return nil, err
+		}
+
+		codeHash, err := security.HashPassword(normalizeRecoveryCode(code))
+		if err != nil {
+			s.logger.Error().Stack().Err(err).Msg("failed to hash recovery code")
+			return nil, err
+		}
+
+		codes[i] = code
+		recoveryCodes[i] = &entity.RecoveryCode{UserID: userID, CodeHash: codeHash}
+	}
+
+	// Previous codes become invalid
+	err := s.recoveryCodeRepo.DeleteRecoveryCodesByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to delete recovery codes")
+		return nil, err
+	}
+
+	err = s.recoveryCodeRepo.CreateRecoveryCodes(ctx, recoveryCodes)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to create recovery codes")
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func (s *svc) DeleteRecoveryCodes(ctx context.Context, userID uuid.UUID) error {
+	s.logger.Debug().Msg("delete recovery codes")
+
+	err := s.recoveryCodeRepo.DeleteRecoveryCodesByUserID(ctx, userID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to delete recovery codes")
+		return err
+	}
+
+	return nil
+}
+
+// GenerateCode returns TOTP code for the given time as described in RFC 6238
+func GenerateCode(secret string, t time.Time) (string, error) {
+	return generateCode(secret, t.Unix()/period)
+}
+
+func generateCode(secret string, step int64) (string, error) {
+	key, err := secretEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", err
+	}
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation as described in RFC 4226
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range digits {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", digits, value%mod), nil
+}
+
+// validateCode checks code in the window of adjacent time steps to tolerate clock drift
+func validateCode(secret string, code string, t time.Time) (int64, bool) {
+	currentStep := t.Unix() / period
+	for step := currentStep - skew; step <= currentStep+skew; step++ {
+		expectedCode, err := generateCode(secret, step)
+		if err != nil {
+			return 0, false
+		}
+
+		if subtle.ConstantTimeCompare([]byte(expectedCode), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+func generateRecoveryCode() (string, error) {
+	code := make([]byte, recoveryCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(recoveryCodeAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		code[i] = recoveryCodeAlphabet[n.Int64()]
+	}
+
+	// Split code into two groups for readability
+	half := recoveryCodeLength / 2
+	return string(code[:half]) + "-" + string(code[half:]), nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
@@ -0,0 +1,228 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/ugorji/go/codec"
+	"math/big"
+	"strings"
+)
+
+// COSE algorithm identifiers, see https://www.iana.org/assignments/cose/cose.xhtml#algorithms
+const (
+	COSEAlgorithmES256 = -7
+	COSEAlgorithmEdDSA = -8
+	COSEAlgorithmRS256 = -257
+)
+
+// COSE key parameters
+const (
+	coseKeyTypeOKP = 1
+	coseKeyTypeEC2 = 2
+	coseKeyTypeRSA = 3
+
+	coseCurveP256    = 1
+	coseCurveEd25519 = 6
+
+	coseKeyLabelKty = 1
+	coseKeyLabelAlg = 3
+	coseKeyLabelCrv = -1
+	coseKeyLabelX   = -2
+	coseKeyLabelY   = -3
+	coseKeyLabelN   = -1
+	coseKeyLabelE   = -2
+)
+
+// Authenticator data flags
+const (
+	flagUserPresent            = 0x01
+	flagUserVerified           = 0x04
+	flagAttestedCredentialData = 0x40
+)
+
+const (
+	rpIDHashLength    = 32
+	aaguidLength      = 16
+	minAuthDataLength = rpIDHashLength + 1 + 4
+)
+
+var (
+	errMalformedAuthData   = errors.New("malformed authenticator data")
+	errUnsupportedCOSEKey  = errors.New("unsupported COSE key")
+	errInvalidSignature    = errors.New("invalid signature")
+	supportedCOSEAlgorithm = []int{COSEAlgorithmES256, COSEAlgorithmEdDSA, COSEAlgorithmRS256}
+)
+
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+type attestationObject struct {
+	Fmt      string                 `codec:"fmt"`
+	AttStmt  map[string]interface{} `codec:"attStmt"`
+	AuthData []byte                 `codec:"authData"`
+}
+
+type authenticatorData struct {
+	RPIDHash     []byte
+	Flags        byte
+	SignCount    uint32
+	AAGUID       []byte
+	CredentialID []byte
+	PublicKey    []byte
+}
+
+func (d authenticatorData) hasFlag(flag byte) bool {
+	return d.Flags&flag == flag
+}
+
+func newCBORHandle() *codec.CborHandle {
+	h := &codec.CborHandle{}
+	h.SignedInteger = true
+	return h
+}
+
+func decodeCBOR(data []byte, v any) (int, error) {
+	dec := codec.NewDecoderBytes(data, newCBORHandle())
+	err := dec.Decode(v)
+	return dec.NumBytesRead(), err
+}
+
+// decodeBase64URL accepts both padded and unpadded base64url, because clients differ in encoding
+func decodeBase64URL(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+func encodeBase64URL(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// parseAuthenticatorData parses authenticator data as described in https://www.w3.org/TR/webauthn-3/#sctn-authenticator-data
+func parseAuthenticatorData(data []byte) (authenticatorData, error) {
+	if len(data) < minAuthDataLength {
+		return authenticatorData{}, errMalformedAuthData
+	}
+
+	authData := authenticatorData{
+		RPIDHash:  data[:rpIDHashLength],
+		Flags:     data[rpIDHashLength],
+		SignCount: binary.BigEndian.Uint32(data[rpIDHashLength+1 : minAuthDataLength]),
+	}
+
+	if !authData.hasFlag(flagAttestedCredentialData) {
+		return authData, nil
+	}
+
+	rest := data[minAuthDataLength:]
+	if len(rest) < aaguidLength+2 {
+		return authenticatorData{}, errMalformedAuthData
+	}
+	authData.AAGUID = rest[:aaguidLength]
+
+	credentialIDLength := int(binary.BigEndian.Uint16(rest[aaguidLength : aaguidLength+2]))
+	rest = rest[aaguidLength+2:]
+	if len(rest) < credentialIDLength {
+		return authenticatorData{}, errMalformedAuthData
+	}
+	authData.CredentialID = rest[:credentialIDLength]
+	rest = rest[credentialIDLength:]
+
+	// Public key is CBOR encoded map, its length is known only after decoding
+	var coseKey map[int]interface{}
+	n, err := decodeCBOR(rest, &coseKey)
+	if err != nil {
+		return authenticatorData{}, fmt.Errorf("%w: %w", errMalformedAuthData, err)
+	}
+	authData.PublicKey = rest[:n]
+
+	return authData, nil
+}
+
+// parseCOSEKey converts COSE encoded public key into crypto.PublicKey and returns its algorithm
+func parseCOSEKey(data []byte) (crypto.PublicKey, int, error) {
+	var coseKey map[int]interface{}
+	if _, err := decodeCBOR(data, &coseKey); err != nil {
+		return nil, 0, err
+	}
+
+	kty, _ := coseKey[coseKeyLabelKty].(int64)
+	alg, _ := coseKey[coseKeyLabelAlg].(int64)
+
+	switch {
+	case kty == coseKeyTypeEC2 && alg == COSEAlgorithmES256:
+		crv, _ := coseKey[coseKeyLabelCrv].(int64)
+		x, _ := coseKey[coseKeyLabelX].([]byte)
+		y, _ := coseKey[coseKeyLabelY].([]byte)
+		if crv != coseCurveP256 || len(x) != 32 || len(y) != 32 {
+			return nil, 0, errUnsupportedCOSEKey
+		}
+
+		// Check that point is on the curve
+		point := append([]byte{0x04}, append(x, y...)...)
+		if _, err := ecdh.P256().NewPublicKey(point); err != nil {
+			return nil, 0, fmt.Errorf("%w: %w", errUnsupportedCOSEKey, err)
+		}
+
+		return &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, COSEAlgorithmES256, nil
+	case kty == coseKeyTypeOKP && alg == COSEAlgorithmEdDSA:
+		crv, _ := coseKey[coseKeyLabelCrv].(int64)
+		x, _ := coseKey[coseKeyLabelX].([]byte)
+		if crv != coseCurveEd25519 || len(x) != ed25519.PublicKeySize {
+			return nil, 0, errUnsupportedCOSEKey
+		}
+
+		return ed25519.PublicKey(x), COSEAlgorithmEdDSA, nil
+	case kty == coseKeyTypeRSA && alg == COSEAlgorithmRS256:
+		n, _ := coseKey[coseKeyLabelN].([]byte)
+		e, _ := coseKey[coseKeyLabelE].([]byte)
+		if len(n) == 0 || len(e) == 0 || len(e) > 4 {
+			return nil, 0, errUnsupportedCOSEKey
+		}
+
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, COSEAlgorithmRS256, nil
+	default:
+		return nil, 0, errUnsupportedCOSEKey
+	}
+}
+
+func verifySignature(publicKey crypto.PublicKey, data []byte, signature []byte) error {
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(data)
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return errInvalidSignature
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, data, signature) {
+			return errInvalidSignature
+		}
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(data)
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return errInvalidSignature
+		}
+	default:
+		return errUnsupportedCOSEKey
+	}
+
+	return nil
+}
@@ -0,0 +1,469 @@
+package webauthn
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	cachehelper "github.com/mandarine-io/backend/internal/util/cache"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/rs/zerolog"
+	"github.com/samber/lo"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	registrationCachePrefix = "webauthn.registration"
+	loginCachePrefix        = "webauthn.login"
+	consumedCachePrefix     = "webauthn.consumed"
+
+	challengeSize      = 32
+	defaultPasskeyName = "Passkey"
+
+	credentialType           = "public-key"
+	clientDataTypeCreate     = "webauthn.create"
+	clientDataTypeGet        = "webauthn.get"
+	residentKeyRequired      = "required"
+	userVerificationRequired = "required"
+	attestationNone          = "none"
+)
+
+type svc struct {
+	manager     cache.Manager
+	passkeyRepo repo.PasskeyRepository
+	cfg         config.WebAuthnConfig
+	origins     []string
+	logger      zerolog.Logger
+}
+
+type Option func(*svc)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(p *svc) {
+		p.logger = logger
+	}
+}
+
+func NewService(
+	manager cache.Manager,
+	passkeyRepo repo.PasskeyRepository,
+	cfg config.Config,
+	opts ...Option,
+) infrastructure.WebAuthnService {
+	// Passkeys are created by frontend, which is served from external URL by default
+	origins := cfg.Security.WebAuthn.Origins
+	if len(origins) == 0 {
+		origins = []string{cfg.Server.ExternalURL}
+	}
+
+	s := &svc{
+		manager:     manager,
+		passkeyRepo: passkeyRepo,
+		cfg:         cfg.Security.WebAuthn,
+		origins:     origins,
+		logger:      zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+func (s *svc) BeginRegistration(ctx context.Context, userEntity *entity.User) (v0.PasskeyCreationOptionsOutput, error) {
+	s.logger.Debug().Msg("begin passkey registration")
+
+	challenge, err := generateChallenge()
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to generate challenge")
+		return v0.PasskeyCreationOptionsOutput{}, err
+	}
+
+	// Prevent registration of the same authenticator twice
+	passkeys, err := s.passkeyRepo.FindPasskeysByUserID(ctx, userEntity.ID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find passkeys")
+		return v0.PasskeyCreationOptionsOutput{}, err
+	}
+
+	excludeCredentials := make([]v0.PublicKeyCredentialDescriptor, len(passkeys))
+	for i, passkey := range passkeys {
+		excludeCredentials[i] = mapPasskeyToDescriptor(passkey)
+	}
+
+	// Challenge is bound to user, so only the last started registration can be finished
+	err = s.manager.SetWithExpiration(
+		ctx,
+		cachehelper.CreateCacheKey(registrationCachePrefix, userEntity.ID.String()),
+		challenge,
+		time.Duration(s.cfg.ChallengeTTL)*time.Second,
+	)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to save challenge")
+		return v0.PasskeyCreationOptionsOutput{}, err
+	}
+
+	pubKeyCredParams := make([]v0.PublicKeyCredentialParameters, len(supportedCOSEAlgorithm))
+	for i, alg := range supportedCOSEAlgorithm {
+		pubKeyCredParams[i] = v0.PublicKeyCredentialParameters{Type: credentialType, Alg: alg}
+	}
+
+	return v0.PasskeyCreationOptionsOutput{
+		PublicKey: v0.PublicKeyCredentialCreationOptions{
+			Challenge: challenge,
+			RP: v0.PublicKeyCredentialRPEntity{
+				ID:   s.cfg.RPID,
+				Name: s.cfg.RPName,
+			},
+			User: v0.PublicKeyCredentialUserEntity{
+				ID:          encodeBase64URL(userEntity.ID[:]),
+				Name:        lo.CoalesceOrEmpty(userEntity.Email, userEntity.Username),
+				DisplayName: userEntity.Username,
+			},
+			PubKeyCredParams:   pubKeyCredParams,
+			Timeout:            s.cfg.ChallengeTTL * 1000,
+			ExcludeCredentials: excludeCredentials,
+			AuthenticatorSelection: v0.AuthenticatorSelectionCriteria{
+				ResidentKey:        residentKeyRequired,
+				RequireResidentKey: true,
+				UserVerification:   userVerificationRequired,
+			},
+			Attestation: attestationNone,
+		},
+	}, nil
+}
+
+func (s *svc) FinishRegistration(
+	ctx context.Context,
+	userEntity *entity.User,
+	input v0.PasskeyRegistrationInput,
+) (*entity.Passkey, error) {
+	s.logger.Debug().Msg("finish passkey registration")
+
+	challenge, err := s.popChallenge(ctx, cachehelper.CreateCacheKey(registrationCachePrefix, userEntity.ID.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	credentialID, err := decodeBase64URL(input.Credential.RawID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to decode credential id")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	// Check client data
+	_, err = s.verifyClientData(input.Credential.Response.ClientDataJSON, clientDataTypeCreate, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check authenticator data. Attestation statement is not verified, because attestation "none" is requested
+	attestationObjectBytes, err := decodeBase64URL(input.Credential.Response.AttestationObject)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to decode attestation object")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	var attObj attestationObject
+	if _, err = decodeCBOR(attestationObjectBytes, &attObj); err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to decode attestation object")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	authData, err := s.verifyAuthenticatorData(attObj.AuthData)
+	if err != nil {
+		return nil, err
+	}
+	if !authData.hasFlag(flagAttestedCredentialData) || !bytes.Equal(authData.CredentialID, credentialID) {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidPasskey).Msg("credential data mismatch")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	_, alg, err := parseCOSEKey(authData.PublicKey)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to parse credential public key")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	// Save passkey
+	passkeyEntity := &entity.Passkey{
+		UserID:       userEntity.ID,
+		CredentialID: credentialID,
+		PublicKey:    authData.PublicKey,
+		Algorithm:    alg,
+		SignCount:    authData.SignCount,
+		Name:         lo.Ternary(input.Name != "", input.Name, defaultPasskeyName),
+	}
+	if aaguid, err := uuid.FromBytes(authData.AAGUID); err == nil {
+		passkeyEntity.AAGUID = &aaguid
+	}
+	if len(input.Credential.Response.Transports) > 0 {
+		passkeyEntity.Transports = lo.ToPtr(strings.Join(input.Credential.Response.Transports, ","))
+	}
+
+	passkeyEntity, err = s.passkeyRepo.CreatePasskey(ctx, passkeyEntity)
+	if errors.Is(err, repo.ErrDuplicatePasskey) {
+		s.logger.Error().Stack().Err(err).Msg("passkey already registered")
+		return nil, infrastructure.ErrDuplicatePasskey
+	}
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to create passkey")
+		return nil, err
+	}
+
+	return passkeyEntity, nil
+}
+
+func (s *svc) BeginLogin(ctx context.Context) (v0.PasskeyRequestOptionsOutput, error) {
+	s.logger.Debug().Msg("begin passkey login")
+
+	challenge, err := generateChallenge()
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to generate challenge")
+		return v0.PasskeyRequestOptionsOutput{}, err
+	}
+
+	challengeID := uuid.New().String()
+	err = s.manager.SetWithExpiration(
+		ctx,
+		cachehelper.CreateCacheKey(loginCachePrefix, challengeID),
+		challenge,
+		time.Duration(s.cfg.ChallengeTTL)*time.Second,
+	)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to save challenge")
+		return v0.PasskeyRequestOptionsOutput{}, err
+	}
+
+	// Allowed credentials are empty, so authenticator offers discoverable passkeys
+	return v0.PasskeyRequestOptionsOutput{
+		ChallengeID: challengeID,
+		PublicKey: v0.PublicKeyCredentialRequestOptions{
+			Challenge:        challenge,
+			RPID:             s.cfg.RPID,
+			Timeout:          s.cfg.ChallengeTTL * 1000,
+			AllowCredentials: []v0.PublicKeyCredentialDescriptor{},
+			UserVerification: userVerificationRequired,
+		},
+	}, nil
+}
+
+func (s *svc) FinishLogin(ctx context.Context, input v0.PasskeyLoginInput) (*entity.Passkey, error) {
+	s.logger.Debug().Msg("finish passkey login")
+
+	challenge, err := s.popChallenge(ctx, cachehelper.CreateCacheKey(loginCachePrefix, input.ChallengeID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Get passkey
+	credentialID, err := decodeBase64URL(input.Credential.RawID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to decode credential id")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	passkeyEntity, err := s.passkeyRepo.FindPasskeyByCredentialID(ctx, credentialID)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to find passkey")
+		return nil, err
+	}
+	if passkeyEntity == nil {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidPasskey).Msg("passkey not found")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	// User handle is returned for discoverable credentials and must belong to passkey owner
+	if input.Credential.Response.UserHandle != "" {
+		userHandle, err := decodeBase64URL(input.Credential.Response.UserHandle)
+		if err != nil || !bytes.Equal(userHandle, passkeyEntity.UserID[:]) {
+			s.logger.Error().Stack().Err(infrastructure.ErrInvalidPasskey).Msg("user handle mismatch")
+			return nil, infrastructure.ErrInvalidPasskey
+		}
+	}
+
+	// Check client data and authenticator data
+	clientDataJSON, err := s.verifyClientData(input.Credential.Response.ClientDataJSON, clientDataTypeGet, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	authDataBytes, err := decodeBase64URL(input.Credential.Response.AuthenticatorData)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to decode authenticator data")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	authData, err := s.verifyAuthenticatorData(authDataBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check signature over authenticator data and client data hash
+	signature, err := decodeBase64URL(input.Credential.Response.Signature)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to decode signature")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	publicKey, _, err := parseCOSEKey(passkeyEntity.PublicKey)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to parse passkey public key")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signedData := append(slices.Clone(authDataBytes), clientDataHash[:]...)
+	if err = verifySignature(publicKey, signedData, signature); err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to verify signature")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	// Non-increasing signature counter means that authenticator may have been cloned
+	if (authData.SignCount != 0 || passkeyEntity.SignCount != 0) && authData.SignCount <= passkeyEntity.SignCount {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidPasskey).Msg("signature counter is not increased")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	updated, err := s.passkeyRepo.UpdatePasskeySignCount(
+		ctx,
+		passkeyEntity.ID,
+		passkeyEntity.SignCount,
+		authData.SignCount,
+	)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to update passkey sign count")
+		return nil, err
+	}
+	if !updated {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidPasskey).Msg("passkey has been used concurrently")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	passkeyEntity.SignCount = authData.SignCount
+	return passkeyEntity, nil
+}
+
+// popChallenge returns challenge and deletes it, so every challenge can be used only once.
+// Concurrent requests may read the same challenge, so it is consumed with atomic increment and only the first wins
+func (s *svc) popChallenge(ctx context.Context, key string) (string, error) {
+	var challenge string
+	err := s.manager.Get(ctx, key, &challenge)
+	if errors.Is(err, cache.ErrCacheEntryNotFound) {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidOrExpiredChallenge).Msg("challenge not found")
+		return "", infrastructure.ErrInvalidOrExpiredChallenge
+	}
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to get challenge")
+		return "", err
+	}
+
+	consumed, err := s.manager.Increment(
+		ctx,
+		cachehelper.CreateCacheKey(consumedCachePrefix, challenge),
+		time.Duration(s.cfg.ChallengeTTL)*time.Second,
+	)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to consume challenge")
+		return "", err
+	}
+	if consumed > 1 {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidOrExpiredChallenge).Msg("challenge is already consumed")
+		return "", infrastructure.ErrInvalidOrExpiredChallenge
+	}
+
+	err = s.manager.Delete(ctx, key)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to delete challenge")
+		return "", err
+	}
+
+	return challenge, nil
+}
+
+// verifyClientData checks client data as described in https://www.w3.org/TR/webauthn-3/#sctn-registering-a-new-credential
+// and returns its raw JSON for signature verification
+func (s *svc) verifyClientData(encoded string, expectedType string, expectedChallenge string) ([]byte, error) {
+	clientDataJSON, err := decodeBase64URL(encoded)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to decode client data")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	var data clientData
+	if err = json.Unmarshal(clientDataJSON, &data); err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to unmarshal client data")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	if data.Type != expectedType {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidPasskey).Msgf("unexpected client data type: %s", data.Type)
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	if subtle.ConstantTimeCompare([]byte(strings.TrimRight(data.Challenge, "=")), []byte(expectedChallenge)) != 1 {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidPasskey).Msg("challenge mismatch")
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	if !slices.Contains(s.origins, data.Origin) {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidPasskey).Msgf("unexpected origin: %s", data.Origin)
+		return nil, infrastructure.ErrInvalidPasskey
+	}
+
+	return clientDataJSON, nil
+}
+
+func (s *svc) verifyAuthenticatorData(data []byte) (authenticatorData, error) {
+	authData, err := parseAuthenticatorData(data)
+	if err != nil {
+		s.logger.Error().Stack().Err(err).Msg("failed to parse authenticator data")
+		return authenticatorData{}, infrastructure.ErrInvalidPasskey
+	}
+
+	rpIDHash := sha256.Sum256([]byte(s.cfg.RPID))
+	if !bytes.Equal(authData.RPIDHash, rpIDHash[:]) {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidPasskey).Msg("rp id hash mismatch")
+		return authenticatorData{}, infrastructure.ErrInvalidPasskey
+	}
+
+	if !authData.hasFlag(flagUserPresent) || !authData.hasFlag(flagUserVerified) {
+		s.logger.Error().Stack().Err(infrastructure.ErrInvalidPasskey).Msg("user is not present or verified")
+		return authenticatorData{}, infrastructure.ErrInvalidPasskey
+	}
+
+	return authData, nil
+}
+
+func generateChallenge() (string, error) {
+	challenge := make([]byte, challengeSize)
+	if _, err := rand.Read(challenge); err != nil {
+		return "", err
+	}
+
+	return encodeBase64URL(challenge), nil
+}
+
+func mapPasskeyToDescriptor(passkey *entity.Passkey) v0.PublicKeyCredentialDescriptor {
+	descriptor := v0.PublicKeyCredentialDescriptor{
+		Type: credentialType,
+		ID:   encodeBase64URL(passkey.CredentialID),
+	}
+	if passkey.Transports != nil && *passkey.Transports != "" {
+		descriptor.Transports = strings.Split(*passkey.Transports, ",")
+	}
+
+	return descriptor
+}
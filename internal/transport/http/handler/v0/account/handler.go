@@ -3,6 +3,7 @@ package account
 import (
 	"errors"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/mandarine-io/backend/internal/infrastructure/locale"
 	"github.com/mandarine-io/backend/internal/service/domain"
 	"github.com/mandarine-io/backend/internal/service/infrastructure"
@@ -14,6 +15,11 @@ import (
 	"net/http"
 )
 
+var (
+	// stateCookieName is the same cookie, which is set by social login consent page request
+	stateCookieName = "OAuthState"
+)
+
 type handler struct {
 	svc    domain.AccountService
 	logger zerolog.Logger
@@ -55,6 +61,7 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 			middleware.Registry.Auth,
 			middleware.Registry.BannedUser,
 			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
 			h.updateUsername,
 		)
 		accountRouter.PATCH(
@@ -62,6 +69,7 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 			middleware.Registry.Auth,
 			middleware.Registry.BannedUser,
 			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
 			h.updateEmail,
 		)
 		accountRouter.POST(
@@ -69,13 +77,31 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 			middleware.Registry.Auth,
 			middleware.Registry.BannedUser,
 			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
 			h.verifyEmail,
 		)
+		accountRouter.PATCH(
+			"/phone",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.updatePhone,
+		)
+		accountRouter.POST(
+			"/phone/verify",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.verifyPhone,
+		)
 		accountRouter.POST(
 			"/password",
 			middleware.Registry.Auth,
 			middleware.Registry.BannedUser,
 			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
 			h.setPassword,
 		)
 		accountRouter.PATCH(
@@ -83,6 +109,7 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 			middleware.Registry.Auth,
 			middleware.Registry.BannedUser,
 			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
 			h.updatePassword,
 		)
 		accountRouter.DELETE(
@@ -97,6 +124,124 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 			middleware.Registry.BannedUser,
 			h.restoreAccount,
 		)
+		accountRouter.GET(
+			"/sessions",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.getSessions,
+		)
+		accountRouter.DELETE(
+			"/sessions",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.revokeOtherSessions,
+		)
+		accountRouter.DELETE(
+			"/sessions/:id",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.revokeSession,
+		)
+		accountRouter.POST(
+			"/2fa/setup",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			h.setupTOTP,
+		)
+		accountRouter.POST(
+			"/2fa/enable",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			h.enableTOTP,
+		)
+		accountRouter.POST(
+			"/2fa/disable",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.disableTOTP,
+		)
+		accountRouter.POST(
+			"/2fa/recovery-codes",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.regenerateRecoveryCodes,
+		)
+		accountRouter.GET(
+			"/passkeys",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.getPasskeys,
+		)
+		accountRouter.POST(
+			"/passkeys/register/begin",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.beginPasskeyRegistration,
+		)
+		accountRouter.POST(
+			"/passkeys/register/finish",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.finishPasskeyRegistration,
+		)
+		accountRouter.DELETE(
+			"/passkeys/:id",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.deletePasskey,
+		)
+		accountRouter.GET(
+			"/identities",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.getIdentities,
+		)
+		accountRouter.POST(
+			"/identities/confirm",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.confirmIdentityLink,
+		)
+		accountRouter.POST(
+			"/identities/:provider",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.linkIdentity,
+		)
+		accountRouter.DELETE(
+			"/identities/:provider",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.unlinkIdentity,
+		)
 	}
 }
 
@@ -279,7 +424,116 @@ func (h *handler) verifyEmail(ctx *gin.Context) {
 
 	if err := h.svc.VerifyEmail(ctx, principal.ID, input); err != nil {
 		switch {
-		case errors.Is(err, infrastructure.ErrInvalidOrExpiredOTP):
+		case errors.Is(err, infrastructure.ErrInvalidOrExpiredOTP),
+			errors.Is(err, infrastructure.ErrOTPAttemptsExceeded):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrUserNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// updatePhone godoc
+//
+//	@Id				UpdatePhone
+//	@Summary		Update phone
+//	@Description	Request for updating phone number in E.164 format. User must be logged in. In process will be sent SMS with verification code. In response will be returned updated account info.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input	body		v0.UpdatePhoneInput	true	"Update phone request body"
+//	@Success		200		{object}	v0.AccountOutput	"Account info (phone is verified)"
+//	@Success		202		{object}	v0.AccountOutput	"Account info (phone is not verified)"
+//	@Failure		400		{object}	v0.ErrorOutput		"Validation error"
+//	@Failure		401		{object}	v0.ErrorOutput		"Unauthorized"
+//	@Failure		403		{object}	v0.ErrorOutput		"User is blocked or deleted"
+//	@Failure		404		{object}	v0.ErrorOutput		"Not found user"
+//	@Failure		409		{object}	v0.ErrorOutput		"Duplicate phone"
+//	@Failure		429		{object}	v0.ErrorOutput		"Too many requests, rate limit is exceeded"
+//	@Failure		500		{object}	v0.ErrorOutput		"Internal server error"
+//	@Router			/v0/account/phone [patch]
+func (h *handler) updatePhone(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle update phone")
+
+	input := v0.UpdatePhoneInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	h.logger.Debug().Msg("get localizer")
+	localizer := ctx.Value(middleware.LocalizerKey).(locale.Localizer)
+
+	res, err := h.svc.UpdatePhone(ctx, principal.ID, input, localizer)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domain.ErrDuplicatePhone):
+			_ = util.ErrorWithStatus(ctx, http.StatusConflict, err)
+		case errors.Is(err, domain.ErrSendSMS):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	if res.IsPhoneVerified {
+		ctx.JSON(http.StatusOK, res)
+	} else {
+		ctx.JSON(http.StatusAccepted, res)
+	}
+}
+
+// verifyPhone godoc
+//
+//	@Id				VerifyPhone
+//	@Summary		Verify phone
+//	@Description	Request for verify phone number with code from SMS. User must be logged in.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input	body	v0.VerifyPhoneInput	true	"Verify phone request body"
+//	@Success		200
+//	@Failure		400	{object}	v0.ErrorOutput	"Validation error"
+//	@Failure		401	{object}	v0.ErrorOutput	"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput	"User is blocked or deleted"
+//	@Failure		404	{object}	v0.ErrorOutput	"Not found user"
+//	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
+//	@Router			/v0/account/phone/verify [post]
+func (h *handler) verifyPhone(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle verify phone")
+
+	input := v0.VerifyPhoneInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	if err := h.svc.VerifyPhone(ctx, principal.ID, input); err != nil {
+		switch {
+		case errors.Is(err, infrastructure.ErrInvalidOrExpiredOTP),
+			errors.Is(err, infrastructure.ErrOTPAttemptsExceeded):
 			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
 		case errors.Is(err, domain.ErrUserNotFound):
 			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
@@ -372,7 +626,7 @@ func (h *handler) updatePassword(ctx *gin.Context) {
 		return
 	}
 
-	if err := h.svc.UpdatePassword(ctx, principal.ID, input); err != nil {
+	if err := h.svc.UpdatePassword(ctx, principal.ID, principal.SessionID, input); err != nil {
 		switch {
 		case errors.Is(err, domain.ErrIncorrectOldPassword):
 			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
@@ -467,3 +721,669 @@ func (h *handler) deleteAccount(ctx *gin.Context) {
 
 	ctx.Status(http.StatusNoContent)
 }
+
+// getSessions godoc
+//
+//	@Id				GetSessions
+//	@Summary		Get sessions
+//	@Description	Request for receiving active sessions of own account. User must be logged in. In response will be returned device, location and last activity of each session. Device name and location are reported by client and are not verified.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Success		200	{object}	v0.SessionsOutput	"Active sessions"
+//	@Failure		401	{object}	v0.ErrorOutput		"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput		"User is blocked or deleted"
+//	@Failure		500	{object}	v0.ErrorOutput		"Internal server error"
+//	@Router			/v0/account/sessions [get]
+func (h *handler) getSessions(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle get sessions")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	res, err := h.svc.GetSessions(ctx, principal.ID, principal.SessionID)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// revokeSession godoc
+//
+//	@Id				RevokeSession
+//	@Summary		Revoke session
+//	@Description	Request for revoking one of own sessions. User must be logged in. Refresh token and the latest access token of the revoked session stop working immediately, access tokens issued before are valid until they expire.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			id	path	string	true	"Session ID"
+//	@Success		204
+//	@Failure		400	{object}	v0.ErrorOutput	"Validation error"
+//	@Failure		401	{object}	v0.ErrorOutput	"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput	"User is blocked or deleted"
+//	@Failure		404	{object}	v0.ErrorOutput	"Not found session"
+//	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
+//	@Router			/v0/account/sessions/{id} [delete]
+func (h *handler) revokeSession(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle revoke session")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	sessionIDRaw := ctx.Param("id")
+	sessionID, err := uuid.Parse(sessionIDRaw)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.svc.RevokeSession(ctx, principal.ID, sessionID); err != nil {
+		switch {
+		case errors.Is(err, infrastructure.ErrSessionNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// revokeOtherSessions godoc
+//
+//	@Id				RevokeOtherSessions
+//	@Summary		Revoke other sessions
+//	@Description	Request for signing out everywhere else. User must be logged in. All own sessions except the current one will be revoked.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Success		204
+//	@Failure		401	{object}	v0.ErrorOutput	"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput	"User is blocked or deleted"
+//	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
+//	@Router			/v0/account/sessions [delete]
+func (h *handler) revokeOtherSessions(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle revoke other sessions")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	if err := h.svc.RevokeOtherSessions(ctx, principal.ID, principal.SessionID); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// setupTOTP godoc
+//
+//	@Id				SetupTOTP
+//	@Summary		Setup two-factor authentication
+//	@Description	Request for starting two-factor authentication setup. User must be logged in. In response will be returned secret and provisioning URI for authenticator app. Two-factor authentication becomes enabled after confirmation by code.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Success		200	{object}	v0.TOTPSetupOutput	"TOTP secret"
+//	@Failure		401	{object}	v0.ErrorOutput		"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput		"User is blocked or deleted"
+//	@Failure		404	{object}	v0.ErrorOutput		"Not found user"
+//	@Failure		409	{object}	v0.ErrorOutput		"Two-factor authentication is already enabled"
+//	@Failure		500	{object}	v0.ErrorOutput		"Internal server error"
+//	@Router			/v0/account/2fa/setup [post]
+func (h *handler) setupTOTP(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle setup totp")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	res, err := h.svc.SetupTOTP(ctx, principal.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domain.ErrMFAAlreadyEnabled):
+			_ = util.ErrorWithStatus(ctx, http.StatusConflict, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// enableTOTP godoc
+//
+//	@Id				EnableTOTP
+//	@Summary		Enable two-factor authentication
+//	@Description	Request for confirming two-factor authentication setup by code from authenticator app. User must be logged in. In response will be returned one-time recovery codes.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input	body		v0.TOTPCodeInput		true	"TOTP code request body"
+//	@Success		200		{object}	v0.RecoveryCodesOutput	"Recovery codes"
+//	@Failure		400		{object}	v0.ErrorOutput			"Validation error"
+//	@Failure		401		{object}	v0.ErrorOutput			"Unauthorized"
+//	@Failure		403		{object}	v0.ErrorOutput			"User is blocked or deleted"
+//	@Failure		404		{object}	v0.ErrorOutput			"Not found user"
+//	@Failure		409		{object}	v0.ErrorOutput			"Two-factor authentication is already enabled"
+//	@Failure		429		{object}	v0.ErrorOutput			"Too many failed attempts, account or IP is temporarily locked"
+//	@Failure		500		{object}	v0.ErrorOutput			"Internal server error"
+//	@Router			/v0/account/2fa/enable [post]
+func (h *handler) enableTOTP(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle enable totp")
+
+	input := v0.TOTPCodeInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	res, err := h.svc.EnableTOTP(ctx, principal.ID, input, util.GetClientInfo(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, infrastructure.ErrInvalidTOTPCode):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrMFASetupNotStarted):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrUserNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domain.ErrMFAAlreadyEnabled):
+			_ = util.ErrorWithStatus(ctx, http.StatusConflict, err)
+		case errors.Is(err, infrastructure.ErrAccountTemporarilyLocked),
+			errors.Is(err, infrastructure.ErrTooManyAttempts):
+			_ = util.TooManyRequestsError(ctx, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// disableTOTP godoc
+//
+//	@Id				DisableTOTP
+//	@Summary		Disable two-factor authentication
+//	@Description	Request for disabling two-factor authentication. User must be logged in. Code from authenticator app or one of recovery codes is required.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input	body	v0.TOTPCodeInput	true	"TOTP code request body"
+//	@Success		200
+//	@Failure		400	{object}	v0.ErrorOutput	"Validation error"
+//	@Failure		401	{object}	v0.ErrorOutput	"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput	"User is blocked or deleted"
+//	@Failure		404	{object}	v0.ErrorOutput	"Not found user"
+//	@Failure		429	{object}	v0.ErrorOutput	"Too many failed attempts, account or IP is temporarily locked"
+//	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
+//	@Router			/v0/account/2fa/disable [post]
+func (h *handler) disableTOTP(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle disable totp")
+
+	input := v0.TOTPCodeInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	if err := h.svc.DisableTOTP(ctx, principal.ID, input, util.GetClientInfo(ctx)); err != nil {
+		switch {
+		case errors.Is(err, infrastructure.ErrInvalidTOTPCode):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrMFANotEnabled):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrUserNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, infrastructure.ErrAccountTemporarilyLocked),
+			errors.Is(err, infrastructure.ErrTooManyAttempts):
+			_ = util.TooManyRequestsError(ctx, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
+
+// regenerateRecoveryCodes godoc
+//
+//	@Id				RegenerateRecoveryCodes
+//	@Summary		Regenerate recovery codes
+//	@Description	Request for regenerating recovery codes. User must be logged in. Previous recovery codes become invalid.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input	body		v0.TOTPCodeInput		true	"TOTP code request body"
+//	@Success		200		{object}	v0.RecoveryCodesOutput	"Recovery codes"
+//	@Failure		400		{object}	v0.ErrorOutput			"Validation error"
+//	@Failure		401		{object}	v0.ErrorOutput			"Unauthorized"
+//	@Failure		403		{object}	v0.ErrorOutput			"User is blocked or deleted"
+//	@Failure		404		{object}	v0.ErrorOutput			"Not found user"
+//	@Failure		429		{object}	v0.ErrorOutput			"Too many failed attempts, account or IP is temporarily locked"
+//	@Failure		500		{object}	v0.ErrorOutput			"Internal server error"
+//	@Router			/v0/account/2fa/recovery-codes [post]
+func (h *handler) regenerateRecoveryCodes(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle regenerate recovery codes")
+
+	input := v0.TOTPCodeInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	res, err := h.svc.RegenerateRecoveryCodes(ctx, principal.ID, input, util.GetClientInfo(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, infrastructure.ErrInvalidTOTPCode):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrMFANotEnabled):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrUserNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, infrastructure.ErrAccountTemporarilyLocked),
+			errors.Is(err, infrastructure.ErrTooManyAttempts):
+			_ = util.TooManyRequestsError(ctx, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// getPasskeys godoc
+//
+//	@Id				GetPasskeys
+//	@Summary		Get passkeys
+//	@Description	Request for receiving passkeys of own account. User must be logged in.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Success		200	{object}	v0.PasskeysOutput	"Passkeys"
+//	@Failure		401	{object}	v0.ErrorOutput		"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput		"User is blocked or deleted"
+//	@Failure		500	{object}	v0.ErrorOutput		"Internal server error"
+//	@Router			/v0/account/passkeys [get]
+func (h *handler) getPasskeys(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle get passkeys")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	res, err := h.svc.GetPasskeys(ctx, principal.ID)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// beginPasskeyRegistration godoc
+//
+//	@Id				BeginPasskeyRegistration
+//	@Summary		Begin passkey registration
+//	@Description	Request for starting passkey registration. User must be logged in. In response will be returned options for navigator.credentials.create(). Challenge is valid for a limited time and can be used only once.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Success		200	{object}	v0.PasskeyCreationOptionsOutput	"Passkey creation options"
+//	@Failure		401	{object}	v0.ErrorOutput					"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput					"User is blocked or deleted"
+//	@Failure		404	{object}	v0.ErrorOutput					"Not found user"
+//	@Failure		500	{object}	v0.ErrorOutput					"Internal server error"
+//	@Router			/v0/account/passkeys/register/begin [post]
+func (h *handler) beginPasskeyRegistration(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle begin passkey registration")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	res, err := h.svc.BeginPasskeyRegistration(ctx, principal.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// finishPasskeyRegistration godoc
+//
+//	@Id				FinishPasskeyRegistration
+//	@Summary		Finish passkey registration
+//	@Description	Request for finishing passkey registration by credential returned from navigator.credentials.create(). User must be logged in. In response will be returned created passkey.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input	body		v0.PasskeyRegistrationInput	true	"Passkey registration request body"
+//	@Success		201		{object}	v0.PasskeyOutput			"Created passkey"
+//	@Failure		400		{object}	v0.ErrorOutput				"Validation error or invalid passkey"
+//	@Failure		401		{object}	v0.ErrorOutput				"Unauthorized"
+//	@Failure		403		{object}	v0.ErrorOutput				"User is blocked or deleted"
+//	@Failure		404		{object}	v0.ErrorOutput				"Not found user"
+//	@Failure		409		{object}	v0.ErrorOutput				"Passkey is already registered"
+//	@Failure		500		{object}	v0.ErrorOutput				"Internal server error"
+//	@Router			/v0/account/passkeys/register/finish [post]
+func (h *handler) finishPasskeyRegistration(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle finish passkey registration")
+
+	input := v0.PasskeyRegistrationInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	res, err := h.svc.FinishPasskeyRegistration(ctx, principal.ID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, infrastructure.ErrInvalidPasskey):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, infrastructure.ErrInvalidOrExpiredChallenge):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrUserNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, infrastructure.ErrDuplicatePasskey):
+			_ = util.ErrorWithStatus(ctx, http.StatusConflict, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+// deletePasskey godoc
+//
+//	@Id				DeletePasskey
+//	@Summary		Delete passkey
+//	@Description	Request for removing one of own passkeys. User must be logged in. Removed passkey can no longer be used for login.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			id	path	string	true	"Passkey ID"
+//	@Success		204
+//	@Failure		400	{object}	v0.ErrorOutput	"Validation error"
+//	@Failure		401	{object}	v0.ErrorOutput	"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput	"User is blocked or deleted"
+//	@Failure		404	{object}	v0.ErrorOutput	"Not found passkey"
+//	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
+//	@Router			/v0/account/passkeys/{id} [delete]
+func (h *handler) deletePasskey(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle delete passkey")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	passkeyIDRaw := ctx.Param("id")
+	passkeyID, err := uuid.Parse(passkeyIDRaw)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.svc.DeletePasskey(ctx, principal.ID, passkeyID); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPasskeyNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// getIdentities godoc
+//
+//	@Id				GetIdentities
+//	@Summary		Get linked identities
+//	@Description	Request for receiving social login providers linked to own account. User must be logged in.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Success		200	{object}	v0.IdentitiesOutput	"Linked identities"
+//	@Failure		401	{object}	v0.ErrorOutput		"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput		"User is blocked or deleted"
+//	@Failure		500	{object}	v0.ErrorOutput		"Internal server error"
+//	@Router			/v0/account/identities [get]
+func (h *handler) getIdentities(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle get identities")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	res, err := h.svc.GetIdentities(ctx, principal.ID)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// linkIdentity godoc
+//
+//	@Id				LinkIdentity
+//	@Summary		Link identity
+//	@Description	Request for linking social login provider to own account. User must be logged in. Consent page URL is received by social login request, then code and state from callback are sent in request body. Identity linked to another account is not moved, it must be unlinked there first.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			provider	path		string					true	"Social login provider (yandex, google, mailru, vk, telegram or name of OpenID Connect provider)"
+//	@Param			input		body		v0.LinkIdentityInput	true	"Link identity request body"
+//	@Success		200			{object}	v0.IdentityOutput		"Linked identity"
+//	@Failure		400			{object}	v0.ErrorOutput			"Validation error or invalid state"
+//	@Failure		401			{object}	v0.ErrorOutput			"Unauthorized"
+//	@Failure		403			{object}	v0.ErrorOutput			"User is blocked or deleted"
+//	@Failure		404			{object}	v0.ErrorOutput			"Provider not found or user info not received"
+//	@Failure		409			{object}	v0.ErrorOutput			"Identity is linked to another user"
+//	@Failure		500			{object}	v0.ErrorOutput			"Internal server error"
+//	@Router			/v0/account/identities/{provider} [post]
+func (h *handler) linkIdentity(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle link identity")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	input := v0.LinkIdentityInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	// Get and check state
+	cookieState, err := ctx.Cookie(stateCookieName)
+	ctx.SetCookie(stateCookieName, "", -1, "", "", true, true)
+	if err != nil || cookieState != input.State {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, domain.ErrInvalidState)
+		return
+	}
+
+	res, err := h.svc.LinkIdentity(ctx, principal.ID, ctx.Param("provider"), input, util.GetClientInfo(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidState):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrInvalidProvider),
+			errors.Is(err, domain.ErrUserInfoNotReceived):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domain.ErrIdentityLinked):
+			_ = util.ErrorWithStatus(ctx, http.StatusConflict, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// confirmIdentityLink godoc
+//
+//	@Id				ConfirmIdentityLink
+//	@Summary		Confirm identity link
+//	@Description	Request for linking social login provider, which matched own account by email, to own account. User must be logged in to the account, which identity link token was issued for by social login.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input	body		v0.ConfirmIdentityLinkInput	true	"Confirm identity link request body"
+//	@Success		200		{object}	v0.IdentityOutput			"Linked identity"
+//	@Failure		400		{object}	v0.ErrorOutput				"Validation error or invalid, expired or already used token"
+//	@Failure		401		{object}	v0.ErrorOutput				"Unauthorized"
+//	@Failure		403		{object}	v0.ErrorOutput				"User is blocked or deleted"
+//	@Failure		409		{object}	v0.ErrorOutput				"Identity is already linked"
+//	@Failure		500		{object}	v0.ErrorOutput				"Internal server error"
+//	@Router			/v0/account/identities/confirm [post]
+func (h *handler) confirmIdentityLink(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle confirm identity link")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	input := v0.ConfirmIdentityLinkInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.svc.ConfirmIdentityLink(ctx, principal.ID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, infrastructure.ErrInvalidOrExpiredOTP):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrIdentityLinked):
+			_ = util.ErrorWithStatus(ctx, http.StatusConflict, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// unlinkIdentity godoc
+//
+//	@Id				UnlinkIdentity
+//	@Summary		Unlink identity
+//	@Description	Request for unlinking social login provider from own account. User must be logged in. The last way to sign in cannot be removed.
+//	@Security		BearerAuth
+//	@Tags			Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			provider	path	string	true	"Social login provider (yandex, google, mailru, vk, telegram or name of OpenID Connect provider)"
+//	@Success		204
+//	@Failure		400	{object}	v0.ErrorOutput	"Identity is the last login method"
+//	@Failure		401	{object}	v0.ErrorOutput	"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput	"User is blocked or deleted"
+//	@Failure		404	{object}	v0.ErrorOutput	"Not found user or identity"
+//	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
+//	@Router			/v0/account/identities/{provider} [delete]
+func (h *handler) unlinkIdentity(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle unlink identity")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	if err := h.svc.UnlinkIdentity(ctx, principal.ID, ctx.Param("provider")); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrIdentityNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domain.ErrLastLoginMethod):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
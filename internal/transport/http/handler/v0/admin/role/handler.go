@@ -0,0 +1,132 @@
+package role
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	apihandler "github.com/mandarine-io/backend/internal/transport/http/handler"
+	"github.com/mandarine-io/backend/internal/transport/http/middleware"
+	"github.com/mandarine-io/backend/internal/transport/http/util"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/rs/zerolog"
+	"net/http"
+)
+
+type handler struct {
+	svc    domain.RoleService
+	logger zerolog.Logger
+}
+
+type Option func(*handler)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(h *handler) {
+		h.logger = logger
+	}
+}
+
+func NewHandler(svc domain.RoleService, opts ...Option) apihandler.APIHandler {
+	h := &handler{
+		svc:    svc,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+func (h *handler) RegisterRoutes(router *gin.Engine) {
+	h.logger.Debug().Msg("register admin role routes")
+
+	roleRouter := router.Group("/v0/admin/roles")
+	{
+		roleRouter.GET(
+			"",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.AdminRole,
+			middleware.Registry.MFAUser,
+			h.getRoles,
+		)
+		roleRouter.PATCH(
+			"/:name",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.AdminRole,
+			middleware.Registry.MFAUser,
+			h.updateRole,
+		)
+	}
+}
+
+// getRoles godoc
+//
+//	@Id				GetRoles
+//	@Summary		Get roles
+//	@Description	Request for getting all roles. User must be logged in as admin.
+//	@Security		BearerAuth
+//	@Tags			Admin API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Success		200	{object}	v0.RolesOutput	"Roles"
+//	@Failure		401	{object}	v0.ErrorOutput	"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput	"User is blocked, deleted, not admin or must setup two-factor authentication"
+//	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
+//	@Router			/v0/admin/roles [get]
+func (h *handler) getRoles(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle get roles")
+
+	res, err := h.svc.GetRoles(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// updateRole godoc
+//
+//	@Id				UpdateRole
+//	@Summary		Update role
+//	@Description	Request for updating role settings, e.g. requirement of two-factor authentication for role members. User must be logged in as admin.
+//	@Security		BearerAuth
+//	@Tags			Admin API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			name	path		string				true	"Role name"
+//	@Param			input	body		v0.UpdateRoleInput	true	"Update role request body"
+//	@Success		200		{object}	v0.RoleOutput		"Role"
+//	@Failure		400		{object}	v0.ErrorOutput		"Validation error"
+//	@Failure		401		{object}	v0.ErrorOutput		"Unauthorized"
+//	@Failure		403		{object}	v0.ErrorOutput		"User is blocked, deleted, not admin or must setup two-factor authentication"
+//	@Failure		404		{object}	v0.ErrorOutput		"Role not found"
+//	@Failure		500		{object}	v0.ErrorOutput		"Internal server error"
+//	@Router			/v0/admin/roles/{name} [patch]
+func (h *handler) updateRole(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle update role")
+
+	input := v0.UpdateRoleInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.svc.UpdateRole(ctx, ctx.Param("name"), input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrRoleNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
@@ -17,10 +17,10 @@ import (
 
 var (
 	errRedirectURLNotFound = v0.NewI18nError("not found redirect url", "errors.redirect_url_not_found")
-	errInvalidState        = v0.NewI18nError("invalid state", "errors.invalid_state")
 
-	stateCookieName   = "OAuthState"
-	stateCookieMaxAge = 20 * 60
+	stateCookieName = "OAuthState"
+
+	magicLinkDeviceCookieName = "MagicLinkDevice"
 )
 
 type handler struct {
@@ -57,6 +57,13 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 	authRouter := router.Group("/v0/auth")
 	{
 		authRouter.POST("/login", h.Login)
+		authRouter.POST("/login/mfa", h.LoginMFA)
+		authRouter.POST("/login/phone", h.LoginPhone)
+		authRouter.POST("/login/phone/confirm", h.LoginPhoneConfirm)
+		authRouter.POST("/magic-link", h.SendMagicLink)
+		authRouter.POST("/magic-link/verify", h.VerifyMagicLink)
+		authRouter.POST("/passkey/login/begin", h.BeginPasskeyLogin)
+		authRouter.POST("/passkey/login/finish", h.FinishPasskeyLogin)
 		authRouter.POST("/refresh", h.RefreshTokens)
 		authRouter.GET("/social/:provider", h.SocialLogin)
 		authRouter.POST("/social/:provider/callback", h.SocialLoginCallback)
@@ -82,13 +89,16 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 //	@Tags			Authentication and Authorization API
 //	@Accept			application/json
 //	@Produce		application/json
-//	@Param			input	body		v0.LoginInput		true	"Login request body"
-//	@Header			200		{string}	Set-Cookie				"RefreshToken=; HttpOnly; Max-Age=86400; Secure"
-//	@Success		200		{object}	v0.JwtTokensOutput	"JWT tokens"
-//	@Failure		400		{object}	v0.ErrorOutput		"Validation error"
-//	@Failure		403		{object}	v0.ErrorOutput		"User is blocked"
-//	@Failure		404		{object}	v0.ErrorOutput		"User not found"
-//	@Failure		500		{object}	v0.ErrorOutput		"Internal server error"
+//	@Param			input				body		v0.LoginInput	true	"Login request body"
+//	@Param			X-Device-Name		header		string			false	"Client device name, reported by client and not verified"
+//	@Param			X-Client-Location	header		string			false	"Client location, reported by client and not verified"
+//	@Header			200					{string}	Set-Cookie		"RefreshToken=; HttpOnly; Max-Age=86400; Secure"
+//	@Success		200					{object}	v0.LoginOutput	"JWT tokens or MFA token, if two-factor authentication is enabled"
+//	@Failure		400					{object}	v0.ErrorOutput	"Validation error"
+//	@Failure		403					{object}	v0.ErrorOutput	"User is blocked"
+//	@Failure		404					{object}	v0.ErrorOutput	"User not found"
+//	@Failure		429					{object}	v0.ErrorOutput	"Too many failed attempts, account or IP is temporarily locked"
+//	@Failure		500					{object}	v0.ErrorOutput	"Internal server error"
 //	@Router			/v0/auth/login [post]
 func (h *handler) Login(ctx *gin.Context) {
 	h.logger.Debug().Msg("handle login")
@@ -99,7 +109,7 @@ func (h *handler) Login(ctx *gin.Context) {
 		return
 	}
 
-	res, err := h.svc.Login(ctx, input)
+	res, err := h.svc.Login(ctx, input, util.GetClientInfo(ctx))
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrUserNotFound):
@@ -108,6 +118,330 @@ func (h *handler) Login(ctx *gin.Context) {
 			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
 		case errors.Is(err, domain.ErrUserIsBlocked):
 			_ = util.ErrorWithStatus(ctx, http.StatusForbidden, err)
+		case errors.Is(err, infrastructure.ErrAccountTemporarilyLocked),
+			errors.Is(err, infrastructure.ErrTooManyAttempts):
+			_ = util.TooManyRequestsError(ctx, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// LoginMFA godoc
+//
+//	@Id				LoginMFA
+//	@Summary		Sign in with second factor
+//	@Description	Request for completing sign in of user with enabled two-factor authentication. MFA token is returned by login request, code is TOTP code from authenticator app or one of recovery codes.
+//	@Tags			Authentication and Authorization API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input				body		v0.LoginMFAInput	true	"Login MFA request body"
+//	@Param			X-Device-Name		header		string				false	"Client device name, reported by client and not verified"
+//	@Param			X-Client-Location	header		string				false	"Client location, reported by client and not verified"
+//	@Success		200					{object}	v0.JwtTokensOutput	"JWT tokens"
+//	@Failure		400					{object}	v0.ErrorOutput		"Validation error"
+//	@Failure		401					{object}	v0.ErrorOutput		"Invalid or expired MFA token"
+//	@Failure		403					{object}	v0.ErrorOutput		"User is blocked"
+//	@Failure		404					{object}	v0.ErrorOutput		"User not found"
+//	@Failure		429					{object}	v0.ErrorOutput		"Too many failed attempts, account or IP is temporarily locked"
+//	@Failure		500					{object}	v0.ErrorOutput		"Internal server error"
+//	@Router			/v0/auth/login/mfa [post]
+func (h *handler) LoginMFA(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle login mfa")
+
+	input := v0.LoginMFAInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.svc.LoginMFA(ctx, input, util.GetClientInfo(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, infrastructure.ErrInvalidJWTToken),
+			errors.Is(err, infrastructure.ErrExpiredJWTToken),
+			errors.Is(err, infrastructure.ErrBannedJWTToken):
+			_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, infrastructure.ErrInvalidTOTPCode):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrMFANotEnabled):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrUserNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domain.ErrUserIsBlocked):
+			_ = util.ErrorWithStatus(ctx, http.StatusForbidden, err)
+		case errors.Is(err, infrastructure.ErrAccountTemporarilyLocked),
+			errors.Is(err, infrastructure.ErrTooManyAttempts):
+			_ = util.TooManyRequestsError(ctx, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// LoginPhone godoc
+//
+//	@Id				LoginPhone
+//	@Summary		Sign in by phone
+//	@Description	Request for sign in by verified phone number. At the end will be sent SMS with code, which should be confirmed.
+//	@Tags			Authentication and Authorization API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input	body	v0.PhoneLoginInput	true	"Login by phone request body"
+//	@Success		202
+//	@Failure		400	{object}	v0.ErrorOutput	"Validation error or phone is not verified"
+//	@Failure		404	{object}	v0.ErrorOutput	"User not found"
+//	@Failure		429	{object}	v0.ErrorOutput	"Too many requests, rate limit is exceeded"
+//	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
+//	@Router			/v0/auth/login/phone [post]
+func (h *handler) LoginPhone(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle login phone")
+
+	input := v0.PhoneLoginInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	h.logger.Debug().Msg("get localizer")
+	localizer := ctx.Value(middleware.LocalizerKey).(locale.Localizer)
+
+	if err := h.svc.LoginPhone(ctx, input, localizer); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domain.ErrPhoneNotVerified),
+			errors.Is(err, domain.ErrSendSMS):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusAccepted)
+}
+
+// LoginPhoneConfirm godoc
+//
+//	@Id				LoginPhoneConfirm
+//	@Summary		Confirm sign in by phone
+//	@Description	Request for completing sign in by phone with code from SMS. If user has enabled two-factor authentication, MFA token will be returned instead of JWT tokens.
+//	@Tags			Authentication and Authorization API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input				body		v0.PhoneLoginConfirmInput	true	"Confirm login by phone request body"
+//	@Param			X-Device-Name		header		string						false	"Client device name, reported by client and not verified"
+//	@Param			X-Client-Location	header		string						false	"Client location, reported by client and not verified"
+//	@Success		200					{object}	v0.LoginOutput				"JWT tokens or MFA token, if two-factor authentication is enabled"
+//	@Failure		400					{object}	v0.ErrorOutput				"Validation error"
+//	@Failure		403					{object}	v0.ErrorOutput				"User is blocked"
+//	@Failure		404					{object}	v0.ErrorOutput				"User not found"
+//	@Failure		429					{object}	v0.ErrorOutput				"Too many failed attempts, account or IP is temporarily locked"
+//	@Failure		500					{object}	v0.ErrorOutput				"Internal server error"
+//	@Router			/v0/auth/login/phone/confirm [post]
+func (h *handler) LoginPhoneConfirm(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle login phone confirm")
+
+	input := v0.PhoneLoginConfirmInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.svc.LoginPhoneConfirm(ctx, input, util.GetClientInfo(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, infrastructure.ErrInvalidOrExpiredOTP),
+			errors.Is(err, infrastructure.ErrOTPAttemptsExceeded):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrUserNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domain.ErrUserIsBlocked):
+			_ = util.ErrorWithStatus(ctx, http.StatusForbidden, err)
+		case errors.Is(err, infrastructure.ErrAccountTemporarilyLocked),
+			errors.Is(err, infrastructure.ErrTooManyAttempts):
+			_ = util.TooManyRequestsError(ctx, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// SendMagicLink godoc
+//
+//	@Id				SendMagicLink
+//	@Summary		Send sign in link
+//	@Description	Request for passwordless sign in by email. At the end will be sent email with single-use sign in link. Link can be used only in the browser, which requested it, so device secret is set in http-only cookie.
+//	@Tags			Authentication and Authorization API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input	body		v0.MagicLinkInput	true	"Magic link request body"
+//	@Header			202		{string}	Set-Cookie			"MagicLinkDevice=; HttpOnly; Max-Age=900"
+//	@Success		202
+//	@Failure		400	{object}	v0.ErrorOutput	"Validation error or email is not sent"
+//	@Failure		403	{object}	v0.ErrorOutput	"User is blocked"
+//	@Failure		404	{object}	v0.ErrorOutput	"User not found"
+//	@Failure		429	{object}	v0.ErrorOutput	"Too many requests, rate limit is exceeded"
+//	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
+//	@Router			/v0/auth/magic-link [post]
+func (h *handler) SendMagicLink(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle send magic link")
+
+	input := v0.MagicLinkInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	h.logger.Debug().Msg("get localizer")
+	localizer := ctx.Value(middleware.LocalizerKey).(locale.Localizer)
+
+	output, err := h.svc.SendMagicLink(ctx, input, localizer)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrUserNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domain.ErrUserIsBlocked):
+			_ = util.ErrorWithStatus(ctx, http.StatusForbidden, err)
+		case errors.Is(err, domain.ErrSendEmail):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.SetCookie(
+		magicLinkDeviceCookieName,
+		output.DeviceSecret,
+		h.cfg.Security.MagicLink.TTL,
+		"",
+		"",
+		false,
+		true,
+	)
+	ctx.Status(http.StatusAccepted)
+}
+
+// VerifyMagicLink godoc
+//
+//	@Id				VerifyMagicLink
+//	@Summary		Verify sign in link
+//	@Description	Request for completing sign in by token from email link. Request must be sent from the browser, which requested link. If user has enabled two-factor authentication, MFA token will be returned instead of JWT tokens.
+//	@Tags			Authentication and Authorization API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input				body		v0.VerifyMagicLinkInput	true	"Verify magic link request body"
+//	@Param			X-Device-Name		header		string					false	"Client device name, reported by client and not verified"
+//	@Param			X-Client-Location	header		string					false	"Client location, reported by client and not verified"
+//	@Success		200					{object}	v0.LoginOutput			"JWT tokens or MFA token, if two-factor authentication is enabled"
+//	@Failure		400					{object}	v0.ErrorOutput			"Validation error or invalid, expired or already used link"
+//	@Failure		403					{object}	v0.ErrorOutput			"User is blocked or link is requested from another browser"
+//	@Failure		404					{object}	v0.ErrorOutput			"User not found"
+//	@Failure		500					{object}	v0.ErrorOutput			"Internal server error"
+//	@Router			/v0/auth/magic-link/verify [post]
+func (h *handler) VerifyMagicLink(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle verify magic link")
+
+	input := v0.VerifyMagicLinkInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	// Missing cookie is checked by service as device mismatch
+	deviceSecret, _ := ctx.Cookie(magicLinkDeviceCookieName)
+
+	res, err := h.svc.VerifyMagicLink(ctx, input, deviceSecret, util.GetClientInfo(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, infrastructure.ErrInvalidOrExpiredOTP):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrUserNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domain.ErrUserIsBlocked),
+			errors.Is(err, domain.ErrMagicLinkDeviceMismatch):
+			_ = util.ErrorWithStatus(ctx, http.StatusForbidden, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.SetCookie(magicLinkDeviceCookieName, "", -1, "", "", false, true)
+	ctx.JSON(http.StatusOK, res)
+}
+
+// BeginPasskeyLogin godoc
+//
+//	@Id				BeginPasskeyLogin
+//	@Summary		Begin sign in with passkey
+//	@Description	Request for starting passwordless sign in. In response will be returned challenge ID and options for navigator.credentials.get(). Challenge is valid for a limited time and can be used only once.
+//	@Tags			Authentication and Authorization API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Success		200	{object}	v0.PasskeyRequestOptionsOutput	"Passkey request options"
+//	@Failure		500	{object}	v0.ErrorOutput					"Internal server error"
+//	@Router			/v0/auth/passkey/login/begin [post]
+func (h *handler) BeginPasskeyLogin(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle begin passkey login")
+
+	res, err := h.svc.BeginPasskeyLogin(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// FinishPasskeyLogin godoc
+//
+//	@Id				FinishPasskeyLogin
+//	@Summary		Finish sign in with passkey
+//	@Description	Request for finishing passwordless sign in by credential returned from navigator.credentials.get(). Passkey requires user verification, so two-factor authentication is not requested.
+//	@Tags			Authentication and Authorization API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input				body		v0.PasskeyLoginInput	true	"Passkey login request body"
+//	@Param			X-Device-Name		header		string					false	"Client device name, reported by client and not verified"
+//	@Param			X-Client-Location	header		string					false	"Client location, reported by client and not verified"
+//	@Success		200					{object}	v0.JwtTokensOutput		"JWT tokens"
+//	@Failure		400					{object}	v0.ErrorOutput			"Validation error"
+//	@Failure		401					{object}	v0.ErrorOutput			"Invalid passkey or expired challenge"
+//	@Failure		403					{object}	v0.ErrorOutput			"User is blocked"
+//	@Failure		404					{object}	v0.ErrorOutput			"User not found"
+//	@Failure		500					{object}	v0.ErrorOutput			"Internal server error"
+//	@Router			/v0/auth/passkey/login/finish [post]
+func (h *handler) FinishPasskeyLogin(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle finish passkey login")
+
+	input := v0.PasskeyLoginInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.svc.FinishPasskeyLogin(ctx, input, util.GetClientInfo(ctx))
+	if err != nil {
+		switch {
+		case errors.Is(err, infrastructure.ErrInvalidPasskey),
+			errors.Is(err, infrastructure.ErrInvalidOrExpiredChallenge):
+			_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		case errors.Is(err, domain.ErrUserNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domain.ErrUserIsBlocked):
+			_ = util.ErrorWithStatus(ctx, http.StatusForbidden, err)
 		default:
 			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
 		}
@@ -125,12 +459,15 @@ func (h *handler) Login(ctx *gin.Context) {
 //	@Tags			Authentication and Authorization API
 //	@Accept			application/json
 //	@Produce		application/json
-//	@Param			input	body		v0.RefreshTokensInput	true	"Refresh token body"
-//	@Success		200		{object}	v0.JwtTokensOutput		"JWT tokens"
-//	@Failure		400		{object}	v0.ErrorOutput			"Validation error"
-//	@Failure		403		{object}	v0.ErrorOutput			"User is blocked"
-//	@Failure		404		{object}	v0.ErrorOutput			"User not found"
-//	@Failure		500		{object}	v0.ErrorOutput			"Internal server error"
+//	@Param			input				body		v0.RefreshTokensInput	true	"Refresh token body"
+//	@Param			X-Device-Name		header		string					false	"Client device name, reported by client and not verified"
+//	@Param			X-Client-Location	header		string					false	"Client location, reported by client and not verified"
+//	@Success		200					{object}	v0.JwtTokensOutput		"JWT tokens"
+//	@Failure		400					{object}	v0.ErrorOutput			"Validation error"
+//	@Failure		401					{object}	v0.ErrorOutput			"Refresh token has been already used, session is revoked"
+//	@Failure		403					{object}	v0.ErrorOutput			"User is blocked"
+//	@Failure		404					{object}	v0.ErrorOutput			"User not found"
+//	@Failure		500					{object}	v0.ErrorOutput			"Internal server error"
 //	@Router			/v0/auth/refresh [post]
 func (h *handler) RefreshTokens(ctx *gin.Context) {
 	h.logger.Debug().Msg("handle refresh tokens")
@@ -141,11 +478,13 @@ func (h *handler) RefreshTokens(ctx *gin.Context) {
 		return
 	}
 
-	res, err := h.svc.RefreshTokens(ctx, input)
+	res, err := h.svc.RefreshTokens(ctx, input, util.GetClientInfo(ctx))
 	if err != nil {
 		switch {
 		case errors.Is(err, infrastructure.ErrInvalidJWTToken):
 			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, infrastructure.ErrReusedJWTToken):
+			_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
 		case errors.Is(err, domain.ErrUserNotFound):
 			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
 		case errors.Is(err, domain.ErrUserIsBlocked):
@@ -181,7 +520,7 @@ func (h *handler) Logout(c *gin.Context) {
 		return
 	}
 
-	err = h.svc.Logout(c, principal.JTI)
+	err = h.svc.Logout(c, principal.ID, principal.SessionID, principal.JTI)
 	if err != nil {
 		_ = c.AbortWithError(http.StatusInternalServerError, err)
 		return
@@ -202,6 +541,7 @@ func (h *handler) Logout(c *gin.Context) {
 //	@Success		202
 //	@Failure		400	{object}	v0.ErrorOutput	"Validation error"
 //	@Failure		409	{object}	v0.ErrorOutput	"User already exists"
+//	@Failure		429	{object}	v0.ErrorOutput	"Too many requests, rate limit is exceeded"
 //	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
 //	@Router			/v0/auth/register [post]
 func (h *handler) Register(ctx *gin.Context) {
@@ -243,6 +583,7 @@ func (h *handler) Register(ctx *gin.Context) {
 //	@Success		200
 //	@Failure		400	{object}	v0.ErrorOutput	"Validation error"
 //	@Failure		409	{object}	v0.ErrorOutput	"User already exists"
+//	@Failure		429	{object}	v0.ErrorOutput	"Too many failed attempts, account or IP is temporarily locked"
 //	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
 //	@Router			/v0/auth/register/confirm [post]
 func (h *handler) RegisterConfirm(ctx *gin.Context) {
@@ -254,12 +595,16 @@ func (h *handler) RegisterConfirm(ctx *gin.Context) {
 		return
 	}
 
-	if err := h.svc.RegisterConfirm(ctx, input); err != nil {
+	if err := h.svc.RegisterConfirm(ctx, input, util.GetClientInfo(ctx)); err != nil {
 		switch {
-		case errors.Is(err, infrastructure.ErrInvalidOrExpiredOTP):
+		case errors.Is(err, infrastructure.ErrInvalidOrExpiredOTP),
+			errors.Is(err, infrastructure.ErrOTPAttemptsExceeded):
 			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
 		case errors.Is(err, domain.ErrDuplicateUser):
 			_ = util.ErrorWithStatus(ctx, http.StatusConflict, err)
+		case errors.Is(err, infrastructure.ErrAccountTemporarilyLocked),
+			errors.Is(err, infrastructure.ErrTooManyAttempts):
+			_ = util.TooManyRequestsError(ctx, err)
 		default:
 			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
 		}
@@ -281,6 +626,7 @@ func (h *handler) RegisterConfirm(ctx *gin.Context) {
 //	@Success		202
 //	@Failure		400	{object}	v0.ErrorOutput	"Validation error"
 //	@Failure		404	{object}	v0.ErrorOutput	"User not found"
+//	@Failure		429	{object}	v0.ErrorOutput	"Too many requests, rate limit is exceeded"
 //	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
 //	@Router			/v0/auth/recovery-password [post]
 func (h *handler) RecoveryPassword(ctx *gin.Context) {
@@ -321,6 +667,7 @@ func (h *handler) RecoveryPassword(ctx *gin.Context) {
 //	@Param			input	body	v0.VerifyRecoveryCodeInput	true	"Verify recovery code body"
 //	@Success		200
 //	@Failure		400	{object}	v0.ErrorOutput	"Validation error"
+//	@Failure		429	{object}	v0.ErrorOutput	"Too many failed attempts, account or IP is temporarily locked"
 //	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
 //	@Router			/v0/auth/recovery-password/verify [post]
 func (h *handler) VerifyRecoveryCode(ctx *gin.Context) {
@@ -332,10 +679,14 @@ func (h *handler) VerifyRecoveryCode(ctx *gin.Context) {
 		return
 	}
 
-	if err := h.svc.VerifyRecoveryCode(ctx, input); err != nil {
+	if err := h.svc.VerifyRecoveryCode(ctx, input, util.GetClientInfo(ctx)); err != nil {
 		switch {
-		case errors.Is(err, infrastructure.ErrInvalidOrExpiredOTP):
+		case errors.Is(err, infrastructure.ErrInvalidOrExpiredOTP),
+			errors.Is(err, infrastructure.ErrOTPAttemptsExceeded):
 			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, infrastructure.ErrAccountTemporarilyLocked),
+			errors.Is(err, infrastructure.ErrTooManyAttempts):
+			_ = util.TooManyRequestsError(ctx, err)
 		default:
 			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
 		}
@@ -357,6 +708,7 @@ func (h *handler) VerifyRecoveryCode(ctx *gin.Context) {
 //	@Success		200
 //	@Failure		400	{object}	v0.ErrorOutput	"Validation error"
 //	@Failure		404	{object}	v0.ErrorOutput	"User not found"
+//	@Failure		429	{object}	v0.ErrorOutput	"Too many failed attempts, account or IP is temporarily locked"
 //	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
 //	@Router			/v0/auth/reset-password [post]
 func (h *handler) ResetPassword(ctx *gin.Context) {
@@ -368,12 +720,16 @@ func (h *handler) ResetPassword(ctx *gin.Context) {
 		return
 	}
 
-	if err := h.svc.ResetPassword(ctx, input); err != nil {
+	if err := h.svc.ResetPassword(ctx, input, util.GetClientInfo(ctx)); err != nil {
 		switch {
-		case errors.Is(err, infrastructure.ErrInvalidOrExpiredOTP):
+		case errors.Is(err, infrastructure.ErrInvalidOrExpiredOTP),
+			errors.Is(err, infrastructure.ErrOTPAttemptsExceeded):
 			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
 		case errors.Is(err, domain.ErrUserNotFound):
 			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, infrastructure.ErrAccountTemporarilyLocked),
+			errors.Is(err, infrastructure.ErrTooManyAttempts):
+			_ = util.TooManyRequestsError(ctx, err)
 		default:
 			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
 		}
@@ -387,13 +743,13 @@ func (h *handler) ResetPassword(ctx *gin.Context) {
 //
 //	@Id				SocialLogin
 //	@Summary		Social login
-//	@Description	Request for redirecting to OAuth consent page. After serviceorization, it will redirect to redirectURL with serviceorization code and state
+//	@Description	Request for redirecting to OAuth consent page. After serviceorization, it will redirect to redirectURL with serviceorization code and state. State is single-use, it must be sent to callback by the same client, and authorization code is protected by PKCE
 //	@Tags			Authentication and Authorization API
 //	@Accept			application/json
 //	@Produce		application/json
-//	@Param			provider	path		string		true	"Social login provider (yandex, google, mailru)"
+//	@Param			provider	path		string		true	"Social login provider (yandex, google, mailru, vk, telegram or name of OpenID Connect provider)"
 //	@Param			redirectURL	query		string		true	"Redirect URL"
-//	@Header			302			{string}	Set-Cookie	"OAuthGoogleState=; HttpOnly; Max-Age=1200; Secure"
+//	@Header			302			{string}	Set-Cookie	"OAuthState=; HttpOnly; Max-Age=1200; Secure"
 //	@Success		302
 //	@Failure		404	{object}	v0.ErrorOutput	"Provider not found"
 //	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
@@ -412,7 +768,7 @@ func (h *handler) SocialLogin(ctx *gin.Context) {
 	}
 
 	// Get consent page url
-	output, err := h.svc.GetConsentPageURL(ctx, p, redirectURL)
+	output, err := h.svc.GetConsentPageURL(ctx, p, redirectURL, util.GetClientInfo(ctx))
 	if err != nil {
 		switch {
 		case errors.Is(err, domain.ErrInvalidProvider):
@@ -424,7 +780,7 @@ func (h *handler) SocialLogin(ctx *gin.Context) {
 	}
 
 	// Set cookies amd redirect
-	ctx.SetCookie(stateCookieName, output.OauthState, stateCookieMaxAge, "", "", false, true)
+	ctx.SetCookie(stateCookieName, output.OauthState, h.cfg.Security.OAuthState.TTL, "", "", false, true)
 	ctx.Redirect(http.StatusFound, output.ConsentPageURL)
 }
 
@@ -436,13 +792,15 @@ func (h *handler) SocialLogin(ctx *gin.Context) {
 //	@Tags			Authentication and Authorization API
 //	@Accept			application/json
 //	@Produce		application/json
-//	@Param			provider	path		string							true	"Social login provider (yandex, google, mailru)"
-//	@Param			input		body		v0.SocialLoginCallbackInput	true	"Social login callback request body"
-//	@Success		200			{object}	v0.JwtTokensOutput			"JWT tokens"
-//	@Failure		400			{object}	v0.ErrorOutput				"Validation error"
-//	@Failure		403			{object}	v0.ErrorOutput				"User already exists"
-//	@Failure		404			{object}	v0.ErrorOutput				"User not found"
-//	@Failure		500			{object}	v0.ErrorOutput				"Internal server error"
+//	@Param			provider			path		string						true	"Social login provider (yandex, google, mailru, vk, telegram or name of OpenID Connect provider)"
+//	@Param			input				body		v0.SocialLoginCallbackInput	true	"Social login callback request body"
+//	@Param			X-Device-Name		header		string						false	"Client device name, reported by client and not verified"
+//	@Param			X-Client-Location	header		string						false	"Client location, reported by client and not verified"
+//	@Success		200					{object}	v0.LoginOutput				"JWT tokens, MFA token, if two-factor authentication is enabled, or identity link token, if account with the same email exists"
+//	@Failure		400					{object}	v0.ErrorOutput				"Validation error or invalid, expired or already used state"
+//	@Failure		403					{object}	v0.ErrorOutput				"User is blocked"
+//	@Failure		404					{object}	v0.ErrorOutput				"User not found"
+//	@Failure		500					{object}	v0.ErrorOutput				"Internal server error"
 //	@Router			/v0/auth/social/{provider}/callback [post]
 func (h *handler) SocialLoginCallback(ctx *gin.Context) {
 	h.logger.Debug().Msg("handle social login callback")
@@ -461,25 +819,38 @@ func (h *handler) SocialLoginCallback(ctx *gin.Context) {
 	cookieState, err := ctx.Cookie(stateCookieName)
 	ctx.SetCookie(stateCookieName, "", -1, "", "", true, true)
 	if err != nil {
-		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, errInvalidState)
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, domain.ErrInvalidState)
 		return
 	}
 	if cookieState != input.State {
-		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, errInvalidState)
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, domain.ErrInvalidState)
 		return
 	}
 
 	// Fetch user info
-	userInfo, err := h.svc.FetchUserInfo(ctx, p, v0.FetchUserInfoInput{Code: input.Code})
+	userInfo, err := h.svc.FetchUserInfo(
+		ctx,
+		p,
+		v0.FetchUserInfoInput{Code: input.Code, State: input.State, DeviceID: input.DeviceID},
+		util.GetClientInfo(ctx),
+	)
 	if err != nil {
-		_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		switch {
+		case errors.Is(err, domain.ErrInvalidState):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		}
 		return
 	}
 
 	// Register or login
-	res, err := h.svc.RegisterOrLogin(ctx, userInfo)
+	res, err := h.svc.RegisterOrLogin(ctx, p, userInfo, util.GetClientInfo(ctx))
 	if err != nil {
 		switch {
+		case errors.Is(err, domain.ErrUserNotFound),
+			errors.Is(err, domain.ErrUserInfoNotReceived):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
 		case errors.Is(err, domain.ErrUserIsBlocked):
 			_ = util.ErrorWithStatus(ctx, http.StatusForbidden, err)
 		default:
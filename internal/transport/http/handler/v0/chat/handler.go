@@ -0,0 +1,513 @@
+package chat
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/infrastructure/s3"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	apihandler "github.com/mandarine-io/backend/internal/transport/http/handler"
+	"github.com/mandarine-io/backend/internal/transport/http/middleware"
+	"github.com/mandarine-io/backend/internal/transport/http/util"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/rs/zerolog"
+	"net/http"
+	"path"
+	"strings"
+)
+
+type handler struct {
+	svc    domain.ChatService
+	logger zerolog.Logger
+}
+
+type Option func(*handler)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(h *handler) {
+		h.logger = logger
+	}
+}
+
+func NewHandler(svc domain.ChatService, opts ...Option) apihandler.APIHandler {
+	h := &handler{
+		svc:    svc,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+func (h *handler) RegisterRoutes(router *gin.Engine) {
+	h.logger.Debug().Msg("register chat routes")
+
+	conversationRouter := router.Group("/v0/chat/conversations")
+	{
+		conversationRouter.GET(
+			"",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.getConversations,
+		)
+		conversationRouter.POST(
+			"",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.createConversation,
+		)
+		conversationRouter.GET(
+			"/:id",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.getConversation,
+		)
+		conversationRouter.GET(
+			"/:id/messages",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.getMessages,
+		)
+		conversationRouter.POST(
+			"/:id/messages",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.sendMessage,
+		)
+		conversationRouter.POST(
+			"/:id/read",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.markRead,
+		)
+		conversationRouter.POST(
+			"/:id/attachments",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.uploadAttachments,
+		)
+		conversationRouter.GET(
+			"/:id/attachments/*objectID",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.downloadAttachment,
+		)
+	}
+}
+
+// getConversations godoc
+//
+//	@Id				GetConversations
+//	@Summary		Get conversations
+//	@Description	Request for receiving own conversations as client or master with unread counters. User must be logged in. Conversations are sorted by last message from newest to oldest.
+//	@Security		BearerAuth
+//	@Tags			Chat API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Success		200	{object}	v0.ConversationsOutput	"Conversations"
+//	@Failure		401	{object}	v0.ErrorOutput			"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput			"User is blocked or deleted"
+//	@Failure		500	{object}	v0.ErrorOutput			"Internal server error"
+//	@Router			/v0/chat/conversations [get]
+func (h *handler) getConversations(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle get conversations")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	res, err := h.svc.GetConversations(ctx, principal.ID)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// createConversation godoc
+//
+//	@Id				CreateConversation
+//	@Summary		Create conversation
+//	@Description	Request for starting conversation with master, optionally about appointment. User must be logged in. Existing conversation is returned, if it has been already started.
+//	@Security		BearerAuth
+//	@Tags			Chat API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input	body		v0.CreateConversationInput	true	"Create conversation request body"
+//	@Success		200		{object}	v0.ConversationOutput		"Conversation"
+//	@Failure		400		{object}	v0.ErrorOutput				"Validation error or conversation with self"
+//	@Failure		401		{object}	v0.ErrorOutput				"Unauthorized"
+//	@Failure		403		{object}	v0.ErrorOutput				"User is blocked or deleted"
+//	@Failure		404		{object}	v0.ErrorOutput				"Not found master profile"
+//	@Failure		500		{object}	v0.ErrorOutput				"Internal server error"
+//	@Router			/v0/chat/conversations [post]
+func (h *handler) createConversation(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle create conversation")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	input := v0.CreateConversationInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.svc.CreateConversation(ctx, principal.ID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrChatWithSelf):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrMasterProfileNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// getConversation godoc
+//
+//	@Id				GetConversation
+//	@Summary		Get conversation
+//	@Description	Request for receiving own conversation with unread counter. User must be logged in.
+//	@Security		BearerAuth
+//	@Tags			Chat API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			id	path		string					true	"Conversation ID"
+//	@Success		200	{object}	v0.ConversationOutput	"Conversation"
+//	@Failure		400	{object}	v0.ErrorOutput			"Validation error"
+//	@Failure		401	{object}	v0.ErrorOutput			"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput			"User is blocked or deleted"
+//	@Failure		404	{object}	v0.ErrorOutput			"Not found conversation"
+//	@Failure		500	{object}	v0.ErrorOutput			"Internal server error"
+//	@Router			/v0/chat/conversations/{id} [get]
+func (h *handler) getConversation(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle get conversation")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	conversationID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.svc.GetConversation(ctx, principal.ID, conversationID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrConversationNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// getMessages godoc
+//
+//	@Id				GetChatMessages
+//	@Summary		Get chat messages
+//	@Description	Request for receiving history of conversation from newest to oldest messages. User must be logged in. Next page is requested with cursor from previous page.
+//	@Security		BearerAuth
+//	@Tags			Chat API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			id		path		string						true	"Conversation ID"
+//	@Param			input	query		v0.FindChatMessagesInput	false	"Find chat messages query"
+//	@Success		200		{object}	v0.ChatMessagesOutput		"Chat messages"
+//	@Failure		400		{object}	v0.ErrorOutput				"Validation error or invalid cursor"
+//	@Failure		401		{object}	v0.ErrorOutput				"Unauthorized"
+//	@Failure		403		{object}	v0.ErrorOutput				"User is blocked or deleted"
+//	@Failure		404		{object}	v0.ErrorOutput				"Not found conversation"
+//	@Failure		500		{object}	v0.ErrorOutput				"Internal server error"
+//	@Router			/v0/chat/conversations/{id}/messages [get]
+func (h *handler) getMessages(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle get chat messages")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	conversationID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	input := v0.FindChatMessagesInput{}
+	if err := ctx.ShouldBindQuery(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.svc.GetMessages(ctx, principal.ID, conversationID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidCursor):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrConversationNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// sendMessage godoc
+//
+//	@Id				SendChatMessage
+//	@Summary		Send chat message
+//	@Description	Request for sending message to conversation. User must be logged in. Message is delivered over websocket to subscribers of topic chat:{id}, offline recipient is notified by email.
+//	@Security		BearerAuth
+//	@Tags			Chat API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			id		path		string					true	"Conversation ID"
+//	@Param			input	body		v0.SendChatMessageInput	true	"Send chat message request body"
+//	@Success		201		{object}	v0.ChatMessageOutput	"Sent message"
+//	@Failure		400		{object}	v0.ErrorOutput			"Validation error or attachment is not uploaded by sender to conversation"
+//	@Failure		401		{object}	v0.ErrorOutput			"Unauthorized"
+//	@Failure		403		{object}	v0.ErrorOutput			"User is blocked or deleted"
+//	@Failure		404		{object}	v0.ErrorOutput			"Not found conversation"
+//	@Failure		500		{object}	v0.ErrorOutput			"Internal server error"
+//	@Router			/v0/chat/conversations/{id}/messages [post]
+func (h *handler) sendMessage(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle send chat message")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	conversationID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	input := v0.SendChatMessageInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.svc.SendMessage(ctx, principal.ID, conversationID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidAttachment):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrConversationNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+// markRead godoc
+//
+//	@Id				MarkChatRead
+//	@Summary		Mark chat as read
+//	@Description	Request for sending read receipt. User must be logged in. Messages until given one or all messages are marked as read, read marker never moves back.
+//	@Security		BearerAuth
+//	@Tags			Chat API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			id		path	string					true	"Conversation ID"
+//	@Param			input	body	v0.MarkChatReadInput	true	"Mark chat as read request body"
+//	@Success		204
+//	@Failure		400	{object}	v0.ErrorOutput	"Validation error"
+//	@Failure		401	{object}	v0.ErrorOutput	"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput	"User is blocked or deleted"
+//	@Failure		404	{object}	v0.ErrorOutput	"Not found conversation or message"
+//	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
+//	@Router			/v0/chat/conversations/{id}/read [post]
+func (h *handler) markRead(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle mark chat as read")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	conversationID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	input := v0.MarkChatReadInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.svc.MarkRead(ctx, principal.ID, conversationID, input); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrConversationNotFound), errors.Is(err, domain.ErrChatMessageNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// uploadAttachments godoc
+//
+//	@Id				UploadChatAttachments
+//	@Summary		Upload chat attachments
+//	@Description	Request for uploading attachments of conversation. User must be logged in. Returned object ids are sent with message and are available to participants of conversation only.
+//	@Security		BearerAuth
+//	@Tags			Chat API
+//	@Accept			multipart/form-data
+//	@Produce		application/json
+//	@Param			id			path		string						true	"Conversation ID"
+//	@Param			resources	formData	[]string					true	"Files to upload"
+//	@Success		201			{object}	v0.UploadResourcesOutput	"Uploaded attachments"
+//	@Failure		400			{object}	v0.ErrorOutput				"Validation error"
+//	@Failure		401			{object}	v0.ErrorOutput				"Unauthorized"
+//	@Failure		403			{object}	v0.ErrorOutput				"User is blocked or deleted"
+//	@Failure		404			{object}	v0.ErrorOutput				"Not found conversation"
+//	@Failure		500			{object}	v0.ErrorOutput				"Internal server error"
+//	@Router			/v0/chat/conversations/{id}/attachments [post]
+func (h *handler) uploadAttachments(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle upload chat attachments")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	conversationID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	input := v0.UploadResourcesInput{}
+	if err := ctx.ShouldBind(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, domain.ErrResourceNotUploaded)
+		return
+	}
+
+	res, err := h.svc.UploadAttachments(ctx, principal.ID, conversationID, &input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrConversationNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+// downloadAttachment godoc
+//
+//	@Id				DownloadChatAttachment
+//	@Summary		Download chat attachment
+//	@Description	Request for downloading attachment of conversation. User must be logged in and be participant of conversation.
+//	@Security		BearerAuth
+//	@Tags			Chat API
+//	@Param			id			path	string	true	"Conversation ID"
+//	@Param			objectID	path	string	true	"Object id of attachment"
+//	@Success		200
+//	@Failure		400	{object}	v0.ErrorOutput	"Validation error"
+//	@Failure		401	{object}	v0.ErrorOutput	"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput	"User is blocked or deleted"
+//	@Failure		404	{object}	v0.ErrorOutput	"Not found conversation or attachment"
+//	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
+//	@Router			/v0/chat/conversations/{id}/attachments/{objectID} [get]
+func (h *handler) downloadAttachment(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle download chat attachment")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	conversationID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	// Object id contains folder, so it is matched by wildcard with leading slash
+	objectID := strings.TrimPrefix(ctx.Param("objectID"), "/")
+
+	data, err := h.svc.DownloadAttachment(ctx, principal.ID, conversationID, objectID)
+	defer func() {
+		if data == nil {
+			return
+		}
+		err := data.Reader.Close()
+		if err != nil {
+			h.logger.Warn().Err(err).Msg("failed to close file")
+		}
+	}()
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrConversationNotFound), errors.Is(err, s3.ErrObjectNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	util.File(ctx, path.Base(data.ID), data)
+}
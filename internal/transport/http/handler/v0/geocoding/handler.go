@@ -47,6 +47,7 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 		middleware.Registry.Auth,
 		middleware.Registry.BannedUser,
 		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
 		h.Geocode,
 	)
 	router.GET(
@@ -54,6 +55,7 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 		middleware.Registry.Auth,
 		middleware.Registry.BannedUser,
 		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
 		h.ReverseGeocode,
 	)
 }
@@ -72,6 +74,7 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 //	@Failure		400		{object}	v0.ErrorOutput		"Validation error"
 //	@Failure		401		{object}	v0.ErrorOutput		"Unauthorized error"
 //	@Failure		403		{object}	v0.ErrorOutput		"User is blocked or deleted"
+//	@Failure		429		{object}	v0.ErrorOutput		"Too many requests, rate limit is exceeded"
 //	@Failure		503		{object}	v0.ErrorOutput		"Geocoding service is unavailable"
 //	@Router			/v0/geocode/forward [get]
 func (h *handler) Geocode(ctx *gin.Context) {
@@ -122,6 +125,7 @@ func (h *handler) Geocode(ctx *gin.Context) {
 //	@Failure		400		{object}	v0.ErrorOutput				"Validation error"
 //	@Failure		401		{object}	v0.ErrorOutput				"Unauthorized error"
 //	@Failure		403		{object}	v0.ErrorOutput				"User is blocked or deleted"
+//	@Failure		429		{object}	v0.ErrorOutput				"Too many requests, rate limit is exceeded"
 //	@Failure		503		{object}	v0.ErrorOutput				"Geocoding service is unavailable"
 //	@Router			/v0/geocode/reverse [get]
 func (h *handler) ReverseGeocode(ctx *gin.Context) {
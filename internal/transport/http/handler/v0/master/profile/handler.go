@@ -47,6 +47,7 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 		middleware.Registry.Auth,
 		middleware.Registry.BannedUser,
 		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
 		h.CreateMasterProfile,
 	)
 	router.PATCH(
@@ -54,6 +55,7 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 		middleware.Registry.Auth,
 		middleware.Registry.BannedUser,
 		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
 		h.UpdateMasterProfile,
 	)
 	router.GET(
@@ -68,6 +70,7 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 		middleware.Registry.Auth,
 		middleware.Registry.BannedUser,
 		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
 		h.GetMasterProfile,
 	)
 }
@@ -45,44 +45,50 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 
 	router.POST(
 		"v0/masters/profiles/:username/services",
-		middleware.Registry.Auth,
+		middleware.Registry.ScopedAuth(middleware.ScopeServicesWrite),
 		middleware.Registry.BannedUser,
 		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
 		h.CreateMasterService,
 	)
 	router.PATCH(
 		"v0/masters/profiles/:username/services/:id",
-		middleware.Registry.Auth,
+		middleware.Registry.ScopedAuth(middleware.ScopeServicesWrite),
 		middleware.Registry.BannedUser,
 		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
 		h.UpdateMasterService,
 	)
 	router.DELETE(
 		"v0/masters/profiles/:username/services/:id",
-		middleware.Registry.Auth,
+		middleware.Registry.ScopedAuth(middleware.ScopeServicesWrite),
 		middleware.Registry.BannedUser,
 		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
 		h.DeleteMasterService,
 	)
 	router.GET(
 		"v0/masters/profiles/-/services",
-		middleware.Registry.Auth,
+		middleware.Registry.ScopedAuth(middleware.ScopeServicesRead),
 		middleware.Registry.BannedUser,
 		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
 		h.FindMasterServices,
 	)
 	router.GET(
 		"v0/masters/profiles/:username/services",
-		middleware.Registry.Auth,
+		middleware.Registry.ScopedAuth(middleware.ScopeServicesRead),
 		middleware.Registry.BannedUser,
 		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
 		h.FindMasterServicesByUsername,
 	)
 	router.GET(
 		"v0/masters/profiles/:username/services/:id",
-		middleware.Registry.Auth,
+		middleware.Registry.ScopedAuth(middleware.ScopeServicesRead),
 		middleware.Registry.BannedUser,
 		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
 		h.GetMasterServiceByUsername,
 	)
 }
@@ -93,6 +99,7 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 //	@Summary		Create master service
 //	@Description	Request for creating master service. User must be logged in. In response will be returned created master service.
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 //	@Tags			Master Service API
 //	@Accept			application/json
 //	@Produce		application/json
@@ -148,6 +155,7 @@ func (h *handler) CreateMasterService(ctx *gin.Context) {
 //	@Summary		Update master service
 //	@Description	Request for updating master service. User must be logged in. In response will be returned updated master service.
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 //	@Tags			Master Service API
 //	@Accept			application/json
 //	@Produce		application/json
@@ -212,6 +220,7 @@ func (h *handler) UpdateMasterService(ctx *gin.Context) {
 //	@Summary		Delete master service
 //	@Description	Request for deleting master service. User must be logged in.
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 //	@Tags			Master Service API
 //	@Accept			application/json
 //	@Produce		application/json
@@ -270,6 +279,7 @@ func (h *handler) DeleteMasterService(ctx *gin.Context) {
 //	@Summary		Find master services
 //	@Description	Request for finding master services. User must be logged in. In response will be returned found master services.
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 //	@Tags			Master Service API
 //	@Accept			application/json
 //	@Produce		application/json
@@ -309,6 +319,7 @@ func (h *handler) FindMasterServices(ctx *gin.Context) {
 //	@Summary		Find master services by username
 //	@Description	Request for finding master services by username. User must be logged in. In response will be returned found master services.
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 //	@Tags			Master Service API
 //	@Accept			application/json
 //	@Produce		application/json
@@ -382,6 +393,7 @@ func (h *handler) FindMasterServicesByUsername(ctx *gin.Context) {
 //	@Summary		Get master service
 //	@Description	Request for getting master service. User must be logged in. In response will be returned found master service.
 //	@Security		BearerAuth
+//	@Security		APIKeyAuth
 //	@Tags			Master Service API
 //	@Accept			application/json
 //	@Produce		application/json
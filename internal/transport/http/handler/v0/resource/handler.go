@@ -12,9 +12,6 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 	"net/http"
-	"net/url"
-	"strings"
-	"unicode"
 )
 
 var (
@@ -57,6 +54,7 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 		middleware.Registry.Auth,
 		middleware.Registry.BannedUser,
 		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
 		h.UploadResource,
 	)
 	router.POST(
@@ -64,6 +62,7 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 		middleware.Registry.Auth,
 		middleware.Registry.BannedUser,
 		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
 		h.UploadResources,
 	)
 }
@@ -82,6 +81,7 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 //	@Failure		400			{object}	v0.ErrorOutput			"Validation error"
 //	@Failure		401			{object}	v0.ErrorOutput			"Unauthorized error"
 //	@Failure		403			{object}	v0.ErrorOutput			"User is blocked or deleted"
+//	@Failure		429			{object}	v0.ErrorOutput			"Too many requests, rate limit is exceeded"
 //	@Failure		500			{object}	v0.ErrorOutput			"Internal server error"
 //	@Router			/v0/resources/one [post]
 func (h *handler) UploadResource(ctx *gin.Context) {
@@ -121,6 +121,7 @@ func (h *handler) UploadResource(ctx *gin.Context) {
 //	@Failure		400			{object}	v0.ErrorOutput			"Validation error"
 //	@Failure		401			{object}	v0.ErrorOutput			"Unauthorized error"
 //	@Failure		403			{object}	v0.ErrorOutput			"User is blocked or deleted"
+//	@Failure		429			{object}	v0.ErrorOutput			"Too many requests, rate limit is exceeded"
 //	@Failure		500			{object}	v0.ErrorOutput			"Internal server error"
 //	@Router			/v0/resources/many [post]
 func (h *handler) UploadResources(ctx *gin.Context) {
@@ -145,7 +146,7 @@ func (h *handler) UploadResources(ctx *gin.Context) {
 //
 //	@Id				DownloadResource
 //	@Summary		Download resource
-//	@Description	Request for getting resource. Return the resource in S3 storage.
+//	@Description	Request for getting resource. Return the resource in S3 storage. Private resources, e.g. chat attachments, are not available.
 //	@Tags			Resource API
 //	@Param			objectID	path	string	true	"Object id"
 //	@Success		200
@@ -177,28 +178,5 @@ func (h *handler) DownloadResource(ctx *gin.Context) {
 		return
 	}
 
-	if isASCII(data.ID) {
-		ctx.Writer.Header().Set("Content-Disposition", `attachment; filename="`+escapeQuotes(data.ID)+`"`)
-	} else {
-		ctx.Writer.Header().Set("Content-Disposition", `attachment; filename*=UTF-8''`+url.QueryEscape(data.ID))
-	}
-
-	ctx.DataFromReader(
-		http.StatusOK, data.Size, data.ContentType, data.Reader,
-		map[string]string{},
-	)
-}
-
-func isASCII(s string) bool {
-	for i := 0; i < len(s); i++ {
-		if s[i] > unicode.MaxASCII {
-			return false
-		}
-	}
-	return true
-}
-
-func escapeQuotes(s string) string {
-	quoteEscaper := strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
-	return quoteEscaper.Replace(s)
+	util.File(ctx, data.ID, data)
 }
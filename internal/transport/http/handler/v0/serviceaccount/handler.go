@@ -0,0 +1,437 @@
+package serviceaccount
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	apihandler "github.com/mandarine-io/backend/internal/transport/http/handler"
+	"github.com/mandarine-io/backend/internal/transport/http/middleware"
+	"github.com/mandarine-io/backend/internal/transport/http/util"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/rs/zerolog"
+	"net/http"
+)
+
+type handler struct {
+	svc    domain.ServiceAccountService
+	logger zerolog.Logger
+}
+
+type Option func(*handler)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(h *handler) {
+		h.logger = logger
+	}
+}
+
+func NewHandler(svc domain.ServiceAccountService, opts ...Option) apihandler.APIHandler {
+	h := &handler{
+		svc:    svc,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+func (h *handler) RegisterRoutes(router *gin.Engine) {
+	h.logger.Debug().Msg("register service account routes")
+
+	// Service accounts are managed only by user, API keys cannot create other API keys
+	serviceAccountRouter := router.Group("/v0/account/service-accounts")
+	{
+		serviceAccountRouter.GET(
+			"",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.getServiceAccounts,
+		)
+		serviceAccountRouter.POST(
+			"",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.createServiceAccount,
+		)
+		serviceAccountRouter.DELETE(
+			"/:id",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.deleteServiceAccount,
+		)
+		serviceAccountRouter.GET(
+			"/:id/keys",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.getAPIKeys,
+		)
+		serviceAccountRouter.POST(
+			"/:id/keys",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.createAPIKey,
+		)
+		serviceAccountRouter.POST(
+			"/:id/keys/:keyID/rotate",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.rotateAPIKey,
+		)
+		serviceAccountRouter.DELETE(
+			"/:id/keys/:keyID",
+			middleware.Registry.Auth,
+			middleware.Registry.BannedUser,
+			middleware.Registry.DeletedUser,
+			middleware.Registry.MFAUser,
+			h.revokeAPIKey,
+		)
+	}
+}
+
+// getServiceAccounts godoc
+//
+//	@Id				GetServiceAccounts
+//	@Summary		Get service accounts
+//	@Description	Request for receiving own service accounts. User must be logged in.
+//	@Security		BearerAuth
+//	@Tags			Service Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Success		200	{object}	v0.ServiceAccountsOutput	"Service accounts"
+//	@Failure		401	{object}	v0.ErrorOutput				"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput				"User is blocked or deleted"
+//	@Failure		500	{object}	v0.ErrorOutput				"Internal server error"
+//	@Router			/v0/account/service-accounts [get]
+func (h *handler) getServiceAccounts(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle get service accounts")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	res, err := h.svc.GetServiceAccounts(ctx, principal.ID)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// createServiceAccount godoc
+//
+//	@Id				CreateServiceAccount
+//	@Summary		Create service account
+//	@Description	Request for creating service account for external integration. User must be logged in. Service account acts on behalf of user within scopes of its API keys.
+//	@Security		BearerAuth
+//	@Tags			Service Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			input	body		v0.CreateServiceAccountInput	true	"Create service account request body"
+//	@Success		201		{object}	v0.ServiceAccountOutput			"Created service account"
+//	@Failure		400		{object}	v0.ErrorOutput					"Validation error"
+//	@Failure		401		{object}	v0.ErrorOutput					"Unauthorized"
+//	@Failure		403		{object}	v0.ErrorOutput					"User is blocked or deleted"
+//	@Failure		409		{object}	v0.ErrorOutput					"Service account with the same name already exists"
+//	@Failure		500		{object}	v0.ErrorOutput					"Internal server error"
+//	@Router			/v0/account/service-accounts [post]
+func (h *handler) createServiceAccount(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle create service account")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	input := v0.CreateServiceAccountInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.svc.CreateServiceAccount(ctx, principal.ID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrDuplicateServiceAccount):
+			_ = util.ErrorWithStatus(ctx, http.StatusConflict, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+// deleteServiceAccount godoc
+//
+//	@Id				DeleteServiceAccount
+//	@Summary		Delete service account
+//	@Description	Request for removing own service account. User must be logged in. All API keys of service account stop working.
+//	@Security		BearerAuth
+//	@Tags			Service Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			id	path	string	true	"Service account ID"
+//	@Success		204
+//	@Failure		400	{object}	v0.ErrorOutput	"Validation error"
+//	@Failure		401	{object}	v0.ErrorOutput	"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput	"User is blocked or deleted"
+//	@Failure		404	{object}	v0.ErrorOutput	"Not found service account"
+//	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
+//	@Router			/v0/account/service-accounts/{id} [delete]
+func (h *handler) deleteServiceAccount(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle delete service account")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	serviceAccountID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.svc.DeleteServiceAccount(ctx, principal.ID, serviceAccountID); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrServiceAccountNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// getAPIKeys godoc
+//
+//	@Id				GetAPIKeys
+//	@Summary		Get API keys
+//	@Description	Request for receiving API keys of own service account with their usage. User must be logged in. Keys themselves are not returned, only their prefixes.
+//	@Security		BearerAuth
+//	@Tags			Service Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			id	path		string				true	"Service account ID"
+//	@Success		200	{object}	v0.APIKeysOutput	"API keys"
+//	@Failure		400	{object}	v0.ErrorOutput		"Validation error"
+//	@Failure		401	{object}	v0.ErrorOutput		"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput		"User is blocked or deleted"
+//	@Failure		404	{object}	v0.ErrorOutput		"Not found service account"
+//	@Failure		500	{object}	v0.ErrorOutput		"Internal server error"
+//	@Router			/v0/account/service-accounts/{id}/keys [get]
+func (h *handler) getAPIKeys(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle get API keys")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	serviceAccountID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.svc.GetAPIKeys(ctx, principal.ID, serviceAccountID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrServiceAccountNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, res)
+}
+
+// createAPIKey godoc
+//
+//	@Id				CreateAPIKey
+//	@Summary		Create API key
+//	@Description	Request for creating API key of own service account. User must be logged in. In response will be returned the key, it is shown only once.
+//	@Security		BearerAuth
+//	@Tags			Service Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			id		path		string					true	"Service account ID"
+//	@Param			input	body		v0.CreateAPIKeyInput	true	"Create API key request body"
+//	@Success		201		{object}	v0.CreatedAPIKeyOutput	"Created API key"
+//	@Failure		400		{object}	v0.ErrorOutput			"Validation error"
+//	@Failure		401		{object}	v0.ErrorOutput			"Unauthorized"
+//	@Failure		403		{object}	v0.ErrorOutput			"User is blocked or deleted"
+//	@Failure		404		{object}	v0.ErrorOutput			"Not found service account"
+//	@Failure		500		{object}	v0.ErrorOutput			"Internal server error"
+//	@Router			/v0/account/service-accounts/{id}/keys [post]
+func (h *handler) createAPIKey(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle create API key")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	serviceAccountID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	input := v0.CreateAPIKeyInput{}
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.svc.CreateAPIKey(ctx, principal.ID, serviceAccountID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrServiceAccountNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+// rotateAPIKey godoc
+//
+//	@Id				RotateAPIKey
+//	@Summary		Rotate API key
+//	@Description	Request for replacing API key of own service account with the new one. User must be logged in. New key has the same scopes and lifetime, old key remains valid during grace period. In response will be returned the new key, it is shown only once.
+//	@Security		BearerAuth
+//	@Tags			Service Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			id		path		string					true	"Service account ID"
+//	@Param			keyID	path		string					true	"API key ID"
+//	@Success		201		{object}	v0.CreatedAPIKeyOutput	"New API key"
+//	@Failure		400		{object}	v0.ErrorOutput			"Validation error"
+//	@Failure		401		{object}	v0.ErrorOutput			"Unauthorized"
+//	@Failure		403		{object}	v0.ErrorOutput			"User is blocked or deleted"
+//	@Failure		404		{object}	v0.ErrorOutput			"Not found service account or API key"
+//	@Failure		409		{object}	v0.ErrorOutput			"API key is revoked or expired"
+//	@Failure		500		{object}	v0.ErrorOutput			"Internal server error"
+//	@Router			/v0/account/service-accounts/{id}/keys/{keyID}/rotate [post]
+func (h *handler) rotateAPIKey(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle rotate API key")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	serviceAccountID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	apiKeyID, err := uuid.Parse(ctx.Param("keyID"))
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	res, err := h.svc.RotateAPIKey(ctx, principal.ID, serviceAccountID, apiKeyID)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrServiceAccountNotFound),
+			errors.Is(err, domain.ErrAPIKeyNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		case errors.Is(err, domain.ErrAPIKeyNotActive):
+			_ = util.ErrorWithStatus(ctx, http.StatusConflict, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, res)
+}
+
+// revokeAPIKey godoc
+//
+//	@Id				RevokeAPIKey
+//	@Summary		Revoke API key
+//	@Description	Request for revoking API key of own service account. User must be logged in. Revoked key can no longer be used.
+//	@Security		BearerAuth
+//	@Tags			Service Account API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Param			id		path	string	true	"Service account ID"
+//	@Param			keyID	path	string	true	"API key ID"
+//	@Success		204
+//	@Failure		400	{object}	v0.ErrorOutput	"Validation error"
+//	@Failure		401	{object}	v0.ErrorOutput	"Unauthorized"
+//	@Failure		403	{object}	v0.ErrorOutput	"User is blocked or deleted"
+//	@Failure		404	{object}	v0.ErrorOutput	"Not found service account or API key"
+//	@Failure		500	{object}	v0.ErrorOutput	"Internal server error"
+//	@Router			/v0/account/service-accounts/{id}/keys/{keyID} [delete]
+func (h *handler) revokeAPIKey(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle revoke API key")
+
+	principal, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	serviceAccountID, err := uuid.Parse(ctx.Param("id"))
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	apiKeyID, err := uuid.Parse(ctx.Param("keyID"))
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		return
+	}
+
+	if err := h.svc.RevokeAPIKey(ctx, principal.ID, serviceAccountID, apiKeyID); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrServiceAccountNotFound),
+			errors.Is(err, domain.ErrAPIKeyNotFound):
+			_ = util.ErrorWithStatus(ctx, http.StatusNotFound, err)
+		default:
+			_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
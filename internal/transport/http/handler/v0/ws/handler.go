@@ -1,6 +1,7 @@
 package ws
 
 import (
+	"errors"
 	"github.com/gin-gonic/gin"
 	"github.com/mandarine-io/backend/internal/service/domain"
 	apihandler "github.com/mandarine-io/backend/internal/transport/http/handler"
@@ -46,8 +47,17 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 		middleware.Registry.Auth,
 		middleware.Registry.BannedUser,
 		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
 		h.Connect,
 	)
+	router.GET(
+		"v0/ws/events",
+		middleware.Registry.Auth,
+		middleware.Registry.BannedUser,
+		middleware.Registry.DeletedUser,
+		middleware.Registry.MFAUser,
+		h.StreamEvents,
+	)
 }
 
 // Connect godoc
@@ -55,9 +65,14 @@ func (h *handler) RegisterRoutes(router *gin.Engine) {
 //	@Id				WsConnect
 //	@Summary		Connect to websocket server
 //	@Description	Request for connect to websocket server. If pool is not full, a new websocket connection is created.
+//	@Description	User may have several connections at the same time, each of them receives all messages of the user.
+//	@Description	Messages are JSON envelopes `{"v": 1, "type": "...", "id": "...", "replyTo": "...", "topic": "...", "payload": {...}}`.
+//	@Description	Client subscribes to topics (`notifications`, `appointments`) by `subscribe` and `unsubscribe` messages.
+//	@Description	Message with `id` is answered by `ack`, `response` or `error` message with the same `replyTo`.
 //	@Tags			Websocket API
 //	@Security		BearerAuth
 //	@Success		101
+//	@Header			101	{string}	X-Connection-ID	"Identifier of created connection"
 //	@Failure		400	{object}	v0.ErrorOutput
 //	@Failure		401	{object}	v0.ErrorOutput
 //	@Failure		503	{object}	v0.ErrorOutput
@@ -75,3 +90,52 @@ func (h *handler) Connect(ctx *gin.Context) {
 	ctx.Writer.Header().Set("Content-Type", "application/json")
 	_ = h.svc.RegisterClient(authUser.ID, ctx.Request, ctx.Writer)
 }
+
+// StreamEvents godoc
+//
+//	@Id				WsStreamEvents
+//	@Summary		Stream events
+//	@Description	Request for receiving the same messages as websocket connection by Server-Sent Events.
+//	@Description	It is fallback for networks and clients, which do not support websockets. Stream is read only,
+//	@Description	connection is subscribed to topics from query and receives JSON envelopes in `data` field of events.
+//	@Description	Events addressed to user have `id`. If connection is lost, client resumes stream by `Last-Event-ID` header
+//	@Description	(or `lastEventId` query parameter) and receives missed events, which are kept for a short time.
+//	@Description	Server sends comment `: ping` periodically to keep connection alive.
+//	@Tags			Websocket API
+//	@Security		BearerAuth
+//	@Produce		text/event-stream
+//	@Param			topic			query		[]string		false	"Topics to subscribe"	collectionFormat(multi)
+//	@Param			lastEventId		query		string			false	"Identifier of last received event"
+//	@Param			Last-Event-ID	header		string			false	"Identifier of last received event"
+//	@Success		200				{string}	string			"Stream of events"
+//	@Header			200				{string}	X-Connection-ID	"Identifier of created connection"
+//	@Failure		400				{object}	v0.ErrorOutput
+//	@Failure		401				{object}	v0.ErrorOutput
+//	@Failure		403				{object}	v0.ErrorOutput
+//	@Failure		503				{object}	v0.ErrorOutput
+//	@Router			/v0/ws/events [get]
+func (h *handler) StreamEvents(ctx *gin.Context) {
+	log.Debug().Msg("handle stream events")
+
+	authUser, err := middleware.GetAuthUser(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusUnauthorized, err)
+		return
+	}
+
+	lastEventID := ctx.GetHeader("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = ctx.Query("lastEventId")
+	}
+
+	err = h.svc.StreamEvents(authUser.ID, ctx.QueryArray("topic"), lastEventID, ctx.Request, ctx.Writer)
+	if err != nil {
+		// Other errors occur, when response is already written
+		switch {
+		case errors.Is(err, domain.ErrUnknownTopic):
+			_ = util.ErrorWithStatus(ctx, http.StatusBadRequest, err)
+		case errors.Is(err, domain.ErrTopicForbidden):
+			_ = util.ErrorWithStatus(ctx, http.StatusForbidden, err)
+		}
+	}
+}
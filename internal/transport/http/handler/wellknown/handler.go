@@ -0,0 +1,70 @@
+package wellknown
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	apihandler "github.com/mandarine-io/backend/internal/transport/http/handler"
+	"github.com/mandarine-io/backend/internal/transport/http/util"
+	_ "github.com/mandarine-io/backend/pkg/model/v0"
+	_ "github.com/mandarine-io/backend/pkg/model/wellknown"
+	"github.com/rs/zerolog"
+	"net/http"
+)
+
+type handler struct {
+	logger zerolog.Logger
+	svc    domain.WellKnownService
+}
+
+type Option func(*handler)
+
+func WithLogger(logger zerolog.Logger) Option {
+	return func(h *handler) {
+		h.logger = logger
+	}
+}
+
+func NewHandler(svc domain.WellKnownService, opts ...Option) apihandler.APIHandler {
+	h := &handler{
+		svc:    svc,
+		logger: zerolog.Nop(),
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	return h
+}
+
+func (h *handler) RegisterRoutes(router *gin.Engine) {
+	h.logger.Debug().Msg("register well-known routes")
+
+	wellKnownRouter := router.Group("/.well-known")
+	{
+		wellKnownRouter.GET("/jwks.json", h.getJWKS)
+	}
+}
+
+// getJWKS godoc
+//
+//	@Id				getJWKS
+//	@Summary		JSON Web Key Set
+//	@Description	Request for getting public keys, which are used to verify JWT tokens. Contains current and previous keys, which are still valid.
+//	@Tags			Well-known API
+//	@Accept			application/json
+//	@Produce		application/json
+//	@Success		200	{object}	wellknown.JWKSOutput
+//	@Failure		500	{object}	v0.ErrorOutput
+//	@Router			/.well-known/jwks.json [get]
+func (h *handler) getJWKS(ctx *gin.Context) {
+	h.logger.Debug().Msg("handle get jwks")
+
+	resp, err := h.svc.GetJWKS(ctx)
+	if err != nil {
+		_ = util.ErrorWithStatus(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
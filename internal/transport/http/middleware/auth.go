@@ -0,0 +1,158 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+	"net/http"
+	"strings"
+)
+
+const (
+	AuthUserKey = "authUser"
+
+	ScopeBookingsRead   = "bookings:read"
+	ScopeServicesRead   = "services:read"
+	ScopeServicesWrite  = "services:write"
+	ScopeWebhooksManage = "webhooks:manage"
+)
+
+var (
+	ErrJWTTokenIsMissing = v0.NewI18nError("JWT token is missing", "errors.session_invalid")
+	ErrUserNotFound      = v0.NewI18nError("user not found", "errors.user_not_found")
+	ErrAPIKeyNotAllowed  = v0.NewI18nError("API key is not allowed", "errors.api_key_not_allowed")
+	ErrInsufficientScope = v0.NewI18nError("insufficient scope of API key", "errors.insufficient_scope")
+)
+
+// AuthUser is authenticated principal. If request is authenticated by API key, user fields describe owner
+// of service account, and APIKeyID, ServiceAccountID and Scopes are set
+type AuthUser struct {
+	ID             uuid.UUID `json:"id"`
+	Username       string    `json:"username"`
+	Email          string    `json:"email"`
+	Role           string    `json:"role"`
+	IsPasswordTemp bool      `json:"isPasswordTemp"`
+	IsEnabled      bool      `json:"isEnabled"`
+	IsDeleted      bool      `json:"isDeleted"`
+	JTI            string    `json:"jti"`
+	SessionID      uuid.UUID `json:"sessionId"`
+
+	IsMFASetupRequired bool `json:"isMfaSetupRequired"`
+
+	APIKeyID         uuid.UUID `json:"apiKeyId,omitempty"`
+	ServiceAccountID uuid.UUID `json:"serviceAccountId,omitempty"`
+	Scopes           []string  `json:"scopes,omitempty"`
+}
+
+// AuthMiddleware authenticates user by JWT access token or service account by API key.
+// API keys are rejected, unless scopes are passed, and key must have all of them.
+// So routes are not available to service accounts until they explicitly declare required scopes
+func AuthMiddleware(
+	jwtService infrastructure.JWTService,
+	apiKeyService infrastructure.APIKeyService,
+	scopes ...string,
+) gin.HandlerFunc {
+	log.Debug().Msg("setup auth middleware")
+	logger := log.With().Str("middleware", "auth").Logger()
+
+	return func(c *gin.Context) {
+		if apiKey := getAPIKey(c); apiKey != "" {
+			if len(scopes) == 0 {
+				logger.Error().Stack().Err(ErrAPIKeyNotAllowed).Msg("route is not available for API keys")
+				_ = c.AbortWithError(http.StatusForbidden, ErrAPIKeyNotAllowed)
+				return
+			}
+
+			claims, err := apiKeyService.GetAPIKeyClaims(c, apiKey, c.ClientIP())
+			if err != nil {
+				logger.Error().Err(err).Stack().Msg("failed to check API key")
+				_ = c.AbortWithError(http.StatusUnauthorized, err)
+				return
+			}
+
+			if !lo.Every(claims.Scopes, scopes) {
+				logger.Error().Stack().Err(ErrInsufficientScope).Msgf("API key has no scopes %v", scopes)
+				_ = c.AbortWithError(http.StatusForbidden, ErrInsufficientScope)
+				return
+			}
+
+			authUser := AuthUser{
+				ID:        claims.UserID,
+				Username:  claims.Username,
+				Email:     claims.Email,
+				Role:      claims.Role,
+				IsEnabled: claims.IsEnabled,
+				IsDeleted: claims.IsDeleted,
+
+				APIKeyID:         claims.APIKeyID,
+				ServiceAccountID: claims.ServiceAccountID,
+				Scopes:           claims.Scopes,
+			}
+
+			c.Set(AuthUserKey, authUser)
+			return
+		}
+
+		bearerHeader := c.Request.Header.Get("Authorization")
+		if bearerHeader == "" {
+			logger.Error().Stack().Msg("not found Authorization header")
+			_ = c.AbortWithError(http.StatusUnauthorized, ErrJWTTokenIsMissing)
+			return
+		}
+
+		accessToken, _ := strings.CutPrefix(bearerHeader, "Bearer ")
+
+		claims, err := jwtService.GetAccessTokenClaims(c, accessToken)
+		if err != nil {
+			logger.Error().Err(err).Stack().Msg("failed to parse JWT token")
+			_ = c.AbortWithError(http.StatusUnauthorized, err)
+			return
+		}
+
+		authUser := AuthUser{
+			ID:             claims.UserID,
+			Username:       claims.Username,
+			Email:          claims.Email,
+			Role:           claims.Role,
+			IsPasswordTemp: claims.IsPasswordTemp,
+			IsEnabled:      claims.IsEnabled,
+			IsDeleted:      claims.IsDeleted,
+			JTI:            claims.JTI,
+			SessionID:      claims.SessionID,
+
+			IsMFASetupRequired: claims.IsMFASetupRequired,
+		}
+
+		c.Set(AuthUserKey, authUser)
+	}
+}
+
+func GetAuthUser(ctx *gin.Context) (AuthUser, error) {
+	authUserAny, ok := ctx.Get(AuthUserKey)
+	if !ok {
+		return AuthUser{}, ErrUserNotFound
+	}
+
+	authUser, ok := authUserAny.(AuthUser)
+	if !ok {
+		return AuthUser{}, ErrUserNotFound
+	}
+
+	return authUser, nil
+}
+
+// getAPIKey returns API key from X-API-Key header or Authorization header with ApiKey scheme
+func getAPIKey(c *gin.Context) string {
+	if apiKey := c.GetHeader(apiKeyHeader); apiKey != "" {
+		return apiKey
+	}
+
+	if apiKey, ok := strings.CutPrefix(c.GetHeader("Authorization"), apiKeyScheme); ok {
+		return apiKey
+	}
+
+	return ""
+}
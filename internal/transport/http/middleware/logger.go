@@ -23,7 +23,10 @@ type bodyLogWriter struct {
 }
 
 func (w bodyLogWriter) Write(b []byte) (int, error) {
-	w.body.Write(b)
+	// Only logged part of body is kept, so long-lived responses (e.g. event streams) do not grow buffer
+	if rest := maxLogBodySize + 1 - w.body.Len(); rest > 0 {
+		w.body.Write(b[:min(rest, len(b))])
+	}
 	return w.ResponseWriter.Write(b)
 }
 
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/rs/zerolog/log"
+	"net/http"
+)
+
+var (
+	ErrMFASetupRequired = v0.NewI18nError("two-factor authentication setup required", "errors.mfa_setup_required")
+)
+
+// MFAUserMiddleware denies access to users, whose role requires 2FA, until they enable it.
+// It is applied to all authenticated routes except the ones needed to set up 2FA or leave the account
+//
+// Use strictly after adding JWT middleware
+func MFAUserMiddleware() gin.HandlerFunc {
+	log.Debug().Msg("setup mfa user middleware")
+	logger := log.With().Str("middleware", "mfa-user").Logger()
+
+	return func(c *gin.Context) {
+		logger.Debug().Msg("check mfa user")
+
+		authUser, err := GetAuthUser(c)
+		if err != nil {
+			logger.Error().Stack().Err(err).Msg("failed to get auth user")
+			_ = c.AbortWithError(http.StatusUnauthorized, err)
+			return
+		}
+
+		if authUser.IsMFASetupRequired {
+			logger.Error().Stack().Err(ErrMFASetupRequired).Msg("user has not enabled required 2FA")
+			_ = c.AbortWithError(http.StatusForbidden, ErrMFASetupRequired)
+			return
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/gin-gonic/gin"
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/infrastructure/ratelimit"
+	"github.com/mandarine-io/backend/internal/observability"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	cachehelper "github.com/mandarine-io/backend/internal/util/cache"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/rs/zerolog/log"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	rateLimitCachePrefix = "ratelimit"
+	defaultPolicyRoute   = "default"
+
+	IPRateLimitKey     = "ip"
+	UserRateLimitKey   = "user"
+	APIKeyRateLimitKey = "api_key"
+
+	apiKeyHeader = "X-API-Key"
+	apiKeyScheme = "ApiKey "
+)
+
+var (
+	ErrRateLimitExceeded = v0.NewI18nError("rate limit exceeded", "errors.rate_limit_exceeded")
+)
+
+type rateLimitPolicy struct {
+	route string
+	limit ratelimit.Limit
+	key   string
+}
+
+// RateLimitMiddleware limits requests by policy of matched route or by default policy.
+// Limiter errors do not reject requests, so that unavailable storage does not stop the service
+func RateLimitMiddleware(
+	limiter ratelimit.Limiter,
+	cfg config.RateLimitConfig,
+	jwtService infrastructure.JWTService,
+	adapter observability.MetricsAdapter,
+	ignorePathRegexpStrs ...string,
+) gin.HandlerFunc {
+	log.Debug().Msg("setup rate limit middleware")
+	logger := log.With().Str("middleware", "rate-limit").Logger()
+
+	if !cfg.Enable {
+		logger.Info().Msg("rate limit is disabled")
+		return func(_ *gin.Context) {}
+	}
+
+	ignorePathRegexps := make([]*regexp.Regexp, 0)
+	for _, regexpStr := range ignorePathRegexpStrs {
+		logger.Debug().Msgf("compile ingnore regexp: %s", regexpStr)
+		compiledRegexp, err := regexp.Compile(regexpStr)
+		if err != nil {
+			logger.Error().Err(err).Msgf("failed to compiled regexp")
+			continue
+		}
+
+		ignorePathRegexps = append(ignorePathRegexps, compiledRegexp)
+	}
+
+	defaultPolicy := rateLimitPolicy{
+		route: defaultPolicyRoute,
+		limit: ratelimit.Limit{Rate: cfg.Default.Limit, Period: time.Duration(cfg.Default.Period) * time.Second},
+		key:   cfg.Default.Key,
+	}
+	routePolicies := make(map[string]rateLimitPolicy, len(cfg.Routes))
+	for _, route := range cfg.Routes {
+		routePolicies[route.Method+" "+route.Path] = rateLimitPolicy{
+			route: route.Method + " " + route.Path,
+			limit: ratelimit.Limit{Rate: route.Limit, Period: time.Duration(route.Period) * time.Second},
+			key:   route.Key,
+		}
+	}
+
+	return func(c *gin.Context) {
+		// Not found routes are not limited
+		route := c.FullPath()
+		if route == "" {
+			return
+		}
+
+		for _, pathRegexp := range ignorePathRegexps {
+			if pathRegexp.MatchString(c.Request.URL.Path) {
+				return
+			}
+		}
+
+		policy, ok := routePolicies[c.Request.Method+" "+route]
+		if !ok {
+			policy = defaultPolicy
+		}
+		if policy.limit.Rate <= 0 {
+			return
+		}
+
+		keyType, key := resolveRateLimitKey(c, policy.key, jwtService)
+		res, err := limiter.Allow(
+			c,
+			cachehelper.CreateCacheKey(rateLimitCachePrefix, policy.route, keyType, key),
+			policy.limit,
+		)
+		if err != nil {
+			logger.Error().Stack().Err(err).Msg("failed to check rate limit")
+			return
+		}
+
+		c.Header("RateLimit-Limit", strconv.Itoa(res.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		c.Header("RateLimit-Reset", strconv.FormatInt(durationToSeconds(res.Reset), 10))
+		c.Header(
+			"RateLimit-Policy",
+			strconv.Itoa(policy.limit.Rate)+";w="+strconv.FormatInt(durationToSeconds(policy.limit.Period), 10),
+		)
+
+		if !res.Allowed {
+			logger.Warn().Msgf("rate limit exceeded, route: %s, key: %s", policy.route, keyType)
+
+			adapter.IncrementRateLimited(route, c.Request.Method, keyType)
+			c.Header("Retry-After", strconv.FormatInt(durationToSeconds(res.RetryAfter), 10))
+			_ = c.AbortWithError(http.StatusTooManyRequests, ErrRateLimitExceeded)
+			return
+		}
+	}
+}
+
+// resolveRateLimitKey returns type and value of key, client IP is used if user or API key is not provided
+func resolveRateLimitKey(c *gin.Context, keyType string, jwtService infrastructure.JWTService) (string, string) {
+	switch keyType {
+	case APIKeyRateLimitKey:
+		// API key is not stored in plain form
+		if apiKey := getAPIKey(c); apiKey != "" {
+			hash := sha256.Sum256([]byte(apiKey))
+			return APIKeyRateLimitKey, hex.EncodeToString(hash[:])
+		}
+	case UserRateLimitKey:
+		// Route middlewares are not executed yet, so access token is parsed here
+		bearerHeader := c.GetHeader("Authorization")
+		if accessToken, ok := strings.CutPrefix(bearerHeader, "Bearer "); ok {
+			claims, err := jwtService.GetAccessTokenClaims(c, accessToken)
+			if err == nil {
+				return UserRateLimitKey, claims.UserID.String()
+			}
+		}
+	}
+
+	return IPRateLimitKey, c.ClientIP()
+}
+
+func durationToSeconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
@@ -15,14 +15,23 @@ type RouteMiddlewareRegistry struct {
 	AdminRole   gin.HandlerFunc
 	BannedUser  gin.HandlerFunc
 	DeletedUser gin.HandlerFunc
+	MFAUser     gin.HandlerFunc
+
+	// ScopedAuth is the same as Auth, but also allows service accounts, whose API keys have all passed scopes
+	ScopedAuth func(scopes ...string) gin.HandlerFunc
 }
 
-func InitRegistry(jwtClient infrastructure.JWTService) {
+func InitRegistry(jwtClient infrastructure.JWTService, apiKeyClient infrastructure.APIKeyService) {
 	Registry = RouteMiddlewareRegistry{
-		Auth:        JWTAuthMiddleware(jwtClient),
+		Auth:        AuthMiddleware(jwtClient, apiKeyClient),
 		UserRole:    UserRoleMiddleware(),
 		AdminRole:   AdminRoleMiddleware(),
 		BannedUser:  BannedUserMiddleware(),
 		DeletedUser: DeletedUserMiddleware(),
+		MFAUser:     MFAUserMiddleware(),
+
+		ScopedAuth: func(scopes ...string) gin.HandlerFunc {
+			return AuthMiddleware(jwtClient, apiKeyClient, scopes...)
+		},
 	}
 }
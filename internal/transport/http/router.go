@@ -42,6 +42,8 @@ type RequireRoleMiddlewareFactory func(...string) gin.HandlerFunc
 //	@tag.description			API for account management
 //	@tag.name					Authentication and Authorization API
 //	@tag.description			API for authentication and authorization
+//	@tag.name					Chat API
+//	@tag.description			API for conversations between clients and masters
 //	@tag.name					Geocoding API
 //	@tag.description			API for geocoding
 //	@tag.name					Master Profile API
@@ -52,6 +54,8 @@ type RequireRoleMiddlewareFactory func(...string) gin.HandlerFunc
 //	@tag.description			API for getting metrics and healthcheck
 //	@tag.name					Resource API
 //	@tag.description			API for download and upload files
+//	@tag.name					Service Account API
+//	@tag.description			API for service accounts and their API keys
 //	@tag.name					Swagger API
 //	@tag.description			API for getting swagger documentation
 //	@tag.name					Websocket API
@@ -63,6 +67,10 @@ type RequireRoleMiddlewareFactory func(...string) gin.HandlerFunc
 //	@securityDefinitions.apikey	BearerAuth
 //	@in							header
 //	@name						Authorization
+//	@securityDefinitions.apikey	APIKeyAuth
+//	@in							header
+//	@name						X-API-Key
+//	@description				API key of service account, also it can be passed in Authorization header with ApiKey scheme
 //	@externalDocs.description	OpenAPI
 //	@externalDocs.url			https://swagger.io/resources/open-api/
 func SetupRouter(container *di.Container) *gin.Engine {
@@ -75,6 +83,12 @@ func SetupRouter(container *di.Container) *gin.Engine {
 	gin.SetMode(gin.ReleaseMode)
 	router := gin.New()
 
+	// Setup trusted proxies
+	log.Debug().Msg("setup trusted proxies")
+	if err := SetupTrustedProxies(router, container.Config.Server.TrustedProxies); err != nil {
+		log.Error().Stack().Err(err).Msg("failed to setup trusted proxies")
+	}
+
 	// Setup validators
 	log.Debug().Msg("setup validators")
 	decimal.MarshalJSONWithoutQuotes = true
@@ -99,7 +113,16 @@ func SetupRouter(container *di.Container) *gin.Engine {
 	router.Use(middleware.LocaleMiddleware(container.Infrastructure.LocaleBundle))
 	router.Use(middleware.RecoveryMiddleware())
 	router.Use(middleware.ErrorMiddleware())
-	middleware.InitRegistry(container.InfrastructureSVCs.JWT)
+	router.Use(
+		middleware.RateLimitMiddleware(
+			container.Infrastructure.RateLimiter,
+			container.Config.Security.RateLimit,
+			container.InfrastructureSVCs.JWT,
+			container.Metrics,
+			ignorePathRegexps...,
+		),
+	)
+	middleware.InitRegistry(container.InfrastructureSVCs.JWT, container.InfrastructureSVCs.APIKey)
 
 	// Register routes
 	log.Debug().Msg("register routes")
@@ -130,3 +153,13 @@ func handleNoRoute(ctx *gin.Context) {
 	log.Debug().Msg("handle route not found")
 	_ = util.ErrorWithStatus(ctx, http.StatusNotFound, ErrRouteNotFound)
 }
+
+// SetupTrustedProxies makes router take client IP from X-Forwarded-For header only of trusted proxies.
+// Header of other clients is ignored, so they cannot change IP, by which requests are limited
+func SetupTrustedProxies(router *gin.Engine, proxies []string) error {
+	if len(proxies) == 0 {
+		return router.SetTrustedProxies(nil)
+	}
+
+	return router.SetTrustedProxies(proxies)
+}
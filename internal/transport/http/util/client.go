@@ -0,0 +1,23 @@
+package util
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+)
+
+const (
+	deviceNameHeaderKey = "X-Device-Name"
+	locationHeaderKey   = "X-Client-Location"
+)
+
+// GetClientInfo returns info about client of request. Device name and location are taken from headers as is,
+// so they are reported by client and must be displayed as such. IP is taken from X-Forwarded-For only
+// if request is sent by trusted proxy (see server.trustedproxies), so client cannot reset per-IP counters
+func GetClientInfo(ctx *gin.Context) infrastructure.ClientInfo {
+	return infrastructure.ClientInfo{
+		IP:         ctx.ClientIP(),
+		UserAgent:  ctx.Request.UserAgent(),
+		DeviceName: ctx.GetHeader(deviceNameHeaderKey),
+		Location:   ctx.GetHeader(locationHeaderKey),
+	}
+}
@@ -1,10 +1,26 @@
 package util
 
 import (
+	"errors"
 	"github.com/gin-gonic/gin"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"math"
+	"net/http"
+	"strconv"
 )
 
 func ErrorWithStatus(ctx *gin.Context, status int, err error) error {
 	ctx.Status(status)
 	return ctx.Error(err)
 }
+
+// TooManyRequestsError responds with 429 status and sets Retry-After header, if error contains lockout duration
+func TooManyRequestsError(ctx *gin.Context, err error) error {
+	var lockoutErr infrastructure.LockoutError
+	if errors.As(err, &lockoutErr) && lockoutErr.RetryAfter > 0 {
+		retryAfter := int64(math.Ceil(lockoutErr.RetryAfter.Seconds()))
+		ctx.Header("Retry-After", strconv.FormatInt(retryAfter, 10))
+	}
+
+	return ErrorWithStatus(ctx, http.StatusTooManyRequests, err)
+}
@@ -0,0 +1,38 @@
+package util
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mandarine-io/backend/internal/infrastructure/s3"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// File writes file from S3 storage to response as attachment with given filename
+func File(ctx *gin.Context, filename string, data *s3.FileData) {
+	if isASCII(filename) {
+		ctx.Writer.Header().Set("Content-Disposition", `attachment; filename="`+escapeQuotes(filename)+`"`)
+	} else {
+		ctx.Writer.Header().Set("Content-Disposition", `attachment; filename*=UTF-8''`+url.QueryEscape(filename))
+	}
+
+	ctx.DataFromReader(
+		http.StatusOK, data.Size, data.ContentType, data.Reader,
+		map[string]string{},
+	)
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
+
+func escapeQuotes(s string) string {
+	quoteEscaper := strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+	return quoteEscaper.Replace(s)
+}
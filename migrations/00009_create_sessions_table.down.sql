@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS user_id_sessions_index;
+DROP INDEX IF EXISTS expired_at_sessions_index;
+
+DROP TABLE IF EXISTS sessions;
\ No newline at end of file
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS sessions
+(
+    id           uuid PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    user_id      uuid        NOT NULL REFERENCES users (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    jti          TEXT        NOT NULL UNIQUE,
+    device_name  TEXT,
+    ip           TEXT,
+    user_agent   TEXT,
+    created_at   timestamptz NOT NULL DEFAULT NOW(),
+    last_used_at timestamptz NOT NULL DEFAULT NOW(),
+    expired_at   timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS user_id_sessions_index on sessions (user_id);
+CREATE INDEX IF NOT EXISTS expired_at_sessions_index on sessions (expired_at);
\ No newline at end of file
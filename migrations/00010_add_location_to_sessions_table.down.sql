@@ -0,0 +1,2 @@
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS location;
\ No newline at end of file
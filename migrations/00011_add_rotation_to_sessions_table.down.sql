@@ -0,0 +1,3 @@
+ALTER TABLE sessions
+    DROP COLUMN IF EXISTS previous_jti,
+    DROP COLUMN IF EXISTS rotated_at;
\ No newline at end of file
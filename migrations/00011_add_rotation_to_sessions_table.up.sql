@@ -0,0 +1,3 @@
+ALTER TABLE sessions
+    ADD COLUMN IF NOT EXISTS previous_jti TEXT,
+    ADD COLUMN IF NOT EXISTS rotated_at   timestamptz;
\ No newline at end of file
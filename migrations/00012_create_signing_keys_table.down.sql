@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS expired_at_signing_keys_index;
+
+DROP TABLE IF EXISTS signing_keys;
\ No newline at end of file
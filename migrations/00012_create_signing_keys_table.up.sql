@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS signing_keys
+(
+    id          TEXT PRIMARY KEY,
+    algorithm   TEXT        NOT NULL,
+    private_key TEXT        NOT NULL,
+    public_key  TEXT        NOT NULL,
+    created_at  timestamptz NOT NULL DEFAULT NOW(),
+    expired_at  timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS expired_at_signing_keys_index on signing_keys (expired_at);
\ No newline at end of file
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS user_id_recovery_codes_index;
+
+DROP TABLE IF EXISTS recovery_codes;
+
+ALTER TABLE roles
+    DROP COLUMN IF EXISTS is_mfa_required;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS totp_secret,
+    DROP COLUMN IF EXISTS totp_last_used_step,
+    DROP COLUMN IF EXISTS is_totp_enabled;
\ No newline at end of file
@@ -0,0 +1,18 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS totp_secret         TEXT,
+    ADD COLUMN IF NOT EXISTS totp_last_used_step BIGINT,
+    ADD COLUMN IF NOT EXISTS is_totp_enabled     BOOLEAN NOT NULL DEFAULT false;
+
+ALTER TABLE roles
+    ADD COLUMN IF NOT EXISTS is_mfa_required BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS recovery_codes
+(
+    id         uuid PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    user_id    uuid        NOT NULL REFERENCES users (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    code_hash  TEXT        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT NOW(),
+    used_at    timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS user_id_recovery_codes_index on recovery_codes (user_id);
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS user_id_passkeys_index;
+
+DROP TABLE IF EXISTS passkeys;
\ No newline at end of file
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS passkeys
+(
+    id            uuid PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    user_id       uuid        NOT NULL REFERENCES users (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    credential_id BYTEA       NOT NULL UNIQUE,
+    public_key    BYTEA       NOT NULL,
+    algorithm     INTEGER     NOT NULL,
+    sign_count    BIGINT      NOT NULL DEFAULT 0,
+    aaguid        uuid,
+    transports    TEXT,
+    name          TEXT        NOT NULL,
+    created_at    timestamptz NOT NULL DEFAULT NOW(),
+    last_used_at  timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS user_id_passkeys_index on passkeys (user_id);
\ No newline at end of file
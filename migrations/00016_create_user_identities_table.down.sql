@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS user_id_provider_user_identities_index;
+DROP INDEX IF EXISTS provider_subject_user_identities_index;
+
+DROP TABLE IF EXISTS user_identities;
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS user_identities
+(
+    id         uuid PRIMARY KEY      DEFAULT uuid_generate_v4(),
+    user_id    uuid         NOT NULL REFERENCES users (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    provider   VARCHAR(50)  NOT NULL,
+    subject    VARCHAR(255) NOT NULL,
+    email      VARCHAR(255),
+    created_at timestamptz  NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS provider_subject_user_identities_index on user_identities (provider, subject);
+CREATE UNIQUE INDEX IF NOT EXISTS user_id_provider_user_identities_index on user_identities (user_id, provider);
\ No newline at end of file
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS users_email_unique_index;
+
+ALTER TABLE users
+    ADD CONSTRAINT users_email_key UNIQUE (email);
\ No newline at end of file
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS service_account_id_api_keys_index;
+
+DROP TABLE IF EXISTS api_keys;
+
+DROP INDEX IF EXISTS owner_id_name_service_accounts_index;
+
+DROP TABLE IF EXISTS service_accounts;
\ No newline at end of file
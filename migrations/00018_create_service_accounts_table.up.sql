@@ -0,0 +1,29 @@
+CREATE TABLE IF NOT EXISTS service_accounts
+(
+    id          uuid PRIMARY KEY      DEFAULT uuid_generate_v4(),
+    owner_id    uuid         NOT NULL REFERENCES users (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    name        VARCHAR(100) NOT NULL,
+    description TEXT,
+    created_at  timestamptz  NOT NULL DEFAULT NOW(),
+    updated_at  timestamptz  NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS owner_id_name_service_accounts_index on service_accounts (owner_id, name);
+
+CREATE TABLE IF NOT EXISTS api_keys
+(
+    id                 uuid PRIMARY KEY      DEFAULT uuid_generate_v4(),
+    service_account_id uuid         NOT NULL REFERENCES service_accounts (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    name               VARCHAR(100) NOT NULL,
+    prefix             VARCHAR(32)  NOT NULL UNIQUE,
+    key_hash           VARCHAR(64)  NOT NULL UNIQUE,
+    scopes             TEXT         NOT NULL,
+    expires_at         timestamptz,
+    revoked_at         timestamptz,
+    usage_count        BIGINT       NOT NULL DEFAULT 0,
+    last_used_at       timestamptz,
+    last_used_ip       VARCHAR(45),
+    created_at         timestamptz  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS service_account_id_api_keys_index on api_keys (service_account_id);
\ No newline at end of file
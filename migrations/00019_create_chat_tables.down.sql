@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS message_id_chat_message_attachments_index;
+
+DROP TABLE IF EXISTS chat_message_attachments;
+
+DROP INDEX IF EXISTS conversation_id_created_at_chat_messages_index;
+
+DROP TABLE IF EXISTS chat_messages;
+
+DROP INDEX IF EXISTS master_id_conversations_index;
+
+DROP INDEX IF EXISTS participants_conversations_index;
+
+DROP TABLE IF EXISTS conversations;
\ No newline at end of file
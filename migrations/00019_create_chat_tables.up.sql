@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS conversations
+(
+    id                  uuid PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    client_id           uuid        NOT NULL REFERENCES users (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    master_id           uuid        NOT NULL REFERENCES users (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    appointment_id      uuid,
+    client_last_read_at timestamptz,
+    master_last_read_at timestamptz,
+    last_message_at     timestamptz,
+    created_at          timestamptz NOT NULL DEFAULT NOW(),
+    updated_at          timestamptz NOT NULL DEFAULT NOW()
+);
+
+-- Conversation without appointment is unique for client and master too
+CREATE UNIQUE INDEX IF NOT EXISTS participants_conversations_index on conversations (client_id, master_id,
+                                                                                    COALESCE(appointment_id, '00000000-0000-0000-0000-000000000000'::uuid));
+CREATE INDEX IF NOT EXISTS master_id_conversations_index on conversations (master_id);
+
+CREATE TABLE IF NOT EXISTS chat_messages
+(
+    id              uuid PRIMARY KEY     DEFAULT uuid_generate_v4(),
+    conversation_id uuid        NOT NULL REFERENCES conversations (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    sender_id       uuid        NOT NULL REFERENCES users (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    text            TEXT        NOT NULL DEFAULT '',
+    created_at      timestamptz NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS conversation_id_created_at_chat_messages_index on chat_messages (conversation_id, created_at DESC, id DESC);
+
+CREATE TABLE IF NOT EXISTS chat_message_attachments
+(
+    id         uuid PRIMARY KEY DEFAULT uuid_generate_v4(),
+    message_id uuid NOT NULL REFERENCES chat_messages (id) ON DELETE CASCADE ON UPDATE CASCADE,
+    object_id  TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS message_id_chat_message_attachments_index on chat_message_attachments (message_id);
\ No newline at end of file
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS published_at_outbox_events_index;
+
+DROP INDEX IF EXISTS aggregate_outbox_events_index;
+
+DROP INDEX IF EXISTS pending_outbox_events_index;
+
+DROP TABLE IF EXISTS outbox_events;
\ No newline at end of file
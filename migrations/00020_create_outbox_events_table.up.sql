@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS outbox_events
+(
+    id              uuid PRIMARY KEY      DEFAULT uuid_generate_v4(),
+    seq             BIGSERIAL    NOT NULL,
+    aggregate_type  VARCHAR(255) NOT NULL,
+    aggregate_id    VARCHAR(255) NOT NULL,
+    event_type      VARCHAR(255) NOT NULL,
+    payload         jsonb        NOT NULL,
+    attempts        INT          NOT NULL DEFAULT 0,
+    last_error      TEXT,
+    next_attempt_at timestamptz  NOT NULL DEFAULT NOW(),
+    published_at    timestamptz,
+    created_at      timestamptz  NOT NULL DEFAULT NOW()
+);
+
+-- Pending events are read in order of sequence, the earliest pending event of aggregate blocks later ones
+CREATE INDEX IF NOT EXISTS pending_outbox_events_index on outbox_events (seq) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS aggregate_outbox_events_index on outbox_events (aggregate_type, aggregate_id, seq) WHERE published_at IS NULL;
+CREATE INDEX IF NOT EXISTS published_at_outbox_events_index on outbox_events (published_at) WHERE published_at IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,2 @@
+ALTER TABLE outbox_events
+    DROP COLUMN IF EXISTS failed_at;
\ No newline at end of file
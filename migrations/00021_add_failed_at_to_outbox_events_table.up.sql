@@ -0,0 +1,2 @@
+ALTER TABLE outbox_events
+    ADD COLUMN IF NOT EXISTS failed_at timestamptz;
\ No newline at end of file
@@ -28,6 +28,36 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/.well-known/jwks.json": {
+            "get": {
+                "description": "Request for getting public keys, which are used to verify JWT tokens. Contains current and previous keys, which are still valid.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Well-known API"
+                ],
+                "summary": "JSON Web Key Set",
+                "operationId": "getJWKS",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/wellknown.JWKSOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
         "/health": {
             "get": {
                 "description": "Request for getting health. Alias healthReadiness",
@@ -303,14 +333,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/account/email": {
-            "patch": {
+        "/v0/account/2fa/disable": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for updating email. User must be logged in. In process will be sent verification email. In response will be returned updated service info.",
+                "description": "Request for disabling two-factor authentication. User must be logged in. Code from authenticator app or one of recovery codes is required.",
                 "consumes": [
                     "application/json"
                 ],
@@ -320,31 +350,22 @@ const docTemplate = `{
                 "tags": [
                     "Account API"
                 ],
-                "summary": "Update email",
-                "operationId": "UpdateEmail",
+                "summary": "Disable two-factor authentication",
+                "operationId": "DisableTOTP",
                 "parameters": [
                     {
-                        "description": "Update email request body",
+                        "description": "TOTP code request body",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v0.UpdateEmailInput"
+                            "$ref": "#/definitions/v0.TOTPCodeInput"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Account info (email is verified)",
-                        "schema": {
-                            "$ref": "#/definitions/v0.AccountOutput"
-                        }
-                    },
-                    "202": {
-                        "description": "Account info (email is not verified)",
-                        "schema": {
-                            "$ref": "#/definitions/v0.AccountOutput"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Validation error",
@@ -370,8 +391,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "409": {
-                        "description": "Duplicate email",
+                    "429": {
+                        "description": "Too many failed attempts, account or IP is temporarily locked",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -385,14 +406,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/account/email/verify": {
+        "/v0/account/2fa/enable": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for verify email. User must be logged in.",
+                "description": "Request for confirming two-factor authentication setup by code from authenticator app. User must be logged in. In response will be returned one-time recovery codes.",
                 "consumes": [
                     "application/json"
                 ],
@@ -402,22 +423,25 @@ const docTemplate = `{
                 "tags": [
                     "Account API"
                 ],
-                "summary": "Verify email",
-                "operationId": "VerifyEmail",
+                "summary": "Enable two-factor authentication",
+                "operationId": "EnableTOTP",
                 "parameters": [
                     {
-                        "description": "Verify email request body",
+                        "description": "TOTP code request body",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v0.VerifyEmailInput"
+                            "$ref": "#/definitions/v0.TOTPCodeInput"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "Recovery codes",
+                        "schema": {
+                            "$ref": "#/definitions/v0.RecoveryCodesOutput"
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -443,75 +467,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/v0.ErrorOutput"
-                        }
-                    }
-                }
-            }
-        },
-        "/v0/account/password": {
-            "post": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    }
-                ],
-                "description": "Request for setting password. User must be logged in.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Account API"
-                ],
-                "summary": "Set password",
-                "operationId": "SetPassword",
-                "parameters": [
-                    {
-                        "description": "Set password request body",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v0.SetPasswordInput"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Validation error",
-                        "schema": {
-                            "$ref": "#/definitions/v0.ErrorOutput"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/v0.ErrorOutput"
-                        }
-                    },
-                    "403": {
-                        "description": "User is blocked or deleted",
-                        "schema": {
-                            "$ref": "#/definitions/v0.ErrorOutput"
-                        }
-                    },
-                    "404": {
-                        "description": "Not found user",
+                    "409": {
+                        "description": "Two-factor authentication is already enabled",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "409": {
-                        "description": "Password is set",
+                    "429": {
+                        "description": "Too many failed attempts, account or IP is temporarily locked",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -523,14 +486,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/v0/account/2fa/recovery-codes": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for updating password. User must be logged in.",
+                "description": "Request for regenerating recovery codes. User must be logged in. Previous recovery codes become invalid.",
                 "consumes": [
                     "application/json"
                 ],
@@ -540,22 +505,25 @@ const docTemplate = `{
                 "tags": [
                     "Account API"
                 ],
-                "summary": "Update password",
-                "operationId": "UpdatePassword",
+                "summary": "Regenerate recovery codes",
+                "operationId": "RegenerateRecoveryCodes",
                 "parameters": [
                     {
-                        "description": "Update password request body",
+                        "description": "TOTP code request body",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v0.UpdatePasswordInput"
+                            "$ref": "#/definitions/v0.TOTPCodeInput"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "Recovery codes",
+                        "schema": {
+                            "$ref": "#/definitions/v0.RecoveryCodesOutput"
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -581,6 +549,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
+                    "429": {
+                        "description": "Too many failed attempts, account or IP is temporarily locked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -590,14 +564,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/account/restore": {
-            "get": {
+        "/v0/account/2fa/setup": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for restoring domain. User must be logged in. User must be deleted. In response will be returned restored service info.",
+                "description": "Request for starting two-factor authentication setup. User must be logged in. In response will be returned secret and provisioning URI for authenticator app. Two-factor authentication becomes enabled after confirmation by code.",
                 "consumes": [
                     "application/json"
                 ],
@@ -607,13 +581,13 @@ const docTemplate = `{
                 "tags": [
                     "Account API"
                 ],
-                "summary": "Restore service",
-                "operationId": "RestoreAccount",
+                "summary": "Setup two-factor authentication",
+                "operationId": "SetupTOTP",
                 "responses": {
                     "200": {
-                        "description": "Account info",
+                        "description": "TOTP secret",
                         "schema": {
-                            "$ref": "#/definitions/v0.AccountOutput"
+                            "$ref": "#/definitions/v0.TOTPSetupOutput"
                         }
                     },
                     "401": {
@@ -635,7 +609,7 @@ const docTemplate = `{
                         }
                     },
                     "409": {
-                        "description": "User is not deleted",
+                        "description": "Two-factor authentication is already enabled",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -649,14 +623,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/account/username": {
+        "/v0/account/email": {
             "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for updating username. User must be logged in. In response will be returned updated service info.",
+                "description": "Request for updating email. User must be logged in. In process will be sent verification email. In response will be returned updated service info.",
                 "consumes": [
                     "application/json"
                 ],
@@ -666,22 +640,28 @@ const docTemplate = `{
                 "tags": [
                     "Account API"
                 ],
-                "summary": "Update username",
-                "operationId": "UpdateUsername",
+                "summary": "Update email",
+                "operationId": "UpdateEmail",
                 "parameters": [
                     {
-                        "description": "Update username request body",
+                        "description": "Update email request body",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v0.UpdateUsernameInput"
+                            "$ref": "#/definitions/v0.UpdateEmailInput"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Account info",
+                        "description": "Account info (email is verified)",
+                        "schema": {
+                            "$ref": "#/definitions/v0.AccountOutput"
+                        }
+                    },
+                    "202": {
+                        "description": "Account info (email is not verified)",
                         "schema": {
                             "$ref": "#/definitions/v0.AccountOutput"
                         }
@@ -711,7 +691,7 @@ const docTemplate = `{
                         }
                     },
                     "409": {
-                        "description": "Duplicate username",
+                        "description": "Duplicate email",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -725,9 +705,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/auth/login": {
+        "/v0/account/email/verify": {
             "post": {
-                "description": "Request for serviceentication. In response will be new access token in body and new refresh tokens in http-only cookie.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for verify email. User must be logged in.",
                 "consumes": [
                     "application/json"
                 ],
@@ -735,27 +720,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication and Authorization API"
+                    "Account API"
                 ],
-                "summary": "Sign in",
-                "operationId": "Login",
+                "summary": "Verify email",
+                "operationId": "VerifyEmail",
                 "parameters": [
                     {
-                        "description": "Login request body",
+                        "description": "Verify email request body",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v0.LoginInput"
+                            "$ref": "#/definitions/v0.VerifyEmailInput"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "JWT tokens",
-                        "schema": {
-                            "$ref": "#/definitions/v0.JwtTokensOutput"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Validation error",
@@ -763,14 +745,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
                     "403": {
-                        "description": "User is blocked",
+                        "description": "User is blocked or deleted",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Not found user",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -784,14 +772,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/auth/logout": {
+        "/v0/account/identities": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for logout. User must be logged in.",
+                "description": "Request for receiving social login providers linked to own account. User must be logged in.",
                 "consumes": [
                     "application/json"
                 ],
@@ -799,13 +787,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication and Authorization API"
+                    "Account API"
                 ],
-                "summary": "Logout",
-                "operationId": "Logout",
+                "summary": "Get linked identities",
+                "operationId": "GetIdentities",
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "Linked identities",
+                        "schema": {
+                            "$ref": "#/definitions/v0.IdentitiesOutput"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -813,6 +804,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -822,9 +819,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/auth/recovery-password": {
+        "/v0/account/identities/confirm": {
             "post": {
-                "description": "Request for recovery password. At the end will be sent email with code",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for linking social login provider, which matched own account by email, to own account. User must be logged in to the account, which identity link token was issued for by social login.",
                 "consumes": [
                     "application/json"
                 ],
@@ -832,33 +834,48 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication and Authorization API"
+                    "Account API"
                 ],
-                "summary": "Recovery password",
-                "operationId": "RecoveryPassword",
+                "summary": "Confirm identity link",
+                "operationId": "ConfirmIdentityLink",
                 "parameters": [
                     {
-                        "description": "Recovery password body",
+                        "description": "Confirm identity link request body",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v0.RecoveryPasswordInput"
+                            "$ref": "#/definitions/v0.ConfirmIdentityLinkInput"
                         }
                     }
                 ],
                 "responses": {
-                    "202": {
-                        "description": "Accepted"
+                    "200": {
+                        "description": "Linked identity",
+                        "schema": {
+                            "$ref": "#/definitions/v0.IdentityOutput"
+                        }
                     },
                     "400": {
-                        "description": "Validation error",
+                        "description": "Validation error or invalid, expired or already used token",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "409": {
+                        "description": "Identity is already linked",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -872,37 +889,76 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/auth/recovery-password/verify": {
+        "/v0/account/identities/{provider}": {
             "post": {
-                "description": "Request for verify recovery code. If code is correct will be sent status 200",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for linking social login provider to own account. User must be logged in. Consent page URL is received by social login request, then code and state from callback are sent in request body. Identity linked to another account is not moved, it must be unlinked there first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication and Authorization API"
+                    "Account API"
                 ],
-                "summary": "Verify recovery code",
-                "operationId": "VerifyRecoveryCode",
+                "summary": "Link identity",
+                "operationId": "LinkIdentity",
                 "parameters": [
                     {
-                        "description": "Verify recovery code body",
+                        "type": "string",
+                        "description": "Social login provider (yandex, google, mailru, vk, telegram or name of OpenID Connect provider)",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Link identity request body",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v0.VerifyRecoveryCodeInput"
+                            "$ref": "#/definitions/v0.LinkIdentityInput"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "Linked identity",
+                        "schema": {
+                            "$ref": "#/definitions/v0.IdentityOutput"
+                        }
                     },
                     "400": {
-                        "description": "Validation error",
+                        "description": "Validation error or invalid state",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Provider not found or user info not received",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "409": {
+                        "description": "Identity is linked to another user",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -914,11 +970,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v0/auth/refresh": {
-            "post": {
-                "description": "Request for refreshing tokens. In response will be new access token in body and new refresh tokens in http-only cookie.",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for unlinking social login provider from own account. User must be logged in. The last way to sign in cannot be removed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -926,42 +985,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication and Authorization API"
+                    "Account API"
                 ],
-                "summary": "Refresh tokens",
-                "operationId": "RefreshTokens",
+                "summary": "Unlink identity",
+                "operationId": "UnlinkIdentity",
                 "parameters": [
                     {
-                        "description": "Refresh token body",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v0.RefreshTokensInput"
-                        }
+                        "type": "string",
+                        "description": "Social login provider (yandex, google, mailru, vk, telegram or name of OpenID Connect provider)",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "JWT tokens",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Identity is the last login method",
                         "schema": {
-                            "$ref": "#/definitions/v0.JwtTokensOutput"
+                            "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "400": {
-                        "description": "Validation error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "403": {
-                        "description": "User is blocked",
+                        "description": "User is blocked or deleted",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Not found user or identity",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -975,9 +1035,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/auth/register": {
-            "post": {
-                "description": "Request for creating new user. At the end will be sent confirmation email with code",
+        "/v0/account/passkeys": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for receiving passkeys of own account. User must be logged in.",
                 "consumes": [
                     "application/json"
                 ],
@@ -985,33 +1050,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication and Authorization API"
+                    "Account API"
                 ],
-                "summary": "Register",
-                "operationId": "Register",
-                "parameters": [
-                    {
-                        "description": "Register request body",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
+                "summary": "Get passkeys",
+                "operationId": "GetPasskeys",
+                "responses": {
+                    "200": {
+                        "description": "Passkeys",
                         "schema": {
-                            "$ref": "#/definitions/v0.RegisterInput"
+                            "$ref": "#/definitions/v0.PasskeysOutput"
                         }
-                    }
-                ],
-                "responses": {
-                    "202": {
-                        "description": "Accepted"
                     },
-                    "400": {
-                        "description": "Validation error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "409": {
-                        "description": "User already exists",
+                    "403": {
+                        "description": "User is blocked or deleted",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1025,9 +1082,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/auth/register/confirm": {
+        "/v0/account/passkeys/register/begin": {
             "post": {
-                "description": "Request for confirming registration. At the end will be created new user",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for starting passkey registration. User must be logged in. In response will be returned options for navigator.credentials.create(). Challenge is valid for a limited time and can be used only once.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1035,33 +1097,31 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication and Authorization API"
-                ],
-                "summary": "Register confirmation",
-                "operationId": "RegisterConfirm",
-                "parameters": [
-                    {
-                        "description": "Register confirm body",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v0.RegisterConfirmInput"
-                        }
-                    }
+                    "Account API"
                 ],
+                "summary": "Begin passkey registration",
+                "operationId": "BeginPasskeyRegistration",
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "Passkey creation options",
+                        "schema": {
+                            "$ref": "#/definitions/v0.PasskeyCreationOptionsOutput"
+                        }
                     },
-                    "400": {
-                        "description": "Validation error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "409": {
-                        "description": "User already exists",
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found user",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1075,9 +1135,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/auth/reset-password": {
+        "/v0/account/passkeys/register/finish": {
             "post": {
-                "description": "Request for reset password. If code is correct will be updated password",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for finishing passkey registration by credential returned from navigator.credentials.create(). User must be logged in. In response will be returned created passkey.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1085,33 +1150,54 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication and Authorization API"
+                    "Account API"
                 ],
-                "summary": "Reset password",
-                "operationId": "ResetPassword",
+                "summary": "Finish passkey registration",
+                "operationId": "FinishPasskeyRegistration",
                 "parameters": [
                     {
-                        "description": "Reset password body",
+                        "description": "Passkey registration request body",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v0.ResetPasswordInput"
+                            "$ref": "#/definitions/v0.PasskeyRegistrationInput"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK"
+                    "201": {
+                        "description": "Created passkey",
+                        "schema": {
+                            "$ref": "#/definitions/v0.PasskeyOutput"
+                        }
                     },
                     "400": {
-                        "description": "Validation error",
+                        "description": "Validation error or invalid passkey",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Not found user",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "409": {
+                        "description": "Passkey is already registered",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1125,9 +1211,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/auth/social/{provider}": {
-            "get": {
-                "description": "Request for redirecting to OAuth consent page. After serviceorization, it will redirect to redirectURL with serviceorization code and state",
+        "/v0/account/passkeys/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for removing one of own passkeys. User must be logged in. Removed passkey can no longer be used for login.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1135,32 +1226,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication and Authorization API"
+                    "Account API"
                 ],
-                "summary": "Social login",
-                "operationId": "SocialLogin",
+                "summary": "Delete passkey",
+                "operationId": "DeletePasskey",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Social login provider (yandex, google, mailru)",
-                        "name": "provider",
+                        "description": "Passkey ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Redirect URL",
-                        "name": "redirectURL",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
-                    "302": {
-                        "description": "Found"
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
                     },
                     "404": {
-                        "description": "Provider not found",
+                        "description": "Not found passkey",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1174,9 +1276,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/auth/social/{provider}/callback": {
+        "/v0/account/password": {
             "post": {
-                "description": "Request for exchanging serviceorization code to token pairs. In process, it will exchange code to user info and register new user or login existing user. In response will be new access token in body and new refresh tokens in http-only cookie.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for setting password. User must be logged in.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1184,34 +1291,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Authentication and Authorization API"
+                    "Account API"
                 ],
-                "summary": "Social login callback",
-                "operationId": "SocialLoginCallback",
+                "summary": "Set password",
+                "operationId": "SetPassword",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Social login provider (yandex, google, mailru)",
-                        "name": "provider",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Social login callback request body",
+                        "description": "Set password request body",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v0.SocialLoginCallbackInput"
+                            "$ref": "#/definitions/v0.SetPasswordInput"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "JWT tokens",
-                        "schema": {
-                            "$ref": "#/definitions/v0.JwtTokensOutput"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Validation error",
@@ -1219,14 +1316,26 @@ const docTemplate = `{
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
                     "403": {
-                        "description": "User already exists",
+                        "description": "User is blocked or deleted",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Not found user",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "409": {
+                        "description": "Password is set",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1238,16 +1347,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v0/geocode/forward": {
-            "get": {
+            },
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for geocoding. User must be logged in. In response will be returned coordinates.",
+                "description": "Request for updating password. User must be logged in.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1255,30 +1362,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Geocoding API"
+                    "Account API"
                 ],
-                "summary": "Geocode",
-                "operationId": "Geocode",
+                "summary": "Update password",
+                "operationId": "UpdatePassword",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "address",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Update password request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.UpdatePasswordInput"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Geocoded coordinates",
-                        "schema": {
-                            "$ref": "#/definitions/v0.GeocodingOutput"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Validation error",
@@ -1287,7 +1388,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized error",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1298,8 +1399,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "503": {
-                        "description": "Geocoding service is unavailable",
+                    "404": {
+                        "description": "Not found user",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1307,14 +1414,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/geocode/reverse": {
-            "get": {
+        "/v0/account/phone": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for reverse geocoding. User must be logged in. In response will be returned address.",
+                "description": "Request for updating phone number in E.164 format. User must be logged in. In process will be sent SMS with verification code. In response will be returned updated account info.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1322,37 +1429,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Geocoding API"
+                    "Account API"
                 ],
-                "summary": "Reverse geocode",
-                "operationId": "ReverseGeocode",
+                "summary": "Update phone",
+                "operationId": "UpdatePhone",
                 "parameters": [
                     {
-                        "type": "number",
-                        "format": "decimal",
-                        "name": "lat",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "format": "decimal",
-                        "name": "lng",
-                        "in": "query",
-                        "required": true
+                        "description": "Update phone request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.UpdatePhoneInput"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Reverse geocoded address",
+                        "description": "Account info (phone is verified)",
                         "schema": {
-                            "$ref": "#/definitions/v0.ReverseGeocodingOutput"
+                            "$ref": "#/definitions/v0.AccountOutput"
+                        }
+                    },
+                    "202": {
+                        "description": "Account info (phone is not verified)",
+                        "schema": {
+                            "$ref": "#/definitions/v0.AccountOutput"
                         }
                     },
                     "400": {
@@ -1362,7 +1464,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized error",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1373,8 +1475,26 @@ const docTemplate = `{
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "503": {
-                        "description": "Geocoding service is unavailable",
+                    "404": {
+                        "description": "Not found user",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "409": {
+                        "description": "Duplicate phone",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests, rate limit is exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1382,14 +1502,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/masters/profiles": {
-            "get": {
+        "/v0/account/phone/verify": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for finding master profiles. User must be logged in. In response will be returned found master profiles.",
+                "description": "Request for verify phone number with code from SMS. User must be logged in.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1397,73 +1517,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Master Profile API"
+                    "Account API"
                 ],
-                "summary": "Find master profiles",
-                "operationId": "FindMasterProfiles",
+                "summary": "Verify phone",
+                "operationId": "VerifyPhone",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "displayName",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "field",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "job",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "format": "decimal",
-                        "name": "lat",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "format": "decimal",
-                        "name": "lng",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
-                        "type": "string",
-                        "name": "order",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 0,
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 100,
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "pageSize",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "format": "decimal",
-                        "name": "radius",
-                        "in": "query"
+                        "description": "Verify phone request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.VerifyPhoneInput"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Found master profiles",
-                        "schema": {
-                            "$ref": "#/definitions/v0.MasterProfilesOutput"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Validation error",
@@ -1472,7 +1543,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized error",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1484,7 +1555,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Master profile not found",
+                        "description": "Not found user",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1496,14 +1567,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v0/account/restore": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for creating master profile. User must be logged in. In response will be returned created master profile.",
+                "description": "Request for restoring domain. User must be logged in. User must be deleted. In response will be returned restored service info.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1511,36 +1584,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Master Profile API"
-                ],
-                "summary": "Create master profile",
-                "operationId": "CreateMasterProfile",
-                "parameters": [
-                    {
-                        "description": "Create master profile request body",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v0.CreateMasterProfileInput"
-                        }
-                    }
+                    "Account API"
                 ],
+                "summary": "Restore service",
+                "operationId": "RestoreAccount",
                 "responses": {
-                    "201": {
-                        "description": "Created master profile",
+                    "200": {
+                        "description": "Account info",
                         "schema": {
-                            "$ref": "#/definitions/v0.MasterProfileOutput"
+                            "$ref": "#/definitions/v0.AccountOutput"
                         }
                     },
-                    "400": {
-                        "description": "Validation error",
-                        "schema": {
-                            "$ref": "#/definitions/v0.ErrorOutput"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1552,13 +1608,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Master profile not found",
+                        "description": "Not found user",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "409": {
-                        "description": "Master profile already exists",
+                        "description": "User is not deleted",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1570,14 +1626,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/v0/account/service-accounts": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for updating master profile. User must be logged in. In response will be returned updated master profile.",
+                "description": "Request for receiving own service accounts. User must be logged in.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1585,36 +1643,19 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Master Profile API"
-                ],
-                "summary": "Update master profile",
-                "operationId": "UpdateMasterProfile",
-                "parameters": [
-                    {
-                        "description": "Update master profile request body",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v0.UpdateMasterProfileInput"
-                        }
-                    }
+                    "Service Account API"
                 ],
+                "summary": "Get service accounts",
+                "operationId": "GetServiceAccounts",
                 "responses": {
                     "200": {
-                        "description": "Updated master profile",
-                        "schema": {
-                            "$ref": "#/definitions/v0.MasterProfileOutput"
-                        }
-                    },
-                    "400": {
-                        "description": "Validation error",
+                        "description": "Service accounts",
                         "schema": {
-                            "$ref": "#/definitions/v0.ErrorOutput"
+                            "$ref": "#/definitions/v0.ServiceAccountsOutput"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized error",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1625,12 +1666,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Master profile not found",
-                        "schema": {
-                            "$ref": "#/definitions/v0.ErrorOutput"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1638,16 +1673,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v0/masters/profiles/-/services": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for finding master services. User must be logged in. In response will be returned found master services.",
+                "description": "Request for creating service account for external integration. User must be logged in. Service account acts on behalf of user within scopes of its API keys.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1655,69 +1688,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Master Service API"
+                    "Service Account API"
                 ],
-                "summary": "Find master services",
-                "operationId": "FindMasterServices",
+                "summary": "Create service account",
+                "operationId": "CreateServiceAccount",
                 "parameters": [
                     {
-                        "type": "string",
-                        "name": "field",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "maxInterval",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "name": "maxPrice",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "minInterval",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "name": "minPrice",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
-                        "type": "string",
-                        "name": "order",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 0,
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 100,
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "pageSize",
-                        "in": "query"
+                        "description": "Create service account request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.CreateServiceAccountInput"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Found master services",
+                    "201": {
+                        "description": "Created service account",
                         "schema": {
-                            "$ref": "#/definitions/v0.MasterServicesOutput"
+                            "$ref": "#/definitions/v0.ServiceAccountOutput"
                         }
                     },
                     "400": {
@@ -1727,7 +1717,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized error",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1738,6 +1728,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
+                    "409": {
+                        "description": "Service account with the same name already exists",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1747,14 +1743,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/masters/profiles/{username}": {
-            "get": {
+        "/v0/account/service-accounts/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for getting master profile. User must be logged in. In response will be returned found master profile.",
+                "description": "Request for removing own service account. User must be logged in. All API keys of service account stop working.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1762,40 +1758,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Master Profile API"
+                    "Service Account API"
                 ],
-                "summary": "Get master profile",
-                "operationId": "GetMasterProfile",
+                "summary": "Delete service account",
+                "operationId": "DeleteServiceAccount",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Username",
-                        "name": "username",
+                        "description": "Service account ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Found master profile",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Validation error",
                         "schema": {
-                            "$ref": "#/definitions/v0.MasterProfileOutput"
+                            "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized error",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "403": {
-                        "description": "User is blocked or deleted; Own master profile is disabled",
+                        "description": "User is blocked or deleted",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "404": {
-                        "description": "Master profile not found",
+                        "description": "Not found service account",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1809,14 +1808,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/masters/profiles/{username}/services": {
+        "/v0/account/service-accounts/{id}/keys": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for finding master services by username. User must be logged in. In response will be returned found master services.",
+                "description": "Request for receiving API keys of own service account with their usage. User must be logged in. Keys themselves are not returned, only their prefixes.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1824,76 +1823,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Master Service API"
+                    "Service Account API"
                 ],
-                "summary": "Find master services by username",
-                "operationId": "FindMasterServicesByUsername",
+                "summary": "Get API keys",
+                "operationId": "GetAPIKeys",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Username",
-                        "name": "username",
+                        "description": "Service account ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "name": "field",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "maxInterval",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "name": "maxPrice",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "minInterval",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "name": "minPrice",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "name": "name",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
-                        "type": "string",
-                        "name": "order",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 0,
-                        "type": "integer",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 100,
-                        "minimum": 1,
-                        "type": "integer",
-                        "name": "pageSize",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Found master services",
+                        "description": "API keys",
                         "schema": {
-                            "$ref": "#/definitions/v0.MasterServicesOutput"
+                            "$ref": "#/definitions/v0.APIKeysOutput"
                         }
                     },
                     "400": {
@@ -1903,19 +1850,19 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized error",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "403": {
-                        "description": "User is blocked or deleted; Master profile is disabled",
+                        "description": "User is blocked or deleted",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "404": {
-                        "description": "Master profile not found",
+                        "description": "Not found service account",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -1934,7 +1881,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for creating master service. User must be logged in. In response will be returned created master service.",
+                "description": "Request for creating API key of own service account. User must be logged in. In response will be returned the key, it is shown only once.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1942,33 +1889,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Master Service API"
+                    "Service Account API"
                 ],
-                "summary": "Create master service",
-                "operationId": "CreateMasterService",
+                "summary": "Create API key",
+                "operationId": "CreateAPIKey",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Username",
-                        "name": "username",
+                        "description": "Service account ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Create master service request body",
+                        "description": "Create API key request body",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v0.CreateMasterServiceInput"
+                            "$ref": "#/definitions/v0.CreateAPIKeyInput"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created master service",
+                        "description": "Created API key",
                         "schema": {
-                            "$ref": "#/definitions/v0.MasterServiceOutput"
+                            "$ref": "#/definitions/v0.CreatedAPIKeyOutput"
                         }
                     },
                     "400": {
@@ -1978,19 +1925,19 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized error",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "403": {
-                        "description": "User is blocked or deleted; Master profile is disabled; Cannot create master service for another master profile",
+                        "description": "User is blocked or deleted",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "404": {
-                        "description": "Master service not found",
+                        "description": "Not found service account",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -2004,14 +1951,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/masters/profiles/{username}/services/{id}": {
-            "get": {
+        "/v0/account/service-accounts/{id}/keys/{keyID}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for getting master service. User must be logged in. In response will be returned found master service.",
+                "description": "Request for revoking API key of own service account. User must be logged in. Revoked key can no longer be used.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2019,47 +1966,50 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Master Service API"
+                    "Service Account API"
                 ],
-                "summary": "Get master service",
-                "operationId": "GetMasterServiceByUsername",
+                "summary": "Revoke API key",
+                "operationId": "RevokeAPIKey",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Username",
-                        "name": "username",
+                        "description": "Service account ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Master profile ID",
-                        "name": "id",
+                        "description": "API key ID",
+                        "name": "keyID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Found master service",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Validation error",
                         "schema": {
-                            "$ref": "#/definitions/v0.MasterServiceOutput"
+                            "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized error",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "403": {
-                        "description": "User is blocked or deleted; Master profile is disabled",
+                        "description": "User is blocked or deleted",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "404": {
-                        "description": "Master service not found; Master profile not found",
+                        "description": "Not found service account or API key",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -2071,14 +2021,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
+            }
+        },
+        "/v0/account/service-accounts/{id}/keys/{keyID}/rotate": {
+            "post": {
+                "security": [
+                    {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for deleting master service. User must be logged in.",
+                "description": "Request for replacing API key of own service account with the new one. User must be logged in. New key has the same scopes and lifetime, old key remains valid during grace period. In response will be returned the new key, it is shown only once.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2086,29 +2038,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Master Service API"
+                    "Service Account API"
                 ],
-                "summary": "Delete master service",
-                "operationId": "DeleteMasterService",
+                "summary": "Rotate API key",
+                "operationId": "RotateAPIKey",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Username",
-                        "name": "username",
+                        "description": "Service account ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Master service ID",
-                        "name": "id",
+                        "description": "API key ID",
+                        "name": "keyID",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "New API key",
+                        "schema": {
+                            "$ref": "#/definitions/v0.CreatedAPIKeyOutput"
+                        }
                     },
                     "400": {
                         "description": "Validation error",
@@ -2117,19 +2072,25 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized error",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "403": {
-                        "description": "User is blocked or deleted; Master profile is disabled; Cannot delete master service for another master profile",
+                        "description": "User is blocked or deleted",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
                     "404": {
-                        "description": "Master service not found; Master profile not found",
+                        "description": "Not found service account or API key",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "409": {
+                        "description": "API key is revoked or expired",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -2141,14 +2102,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/v0/account/sessions": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for updating master service. User must be logged in. In response will be returned updated master service.",
+                "description": "Request for receiving active sessions of own account. User must be logged in. In response will be returned device, location and last activity of each session. Device name and location are reported by client and are not verified.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2156,62 +2119,67 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Master Service API"
-                ],
-                "summary": "Update master service",
-                "operationId": "UpdateMasterService",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Username",
-                        "name": "username",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Master service ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Update master service request body",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v0.UpdateMasterServiceInput"
-                        }
-                    }
+                    "Account API"
                 ],
+                "summary": "Get sessions",
+                "operationId": "GetSessions",
                 "responses": {
                     "200": {
-                        "description": "Updated master service",
+                        "description": "Active sessions",
                         "schema": {
-                            "$ref": "#/definitions/v0.MasterServiceOutput"
+                            "$ref": "#/definitions/v0.SessionsOutput"
                         }
                     },
-                    "400": {
-                        "description": "Validation error",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized error",
+                    "403": {
+                        "description": "User is blocked or deleted",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "403": {
-                        "description": "User is blocked or deleted; Master profile is disabled; Cannot update master service for another master profile",
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for signing out everywhere else. User must be logged in. All own sessions except the current one will be revoked.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Account API"
+                ],
+                "summary": "Revoke other sessions",
+                "operationId": "RevokeOtherSessions",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "404": {
-                        "description": "Master service not found; Master profile not found",
+                    "403": {
+                        "description": "User is blocked or deleted",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -2225,44 +2193,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/resources/many": {
-            "post": {
+        "/v0/account/sessions/{id}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for uploading resources. Return the array of object ids in S3 storage for successful uploaded files.",
+                "description": "Request for revoking one of own sessions. User must be logged in. Refresh token and the latest access token of the revoked session stop working immediately, access tokens issued before are valid until they expire.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Resource API"
+                    "Account API"
                 ],
-                "summary": "Upload resources",
-                "operationId": "UploadResources",
+                "summary": "Revoke session",
+                "operationId": "RevokeSession",
                 "parameters": [
                     {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "Files to upload",
-                        "name": "resources",
-                        "in": "formData",
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Uploaded resources",
-                        "schema": {
-                            "$ref": "#/definitions/v0.UploadResourcesOutput"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Validation error",
@@ -2271,7 +2232,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized error",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -2282,6 +2243,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
+                    "404": {
+                        "description": "Not found session",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -2291,39 +2258,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/resources/one": {
-            "post": {
+        "/v0/account/username": {
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for uploading resource. Return the object id in S3 storage.",
+                "description": "Request for updating username. User must be logged in. In response will be returned updated service info.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Resource API"
+                    "Account API"
                 ],
-                "summary": "Upload resource",
-                "operationId": "UploadResource",
+                "summary": "Update username",
+                "operationId": "UpdateUsername",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "File to upload",
-                        "name": "resource",
-                        "in": "formData",
-                        "required": true
+                        "description": "Update username request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.UpdateUsernameInput"
+                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Uploaded resource",
+                    "200": {
+                        "description": "Account info",
                         "schema": {
-                            "$ref": "#/definitions/v0.UploadResourceOutput"
+                            "$ref": "#/definitions/v0.AccountOutput"
                         }
                     },
                     "400": {
@@ -2333,7 +2302,7 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "Unauthorized error",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -2344,38 +2313,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "404": {
+                        "description": "Not found user",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
-                    }
-                }
-            }
-        },
-        "/v0/resources/{objectID}": {
-            "get": {
-                "description": "Request for getting resource. Return the resource in S3 storage.",
-                "tags": [
-                    "Resource API"
-                ],
-                "summary": "Download resource",
-                "operationId": "DownloadResource",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Object id",
-                        "name": "objectID",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
                     },
-                    "404": {
-                        "description": "Resource not found",
+                    "409": {
+                        "description": "Duplicate username",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
@@ -2389,27 +2334,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/v0/ws": {
+        "/v0/admin/roles": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Request for connect to websocket server. If pool is not full, a new websocket connection is created.",
+                "description": "Request for getting all roles. User must be logged in as admin.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Websocket API"
+                    "Admin API"
                 ],
-                "summary": "Connect to websocket server",
-                "operationId": "WsConnect",
+                "summary": "Get roles",
+                "operationId": "GetRoles",
                 "responses": {
-                    "101": {
-                        "description": "Switching Protocols"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "200": {
+                        "description": "Roles",
                         "schema": {
-                            "$ref": "#/definitions/v0.ErrorOutput"
+                            "$ref": "#/definitions/v0.RolesOutput"
                         }
                     },
                     "401": {
@@ -2418,114 +2366,3717 @@ const docTemplate = `{
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
+                    "403": {
+                        "description": "User is blocked, deleted, not admin or must setup two-factor authentication",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/v0.ErrorOutput"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "health.HealthOutput": {
-            "type": "object",
-            "required": [
-                "name",
-                "pass"
-            ],
-            "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "pass": {
-                    "type": "boolean"
-                }
-            }
         },
-        "v0.AccountOutput": {
-            "type": "object",
-            "required": [
-                "email",
-                "isDeleted",
+        "/v0/admin/roles/{name}": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for updating role settings, e.g. requirement of two-factor authentication for role members. User must be logged in as admin.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin API"
+                ],
+                "summary": "Update role",
+                "operationId": "UpdateRole",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Role name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update role request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.UpdateRoleInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Role",
+                        "schema": {
+                            "$ref": "#/definitions/v0.RoleOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked, deleted, not admin or must setup two-factor authentication",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Role not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/login": {
+            "post": {
+                "description": "Request for serviceentication. In response will be new access token in body and new refresh tokens in http-only cookie.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Sign in",
+                "operationId": "Login",
+                "parameters": [
+                    {
+                        "description": "Login request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.LoginInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client device name, reported by client and not verified",
+                        "name": "X-Device-Name",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client location, reported by client and not verified",
+                        "name": "X-Client-Location",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JWT tokens or MFA token, if two-factor authentication is enabled",
+                        "schema": {
+                            "$ref": "#/definitions/v0.LoginOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed attempts, account or IP is temporarily locked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/login/mfa": {
+            "post": {
+                "description": "Request for completing sign in of user with enabled two-factor authentication. MFA token is returned by login request, code is TOTP code from authenticator app or one of recovery codes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Sign in with second factor",
+                "operationId": "LoginMFA",
+                "parameters": [
+                    {
+                        "description": "Login MFA request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.LoginMFAInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client device name, reported by client and not verified",
+                        "name": "X-Device-Name",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client location, reported by client and not verified",
+                        "name": "X-Client-Location",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JWT tokens",
+                        "schema": {
+                            "$ref": "#/definitions/v0.JwtTokensOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid or expired MFA token",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed attempts, account or IP is temporarily locked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/login/phone": {
+            "post": {
+                "description": "Request for sign in by verified phone number. At the end will be sent SMS with code, which should be confirmed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Sign in by phone",
+                "operationId": "LoginPhone",
+                "parameters": [
+                    {
+                        "description": "Login by phone request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.PhoneLoginInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "Validation error or phone is not verified",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests, rate limit is exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/login/phone/confirm": {
+            "post": {
+                "description": "Request for completing sign in by phone with code from SMS. If user has enabled two-factor authentication, MFA token will be returned instead of JWT tokens.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Confirm sign in by phone",
+                "operationId": "LoginPhoneConfirm",
+                "parameters": [
+                    {
+                        "description": "Confirm login by phone request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.PhoneLoginConfirmInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client device name, reported by client and not verified",
+                        "name": "X-Device-Name",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client location, reported by client and not verified",
+                        "name": "X-Client-Location",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JWT tokens or MFA token, if two-factor authentication is enabled",
+                        "schema": {
+                            "$ref": "#/definitions/v0.LoginOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed attempts, account or IP is temporarily locked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/logout": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for logout. User must be logged in.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Logout",
+                "operationId": "Logout",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/magic-link": {
+            "post": {
+                "description": "Request for passwordless sign in by email. At the end will be sent email with single-use sign in link. Link can be used only in the browser, which requested it, so device secret is set in http-only cookie.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Send sign in link",
+                "operationId": "SendMagicLink",
+                "parameters": [
+                    {
+                        "description": "Magic link request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.MagicLinkInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "Validation error or email is not sent",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests, rate limit is exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/magic-link/verify": {
+            "post": {
+                "description": "Request for completing sign in by token from email link. Request must be sent from the browser, which requested link. If user has enabled two-factor authentication, MFA token will be returned instead of JWT tokens.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Verify sign in link",
+                "operationId": "VerifyMagicLink",
+                "parameters": [
+                    {
+                        "description": "Verify magic link request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.VerifyMagicLinkInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client device name, reported by client and not verified",
+                        "name": "X-Device-Name",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client location, reported by client and not verified",
+                        "name": "X-Client-Location",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JWT tokens or MFA token, if two-factor authentication is enabled",
+                        "schema": {
+                            "$ref": "#/definitions/v0.LoginOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or invalid, expired or already used link",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or link is requested from another browser",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/passkey/login/begin": {
+            "post": {
+                "description": "Request for starting passwordless sign in. In response will be returned challenge ID and options for navigator.credentials.get(). Challenge is valid for a limited time and can be used only once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Begin sign in with passkey",
+                "operationId": "BeginPasskeyLogin",
+                "responses": {
+                    "200": {
+                        "description": "Passkey request options",
+                        "schema": {
+                            "$ref": "#/definitions/v0.PasskeyRequestOptionsOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/passkey/login/finish": {
+            "post": {
+                "description": "Request for finishing passwordless sign in by credential returned from navigator.credentials.get(). Passkey requires user verification, so two-factor authentication is not requested.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Finish sign in with passkey",
+                "operationId": "FinishPasskeyLogin",
+                "parameters": [
+                    {
+                        "description": "Passkey login request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.PasskeyLoginInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client device name, reported by client and not verified",
+                        "name": "X-Device-Name",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client location, reported by client and not verified",
+                        "name": "X-Client-Location",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JWT tokens",
+                        "schema": {
+                            "$ref": "#/definitions/v0.JwtTokensOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid passkey or expired challenge",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/recovery-password": {
+            "post": {
+                "description": "Request for recovery password. At the end will be sent email with code",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Recovery password",
+                "operationId": "RecoveryPassword",
+                "parameters": [
+                    {
+                        "description": "Recovery password body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.RecoveryPasswordInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests, rate limit is exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/recovery-password/verify": {
+            "post": {
+                "description": "Request for verify recovery code. If code is correct will be sent status 200",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Verify recovery code",
+                "operationId": "VerifyRecoveryCode",
+                "parameters": [
+                    {
+                        "description": "Verify recovery code body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.VerifyRecoveryCodeInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed attempts, account or IP is temporarily locked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/refresh": {
+            "post": {
+                "description": "Request for refreshing tokens. In response will be new access token in body and new refresh tokens in http-only cookie.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Refresh tokens",
+                "operationId": "RefreshTokens",
+                "parameters": [
+                    {
+                        "description": "Refresh token body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.RefreshTokensInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client device name, reported by client and not verified",
+                        "name": "X-Device-Name",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client location, reported by client and not verified",
+                        "name": "X-Client-Location",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JWT tokens",
+                        "schema": {
+                            "$ref": "#/definitions/v0.JwtTokensOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Refresh token has been already used, session is revoked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/register": {
+            "post": {
+                "description": "Request for creating new user. At the end will be sent confirmation email with code",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Register",
+                "operationId": "Register",
+                "parameters": [
+                    {
+                        "description": "Register request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.RegisterInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "409": {
+                        "description": "User already exists",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests, rate limit is exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/register/confirm": {
+            "post": {
+                "description": "Request for confirming registration. At the end will be created new user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Register confirmation",
+                "operationId": "RegisterConfirm",
+                "parameters": [
+                    {
+                        "description": "Register confirm body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.RegisterConfirmInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "409": {
+                        "description": "User already exists",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed attempts, account or IP is temporarily locked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/reset-password": {
+            "post": {
+                "description": "Request for reset password. If code is correct will be updated password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Reset password",
+                "operationId": "ResetPassword",
+                "parameters": [
+                    {
+                        "description": "Reset password body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.ResetPasswordInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many failed attempts, account or IP is temporarily locked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/social/{provider}": {
+            "get": {
+                "description": "Request for redirecting to OAuth consent page. After serviceorization, it will redirect to redirectURL with serviceorization code and state. State is single-use, it must be sent to callback by the same client, and authorization code is protected by PKCE",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Social login",
+                "operationId": "SocialLogin",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Social login provider (yandex, google, mailru, vk, telegram or name of OpenID Connect provider)",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Redirect URL",
+                        "name": "redirectURL",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    },
+                    "404": {
+                        "description": "Provider not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/auth/social/{provider}/callback": {
+            "post": {
+                "description": "Request for exchanging serviceorization code to token pairs. In process, it will exchange code to user info and register new user or login existing user. In response will be new access token in body and new refresh tokens in http-only cookie.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Authentication and Authorization API"
+                ],
+                "summary": "Social login callback",
+                "operationId": "SocialLoginCallback",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Social login provider (yandex, google, mailru, vk, telegram or name of OpenID Connect provider)",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Social login callback request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.SocialLoginCallbackInput"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client device name, reported by client and not verified",
+                        "name": "X-Device-Name",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Client location, reported by client and not verified",
+                        "name": "X-Client-Location",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "JWT tokens, MFA token, if two-factor authentication is enabled, or identity link token, if account with the same email exists",
+                        "schema": {
+                            "$ref": "#/definitions/v0.LoginOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or invalid, expired or already used state",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/chat/conversations": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for receiving own conversations as client or master with unread counters. User must be logged in. Conversations are sorted by last message from newest to oldest.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chat API"
+                ],
+                "summary": "Get conversations",
+                "operationId": "GetConversations",
+                "responses": {
+                    "200": {
+                        "description": "Conversations",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ConversationsOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for starting conversation with master, optionally about appointment. User must be logged in. Existing conversation is returned, if it has been already started.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chat API"
+                ],
+                "summary": "Create conversation",
+                "operationId": "CreateConversation",
+                "parameters": [
+                    {
+                        "description": "Create conversation request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.CreateConversationInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Conversation",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ConversationOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or conversation with self",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found master profile",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/chat/conversations/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for receiving own conversation with unread counter. User must be logged in.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chat API"
+                ],
+                "summary": "Get conversation",
+                "operationId": "GetConversation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Conversation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Conversation",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ConversationOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found conversation",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/chat/conversations/{id}/attachments": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for uploading attachments of conversation. User must be logged in. Returned object ids are sent with message and are available to participants of conversation only.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chat API"
+                ],
+                "summary": "Upload chat attachments",
+                "operationId": "UploadChatAttachments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Conversation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Files to upload",
+                        "name": "resources",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Uploaded attachments",
+                        "schema": {
+                            "$ref": "#/definitions/v0.UploadResourcesOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found conversation",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/chat/conversations/{id}/attachments/{objectID}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for downloading attachment of conversation. User must be logged in and be participant of conversation.",
+                "tags": [
+                    "Chat API"
+                ],
+                "summary": "Download chat attachment",
+                "operationId": "DownloadChatAttachment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Conversation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Object id of attachment",
+                        "name": "objectID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found conversation or attachment",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/chat/conversations/{id}/messages": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for receiving history of conversation from newest to oldest messages. User must be logged in. Next page is requested with cursor from previous page.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chat API"
+                ],
+                "summary": "Get chat messages",
+                "operationId": "GetChatMessages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Conversation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Chat messages",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ChatMessagesOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or invalid cursor",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found conversation",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for sending message to conversation. User must be logged in. Message is delivered over websocket to subscribers of topic chat:{id}, offline recipient is notified by email.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chat API"
+                ],
+                "summary": "Send chat message",
+                "operationId": "SendChatMessage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Conversation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Send chat message request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.SendChatMessageInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Sent message",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ChatMessageOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or attachment is not uploaded by sender to conversation",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found conversation",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/chat/conversations/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for sending read receipt. User must be logged in. Messages until given one or all messages are marked as read, read marker never moves back.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Chat API"
+                ],
+                "summary": "Mark chat as read",
+                "operationId": "MarkChatRead",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Conversation ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Mark chat as read request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.MarkChatReadInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found conversation or message",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/geocode/forward": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for geocoding. User must be logged in. In response will be returned coordinates.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Geocoding API"
+                ],
+                "summary": "Geocode",
+                "operationId": "Geocode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "address",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Geocoded coordinates",
+                        "schema": {
+                            "$ref": "#/definitions/v0.GeocodingOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests, rate limit is exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "503": {
+                        "description": "Geocoding service is unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/geocode/reverse": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for reverse geocoding. User must be logged in. In response will be returned address.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Geocoding API"
+                ],
+                "summary": "Reverse geocode",
+                "operationId": "ReverseGeocode",
+                "parameters": [
+                    {
+                        "type": "number",
+                        "format": "decimal",
+                        "name": "lat",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "format": "decimal",
+                        "name": "lng",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Reverse geocoded address",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ReverseGeocodingOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests, rate limit is exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "503": {
+                        "description": "Geocoding service is unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/masters/profiles": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for finding master profiles. User must be logged in. In response will be returned found master profiles.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Master Profile API"
+                ],
+                "summary": "Find master profiles",
+                "operationId": "FindMasterProfiles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "displayName",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "field",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "job",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "format": "decimal",
+                        "name": "lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "format": "decimal",
+                        "name": "lng",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "pageSize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "format": "decimal",
+                        "name": "radius",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Found master profiles",
+                        "schema": {
+                            "$ref": "#/definitions/v0.MasterProfilesOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Master profile not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for creating master profile. User must be logged in. In response will be returned created master profile.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Master Profile API"
+                ],
+                "summary": "Create master profile",
+                "operationId": "CreateMasterProfile",
+                "parameters": [
+                    {
+                        "description": "Create master profile request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.CreateMasterProfileInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created master profile",
+                        "schema": {
+                            "$ref": "#/definitions/v0.MasterProfileOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Master profile not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "409": {
+                        "description": "Master profile already exists",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for updating master profile. User must be logged in. In response will be returned updated master profile.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Master Profile API"
+                ],
+                "summary": "Update master profile",
+                "operationId": "UpdateMasterProfile",
+                "parameters": [
+                    {
+                        "description": "Update master profile request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.UpdateMasterProfileInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated master profile",
+                        "schema": {
+                            "$ref": "#/definitions/v0.MasterProfileOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Master profile not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/masters/profiles/-/services": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Request for finding master services. User must be logged in. In response will be returned found master services.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Master Service API"
+                ],
+                "summary": "Find master services",
+                "operationId": "FindMasterServices",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "name": "field",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "maxInterval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "name": "maxPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "minInterval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "name": "minPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Found master services",
+                        "schema": {
+                            "$ref": "#/definitions/v0.MasterServicesOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/masters/profiles/{username}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for getting master profile. User must be logged in. In response will be returned found master profile.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Master Profile API"
+                ],
+                "summary": "Get master profile",
+                "operationId": "GetMasterProfile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Found master profile",
+                        "schema": {
+                            "$ref": "#/definitions/v0.MasterProfileOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted; Own master profile is disabled",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Master profile not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/masters/profiles/{username}/services": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Request for finding master services by username. User must be logged in. In response will be returned found master services.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Master Service API"
+                ],
+                "summary": "Find master services by username",
+                "operationId": "FindMasterServicesByUsername",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "name": "field",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "maxInterval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "name": "maxPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "minInterval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "name": "minPrice",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Found master services",
+                        "schema": {
+                            "$ref": "#/definitions/v0.MasterServicesOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted; Master profile is disabled",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Master profile not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Request for creating master service. User must be logged in. In response will be returned created master service.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Master Service API"
+                ],
+                "summary": "Create master service",
+                "operationId": "CreateMasterService",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Create master service request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.CreateMasterServiceInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created master service",
+                        "schema": {
+                            "$ref": "#/definitions/v0.MasterServiceOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted; Master profile is disabled; Cannot create master service for another master profile",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Master service not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/masters/profiles/{username}/services/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Request for getting master service. User must be logged in. In response will be returned found master service.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Master Service API"
+                ],
+                "summary": "Get master service",
+                "operationId": "GetMasterServiceByUsername",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Master profile ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Found master service",
+                        "schema": {
+                            "$ref": "#/definitions/v0.MasterServiceOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted; Master profile is disabled",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Master service not found; Master profile not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Request for deleting master service. User must be logged in.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Master Service API"
+                ],
+                "summary": "Delete master service",
+                "operationId": "DeleteMasterService",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Master service ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted; Master profile is disabled; Cannot delete master service for another master profile",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Master service not found; Master profile not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Request for updating master service. User must be logged in. In response will be returned updated master service.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Master Service API"
+                ],
+                "summary": "Update master service",
+                "operationId": "UpdateMasterService",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Master service ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Update master service request body",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v0.UpdateMasterServiceInput"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated master service",
+                        "schema": {
+                            "$ref": "#/definitions/v0.MasterServiceOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted; Master profile is disabled; Cannot update master service for another master profile",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "404": {
+                        "description": "Master service not found; Master profile not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/resources/many": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for uploading resources. Return the array of object ids in S3 storage for successful uploaded files.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource API"
+                ],
+                "summary": "Upload resources",
+                "operationId": "UploadResources",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Files to upload",
+                        "name": "resources",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Uploaded resources",
+                        "schema": {
+                            "$ref": "#/definitions/v0.UploadResourcesOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests, rate limit is exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/resources/one": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for uploading resource. Return the object id in S3 storage.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Resource API"
+                ],
+                "summary": "Upload resource",
+                "operationId": "UploadResource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "File to upload",
+                        "name": "resource",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Uploaded resource",
+                        "schema": {
+                            "$ref": "#/definitions/v0.UploadResourceOutput"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "User is blocked or deleted",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "429": {
+                        "description": "Too many requests, rate limit is exceeded",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/resources/{objectID}": {
+            "get": {
+                "description": "Request for getting resource. Return the resource in S3 storage. Private resources, e.g. chat attachments, are not available.",
+                "tags": [
+                    "Resource API"
+                ],
+                "summary": "Download resource",
+                "operationId": "DownloadResource",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Object id",
+                        "name": "objectID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "404": {
+                        "description": "Resource not found",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/ws": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for connect to websocket server. If pool is not full, a new websocket connection is created.\nUser may have several connections at the same time, each of them receives all messages of the user.\nMessages are JSON envelopes ` + "`" + `{\"v\": 1, \"type\": \"...\", \"id\": \"...\", \"replyTo\": \"...\", \"topic\": \"...\", \"payload\": {...}}` + "`" + `.\nClient subscribes to topics (` + "`" + `notifications` + "`" + `, ` + "`" + `appointments` + "`" + `) by ` + "`" + `subscribe` + "`" + ` and ` + "`" + `unsubscribe` + "`" + ` messages.\nMessage with ` + "`" + `id` + "`" + ` is answered by ` + "`" + `ack` + "`" + `, ` + "`" + `response` + "`" + ` or ` + "`" + `error` + "`" + ` message with the same ` + "`" + `replyTo` + "`" + `.",
+                "tags": [
+                    "Websocket API"
+                ],
+                "summary": "Connect to websocket server",
+                "operationId": "WsConnect",
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols",
+                        "headers": {
+                            "X-Connection-ID": {
+                                "type": "string",
+                                "description": "Identifier of created connection"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        },
+        "/v0/ws/events": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Request for receiving the same messages as websocket connection by Server-Sent Events.\nIt is fallback for networks and clients, which do not support websockets. Stream is read only,\nconnection is subscribed to topics from query and receives JSON envelopes in ` + "`" + `data` + "`" + ` field of events.\nEvents addressed to user have ` + "`" + `id` + "`" + `. If connection is lost, client resumes stream by ` + "`" + `Last-Event-ID` + "`" + ` header\n(or ` + "`" + `lastEventId` + "`" + ` query parameter) and receives missed events, which are kept for a short time.\nServer sends comment ` + "`" + `: ping` + "`" + ` periodically to keep connection alive.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Websocket API"
+                ],
+                "summary": "Stream events",
+                "operationId": "WsStreamEvents",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Topics to subscribe",
+                        "name": "topic",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Identifier of last received event",
+                        "name": "lastEventId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Identifier of last received event",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stream of events",
+                        "schema": {
+                            "type": "string"
+                        },
+                        "headers": {
+                            "X-Connection-ID": {
+                                "type": "string",
+                                "description": "Identifier of created connection"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/v0.ErrorOutput"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "health.HealthOutput": {
+            "type": "object",
+            "required": [
+                "name",
+                "pass"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "pass": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "v0.APIKeyOutput": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "name",
+                "prefix",
+                "scopes"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "expiresAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "lastUsedAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "lastUsedIp": {
+                    "type": "string",
+                    "format": "ipv4"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "revokedAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "scopes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "usageCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v0.APIKeysOutput": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v0.APIKeyOutput"
+                    }
+                }
+            }
+        },
+        "v0.AccountOutput": {
+            "type": "object",
+            "required": [
+                "email",
+                "isDeleted",
                 "isEmailVerified",
                 "isEnabled",
+                "isMfaEnabled",
                 "isPasswordTemp",
+                "isPhoneVerified",
+                "username"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "format": "email"
+                },
+                "isDeleted": {
+                    "type": "boolean"
+                },
+                "isEmailVerified": {
+                    "type": "boolean"
+                },
+                "isEnabled": {
+                    "type": "boolean"
+                },
+                "isMfaEnabled": {
+                    "type": "boolean"
+                },
+                "isPasswordTemp": {
+                    "type": "boolean"
+                },
+                "isPhoneVerified": {
+                    "type": "boolean"
+                },
+                "phone": {
+                    "type": "string",
+                    "format": "e164"
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "v0.AddressOutput": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "countryCode": {
+                    "type": "string"
+                },
+                "county": {
+                    "type": "string"
+                },
+                "formattedAddress": {
+                    "type": "string"
+                },
+                "houseNumber": {
+                    "type": "string"
+                },
+                "postcode": {
+                    "type": "string"
+                },
+                "state": {
+                    "type": "string"
+                },
+                "stateCode": {
+                    "type": "string"
+                },
+                "stateDistrict": {
+                    "type": "string"
+                },
+                "street": {
+                    "type": "string"
+                },
+                "suburb": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.AuthenticatorAssertionResponse": {
+            "type": "object",
+            "required": [
+                "authenticatorData",
+                "clientDataJSON",
+                "signature"
+            ],
+            "properties": {
+                "authenticatorData": {
+                    "type": "string",
+                    "format": "base64url"
+                },
+                "clientDataJSON": {
+                    "type": "string",
+                    "format": "base64url"
+                },
+                "signature": {
+                    "type": "string",
+                    "format": "base64url"
+                },
+                "userHandle": {
+                    "type": "string",
+                    "format": "base64url"
+                }
+            }
+        },
+        "v0.AuthenticatorAttestationResponse": {
+            "type": "object",
+            "required": [
+                "attestationObject",
+                "clientDataJSON"
+            ],
+            "properties": {
+                "attestationObject": {
+                    "type": "string",
+                    "format": "base64url"
+                },
+                "clientDataJSON": {
+                    "type": "string",
+                    "format": "base64url"
+                },
+                "transports": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v0.AuthenticatorSelectionCriteria": {
+            "type": "object",
+            "required": [
+                "residentKey",
+                "userVerification"
+            ],
+            "properties": {
+                "requireResidentKey": {
+                    "type": "boolean"
+                },
+                "residentKey": {
+                    "type": "string"
+                },
+                "userVerification": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.ChatMessageOutput": {
+            "type": "object",
+            "required": [
+                "conversationId",
+                "createdAt",
+                "id",
+                "senderId"
+            ],
+            "properties": {
+                "attachments": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "conversationId": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "senderId": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.ChatMessagesOutput": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v0.ChatMessageOutput"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.ConfirmIdentityLinkInput": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.ConversationOutput": {
+            "type": "object",
+            "required": [
+                "client",
+                "createdAt",
+                "id",
+                "master"
+            ],
+            "properties": {
+                "appointmentId": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "client": {
+                    "$ref": "#/definitions/v0.ConversationParticipantOutput"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "lastMessageAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "master": {
+                    "$ref": "#/definitions/v0.ConversationParticipantOutput"
+                },
+                "unreadCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v0.ConversationParticipantOutput": {
+            "type": "object",
+            "required": [
+                "id",
                 "username"
             ],
             "properties": {
-                "email": {
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.ConversationsOutput": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v0.ConversationOutput"
+                    }
+                },
+                "unreadCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v0.CreateAPIKeyInput": {
+            "type": "object",
+            "required": [
+                "name",
+                "scopes"
+            ],
+            "properties": {
+                "expiresIn": {
+                    "type": "integer",
+                    "minimum": 60
+                },
+                "name": {
                     "type": "string",
-                    "format": "email"
+                    "maxLength": 100,
+                    "minLength": 1
                 },
-                "isDeleted": {
-                    "type": "boolean"
+                "scopes": {
+                    "type": "array",
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "v0.CreateConversationInput": {
+            "type": "object",
+            "required": [
+                "masterUsername"
+            ],
+            "properties": {
+                "appointmentId": {
+                    "type": "string",
+                    "format": "uuid"
                 },
-                "isEmailVerified": {
-                    "type": "boolean"
+                "masterUsername": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.CreateMasterProfileInput": {
+            "type": "object",
+            "required": [
+                "displayName",
+                "job",
+                "latitude",
+                "longitude"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string"
                 },
-                "isEnabled": {
-                    "type": "boolean"
+                "avatarId": {
+                    "type": "string"
                 },
-                "isPasswordTemp": {
-                    "type": "boolean"
+                "description": {
+                    "type": "string"
                 },
-                "username": {
-                    "type": "string",
-                    "maxLength": 255,
-                    "minLength": 1
+                "displayName": {
+                    "type": "string"
+                },
+                "job": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number",
+                    "format": "decimal"
+                },
+                "longitude": {
+                    "type": "number",
+                    "format": "decimal"
                 }
             }
         },
-        "v0.AddressOutput": {
+        "v0.CreateMasterServiceInput": {
             "type": "object",
+            "required": [
+                "name"
+            ],
             "properties": {
-                "city": {
+                "avatarId": {
                     "type": "string"
                 },
-                "country": {
+                "description": {
                     "type": "string"
                 },
-                "countryCode": {
+                "maxInterval": {
+                    "type": "string",
+                    "format": "hh:mm:ss"
+                },
+                "maxPrice": {
+                    "type": "number"
+                },
+                "minInterval": {
+                    "type": "string",
+                    "format": "hh:mm:ss"
+                },
+                "minPrice": {
+                    "type": "number"
+                },
+                "name": {
                     "type": "string"
+                }
+            }
+        },
+        "v0.CreateServiceAccountInput": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
                 },
-                "county": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                }
+            }
+        },
+        "v0.CreatedAPIKeyOutput": {
+            "type": "object",
+            "required": [
+                "apiKey",
+                "key"
+            ],
+            "properties": {
+                "apiKey": {
+                    "$ref": "#/definitions/v0.APIKeyOutput"
+                },
+                "key": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.ErrorOutput": {
+            "type": "object",
+            "required": [
+                "message",
+                "path",
+                "status",
+                "timestamp"
+            ],
+            "properties": {
+                "message": {
                     "type": "string"
                 },
-                "formattedAddress": {
+                "path": {
+                    "type": "string",
+                    "format": "url_path",
+                    "example": "/api/v0/example"
+                },
+                "status": {
+                    "type": "integer",
+                    "maximum": 599,
+                    "minimum": 400
+                },
+                "timestamp": {
                     "type": "string"
+                }
+            }
+        },
+        "v0.GeocodingOutput": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
                 },
-                "houseNumber": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v0.PointOutput"
+                    }
+                }
+            }
+        },
+        "v0.IdentitiesOutput": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v0.IdentityOutput"
+                    }
+                }
+            }
+        },
+        "v0.IdentityOutput": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "provider"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "email": {
+                    "type": "string",
+                    "format": "email"
+                },
+                "provider": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.JwtTokensOutput": {
+            "type": "object",
+            "required": [
+                "accessToken",
+                "refreshToken"
+            ],
+            "properties": {
+                "accessToken": {
+                    "type": "string",
+                    "format": "jwt"
+                },
+                "refreshToken": {
+                    "type": "string",
+                    "format": "jwt"
+                }
+            }
+        },
+        "v0.LinkIdentityInput": {
+            "type": "object",
+            "required": [
+                "code",
+                "state"
+            ],
+            "properties": {
+                "code": {
                     "type": "string"
                 },
-                "postcode": {
+                "deviceId": {
                     "type": "string"
                 },
                 "state": {
                     "type": "string"
+                }
+            }
+        },
+        "v0.LoginInput": {
+            "type": "object",
+            "required": [
+                "login",
+                "password"
+            ],
+            "properties": {
+                "login": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.LoginMFAInput": {
+            "type": "object",
+            "required": [
+                "code",
+                "mfaToken"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "mfaToken": {
+                    "type": "string",
+                    "format": "jwt"
+                }
+            }
+        },
+        "v0.LoginOutput": {
+            "type": "object",
+            "properties": {
+                "accessToken": {
+                    "type": "string",
+                    "format": "jwt"
                 },
-                "stateCode": {
-                    "type": "string"
+                "identityLinkRequired": {
+                    "type": "boolean"
                 },
-                "stateDistrict": {
+                "identityLinkToken": {
                     "type": "string"
                 },
-                "street": {
-                    "type": "string"
+                "mfaRequired": {
+                    "type": "boolean"
                 },
-                "suburb": {
-                    "type": "string"
+                "mfaToken": {
+                    "type": "string",
+                    "format": "jwt"
+                },
+                "refreshToken": {
+                    "type": "string",
+                    "format": "jwt"
                 }
             }
         },
-        "v0.CreateMasterProfileInput": {
+        "v0.MagicLinkInput": {
+            "type": "object",
+            "required": [
+                "email"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "format": "email"
+                }
+            }
+        },
+        "v0.MarkChatReadInput": {
+            "type": "object",
+            "properties": {
+                "messageId": {
+                    "type": "string",
+                    "format": "uuid"
+                }
+            }
+        },
+        "v0.MasterProfileOutput": {
             "type": "object",
             "required": [
                 "displayName",
                 "job",
-                "latitude",
-                "longitude"
+                "point"
             ],
             "properties": {
                 "address": {
@@ -2540,24 +6091,37 @@ const docTemplate = `{
                 "displayName": {
                     "type": "string"
                 },
+                "isEnabled": {
+                    "type": "boolean"
+                },
                 "job": {
                     "type": "string"
                 },
-                "latitude": {
-                    "type": "number",
-                    "format": "decimal"
-                },
-                "longitude": {
-                    "type": "number",
-                    "format": "decimal"
+                "point": {
+                    "$ref": "#/definitions/v0.PointOutput"
                 }
             }
         },
-        "v0.CreateMasterServiceInput": {
+        "v0.MasterProfilesOutput": {
             "type": "object",
             "required": [
-                "name"
+                "count",
+                "data"
             ],
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v0.MasterProfileOutput"
+                    }
+                }
+            }
+        },
+        "v0.MasterServiceOutput": {
+            "type": "object",
             "properties": {
                 "avatarId": {
                     "type": "string"
@@ -2565,16 +6129,17 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "string"
+                },
                 "maxInterval": {
-                    "type": "string",
-                    "format": "hh:mm:ss"
+                    "type": "string"
                 },
                 "maxPrice": {
                     "type": "number"
                 },
                 "minInterval": {
-                    "type": "string",
-                    "format": "hh:mm:ss"
+                    "type": "string"
                 },
                 "minPrice": {
                     "type": "number"
@@ -2584,34 +6149,154 @@ const docTemplate = `{
                 }
             }
         },
-        "v0.ErrorOutput": {
+        "v0.MasterServicesOutput": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v0.MasterServiceOutput"
+                    }
+                }
+            }
+        },
+        "v0.PasskeyAssertionCredential": {
             "type": "object",
             "required": [
-                "message",
-                "path",
-                "status",
-                "timestamp"
+                "id",
+                "rawId",
+                "response",
+                "type"
             ],
             "properties": {
-                "message": {
+                "id": {
+                    "type": "string",
+                    "format": "base64url"
+                },
+                "rawId": {
+                    "type": "string",
+                    "format": "base64url"
+                },
+                "response": {
+                    "$ref": "#/definitions/v0.AuthenticatorAssertionResponse"
+                },
+                "type": {
                     "type": "string"
+                }
+            }
+        },
+        "v0.PasskeyAttestationCredential": {
+            "type": "object",
+            "required": [
+                "id",
+                "rawId",
+                "response",
+                "type"
+            ],
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "format": "base64url"
                 },
-                "path": {
+                "rawId": {
                     "type": "string",
-                    "format": "url_path",
-                    "example": "/api/v0/example"
+                    "format": "base64url"
                 },
-                "status": {
-                    "type": "integer",
-                    "maximum": 599,
-                    "minimum": 400
+                "response": {
+                    "$ref": "#/definitions/v0.AuthenticatorAttestationResponse"
                 },
-                "timestamp": {
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "v0.GeocodingOutput": {
+        "v0.PasskeyCreationOptionsOutput": {
+            "type": "object",
+            "required": [
+                "publicKey"
+            ],
+            "properties": {
+                "publicKey": {
+                    "$ref": "#/definitions/v0.PublicKeyCredentialCreationOptions"
+                }
+            }
+        },
+        "v0.PasskeyLoginInput": {
+            "type": "object",
+            "required": [
+                "challengeId",
+                "credential"
+            ],
+            "properties": {
+                "challengeId": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "credential": {
+                    "$ref": "#/definitions/v0.PasskeyAssertionCredential"
+                }
+            }
+        },
+        "v0.PasskeyOutput": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "name"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "lastUsedAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.PasskeyRegistrationInput": {
+            "type": "object",
+            "required": [
+                "credential"
+            ],
+            "properties": {
+                "credential": {
+                    "$ref": "#/definitions/v0.PasskeyAttestationCredential"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "v0.PasskeyRequestOptionsOutput": {
+            "type": "object",
+            "required": [
+                "challengeId",
+                "publicKey"
+            ],
+            "properties": {
+                "challengeId": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "publicKey": {
+                    "$ref": "#/definitions/v0.PublicKeyCredentialRequestOptions"
+                }
+            }
+        },
+        "v0.PasskeysOutput": {
             "type": "object",
             "properties": {
                 "count": {
@@ -2620,143 +6305,208 @@ const docTemplate = `{
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/v0.PointOutput"
+                        "$ref": "#/definitions/v0.PasskeyOutput"
                     }
                 }
             }
         },
-        "v0.JwtTokensOutput": {
+        "v0.PhoneLoginConfirmInput": {
             "type": "object",
             "required": [
-                "accessToken",
-                "refreshToken"
+                "otp",
+                "phone"
             ],
             "properties": {
-                "accessToken": {
-                    "type": "string",
-                    "format": "jwt"
+                "otp": {
+                    "type": "string"
                 },
-                "refreshToken": {
+                "phone": {
                     "type": "string",
-                    "format": "jwt"
+                    "format": "e164"
                 }
             }
         },
-        "v0.LoginInput": {
+        "v0.PhoneLoginInput": {
             "type": "object",
             "required": [
-                "login",
-                "password"
+                "phone"
             ],
             "properties": {
-                "login": {
-                    "type": "string"
+                "phone": {
+                    "type": "string",
+                    "format": "e164"
+                }
+            }
+        },
+        "v0.PointOutput": {
+            "type": "object",
+            "properties": {
+                "latitude": {
+                    "type": "number"
                 },
-                "password": {
-                    "type": "string"
+                "longitude": {
+                    "type": "number"
                 }
             }
         },
-        "v0.MasterProfileOutput": {
+        "v0.PublicKeyCredentialCreationOptions": {
             "type": "object",
             "required": [
-                "displayName",
-                "job",
-                "point"
+                "attestation",
+                "authenticatorSelection",
+                "challenge",
+                "pubKeyCredParams",
+                "rp",
+                "timeout",
+                "user"
             ],
             "properties": {
-                "address": {
+                "attestation": {
                     "type": "string"
                 },
-                "avatarId": {
-                    "type": "string"
+                "authenticatorSelection": {
+                    "$ref": "#/definitions/v0.AuthenticatorSelectionCriteria"
                 },
-                "description": {
-                    "type": "string"
+                "challenge": {
+                    "type": "string",
+                    "format": "base64url"
                 },
-                "displayName": {
-                    "type": "string"
+                "excludeCredentials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v0.PublicKeyCredentialDescriptor"
+                    }
                 },
-                "isEnabled": {
-                    "type": "boolean"
+                "pubKeyCredParams": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v0.PublicKeyCredentialParameters"
+                    }
                 },
-                "job": {
-                    "type": "string"
+                "rp": {
+                    "$ref": "#/definitions/v0.PublicKeyCredentialRPEntity"
                 },
-                "point": {
-                    "$ref": "#/definitions/v0.PointOutput"
+                "timeout": {
+                    "type": "integer"
+                },
+                "user": {
+                    "$ref": "#/definitions/v0.PublicKeyCredentialUserEntity"
                 }
             }
         },
-        "v0.MasterProfilesOutput": {
+        "v0.PublicKeyCredentialDescriptor": {
             "type": "object",
             "required": [
-                "count",
-                "data"
+                "id",
+                "type"
             ],
             "properties": {
-                "count": {
-                    "type": "integer"
+                "id": {
+                    "type": "string",
+                    "format": "base64url"
                 },
-                "data": {
+                "transports": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/v0.MasterProfileOutput"
+                        "type": "string"
                     }
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "v0.MasterServiceOutput": {
+        "v0.PublicKeyCredentialParameters": {
             "type": "object",
+            "required": [
+                "alg",
+                "type"
+            ],
             "properties": {
-                "avatarId": {
-                    "type": "string"
+                "alg": {
+                    "type": "integer"
                 },
-                "description": {
+                "type": {
                     "type": "string"
-                },
+                }
+            }
+        },
+        "v0.PublicKeyCredentialRPEntity": {
+            "type": "object",
+            "required": [
+                "id",
+                "name"
+            ],
+            "properties": {
                 "id": {
                     "type": "string"
                 },
-                "maxInterval": {
+                "name": {
                     "type": "string"
+                }
+            }
+        },
+        "v0.PublicKeyCredentialRequestOptions": {
+            "type": "object",
+            "required": [
+                "challenge",
+                "rpId",
+                "timeout",
+                "userVerification"
+            ],
+            "properties": {
+                "allowCredentials": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v0.PublicKeyCredentialDescriptor"
+                    }
                 },
-                "maxPrice": {
-                    "type": "number"
+                "challenge": {
+                    "type": "string",
+                    "format": "base64url"
                 },
-                "minInterval": {
+                "rpId": {
                     "type": "string"
                 },
-                "minPrice": {
-                    "type": "number"
+                "timeout": {
+                    "type": "integer"
                 },
-                "name": {
+                "userVerification": {
                     "type": "string"
                 }
             }
         },
-        "v0.MasterServicesOutput": {
+        "v0.PublicKeyCredentialUserEntity": {
             "type": "object",
+            "required": [
+                "displayName",
+                "id",
+                "name"
+            ],
             "properties": {
-                "count": {
-                    "type": "integer"
+                "displayName": {
+                    "type": "string"
                 },
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/v0.MasterServiceOutput"
-                    }
+                "id": {
+                    "type": "string",
+                    "format": "base64url"
+                },
+                "name": {
+                    "type": "string"
                 }
             }
         },
-        "v0.PointOutput": {
+        "v0.RecoveryCodesOutput": {
             "type": "object",
+            "required": [
+                "codes"
+            ],
             "properties": {
-                "latitude": {
-                    "type": "number"
-                },
-                "longitude": {
-                    "type": "number"
+                "codes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
@@ -2840,6 +6590,9 @@ const docTemplate = `{
                 "password": {
                     "type": "string",
                     "format": "zxcvbn"
+                },
+                "revokeSessions": {
+                    "type": "boolean"
                 }
             }
         },
@@ -2857,6 +6610,148 @@ const docTemplate = `{
                 }
             }
         },
+        "v0.RoleOutput": {
+            "type": "object",
+            "required": [
+                "isMfaRequired",
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "isMfaRequired": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.RolesOutput": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v0.RoleOutput"
+                    }
+                }
+            }
+        },
+        "v0.SendChatMessageInput": {
+            "type": "object",
+            "required": [
+                "attachments"
+            ],
+            "properties": {
+                "attachments": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text": {
+                    "type": "string",
+                    "maxLength": 4000
+                }
+            }
+        },
+        "v0.ServiceAccountOutput": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "name"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.ServiceAccountsOutput": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v0.ServiceAccountOutput"
+                    }
+                }
+            }
+        },
+        "v0.SessionOutput": {
+            "type": "object",
+            "required": [
+                "createdAt",
+                "id",
+                "isCurrent",
+                "lastUsedAt"
+            ],
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "deviceName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string",
+                    "format": "uuid"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "isCurrent": {
+                    "type": "boolean"
+                },
+                "lastUsedAt": {
+                    "type": "string",
+                    "format": "date-time"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "userAgent": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.SessionsOutput": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v0.SessionOutput"
+                    }
+                }
+            }
+        },
         "v0.SetPasswordInput": {
             "type": "object",
             "required": [
@@ -2879,11 +6774,41 @@ const docTemplate = `{
                 "code": {
                     "type": "string"
                 },
+                "deviceId": {
+                    "type": "string"
+                },
                 "state": {
                     "type": "string"
                 }
             }
         },
+        "v0.TOTPCodeInput": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.TOTPSetupOutput": {
+            "type": "object",
+            "required": [
+                "provisioningUri",
+                "secret"
+            ],
+            "properties": {
+                "provisioningUri": {
+                    "type": "string",
+                    "format": "uri"
+                },
+                "secret": {
+                    "type": "string"
+                }
+            }
+        },
         "v0.UpdateEmailInput": {
             "type": "object",
             "required": [
@@ -2974,6 +6899,32 @@ const docTemplate = `{
                 },
                 "oldPassword": {
                     "type": "string"
+                },
+                "revokeOtherSessions": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "v0.UpdatePhoneInput": {
+            "type": "object",
+            "required": [
+                "phone"
+            ],
+            "properties": {
+                "phone": {
+                    "type": "string",
+                    "format": "e164"
+                }
+            }
+        },
+        "v0.UpdateRoleInput": {
+            "type": "object",
+            "required": [
+                "isMfaRequired"
+            ],
+            "properties": {
+                "isMfaRequired": {
+                    "type": "boolean"
                 }
             }
         },
@@ -3027,6 +6978,33 @@ const docTemplate = `{
                 }
             }
         },
+        "v0.VerifyMagicLinkInput": {
+            "type": "object",
+            "required": [
+                "token"
+            ],
+            "properties": {
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "v0.VerifyPhoneInput": {
+            "type": "object",
+            "required": [
+                "otp",
+                "phone"
+            ],
+            "properties": {
+                "otp": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string",
+                    "format": "e164"
+                }
+            }
+        },
         "v0.VerifyRecoveryCodeInput": {
             "type": "object",
             "required": [
@@ -3042,9 +7020,71 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "wellknown.JWKOutput": {
+            "type": "object",
+            "required": [
+                "alg",
+                "kid",
+                "kty",
+                "use"
+            ],
+            "properties": {
+                "alg": {
+                    "type": "string",
+                    "example": "RS256"
+                },
+                "crv": {
+                    "type": "string",
+                    "example": "P-256"
+                },
+                "e": {
+                    "type": "string"
+                },
+                "kid": {
+                    "type": "string"
+                },
+                "kty": {
+                    "type": "string",
+                    "example": "RSA"
+                },
+                "n": {
+                    "type": "string"
+                },
+                "use": {
+                    "type": "string",
+                    "example": "sig"
+                },
+                "x": {
+                    "type": "string"
+                },
+                "y": {
+                    "type": "string"
+                }
+            }
+        },
+        "wellknown.JWKSOutput": {
+            "type": "object",
+            "required": [
+                "keys"
+            ],
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/wellknown.JWKOutput"
+                    }
+                }
+            }
         }
     },
     "securityDefinitions": {
+        "APIKeyAuth": {
+            "description": "API key of service account, also it can be passed in Authorization header with ApiKey scheme",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
         "BearerAuth": {
             "type": "apiKey",
             "name": "Authorization",
@@ -3060,6 +7100,10 @@ const docTemplate = `{
             "description": "API for authentication and authorization",
             "name": "Authentication and Authorization API"
         },
+        {
+            "description": "API for conversations between clients and masters",
+            "name": "Chat API"
+        },
         {
             "description": "API for geocoding",
             "name": "Geocoding API"
@@ -3080,6 +7124,10 @@ const docTemplate = `{
             "description": "API for download and upload files",
             "name": "Resource API"
         },
+        {
+            "description": "API for service accounts and their API keys",
+            "name": "Service Account API"
+        },
         {
             "description": "API for getting swagger documentation",
             "name": "Swagger API"
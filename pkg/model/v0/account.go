@@ -12,22 +12,64 @@ type UpdateEmailInput struct {
 	Email string `format:"email" json:"email" binding:"required,email"`
 }
 
+type UpdatePhoneInput struct {
+	Phone string `json:"phone" format:"e164" binding:"required,e164"`
+}
+
 type SetPasswordInput struct {
 	Password string `json:"password" format:"zxcvbn" binding:"required,zxcvbn"`
 }
 
 type UpdatePasswordInput struct {
-	OldPassword string `json:"oldPassword" binding:"required"`
-	NewPassword string `json:"newPassword" format:"zxcvbn" binding:"required,zxcvbn"`
+	OldPassword         string `json:"oldPassword" binding:"required"`
+	NewPassword         string `json:"newPassword" format:"zxcvbn" binding:"required,zxcvbn"`
+	RevokeOtherSessions bool   `json:"revokeOtherSessions"`
 }
 
 type AccountOutput struct {
-	Username        string `json:"username" binding:"required,max=255,min=1"`
-	Email           string `json:"email" format:"email" binding:"required,email"`
-	IsEnabled       bool   `json:"isEnabled" binding:"required"`
-	IsEmailVerified bool   `json:"isEmailVerified" binding:"required"`
-	IsPasswordTemp  bool   `json:"isPasswordTemp" binding:"required"`
-	IsDeleted       bool   `json:"isDeleted" binding:"required"`
+	Username        string  `json:"username" binding:"required,max=255,min=1"`
+	Email           string  `json:"email" format:"email" binding:"required,email"`
+	IsEnabled       bool    `json:"isEnabled" binding:"required"`
+	IsEmailVerified bool    `json:"isEmailVerified" binding:"required"`
+	Phone           *string `json:"phone,omitempty" format:"e164"`
+	IsPhoneVerified bool    `json:"isPhoneVerified" binding:"required"`
+	IsPasswordTemp  bool    `json:"isPasswordTemp" binding:"required"`
+	IsDeleted       bool    `json:"isDeleted" binding:"required"`
+	IsMFAEnabled    bool    `json:"isMfaEnabled" binding:"required"`
+}
+
+//////////////////// Session ////////////////////
+
+// SessionOutput describes active session. DeviceName and Location are reported by client and are not verified
+type SessionOutput struct {
+	ID         string    `json:"id" format:"uuid" binding:"required"`
+	DeviceName *string   `json:"deviceName,omitempty"`
+	IP         *string   `json:"ip,omitempty"`
+	UserAgent  *string   `json:"userAgent,omitempty"`
+	Location   *string   `json:"location,omitempty"`
+	IsCurrent  bool      `json:"isCurrent" binding:"required"`
+	CreatedAt  time.Time `json:"createdAt" format:"date-time" binding:"required"`
+	LastUsedAt time.Time `json:"lastUsedAt" format:"date-time" binding:"required"`
+}
+
+type SessionsOutput struct {
+	Count int             `json:"count"`
+	Data  []SessionOutput `json:"data"`
+}
+
+//////////////////// Two-factor authentication ////////////////////
+
+type TOTPSetupOutput struct {
+	Secret          string `json:"secret" binding:"required"`
+	ProvisioningURI string `json:"provisioningUri" format:"uri" binding:"required"`
+}
+
+type TOTPCodeInput struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type RecoveryCodesOutput struct {
+	Codes []string `json:"codes" binding:"required"`
 }
 
 //////////////////// Email Verify ////////////////////
@@ -52,3 +94,15 @@ type EmailVerifyTemplateArgs struct {
 	TTL   int
 	OTP   string
 }
+
+//////////////////// Phone Verify ////////////////////
+
+type VerifyPhoneInput struct {
+	OTP   string `json:"otp" binding:"required"`
+	Phone string `json:"phone" format:"e164" binding:"required,e164"`
+}
+
+type OTPSMSArgs struct {
+	OTP string
+	TTL int
+}
@@ -1,5 +1,7 @@
 package v0
 
+import "time"
+
 //////////////////// Login //////////////////////
 
 type LoginInput struct {
@@ -12,6 +14,56 @@ type JwtTokensOutput struct {
 	RefreshToken string `json:"refreshToken" format:"jwt" binding:"required"`
 }
 
+// LoginOutput contains either JWT tokens or, if user has enabled 2FA, short-lived MFA token,
+// which should be exchanged for JWT tokens with TOTP or recovery code.
+// If social login matches existing account by email, which provider did not verify, link token is returned instead,
+// and identity is linked only after user signs in to the existing account and confirms it
+type LoginOutput struct {
+	AccessToken          string `json:"accessToken,omitempty" format:"jwt"`
+	RefreshToken         string `json:"refreshToken,omitempty" format:"jwt"`
+	MFARequired          bool   `json:"mfaRequired"`
+	MFAToken             string `json:"mfaToken,omitempty" format:"jwt"`
+	IdentityLinkRequired bool   `json:"identityLinkRequired"`
+	IdentityLinkToken    string `json:"identityLinkToken,omitempty"`
+}
+
+type LoginMFAInput struct {
+	MFAToken string `json:"mfaToken" format:"jwt" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+//////////////////// Login by phone //////////////////////
+
+type PhoneLoginInput struct {
+	Phone string `json:"phone" format:"e164" binding:"required,e164"`
+}
+
+type PhoneLoginConfirmInput struct {
+	OTP   string `json:"otp" binding:"required"`
+	Phone string `json:"phone" format:"e164" binding:"required,e164"`
+}
+
+//////////////////// Login by magic link //////////////////////
+
+type MagicLinkInput struct {
+	Email string `json:"email" format:"email" binding:"required,email"`
+}
+
+// MagicLinkOutput contains device secret, which binds link to the browser requested it
+type MagicLinkOutput struct {
+	DeviceSecret string `json:"-"`
+}
+
+type MagicLinkTemplateArgs struct {
+	Email string
+	TTL   int
+	Link  string
+}
+
+type VerifyMagicLinkInput struct {
+	Token string `json:"token" binding:"required"`
+}
+
 //////////////////// Register //////////////////////
 
 type RegisterInput struct {
@@ -49,6 +101,13 @@ type RecoveryPasswordTemplateArgs struct {
 	OTP   string
 }
 
+type SessionCompromisedTemplateArgs struct {
+	Email     string
+	IP        string
+	UserAgent string
+	Time      string
+}
+
 //////////////////// Verify recovery password ////////////////////
 
 type VerifyRecoveryCodeInput struct {
@@ -59,9 +118,10 @@ type VerifyRecoveryCodeInput struct {
 //////////////////// Reset password ////////////////////
 
 type ResetPasswordInput struct {
-	OTP      string `json:"otp" binding:"required"`
-	Email    string `json:"email" binding:"required,email" format:"email"`
-	Password string `json:"password" binding:"required,zxcvbn" format:"zxcvbn"`
+	OTP            string `json:"otp" binding:"required"`
+	Email          string `json:"email" binding:"required,email" format:"email"`
+	Password       string `json:"password" binding:"required,zxcvbn" format:"zxcvbn"`
+	RevokeSessions bool   `json:"revokeSessions"`
 }
 
 //////////////////// Social login ////////////////////
@@ -72,10 +132,25 @@ type GetConsentPageURLOutput struct {
 }
 
 type FetchUserInfoInput struct {
-	Code string `json:"code" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+	State    string `json:"state" binding:"required"`
+	DeviceID string `json:"deviceId,omitempty"`
 }
 
+// SocialLoginCallbackInput contains parameters of redirect from provider. DeviceID is passed only by VK ID,
+// Telegram Login passes signed user data (tgAuthResult) instead of code
 type SocialLoginCallbackInput struct {
-	Code  string `json:"code" binding:"required"`
-	State string `json:"state" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+	State    string `json:"state" binding:"required"`
+	DeviceID string `json:"deviceId,omitempty"`
+}
+
+// OAuthStateData is stored by OAuth state until provider redirects back with authorization code.
+// State is single-use and valid only for the provider and the client, which requested consent page.
+// Issuance time binds auth data of providers, which do not sign state, to the state
+type OAuthStateData struct {
+	Provider     string    `json:"provider"`
+	CodeVerifier string    `json:"codeVerifier"`
+	UserAgent    string    `json:"userAgent"`
+	IssuedAt     time.Time `json:"issuedAt"`
 }
@@ -0,0 +1,108 @@
+package v0
+
+import "time"
+
+//////////////////// Conversation ////////////////////
+
+type ConversationParticipantOutput struct {
+	ID       string `json:"id" format:"uuid" binding:"required"`
+	Username string `json:"username" binding:"required"`
+}
+
+type ConversationOutput struct {
+	ID            string                        `json:"id" format:"uuid" binding:"required"`
+	Client        ConversationParticipantOutput `json:"client" binding:"required"`
+	Master        ConversationParticipantOutput `json:"master" binding:"required"`
+	AppointmentID *string                       `json:"appointmentId,omitempty" format:"uuid"`
+	UnreadCount   int64                         `json:"unreadCount"`
+	LastMessageAt *time.Time                    `json:"lastMessageAt,omitempty" format:"date-time"`
+	CreatedAt     time.Time                     `json:"createdAt" format:"date-time" binding:"required"`
+}
+
+type ConversationsOutput struct {
+	Count       int                  `json:"count"`
+	UnreadCount int64                `json:"unreadCount"`
+	Data        []ConversationOutput `json:"data"`
+}
+
+// CreateConversationInput describes conversation of current user as client with master.
+// Existing conversation is returned, if it has been already started
+type CreateConversationInput struct {
+	MasterUsername string  `json:"masterUsername" binding:"required"`
+	AppointmentID  *string `json:"appointmentId,omitempty" format:"uuid" binding:"omitempty,uuid"`
+}
+
+//////////////////// Chat message ////////////////////
+
+type ChatMessageOutput struct {
+	ID             string    `json:"id" format:"uuid" binding:"required"`
+	ConversationID string    `json:"conversationId" format:"uuid" binding:"required"`
+	SenderID       string    `json:"senderId" format:"uuid" binding:"required"`
+	Text           string    `json:"text"`
+	Attachments    []string  `json:"attachments"`
+	CreatedAt      time.Time `json:"createdAt" format:"date-time" binding:"required"`
+}
+
+// ChatMessagesOutput contains page of messages from newest to oldest. NextCursor is absent on the last page
+type ChatMessagesOutput struct {
+	Data       []ChatMessageOutput `json:"data"`
+	NextCursor *string             `json:"nextCursor,omitempty"`
+}
+
+// SendChatMessageInput describes new message. Attachments are object ids of uploaded chat attachments
+type SendChatMessageInput struct {
+	Text        string   `json:"text" binding:"required_without=Attachments,max=4000"`
+	Attachments []string `json:"attachments,omitempty" binding:"omitempty,max=10,unique,dive,required"`
+}
+
+type FindChatMessagesInput struct {
+	Cursor string `form:"cursor,omitempty"`
+	Limit  int    `form:"limit,default=20" binding:"min=1,max=100"`
+}
+
+// MarkChatReadInput describes read receipt. All messages of conversation are read, if MessageID is absent
+type MarkChatReadInput struct {
+	MessageID *string `json:"messageId,omitempty" format:"uuid" binding:"omitempty,uuid"`
+}
+
+//////////////////// Chat event ////////////////////
+
+const (
+	ChatMessageEventType = "message"
+	ChatTypingEventType  = "typing"
+	ChatReadEventType    = "read"
+	ChatUnreadEventType  = "unread"
+)
+
+// ChatEventOutput is sent over websocket to topic of conversation or to notifications topic of participant
+type ChatEventOutput struct {
+	Type string `json:"type" enums:"message,typing,read,unread" binding:"required"`
+	Data any    `json:"data" binding:"required"`
+}
+
+type ChatTypingEventOutput struct {
+	ConversationID string `json:"conversationId" format:"uuid" binding:"required"`
+	UserID         string `json:"userId" format:"uuid" binding:"required"`
+}
+
+type ChatReadEventOutput struct {
+	ConversationID string    `json:"conversationId" format:"uuid" binding:"required"`
+	UserID         string    `json:"userId" format:"uuid" binding:"required"`
+	ReadAt         time.Time `json:"readAt" format:"date-time" binding:"required"`
+}
+
+type ChatUnreadEventOutput struct {
+	ConversationID string `json:"conversationId" format:"uuid" binding:"required"`
+	UnreadCount    int64  `json:"unreadCount"`
+}
+
+// ChatTypingInput is payload of typing message sent by client over websocket
+type ChatTypingInput struct {
+	ConversationID string `json:"conversationId" binding:"required,uuid"`
+}
+
+type ChatMessageTemplateArgs struct {
+	Email    string
+	Username string
+	Text     string
+}
@@ -0,0 +1,36 @@
+package v0
+
+import "time"
+
+//////////////////// Identity ////////////////////
+
+type IdentityOutput struct {
+	Provider  string    `json:"provider" binding:"required"`
+	Email     *string   `json:"email,omitempty" format:"email"`
+	CreatedAt time.Time `json:"createdAt" format:"date-time" binding:"required"`
+}
+
+type IdentitiesOutput struct {
+	Count int              `json:"count"`
+	Data  []IdentityOutput `json:"data"`
+}
+
+//////////////////// Link identity ////////////////////
+
+type LinkIdentityInput struct {
+	Code     string `json:"code" binding:"required"`
+	State    string `json:"state" binding:"required"`
+	DeviceID string `json:"deviceId,omitempty"`
+}
+
+type ConfirmIdentityLinkInput struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// IdentityLinkData is stored by link token until user confirms linking of identity to the existing account
+type IdentityLinkData struct {
+	UserID   string `json:"userId"`
+	Provider string `json:"provider"`
+	Subject  string `json:"subject"`
+	Email    string `json:"email"`
+}
@@ -0,0 +1,117 @@
+package v0
+
+import "time"
+
+// Binary fields of WebAuthn structures are encoded as base64url without padding,
+// as expected by PublicKeyCredential.parseCreationOptionsFromJSON and toJSON in browsers
+
+//////////////////// Passkey ////////////////////
+
+type PasskeyOutput struct {
+	ID         string     `json:"id" format:"uuid" binding:"required"`
+	Name       string     `json:"name" binding:"required"`
+	CreatedAt  time.Time  `json:"createdAt" format:"date-time" binding:"required"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty" format:"date-time"`
+}
+
+type PasskeysOutput struct {
+	Count int             `json:"count"`
+	Data  []PasskeyOutput `json:"data"`
+}
+
+type PublicKeyCredentialRPEntity struct {
+	ID   string `json:"id" binding:"required"`
+	Name string `json:"name" binding:"required"`
+}
+
+type PublicKeyCredentialUserEntity struct {
+	ID          string `json:"id" format:"base64url" binding:"required"`
+	Name        string `json:"name" binding:"required"`
+	DisplayName string `json:"displayName" binding:"required"`
+}
+
+type PublicKeyCredentialParameters struct {
+	Type string `json:"type" binding:"required"`
+	Alg  int    `json:"alg" binding:"required"`
+}
+
+type PublicKeyCredentialDescriptor struct {
+	Type       string   `json:"type" binding:"required"`
+	ID         string   `json:"id" format:"base64url" binding:"required"`
+	Transports []string `json:"transports,omitempty"`
+}
+
+type AuthenticatorSelectionCriteria struct {
+	ResidentKey        string `json:"residentKey" binding:"required"`
+	RequireResidentKey bool   `json:"requireResidentKey"`
+	UserVerification   string `json:"userVerification" binding:"required"`
+}
+
+//////////////////// Passkey registration ////////////////////
+
+type PublicKeyCredentialCreationOptions struct {
+	Challenge              string                          `json:"challenge" format:"base64url" binding:"required"`
+	RP                     PublicKeyCredentialRPEntity     `json:"rp" binding:"required"`
+	User                   PublicKeyCredentialUserEntity   `json:"user" binding:"required"`
+	PubKeyCredParams       []PublicKeyCredentialParameters `json:"pubKeyCredParams" binding:"required"`
+	Timeout                int                             `json:"timeout" binding:"required"`
+	ExcludeCredentials     []PublicKeyCredentialDescriptor `json:"excludeCredentials"`
+	AuthenticatorSelection AuthenticatorSelectionCriteria  `json:"authenticatorSelection" binding:"required"`
+	Attestation            string                          `json:"attestation" binding:"required"`
+}
+
+type PasskeyCreationOptionsOutput struct {
+	PublicKey PublicKeyCredentialCreationOptions `json:"publicKey" binding:"required"`
+}
+
+type AuthenticatorAttestationResponse struct {
+	ClientDataJSON    string   `json:"clientDataJSON" format:"base64url" binding:"required"`
+	AttestationObject string   `json:"attestationObject" format:"base64url" binding:"required"`
+	Transports        []string `json:"transports,omitempty"`
+}
+
+type PasskeyAttestationCredential struct {
+	ID       string                           `json:"id" format:"base64url" binding:"required"`
+	RawID    string                           `json:"rawId" format:"base64url" binding:"required"`
+	Type     string                           `json:"type" binding:"required,eq=public-key"`
+	Response AuthenticatorAttestationResponse `json:"response" binding:"required"`
+}
+
+type PasskeyRegistrationInput struct {
+	Name       string                       `json:"name" binding:"omitempty,max=255"`
+	Credential PasskeyAttestationCredential `json:"credential" binding:"required"`
+}
+
+//////////////////// Passkey login ////////////////////
+
+type PublicKeyCredentialRequestOptions struct {
+	Challenge        string                          `json:"challenge" format:"base64url" binding:"required"`
+	RPID             string                          `json:"rpId" binding:"required"`
+	Timeout          int                             `json:"timeout" binding:"required"`
+	AllowCredentials []PublicKeyCredentialDescriptor `json:"allowCredentials"`
+	UserVerification string                          `json:"userVerification" binding:"required"`
+}
+
+type PasskeyRequestOptionsOutput struct {
+	ChallengeID string                            `json:"challengeId" format:"uuid" binding:"required"`
+	PublicKey   PublicKeyCredentialRequestOptions `json:"publicKey" binding:"required"`
+}
+
+type AuthenticatorAssertionResponse struct {
+	ClientDataJSON    string `json:"clientDataJSON" format:"base64url" binding:"required"`
+	AuthenticatorData string `json:"authenticatorData" format:"base64url" binding:"required"`
+	Signature         string `json:"signature" format:"base64url" binding:"required"`
+	UserHandle        string `json:"userHandle,omitempty" format:"base64url"`
+}
+
+type PasskeyAssertionCredential struct {
+	ID       string                         `json:"id" format:"base64url" binding:"required"`
+	RawID    string                         `json:"rawId" format:"base64url" binding:"required"`
+	Type     string                         `json:"type" binding:"required,eq=public-key"`
+	Response AuthenticatorAssertionResponse `json:"response" binding:"required"`
+}
+
+type PasskeyLoginInput struct {
+	ChallengeID string                     `json:"challengeId" format:"uuid" binding:"required,uuid"`
+	Credential  PasskeyAssertionCredential `json:"credential" binding:"required"`
+}
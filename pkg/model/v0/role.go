@@ -0,0 +1,18 @@
+package v0
+
+//////////////////// Role ////////////////////
+
+type RoleOutput struct {
+	Name          string  `json:"name" binding:"required"`
+	Description   *string `json:"description,omitempty"`
+	IsMFARequired bool    `json:"isMfaRequired" binding:"required"`
+}
+
+type RolesOutput struct {
+	Count int          `json:"count"`
+	Data  []RoleOutput `json:"data"`
+}
+
+type UpdateRoleInput struct {
+	IsMFARequired *bool `json:"isMfaRequired" binding:"required"`
+}
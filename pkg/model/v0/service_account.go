@@ -0,0 +1,55 @@
+package v0
+
+import "time"
+
+//////////////////// Service account ////////////////////
+
+type ServiceAccountOutput struct {
+	ID          string    `json:"id" format:"uuid" binding:"required"`
+	Name        string    `json:"name" binding:"required"`
+	Description *string   `json:"description,omitempty"`
+	CreatedAt   time.Time `json:"createdAt" format:"date-time" binding:"required"`
+}
+
+type ServiceAccountsOutput struct {
+	Count int                    `json:"count"`
+	Data  []ServiceAccountOutput `json:"data"`
+}
+
+type CreateServiceAccountInput struct {
+	Name        string  `json:"name" binding:"required,min=1,max=100"`
+	Description *string `json:"description,omitempty" binding:"omitempty,max=1000"`
+}
+
+//////////////////// API key ////////////////////
+
+type APIKeyOutput struct {
+	ID         string     `json:"id" format:"uuid" binding:"required"`
+	Name       string     `json:"name" binding:"required"`
+	Prefix     string     `json:"prefix" binding:"required"`
+	Scopes     []string   `json:"scopes" binding:"required"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty" format:"date-time"`
+	RevokedAt  *time.Time `json:"revokedAt,omitempty" format:"date-time"`
+	UsageCount int64      `json:"usageCount"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty" format:"date-time"`
+	LastUsedIP *string    `json:"lastUsedIp,omitempty" format:"ipv4"`
+	CreatedAt  time.Time  `json:"createdAt" format:"date-time" binding:"required"`
+}
+
+type APIKeysOutput struct {
+	Count int            `json:"count"`
+	Data  []APIKeyOutput `json:"data"`
+}
+
+// CreatedAPIKeyOutput contains API key in plain form, it is returned only once on creation or rotation
+type CreatedAPIKeyOutput struct {
+	Key    string       `json:"key" binding:"required"`
+	APIKey APIKeyOutput `json:"apiKey" binding:"required"`
+}
+
+// CreateAPIKeyInput describes new API key. ExpiresIn is lifetime of key in seconds, key without it is valid until revoked
+type CreateAPIKeyInput struct {
+	Name      string   `json:"name" binding:"required,min=1,max=100"`
+	Scopes    []string `json:"scopes" binding:"required,min=1,unique,dive,oneof=bookings:read services:read services:write webhooks:manage"`
+	ExpiresIn *int64   `json:"expiresIn,omitempty" binding:"omitempty,min=60"`
+}
@@ -0,0 +1,17 @@
+package wellknown
+
+type JWKOutput struct {
+	Kty string `json:"kty" binding:"required" example:"RSA"`
+	Kid string `json:"kid" binding:"required"`
+	Use string `json:"use" binding:"required" example:"sig"`
+	Alg string `json:"alg" binding:"required" example:"RS256"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty" example:"P-256"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type JWKSOutput struct {
+	Keys []JWKOutput `json:"keys" binding:"required"`
+}
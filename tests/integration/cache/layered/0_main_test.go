@@ -0,0 +1,75 @@
+package layered
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache/layered"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache/memory"
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	memory2 "github.com/mandarine-io/backend/internal/infrastructure/pubsub/memory"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/require"
+	"io"
+	"testing"
+	"time"
+)
+
+const (
+	localTTL       = 500 * time.Millisecond
+	receiveTimeout = 5 * time.Second
+)
+
+var (
+	ctx    = context.Background()
+	remote cache.Manager
+	agent  pubsub.Agent
+
+	// node1 and node2 share remote tier and pub/sub agent as two nodes of application do
+	node1 cache.Manager
+	node2 cache.Manager
+)
+
+type LayeredCacheManagerSuite struct {
+	suite.Suite
+}
+
+func TestLayeredCacheManagerSuite(t *testing.T) {
+	var err error
+	remote, err = memory.NewManager(memory.WithTTL(time.Minute))
+	require.NoError(t, err)
+
+	agent, err = memory2.NewAgent()
+	require.NoError(t, err)
+
+	node1, err = layered.NewManager(remote, agent, layered.WithLocalTTL(localTTL))
+	require.NoError(t, err)
+	node2, err = layered.NewManager(remote, agent, layered.WithLocalTTL(localTTL))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, node1.(io.Closer).Close())
+		require.NoError(t, node2.(io.Closer).Close())
+	}()
+
+	_, err = layered.NewManager(remote, agent, layered.WithSize(0))
+	require.Error(t, err)
+
+	suite.RunSuite(t, new(LayeredCacheManagerSuite))
+}
+
+func (s *LayeredCacheManagerSuite) Test(t provider.T) {
+	s.RunSuite(t, new(GetSuite))
+	s.RunSuite(t, new(InvalidationSuite))
+	s.RunSuite(t, new(LocalTierSuite))
+}
+
+// eventually checks condition until it is true or timeout is expired
+func eventually(t provider.T, condition func() bool) {
+	deadline := time.Now().Add(receiveTimeout)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("condition is not satisfied")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
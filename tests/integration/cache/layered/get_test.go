@@ -0,0 +1,90 @@
+package layered
+
+import (
+	"github.com/mandarine-io/backend/internal/infrastructure/cache"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache/layered"
+	"github.com/mandarine-io/backend/internal/observability/mock"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"io"
+)
+
+type value struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+type GetSuite struct {
+	suite.Suite
+}
+
+func (s *GetSuite) Test_LocalHit(t provider.T) {
+	t.Title("Get - value is served by local tier after remote tier")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Layered cache manager")
+	t.Tags("Positive")
+
+	metricsMock := new(mock.MetricsAdapterMock)
+	manager, err := layered.NewManager(
+		remote,
+		agent,
+		layered.WithMetrics(metricsMock),
+		layered.WithTopic("cache:invalidate:local_hit"),
+	)
+	t.Require().NoError(err)
+	defer func() {
+		t.Require().NoError(manager.(io.Closer).Close())
+	}()
+
+	err = remote.Set(ctx, "get_local_hit", value{Name: "first", Count: 1})
+	t.Require().NoError(err)
+
+	metricsMock.On("IncrementCacheMiss", layered.LocalTier).Return().Once()
+	metricsMock.On("IncrementCacheHit", layered.RemoteTier).Return().Once()
+
+	var v value
+	err = manager.Get(ctx, "get_local_hit", &v)
+	t.Require().NoError(err)
+	t.Require().Equal(value{Name: "first", Count: 1}, v)
+
+	// Remote tier is changed bypassing manager, so local tier is not invalidated
+	err = remote.Set(ctx, "get_local_hit", value{Name: "second", Count: 2})
+	t.Require().NoError(err)
+
+	metricsMock.On("IncrementCacheHit", layered.LocalTier).Return().Once()
+
+	err = manager.Get(ctx, "get_local_hit", &v)
+	t.Require().NoError(err)
+	t.Require().Equal(value{Name: "first", Count: 1}, v)
+
+	metricsMock.AssertExpectations(t)
+}
+
+func (s *GetSuite) Test_Miss(t provider.T) {
+	t.Title("Get - missing key is reported as miss of both tiers")
+	t.Severity(allure.NORMAL)
+	t.Feature("Layered cache manager")
+	t.Tags("Negative")
+
+	metricsMock := new(mock.MetricsAdapterMock)
+	manager, err := layered.NewManager(
+		remote,
+		agent,
+		layered.WithMetrics(metricsMock),
+		layered.WithTopic("cache:invalidate:miss"),
+	)
+	t.Require().NoError(err)
+	defer func() {
+		t.Require().NoError(manager.(io.Closer).Close())
+	}()
+
+	metricsMock.On("IncrementCacheMiss", layered.LocalTier).Return().Once()
+	metricsMock.On("IncrementCacheMiss", layered.RemoteTier).Return().Once()
+
+	var v value
+	err = manager.Get(ctx, "get_no_such_key", &v)
+	t.Require().ErrorIs(err, cache.ErrCacheEntryNotFound)
+
+	metricsMock.AssertExpectations(t)
+}
@@ -0,0 +1,119 @@
+package layered
+
+import (
+	"errors"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type InvalidationSuite struct {
+	suite.Suite
+}
+
+func (s *InvalidationSuite) Test_Set(t provider.T) {
+	t.Title("Invalidation - value set by other node replaces cached one")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Layered cache manager")
+	t.Tags("Positive")
+
+	err := node2.Set(ctx, "invalidation_set", "first")
+	t.Require().NoError(err)
+
+	var v string
+	err = node1.Get(ctx, "invalidation_set", &v)
+	t.Require().NoError(err)
+	t.Require().Equal("first", v)
+
+	err = node2.Set(ctx, "invalidation_set", "second")
+	t.Require().NoError(err)
+
+	eventually(
+		t, func() bool {
+			err := node1.Get(ctx, "invalidation_set", &v)
+			return err == nil && v == "second"
+		},
+	)
+}
+
+func (s *InvalidationSuite) Test_Delete(t provider.T) {
+	t.Title("Invalidation - value deleted by other node is removed from local tier")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Layered cache manager")
+	t.Tags("Positive")
+
+	err := node2.Set(ctx, "invalidation_delete", "value")
+	t.Require().NoError(err)
+
+	var v string
+	err = node1.Get(ctx, "invalidation_delete", &v)
+	t.Require().NoError(err)
+
+	err = node2.Delete(ctx, "invalidation_delete")
+	t.Require().NoError(err)
+
+	eventually(
+		t, func() bool {
+			return errors.Is(node1.Get(ctx, "invalidation_delete", &v), cache.ErrCacheEntryNotFound)
+		},
+	)
+}
+
+func (s *InvalidationSuite) Test_Invalidate(t provider.T) {
+	t.Title("Invalidation - values invalidated by other node are removed from local tier")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Layered cache manager")
+	t.Tags("Positive")
+
+	for _, key := range []string{"invalidation_pattern:a", "invalidation_pattern:b", "invalidation_other"} {
+		err := node2.Set(ctx, key, key)
+		t.Require().NoError(err)
+
+		var v string
+		err = node1.Get(ctx, key, &v)
+		t.Require().NoError(err)
+	}
+
+	err := node2.Invalidate(ctx, "invalidation_pattern:*")
+	t.Require().NoError(err)
+
+	var v string
+	eventually(
+		t, func() bool {
+			return errors.Is(node1.Get(ctx, "invalidation_pattern:a", &v), cache.ErrCacheEntryNotFound) &&
+				errors.Is(node1.Get(ctx, "invalidation_pattern:b", &v), cache.ErrCacheEntryNotFound)
+		},
+	)
+
+	err = node1.Get(ctx, "invalidation_other", &v)
+	t.Require().NoError(err)
+	t.Require().Equal("invalidation_other", v)
+}
+
+func (s *InvalidationSuite) Test_Increment(t provider.T) {
+	t.Title("Invalidation - incremented value is read from remote tier")
+	t.Severity(allure.NORMAL)
+	t.Feature("Layered cache manager")
+	t.Tags("Positive")
+
+	value, err := node2.Increment(ctx, "invalidation_increment", 0)
+	t.Require().NoError(err)
+	t.Require().Equal(int64(1), value)
+
+	var v int64
+	err = node1.Get(ctx, "invalidation_increment", &v)
+	t.Require().NoError(err)
+	t.Require().Equal(int64(1), v)
+
+	value, err = node2.Increment(ctx, "invalidation_increment", 0)
+	t.Require().NoError(err)
+	t.Require().Equal(int64(2), value)
+
+	eventually(
+		t, func() bool {
+			err := node1.Get(ctx, "invalidation_increment", &v)
+			return err == nil && v == 2
+		},
+	)
+}
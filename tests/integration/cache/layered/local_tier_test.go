@@ -0,0 +1,85 @@
+package layered
+
+import (
+	"github.com/mandarine-io/backend/internal/infrastructure/cache/layered"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"io"
+	"time"
+)
+
+type LocalTierSuite struct {
+	suite.Suite
+}
+
+func (s *LocalTierSuite) Test_TTL(t provider.T) {
+	t.Title("Local tier - value is read from remote tier after local TTL")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Layered cache manager")
+	t.Tags("Positive")
+
+	err := remote.Set(ctx, "local_ttl", "first")
+	t.Require().NoError(err)
+
+	var v string
+	err = node1.Get(ctx, "local_ttl", &v)
+	t.Require().NoError(err)
+
+	err = remote.Set(ctx, "local_ttl", "second")
+	t.Require().NoError(err)
+
+	err = node1.Get(ctx, "local_ttl", &v)
+	t.Require().NoError(err)
+	t.Require().Equal("first", v)
+
+	time.Sleep(localTTL + 100*time.Millisecond)
+
+	err = node1.Get(ctx, "local_ttl", &v)
+	t.Require().NoError(err)
+	t.Require().Equal("second", v)
+}
+
+func (s *LocalTierSuite) Test_Eviction(t provider.T) {
+	t.Title("Local tier - the least recently used value is evicted")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Layered cache manager")
+	t.Tags("Positive")
+
+	manager, err := layered.NewManager(
+		remote,
+		agent,
+		layered.WithSize(2),
+		layered.WithTopic("cache:invalidate:eviction"),
+	)
+	t.Require().NoError(err)
+	defer func() {
+		t.Require().NoError(manager.(io.Closer).Close())
+	}()
+
+	keys := []string{"eviction_1", "eviction_2", "eviction_3"}
+	for _, key := range keys {
+		err = remote.Set(ctx, key, "first")
+		t.Require().NoError(err)
+	}
+
+	// eviction_2 is the least recently used, when eviction_3 is read
+	var v string
+	for _, key := range []string{"eviction_1", "eviction_2", "eviction_1", "eviction_3"} {
+		err = manager.Get(ctx, key, &v)
+		t.Require().NoError(err)
+	}
+
+	for _, key := range keys {
+		err = remote.Set(ctx, key, "second")
+		t.Require().NoError(err)
+	}
+
+	// Evicted value is read last, because storing it evicts another one
+	expected := map[string]string{"eviction_1": "first", "eviction_2": "second", "eviction_3": "first"}
+	for _, key := range []string{"eviction_3", "eviction_1", "eviction_2"} {
+		err = manager.Get(ctx, key, &v)
+		t.Require().NoError(err)
+		t.Require().Equal(expected[key], v, "key: %s", key)
+	}
+}
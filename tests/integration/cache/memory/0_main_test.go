@@ -39,6 +39,7 @@ func TestMemoryCacheManagerSuite(t *testing.T) {
 func (s *MemoryCacheManagerSuite) Test(t provider.T) {
 	s.RunSuite(t, new(DeleteSuite))
 	s.RunSuite(t, new(GetSuite))
+	s.RunSuite(t, new(IncrementSuite))
 	s.RunSuite(t, new(InvalidateSuite))
 	s.RunSuite(t, new(SetSuite))
 	s.RunSuite(t, new(SetWithExpirationSuite))
@@ -0,0 +1,61 @@
+package memory
+
+import (
+	"github.com/mandarine-io/backend/internal/infrastructure/cache"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"time"
+)
+
+type IncrementSuite struct {
+	suite.Suite
+}
+
+func (s *IncrementSuite) AfterEach(t provider.T) {
+	t.Title("Increment - after each")
+	t.Feature("Redis cache manager")
+
+	err := manager.Delete(ctx, "increment_key")
+	t.Require().NoError(err)
+}
+
+func (s *IncrementSuite) Test_Success(t provider.T) {
+	t.Title("Increment - success")
+	t.Severity(allure.NORMAL)
+	t.Feature("Redis cache manager")
+	t.Tags("Positive")
+
+	value, err := manager.Increment(ctx, "increment_key", time.Minute)
+	t.Require().NoError(err)
+	t.Require().Equal(int64(1), value)
+
+	value, err = manager.Increment(ctx, "increment_key", time.Minute)
+	t.Require().NoError(err)
+	t.Require().Equal(int64(2), value)
+
+	var stored int64
+	err = manager.Get(ctx, "increment_key", &stored)
+	t.Require().NoError(err)
+	t.Require().Equal(int64(2), stored)
+}
+
+func (s *IncrementSuite) Test_Expiration(t provider.T) {
+	t.Title("Increment - expiration")
+	t.Severity(allure.NORMAL)
+	t.Feature("Redis cache manager")
+	t.Tags("Positive")
+
+	_, err := manager.Increment(ctx, "increment_key", time.Second)
+	t.Require().NoError(err)
+
+	time.Sleep(4 * time.Second)
+
+	var stored int64
+	err = manager.Get(ctx, "increment_key", &stored)
+	t.Require().ErrorIs(err, cache.ErrCacheEntryNotFound)
+
+	value, err := manager.Increment(ctx, "increment_key", time.Second)
+	t.Require().NoError(err)
+	t.Require().Equal(int64(1), value)
+}
@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"context"
+	postgres2 "github.com/mandarine-io/backend/internal/infrastructure/database/gorm/postgres"
+	"github.com/mandarine-io/backend/tests/integration"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+	"testing"
+)
+
+var (
+	ctx = context.Background()
+	db  *gorm.DB
+)
+
+type PostgresDBSuite struct {
+	suite.Suite
+}
+
+func TestPostgresDBSuite(t *testing.T) {
+	var err error
+	db, err = postgres2.NewDb(integration.Cfg.GetPostgresConfig())
+	require.NoError(t, err)
+
+	t.Cleanup(
+		func() {
+			_ = postgres2.CloseDb(db)
+		},
+	)
+
+	suite.RunSuite(t, new(PostgresDBSuite))
+}
+
+func (s *PostgresDBSuite) Test(t provider.T) {
+	s.RunSuite(t, new(TranslateErrorSuite))
+}
@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"gorm.io/gorm"
+)
+
+type translateErrorEntity struct {
+	ID   uuid.UUID `gorm:"column:id;primaryKey"`
+	Name string    `gorm:"column:name;uniqueIndex"`
+}
+
+func (translateErrorEntity) TableName() string {
+	return "translate_error_test"
+}
+
+type TranslateErrorSuite struct {
+	suite.Suite
+}
+
+func (s *TranslateErrorSuite) BeforeAll(t provider.T) {
+	t.Require().NoError(db.WithContext(ctx).Migrator().AutoMigrate(&translateErrorEntity{}))
+}
+
+func (s *TranslateErrorSuite) AfterAll(t provider.T) {
+	t.Require().NoError(db.WithContext(ctx).Migrator().DropTable(&translateErrorEntity{}))
+}
+
+func (s *TranslateErrorSuite) Test_ErrDuplicatedKey(t provider.T) {
+	t.Title("Returns duplicated key error on unique violation")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Postgres")
+	t.Feature("TranslateError")
+	t.Tags("Negative")
+
+	name := uuid.New().String()
+
+	err := db.WithContext(ctx).Create(&translateErrorEntity{ID: uuid.New(), Name: name}).Error
+	t.Require().NoError(err)
+
+	err = db.WithContext(ctx).Create(&translateErrorEntity{ID: uuid.New(), Name: name}).Error
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, gorm.ErrDuplicatedKey)
+}
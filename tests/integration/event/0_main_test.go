@@ -0,0 +1,46 @@
+package event
+
+import (
+	"github.com/mandarine-io/backend/internal/infrastructure/event"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	registry *event.Registry
+)
+
+// testEvent is event of test aggregate
+type testEvent struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func (testEvent) EventType() string {
+	return "TestEvent"
+}
+
+func (testEvent) AggregateType() string {
+	return "test"
+}
+
+func (e testEvent) AggregateID() string {
+	return e.ID
+}
+
+type EventRegistrySuite struct {
+	suite.Suite
+}
+
+func TestEventRegistrySuite(t *testing.T) {
+	registry = event.NewRegistry()
+	require.NoError(t, event.RegisterJSON[testEvent](registry))
+
+	suite.RunSuite(t, new(EventRegistrySuite))
+}
+
+func (s *EventRegistrySuite) Test(t provider.T) {
+	s.RunSuite(t, new(RegistrySuite))
+}
@@ -0,0 +1,112 @@
+package event
+
+import (
+	"github.com/goccy/go-json"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/infrastructure/event"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"time"
+)
+
+type unknownEvent struct{}
+
+func (unknownEvent) EventType() string {
+	return "UnknownEvent"
+}
+
+func (unknownEvent) AggregateType() string {
+	return "test"
+}
+
+func (unknownEvent) AggregateID() string {
+	return ""
+}
+
+type RegistrySuite struct {
+	suite.Suite
+}
+
+func (s *RegistrySuite) Test_EncodeDecode(t provider.T) {
+	t.Title("Registry - event is decoded to the same typed event")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Event registry")
+	t.Tags("Positive")
+
+	e := testEvent{ID: "1", Name: "test"}
+
+	data, err := registry.Encode(e)
+	t.Require().NoError(err)
+	t.Require().JSONEq(`{"id":"1","name":"test"}`, string(data))
+
+	decoded, err := registry.Decode(e.EventType(), data)
+	t.Require().NoError(err)
+	t.Require().Equal(e, decoded)
+}
+
+func (s *RegistrySuite) Test_DecodeEnvelope(t provider.T) {
+	t.Title("Registry - published message is decoded to envelope and typed event")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Event registry")
+	t.Tags("Positive")
+
+	e := testEvent{ID: "2", Name: "test"}
+	payload, err := registry.Encode(e)
+	t.Require().NoError(err)
+
+	env := event.Envelope{
+		ID:            uuid.New(),
+		Type:          e.EventType(),
+		AggregateType: e.AggregateType(),
+		AggregateID:   e.AggregateID(),
+		OccurredAt:    time.Now().UTC().Truncate(time.Millisecond),
+		Payload:       payload,
+	}
+	data, err := json.Marshal(env)
+	t.Require().NoError(err)
+
+	decodedEnv, decoded, err := registry.DecodeEnvelope(data)
+	t.Require().NoError(err)
+	t.Require().Equal(env.ID, decodedEnv.ID)
+	t.Require().Equal(env.Type, decodedEnv.Type)
+	t.Require().Equal(env.AggregateID, decodedEnv.AggregateID)
+	t.Require().True(env.OccurredAt.Equal(decodedEnv.OccurredAt))
+	t.Require().Equal(e, decoded)
+}
+
+func (s *RegistrySuite) Test_UnknownEventType(t provider.T) {
+	t.Title("Registry - unknown event type is rejected")
+	t.Severity(allure.NORMAL)
+	t.Feature("Event registry")
+	t.Tags("Negative")
+
+	_, err := registry.Encode(unknownEvent{})
+	t.Require().ErrorIs(err, event.ErrUnknownEventType)
+
+	_, err = registry.Decode(unknownEvent{}.EventType(), []byte("{}"))
+	t.Require().ErrorIs(err, event.ErrUnknownEventType)
+}
+
+func (s *RegistrySuite) Test_DuplicateEventType(t provider.T) {
+	t.Title("Registry - event type is registered once")
+	t.Severity(allure.NORMAL)
+	t.Feature("Event registry")
+	t.Tags("Negative")
+
+	err := event.RegisterJSON[testEvent](registry)
+	t.Require().ErrorIs(err, event.ErrDuplicateEventType)
+}
+
+func (s *RegistrySuite) Test_InvalidPayload(t provider.T) {
+	t.Title("Registry - invalid payload is rejected")
+	t.Severity(allure.NORMAL)
+	t.Feature("Event registry")
+	t.Tags("Negative")
+
+	_, err := registry.Decode(testEvent{}.EventType(), []byte("not json"))
+	t.Require().ErrorIs(err, event.ErrInvalidEvent)
+
+	_, _, err = registry.DecodeEnvelope([]byte("not json"))
+	t.Require().ErrorIs(err, event.ErrInvalidEvent)
+}
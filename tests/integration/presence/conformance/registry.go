@@ -0,0 +1,98 @@
+package conformance
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/infrastructure/presence"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"time"
+)
+
+var (
+	ctx = context.Background()
+)
+
+// RegistrySuite checks, that registry behaves as any other backend of presence.Registry.
+// Registry must be created with TTL, so presence expires in time of test
+type RegistrySuite struct {
+	suite.Suite
+
+	Registry presence.Registry
+	Feature  string
+	TTL      time.Duration
+}
+
+func (s *RegistrySuite) Test_JoinLeave(t provider.T) {
+	t.Title("Registry - client is online until it leaves all nodes")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	err := s.Registry.Join(ctx, "node_1", "join_leave_1", "join_leave_2")
+	t.Require().NoError(err)
+	err = s.Registry.Join(ctx, "node_2", "join_leave_1")
+	t.Require().NoError(err)
+
+	online, err := s.Registry.IsOnline(ctx, "join_leave_1")
+	t.Require().NoError(err)
+	t.Require().True(online)
+
+	// Client is still connected to another node
+	err = s.Registry.Leave(ctx, "node_1", "join_leave_1", "join_leave_2")
+	t.Require().NoError(err)
+
+	online, err = s.Registry.IsOnline(ctx, "join_leave_1")
+	t.Require().NoError(err)
+	t.Require().True(online)
+
+	online, err = s.Registry.IsOnline(ctx, "join_leave_2")
+	t.Require().NoError(err)
+	t.Require().False(online)
+
+	err = s.Registry.Leave(ctx, "node_2", "join_leave_1")
+	t.Require().NoError(err)
+
+	online, err = s.Registry.IsOnline(ctx, "join_leave_1")
+	t.Require().NoError(err)
+	t.Require().False(online)
+}
+
+func (s *RegistrySuite) Test_Unknown(t provider.T) {
+	t.Title("Registry - unknown client is offline")
+	t.Severity(allure.NORMAL)
+	t.Feature(s.Feature)
+	t.Tags("Negative")
+
+	online, err := s.Registry.IsOnline(ctx, "unknown")
+	t.Require().NoError(err)
+	t.Require().False(online)
+}
+
+func (s *RegistrySuite) Test_Expired(t provider.T) {
+	t.Title("Registry - client becomes offline if node does not refresh presence")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	err := s.Registry.Join(ctx, "node_1", "expired")
+	t.Require().NoError(err)
+
+	time.Sleep(s.TTL / 2)
+
+	// Refresh
+	err = s.Registry.Join(ctx, "node_1", "expired")
+	t.Require().NoError(err)
+
+	time.Sleep(s.TTL / 2)
+
+	online, err := s.Registry.IsOnline(ctx, "expired")
+	t.Require().NoError(err)
+	t.Require().True(online)
+
+	time.Sleep(s.TTL)
+
+	online, err = s.Registry.IsOnline(ctx, "expired")
+	t.Require().NoError(err)
+	t.Require().False(online)
+}
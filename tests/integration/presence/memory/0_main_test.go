@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"github.com/mandarine-io/backend/internal/infrastructure/presence"
+	"github.com/mandarine-io/backend/internal/infrastructure/presence/memory"
+	"github.com/mandarine-io/backend/tests/integration/presence/conformance"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	ttl = time.Second
+)
+
+var (
+	registry presence.Registry
+)
+
+type MemoryPresenceRegistrySuite struct {
+	suite.Suite
+}
+
+func TestMemoryPresenceRegistrySuite(t *testing.T) {
+	var err error
+	registry, err = memory.NewRegistry(memory.WithTTL(ttl))
+	require.NoError(t, err)
+
+	_, err = memory.NewRegistry(memory.WithTTL(0))
+	require.Error(t, err)
+
+	suite.RunSuite(t, new(MemoryPresenceRegistrySuite))
+}
+
+func (s *MemoryPresenceRegistrySuite) Test(t provider.T) {
+	s.RunSuite(t, &conformance.RegistrySuite{Registry: registry, Feature: "Memory presence registry", TTL: ttl})
+}
@@ -0,0 +1,60 @@
+package redis
+
+import (
+	"context"
+	redis2 "github.com/mandarine-io/backend/internal/infrastructure/cache/redis"
+	"github.com/mandarine-io/backend/internal/infrastructure/presence"
+	redis3 "github.com/mandarine-io/backend/internal/infrastructure/presence/redis"
+	"github.com/mandarine-io/backend/tests/integration"
+	"github.com/mandarine-io/backend/tests/integration/presence/conformance"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	ttl = time.Second
+)
+
+var (
+	ctx      = context.Background()
+	rdb      redis.UniversalClient
+	registry presence.Registry
+)
+
+type RedisPresenceRegistrySuite struct {
+	suite.Suite
+}
+
+func TestRedisPresenceRegistrySuite(t *testing.T) {
+	var err error
+	rdb, err = redis2.NewClient(
+		integration.Cfg.GetRedisConfig(),
+	)
+	require.NoError(t, err)
+
+	registry, err = redis3.NewRegistry(rdb, redis3.WithTTL(ttl))
+	require.NoError(t, err)
+
+	suite.RunSuite(t, new(RedisPresenceRegistrySuite))
+}
+
+func (s *RedisPresenceRegistrySuite) AfterAll(t provider.T) {
+	t.Title("Redis presence registry - after all")
+	t.Feature("Redis presence registry")
+
+	keys, err := rdb.Keys(ctx, "presence:*").Result()
+	t.Require().NoError(err)
+
+	if len(keys) > 0 {
+		err = rdb.Del(ctx, keys...).Err()
+		t.Require().NoError(err)
+	}
+}
+
+func (s *RedisPresenceRegistrySuite) Test(t provider.T) {
+	s.RunSuite(t, &conformance.RegistrySuite{Registry: registry, Feature: "Redis presence registry", TTL: ttl})
+}
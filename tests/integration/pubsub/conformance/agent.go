@@ -0,0 +1,261 @@
+package conformance
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"time"
+)
+
+const (
+	receiveTimeout = 5 * time.Second
+	silenceTimeout = 200 * time.Millisecond
+)
+
+var (
+	ctx = context.Background()
+)
+
+type message struct {
+	Name  string            `json:"name"`
+	Count int               `json:"count"`
+	Tags  []string          `json:"tags"`
+	Meta  map[string]string `json:"meta"`
+}
+
+// AgentSuite checks, that agent behaves as any other backend of pubsub.Agent. It is run against each backend,
+// so consumers receive identical events regardless of backend
+type AgentSuite struct {
+	suite.Suite
+
+	Agent   pubsub.Agent
+	Feature string
+}
+
+func (s *AgentSuite) Test_PublishSubscribe(t provider.T) {
+	t.Title("Publish and subscribe - subscriber receives typed message")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	topic := "conformance:exact"
+	msg := message{Name: "test", Count: 2, Tags: []string{"a", "b"}, Meta: map[string]string{"key": "value"}}
+
+	subscriber, err := s.Agent.Subscribe(ctx, topic)
+	t.Require().NoError(err)
+	defer func() {
+		t.Require().NoError(s.Agent.Unsubscribe(ctx, topic))
+	}()
+
+	err = s.Agent.Publish(ctx, topic, msg)
+	t.Require().NoError(err)
+
+	event := receive(t, subscriber)
+	t.Require().Equal(topic, event.Topic)
+	t.Require().Empty(event.Pattern)
+	t.Require().JSONEq(`{"name":"test","count":2,"tags":["a","b"],"meta":{"key":"value"}}`, string(event.Payload))
+
+	decoded, err := pubsub.DecodeEvent[message](event)
+	t.Require().NoError(err)
+	t.Require().Equal(msg, decoded)
+}
+
+func (s *AgentSuite) Test_PrimitivePayload(t provider.T) {
+	t.Title("Publish and subscribe - primitive message is encoded by codec")
+	t.Severity(allure.NORMAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	topic := "conformance:primitive"
+
+	subscriber, err := s.Agent.Subscribe(ctx, topic)
+	t.Require().NoError(err)
+	defer func() {
+		t.Require().NoError(s.Agent.Unsubscribe(ctx, topic))
+	}()
+
+	err = s.Agent.Publish(ctx, topic, "message")
+	t.Require().NoError(err)
+
+	event := receive(t, subscriber)
+	t.Require().Equal(`"message"`, string(event.Payload))
+
+	decoded, err := pubsub.DecodeEvent[string](event)
+	t.Require().NoError(err)
+	t.Require().Equal("message", decoded)
+}
+
+func (s *AgentSuite) Test_PatternSubscribe(t provider.T) {
+	t.Title("Pattern subscribe - subscriber receives messages of matching topics in order")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	pattern := "conformance:pattern:*"
+
+	subscriber, err := s.Agent.PSubscribe(ctx, pattern)
+	t.Require().NoError(err)
+	defer func() {
+		t.Require().NoError(s.Agent.PUnsubscribe(ctx, pattern))
+	}()
+
+	for i, topic := range []string{"conformance:pattern:a", "conformance:other", "conformance:pattern:b:c"} {
+		err = s.Agent.Publish(ctx, topic, message{Name: topic, Count: i})
+		t.Require().NoError(err)
+	}
+
+	for _, expected := range []message{{Name: "conformance:pattern:a", Count: 0}, {Name: "conformance:pattern:b:c", Count: 2}} {
+		event := receive(t, subscriber)
+		t.Require().Equal(expected.Name, event.Topic)
+		t.Require().Equal(pattern, event.Pattern)
+
+		decoded, err := pubsub.DecodeEvent[message](event)
+		t.Require().NoError(err)
+		t.Require().Equal(expected, decoded)
+	}
+
+	expectSilence(t, subscriber)
+}
+
+func (s *AgentSuite) Test_PatternRules(t provider.T) {
+	t.Title("Pattern subscribe - glob rules of Redis PSUBSCRIBE are supported")
+	t.Severity(allure.NORMAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	prefix := "conformance:glob:"
+	topics := []string{"hello", "hallo", "hillo", "hbllo", "hllo", "h*llo", "heeello"}
+	cases := map[string][]string{
+		`h?llo`:     {"hello", "hallo", "hillo", "hbllo", "h*llo"},
+		`h[ae]llo`:  {"hello", "hallo"},
+		`h[^e]llo`:  {"hallo", "hillo", "hbllo", "h*llo"},
+		`h[a-b]llo`: {"hallo", "hbllo"},
+		`h\*llo`:    {"h*llo"},
+		`h*llo`:     topics,
+	}
+
+	for pattern, expected := range cases {
+		subscriber, err := s.Agent.PSubscribe(ctx, prefix+pattern)
+		t.Require().NoError(err)
+
+		for _, topic := range topics {
+			err = s.Agent.Publish(ctx, prefix+topic, topic)
+			t.Require().NoError(err)
+		}
+
+		for _, topic := range expected {
+			event := receive(t, subscriber)
+			t.Require().Equal(prefix+topic, event.Topic, "pattern: %s", pattern)
+		}
+		expectSilence(t, subscriber)
+
+		t.Require().NoError(s.Agent.PUnsubscribe(ctx, prefix+pattern))
+	}
+}
+
+func (s *AgentSuite) Test_PatternAndTopicSubscribe(t provider.T) {
+	t.Title("Pattern subscribe - message is delivered by each matching subscription")
+	t.Severity(allure.NORMAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	topic := "conformance:both:a"
+	pattern := "conformance:both:?"
+
+	topicSubscriber, err := s.Agent.Subscribe(ctx, topic)
+	t.Require().NoError(err)
+	defer func() {
+		t.Require().NoError(s.Agent.Unsubscribe(ctx, topic))
+	}()
+
+	patternSubscriber, err := s.Agent.PSubscribe(ctx, pattern)
+	t.Require().NoError(err)
+	defer func() {
+		t.Require().NoError(s.Agent.PUnsubscribe(ctx, pattern))
+	}()
+
+	err = s.Agent.Publish(ctx, topic, message{Name: "both"})
+	t.Require().NoError(err)
+
+	event := receive(t, topicSubscriber)
+	t.Require().Equal(topic, event.Topic)
+	t.Require().Empty(event.Pattern)
+
+	event = receive(t, patternSubscriber)
+	t.Require().Equal(topic, event.Topic)
+	t.Require().Equal(pattern, event.Pattern)
+}
+
+func (s *AgentSuite) Test_PublishWithoutSubscribers(t provider.T) {
+	t.Title("Publish - message without subscribers is dropped")
+	t.Severity(allure.NORMAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	err := s.Agent.Publish(ctx, "conformance:nobody", message{Name: "nobody"})
+	t.Require().NoError(err)
+}
+
+func (s *AgentSuite) Test_Unsubscribe(t provider.T) {
+	t.Title("Unsubscribe - channel of subscriber is closed")
+	t.Severity(allure.NORMAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	topic := "conformance:unsubscribe"
+	pattern := "conformance:unsubscribe:*"
+
+	subscriber, err := s.Agent.Subscribe(ctx, topic)
+	t.Require().NoError(err)
+	patternSubscriber, err := s.Agent.PSubscribe(ctx, pattern)
+	t.Require().NoError(err)
+
+	t.Require().NoError(s.Agent.Unsubscribe(ctx, topic))
+	t.Require().NoError(s.Agent.PUnsubscribe(ctx, pattern))
+
+	expectClosed(t, subscriber)
+	expectClosed(t, patternSubscriber)
+}
+
+func (s *AgentSuite) Test_NotFoundTopic(t provider.T) {
+	t.Title("Unsubscribe - not found topic or pattern")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Negative")
+
+	err := s.Agent.Unsubscribe(ctx, "conformance:not-found")
+	t.Require().ErrorIs(err, pubsub.ErrTopicNotFound)
+
+	err = s.Agent.PUnsubscribe(ctx, "conformance:not-found:*")
+	t.Require().ErrorIs(err, pubsub.ErrTopicNotFound)
+}
+
+func receive(t provider.T, ch <-chan pubsub.Event) pubsub.Event {
+	select {
+	case event, ok := <-ch:
+		t.Require().True(ok)
+		return event
+	case <-time.After(receiveTimeout):
+		t.Fatalf("event is not received")
+		return pubsub.Event{}
+	}
+}
+
+func expectSilence(t provider.T, ch <-chan pubsub.Event) {
+	select {
+	case event := <-ch:
+		t.Fatalf("unexpected event of topic %s", event.Topic)
+	case <-time.After(silenceTimeout):
+	}
+}
+
+func expectClosed(t provider.T, ch <-chan pubsub.Event) {
+	select {
+	case _, ok := <-ch:
+		t.Require().False(ok)
+	case <-time.After(receiveTimeout):
+		t.Fatalf("channel is not closed")
+	}
+}
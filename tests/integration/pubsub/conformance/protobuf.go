@@ -0,0 +1,60 @@
+package conformance
+
+import (
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub/protobuf"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ProtobufSuite checks, that agent with protobuf codec delivers protobuf messages
+type ProtobufSuite struct {
+	suite.Suite
+
+	Agent   pubsub.Agent
+	Feature string
+}
+
+func (s *ProtobufSuite) Test_PublishSubscribe(t provider.T) {
+	t.Title("Protobuf codec - subscriber receives protobuf message")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	topic := "conformance:protobuf"
+	msg, err := structpb.NewStruct(map[string]any{"name": "test", "count": 2})
+	t.Require().NoError(err)
+
+	subscriber, err := s.Agent.Subscribe(ctx, topic)
+	t.Require().NoError(err)
+	defer func() {
+		t.Require().NoError(s.Agent.Unsubscribe(ctx, topic))
+	}()
+
+	err = s.Agent.Publish(ctx, topic, msg)
+	t.Require().NoError(err)
+
+	event := receive(t, subscriber)
+	t.Require().Equal(topic, event.Topic)
+
+	encoded, err := proto.Marshal(msg)
+	t.Require().NoError(err)
+	t.Require().Equal(encoded, event.Payload)
+
+	decoded := &structpb.Struct{}
+	t.Require().NoError(event.Decode(decoded))
+	t.Require().True(proto.Equal(msg, decoded))
+}
+
+func (s *ProtobufSuite) Test_NotProtoMessage(t provider.T) {
+	t.Title("Protobuf codec - message, which is not protobuf message, is rejected")
+	t.Severity(allure.NORMAL)
+	t.Feature(s.Feature)
+	t.Tags("Negative")
+
+	err := s.Agent.Publish(ctx, "conformance:protobuf", message{Name: "test"})
+	t.Require().ErrorIs(err, protobuf.ErrNotProtoMessage)
+}
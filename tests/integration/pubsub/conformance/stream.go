@@ -0,0 +1,230 @@
+package conformance
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"time"
+)
+
+// StreamSuite checks delivery guarantees of stream agent. Agent must be created with RedeliveryTimeout
+// and MaxDeliveries, so unacknowledged messages are redelivered and dead-lettered in time of test
+type StreamSuite struct {
+	suite.Suite
+
+	Agent             pubsub.StreamAgent
+	Feature           string
+	RedeliveryTimeout time.Duration
+	MaxDeliveries     int
+}
+
+func (s *StreamSuite) Test_PublishConsume(t provider.T) {
+	t.Title("Stream - messages published before and after creation of group are consumed")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	consumeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	id1, err := s.Agent.Publish(ctx, "stream:publish_consume", []byte("first"))
+	t.Require().NoError(err)
+
+	ch, err := s.Agent.Consume(consumeCtx, "stream:publish_consume", "group", "consumer")
+	t.Require().NoError(err)
+
+	id2, err := s.Agent.Publish(ctx, "stream:publish_consume", []byte("second"))
+	t.Require().NoError(err)
+
+	msg := receiveMessage(t, ch)
+	t.Require().Equal(id1, msg.ID)
+	t.Require().Equal("stream:publish_consume", msg.Stream)
+	t.Require().Equal("group", msg.Group)
+	t.Require().Equal("consumer", msg.Consumer)
+	t.Require().Equal([]byte("first"), msg.Payload)
+	t.Require().Equal(1, msg.Deliveries)
+	t.Require().NoError(s.Agent.Ack(ctx, msg))
+
+	msg = receiveMessage(t, ch)
+	t.Require().Equal(id2, msg.ID)
+	t.Require().Equal([]byte("second"), msg.Payload)
+	t.Require().NoError(s.Agent.Ack(ctx, msg))
+
+	// Acknowledged messages are not delivered again
+	expectNoMessage(t, ch, 2*s.RedeliveryTimeout)
+}
+
+func (s *StreamSuite) Test_Groups(t provider.T) {
+	t.Title("Stream - message is delivered to every group and to one consumer of group")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	consumeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ch1, err := s.Agent.Consume(consumeCtx, "stream:groups", "group_1", "consumer_1")
+	t.Require().NoError(err)
+	ch2, err := s.Agent.Consume(consumeCtx, "stream:groups", "group_1", "consumer_2")
+	t.Require().NoError(err)
+	ch3, err := s.Agent.Consume(consumeCtx, "stream:groups", "group_2", "consumer_1")
+	t.Require().NoError(err)
+
+	_, err = s.Agent.Publish(ctx, "stream:groups", []byte("message"))
+	t.Require().NoError(err)
+
+	msg := receiveMessage(t, ch3)
+	t.Require().Equal("group_2", msg.Group)
+	t.Require().NoError(s.Agent.Ack(ctx, msg))
+
+	var received pubsub.Message
+	select {
+	case received = <-ch1:
+		t.Require().NoError(s.Agent.Ack(ctx, received))
+		expectNoMessage(t, ch2, 2*s.RedeliveryTimeout)
+	case received = <-ch2:
+		t.Require().NoError(s.Agent.Ack(ctx, received))
+		expectNoMessage(t, ch1, 2*s.RedeliveryTimeout)
+	case <-time.After(receiveTimeout):
+		t.Fatalf("message is not received")
+	}
+	t.Require().Equal([]byte("message"), received.Payload)
+}
+
+func (s *StreamSuite) Test_Nack(t provider.T) {
+	t.Title("Stream - negatively acknowledged message is delivered again")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	consumeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ch, err := s.Agent.Consume(consumeCtx, "stream:nack", "group", "consumer")
+	t.Require().NoError(err)
+
+	id, err := s.Agent.Publish(ctx, "stream:nack", []byte("message"))
+	t.Require().NoError(err)
+
+	msg := receiveMessage(t, ch)
+	t.Require().Equal(1, msg.Deliveries)
+	t.Require().NoError(s.Agent.Nack(ctx, msg))
+
+	msg = receiveMessage(t, ch)
+	t.Require().Equal(id, msg.ID)
+	t.Require().Equal(2, msg.Deliveries)
+	t.Require().NoError(s.Agent.Ack(ctx, msg))
+
+	expectNoMessage(t, ch, 2*s.RedeliveryTimeout)
+}
+
+func (s *StreamSuite) Test_Redelivery(t provider.T) {
+	t.Title("Stream - message is delivered to other consumer, if it is not acknowledged in time")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	consumeCtx1, cancel1 := context.WithCancel(ctx)
+	defer cancel1()
+
+	ch1, err := s.Agent.Consume(consumeCtx1, "stream:redelivery", "group", "consumer_1")
+	t.Require().NoError(err)
+
+	id, err := s.Agent.Publish(ctx, "stream:redelivery", []byte("message"))
+	t.Require().NoError(err)
+
+	msg := receiveMessage(t, ch1)
+	t.Require().Equal(id, msg.ID)
+
+	// The first consumer crashes without acknowledgement
+	cancel1()
+
+	consumeCtx2, cancel2 := context.WithCancel(ctx)
+	defer cancel2()
+
+	ch2, err := s.Agent.Consume(consumeCtx2, "stream:redelivery", "group", "consumer_2")
+	t.Require().NoError(err)
+
+	msg = receiveMessage(t, ch2)
+	t.Require().Equal(id, msg.ID)
+	t.Require().Equal("consumer_2", msg.Consumer)
+	t.Require().Equal(2, msg.Deliveries)
+	t.Require().NoError(s.Agent.Ack(ctx, msg))
+}
+
+func (s *StreamSuite) Test_DeadLetter(t provider.T) {
+	t.Title("Stream - message is moved to dead-letter stream after max deliveries")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Negative")
+
+	consumeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ch, err := s.Agent.Consume(consumeCtx, "stream:dead_letter", "group", "consumer")
+	t.Require().NoError(err)
+	deadCh, err := s.Agent.Consume(
+		consumeCtx,
+		pubsub.DeadLetterStream("stream:dead_letter"),
+		"group",
+		"consumer",
+	)
+	t.Require().NoError(err)
+
+	_, err = s.Agent.Publish(ctx, "stream:dead_letter", []byte("message"))
+	t.Require().NoError(err)
+
+	for i := 1; i <= s.MaxDeliveries; i++ {
+		msg := receiveMessage(t, ch)
+		t.Require().Equal(i, msg.Deliveries)
+		t.Require().NoError(s.Agent.Nack(ctx, msg))
+	}
+
+	msg := receiveMessage(t, deadCh)
+	t.Require().Equal([]byte("message"), msg.Payload)
+	t.Require().NoError(s.Agent.Ack(ctx, msg))
+
+	expectNoMessage(t, ch, 2*s.RedeliveryTimeout)
+}
+
+func (s *StreamSuite) Test_CanceledConsumer(t provider.T) {
+	t.Title("Stream - channel of consumer is closed, when context is canceled")
+	t.Severity(allure.NORMAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	consumeCtx, cancel := context.WithCancel(ctx)
+
+	ch, err := s.Agent.Consume(consumeCtx, "stream:canceled", "group", "consumer")
+	t.Require().NoError(err)
+
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		t.Require().False(ok)
+	case <-time.After(receiveTimeout):
+		t.Fatalf("channel is not closed")
+	}
+}
+
+func receiveMessage(t provider.T, ch <-chan pubsub.Message) pubsub.Message {
+	select {
+	case msg, ok := <-ch:
+		t.Require().True(ok)
+		return msg
+	case <-time.After(receiveTimeout):
+		t.Fatalf("message is not received")
+		return pubsub.Message{}
+	}
+}
+
+func expectNoMessage(t provider.T, ch <-chan pubsub.Message, d time.Duration) {
+	select {
+	case msg := <-ch:
+		t.Fatalf("unexpected message %s", msg.ID)
+	case <-time.After(d):
+	}
+}
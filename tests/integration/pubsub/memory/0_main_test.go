@@ -1,18 +1,26 @@
 package memory
 
 import (
-	"context"
 	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
 	"github.com/mandarine-io/backend/internal/infrastructure/pubsub/memory"
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub/protobuf"
+	"github.com/mandarine-io/backend/tests/integration/pubsub/conformance"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/suite"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
+)
+
+const (
+	redeliveryTimeout = 200 * time.Millisecond
+	maxDeliveries     = 3
 )
 
 var (
-	ctx   = context.Background()
-	agent pubsub.Agent
+	agent       pubsub.Agent
+	protoAgent  pubsub.Agent
+	streamAgent pubsub.StreamAgent
 )
 
 type MemoryPubSubSuite struct {
@@ -24,9 +32,31 @@ func TestRedisPubSubSuite(t *testing.T) {
 	agent, err = memory.NewAgent()
 	require.NoError(t, err)
 
+	protoAgent, err = memory.NewAgent(memory.WithCodec(protobuf.NewCodec()))
+	require.NoError(t, err)
+
+	streamAgent, err = memory.NewStreamAgent(
+		memory.WithRedeliveryTimeout(redeliveryTimeout),
+		memory.WithMaxDeliveries(maxDeliveries),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, streamAgent.Close())
+	}()
+
+	_, err = memory.NewStreamAgent(memory.WithMaxDeliveries(0))
+	require.Error(t, err)
+
 	suite.RunSuite(t, new(MemoryPubSubSuite))
 }
 
 func (s *MemoryPubSubSuite) Test(t provider.T) {
-	s.RunSuite(t, new(PublishSubscribeSuite))
+	s.RunSuite(t, &conformance.AgentSuite{Agent: agent, Feature: "Memory pubsub"})
+	s.RunSuite(t, &conformance.ProtobufSuite{Agent: protoAgent, Feature: "Memory pubsub"})
+	s.RunSuite(t, &conformance.StreamSuite{
+		Agent:             streamAgent,
+		Feature:           "Memory stream agent",
+		RedeliveryTimeout: redeliveryTimeout,
+		MaxDeliveries:     maxDeliveries,
+	})
 }
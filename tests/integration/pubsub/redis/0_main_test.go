@@ -4,19 +4,29 @@ import (
 	"context"
 	redis2 "github.com/mandarine-io/backend/internal/infrastructure/cache/redis"
 	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub/protobuf"
 	redis3 "github.com/mandarine-io/backend/internal/infrastructure/pubsub/redis"
 	"github.com/mandarine-io/backend/tests/integration"
+	"github.com/mandarine-io/backend/tests/integration/pubsub/conformance"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/suite"
 	"github.com/redis/go-redis/v9"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
+)
+
+const (
+	redeliveryTimeout = 200 * time.Millisecond
+	maxDeliveries     = 3
 )
 
 var (
-	ctx   = context.Background()
-	rdb   redis.UniversalClient
-	agent pubsub.Agent
+	ctx         = context.Background()
+	rdb         redis.UniversalClient
+	agent       pubsub.Agent
+	protoAgent  pubsub.Agent
+	streamAgent pubsub.StreamAgent
 )
 
 type RedisPubSubSuite struct {
@@ -33,9 +43,42 @@ func TestRedisPubSubSuite(t *testing.T) {
 	agent, err = redis3.NewAgent(rdb)
 	require.NoError(t, err)
 
+	protoAgent, err = redis3.NewAgent(rdb, redis3.WithCodec(protobuf.NewCodec()))
+	require.NoError(t, err)
+
+	streamAgent, err = redis3.NewStreamAgent(
+		rdb,
+		redis3.WithRedeliveryTimeout(redeliveryTimeout),
+		redis3.WithMaxDeliveries(maxDeliveries),
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, streamAgent.Close())
+	}()
+
 	suite.RunSuite(t, new(RedisPubSubSuite))
 }
 
+func (s *RedisPubSubSuite) AfterAll(t provider.T) {
+	t.Title("Redis pubsub - after all")
+	t.Feature("Redis pubsub")
+
+	keys, err := rdb.Keys(ctx, "stream:*").Result()
+	t.Require().NoError(err)
+
+	if len(keys) > 0 {
+		err = rdb.Del(ctx, keys...).Err()
+		t.Require().NoError(err)
+	}
+}
+
 func (s *RedisPubSubSuite) Test(t provider.T) {
-	s.RunSuite(t, new(PublishSubscribeSuite))
+	s.RunSuite(t, &conformance.AgentSuite{Agent: agent, Feature: "Redis pubsub"})
+	s.RunSuite(t, &conformance.ProtobufSuite{Agent: protoAgent, Feature: "Redis pubsub"})
+	s.RunSuite(t, &conformance.StreamSuite{
+		Agent:             streamAgent,
+		Feature:           "Redis stream agent",
+		RedeliveryTimeout: redeliveryTimeout,
+		MaxDeliveries:     maxDeliveries,
+	})
 }
@@ -0,0 +1,65 @@
+package conformance
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/infrastructure/ratelimit"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"time"
+)
+
+var (
+	ctx = context.Background()
+)
+
+// SlidingWindowSuite checks limiter with sliding window algorithm, it is run against each backend
+type SlidingWindowSuite struct {
+	suite.Suite
+
+	Limiter ratelimit.Limiter
+	Feature string
+}
+
+func (s *SlidingWindowSuite) Test_Success(t provider.T) {
+	t.Title("Sliding window - allows requests within limit")
+	t.Severity(allure.NORMAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	limit := ratelimit.Limit{Rate: 3, Period: time.Minute}
+
+	for i := 0; i < limit.Rate; i++ {
+		res, err := s.Limiter.Allow(ctx, "sliding_window_success", limit)
+		t.Require().NoError(err)
+		t.Require().True(res.Allowed)
+		t.Require().Equal(limit.Rate, res.Limit)
+		t.Require().Zero(res.RetryAfter)
+	}
+}
+
+func (s *SlidingWindowSuite) Test_Rejected(t provider.T) {
+	t.Title("Sliding window - rejects requests over limit")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Negative")
+
+	limit := ratelimit.Limit{Rate: 3, Period: time.Minute}
+
+	for i := 0; i < limit.Rate; i++ {
+		_, err := s.Limiter.Allow(ctx, "sliding_window_rejected", limit)
+		t.Require().NoError(err)
+	}
+
+	res, err := s.Limiter.Allow(ctx, "sliding_window_rejected", limit)
+	t.Require().NoError(err)
+	t.Require().False(res.Allowed)
+	t.Require().Zero(res.Remaining)
+	t.Require().Greater(res.RetryAfter, time.Duration(0))
+	t.Require().LessOrEqual(res.RetryAfter, 2*limit.Period)
+
+	// Another key has own quota
+	res, err = s.Limiter.Allow(ctx, "sliding_window_another", limit)
+	t.Require().NoError(err)
+	t.Require().True(res.Allowed)
+}
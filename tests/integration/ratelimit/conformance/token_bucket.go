@@ -0,0 +1,74 @@
+package conformance
+
+import (
+	"github.com/mandarine-io/backend/internal/infrastructure/ratelimit"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"time"
+)
+
+// TokenBucketSuite checks limiter with token bucket algorithm, it is run against each backend
+type TokenBucketSuite struct {
+	suite.Suite
+
+	Limiter ratelimit.Limiter
+	Feature string
+}
+
+func (s *TokenBucketSuite) Test_Success(t provider.T) {
+	t.Title("Token bucket - allows burst within capacity")
+	t.Severity(allure.NORMAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	limit := ratelimit.Limit{Rate: 3, Period: time.Minute}
+
+	for i := 0; i < limit.Rate; i++ {
+		res, err := s.Limiter.Allow(ctx, "token_bucket_success", limit)
+		t.Require().NoError(err)
+		t.Require().True(res.Allowed)
+		t.Require().Equal(limit.Rate-i-1, res.Remaining)
+	}
+}
+
+func (s *TokenBucketSuite) Test_Rejected(t provider.T) {
+	t.Title("Token bucket - rejects requests, when bucket is empty")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Negative")
+
+	limit := ratelimit.Limit{Rate: 3, Period: time.Minute}
+
+	for i := 0; i < limit.Rate; i++ {
+		_, err := s.Limiter.Allow(ctx, "token_bucket_rejected", limit)
+		t.Require().NoError(err)
+	}
+
+	res, err := s.Limiter.Allow(ctx, "token_bucket_rejected", limit)
+	t.Require().NoError(err)
+	t.Require().False(res.Allowed)
+	t.Require().Zero(res.Remaining)
+	t.Require().Greater(res.RetryAfter, time.Duration(0))
+	t.Require().LessOrEqual(res.RetryAfter, limit.Period/time.Duration(limit.Rate))
+}
+
+func (s *TokenBucketSuite) Test_Refill(t provider.T) {
+	t.Title("Token bucket - refills tokens over time")
+	t.Severity(allure.NORMAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	limit := ratelimit.Limit{Rate: 2, Period: time.Second}
+
+	for i := 0; i < limit.Rate; i++ {
+		_, err := s.Limiter.Allow(ctx, "token_bucket_refill", limit)
+		t.Require().NoError(err)
+	}
+
+	time.Sleep(limit.Period / time.Duration(limit.Rate))
+
+	res, err := s.Limiter.Allow(ctx, "token_bucket_refill", limit)
+	t.Require().NoError(err)
+	t.Require().True(res.Allowed)
+}
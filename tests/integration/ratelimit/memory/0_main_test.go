@@ -0,0 +1,39 @@
+package memory
+
+import (
+	"github.com/mandarine-io/backend/internal/infrastructure/ratelimit"
+	"github.com/mandarine-io/backend/internal/infrastructure/ratelimit/memory"
+	"github.com/mandarine-io/backend/tests/integration/ratelimit/conformance"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	slidingWindowLimiter ratelimit.Limiter
+	tokenBucketLimiter   ratelimit.Limiter
+)
+
+type MemoryRateLimiterSuite struct {
+	suite.Suite
+}
+
+func TestMemoryRateLimiterSuite(t *testing.T) {
+	var err error
+	slidingWindowLimiter, err = memory.NewLimiter(memory.WithAlgorithm(ratelimit.SlidingWindowAlgorithm))
+	require.NoError(t, err)
+
+	tokenBucketLimiter, err = memory.NewLimiter(memory.WithAlgorithm(ratelimit.TokenBucketAlgorithm))
+	require.NoError(t, err)
+
+	_, err = memory.NewLimiter(memory.WithAlgorithm("unknown"))
+	require.ErrorIs(t, err, ratelimit.ErrUnknownAlgorithm)
+
+	suite.RunSuite(t, new(MemoryRateLimiterSuite))
+}
+
+func (s *MemoryRateLimiterSuite) Test(t provider.T) {
+	s.RunSuite(t, &conformance.SlidingWindowSuite{Limiter: slidingWindowLimiter, Feature: "Memory rate limiter"})
+	s.RunSuite(t, &conformance.TokenBucketSuite{Limiter: tokenBucketLimiter, Feature: "Memory rate limiter"})
+}
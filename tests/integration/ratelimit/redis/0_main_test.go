@@ -0,0 +1,63 @@
+package redis
+
+import (
+	"context"
+	redis2 "github.com/mandarine-io/backend/internal/infrastructure/cache/redis"
+	"github.com/mandarine-io/backend/internal/infrastructure/ratelimit"
+	redis3 "github.com/mandarine-io/backend/internal/infrastructure/ratelimit/redis"
+	"github.com/mandarine-io/backend/tests/integration"
+	"github.com/mandarine-io/backend/tests/integration/ratelimit/conformance"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	ctx                  = context.Background()
+	rdb                  redis.UniversalClient
+	slidingWindowLimiter ratelimit.Limiter
+	tokenBucketLimiter   ratelimit.Limiter
+)
+
+type RedisRateLimiterSuite struct {
+	suite.Suite
+}
+
+func TestRedisRateLimiterSuite(t *testing.T) {
+	var err error
+	rdb, err = redis2.NewClient(
+		integration.Cfg.GetRedisConfig(),
+	)
+	require.NoError(t, err)
+
+	slidingWindowLimiter, err = redis3.NewLimiter(rdb, redis3.WithAlgorithm(ratelimit.SlidingWindowAlgorithm))
+	require.NoError(t, err)
+
+	tokenBucketLimiter, err = redis3.NewLimiter(rdb, redis3.WithAlgorithm(ratelimit.TokenBucketAlgorithm))
+	require.NoError(t, err)
+
+	suite.RunSuite(t, new(RedisRateLimiterSuite))
+}
+
+func (s *RedisRateLimiterSuite) AfterAll(t provider.T) {
+	t.Title("Redis rate limiter - after all")
+	t.Feature("Redis rate limiter")
+
+	keys, err := rdb.Keys(ctx, "sliding_window_*").Result()
+	t.Require().NoError(err)
+	tokenBucketKeys, err := rdb.Keys(ctx, "token_bucket_*").Result()
+	t.Require().NoError(err)
+
+	keys = append(keys, tokenBucketKeys...)
+	if len(keys) > 0 {
+		err = rdb.Del(ctx, keys...).Err()
+		t.Require().NoError(err)
+	}
+}
+
+func (s *RedisRateLimiterSuite) Test(t provider.T) {
+	s.RunSuite(t, &conformance.SlidingWindowSuite{Limiter: slidingWindowLimiter, Feature: "Redis rate limiter"})
+	s.RunSuite(t, &conformance.TokenBucketSuite{Limiter: tokenBucketLimiter, Feature: "Redis rate limiter"})
+}
@@ -0,0 +1,116 @@
+package conformance
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/infrastructure/replay"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"time"
+)
+
+var (
+	ctx = context.Background()
+)
+
+// BufferSuite checks, that buffer behaves as any other backend of replay.Buffer.
+// Buffer must be created with Size and TTL, so old events are evicted and expire in time of test
+type BufferSuite struct {
+	suite.Suite
+
+	Buffer  replay.Buffer
+	Feature string
+	Size    int
+	TTL     time.Duration
+}
+
+func (s *BufferSuite) Test_AppendSince(t provider.T) {
+	t.Title("Buffer - events after last event are returned in order")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	firstID, err := s.Buffer.Append(ctx, "append_since", "", []byte("first"))
+	t.Require().NoError(err)
+	secondID, err := s.Buffer.Append(ctx, "append_since", "notifications", []byte("second"))
+	t.Require().NoError(err)
+	thirdID, err := s.Buffer.Append(ctx, "append_since", "", []byte("third"))
+	t.Require().NoError(err)
+
+	events, err := s.Buffer.Since(ctx, "append_since", firstID)
+	t.Require().NoError(err)
+	t.Require().Equal(
+		[]replay.Event{
+			{ID: secondID, Topic: "notifications", Payload: []byte("second")},
+			{ID: thirdID, Payload: []byte("third")},
+		},
+		events,
+	)
+
+	events, err = s.Buffer.Since(ctx, "append_since", thirdID)
+	t.Require().NoError(err)
+	t.Require().Empty(events)
+}
+
+func (s *BufferSuite) Test_Size(t provider.T) {
+	t.Title("Buffer - only last events are kept")
+	t.Severity(allure.NORMAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	firstID, err := s.Buffer.Append(ctx, "size", "", []byte("0"))
+	t.Require().NoError(err)
+	for i := 1; i <= s.Size; i++ {
+		_, err = s.Buffer.Append(ctx, "size", "", []byte{byte('0' + i)})
+		t.Require().NoError(err)
+	}
+
+	events, err := s.Buffer.Since(ctx, "size", firstID)
+	t.Require().NoError(err)
+	t.Require().Len(events, s.Size)
+	t.Require().Equal([]byte("1"), events[0].Payload)
+}
+
+func (s *BufferSuite) Test_OtherClient(t provider.T) {
+	t.Title("Buffer - events of other client are not returned")
+	t.Severity(allure.CRITICAL)
+	t.Feature(s.Feature)
+	t.Tags("Negative")
+
+	id, err := s.Buffer.Append(ctx, "other_client_1", "", []byte("first"))
+	t.Require().NoError(err)
+	_, err = s.Buffer.Append(ctx, "other_client_1", "", []byte("second"))
+	t.Require().NoError(err)
+
+	events, err := s.Buffer.Since(ctx, "other_client_2", id)
+	t.Require().NoError(err)
+	t.Require().Empty(events)
+}
+
+func (s *BufferSuite) Test_InvalidEventID(t provider.T) {
+	t.Title("Buffer - invalid event identifier")
+	t.Severity(allure.NORMAL)
+	t.Feature(s.Feature)
+	t.Tags("Negative")
+
+	_, err := s.Buffer.Since(ctx, "invalid_event_id", "invalid")
+	t.Require().ErrorIs(err, replay.ErrInvalidEventID)
+}
+
+func (s *BufferSuite) Test_Expired(t provider.T) {
+	t.Title("Buffer - events expire if client does not receive new ones")
+	t.Severity(allure.NORMAL)
+	t.Feature(s.Feature)
+	t.Tags("Positive")
+
+	id, err := s.Buffer.Append(ctx, "expired", "", []byte("first"))
+	t.Require().NoError(err)
+	_, err = s.Buffer.Append(ctx, "expired", "", []byte("second"))
+	t.Require().NoError(err)
+
+	time.Sleep(s.TTL + 100*time.Millisecond)
+
+	events, err := s.Buffer.Since(ctx, "expired", id)
+	t.Require().NoError(err)
+	t.Require().Empty(events)
+}
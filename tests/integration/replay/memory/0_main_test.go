@@ -0,0 +1,40 @@
+package memory
+
+import (
+	"github.com/mandarine-io/backend/internal/infrastructure/replay"
+	"github.com/mandarine-io/backend/internal/infrastructure/replay/memory"
+	"github.com/mandarine-io/backend/tests/integration/replay/conformance"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	size = 3
+	ttl  = time.Second
+)
+
+var (
+	buffer replay.Buffer
+)
+
+type MemoryReplayBufferSuite struct {
+	suite.Suite
+}
+
+func TestMemoryReplayBufferSuite(t *testing.T) {
+	var err error
+	buffer, err = memory.NewBuffer(memory.WithSize(size), memory.WithTTL(ttl))
+	require.NoError(t, err)
+
+	_, err = memory.NewBuffer(memory.WithSize(0))
+	require.Error(t, err)
+
+	suite.RunSuite(t, new(MemoryReplayBufferSuite))
+}
+
+func (s *MemoryReplayBufferSuite) Test(t provider.T) {
+	s.RunSuite(t, &conformance.BufferSuite{Buffer: buffer, Feature: "Memory replay buffer", Size: size, TTL: ttl})
+}
@@ -0,0 +1,61 @@
+package redis
+
+import (
+	"context"
+	redis2 "github.com/mandarine-io/backend/internal/infrastructure/cache/redis"
+	"github.com/mandarine-io/backend/internal/infrastructure/replay"
+	redis3 "github.com/mandarine-io/backend/internal/infrastructure/replay/redis"
+	"github.com/mandarine-io/backend/tests/integration"
+	"github.com/mandarine-io/backend/tests/integration/replay/conformance"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	size = 3
+	ttl  = time.Second
+)
+
+var (
+	ctx    = context.Background()
+	rdb    redis.UniversalClient
+	buffer replay.Buffer
+)
+
+type RedisReplayBufferSuite struct {
+	suite.Suite
+}
+
+func TestRedisReplayBufferSuite(t *testing.T) {
+	var err error
+	rdb, err = redis2.NewClient(
+		integration.Cfg.GetRedisConfig(),
+	)
+	require.NoError(t, err)
+
+	buffer, err = redis3.NewBuffer(rdb, redis3.WithSize(size), redis3.WithTTL(ttl))
+	require.NoError(t, err)
+
+	suite.RunSuite(t, new(RedisReplayBufferSuite))
+}
+
+func (s *RedisReplayBufferSuite) AfterAll(t provider.T) {
+	t.Title("Redis replay buffer - after all")
+	t.Feature("Redis replay buffer")
+
+	keys, err := rdb.Keys(ctx, "replay:*").Result()
+	t.Require().NoError(err)
+
+	if len(keys) > 0 {
+		err = rdb.Del(ctx, keys...).Err()
+		t.Require().NoError(err)
+	}
+}
+
+func (s *RedisReplayBufferSuite) Test(t provider.T) {
+	s.RunSuite(t, &conformance.BufferSuite{Buffer: buffer, Feature: "Redis replay buffer", Size: size, TTL: ttl})
+}
@@ -0,0 +1,91 @@
+package http
+
+import (
+	"context"
+	"github.com/goccy/go-json"
+	"github.com/mandarine-io/backend/internal/infrastructure/sms"
+	smshttp "github.com/mandarine-io/backend/internal/infrastructure/sms/http"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	apiKey       = "api-key"
+	failedNumber = "+79990000000"
+)
+
+var (
+	ctx    = context.Background()
+	sender sms.Sender
+
+	lock     sync.Mutex
+	requests = make(map[string]receivedRequest)
+)
+
+type receivedRequest struct {
+	Path          string
+	Authorization string
+	Body          map[string]string
+}
+
+type HTTPSMSSenderSuite struct {
+	suite.Suite
+}
+
+func TestHTTPSMSSenderSuite(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := make(map[string]string)
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		lock.Lock()
+		requests[body["to"]] = receivedRequest{
+			Path:          r.URL.Path,
+			Authorization: r.Header.Get("Authorization"),
+			Body:          body,
+		}
+		lock.Unlock()
+
+		if body["to"] == failedNumber {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"error":"invalid number"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	_, err := smshttp.NewSender(smshttp.Config{})
+	require.ErrorIs(t, err, smshttp.ErrEmptyBaseURL)
+
+	sender, err = smshttp.NewSender(smshttp.Config{
+		BaseURL: server.URL + "/",
+		APIKey:  apiKey,
+		From:    "Mandarine",
+		Timeout: 5 * time.Second,
+	})
+	require.NoError(t, err)
+
+	suite.RunSuite(t, new(HTTPSMSSenderSuite))
+}
+
+func (s *HTTPSMSSenderSuite) Test(t provider.T) {
+	s.RunSuite(t, new(SendMessageSuite))
+}
+
+func getRequest(to string) (receivedRequest, bool) {
+	lock.Lock()
+	defer lock.Unlock()
+
+	req, ok := requests[to]
+	return req, ok
+}
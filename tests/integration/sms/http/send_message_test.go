@@ -0,0 +1,40 @@
+package http
+
+import (
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type SendMessageSuite struct {
+	suite.Suite
+}
+
+func (s *SendMessageSuite) Test_Success(t provider.T) {
+	t.Title("Send message - success")
+	t.Severity(allure.NORMAL)
+	t.Feature("HTTP SMS sender")
+	t.Tags("Positive")
+
+	err := sender.SendMessage(ctx, "+79991234567", "content")
+	t.Require().NoError(err)
+
+	req, ok := getRequest("+79991234567")
+	t.Require().True(ok)
+	t.Require().Equal("/messages", req.Path)
+	t.Require().Equal("Bearer "+apiKey, req.Authorization)
+	t.Require().Equal("Mandarine", req.Body["from"])
+	t.Require().Equal("content", req.Body["text"])
+}
+
+func (s *SendMessageSuite) Test_ProviderError(t provider.T) {
+	t.Title("Send message - provider returns error status")
+	t.Severity(allure.CRITICAL)
+	t.Feature("HTTP SMS sender")
+	t.Tags("Negative")
+
+	err := sender.SendMessage(ctx, failedNumber, "content")
+	t.Require().Error(err)
+	t.Require().Contains(err.Error(), "status 422")
+	t.Require().Contains(err.Error(), "invalid number")
+}
@@ -0,0 +1,28 @@
+package memory
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/infrastructure/sms/memory"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"testing"
+)
+
+var (
+	ctx    = context.Background()
+	sender *memory.Sender
+)
+
+type MemorySMSSenderSuite struct {
+	suite.Suite
+}
+
+func TestMemorySMSSenderSuite(t *testing.T) {
+	sender = memory.NewSender()
+
+	suite.RunSuite(t, new(MemorySMSSenderSuite))
+}
+
+func (s *MemorySMSSenderSuite) Test(t provider.T) {
+	s.RunSuite(t, new(SendMessageSuite))
+}
@@ -0,0 +1,40 @@
+package memory
+
+import (
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type SendMessageSuite struct {
+	suite.Suite
+}
+
+func (s *SendMessageSuite) Test_Success(t provider.T) {
+	t.Title("Send message - messages are kept in order of sending")
+	t.Severity(allure.NORMAL)
+	t.Feature("Memory SMS sender")
+	t.Tags("Positive")
+
+	err := sender.SendMessage(ctx, "+79991234567", "first")
+	t.Require().NoError(err)
+	err = sender.SendMessage(ctx, "+79991234567", "second")
+	t.Require().NoError(err)
+
+	messages := sender.Messages("+79991234567")
+	t.Require().Len(messages, 2)
+	t.Require().Equal("first", messages[0].Content)
+	t.Require().Equal("second", messages[1].Content)
+}
+
+func (s *SendMessageSuite) Test_AnotherNumber(t provider.T) {
+	t.Title("Send message - messages of another number are not returned")
+	t.Severity(allure.NORMAL)
+	t.Feature("Memory SMS sender")
+	t.Tags("Positive")
+
+	err := sender.SendMessage(ctx, "+79991234568", "content")
+	t.Require().NoError(err)
+
+	t.Require().Empty(sender.Messages("+79991234569"))
+}
@@ -2,6 +2,10 @@ package websocket
 
 import (
 	"github.com/gin-gonic/gin"
+	"github.com/mandarine-io/backend/internal/infrastructure/presence"
+	memorypresence "github.com/mandarine-io/backend/internal/infrastructure/presence/memory"
+	"github.com/mandarine-io/backend/internal/infrastructure/pubsub"
+	memorypubsub "github.com/mandarine-io/backend/internal/infrastructure/pubsub/memory"
 	"github.com/mandarine-io/backend/internal/infrastructure/websocket"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/suite"
@@ -17,6 +21,10 @@ const (
 var (
 	pool   *websocket.Pool
 	server *httptest.Server
+
+	// Second node shares pub/sub agent and presence registry with the first one
+	pool2   *websocket.Pool
+	server2 *httptest.Server
 )
 
 type WebsocketPoolSuite struct {
@@ -24,25 +32,55 @@ type WebsocketPoolSuite struct {
 }
 
 func TestWebsocketPoolSuite(t *testing.T) {
-	var err error
-	pool, err = websocket.NewPool(poolSize)
+	agent, err := memorypubsub.NewAgent()
+	require.NoError(t, err)
+	registry, err := memorypresence.NewRegistry()
 	require.NoError(t, err)
 
+	pool, server, err = newNode(agent, registry)
+	require.NoError(t, err)
+	defer server.Close()
+
+	pool2, server2, err = newNode(agent, registry)
+	require.NoError(t, err)
+	defer server2.Close()
+
+	suite.RunSuite(t, new(WebsocketPoolSuite))
+}
+
+func newNode(
+	agent pubsub.Agent,
+	registry presence.Registry,
+	opts ...websocket.Option,
+) (*websocket.Pool, *httptest.Server, error) {
+	opts = append(opts, websocket.WithPubSub(agent), websocket.WithPresence(registry))
+	p, err := websocket.NewPool(poolSize, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	gin.SetMode(gin.ReleaseMode)
 	router := gin.New()
 
 	router.GET(
 		"/ws/:id", func(c *gin.Context) {
-			_ = pool.Register(c.Param("id"), c.Request, c.Writer)
+			_, _ = p.Register(c.Param("id"), c.Request, c.Writer)
+		},
+	)
+	router.GET(
+		"/sse/:id", func(c *gin.Context) {
+			_ = p.Stream(c.Param("id"), c.QueryArray("topic"), c.GetHeader("Last-Event-ID"), c.Request, c.Writer)
 		},
 	)
 
-	server = httptest.NewServer(router)
-	defer server.Close()
-
-	suite.RunSuite(t, new(WebsocketPoolSuite))
+	return p, httptest.NewServer(router), nil
 }
 
 func (s *WebsocketPoolSuite) Test(t provider.T) {
 	s.RunSuite(t, new(RegisterSendReceiveSuite))
+	s.RunSuite(t, new(FanOutSuite))
+	s.RunSuite(t, new(MultipleConnectionsSuite))
+	s.RunSuite(t, new(SlowConsumerSuite))
+	s.RunSuite(t, new(ProtocolSuite))
+	s.RunSuite(t, new(SSESuite))
 }
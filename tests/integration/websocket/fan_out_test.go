@@ -0,0 +1,113 @@
+package websocket
+
+import (
+	"context"
+	"github.com/gorilla/websocket"
+	memorypresence "github.com/mandarine-io/backend/internal/infrastructure/presence/memory"
+	memorypubsub "github.com/mandarine-io/backend/internal/infrastructure/pubsub/memory"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type FanOutSuite struct {
+	suite.Suite
+}
+
+func (suite *FanOutSuite) Test_SendToAnotherNode(t provider.T) {
+	t.Title("Fan out - send message to client of another node")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Websocket pool")
+	t.Tags("Positive")
+
+	c := dial(t, server2, "fan_out_send")
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(c)
+
+	online, err := pool.IsOnline(context.Background(), "fan_out_send")
+	t.Require().NoError(err)
+	t.Require().True(online)
+
+	err = pool.Send(context.Background(), "fan_out_send", []byte("Hello"))
+	t.Require().NoError(err)
+
+	_ = c.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, message, err := c.ReadMessage()
+	t.Require().NoError(err)
+	t.Require().Equal("Hello", string(message))
+}
+
+func (suite *FanOutSuite) Test_Broadcast(t provider.T) {
+	t.Title("Fan out - broadcast message to clients of all nodes")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Websocket pool")
+	t.Tags("Positive")
+
+	// Separate nodes, so that broadcast does not reach clients of other tests
+	agent, err := memorypubsub.NewAgent()
+	t.Require().NoError(err)
+	registry, err := memorypresence.NewRegistry()
+	t.Require().NoError(err)
+
+	node1, srv1, err := newNode(agent, registry)
+	t.Require().NoError(err)
+	defer srv1.Close()
+	_, srv2, err := newNode(agent, registry)
+	t.Require().NoError(err)
+	defer srv2.Close()
+
+	c1 := dial(t, srv1, "broadcast_1")
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(c1)
+	c2 := dial(t, srv2, "broadcast_2")
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(c2)
+
+	err = node1.Broadcast(context.Background(), []byte("Hello"))
+	t.Require().NoError(err)
+
+	for _, c := range []*websocket.Conn{c1, c2} {
+		_ = c.SetReadDeadline(time.Now().Add(5 * time.Second))
+		_, message, err := c.ReadMessage()
+		t.Require().NoError(err)
+		t.Require().Equal("Hello", string(message))
+	}
+}
+
+func (suite *FanOutSuite) Test_Offline(t provider.T) {
+	t.Title("Fan out - client is offline after disconnect")
+	t.Severity(allure.NORMAL)
+	t.Feature("Websocket pool")
+	t.Tags("Positive")
+
+	c := dial(t, server2, "fan_out_offline")
+	err := c.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	t.Require().NoError(err)
+	_ = c.Close()
+
+	t.Require().Eventually(
+		func() bool {
+			online, err := pool.IsOnline(context.Background(), "fan_out_offline")
+			return err == nil && !online
+		}, 5*time.Second, 50*time.Millisecond,
+	)
+}
+
+func dial(t provider.T, srv *httptest.Server, clientID string) *websocket.Conn {
+	u := url.URL{
+		Scheme: "ws",
+		Host:   strings.Replace(srv.URL, "http://", "", 1),
+		Path:   "/ws/" + clientID,
+	}
+	c, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	t.Require().NoError(err)
+
+	return c
+}
@@ -0,0 +1,88 @@
+package websocket
+
+import (
+	"context"
+	"github.com/gorilla/websocket"
+	websocket2 "github.com/mandarine-io/backend/internal/infrastructure/websocket"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type MultipleConnectionsSuite struct {
+	suite.Suite
+}
+
+func (suite *MultipleConnectionsSuite) Test_SendToAllConnections(t provider.T) {
+	t.Title("Multiple connections - send message to all connections of client")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Websocket pool")
+	t.Tags("Positive")
+
+	u := url.URL{
+		Scheme: "ws",
+		Host:   strings.Replace(server2.URL, "http://", "", 1),
+		Path:   "/ws/multiple_send",
+	}
+	c1, resp1, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	t.Require().NoError(err)
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(c1)
+	c2, resp2, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	t.Require().NoError(err)
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(c2)
+
+	connID1 := resp1.Header.Get(websocket2.ConnectionIDHeader)
+	connID2 := resp2.Header.Get(websocket2.ConnectionIDHeader)
+	t.Require().NotEmpty(connID1)
+	t.Require().NotEmpty(connID2)
+	t.Require().NotEqual(connID1, connID2)
+
+	err = pool.Send(context.Background(), "multiple_send", []byte("Hello"))
+	t.Require().NoError(err)
+
+	for _, c := range []*websocket.Conn{c1, c2} {
+		_ = c.SetReadDeadline(time.Now().Add(5 * time.Second))
+		_, message, err := c.ReadMessage()
+		t.Require().NoError(err)
+		t.Require().Equal("Hello", string(message))
+	}
+}
+
+func (suite *MultipleConnectionsSuite) Test_DisconnectOne(t provider.T) {
+	t.Title("Multiple connections - disconnect of one connection does not affect others")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Websocket pool")
+	t.Tags("Positive")
+
+	c1 := dial(t, server2, "multiple_disconnect")
+	c2 := dial(t, server2, "multiple_disconnect")
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(c2)
+
+	err := c1.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+	t.Require().NoError(err)
+	_ = c1.Close()
+
+	// Wait until the first connection is unregistered
+	time.Sleep(100 * time.Millisecond)
+
+	online, err := pool.IsOnline(context.Background(), "multiple_disconnect")
+	t.Require().NoError(err)
+	t.Require().True(online)
+
+	err = pool.Send(context.Background(), "multiple_disconnect", []byte("Hello"))
+	t.Require().NoError(err)
+
+	_ = c2.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, message, err := c2.ReadMessage()
+	t.Require().NoError(err)
+	t.Require().Equal("Hello", string(message))
+}
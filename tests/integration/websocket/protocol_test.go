@@ -0,0 +1,242 @@
+package websocket
+
+import (
+	"context"
+	"github.com/goccy/go-json"
+	"github.com/gorilla/websocket"
+	memorypresence "github.com/mandarine-io/backend/internal/infrastructure/presence/memory"
+	memorypubsub "github.com/mandarine-io/backend/internal/infrastructure/pubsub/memory"
+	websocket2 "github.com/mandarine-io/backend/internal/infrastructure/websocket"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"net/http/httptest"
+	"strings"
+	"time"
+)
+
+type ProtocolSuite struct {
+	suite.Suite
+
+	pool   *websocket2.Pool
+	server *httptest.Server
+}
+
+func (suite *ProtocolSuite) BeforeAll(t provider.T) {
+	agent, err := memorypubsub.NewAgent()
+	t.Require().NoError(err)
+	registry, err := memorypresence.NewRegistry()
+	t.Require().NoError(err)
+
+	suite.pool, suite.server, err = newNode(agent, registry)
+	t.Require().NoError(err)
+
+	router := websocket2.NewRouter()
+	router.Handle(
+		"echo", func(c *websocket2.Context) error {
+			var payload map[string]any
+			if err := c.Bind(&payload); err != nil {
+				return err
+			}
+
+			return c.Reply(payload)
+		},
+	)
+	router.Handle(
+		"noop", func(*websocket2.Context) error {
+			return nil
+		},
+	)
+	router.Topic(
+		"news", func(context.Context, string, string) error {
+			return nil
+		},
+	)
+	router.Topic(
+		"chat:*", func(_ context.Context, clientID string, topic string) error {
+			if strings.TrimPrefix(topic, "chat:") != clientID {
+				return websocket2.ErrForbidden
+			}
+			return nil
+		},
+	)
+	suite.pool.RegisterHandler(router.Serve)
+}
+
+func (suite *ProtocolSuite) AfterAll(provider.T) {
+	suite.server.Close()
+}
+
+func (suite *ProtocolSuite) Test_RequestResponse(t provider.T) {
+	t.Title("Protocol - handler replies to request")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Websocket protocol")
+	t.Tags("Positive")
+
+	c := dial(t, suite.server, "protocol_echo")
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(c)
+
+	writeEnvelope(t, c, websocket2.Envelope{Version: 1, Type: "echo", ID: "1", Payload: []byte(`{"text":"Hello"}`)})
+
+	env := readEnvelope(t, c)
+	t.Require().Equal(websocket2.ResponseMessageType, env.Type)
+	t.Require().Equal("1", env.ReplyTo)
+	t.Require().JSONEq(`{"text":"Hello"}`, string(env.Payload))
+}
+
+func (suite *ProtocolSuite) Test_Ack(t provider.T) {
+	t.Title("Protocol - message with id is acknowledged")
+	t.Severity(allure.NORMAL)
+	t.Feature("Websocket protocol")
+	t.Tags("Positive")
+
+	c := dial(t, suite.server, "protocol_ack")
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(c)
+
+	writeEnvelope(t, c, websocket2.Envelope{Version: 1, Type: "noop", ID: "2"})
+
+	env := readEnvelope(t, c)
+	t.Require().Equal(websocket2.AckMessageType, env.Type)
+	t.Require().Equal("2", env.ReplyTo)
+}
+
+func (suite *ProtocolSuite) Test_Errors(t provider.T) {
+	t.Title("Protocol - invalid messages are answered by error")
+	t.Severity(allure.NORMAL)
+	t.Feature("Websocket protocol")
+	t.Tags("Negative")
+
+	c := dial(t, suite.server, "protocol_errors")
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(c)
+
+	cases := []struct {
+		msg  []byte
+		code string
+	}{
+		{msg: []byte("not json"), code: websocket2.BadRequestErrorCode},
+		{msg: []byte(`{"v":2,"type":"noop","id":"3"}`), code: websocket2.UnsupportedVersionErrorCode},
+		{msg: []byte(`{"v":1,"type":"unknown","id":"4"}`), code: websocket2.UnknownTypeErrorCode},
+		{msg: []byte(`{"v":1,"type":"subscribe","id":"5","topic":"unknown"}`), code: websocket2.UnknownTopicErrorCode},
+		{msg: []byte(`{"v":1,"type":"subscribe","id":"6","topic":"chat:another"}`), code: websocket2.ForbiddenErrorCode},
+	}
+
+	for _, tc := range cases {
+		err := c.WriteMessage(websocket.TextMessage, tc.msg)
+		t.Require().NoError(err)
+
+		env := readEnvelope(t, c)
+		t.Require().Equal(websocket2.ErrorMessageType, env.Type)
+
+		var protocolErr websocket2.ProtocolError
+		err = json.Unmarshal(env.Payload, &protocolErr)
+		t.Require().NoError(err)
+		t.Require().Equal(tc.code, protocolErr.Code)
+	}
+}
+
+func (suite *ProtocolSuite) Test_SubscribePublish(t provider.T) {
+	t.Title("Protocol - subscribed connections receive events of topic")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Websocket protocol")
+	t.Tags("Positive")
+
+	subscriber := dial(t, suite.server, "protocol_subscriber")
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(subscriber)
+	other := dial(t, suite.server, "protocol_other")
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(other)
+
+	writeEnvelope(t, subscriber, websocket2.Envelope{Version: 1, Type: "subscribe", ID: "7", Topic: "chat:protocol_subscriber"})
+	env := readEnvelope(t, subscriber)
+	t.Require().Equal(websocket2.AckMessageType, env.Type)
+
+	err := suite.pool.Publish(context.Background(), "chat:protocol_subscriber", map[string]string{"text": "Hello"})
+	t.Require().NoError(err)
+
+	env = readEnvelope(t, subscriber)
+	t.Require().Equal(websocket2.EventMessageType, env.Type)
+	t.Require().Equal("chat:protocol_subscriber", env.Topic)
+	t.Require().NotEmpty(env.ID)
+	t.Require().JSONEq(`{"text":"Hello"}`, string(env.Payload))
+
+	// Event of unsubscribed topic is not delivered, so the next message is marker
+	writeEnvelope(t, subscriber, websocket2.Envelope{Version: 1, Type: "unsubscribe", ID: "8", Topic: "chat:protocol_subscriber"})
+	env = readEnvelope(t, subscriber)
+	t.Require().Equal(websocket2.AckMessageType, env.Type)
+
+	err = suite.pool.Publish(context.Background(), "chat:protocol_subscriber", map[string]string{"text": "Hello"})
+	t.Require().NoError(err)
+	err = suite.pool.Send(context.Background(), "protocol_subscriber", []byte(`{"v":1,"type":"marker"}`))
+	t.Require().NoError(err)
+
+	env = readEnvelope(t, subscriber)
+	t.Require().Equal("marker", env.Type)
+
+	// Connection without subscription does not receive events
+	err = suite.pool.Send(context.Background(), "protocol_other", []byte(`{"v":1,"type":"marker"}`))
+	t.Require().NoError(err)
+
+	env = readEnvelope(t, other)
+	t.Require().Equal("marker", env.Type)
+}
+
+func (suite *ProtocolSuite) Test_SendEvent(t provider.T) {
+	t.Title("Protocol - personal event is delivered to subscribed connections of client")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Websocket protocol")
+	t.Tags("Positive")
+
+	subscribed := dial(t, suite.server, "protocol_personal")
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(subscribed)
+	unsubscribed := dial(t, suite.server, "protocol_personal")
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(unsubscribed)
+
+	writeEnvelope(t, subscribed, websocket2.Envelope{Version: 1, Type: "subscribe", ID: "9", Topic: "news"})
+	env := readEnvelope(t, subscribed)
+	t.Require().Equal(websocket2.AckMessageType, env.Type)
+
+	err := suite.pool.SendEvent(context.Background(), "protocol_personal", "news", map[string]string{"text": "Hello"})
+	t.Require().NoError(err)
+	err = suite.pool.Send(context.Background(), "protocol_personal", []byte(`{"v":1,"type":"marker"}`))
+	t.Require().NoError(err)
+
+	env = readEnvelope(t, subscribed)
+	t.Require().Equal(websocket2.EventMessageType, env.Type)
+	t.Require().Equal("news", env.Topic)
+
+	env = readEnvelope(t, unsubscribed)
+	t.Require().Equal("marker", env.Type)
+}
+
+func writeEnvelope(t provider.T, c *websocket.Conn, env websocket2.Envelope) {
+	data, err := json.Marshal(env)
+	t.Require().NoError(err)
+
+	err = c.WriteMessage(websocket.TextMessage, data)
+	t.Require().NoError(err)
+}
+
+func readEnvelope(t provider.T, c *websocket.Conn) websocket2.Envelope {
+	_ = c.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, data, err := c.ReadMessage()
+	t.Require().NoError(err)
+
+	var env websocket2.Envelope
+	err = json.Unmarshal(data, &env)
+	t.Require().NoError(err)
+
+	return env
+}
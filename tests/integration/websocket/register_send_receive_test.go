@@ -1,6 +1,7 @@
 package websocket
 
 import (
+	"context"
 	"fmt"
 	"github.com/gorilla/websocket"
 	websocket2 "github.com/mandarine-io/backend/internal/infrastructure/websocket"
@@ -9,6 +10,7 @@ import (
 	"github.com/ozontech/allure-go/pkg/framework/suite"
 	"net/url"
 	"strings"
+	"time"
 )
 
 type RegisterSendReceiveSuite struct {
@@ -24,7 +26,7 @@ func (suite *RegisterSendReceiveSuite) Test_Success(t provider.T) {
 
 	pool.RegisterHandler(
 		func(pool *websocket2.Pool, msg websocket2.ClientMessage) {
-			pool.Send(msg.ClientID, msg.Payload)
+			_ = pool.Send(context.Background(), msg.ClientID, msg.Payload)
 		},
 	)
 
@@ -64,10 +66,18 @@ func (suite *RegisterSendReceiveSuite) Test_PoolIsFull(t provider.T) {
 		t.Require().NoError(err)
 		conns[i] = c
 	}
+	// Pool releases places, when it notices closed connections, so next tests must wait for it
 	defer func() {
 		for _, c := range conns {
 			_ = c.Close()
 		}
+		t.Require().Eventually(
+			func() bool {
+				return pool.Count() == 0
+			},
+			5*time.Second,
+			10*time.Millisecond,
+		)
 	}()
 
 	u := url.URL{
@@ -0,0 +1,120 @@
+package websocket
+
+import (
+	"bytes"
+	"context"
+	"github.com/gorilla/websocket"
+	memorypresence "github.com/mandarine-io/backend/internal/infrastructure/presence/memory"
+	memorypubsub "github.com/mandarine-io/backend/internal/infrastructure/pubsub/memory"
+	websocket2 "github.com/mandarine-io/backend/internal/infrastructure/websocket"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"net/http/httptest"
+	"time"
+)
+
+const (
+	slowConsumerMessages = 64
+)
+
+var (
+	// Large message fills socket buffers quickly, so writer of connection, which is not read, is blocked
+	largePayload = bytes.Repeat([]byte("a"), 1<<20)
+)
+
+type SlowConsumerSuite struct {
+	suite.Suite
+}
+
+func (suite *SlowConsumerSuite) Test_ClosePolicy(t provider.T) {
+	t.Title("Slow consumer - connection is closed, when send queue is full")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Websocket pool")
+	t.Tags("Positive")
+
+	node, srv := newSlowConsumerNode(t, websocket2.CloseConnectionPolicy)
+	defer srv.Close()
+
+	slow := dial(t, srv, "slow_close")
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(slow)
+	fast := dial(t, srv, "fast_close")
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(fast)
+
+	for i := 0; i < slowConsumerMessages; i++ {
+		err := node.Send(context.Background(), "slow_close", largePayload)
+		t.Require().NoError(err)
+	}
+
+	t.Require().Eventually(
+		func() bool {
+			return node.Count() == 1
+		}, 10*time.Second, 50*time.Millisecond,
+	)
+
+	// Another client still receives messages
+	err := node.Send(context.Background(), "fast_close", []byte("Hello"))
+	t.Require().NoError(err)
+
+	_ = fast.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, message, err := fast.ReadMessage()
+	t.Require().NoError(err)
+	t.Require().Equal("Hello", string(message))
+}
+
+func (suite *SlowConsumerSuite) Test_DropPolicy(t provider.T) {
+	t.Title("Slow consumer - messages are dropped, when send queue is full")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Websocket pool")
+	t.Tags("Positive")
+
+	node, srv := newSlowConsumerNode(t, websocket2.DropMessagePolicy)
+	defer srv.Close()
+
+	slow := dial(t, srv, "slow_drop")
+	defer func(c *websocket.Conn) {
+		_ = c.Close()
+	}(slow)
+
+	for i := 0; i < slowConsumerMessages; i++ {
+		err := node.Send(context.Background(), "slow_drop", largePayload)
+		t.Require().NoError(err)
+	}
+
+	// Connection is alive, but client receives only part of messages
+	_ = slow.SetReadDeadline(time.Now().Add(5 * time.Second))
+	received := 0
+	for {
+		_, _, err := slow.ReadMessage()
+		if err != nil {
+			break
+		}
+		received++
+		_ = slow.SetReadDeadline(time.Now().Add(time.Second))
+	}
+
+	t.Require().Equal(1, node.Count())
+	t.Require().Greater(received, 0)
+	t.Require().Less(received, slowConsumerMessages)
+}
+
+func newSlowConsumerNode(t provider.T, policy websocket2.SlowConsumerPolicy) (*websocket2.Pool, *httptest.Server) {
+	agent, err := memorypubsub.NewAgent()
+	t.Require().NoError(err)
+	registry, err := memorypresence.NewRegistry()
+	t.Require().NoError(err)
+
+	node, srv, err := newNode(
+		agent,
+		registry,
+		websocket2.WithSendQueueSize(1),
+		websocket2.WithSlowConsumerPolicy(policy),
+	)
+	t.Require().NoError(err)
+
+	return node, srv
+}
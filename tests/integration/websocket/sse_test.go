@@ -0,0 +1,205 @@
+package websocket
+
+import (
+	"bufio"
+	"context"
+	"github.com/goccy/go-json"
+	memorypresence "github.com/mandarine-io/backend/internal/infrastructure/presence/memory"
+	memorypubsub "github.com/mandarine-io/backend/internal/infrastructure/pubsub/memory"
+	"github.com/mandarine-io/backend/internal/infrastructure/replay/memory"
+	websocket2 "github.com/mandarine-io/backend/internal/infrastructure/websocket"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+)
+
+type SSESuite struct {
+	suite.Suite
+
+	pool   *websocket2.Pool
+	server *httptest.Server
+}
+
+type sseEvent struct {
+	id   string
+	data string
+}
+
+type sseStream struct {
+	resp   *http.Response
+	reader *bufio.Reader
+}
+
+func (suite *SSESuite) BeforeAll(t provider.T) {
+	agent, err := memorypubsub.NewAgent()
+	t.Require().NoError(err)
+	registry, err := memorypresence.NewRegistry()
+	t.Require().NoError(err)
+	buffer, err := memory.NewBuffer()
+	t.Require().NoError(err)
+
+	suite.pool, suite.server, err = newNode(agent, registry, websocket2.WithReplayBuffer(buffer))
+	t.Require().NoError(err)
+}
+
+func (suite *SSESuite) AfterAll(t provider.T) {
+	suite.server.Close()
+	err := suite.pool.Close()
+	t.Require().NoError(err)
+}
+
+func (suite *SSESuite) Test_Stream(t provider.T) {
+	t.Title("SSE - message of client is streamed as event with identifier")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Websocket SSE")
+	t.Tags("Positive")
+
+	stream := openStream(t, suite.server, "sse_stream", "")
+	defer stream.close()
+
+	t.Require().Equal("text/event-stream", stream.resp.Header.Get("Content-Type"))
+	t.Require().NotEmpty(stream.resp.Header.Get(websocket2.ConnectionIDHeader))
+
+	waitOnline(t, suite.pool, "sse_stream")
+
+	err := suite.pool.Send(context.Background(), "sse_stream", []byte("Hello"))
+	t.Require().NoError(err)
+
+	event := stream.next(t)
+	t.Require().NotEmpty(event.id)
+	t.Require().Equal("Hello", event.data)
+}
+
+func (suite *SSESuite) Test_Topics(t provider.T) {
+	t.Title("SSE - only events of subscribed topics are streamed")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Websocket SSE")
+	t.Tags("Positive")
+
+	stream := openStream(t, suite.server, "sse_topics?topic=news", "")
+	defer stream.close()
+
+	waitOnline(t, suite.pool, "sse_topics")
+
+	err := suite.pool.SendEvent(context.Background(), "sse_topics", "other", map[string]string{"text": "Other"})
+	t.Require().NoError(err)
+	err = suite.pool.SendEvent(context.Background(), "sse_topics", "news", map[string]string{"text": "News"})
+	t.Require().NoError(err)
+
+	env := websocket2.Envelope{}
+	err = json.Unmarshal([]byte(stream.next(t).data), &env)
+	t.Require().NoError(err)
+	t.Require().Equal(websocket2.EventMessageType, env.Type)
+	t.Require().Equal("news", env.Topic)
+}
+
+func (suite *SSESuite) Test_Resume(t provider.T) {
+	t.Title("SSE - missed events are replayed after Last-Event-ID")
+	t.Severity(allure.CRITICAL)
+	t.Feature("Websocket SSE")
+	t.Tags("Positive")
+
+	stream := openStream(t, suite.server, "sse_resume", "")
+	waitOnline(t, suite.pool, "sse_resume")
+
+	err := suite.pool.Send(context.Background(), "sse_resume", []byte("first"))
+	t.Require().NoError(err)
+	lastEventID := stream.next(t).id
+	stream.close()
+
+	waitOffline(t, suite.pool, "sse_resume")
+
+	// Client is offline, so events are kept only in replay buffer
+	err = suite.pool.Send(context.Background(), "sse_resume", []byte("second"))
+	t.Require().NoError(err)
+	err = suite.pool.Send(context.Background(), "sse_resume", []byte("third"))
+	t.Require().NoError(err)
+
+	stream = openStream(t, suite.server, "sse_resume", lastEventID)
+	defer stream.close()
+
+	t.Require().Equal("second", stream.next(t).data)
+	t.Require().Equal("third", stream.next(t).data)
+}
+
+func (suite *SSESuite) Test_InvalidLastEventID(t provider.T) {
+	t.Title("SSE - stream with invalid Last-Event-ID starts from new events")
+	t.Severity(allure.NORMAL)
+	t.Feature("Websocket SSE")
+	t.Tags("Negative")
+
+	stream := openStream(t, suite.server, "sse_invalid", "invalid")
+	defer stream.close()
+
+	waitOnline(t, suite.pool, "sse_invalid")
+
+	err := suite.pool.Send(context.Background(), "sse_invalid", []byte("Hello"))
+	t.Require().NoError(err)
+
+	t.Require().Equal("Hello", stream.next(t).data)
+}
+
+func openStream(t provider.T, srv *httptest.Server, path string, lastEventID string) *sseStream {
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/sse/"+path, nil)
+	t.Require().NoError(err)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Do(req)
+	t.Require().NoError(err)
+	t.Require().Equal(http.StatusOK, resp.StatusCode)
+
+	return &sseStream{resp: resp, reader: bufio.NewReader(resp.Body)}
+}
+
+// next reads event skipping comments
+func (s *sseStream) next(t provider.T) sseEvent {
+	event := sseEvent{}
+	data := make([]string, 0)
+	for {
+		line, err := s.reader.ReadString('\n')
+		t.Require().NoError(err)
+		line = strings.TrimSuffix(line, "\n")
+
+		switch {
+		case line == "" && len(data) > 0:
+			event.data = strings.Join(data, "\n")
+			return event
+		case strings.HasPrefix(line, "id: "):
+			event.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "data: "):
+			data = append(data, strings.TrimPrefix(line, "data: "))
+		}
+	}
+}
+
+func (s *sseStream) close() {
+	_ = s.resp.Body.Close()
+}
+
+func waitOnline(t provider.T, p *websocket2.Pool, clientID string) {
+	waitPresence(t, p, clientID, true)
+}
+
+func waitOffline(t provider.T, p *websocket2.Pool, clientID string) {
+	waitPresence(t, p, clientID, false)
+}
+
+func waitPresence(t provider.T, p *websocket2.Pool, clientID string, expected bool) {
+	for i := 0; i < 50; i++ {
+		online, err := p.IsOnline(context.Background(), clientID)
+		t.Require().NoError(err)
+		if online == expected {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	t.Fatalf("presence of client %s is not %v", clientID, expected)
+}
@@ -2,12 +2,15 @@ package account
 
 import (
 	"github.com/mandarine-io/backend/config"
+	mock5 "github.com/mandarine-io/backend/internal/infrastructure/sms/mock"
 	mock3 "github.com/mandarine-io/backend/internal/infrastructure/smtp/mock"
 	mock4 "github.com/mandarine-io/backend/internal/infrastructure/template/mock"
 	mock2 "github.com/mandarine-io/backend/internal/persistence/repo/mock"
 	"github.com/mandarine-io/backend/internal/service/domain"
 	"github.com/mandarine-io/backend/internal/service/domain/account"
 	"github.com/mandarine-io/backend/internal/service/infrastructure/mock"
+	"github.com/mandarine-io/backend/third_party/oauth"
+	mock6 "github.com/mandarine-io/backend/third_party/oauth/mock"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"testing"
 
@@ -15,20 +18,41 @@ import (
 )
 
 var (
-	userRepoMock       *mock2.UserRepositoryMock
-	smtpSenderMock     *mock3.SenderMock
-	templateEngineMock *mock4.EngineMock
-	otpServiceMock     *mock.OTPServiceMock
-	cfg                config.Config
-	svc                domain.AccountService
+	userRepoMock          *mock2.UserRepositoryMock
+	sessionRepoMock       *mock2.SessionRepositoryMock
+	passkeyRepoMock       *mock2.PasskeyRepositoryMock
+	identityRepoMock      *mock2.UserIdentityRepositoryMock
+	smtpSenderMock        *mock3.SenderMock
+	smsSenderMock         *mock5.SenderMock
+	templateEngineMock    *mock4.EngineMock
+	otpServiceMock        *mock.OTPServiceMock
+	jwtServiceMock        *mock.JWTServiceMock
+	totpServiceMock       *mock.TOTPServiceMock
+	webAuthnServiceMock   *mock.WebAuthnServiceMock
+	bruteForceServiceMock *mock.BruteForceServiceMock
+	oauthProviderMock     *mock6.ProviderMock
+	cfg                   config.Config
+	svc                   domain.AccountService
 )
 
 func init() {
 	userRepoMock = &mock2.UserRepositoryMock{}
+	sessionRepoMock = &mock2.SessionRepositoryMock{}
+	passkeyRepoMock = &mock2.PasskeyRepositoryMock{}
+	identityRepoMock = &mock2.UserIdentityRepositoryMock{}
 	smtpSenderMock = &mock3.SenderMock{}
+	smsSenderMock = &mock5.SenderMock{}
 	templateEngineMock = &mock4.EngineMock{}
 	otpServiceMock = &mock.OTPServiceMock{}
+	jwtServiceMock = &mock.JWTServiceMock{}
+	totpServiceMock = &mock.TOTPServiceMock{}
+	webAuthnServiceMock = &mock.WebAuthnServiceMock{}
+	bruteForceServiceMock = &mock.BruteForceServiceMock{}
+	oauthProviderMock = &mock6.ProviderMock{}
 	cfg = config.Config{
+		Server: config.ServerConfig{
+			ExternalURL: "http://localhost:8080",
+		},
 		Security: config.SecurityConfig{
 			OTP: config.OTPConfig{
 				Length: 6,
@@ -36,7 +60,22 @@ func init() {
 			},
 		},
 	}
-	svc = account.NewService(cfg, userRepoMock, smtpSenderMock, templateEngineMock, otpServiceMock)
+	svc = account.NewService(
+		cfg,
+		userRepoMock,
+		sessionRepoMock,
+		passkeyRepoMock,
+		identityRepoMock,
+		smtpSenderMock,
+		smsSenderMock,
+		templateEngineMock,
+		otpServiceMock,
+		jwtServiceMock,
+		totpServiceMock,
+		webAuthnServiceMock,
+		bruteForceServiceMock,
+		map[string]oauth.Provider{"mock": oauthProviderMock},
+	)
 }
 
 type AccountServiceSuite struct {
@@ -48,12 +87,29 @@ func TestAccountServiceSuite(t *testing.T) {
 }
 
 func (s *AccountServiceSuite) Test(t provider.T) {
+	s.RunSuite(t, new(BeginPasskeyRegistrationSuite))
+	s.RunSuite(t, new(ConfirmIdentityLinkSuite))
 	s.RunSuite(t, new(DeleteAccountSuite))
+	s.RunSuite(t, new(DeletePasskeySuite))
+	s.RunSuite(t, new(DisableTOTPSuite))
+	s.RunSuite(t, new(EnableTOTPSuite))
+	s.RunSuite(t, new(FinishPasskeyRegistrationSuite))
 	s.RunSuite(t, new(GetAccountSuite))
+	s.RunSuite(t, new(GetIdentitiesSuite))
+	s.RunSuite(t, new(GetPasskeysSuite))
+	s.RunSuite(t, new(GetSessionsSuite))
+	s.RunSuite(t, new(LinkIdentitySuite))
+	s.RunSuite(t, new(RegenerateRecoveryCodesSuite))
 	s.RunSuite(t, new(RestoreAccountSuite))
+	s.RunSuite(t, new(RevokeOtherSessionsSuite))
+	s.RunSuite(t, new(RevokeSessionSuite))
 	s.RunSuite(t, new(SetPasswordSuite))
+	s.RunSuite(t, new(SetupTOTPSuite))
+	s.RunSuite(t, new(UnlinkIdentitySuite))
 	s.RunSuite(t, new(UpdateEmailSuite))
 	s.RunSuite(t, new(UpdatePasswordSuite))
+	s.RunSuite(t, new(UpdatePhoneSuite))
 	s.RunSuite(t, new(UpdateUsernameSuite))
 	s.RunSuite(t, new(VerifyEmailSuite))
+	s.RunSuite(t, new(VerifyPhoneSuite))
 }
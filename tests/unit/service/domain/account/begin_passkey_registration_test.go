@@ -0,0 +1,87 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/pkg/errors"
+)
+
+type BeginPasskeyRegistrationSuite struct {
+	suite.Suite
+}
+
+func (s *BeginPasskeyRegistrationSuite) Test_Success(t provider.T) {
+	t.Title("BeginPasskeyRegistration returns passkey creation options")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("BeginPasskeyRegistration")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID, Username: "user", Email: "user@example.com"}
+	output := v0.PasskeyCreationOptionsOutput{
+		PublicKey: v0.PublicKeyCredentialCreationOptions{Challenge: "challenge"},
+	}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	webAuthnServiceMock.On("BeginRegistration", ctx, userEntity).Once().Return(output, nil)
+
+	// Act
+	resp, err := svc.BeginPasskeyRegistration(ctx, userID)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(output, resp)
+}
+
+func (s *BeginPasskeyRegistrationSuite) Test_UserNotFound(t provider.T) {
+	t.Title("BeginPasskeyRegistration returns UserNotFound error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("BeginPasskeyRegistration")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(nil, nil)
+
+	// Act
+	_, err := svc.BeginPasskeyRegistration(ctx, userID)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrUserNotFound)
+}
+
+func (s *BeginPasskeyRegistrationSuite) Test_WebAuthnError(t provider.T) {
+	t.Title("BeginPasskeyRegistration returns error from WebAuthn service")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("BeginPasskeyRegistration")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID}
+	expectedErr := errors.New("cache error")
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	webAuthnServiceMock.On("BeginRegistration", ctx, userEntity).
+		Once().Return(v0.PasskeyCreationOptionsOutput{}, expectedErr)
+
+	// Act
+	_, err := svc.BeginPasskeyRegistration(ctx, userID)
+
+	// Assert
+	t.Require().ErrorIs(err, expectedErr)
+}
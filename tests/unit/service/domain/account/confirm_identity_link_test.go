@@ -0,0 +1,89 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+type ConfirmIdentityLinkSuite struct {
+	suite.Suite
+}
+
+func (s *ConfirmIdentityLinkSuite) Test_Success(t provider.T) {
+	t.Title("ConfirmIdentityLink links identity from token to the user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("ConfirmIdentityLink")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	input := v0.ConfirmIdentityLinkInput{Token: "confirm_token_1"}
+	data := v0.IdentityLinkData{UserID: userID.String(), Provider: "google", Subject: "1", Email: "c1@example.com"}
+
+	otpServiceMock.On("ConsumeDataByToken", ctx, "identity_link", input.Token, mock.Anything).Run(
+		func(args mock.Arguments) {
+			*args.Get(3).(*v0.IdentityLinkData) = data
+		},
+	).Once().Return(nil)
+	identityRepoMock.On("CreateUserIdentity", ctx, mock.MatchedBy(func(identity *entity.UserIdentity) bool {
+		return identity.UserID == userID && identity.Provider == "google" && identity.Subject == "1"
+	})).Once().Return(func(_ context.Context, identity *entity.UserIdentity) *entity.UserIdentity {
+		return identity
+	}, nil)
+
+	resp, err := svc.ConfirmIdentityLink(ctx, userID, input)
+
+	t.Require().NoError(err)
+	t.Require().Equal("google", resp.Provider)
+	t.Require().Equal(data.Email, *resp.Email)
+}
+
+func (s *ConfirmIdentityLinkSuite) Test_InvalidToken(t provider.T) {
+	t.Title("ConfirmIdentityLink returns InvalidOrExpiredOTP error for used or expired token")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("ConfirmIdentityLink")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	input := v0.ConfirmIdentityLinkInput{Token: "confirm_token_2"}
+
+	otpServiceMock.On("ConsumeDataByToken", ctx, "identity_link", input.Token, mock.Anything).
+		Once().Return(infrastructure.ErrInvalidOrExpiredOTP)
+
+	resp, err := svc.ConfirmIdentityLink(ctx, uuid.New(), input)
+
+	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
+	t.Require().Equal(v0.IdentityOutput{}, resp)
+}
+
+func (s *ConfirmIdentityLinkSuite) Test_AnotherUserToken(t provider.T) {
+	t.Title("ConfirmIdentityLink returns InvalidOrExpiredOTP error if token was issued for another user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("ConfirmIdentityLink")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	input := v0.ConfirmIdentityLinkInput{Token: "confirm_token_3"}
+	data := v0.IdentityLinkData{UserID: uuid.New().String(), Provider: "google", Subject: "3"}
+
+	otpServiceMock.On("ConsumeDataByToken", ctx, "identity_link", input.Token, mock.Anything).Run(
+		func(args mock.Arguments) {
+			*args.Get(3).(*v0.IdentityLinkData) = data
+		},
+	).Once().Return(nil)
+
+	resp, err := svc.ConfirmIdentityLink(ctx, uuid.New(), input)
+
+	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
+	t.Require().Equal(v0.IdentityOutput{}, resp)
+}
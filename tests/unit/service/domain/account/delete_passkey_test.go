@@ -0,0 +1,56 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type DeletePasskeySuite struct {
+	suite.Suite
+}
+
+func (s *DeletePasskeySuite) Test_Success(t provider.T) {
+	t.Title("DeletePasskey removes passkey of the user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("DeletePasskey")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	passkeyID := uuid.New()
+
+	passkeyRepoMock.On("DeletePasskey", ctx, passkeyID, userID).Once().Return(true, nil)
+
+	// Act
+	err := svc.DeletePasskey(ctx, userID, passkeyID)
+
+	// Assert
+	t.Require().NoError(err)
+}
+
+func (s *DeletePasskeySuite) Test_PasskeyNotFound(t provider.T) {
+	t.Title("DeletePasskey returns PasskeyNotFound error for foreign or missing passkey")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("DeletePasskey")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	passkeyID := uuid.New()
+
+	passkeyRepoMock.On("DeletePasskey", ctx, passkeyID, userID).Once().Return(false, nil)
+
+	// Act
+	err := svc.DeletePasskey(ctx, userID, passkeyID)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrPasskeyNotFound)
+}
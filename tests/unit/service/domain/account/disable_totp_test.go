@@ -0,0 +1,100 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/samber/lo"
+)
+
+type DisableTOTPSuite struct {
+	suite.Suite
+}
+
+func (s *DisableTOTPSuite) Test_Success(t provider.T) {
+	t.Title("DisableTOTP disables 2FA and deletes recovery codes")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("DisableTOTP")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{
+		ID:               userID,
+		TOTPSecret:       lo.ToPtr("JBSWY3DPEHPK3PXP"),
+		TOTPLastUsedStep: lo.ToPtr(int64(100)),
+		IsTOTPEnabled:    true,
+	}
+	input := v0.TOTPCodeInput{Code: "123456"}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("CheckAttempt", ctx, totpAttempt(userID)).Once().Return(nil)
+	totpServiceMock.On("VerifyCode", ctx, userEntity, input.Code).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, totpAttempt(userID)).Once().Return(nil)
+	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(userEntity, nil)
+	totpServiceMock.On("DeleteRecoveryCodes", ctx, userID).Once().Return(nil)
+
+	// Act
+	err := svc.DisableTOTP(ctx, userID, input, clientInfo)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().False(userEntity.IsTOTPEnabled)
+	t.Require().Nil(userEntity.TOTPSecret)
+	t.Require().Nil(userEntity.TOTPLastUsedStep)
+}
+
+func (s *DisableTOTPSuite) Test_MFANotEnabled(t provider.T) {
+	t.Title("DisableTOTP returns MFANotEnabled error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("DisableTOTP")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+
+	// Act
+	err := svc.DisableTOTP(ctx, userID, v0.TOTPCodeInput{Code: "123456"}, clientInfo)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrMFANotEnabled)
+}
+
+func (s *DisableTOTPSuite) Test_InvalidCode(t provider.T) {
+	t.Title("DisableTOTP returns InvalidTOTPCode error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("DisableTOTP")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID, TOTPSecret: lo.ToPtr("JBSWY3DPEHPK3PXP"), IsTOTPEnabled: true}
+	input := v0.TOTPCodeInput{Code: "000000"}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("CheckAttempt", ctx, totpAttempt(userID)).Once().Return(nil)
+	totpServiceMock.On("VerifyCode", ctx, userEntity, input.Code).Once().Return(infrastructure.ErrInvalidTOTPCode)
+	bruteForceServiceMock.On("FailAttempt", ctx, totpAttempt(userID)).Once().Return(nil)
+
+	// Act
+	err := svc.DisableTOTP(ctx, userID, input, clientInfo)
+
+	// Assert
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidTOTPCode)
+	t.Require().True(userEntity.IsTOTPEnabled)
+}
@@ -0,0 +1,175 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/samber/lo"
+	"time"
+)
+
+var clientInfo = infrastructure.ClientInfo{IP: "127.0.0.1", UserAgent: "test"}
+
+func totpAttempt(userID uuid.UUID) infrastructure.Attempt {
+	return infrastructure.Attempt{Action: "totp", Account: userID.String(), IP: clientInfo.IP}
+}
+
+type EnableTOTPSuite struct {
+	suite.Suite
+}
+
+func (s *EnableTOTPSuite) Test_Success(t provider.T) {
+	t.Title("EnableTOTP enables 2FA and returns recovery codes")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("EnableTOTP")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID, TOTPSecret: lo.ToPtr("JBSWY3DPEHPK3PXP")}
+	input := v0.TOTPCodeInput{Code: "123456"}
+	codes := []string{"abcde-fghij", "kmnpq-rstuv"}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("CheckAttempt", ctx, totpAttempt(userID)).Once().Return(nil)
+	totpServiceMock.On("VerifyCode", ctx, userEntity, input.Code).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, totpAttempt(userID)).Once().Return(nil)
+	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(userEntity, nil)
+	totpServiceMock.On("GenerateRecoveryCodes", ctx, userID).Once().Return(codes, nil)
+
+	// Act
+	resp, err := svc.EnableTOTP(ctx, userID, input, clientInfo)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(v0.RecoveryCodesOutput{Codes: codes}, resp)
+	t.Require().True(userEntity.IsTOTPEnabled)
+}
+
+func (s *EnableTOTPSuite) Test_MFASetupNotStarted(t provider.T) {
+	t.Title("EnableTOTP returns MFASetupNotStarted error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("EnableTOTP")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+
+	// Act
+	_, err := svc.EnableTOTP(ctx, userID, v0.TOTPCodeInput{Code: "123456"}, clientInfo)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrMFASetupNotStarted)
+}
+
+func (s *EnableTOTPSuite) Test_MFAAlreadyEnabled(t provider.T) {
+	t.Title("EnableTOTP returns MFAAlreadyEnabled error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("EnableTOTP")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID, TOTPSecret: lo.ToPtr("JBSWY3DPEHPK3PXP"), IsTOTPEnabled: true}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+
+	// Act
+	_, err := svc.EnableTOTP(ctx, userID, v0.TOTPCodeInput{Code: "123456"}, clientInfo)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrMFAAlreadyEnabled)
+}
+
+func (s *EnableTOTPSuite) Test_InvalidCode(t provider.T) {
+	t.Title("EnableTOTP returns InvalidTOTPCode error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("EnableTOTP")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID, TOTPSecret: lo.ToPtr("JBSWY3DPEHPK3PXP")}
+	input := v0.TOTPCodeInput{Code: "000000"}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("CheckAttempt", ctx, totpAttempt(userID)).Once().Return(nil)
+	totpServiceMock.On("VerifyCode", ctx, userEntity, input.Code).Once().Return(infrastructure.ErrInvalidTOTPCode)
+	bruteForceServiceMock.On("FailAttempt", ctx, totpAttempt(userID)).Once().Return(nil)
+
+	// Act
+	_, err := svc.EnableTOTP(ctx, userID, input, clientInfo)
+
+	// Assert
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidTOTPCode)
+	t.Require().False(userEntity.IsTOTPEnabled)
+}
+
+func (s *EnableTOTPSuite) Test_Locked(t provider.T) {
+	t.Title("EnableTOTP returns lockout error without checking code")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("EnableTOTP")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID, TOTPSecret: lo.ToPtr("JBSWY3DPEHPK3PXP")}
+	input := v0.TOTPCodeInput{Code: "123456"}
+	lockErr := infrastructure.LockoutError{Err: infrastructure.ErrAccountTemporarilyLocked, RetryAfter: time.Minute}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("CheckAttempt", ctx, totpAttempt(userID)).Once().Return(lockErr)
+
+	// Act
+	_, err := svc.EnableTOTP(ctx, userID, input, clientInfo)
+
+	// Assert
+	t.Require().ErrorIs(err, infrastructure.ErrAccountTemporarilyLocked)
+	t.Require().False(userEntity.IsTOTPEnabled)
+	totpServiceMock.AssertNotCalled(t, "VerifyCode", ctx, userEntity, input.Code)
+}
+
+func (s *EnableTOTPSuite) Test_LockedAfterFailure(t provider.T) {
+	t.Title("EnableTOTP returns lockout error if failed code has locked attempts")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("EnableTOTP")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID, TOTPSecret: lo.ToPtr("JBSWY3DPEHPK3PXP")}
+	input := v0.TOTPCodeInput{Code: "000000"}
+	lockErr := infrastructure.LockoutError{Err: infrastructure.ErrAccountTemporarilyLocked, RetryAfter: time.Minute}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("CheckAttempt", ctx, totpAttempt(userID)).Once().Return(nil)
+	totpServiceMock.On("VerifyCode", ctx, userEntity, input.Code).Once().Return(infrastructure.ErrInvalidTOTPCode)
+	bruteForceServiceMock.On("FailAttempt", ctx, totpAttempt(userID)).Once().Return(lockErr)
+
+	// Act
+	_, err := svc.EnableTOTP(ctx, userID, input, clientInfo)
+
+	// Assert
+	t.Require().ErrorIs(err, infrastructure.ErrAccountTemporarilyLocked)
+}
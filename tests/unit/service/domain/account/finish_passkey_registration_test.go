@@ -0,0 +1,99 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"time"
+)
+
+type FinishPasskeyRegistrationSuite struct {
+	suite.Suite
+}
+
+func (s *FinishPasskeyRegistrationSuite) Test_Success(t provider.T) {
+	t.Title("FinishPasskeyRegistration returns created passkey")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("FinishPasskeyRegistration")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID}
+	input := v0.PasskeyRegistrationInput{Name: "MacBook"}
+	passkeyEntity := &entity.Passkey{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      "MacBook",
+		CreatedAt: time.Now(),
+	}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	webAuthnServiceMock.On("FinishRegistration", ctx, userEntity, input).Once().Return(passkeyEntity, nil)
+
+	// Act
+	resp, err := svc.FinishPasskeyRegistration(ctx, userID, input)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(
+		v0.PasskeyOutput{
+			ID:        passkeyEntity.ID.String(),
+			Name:      passkeyEntity.Name,
+			CreatedAt: passkeyEntity.CreatedAt,
+		},
+		resp,
+	)
+}
+
+func (s *FinishPasskeyRegistrationSuite) Test_UserNotFound(t provider.T) {
+	t.Title("FinishPasskeyRegistration returns UserNotFound error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("FinishPasskeyRegistration")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(nil, nil)
+
+	// Act
+	_, err := svc.FinishPasskeyRegistration(ctx, userID, v0.PasskeyRegistrationInput{})
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrUserNotFound)
+}
+
+func (s *FinishPasskeyRegistrationSuite) Test_InvalidPasskey(t provider.T) {
+	t.Title("FinishPasskeyRegistration returns InvalidPasskey error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("FinishPasskeyRegistration")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID}
+	input := v0.PasskeyRegistrationInput{Name: "Broken"}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	webAuthnServiceMock.On("FinishRegistration", ctx, userEntity, input).
+		Once().Return(nil, infrastructure.ErrInvalidPasskey)
+
+	// Act
+	_, err := svc.FinishPasskeyRegistration(ctx, userID, input)
+
+	// Assert
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidPasskey)
+}
@@ -0,0 +1,69 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"time"
+)
+
+type GetIdentitiesSuite struct {
+	suite.Suite
+}
+
+func (s *GetIdentitiesSuite) Test_Success(t provider.T) {
+	t.Title("GetIdentities returns linked identities of the user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("GetIdentities")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	now := time.Now()
+	identities := []*entity.UserIdentity{
+		{ID: uuid.New(), UserID: userID, Provider: "google", Subject: "1", Email: lo.ToPtr("g@example.com"), CreatedAt: now},
+		{ID: uuid.New(), UserID: userID, Provider: "yandex", Subject: "2", CreatedAt: now.Add(time.Hour)},
+	}
+
+	identityRepoMock.On("FindUserIdentitiesByUserID", ctx, userID).Once().Return(identities, nil)
+
+	resp, err := svc.GetIdentities(ctx, userID)
+
+	t.Require().NoError(err)
+	t.Require().Equal(
+		v0.IdentitiesOutput{
+			Count: 2,
+			Data: []v0.IdentityOutput{
+				{Provider: "google", Email: lo.ToPtr("g@example.com"), CreatedAt: now},
+				{Provider: "yandex", CreatedAt: now.Add(time.Hour)},
+			},
+		},
+		resp,
+	)
+}
+
+func (s *GetIdentitiesSuite) Test_RepoError(t provider.T) {
+	t.Title("GetIdentities returns repository error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("GetIdentities")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	repoErr := errors.New("repo error")
+
+	identityRepoMock.On("FindUserIdentitiesByUserID", ctx, userID).Once().Return(nil, repoErr)
+
+	resp, err := svc.GetIdentities(ctx, userID)
+
+	t.Require().ErrorIs(err, repoErr)
+	t.Require().Equal(v0.IdentitiesOutput{}, resp)
+}
@@ -0,0 +1,95 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"time"
+)
+
+type GetPasskeysSuite struct {
+	suite.Suite
+}
+
+func (s *GetPasskeysSuite) Test_Success(t provider.T) {
+	t.Title("GetPasskeys returns passkeys of the user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("GetPasskeys")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	now := time.Now()
+	passkeys := []*entity.Passkey{
+		{ID: uuid.New(), UserID: userID, Name: "iPhone", CreatedAt: now, LastUsedAt: lo.ToPtr(now)},
+		{ID: uuid.New(), UserID: userID, Name: "YubiKey", CreatedAt: now.Add(-time.Hour)},
+	}
+
+	passkeyRepoMock.On("FindPasskeysByUserID", ctx, userID).Once().Return(passkeys, nil)
+
+	// Act
+	resp, err := svc.GetPasskeys(ctx, userID)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(
+		v0.PasskeysOutput{
+			Count: 2,
+			Data: []v0.PasskeyOutput{
+				{ID: passkeys[0].ID.String(), Name: "iPhone", CreatedAt: now, LastUsedAt: lo.ToPtr(now)},
+				{ID: passkeys[1].ID.String(), Name: "YubiKey", CreatedAt: now.Add(-time.Hour)},
+			},
+		},
+		resp,
+	)
+}
+
+func (s *GetPasskeysSuite) Test_Empty(t provider.T) {
+	t.Title("GetPasskeys returns empty list")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("GetPasskeys")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+
+	passkeyRepoMock.On("FindPasskeysByUserID", ctx, userID).Once().Return([]*entity.Passkey{}, nil)
+
+	// Act
+	resp, err := svc.GetPasskeys(ctx, userID)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(v0.PasskeysOutput{Count: 0, Data: []v0.PasskeyOutput{}}, resp)
+}
+
+func (s *GetPasskeysSuite) Test_RepoError(t provider.T) {
+	t.Title("GetPasskeys returns repository error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("GetPasskeys")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	expectedErr := errors.New("database error")
+
+	passkeyRepoMock.On("FindPasskeysByUserID", ctx, userID).Once().Return(nil, expectedErr)
+
+	// Act
+	_, err := svc.GetPasskeys(ctx, userID)
+
+	// Assert
+	t.Require().ErrorIs(err, expectedErr)
+}
@@ -0,0 +1,97 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+	"time"
+)
+
+type GetSessionsSuite struct {
+	suite.Suite
+}
+
+func (s *GetSessionsSuite) Test_Success(t provider.T) {
+	t.Title("Returns active sessions with current session marked")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("GetSessions")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	now := time.Now()
+	currentSession := &entity.Session{
+		ID:         uuid.New(),
+		UserID:     userID,
+		DeviceName: lo.ToPtr("iPhone"),
+		IP:         lo.ToPtr("127.0.0.1"),
+		Location:   lo.ToPtr("Moscow, Russia"),
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+	otherSession := &entity.Session{
+		ID:         uuid.New(),
+		UserID:     userID,
+		UserAgent:  lo.ToPtr("Mozilla/5.0"),
+		CreatedAt:  now.Add(-time.Hour),
+		LastUsedAt: now.Add(-time.Minute),
+	}
+
+	sessionRepoMock.On("FindSessionsByUserID", ctx, userID).
+		Once().Return([]*entity.Session{currentSession, otherSession}, nil)
+
+	res, err := svc.GetSessions(ctx, userID, currentSession.ID)
+
+	t.Require().NoError(err)
+	t.Require().Equal(
+		v0.SessionsOutput{
+			Count: 2,
+			Data: []v0.SessionOutput{
+				{
+					ID:         currentSession.ID.String(),
+					DeviceName: currentSession.DeviceName,
+					IP:         currentSession.IP,
+					Location:   currentSession.Location,
+					IsCurrent:  true,
+					CreatedAt:  currentSession.CreatedAt,
+					LastUsedAt: currentSession.LastUsedAt,
+				},
+				{
+					ID:         otherSession.ID.String(),
+					UserAgent:  otherSession.UserAgent,
+					IsCurrent:  false,
+					CreatedAt:  otherSession.CreatedAt,
+					LastUsedAt: otherSession.LastUsedAt,
+				},
+			},
+		},
+		res,
+	)
+}
+
+func (s *GetSessionsSuite) Test_ErrorFindingSessions(t provider.T) {
+	t.Title("Returns error when finding sessions fails")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("GetSessions")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	expectedErr := errors.New("database error")
+
+	sessionRepoMock.On("FindSessionsByUserID", ctx, userID).Once().Return(nil, expectedErr)
+
+	res, err := svc.GetSessions(ctx, userID, uuid.New())
+
+	t.Require().Error(err)
+	t.Require().Equal(expectedErr, err)
+	t.Require().Equal(v0.SessionsOutput{}, res)
+}
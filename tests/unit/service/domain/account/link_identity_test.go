@@ -0,0 +1,297 @@
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/mandarine-io/backend/third_party/oauth"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/oauth2"
+	"time"
+)
+
+const linkCallbackURL = "http://localhost:8080/auth/social/mock/callback/"
+
+var linkClientInfo = infrastructure.ClientInfo{IP: "127.0.0.1", UserAgent: "test"}
+
+type LinkIdentitySuite struct {
+	suite.Suite
+}
+
+// mockConsumeOAuthState makes OTP service return data saved by social login consent page request
+func mockConsumeOAuthState(ctx context.Context, state string, data v0.OAuthStateData) {
+	dataBytes, _ := json.Marshal(data)
+
+	otpServiceMock.On("ConsumeDataByToken", ctx, "oauth_state", state, mock.Anything).Run(
+		func(args mock.Arguments) {
+			_ = json.Unmarshal(dataBytes, args.Get(3))
+		},
+	).Once().Return(nil)
+}
+
+// mockConsumeOAuthToken makes OTP service accept or reject token, to which code is exchanged
+func mockConsumeOAuthToken(ctx context.Context, accessToken string, err error) {
+	otpServiceMock.On("ConsumeToken", ctx, "oauth_token", accessToken, oauth.MaxCodeAge).Once().Return(err)
+}
+
+func (s *LinkIdentitySuite) Test_Success(t provider.T) {
+	t.Title("LinkIdentity links provider account to the user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("LinkIdentity")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	input := v0.LinkIdentityInput{Code: "link_code_1", State: "link_state_1"}
+	token := &oauth2.Token{AccessToken: "link_token_1"}
+	userInfo := oauth.UserInfo{ID: "subject_1", Email: "link1@example.com"}
+	now := time.Now()
+
+	mockConsumeOAuthState(
+		ctx,
+		input.State,
+		v0.OAuthStateData{Provider: "mock", CodeVerifier: "verifier", UserAgent: linkClientInfo.UserAgent},
+	)
+	mockConsumeOAuthToken(ctx, token.AccessToken, nil)
+	oauthProviderMock.On(
+		"ExchangeCodeToToken", ctx, input.Code, linkCallbackURL, "verifier", mock.Anything, mock.Anything, mock.Anything,
+	).Once().Return(token, nil)
+	oauthProviderMock.On("GetUserInfo", ctx, token).Once().Return(userInfo, nil)
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", ctx, "mock", userInfo.ID).Once().Return(nil, nil)
+	identityRepoMock.On("CreateUserIdentity", ctx, mock.MatchedBy(func(identity *entity.UserIdentity) bool {
+		return identity.UserID == userID && identity.Provider == "mock" && identity.Subject == userInfo.ID &&
+			*identity.Email == userInfo.Email
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*entity.UserIdentity).CreatedAt = now
+	}).Once().Return(func(_ context.Context, identity *entity.UserIdentity) *entity.UserIdentity {
+		return identity
+	}, nil)
+
+	resp, err := svc.LinkIdentity(ctx, userID, "mock", input, linkClientInfo)
+
+	t.Require().NoError(err)
+	t.Require().Equal(v0.IdentityOutput{Provider: "mock", Email: lo.ToPtr(userInfo.Email), CreatedAt: now}, resp)
+}
+
+func (s *LinkIdentitySuite) Test_AlreadyLinkedToUser(t provider.T) {
+	t.Title("LinkIdentity returns existing identity if it is already linked to the user")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("LinkIdentity")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	input := v0.LinkIdentityInput{Code: "link_code_2", State: "link_state_2"}
+	token := &oauth2.Token{AccessToken: "link_token_2"}
+	userInfo := oauth.UserInfo{ID: "subject_2"}
+	identity := &entity.UserIdentity{UserID: userID, Provider: "mock", Subject: userInfo.ID}
+
+	mockConsumeOAuthState(
+		ctx,
+		input.State,
+		v0.OAuthStateData{Provider: "mock", CodeVerifier: "verifier", UserAgent: linkClientInfo.UserAgent},
+	)
+	mockConsumeOAuthToken(ctx, token.AccessToken, nil)
+	oauthProviderMock.On(
+		"ExchangeCodeToToken", ctx, input.Code, linkCallbackURL, "verifier", mock.Anything, mock.Anything, mock.Anything,
+	).Once().Return(token, nil)
+	oauthProviderMock.On("GetUserInfo", ctx, token).Once().Return(userInfo, nil)
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", ctx, "mock", userInfo.ID).Once().Return(identity, nil)
+
+	resp, err := svc.LinkIdentity(ctx, userID, "mock", input, linkClientInfo)
+
+	t.Require().NoError(err)
+	t.Require().Equal("mock", resp.Provider)
+}
+
+func (s *LinkIdentitySuite) Test_LinkedToAnotherUser(t provider.T) {
+	t.Title("LinkIdentity returns IdentityLinked error if identity belongs to another user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("LinkIdentity")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	input := v0.LinkIdentityInput{Code: "link_code_3", State: "link_state_3"}
+	token := &oauth2.Token{AccessToken: "link_token_3"}
+	userInfo := oauth.UserInfo{ID: "subject_3"}
+	identity := &entity.UserIdentity{UserID: uuid.New(), Provider: "mock", Subject: userInfo.ID}
+
+	mockConsumeOAuthState(
+		ctx,
+		input.State,
+		v0.OAuthStateData{Provider: "mock", CodeVerifier: "verifier", UserAgent: linkClientInfo.UserAgent},
+	)
+	mockConsumeOAuthToken(ctx, token.AccessToken, nil)
+	oauthProviderMock.On(
+		"ExchangeCodeToToken", ctx, input.Code, linkCallbackURL, "verifier", mock.Anything, mock.Anything, mock.Anything,
+	).Once().Return(token, nil)
+	oauthProviderMock.On("GetUserInfo", ctx, token).Once().Return(userInfo, nil)
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", ctx, "mock", userInfo.ID).Once().Return(identity, nil)
+
+	resp, err := svc.LinkIdentity(ctx, userID, "mock", input, linkClientInfo)
+
+	t.Require().Equal(domain.ErrIdentityLinked, err)
+	t.Require().Equal(v0.IdentityOutput{}, resp)
+}
+
+func (s *LinkIdentitySuite) Test_ProviderAlreadyLinked(t provider.T) {
+	t.Title("LinkIdentity returns IdentityLinked error if user has another account of the provider")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("LinkIdentity")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	input := v0.LinkIdentityInput{Code: "link_code_4", State: "link_state_4"}
+	token := &oauth2.Token{AccessToken: "link_token_4"}
+	userInfo := oauth.UserInfo{ID: "subject_4"}
+
+	mockConsumeOAuthState(
+		ctx,
+		input.State,
+		v0.OAuthStateData{Provider: "mock", CodeVerifier: "verifier", UserAgent: linkClientInfo.UserAgent},
+	)
+	mockConsumeOAuthToken(ctx, token.AccessToken, nil)
+	oauthProviderMock.On(
+		"ExchangeCodeToToken", ctx, input.Code, linkCallbackURL, "verifier", mock.Anything, mock.Anything, mock.Anything,
+	).Once().Return(token, nil)
+	oauthProviderMock.On("GetUserInfo", ctx, token).Once().Return(userInfo, nil)
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", ctx, "mock", userInfo.ID).Once().Return(nil, nil)
+	identityRepoMock.On("CreateUserIdentity", ctx, mock.MatchedBy(func(identity *entity.UserIdentity) bool {
+		return identity.Subject == userInfo.ID
+	})).Once().Return(nil, repo.ErrDuplicateUserIdentity)
+
+	resp, err := svc.LinkIdentity(ctx, userID, "mock", input, linkClientInfo)
+
+	t.Require().Equal(domain.ErrIdentityLinked, err)
+	t.Require().Equal(v0.IdentityOutput{}, resp)
+}
+
+func (s *LinkIdentitySuite) Test_InvalidProvider(t provider.T) {
+	t.Title("LinkIdentity returns InvalidProvider error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("LinkIdentity")
+	t.Tags("Negative")
+
+	resp, err := svc.LinkIdentity(
+		context.Background(),
+		uuid.New(),
+		"unknown",
+		v0.LinkIdentityInput{},
+		linkClientInfo,
+	)
+
+	t.Require().Equal(domain.ErrInvalidProvider, err)
+	t.Require().Equal(v0.IdentityOutput{}, resp)
+}
+
+func (s *LinkIdentitySuite) Test_EmptySubject(t provider.T) {
+	t.Title("LinkIdentity returns UserInfoNotReceived error if provider does not return subject")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("LinkIdentity")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	input := v0.LinkIdentityInput{Code: "link_code_5", State: "link_state_5"}
+	token := &oauth2.Token{AccessToken: "link_token_5"}
+
+	mockConsumeOAuthState(
+		ctx,
+		input.State,
+		v0.OAuthStateData{Provider: "mock", CodeVerifier: "verifier", UserAgent: linkClientInfo.UserAgent},
+	)
+	mockConsumeOAuthToken(ctx, token.AccessToken, nil)
+	oauthProviderMock.On(
+		"ExchangeCodeToToken", ctx, input.Code, linkCallbackURL, "verifier", mock.Anything, mock.Anything, mock.Anything,
+	).Once().Return(token, nil)
+	oauthProviderMock.On("GetUserInfo", ctx, token).Once().Return(oauth.UserInfo{Email: "link5@example.com"}, nil)
+
+	resp, err := svc.LinkIdentity(ctx, uuid.New(), "mock", input, linkClientInfo)
+
+	t.Require().Equal(domain.ErrUserInfoNotReceived, err)
+	t.Require().Equal(v0.IdentityOutput{}, resp)
+}
+
+func (s *LinkIdentitySuite) Test_InvalidOrReplayedState(t provider.T) {
+	t.Title("LinkIdentity returns InvalidState error for unknown, expired or already used state")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("LinkIdentity")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	input := v0.LinkIdentityInput{Code: "link_code_6", State: "link_state_6"}
+
+	otpServiceMock.On("ConsumeDataByToken", ctx, "oauth_state", input.State, mock.Anything).
+		Once().Return(infrastructure.ErrInvalidOrExpiredOTP)
+
+	resp, err := svc.LinkIdentity(ctx, uuid.New(), "mock", input, linkClientInfo)
+
+	t.Require().Equal(domain.ErrInvalidState, err)
+	t.Require().Equal(v0.IdentityOutput{}, resp)
+}
+
+func (s *LinkIdentitySuite) Test_StateOfAnotherClient(t provider.T) {
+	t.Title("LinkIdentity returns InvalidState error for state issued for another client")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("LinkIdentity")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	input := v0.LinkIdentityInput{Code: "link_code_7", State: "link_state_7"}
+
+	mockConsumeOAuthState(
+		ctx,
+		input.State,
+		v0.OAuthStateData{Provider: "mock", CodeVerifier: "verifier", UserAgent: "another"},
+	)
+
+	resp, err := svc.LinkIdentity(ctx, uuid.New(), "mock", input, linkClientInfo)
+
+	t.Require().Equal(domain.ErrInvalidState, err)
+	t.Require().Equal(v0.IdentityOutput{}, resp)
+}
+
+func (s *LinkIdentitySuite) Test_ReplayedCode(t provider.T) {
+	t.Title("LinkIdentity returns InvalidState error for already used code")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("LinkIdentity")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	input := v0.LinkIdentityInput{Code: "link_code_8", State: "link_state_8"}
+	token := &oauth2.Token{AccessToken: "link_token_8"}
+
+	mockConsumeOAuthState(
+		ctx,
+		input.State,
+		v0.OAuthStateData{Provider: "mock", CodeVerifier: "verifier", UserAgent: linkClientInfo.UserAgent},
+	)
+	oauthProviderMock.On(
+		"ExchangeCodeToToken", ctx, input.Code, linkCallbackURL, "verifier", mock.Anything, mock.Anything, mock.Anything,
+	).Once().Return(token, nil)
+	mockConsumeOAuthToken(ctx, token.AccessToken, infrastructure.ErrInvalidOrExpiredOTP)
+
+	resp, err := svc.LinkIdentity(ctx, uuid.New(), "mock", input, linkClientInfo)
+
+	t.Require().Equal(domain.ErrInvalidState, err)
+	t.Require().Equal(v0.IdentityOutput{}, resp)
+}
@@ -0,0 +1,92 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/samber/lo"
+)
+
+type RegenerateRecoveryCodesSuite struct {
+	suite.Suite
+}
+
+func (s *RegenerateRecoveryCodesSuite) Test_Success(t provider.T) {
+	t.Title("RegenerateRecoveryCodes returns new recovery codes")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("RegenerateRecoveryCodes")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID, TOTPSecret: lo.ToPtr("JBSWY3DPEHPK3PXP"), IsTOTPEnabled: true}
+	input := v0.TOTPCodeInput{Code: "123456"}
+	codes := []string{"abcde-fghij"}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("CheckAttempt", ctx, totpAttempt(userID)).Once().Return(nil)
+	totpServiceMock.On("VerifyCode", ctx, userEntity, input.Code).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, totpAttempt(userID)).Once().Return(nil)
+	totpServiceMock.On("GenerateRecoveryCodes", ctx, userID).Once().Return(codes, nil)
+
+	// Act
+	resp, err := svc.RegenerateRecoveryCodes(ctx, userID, input, clientInfo)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(v0.RecoveryCodesOutput{Codes: codes}, resp)
+}
+
+func (s *RegenerateRecoveryCodesSuite) Test_MFANotEnabled(t provider.T) {
+	t.Title("RegenerateRecoveryCodes returns MFANotEnabled error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("RegenerateRecoveryCodes")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+
+	// Act
+	_, err := svc.RegenerateRecoveryCodes(ctx, userID, v0.TOTPCodeInput{Code: "123456"}, clientInfo)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrMFANotEnabled)
+}
+
+func (s *RegenerateRecoveryCodesSuite) Test_InvalidCode(t provider.T) {
+	t.Title("RegenerateRecoveryCodes returns InvalidTOTPCode error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("RegenerateRecoveryCodes")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID, TOTPSecret: lo.ToPtr("JBSWY3DPEHPK3PXP"), IsTOTPEnabled: true}
+	input := v0.TOTPCodeInput{Code: "000000"}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("CheckAttempt", ctx, totpAttempt(userID)).Once().Return(nil)
+	totpServiceMock.On("VerifyCode", ctx, userEntity, input.Code).Once().Return(infrastructure.ErrInvalidTOTPCode)
+	bruteForceServiceMock.On("FailAttempt", ctx, totpAttempt(userID)).Once().Return(nil)
+
+	// Act
+	_, err := svc.RegenerateRecoveryCodes(ctx, userID, input, clientInfo)
+
+	// Assert
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidTOTPCode)
+}
@@ -0,0 +1,52 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/pkg/errors"
+)
+
+type RevokeOtherSessionsSuite struct {
+	suite.Suite
+}
+
+func (s *RevokeOtherSessionsSuite) Test_Success(t provider.T) {
+	t.Title("Successfully revokes all sessions except the current one")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("RevokeOtherSessions")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	jwtServiceMock.On("RevokeSessions", ctx, userID, sessionID).Once().Return(nil)
+
+	err := svc.RevokeOtherSessions(ctx, userID, sessionID)
+
+	t.Require().NoError(err)
+}
+
+func (s *RevokeOtherSessionsSuite) Test_ErrorRevokeSessions(t provider.T) {
+	t.Title("Returns error when revoking sessions fails")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("RevokeOtherSessions")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	sessionID := uuid.New()
+	expectedErr := errors.New("database error")
+
+	jwtServiceMock.On("RevokeSessions", ctx, userID, sessionID).Once().Return(expectedErr)
+
+	err := svc.RevokeOtherSessions(ctx, userID, sessionID)
+
+	t.Require().Error(err)
+	t.Require().Equal(expectedErr, err)
+}
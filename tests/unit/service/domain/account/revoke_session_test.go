@@ -0,0 +1,51 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type RevokeSessionSuite struct {
+	suite.Suite
+}
+
+func (s *RevokeSessionSuite) Test_Success(t provider.T) {
+	t.Title("Successfully revokes the session")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("RevokeSession")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	jwtServiceMock.On("RevokeSession", ctx, userID, sessionID).Once().Return(nil)
+
+	err := svc.RevokeSession(ctx, userID, sessionID)
+
+	t.Require().NoError(err)
+}
+
+func (s *RevokeSessionSuite) Test_SessionNotFound(t provider.T) {
+	t.Title("Returns error when session is not found")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("RevokeSession")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	sessionID := uuid.New()
+
+	jwtServiceMock.On("RevokeSession", ctx, userID, sessionID).Once().Return(infrastructure.ErrSessionNotFound)
+
+	err := svc.RevokeSession(ctx, userID, sessionID)
+
+	t.Require().Error(err)
+	t.Require().Equal(infrastructure.ErrSessionNotFound, err)
+}
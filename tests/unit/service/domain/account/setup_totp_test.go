@@ -0,0 +1,110 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/pkg/errors"
+)
+
+type SetupTOTPSuite struct {
+	suite.Suite
+}
+
+func (s *SetupTOTPSuite) Test_Success(t provider.T) {
+	t.Title("SetupTOTP returns secret and provisioning URI")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("SetupTOTP")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	secret := "JBSWY3DPEHPK3PXP"
+	uri := "otpauth://totp/Mandarine:user@example.com?secret=JBSWY3DPEHPK3PXP"
+	userEntity := &entity.User{ID: userID, Email: "user@example.com"}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	totpServiceMock.On("GenerateSecret", ctx).Once().Return(secret, nil)
+	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(userEntity, nil)
+	totpServiceMock.On("GetProvisioningURI", ctx, secret, userEntity.Email).Once().Return(uri)
+
+	// Act
+	resp, err := svc.SetupTOTP(ctx, userID)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(v0.TOTPSetupOutput{Secret: secret, ProvisioningURI: uri}, resp)
+	t.Require().Equal(&secret, userEntity.TOTPSecret)
+	t.Require().False(userEntity.IsTOTPEnabled)
+}
+
+func (s *SetupTOTPSuite) Test_UserNotFound(t provider.T) {
+	t.Title("SetupTOTP returns UserNotFound error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("SetupTOTP")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(nil, nil)
+
+	// Act
+	_, err := svc.SetupTOTP(ctx, userID)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrUserNotFound)
+}
+
+func (s *SetupTOTPSuite) Test_MFAAlreadyEnabled(t provider.T) {
+	t.Title("SetupTOTP returns MFAAlreadyEnabled error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("SetupTOTP")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID, IsTOTPEnabled: true}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+
+	// Act
+	_, err := svc.SetupTOTP(ctx, userID)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrMFAAlreadyEnabled)
+}
+
+func (s *SetupTOTPSuite) Test_ErrorGeneratingSecret(t provider.T) {
+	t.Title("SetupTOTP returns error when secret generation fails")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("SetupTOTP")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID}
+	expectedErr := errors.New("rand error")
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	totpServiceMock.On("GenerateSecret", ctx).Once().Return("", expectedErr)
+
+	// Act
+	_, err := svc.SetupTOTP(ctx, userID)
+
+	// Assert
+	t.Require().ErrorIs(err, expectedErr)
+}
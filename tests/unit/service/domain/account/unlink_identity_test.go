@@ -0,0 +1,99 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type UnlinkIdentitySuite struct {
+	suite.Suite
+}
+
+func (s *UnlinkIdentitySuite) Test_Success(t provider.T) {
+	t.Title("UnlinkIdentity removes identity of user with password")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("UnlinkIdentity")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID, IsPasswordTemp: false}
+	identities := []*entity.UserIdentity{{UserID: userID, Provider: "google"}}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	identityRepoMock.On("FindUserIdentitiesByUserID", ctx, userID).Once().Return(identities, nil)
+	identityRepoMock.On("DeleteUserIdentity", ctx, userID, "google").Once().Return(true, nil)
+
+	err := svc.UnlinkIdentity(ctx, userID, "google")
+
+	t.Require().NoError(err)
+}
+
+func (s *UnlinkIdentitySuite) Test_SuccessOtherIdentity(t provider.T) {
+	t.Title("UnlinkIdentity removes identity of user without password, if another identity is linked")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("UnlinkIdentity")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID, IsPasswordTemp: true}
+	identities := []*entity.UserIdentity{
+		{UserID: userID, Provider: "google"},
+		{UserID: userID, Provider: "yandex"},
+	}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	identityRepoMock.On("FindUserIdentitiesByUserID", ctx, userID).Once().Return(identities, nil)
+	identityRepoMock.On("DeleteUserIdentity", ctx, userID, "yandex").Once().Return(true, nil)
+
+	err := svc.UnlinkIdentity(ctx, userID, "yandex")
+
+	t.Require().NoError(err)
+}
+
+func (s *UnlinkIdentitySuite) Test_IdentityNotFound(t provider.T) {
+	t.Title("UnlinkIdentity returns IdentityNotFound error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("UnlinkIdentity")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	identityRepoMock.On("FindUserIdentitiesByUserID", ctx, userID).Once().Return([]*entity.UserIdentity{}, nil)
+
+	err := svc.UnlinkIdentity(ctx, userID, "google")
+
+	t.Require().Equal(domain.ErrIdentityNotFound, err)
+}
+
+func (s *UnlinkIdentitySuite) Test_LastLoginMethod(t provider.T) {
+	t.Title("UnlinkIdentity returns LastLoginMethod error for user without password, verified email and phone")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("UnlinkIdentity")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID, IsPasswordTemp: true}
+	identities := []*entity.UserIdentity{{UserID: userID, Provider: "google"}}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	identityRepoMock.On("FindUserIdentitiesByUserID", ctx, userID).Once().Return(identities, nil)
+
+	err := svc.UnlinkIdentity(ctx, userID, "google")
+
+	t.Require().Equal(domain.ErrLastLoginMethod, err)
+}
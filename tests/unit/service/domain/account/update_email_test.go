@@ -37,7 +37,8 @@ func (s *UpdateEmailSuite) Test_Success(t provider.T) {
 
 	userRepoMock.On("ExistsUserByEmail", ctx, "new@example.com").Once().Return(false, nil)
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
-	otpServiceMock.On("GenerateAndSaveWithCode", ctx, "email_verify", "new@example.com").Once().Return("123456", nil)
+	otpServiceMock.On("GenerateAndSaveWithSubject", ctx, "email_verify", "new@example.com", userID.String()).
+		Once().Return("123456", nil)
 	templateEngineMock.On("RenderHTML", "email-verify", mock.Anything).Once().Return("content", nil)
 	smtpSenderMock.On("SendHTMLMessage", mock.Anything, "content", mock.Anything, req.Email).Once().Return(nil)
 	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(userEntity, nil)
@@ -195,7 +196,8 @@ func (s *UpdateEmailSuite) Test_SetInCacheError(t provider.T) {
 
 	userRepoMock.On("ExistsUserByEmail", ctx, "new@example.com").Once().Return(false, nil)
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
-	otpServiceMock.On("GenerateAndSaveWithCode", ctx, "email_verify", "new@example.com").Once().Return("", cacheError)
+	otpServiceMock.On("GenerateAndSaveWithSubject", ctx, "email_verify", "new@example.com", userID.String()).
+		Once().Return("", cacheError)
 
 	resp, err := svc.UpdateEmail(ctx, userID, req, nil)
 
@@ -224,7 +226,8 @@ func (s *UpdateEmailSuite) Test_RenderContentError(t provider.T) {
 
 	userRepoMock.On("ExistsUserByEmail", ctx, "new@example.com").Once().Return(false, nil)
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
-	otpServiceMock.On("GenerateAndSaveWithCode", ctx, "email_verify", "new@example.com").Once().Return("123456", nil)
+	otpServiceMock.On("GenerateAndSaveWithSubject", ctx, "email_verify", "new@example.com", userID.String()).
+		Once().Return("123456", nil)
 	templateEngineMock.On("RenderHTML", "email-verify", mock.Anything).Once().Return("", renderError)
 
 	resp, err := svc.UpdateEmail(ctx, userID, req, nil)
@@ -254,7 +257,8 @@ func (s *UpdateEmailSuite) Test_SendHTMLMessageError(t provider.T) {
 
 	userRepoMock.On("ExistsUserByEmail", ctx, "new@example.com").Once().Return(false, nil)
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
-	otpServiceMock.On("GenerateAndSaveWithCode", ctx, "email_verify", "new@example.com").Once().Return("123456", nil)
+	otpServiceMock.On("GenerateAndSaveWithSubject", ctx, "email_verify", "new@example.com", userID.String()).
+		Once().Return("123456", nil)
 	templateEngineMock.On("RenderHTML", "email-verify", mock.Anything).Once().Return("content", nil)
 	smtpSenderMock.On("SendHTMLMessage", mock.Anything, "content", mock.Anything, req.Email).Once().Return(sendError)
 
@@ -285,7 +289,8 @@ func (s *UpdateEmailSuite) Test_UpdateUserError(t provider.T) {
 
 	userRepoMock.On("ExistsUserByEmail", ctx, "new@example.com").Once().Return(false, nil)
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
-	otpServiceMock.On("GenerateAndSaveWithCode", ctx, "email_verify", "new@example.com").Once().Return("123456", nil)
+	otpServiceMock.On("GenerateAndSaveWithSubject", ctx, "email_verify", "new@example.com", userID.String()).
+		Once().Return("123456", nil)
 	templateEngineMock.On("RenderHTML", "email-verify", mock.Anything).Once().Return("content", nil)
 	smtpSenderMock.On("SendHTMLMessage", mock.Anything, "content", mock.Anything, req.Email).Once().Return(nil)
 	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(nil, updateError)
@@ -28,6 +28,7 @@ func (s *UpdatePasswordSuite) Test_Success(t provider.T) {
 
 	ctx := context.Background()
 	userID := uuid.New()
+	sessionID := uuid.New()
 	hashPassword, _ := security.HashPassword("oldpassword")
 	userEntity := &entity.User{
 		ID:             userID,
@@ -42,7 +43,7 @@ func (s *UpdatePasswordSuite) Test_Success(t provider.T) {
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
 	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(userEntity, nil)
 
-	err := svc.UpdatePassword(ctx, userID, req)
+	err := svc.UpdatePassword(ctx, userID, sessionID, req)
 
 	t.Require().NoError(err)
 }
@@ -56,6 +57,7 @@ func (s *UpdatePasswordSuite) Test_UserNotFound(t provider.T) {
 
 	ctx := context.Background()
 	userID := uuid.New()
+	sessionID := uuid.New()
 	req := v0.UpdatePasswordInput{
 		OldPassword: "oldpassword",
 		NewPassword: "newpassword",
@@ -63,7 +65,7 @@ func (s *UpdatePasswordSuite) Test_UserNotFound(t provider.T) {
 
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(nil, nil)
 
-	err := svc.UpdatePassword(ctx, userID, req)
+	err := svc.UpdatePassword(ctx, userID, sessionID, req)
 
 	t.Require().Error(err)
 	t.Require().Equal(domain.ErrUserNotFound, err)
@@ -78,6 +80,7 @@ func (s *UpdatePasswordSuite) Test_ErrorFindingUser(t provider.T) {
 
 	ctx := context.Background()
 	userID := uuid.New()
+	sessionID := uuid.New()
 	expectedErr := errors.New("database error")
 	req := v0.UpdatePasswordInput{
 		OldPassword: "oldpassword",
@@ -86,7 +89,7 @@ func (s *UpdatePasswordSuite) Test_ErrorFindingUser(t provider.T) {
 
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(nil, expectedErr)
 
-	err := svc.UpdatePassword(ctx, userID, req)
+	err := svc.UpdatePassword(ctx, userID, sessionID, req)
 
 	t.Require().Error(err)
 	t.Require().Equal(expectedErr, err)
@@ -101,6 +104,7 @@ func (s *UpdatePasswordSuite) Test_IncorrectOldPassword(t provider.T) {
 
 	ctx := context.Background()
 	userID := uuid.New()
+	sessionID := uuid.New()
 	hashPassword, _ := security.HashPassword("oldpassword")
 	userEntity := &entity.User{
 		ID:             userID,
@@ -114,7 +118,7 @@ func (s *UpdatePasswordSuite) Test_IncorrectOldPassword(t provider.T) {
 
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
 
-	err := svc.UpdatePassword(ctx, userID, req)
+	err := svc.UpdatePassword(ctx, userID, sessionID, req)
 
 	t.Require().Error(err)
 	t.Require().Equal(domain.ErrIncorrectOldPassword, err)
@@ -129,6 +133,7 @@ func (s *UpdatePasswordSuite) Test_ErrorHashPassword(t provider.T) {
 
 	ctx := context.Background()
 	userID := uuid.New()
+	sessionID := uuid.New()
 	hashPassword, _ := security.HashPassword("oldpassword")
 	userEntity := &entity.User{
 		ID:             userID,
@@ -142,7 +147,7 @@ func (s *UpdatePasswordSuite) Test_ErrorHashPassword(t provider.T) {
 
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
 
-	err := svc.UpdatePassword(ctx, userID, req)
+	err := svc.UpdatePassword(ctx, userID, sessionID, req)
 
 	t.Require().Error(err)
 	t.Require().Equal(bcrypt.ErrPasswordTooLong, err)
@@ -157,6 +162,7 @@ func (s *UpdatePasswordSuite) Test_ErrorUpdateUser(t provider.T) {
 
 	ctx := context.Background()
 	userID := uuid.New()
+	sessionID := uuid.New()
 	hashPassword, _ := security.HashPassword("oldpassword")
 	userEntity := &entity.User{
 		ID:             userID,
@@ -172,7 +178,71 @@ func (s *UpdatePasswordSuite) Test_ErrorUpdateUser(t provider.T) {
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
 	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(nil, expectedErr)
 
-	err := svc.UpdatePassword(ctx, userID, req)
+	err := svc.UpdatePassword(ctx, userID, sessionID, req)
+
+	t.Require().Error(err)
+	t.Require().Equal(expectedErr, err)
+}
+
+func (s *UpdatePasswordSuite) Test_SuccessWithRevokeOtherSessions(t provider.T) {
+	t.Title("Successfully updates the password and revokes other sessions")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("UpdatePassword")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	sessionID := uuid.New()
+	hashPassword, _ := security.HashPassword("oldpassword")
+	userEntity := &entity.User{
+		ID:             userID,
+		IsPasswordTemp: false,
+		Password:       hashPassword,
+	}
+	req := v0.UpdatePasswordInput{
+		OldPassword:         "oldpassword",
+		NewPassword:         "newpassword",
+		RevokeOtherSessions: true,
+	}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(userEntity, nil)
+	jwtServiceMock.On("RevokeSessions", ctx, userID, sessionID).Once().Return(nil)
+
+	err := svc.UpdatePassword(ctx, userID, sessionID, req)
+
+	t.Require().NoError(err)
+}
+
+func (s *UpdatePasswordSuite) Test_ErrorRevokeOtherSessions(t provider.T) {
+	t.Title("Returns error when revoking other sessions fails")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("UpdatePassword")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	sessionID := uuid.New()
+	hashPassword, _ := security.HashPassword("oldpassword")
+	userEntity := &entity.User{
+		ID:             userID,
+		IsPasswordTemp: false,
+		Password:       hashPassword,
+	}
+	req := v0.UpdatePasswordInput{
+		OldPassword:         "oldpassword",
+		NewPassword:         "newpassword",
+		RevokeOtherSessions: true,
+	}
+	expectedErr := errors.New("database error")
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(userEntity, nil)
+	jwtServiceMock.On("RevokeSessions", ctx, userID, sessionID).Once().Return(expectedErr)
+
+	err := svc.UpdatePassword(ctx, userID, sessionID, req)
 
 	t.Require().Error(err)
 	t.Require().Equal(expectedErr, err)
@@ -0,0 +1,160 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+type UpdatePhoneSuite struct {
+	suite.Suite
+}
+
+func (s *UpdatePhoneSuite) Test_Success(t provider.T) {
+	t.Title("Successfully updates phone")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("UpdatePhone")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID, Email: "phone1@example.com"}
+	req := v0.UpdatePhoneInput{Phone: "+79990000101"}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	userRepoMock.On("ExistsUserByPhone", ctx, req.Phone).Once().Return(false, nil)
+	otpServiceMock.On("GenerateAndSaveWithSubject", ctx, "phone_verify", req.Phone, userID.String()).
+		Once().Return("123456", nil)
+	smsSenderMock.On("SendMessage", ctx, req.Phone, mock.MatchedBy(func(content string) bool {
+		return content == "Mandarine: your phone confirmation code is 123456. It is valid for 10 min."
+	})).Once().Return(nil)
+	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(userEntity, nil)
+
+	resp, err := svc.UpdatePhone(ctx, userID, req, nil)
+
+	t.Require().NoError(err)
+	t.Require().NotNil(resp.Phone)
+	t.Require().Equal(req.Phone, *resp.Phone)
+	t.Require().False(resp.IsPhoneVerified)
+}
+
+func (s *UpdatePhoneSuite) Test_PhoneNotChanged(t provider.T) {
+	t.Title("Does not change verified phone if the new phone is the same as the current one")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("UpdatePhone")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	phone := "+79990000102"
+	userEntity := &entity.User{ID: userID, Phone: &phone, IsPhoneVerified: true}
+	req := v0.UpdatePhoneInput{Phone: phone}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+
+	resp, err := svc.UpdatePhone(ctx, userID, req, nil)
+
+	t.Require().NoError(err)
+	t.Require().Equal(phone, *resp.Phone)
+	t.Require().True(resp.IsPhoneVerified)
+}
+
+func (s *UpdatePhoneSuite) Test_ResendForUnverifiedPhone(t provider.T) {
+	t.Title("Sends new code if the same phone is not verified")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("UpdatePhone")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	phone := "+79990000103"
+	userEntity := &entity.User{ID: userID, Phone: &phone}
+	req := v0.UpdatePhoneInput{Phone: phone}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	otpServiceMock.On("GenerateAndSaveWithSubject", ctx, "phone_verify", req.Phone, userID.String()).
+		Once().Return("123456", nil)
+	smsSenderMock.On("SendMessage", ctx, req.Phone, mock.Anything).Once().Return(nil)
+	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(userEntity, nil)
+
+	resp, err := svc.UpdatePhone(ctx, userID, req, nil)
+
+	t.Require().NoError(err)
+	t.Require().False(resp.IsPhoneVerified)
+	userRepoMock.AssertNotCalled(t, "ExistsUserByPhone", ctx, req.Phone)
+}
+
+func (s *UpdatePhoneSuite) Test_UserNotFound(t provider.T) {
+	t.Title("Returns UserNotFound error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("UpdatePhone")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	req := v0.UpdatePhoneInput{Phone: "+79990000104"}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(nil, nil)
+
+	resp, err := svc.UpdatePhone(ctx, userID, req, nil)
+
+	t.Require().Equal(domain.ErrUserNotFound, err)
+	t.Require().Equal(v0.AccountOutput{}, resp)
+}
+
+func (s *UpdatePhoneSuite) Test_DuplicatePhone(t provider.T) {
+	t.Title("Returns ErrDuplicatePhone when phone already exists")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("UpdatePhone")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID}
+	req := v0.UpdatePhoneInput{Phone: "+79990000105"}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	userRepoMock.On("ExistsUserByPhone", ctx, req.Phone).Once().Return(true, nil)
+
+	resp, err := svc.UpdatePhone(ctx, userID, req, nil)
+
+	t.Require().Equal(domain.ErrDuplicatePhone, err)
+	t.Require().Equal(v0.AccountOutput{}, resp)
+}
+
+func (s *UpdatePhoneSuite) Test_SendSMSError(t provider.T) {
+	t.Title("Returns ErrSendSMS when SMS is not sent")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("UpdatePhone")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	userEntity := &entity.User{ID: userID}
+	req := v0.UpdatePhoneInput{Phone: "+79990000106"}
+
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	userRepoMock.On("ExistsUserByPhone", ctx, req.Phone).Once().Return(false, nil)
+	otpServiceMock.On("GenerateAndSaveWithSubject", ctx, "phone_verify", req.Phone, userID.String()).
+		Once().Return("123456", nil)
+	smsSenderMock.On("SendMessage", ctx, req.Phone, mock.Anything).Once().Return(errors.New("provider error"))
+
+	resp, err := svc.UpdatePhone(ctx, userID, req, nil)
+
+	t.Require().Equal(domain.ErrSendSMS, err)
+	t.Require().Equal(v0.AccountOutput{}, resp)
+	t.Require().Nil(userEntity.Phone)
+}
@@ -37,15 +37,15 @@ func (s *VerifyEmailSuite) Test_VerifyEmail_Success(t provider.T) {
 		OTP:   "123456",
 	}
 
-	otpServiceMock.On("GetDataByCode", ctx, "email_verify", "123456", mock.Anything).Run(
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "email_verify", req.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			email := args.Get(3).(*string)
-			*email = "test@example.com"
+			id := args.Get(4).(*string)
+			*id = userID.String()
 		},
 	).Once().Return(nil)
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
 	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(userEntity, nil)
-	otpServiceMock.On("DeleteDataByCode", ctx, "email_verify", "123456").Once().Return(nil)
+	otpServiceMock.On("DeleteDataBySubject", ctx, "email_verify", req.Email).Once().Return(nil)
 
 	err := svc.VerifyEmail(ctx, userID, req)
 
@@ -67,7 +67,7 @@ func (s *VerifyEmailSuite) Test_VerifyEmail_ErrCacheEntryNotFound(t provider.T)
 		OTP:   "123456",
 	}
 
-	otpServiceMock.On("GetDataByCode", ctx, "email_verify", "123456", mock.Anything).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "email_verify", req.Email, "123456", mock.Anything).Once().Return(nil)
 
 	err := svc.VerifyEmail(ctx, userID, req)
 
@@ -88,7 +88,7 @@ func (s *VerifyEmailSuite) Test_VerifyEmail_ErrGetCache(t provider.T) {
 		OTP:   "654321",
 	}
 	cacheError := errors.New("cache error")
-	otpServiceMock.On("GetDataByCode", ctx, "email_verify", "654321", mock.Anything).Once().Return(cacheError)
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "email_verify", req.Email, "654321", mock.Anything).Once().Return(cacheError)
 
 	err := svc.VerifyEmail(ctx, userID, req)
 
@@ -109,10 +109,10 @@ func (s *VerifyEmailSuite) Test_VerifyEmail_ErrUserNotFound(t provider.T) {
 		OTP:   "123456",
 	}
 
-	otpServiceMock.On("GetDataByCode", ctx, "email_verify", "123456", mock.Anything).Run(
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "email_verify", req.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			email := args.Get(3).(*string)
-			*email = "test@example.com"
+			id := args.Get(4).(*string)
+			*id = userID.String()
 		},
 	).Once().Return(nil)
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(nil, nil)
@@ -138,10 +138,10 @@ func (s *VerifyEmailSuite) Test_VerifyEmail_ErrFindUserById(t provider.T) {
 	}
 	findUserError := errors.New("database error")
 
-	otpServiceMock.On("GetDataByCode", ctx, "email_verify", "123456", mock.Anything).Run(
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "email_verify", req.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			email := args.Get(3).(*string)
-			*email = "test@example.com"
+			id := args.Get(4).(*string)
+			*id = userID.String()
 		},
 	).Once().Return(nil)
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(nil, findUserError)
@@ -153,7 +153,7 @@ func (s *VerifyEmailSuite) Test_VerifyEmail_ErrFindUserById(t provider.T) {
 }
 
 func (s *VerifyEmailSuite) Test_VerifyEmail_ErrCheckEmail(t provider.T) {
-	t.Title("Returns error when email in cache and user do not match")
+	t.Title("Returns error when email in request and user do not match")
 	t.Severity(allure.CRITICAL)
 	t.Epic("Account service")
 	t.Feature("VerifyEmail")
@@ -170,10 +170,10 @@ func (s *VerifyEmailSuite) Test_VerifyEmail_ErrCheckEmail(t provider.T) {
 		OTP:   "123456",
 	}
 
-	otpServiceMock.On("GetDataByCode", ctx, "email_verify", "123456", mock.Anything).Run(
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "email_verify", req.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			email := args.Get(3).(*string)
-			*email = "test@example.com"
+			id := args.Get(4).(*string)
+			*id = userID.String()
 		},
 	).Once().Return(nil)
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
@@ -204,10 +204,10 @@ func (s *VerifyEmailSuite) Test_VerifyEmail_ErrUpdateUser(t provider.T) {
 	}
 	updateUserError := errors.New("database error")
 
-	otpServiceMock.On("GetDataByCode", ctx, "email_verify", "123456", mock.Anything).Run(
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "email_verify", req.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			email := args.Get(3).(*string)
-			*email = "test@example.com"
+			id := args.Get(4).(*string)
+			*id = userID.String()
 		},
 	).Once().Return(nil)
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
@@ -239,17 +239,65 @@ func (s *VerifyEmailSuite) Test_VerifyEmail_ErrDeleteInCache(t provider.T) {
 	}
 	cacheError := errors.New("cache error")
 
-	otpServiceMock.On("GetDataByCode", ctx, "email_verify", "123456", mock.Anything).Run(
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "email_verify", req.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			email := args.Get(3).(*string)
-			*email = "test@example.com"
+			id := args.Get(4).(*string)
+			*id = userID.String()
 		},
 	).Once().Return(nil)
 	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
 	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(userEntity, nil)
-	otpServiceMock.On("DeleteDataByCode", ctx, "email_verify", "123456").Once().Return(cacheError)
+	otpServiceMock.On("DeleteDataBySubject", ctx, "email_verify", req.Email).Once().Return(cacheError)
 
 	err := svc.VerifyEmail(ctx, userID, req)
 
 	t.Require().NoError(err)
 }
+
+func (s *VerifyEmailSuite) Test_VerifyEmail_ErrAnotherUser(t provider.T) {
+	t.Title("Returns error when OTP is issued for another user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("VerifyEmail")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	req := v0.VerifyEmailInput{
+		Email: "test@example.com",
+		OTP:   "123456",
+	}
+
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "email_verify", req.Email, "123456", mock.Anything).Run(
+		func(args mock.Arguments) {
+			id := args.Get(4).(*string)
+			*id = uuid.New().String()
+		},
+	).Once().Return(nil)
+
+	err := svc.VerifyEmail(ctx, userID, req)
+
+	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
+}
+
+func (s *VerifyEmailSuite) Test_VerifyEmail_ErrAttemptsExceeded(t provider.T) {
+	t.Title("Returns error when attempts of OTP are exceeded")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("VerifyEmail")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	req := v0.VerifyEmailInput{
+		Email: "test@example.com",
+		OTP:   "123456",
+	}
+
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "email_verify", req.Email, "123456", mock.Anything).
+		Once().Return(infrastructure.ErrOTPAttemptsExceeded)
+
+	err := svc.VerifyEmail(ctx, userID, req)
+
+	t.Require().Equal(infrastructure.ErrOTPAttemptsExceeded, err)
+}
@@ -0,0 +1,111 @@
+package account
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+type VerifyPhoneSuite struct {
+	suite.Suite
+}
+
+func (s *VerifyPhoneSuite) Test_Success(t provider.T) {
+	t.Title("Successfully verifies phone")
+	t.Severity(allure.NORMAL)
+	t.Epic("Account service")
+	t.Feature("VerifyPhone")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	req := v0.VerifyPhoneInput{Phone: "+79990000201", OTP: "123456"}
+	userEntity := &entity.User{ID: userID, Phone: &req.Phone}
+
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "phone_verify", req.Phone, req.OTP, mock.Anything).Run(
+		func(args mock.Arguments) {
+			*args.Get(4).(*string) = userID.String()
+		},
+	).Once().Return(nil)
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(userEntity, nil)
+	otpServiceMock.On("DeleteDataBySubject", ctx, "phone_verify", req.Phone).Once().Return(nil)
+
+	err := svc.VerifyPhone(ctx, userID, req)
+
+	t.Require().NoError(err)
+	t.Require().True(userEntity.IsPhoneVerified)
+}
+
+func (s *VerifyPhoneSuite) Test_InvalidOTP(t provider.T) {
+	t.Title("Returns InvalidOrExpiredOTP error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("VerifyPhone")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	req := v0.VerifyPhoneInput{Phone: "+79990000202", OTP: "000000"}
+
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "phone_verify", req.Phone, req.OTP, mock.Anything).
+		Once().Return(infrastructure.ErrInvalidOrExpiredOTP)
+
+	err := svc.VerifyPhone(ctx, userID, req)
+
+	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
+}
+
+func (s *VerifyPhoneSuite) Test_AnotherUserOTP(t provider.T) {
+	t.Title("Returns InvalidOrExpiredOTP error if OTP was issued for another user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("VerifyPhone")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	req := v0.VerifyPhoneInput{Phone: "+79990000203", OTP: "123456"}
+
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "phone_verify", req.Phone, req.OTP, mock.Anything).Run(
+		func(args mock.Arguments) {
+			*args.Get(4).(*string) = uuid.New().String()
+		},
+	).Once().Return(nil)
+
+	err := svc.VerifyPhone(ctx, userID, req)
+
+	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
+}
+
+func (s *VerifyPhoneSuite) Test_PhoneChanged(t provider.T) {
+	t.Title("Returns InvalidOrExpiredOTP error if user phone has been changed")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Account service")
+	t.Feature("VerifyPhone")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	req := v0.VerifyPhoneInput{Phone: "+79990000204", OTP: "123456"}
+	otherPhone := "+79990000205"
+	userEntity := &entity.User{ID: userID, Phone: &otherPhone}
+
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "phone_verify", req.Phone, req.OTP, mock.Anything).Run(
+		func(args mock.Arguments) {
+			*args.Get(4).(*string) = userID.String()
+		},
+	).Once().Return(nil)
+	userRepoMock.On("FindUserByID", ctx, userID).Once().Return(userEntity, nil)
+
+	err := svc.VerifyPhone(ctx, userID, req)
+
+	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
+	t.Require().False(userEntity.IsPhoneVerified)
+}
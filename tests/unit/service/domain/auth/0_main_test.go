@@ -2,11 +2,13 @@ package auth
 
 import (
 	"github.com/mandarine-io/backend/config"
+	mock7 "github.com/mandarine-io/backend/internal/infrastructure/sms/mock"
 	mock4 "github.com/mandarine-io/backend/internal/infrastructure/smtp/mock"
 	mock5 "github.com/mandarine-io/backend/internal/infrastructure/template/mock"
 	mock2 "github.com/mandarine-io/backend/internal/persistence/repo/mock"
 	"github.com/mandarine-io/backend/internal/service/domain"
 	"github.com/mandarine-io/backend/internal/service/domain/auth"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
 	mock6 "github.com/mandarine-io/backend/internal/service/infrastructure/mock"
 	"github.com/mandarine-io/backend/third_party/oauth"
 	"github.com/mandarine-io/backend/third_party/oauth/mock"
@@ -17,24 +19,47 @@ import (
 )
 
 var (
-	userRepoMock       *mock2.UserRepositoryMock
-	smtpSenderMock     *mock4.SenderMock
-	templateEngineMock *mock5.EngineMock
-	jwtServiceMock     *mock6.JWTServiceMock
-	otpServiceMock     *mock6.OTPServiceMock
-	oauthProviderMock  *mock.ProviderMock
-	cfg                config.Config
-	svc                domain.AuthService
+	userRepoMock          *mock2.UserRepositoryMock
+	identityRepoMock      *mock2.UserIdentityRepositoryMock
+	transactorMock        *mock2.TransactorMock
+	smtpSenderMock        *mock4.SenderMock
+	smsSenderMock         *mock7.SenderMock
+	templateEngineMock    *mock5.EngineMock
+	jwtServiceMock        *mock6.JWTServiceMock
+	otpServiceMock        *mock6.OTPServiceMock
+	totpServiceMock       *mock6.TOTPServiceMock
+	webAuthnServiceMock   *mock6.WebAuthnServiceMock
+	bruteForceServiceMock *mock6.BruteForceServiceMock
+	oauthProviderMock     *mock.ProviderMock
+	cfg                   config.Config
+	svc                   domain.AuthService
+
+	clientInfo = infrastructure.ClientInfo{IP: "127.0.0.1", UserAgent: "test", DeviceName: "test"}
 )
 
 func init() {
 	userRepoMock = &mock2.UserRepositoryMock{}
+	identityRepoMock = &mock2.UserIdentityRepositoryMock{}
+	transactorMock = &mock2.TransactorMock{}
 	smtpSenderMock = &mock4.SenderMock{}
+	smsSenderMock = &mock7.SenderMock{}
 	templateEngineMock = &mock5.EngineMock{}
 	jwtServiceMock = &mock6.JWTServiceMock{}
 	otpServiceMock = &mock6.OTPServiceMock{}
+	totpServiceMock = &mock6.TOTPServiceMock{}
+	webAuthnServiceMock = &mock6.WebAuthnServiceMock{}
+	bruteForceServiceMock = &mock6.BruteForceServiceMock{}
 	cfg = config.Config{
+		Server: config.ServerConfig{
+			ExternalURL: "http://localhost:8080",
+		},
 		Security: config.SecurityConfig{
+			MagicLink: config.MagicLinkConfig{
+				TTL: 900,
+			},
+			OAuthState: config.OAuthStateConfig{
+				TTL: 1200,
+			},
 			OTP: config.OTPConfig{
 				Length: 6,
 				TTL:    600,
@@ -49,10 +74,16 @@ func init() {
 	svc = auth.NewService(
 		cfg,
 		smtpSenderMock,
+		smsSenderMock,
 		templateEngineMock,
 		userRepoMock,
+		identityRepoMock,
+		transactorMock,
 		jwtServiceMock,
 		otpServiceMock,
+		totpServiceMock,
+		webAuthnServiceMock,
+		bruteForceServiceMock,
 		oauthProviderMocks,
 	)
 }
@@ -69,9 +100,14 @@ func (s *AuthServiceSuite) Test(t provider.T) {
 	t.Title("Run Auth Service tests")
 	t.Epic("Auth service")
 
+	s.RunSuite(t, new(BeginPasskeyLoginSuite))
 	s.RunSuite(t, new(FetchUserInfoSuite))
+	s.RunSuite(t, new(FinishPasskeyLoginSuite))
 	s.RunSuite(t, new(GetConsentPageURLSuite))
 	s.RunSuite(t, new(LoginSuite))
+	s.RunSuite(t, new(LoginMFASuite))
+	s.RunSuite(t, new(LoginPhoneSuite))
+	s.RunSuite(t, new(LoginPhoneConfirmSuite))
 	s.RunSuite(t, new(LogoutSuite))
 	s.RunSuite(t, new(RecoveryPasswordSuite))
 	s.RunSuite(t, new(RefreshTokensSuite))
@@ -79,5 +115,7 @@ func (s *AuthServiceSuite) Test(t provider.T) {
 	s.RunSuite(t, new(RegisterOrLoginSuite))
 	s.RunSuite(t, new(RegisterSuite))
 	s.RunSuite(t, new(ResetPasswordSuite))
+	s.RunSuite(t, new(SendMagicLinkSuite))
+	s.RunSuite(t, new(VerifyMagicLinkSuite))
 	s.RunSuite(t, new(VerifyRecoveryCodeSuite))
 }
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/pkg/errors"
+)
+
+type BeginPasskeyLoginSuite struct {
+	suite.Suite
+}
+
+func (s *BeginPasskeyLoginSuite) Test_Success(t provider.T) {
+	t.Title("BeginPasskeyLogin returns passkey request options")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("BeginPasskeyLogin")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	output := v0.PasskeyRequestOptionsOutput{
+		ChallengeID: uuid.New().String(),
+		PublicKey:   v0.PublicKeyCredentialRequestOptions{Challenge: "challenge"},
+	}
+
+	webAuthnServiceMock.On("BeginLogin", ctx).Once().Return(output, nil)
+
+	resp, err := svc.BeginPasskeyLogin(ctx)
+
+	t.Require().NoError(err)
+	t.Require().Equal(output, resp)
+}
+
+func (s *BeginPasskeyLoginSuite) Test_Error(t provider.T) {
+	t.Title("BeginPasskeyLogin returns error from WebAuthn service")
+	t.Severity(allure.NORMAL)
+	t.Epic("Auth service")
+	t.Feature("BeginPasskeyLogin")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	expectedErr := errors.New("cache error")
+
+	webAuthnServiceMock.On("BeginLogin", ctx).Once().Return(v0.PasskeyRequestOptionsOutput{}, expectedErr)
+
+	resp, err := svc.BeginPasskeyLogin(ctx)
+
+	t.Require().Error(err)
+	t.Require().Equal(expectedErr, err)
+	t.Require().Equal(v0.PasskeyRequestOptionsOutput{}, resp)
+}
@@ -2,21 +2,50 @@ package auth
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache/memory"
 	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/domain/auth"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/otp"
 	"github.com/mandarine-io/backend/pkg/model/v0"
 	"github.com/mandarine-io/backend/third_party/oauth"
+	"github.com/mandarine-io/backend/third_party/oauth/telegram"
 	"github.com/ozontech/allure-go/pkg/allure"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/suite"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/oauth2"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type FetchUserInfoSuite struct {
 	suite.Suite
 }
 
+// mockConsumeOAuthState makes OTP service return data saved by consent page request
+func mockConsumeOAuthState(state string, data v0.OAuthStateData) {
+	dataBytes, _ := json.Marshal(data)
+
+	otpServiceMock.On("ConsumeDataByToken", mock.Anything, "oauth_state", state, mock.Anything).Run(
+		func(args mock.Arguments) {
+			_ = json.Unmarshal(dataBytes, args.Get(3))
+		},
+	).Once().Return(nil)
+}
+
+// mockConsumeOAuthToken makes OTP service accept or reject token, to which code is exchanged
+func mockConsumeOAuthToken(accessToken string, err error) {
+	otpServiceMock.On("ConsumeToken", mock.Anything, "oauth_token", accessToken, oauth.MaxCodeAge).Once().Return(err)
+}
+
 func (s *FetchUserInfoSuite) Test_NotSupportedProvider(t provider.T) {
 	t.Title("FetchUserInfo not support provider")
 	t.Severity(allure.CRITICAL)
@@ -24,8 +53,8 @@ func (s *FetchUserInfoSuite) Test_NotSupportedProvider(t provider.T) {
 	t.Feature("FetchUserInfo")
 	t.Tags("Negative")
 
-	input := v0.FetchUserInfoInput{Code: "someCode"}
-	_, err := svc.FetchUserInfo(context.Background(), "unsupported", input)
+	input := v0.FetchUserInfoInput{Code: "code_0", State: "state_0"}
+	_, err := svc.FetchUserInfo(context.Background(), "unsupported", input, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(domain.ErrInvalidProvider, err)
@@ -38,21 +67,118 @@ func (s *FetchUserInfoSuite) Test_Success(t provider.T) {
 	t.Feature("FetchUserInfo")
 	t.Tags("Positive")
 
-	input := v0.FetchUserInfoInput{Code: "someCode"}
+	input := v0.FetchUserInfoInput{Code: "code_1", State: "state_1"}
 	expectedUserInfo := oauth.UserInfo{Email: "test@example.com"}
 
-	oauthProviderMock.On("ExchangeCodeToToken", mock.Anything, input.Code, mock.Anything).Return(
-		&oauth2.Token{},
-		nil,
-	).Once()
+	mockConsumeOAuthState(
+		input.State,
+		v0.OAuthStateData{Provider: "mock", CodeVerifier: "verifier_1", UserAgent: clientInfo.UserAgent},
+	)
+	mockConsumeOAuthToken("token_1", nil)
+	oauthProviderMock.On(
+		"ExchangeCodeToToken",
+		mock.Anything,
+		input.Code,
+		mock.Anything,
+		"verifier_1",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(&oauth2.Token{AccessToken: "token_1"}, nil).Once()
 	oauthProviderMock.On("GetUserInfo", mock.Anything, mock.Anything).Return(expectedUserInfo, nil).Once()
 
-	userInfo, err := svc.FetchUserInfo(context.Background(), "mock", input)
+	userInfo, err := svc.FetchUserInfo(context.Background(), "mock", input, clientInfo)
 
 	t.Require().NoError(err)
 	t.Require().Equal(expectedUserInfo, userInfo)
 }
 
+func (s *FetchUserInfoSuite) Test_InvalidOrReplayedState(t provider.T) {
+	t.Title("FetchUserInfo return InvalidState error for unknown, expired or already used state")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("FetchUserInfo")
+	t.Tags("Negative")
+
+	input := v0.FetchUserInfoInput{Code: "code_2", State: "state_2"}
+
+	otpServiceMock.On("ConsumeDataByToken", mock.Anything, "oauth_state", input.State, mock.Anything).
+		Once().Return(infrastructure.ErrInvalidOrExpiredOTP)
+
+	_, err := svc.FetchUserInfo(context.Background(), "mock", input, clientInfo)
+
+	t.Require().Equal(domain.ErrInvalidState, err)
+}
+
+func (s *FetchUserInfoSuite) Test_StateOfAnotherProvider(t provider.T) {
+	t.Title("FetchUserInfo return InvalidState error for state issued for another provider")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("FetchUserInfo")
+	t.Tags("Negative")
+
+	input := v0.FetchUserInfoInput{Code: "code_3", State: "state_3"}
+
+	mockConsumeOAuthState(
+		input.State,
+		v0.OAuthStateData{Provider: "google", CodeVerifier: "verifier_3", UserAgent: clientInfo.UserAgent},
+	)
+
+	_, err := svc.FetchUserInfo(context.Background(), "mock", input, clientInfo)
+
+	t.Require().Equal(domain.ErrInvalidState, err)
+}
+
+func (s *FetchUserInfoSuite) Test_StateOfAnotherClient(t provider.T) {
+	t.Title("FetchUserInfo return InvalidState error for state issued for another client")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("FetchUserInfo")
+	t.Tags("Negative")
+
+	input := v0.FetchUserInfoInput{Code: "code_4", State: "state_4"}
+
+	mockConsumeOAuthState(
+		input.State,
+		v0.OAuthStateData{Provider: "mock", CodeVerifier: "verifier_4", UserAgent: "another"},
+	)
+
+	_, err := svc.FetchUserInfo(context.Background(), "mock", input, clientInfo)
+
+	t.Require().Equal(domain.ErrInvalidState, err)
+}
+
+func (s *FetchUserInfoSuite) Test_ReplayedCode(t provider.T) {
+	t.Title("FetchUserInfo return InvalidState error for already used code")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("FetchUserInfo")
+	t.Tags("Negative")
+
+	input := v0.FetchUserInfoInput{Code: "code_7", State: "state_7"}
+	token := &oauth2.Token{AccessToken: "token_7"}
+
+	mockConsumeOAuthState(
+		input.State,
+		v0.OAuthStateData{Provider: "mock", CodeVerifier: "verifier_7", UserAgent: clientInfo.UserAgent},
+	)
+	oauthProviderMock.On(
+		"ExchangeCodeToToken",
+		mock.Anything,
+		input.Code,
+		mock.Anything,
+		"verifier_7",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(token, nil).Once()
+	mockConsumeOAuthToken(token.AccessToken, infrastructure.ErrInvalidOrExpiredOTP)
+
+	_, err := svc.FetchUserInfo(context.Background(), "mock", input, clientInfo)
+
+	t.Require().Equal(domain.ErrInvalidState, err)
+}
+
 func (s *FetchUserInfoSuite) Test_ErrorExchangingCodeToToken(t provider.T) {
 	t.Title("FetchUserInfo return ExchangingCodeToToken error")
 	t.Severity(allure.CRITICAL)
@@ -60,15 +186,25 @@ func (s *FetchUserInfoSuite) Test_ErrorExchangingCodeToToken(t provider.T) {
 	t.Feature("FetchUserInfo")
 	t.Tags("Negative")
 
-	input := v0.FetchUserInfoInput{Code: "someCode"}
+	input := v0.FetchUserInfoInput{Code: "code_5", State: "state_5"}
 	expectedError := errors.New("exchange error")
 
-	oauthProviderMock.On("ExchangeCodeToToken", mock.Anything, input.Code, mock.Anything).Return(
-		nil,
-		expectedError,
-	).Once()
+	mockConsumeOAuthState(
+		input.State,
+		v0.OAuthStateData{Provider: "mock", CodeVerifier: "verifier_5", UserAgent: clientInfo.UserAgent},
+	)
+	oauthProviderMock.On(
+		"ExchangeCodeToToken",
+		mock.Anything,
+		input.Code,
+		mock.Anything,
+		"verifier_5",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(nil, expectedError).Once()
 
-	_, err := svc.FetchUserInfo(context.Background(), "mock", input)
+	_, err := svc.FetchUserInfo(context.Background(), "mock", input, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(expectedError, err)
@@ -81,15 +217,85 @@ func (s *FetchUserInfoSuite) Test_ErrorGettingUserInfo(t provider.T) {
 	t.Feature("FetchUserInfo")
 	t.Tags("Negative")
 
-	input := v0.FetchUserInfoInput{Code: "someCode"}
-	token := &oauth2.Token{}
+	input := v0.FetchUserInfoInput{Code: "code_6", State: "state_6"}
+	token := &oauth2.Token{AccessToken: "token_6"}
 	expectedError := errors.New("user info error")
 
-	oauthProviderMock.On("ExchangeCodeToToken", mock.Anything, input.Code, mock.Anything).Return(token, nil).Once()
+	mockConsumeOAuthState(
+		input.State,
+		v0.OAuthStateData{Provider: "mock", CodeVerifier: "verifier_6", UserAgent: clientInfo.UserAgent},
+	)
+	mockConsumeOAuthToken(token.AccessToken, nil)
+	oauthProviderMock.On(
+		"ExchangeCodeToToken",
+		mock.Anything,
+		input.Code,
+		mock.Anything,
+		"verifier_6",
+		mock.Anything,
+		mock.Anything,
+		mock.Anything,
+	).Return(token, nil).Once()
 	oauthProviderMock.On("GetUserInfo", mock.Anything, token).Return(oauth.UserInfo{}, expectedError).Once()
 
-	_, err := svc.FetchUserInfo(context.Background(), "mock", input)
+	_, err := svc.FetchUserInfo(context.Background(), "mock", input, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(expectedError, err)
 }
+
+func (s *FetchUserInfoSuite) Test_TelegramAuthDataReplayedInAnotherEncoding(t provider.T) {
+	t.Title("FetchUserInfo return InvalidState error for Telegram auth data replayed in another encoding")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("FetchUserInfo")
+	t.Tags("Negative")
+
+	const botToken = "123456:telegram-bot-token"
+	ctx := context.Background()
+
+	// Real OTP service and Telegram provider are used, so the same auth data is recognized after decoding
+	manager, err := memory.NewManager()
+	t.Require().NoError(err)
+	telegramSvc := auth.NewService(
+		cfg,
+		smtpSenderMock,
+		smsSenderMock,
+		templateEngineMock,
+		userRepoMock,
+		identityRepoMock,
+		transactorMock,
+		jwtServiceMock,
+		otp.NewService(manager, cfg.Security.OTP),
+		totpServiceMock,
+		webAuthnServiceMock,
+		bruteForceServiceMock,
+		map[string]oauth.Provider{telegram.ProviderKey: telegram.NewProvider(botToken)},
+	)
+
+	authDate := strconv.FormatInt(time.Now().Unix(), 10)
+	dataCheckString := "auth_date=" + authDate + "\nfirst_name=Test\nid=42\nusername=test"
+	secretKey := sha256.Sum256([]byte(botToken))
+	mac := hmac.New(sha256.New, secretKey[:])
+	mac.Write([]byte(dataCheckString))
+	hash := hex.EncodeToString(mac.Sum(nil))
+
+	compact := `{"id":42,"first_name":"Test","username":"test","auth_date":` + authDate + `,"hash":"` + hash + `"}`
+	spaced := `{ "hash": "` + strings.ToUpper(hash) + `", "auth_date": ` + authDate +
+		`, "username": "test", "first_name": "Test", "id": 42 }`
+
+	fetch := func(code string) error {
+		consentPage, err := telegramSvc.GetConsentPageURL(ctx, telegram.ProviderKey, "http://localhost/callback", clientInfo)
+		t.Require().NoError(err)
+
+		input := v0.FetchUserInfoInput{Code: code, State: consentPage.OauthState}
+		_, err = telegramSvc.FetchUserInfo(ctx, telegram.ProviderKey, input, clientInfo)
+		return err
+	}
+
+	err = fetch(base64.RawURLEncoding.EncodeToString([]byte(compact)))
+	t.Require().NoError(err)
+
+	err = fetch(spaced)
+	t.Require().Equal(domain.ErrInvalidState, err)
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type FinishPasskeyLoginSuite struct {
+	suite.Suite
+}
+
+func (s *FinishPasskeyLoginSuite) Test_ErrInvalidPasskey(t provider.T) {
+	t.Title("FinishPasskeyLogin returns InvalidPasskey error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("FinishPasskeyLogin")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.PasskeyLoginInput{ChallengeID: uuid.New().String()}
+
+	webAuthnServiceMock.On("FinishLogin", ctx, req).Once().Return(nil, infrastructure.ErrInvalidPasskey)
+
+	resp, err := svc.FinishPasskeyLogin(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().Equal(infrastructure.ErrInvalidPasskey, err)
+	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+}
+
+func (s *FinishPasskeyLoginSuite) Test_ErrUserNotFound(t provider.T) {
+	t.Title("FinishPasskeyLogin returns UserNotFound error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("FinishPasskeyLogin")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.PasskeyLoginInput{ChallengeID: uuid.New().String()}
+	passkey := &entity.Passkey{ID: uuid.New(), UserID: uuid.New()}
+
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	webAuthnServiceMock.On("FinishLogin", ctx, req).Once().Return(passkey, nil)
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, passkey.UserID, mock.Anything).Once().Return(nil, nil)
+
+	resp, err := svc.FinishPasskeyLogin(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().Equal(domain.ErrUserNotFound, err)
+	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+}
+
+func (s *FinishPasskeyLoginSuite) Test_ErrUserIsBlocked(t provider.T) {
+	t.Title("FinishPasskeyLogin returns UserIsBlocked error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("FinishPasskeyLogin")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.PasskeyLoginInput{ChallengeID: uuid.New().String()}
+	passkey := &entity.Passkey{ID: uuid.New(), UserID: uuid.New()}
+	userEntity := &entity.User{ID: passkey.UserID, IsEnabled: false}
+
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	webAuthnServiceMock.On("FinishLogin", ctx, req).Once().Return(passkey, nil)
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, passkey.UserID, mock.Anything).Once().Return(userEntity, nil)
+
+	resp, err := svc.FinishPasskeyLogin(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().Equal(domain.ErrUserIsBlocked, err)
+	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+}
+
+func (s *FinishPasskeyLoginSuite) Test_SuccessWithoutMFA(t provider.T) {
+	t.Title("FinishPasskeyLogin returns tokens without 2FA check")
+	t.Severity(allure.NORMAL)
+	t.Epic("Auth service")
+	t.Feature("FinishPasskeyLogin")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	req := v0.PasskeyLoginInput{ChallengeID: uuid.New().String()}
+	passkey := &entity.Passkey{ID: uuid.New(), UserID: uuid.New()}
+	userEntity := &entity.User{ID: passkey.UserID, IsEnabled: true, IsTOTPEnabled: true}
+	accessToken := "access_token"
+	refreshToken := "refresh_token"
+
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	webAuthnServiceMock.On("FinishLogin", ctx, req).Once().Return(passkey, nil)
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, passkey.UserID, mock.Anything).Once().Return(userEntity, nil)
+	jwtServiceMock.On("GenerateTokens", ctx, userEntity, clientInfo).Once().Return(accessToken, refreshToken, nil)
+
+	resp, err := svc.FinishPasskeyLogin(ctx, req, clientInfo)
+
+	t.Require().NoError(err)
+	t.Require().Equal(v0.JwtTokensOutput{AccessToken: accessToken, RefreshToken: refreshToken}, resp)
+	totpServiceMock.AssertNotCalled(t, "VerifyCode", ctx, userEntity, mock.Anything)
+}
@@ -3,9 +3,13 @@ package auth
 import (
 	"context"
 	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
 	"github.com/ozontech/allure-go/pkg/allure"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"time"
 )
 
 const (
@@ -23,7 +27,7 @@ func (s *GetConsentPageURLSuite) Test_NotSupportedProvider(t provider.T) {
 	t.Feature("GetConsentPageURL")
 	t.Tags("Negative")
 
-	_, err := svc.GetConsentPageURL(context.Background(), "unsupported", redirectURL)
+	_, err := svc.GetConsentPageURL(context.Background(), "unsupported", redirectURL, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(domain.ErrInvalidProvider, err)
@@ -36,12 +40,64 @@ func (s *GetConsentPageURLSuite) Test_Success(t provider.T) {
 	t.Feature("GetConsentPageURL")
 	t.Tags("Positive")
 
-	oauthProviderMock.On("GetConsentPageURL", redirectURL).
-		Return("consentURL", "oauthState").Once()
+	var codeVerifier string
+	otpServiceMock.On(
+		"GenerateAndSaveWithToken",
+		mock.Anything,
+		"oauth_state",
+		mock.MatchedBy(func(data v0.OAuthStateData) bool {
+			codeVerifier = data.CodeVerifier
+			return data.Provider == "mock" && data.UserAgent == clientInfo.UserAgent && data.CodeVerifier != ""
+		}),
+		20*time.Minute,
+	).Return("oauthState", nil).Once()
+	oauthProviderMock.On(
+		"GetConsentPageURL",
+		mock.Anything,
+		redirectURL,
+		"oauthState",
+		mock.MatchedBy(func(verifier string) bool { return verifier == codeVerifier }),
+	).Return("consentURL", nil).Once()
 
-	result, err := svc.GetConsentPageURL(context.Background(), "mock", redirectURL)
+	result, err := svc.GetConsentPageURL(context.Background(), "mock", redirectURL, clientInfo)
 
 	t.Require().NoError(err)
 	t.Require().Equal("consentURL", result.ConsentPageURL)
 	t.Require().Equal("oauthState", result.OauthState)
 }
+
+func (s *GetConsentPageURLSuite) Test_ErrorSavingState(t provider.T) {
+	t.Title("GetConsentPageURL return SavingState error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("GetConsentPageURL")
+	t.Tags("Negative")
+
+	expectedError := errors.New("cache error")
+
+	otpServiceMock.On("GenerateAndSaveWithToken", mock.Anything, "oauth_state", mock.Anything, mock.Anything).
+		Return("", expectedError).Once()
+
+	_, err := svc.GetConsentPageURL(context.Background(), "mock", redirectURL, clientInfo)
+
+	t.Require().Equal(expectedError, err)
+}
+
+func (s *GetConsentPageURLSuite) Test_ErrorProviderUnavailable(t provider.T) {
+	t.Title("GetConsentPageURL return error of unavailable provider")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("GetConsentPageURL")
+	t.Tags("Negative")
+
+	expectedError := errors.New("discovery failed")
+
+	otpServiceMock.On("GenerateAndSaveWithToken", mock.Anything, "oauth_state", mock.Anything, mock.Anything).
+		Return("oauthState", nil).Once()
+	oauthProviderMock.On("GetConsentPageURL", mock.Anything, redirectURL, "oauthState", mock.Anything).
+		Return("", expectedError).Once()
+
+	_, err := svc.GetConsentPageURL(context.Background(), "mock", redirectURL, clientInfo)
+
+	t.Require().ErrorIs(err, expectedError)
+}
@@ -0,0 +1,257 @@
+package auth
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+	"time"
+)
+
+type LoginMFASuite struct {
+	suite.Suite
+}
+
+func (s *LoginMFASuite) Test_ErrInvalidMFAToken(t provider.T) {
+	t.Title("LoginMFA returns InvalidJWTToken error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("LoginMFA")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.LoginMFAInput{MFAToken: "invalid_token", Code: "123456"}
+
+	jwtServiceMock.On("GetMFATokenClaims", ctx, req.MFAToken).
+		Once().Return(infrastructure.MFATokenClaims{}, infrastructure.ErrInvalidJWTToken)
+
+	resp, err := svc.LoginMFA(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().Equal(infrastructure.ErrInvalidJWTToken, err)
+	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+}
+
+func (s *LoginMFASuite) Test_ErrUserNotFound(t provider.T) {
+	t.Title("LoginMFA returns UserNotFound error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("LoginMFA")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.LoginMFAInput{MFAToken: "mfa_token", Code: "123456"}
+	claims := infrastructure.MFATokenClaims{UserID: uuid.New(), JTI: uuid.New().String()}
+
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	jwtServiceMock.On("GetMFATokenClaims", ctx, req.MFAToken).Once().Return(claims, nil)
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, claims.UserID, mock.Anything).Once().Return(nil, nil)
+
+	resp, err := svc.LoginMFA(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().Equal(domain.ErrUserNotFound, err)
+	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+}
+
+func (s *LoginMFASuite) Test_ErrUserIsBlocked(t provider.T) {
+	t.Title("LoginMFA returns UserIsBlocked error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("LoginMFA")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.LoginMFAInput{MFAToken: "mfa_token", Code: "123456"}
+	claims := infrastructure.MFATokenClaims{UserID: uuid.New(), JTI: uuid.New().String()}
+	userEntity := &entity.User{ID: claims.UserID, IsEnabled: false, IsTOTPEnabled: true}
+
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	jwtServiceMock.On("GetMFATokenClaims", ctx, req.MFAToken).Once().Return(claims, nil)
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, claims.UserID, mock.Anything).Once().Return(userEntity, nil)
+
+	resp, err := svc.LoginMFA(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().Equal(domain.ErrUserIsBlocked, err)
+	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+}
+
+func (s *LoginMFASuite) Test_ErrMFANotEnabled(t provider.T) {
+	t.Title("LoginMFA returns MFANotEnabled error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Auth service")
+	t.Feature("LoginMFA")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.LoginMFAInput{MFAToken: "mfa_token", Code: "123456"}
+	claims := infrastructure.MFATokenClaims{UserID: uuid.New(), JTI: uuid.New().String()}
+	userEntity := &entity.User{ID: claims.UserID, IsEnabled: true, IsTOTPEnabled: false}
+
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	jwtServiceMock.On("GetMFATokenClaims", ctx, req.MFAToken).Once().Return(claims, nil)
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, claims.UserID, mock.Anything).Once().Return(userEntity, nil)
+
+	resp, err := svc.LoginMFA(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().Equal(domain.ErrMFANotEnabled, err)
+	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+}
+
+func (s *LoginMFASuite) Test_ErrInvalidTOTPCode(t provider.T) {
+	t.Title("LoginMFA returns InvalidTOTPCode error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("LoginMFA")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.LoginMFAInput{MFAToken: "mfa_token", Code: "000000"}
+	claims := infrastructure.MFATokenClaims{UserID: uuid.New(), JTI: uuid.New().String()}
+	userEntity := &entity.User{ID: claims.UserID, IsEnabled: true, IsTOTPEnabled: true}
+	attempt := infrastructure.Attempt{Action: "login_mfa", Account: claims.UserID.String(), IP: clientInfo.IP}
+
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	jwtServiceMock.On("GetMFATokenClaims", ctx, req.MFAToken).Once().Return(claims, nil)
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, claims.UserID, mock.Anything).Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
+	totpServiceMock.On("VerifyCode", ctx, userEntity, req.Code).Once().Return(infrastructure.ErrInvalidTOTPCode)
+	bruteForceServiceMock.On("FailAttempt", ctx, attempt).Once().Return(nil)
+
+	resp, err := svc.LoginMFA(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().Equal(infrastructure.ErrInvalidTOTPCode, err)
+	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+}
+
+func (s *LoginMFASuite) Test_ErrBanToken(t provider.T) {
+	t.Title("LoginMFA returns BanToken error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Auth service")
+	t.Feature("LoginMFA")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.LoginMFAInput{MFAToken: "mfa_token", Code: "123456"}
+	claims := infrastructure.MFATokenClaims{UserID: uuid.New(), JTI: uuid.New().String()}
+	userEntity := &entity.User{ID: claims.UserID, IsEnabled: true, IsTOTPEnabled: true}
+	attempt := infrastructure.Attempt{Action: "login_mfa", Account: claims.UserID.String(), IP: clientInfo.IP}
+	expectedErr := errors.New("cache error")
+
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	jwtServiceMock.On("GetMFATokenClaims", ctx, req.MFAToken).Once().Return(claims, nil)
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, claims.UserID, mock.Anything).Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
+	totpServiceMock.On("VerifyCode", ctx, userEntity, req.Code).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, attempt).Once().Return(nil)
+	jwtServiceMock.On("BanToken", ctx, claims.JTI).Once().Return(expectedErr)
+
+	resp, err := svc.LoginMFA(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().Equal(expectedErr, err)
+	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+}
+
+func (s *LoginMFASuite) Test_Success(t provider.T) {
+	t.Title("LoginMFA returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("Auth service")
+	t.Feature("LoginMFA")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	req := v0.LoginMFAInput{MFAToken: "mfa_token", Code: "123456"}
+	claims := infrastructure.MFATokenClaims{UserID: uuid.New(), JTI: uuid.New().String()}
+	userEntity := &entity.User{ID: claims.UserID, IsEnabled: true, IsTOTPEnabled: true}
+	attempt := infrastructure.Attempt{Action: "login_mfa", Account: claims.UserID.String(), IP: clientInfo.IP}
+	accessToken := "access_token"
+	refreshToken := "refresh_token"
+
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	jwtServiceMock.On("GetMFATokenClaims", ctx, req.MFAToken).Once().Return(claims, nil)
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, claims.UserID, mock.Anything).Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
+	totpServiceMock.On("VerifyCode", ctx, userEntity, req.Code).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, attempt).Once().Return(nil)
+	jwtServiceMock.On("BanToken", ctx, claims.JTI).Once().Return(nil)
+	jwtServiceMock.On("GenerateTokens", ctx, userEntity, clientInfo).Once().Return(accessToken, refreshToken, nil)
+
+	resp, err := svc.LoginMFA(ctx, req, clientInfo)
+
+	t.Require().NoError(err)
+	t.Require().Equal(v0.JwtTokensOutput{AccessToken: accessToken, RefreshToken: refreshToken}, resp)
+}
+
+func (s *LoginMFASuite) Test_Locked(t provider.T) {
+	t.Title("LoginMFA returns lockout error without checking code")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("LoginMFA")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.LoginMFAInput{MFAToken: "mfa_token", Code: "123456"}
+	claims := infrastructure.MFATokenClaims{UserID: uuid.New(), JTI: uuid.New().String()}
+	userEntity := &entity.User{ID: claims.UserID, IsEnabled: true, IsTOTPEnabled: true}
+	attempt := infrastructure.Attempt{Action: "login_mfa", Account: claims.UserID.String(), IP: clientInfo.IP}
+	lockErr := infrastructure.LockoutError{Err: infrastructure.ErrAccountTemporarilyLocked, RetryAfter: time.Minute}
+
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	jwtServiceMock.On("GetMFATokenClaims", ctx, req.MFAToken).Once().Return(claims, nil)
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, claims.UserID, mock.Anything).Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(lockErr)
+
+	resp, err := svc.LoginMFA(ctx, req, clientInfo)
+
+	t.Require().ErrorIs(err, infrastructure.ErrAccountTemporarilyLocked)
+	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+	totpServiceMock.AssertNotCalled(t, "VerifyCode", ctx, userEntity, req.Code)
+}
+
+func (s *LoginMFASuite) Test_LockedAfterFailure(t provider.T) {
+	t.Title("LoginMFA returns lockout error if failed code has locked attempts")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("LoginMFA")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.LoginMFAInput{MFAToken: "mfa_token", Code: "000000"}
+	claims := infrastructure.MFATokenClaims{UserID: uuid.New(), JTI: uuid.New().String()}
+	userEntity := &entity.User{ID: claims.UserID, IsEnabled: true, IsTOTPEnabled: true}
+	attempt := infrastructure.Attempt{Action: "login_mfa", Account: claims.UserID.String(), IP: clientInfo.IP}
+	lockErr := infrastructure.LockoutError{Err: infrastructure.ErrAccountTemporarilyLocked, RetryAfter: time.Minute}
+
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	jwtServiceMock.On("GetMFATokenClaims", ctx, req.MFAToken).Once().Return(claims, nil)
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, claims.UserID, mock.Anything).Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
+	totpServiceMock.On("VerifyCode", ctx, userEntity, req.Code).Once().Return(infrastructure.ErrInvalidTOTPCode)
+	bruteForceServiceMock.On("FailAttempt", ctx, attempt).Once().Return(lockErr)
+
+	resp, err := svc.LoginMFA(ctx, req, clientInfo)
+
+	t.Require().ErrorIs(err, infrastructure.ErrAccountTemporarilyLocked)
+	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+}
@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+	"time"
+)
+
+type LoginPhoneConfirmSuite struct {
+	suite.Suite
+}
+
+func (s *LoginPhoneConfirmSuite) Test_Success(t provider.T) {
+	t.Title("LoginPhoneConfirm returns JWT tokens")
+	t.Severity(allure.NORMAL)
+	t.Epic("Auth service")
+	t.Feature("LoginPhoneConfirm")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	req := v0.PhoneLoginConfirmInput{Phone: "+79990000011", OTP: "123456"}
+	userEntity := &entity.User{ID: uuid.New(), Phone: &req.Phone, IsPhoneVerified: true, IsEnabled: true}
+	attempt := infrastructure.Attempt{Action: "login_phone", Account: req.Phone, IP: clientInfo.IP}
+
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "login_phone", req.Phone, req.OTP, mock.Anything).Run(
+		func(args mock.Arguments) {
+			*args.Get(4).(*string) = userEntity.ID.String()
+		},
+	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, attempt).Once().Return(nil)
+	otpServiceMock.On("DeleteDataBySubject", ctx, "login_phone", req.Phone).Once().Return(nil)
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByPhone", ctx, req.Phone, mock.Anything).Once().Return(userEntity, nil)
+	jwtServiceMock.On("GenerateTokens", ctx, userEntity, clientInfo).Once().Return("access", "refresh", nil)
+
+	resp, err := svc.LoginPhoneConfirm(ctx, req, clientInfo)
+
+	t.Require().NoError(err)
+	t.Require().Equal(v0.LoginOutput{AccessToken: "access", RefreshToken: "refresh"}, resp)
+}
+
+func (s *LoginPhoneConfirmSuite) Test_SuccessMFARequired(t provider.T) {
+	t.Title("LoginPhoneConfirm returns MFA token for user with enabled 2FA")
+	t.Severity(allure.NORMAL)
+	t.Epic("Auth service")
+	t.Feature("LoginPhoneConfirm")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	req := v0.PhoneLoginConfirmInput{Phone: "+79990000012", OTP: "123456"}
+	userEntity := &entity.User{
+		ID:              uuid.New(),
+		Phone:           &req.Phone,
+		IsPhoneVerified: true,
+		IsEnabled:       true,
+		IsTOTPEnabled:   true,
+	}
+	attempt := infrastructure.Attempt{Action: "login_phone", Account: req.Phone, IP: clientInfo.IP}
+
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "login_phone", req.Phone, req.OTP, mock.Anything).Run(
+		func(args mock.Arguments) {
+			*args.Get(4).(*string) = userEntity.ID.String()
+		},
+	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, attempt).Once().Return(nil)
+	otpServiceMock.On("DeleteDataBySubject", ctx, "login_phone", req.Phone).Once().Return(nil)
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByPhone", ctx, req.Phone, mock.Anything).Once().Return(userEntity, nil)
+	jwtServiceMock.On("GenerateMFAToken", ctx, userEntity).Once().Return("mfa_token", nil)
+
+	resp, err := svc.LoginPhoneConfirm(ctx, req, clientInfo)
+
+	t.Require().NoError(err)
+	t.Require().Equal(v0.LoginOutput{MFARequired: true, MFAToken: "mfa_token"}, resp)
+}
+
+func (s *LoginPhoneConfirmSuite) Test_InvalidOTP(t provider.T) {
+	t.Title("LoginPhoneConfirm returns InvalidOTP error and registers failed attempt")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("LoginPhoneConfirm")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.PhoneLoginConfirmInput{Phone: "+79990000013", OTP: "000000"}
+	attempt := infrastructure.Attempt{Action: "login_phone", Account: req.Phone, IP: clientInfo.IP}
+
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "login_phone", req.Phone, req.OTP, mock.Anything).
+		Once().Return(infrastructure.ErrInvalidOrExpiredOTP)
+	bruteForceServiceMock.On("FailAttempt", ctx, attempt).Once().Return(nil)
+
+	resp, err := svc.LoginPhoneConfirm(ctx, req, clientInfo)
+
+	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
+	t.Require().Equal(v0.LoginOutput{}, resp)
+}
+
+func (s *LoginPhoneConfirmSuite) Test_Locked(t provider.T) {
+	t.Title("LoginPhoneConfirm returns lockout error without checking OTP")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("LoginPhoneConfirm")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.PhoneLoginConfirmInput{Phone: "+79990000014", OTP: "123456"}
+	attempt := infrastructure.Attempt{Action: "login_phone", Account: req.Phone, IP: clientInfo.IP}
+	lockErr := infrastructure.LockoutError{Err: infrastructure.ErrAccountTemporarilyLocked, RetryAfter: time.Minute}
+
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(lockErr)
+
+	resp, err := svc.LoginPhoneConfirm(ctx, req, clientInfo)
+
+	t.Require().ErrorIs(err, infrastructure.ErrAccountTemporarilyLocked)
+	t.Require().Equal(v0.LoginOutput{}, resp)
+}
+
+func (s *LoginPhoneConfirmSuite) Test_PhoneMovedToAnotherUser(t provider.T) {
+	t.Title("LoginPhoneConfirm returns InvalidOTP error if phone belongs to another user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("LoginPhoneConfirm")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.PhoneLoginConfirmInput{Phone: "+79990000015", OTP: "123456"}
+	userEntity := &entity.User{ID: uuid.New(), Phone: &req.Phone, IsPhoneVerified: true, IsEnabled: true}
+	attempt := infrastructure.Attempt{Action: "login_phone", Account: req.Phone, IP: clientInfo.IP}
+
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "login_phone", req.Phone, req.OTP, mock.Anything).Run(
+		func(args mock.Arguments) {
+			*args.Get(4).(*string) = uuid.New().String()
+		},
+	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, attempt).Once().Return(nil)
+	otpServiceMock.On("DeleteDataBySubject", ctx, "login_phone", req.Phone).Once().Return(nil)
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByPhone", ctx, req.Phone, mock.Anything).Once().Return(userEntity, nil)
+
+	resp, err := svc.LoginPhoneConfirm(ctx, req, clientInfo)
+
+	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
+	t.Require().Equal(v0.LoginOutput{}, resp)
+}
+
+func (s *LoginPhoneConfirmSuite) Test_UserIsBlocked(t provider.T) {
+	t.Title("LoginPhoneConfirm returns UserIsBlocked error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("LoginPhoneConfirm")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.PhoneLoginConfirmInput{Phone: "+79990000016", OTP: "123456"}
+	userEntity := &entity.User{ID: uuid.New(), Phone: &req.Phone, IsPhoneVerified: true, IsEnabled: false}
+	attempt := infrastructure.Attempt{Action: "login_phone", Account: req.Phone, IP: clientInfo.IP}
+
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "login_phone", req.Phone, req.OTP, mock.Anything).Run(
+		func(args mock.Arguments) {
+			*args.Get(4).(*string) = userEntity.ID.String()
+		},
+	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, attempt).Once().Return(nil)
+	otpServiceMock.On("DeleteDataBySubject", ctx, "login_phone", req.Phone).Once().Return(nil)
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByPhone", ctx, req.Phone, mock.Anything).Once().Return(userEntity, nil)
+
+	resp, err := svc.LoginPhoneConfirm(ctx, req, clientInfo)
+
+	t.Require().Equal(domain.ErrUserIsBlocked, err)
+	t.Require().Equal(v0.LoginOutput{}, resp)
+}
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+type LoginPhoneSuite struct {
+	suite.Suite
+}
+
+func (s *LoginPhoneSuite) Test_Success(t provider.T) {
+	t.Title("LoginPhone sends SMS with OTP")
+	t.Severity(allure.NORMAL)
+	t.Epic("Auth service")
+	t.Feature("LoginPhone")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	req := v0.PhoneLoginInput{Phone: "+79990000001"}
+	userEntity := &entity.User{ID: uuid.New(), Phone: &req.Phone, IsPhoneVerified: true, IsEnabled: true}
+
+	userRepoMock.On("FindUserByPhone", ctx, req.Phone).Once().Return(userEntity, nil)
+	otpServiceMock.On("GenerateAndSaveWithSubject", ctx, "login_phone", req.Phone, userEntity.ID.String()).
+		Once().Return("123456", nil)
+	smsSenderMock.On("SendMessage", ctx, req.Phone, mock.MatchedBy(func(content string) bool {
+		return content == "Mandarine: your sign in code is 123456. It is valid for 10 min. Do not share it with anyone."
+	})).Once().Return(nil)
+
+	err := svc.LoginPhone(ctx, req, nil)
+
+	t.Require().NoError(err)
+}
+
+func (s *LoginPhoneSuite) Test_UserNotFound(t provider.T) {
+	t.Title("LoginPhone returns UserNotFound error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("LoginPhone")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.PhoneLoginInput{Phone: "+79990000002"}
+
+	userRepoMock.On("FindUserByPhone", ctx, req.Phone).Once().Return(nil, nil)
+
+	err := svc.LoginPhone(ctx, req, nil)
+
+	t.Require().Equal(domain.ErrUserNotFound, err)
+}
+
+func (s *LoginPhoneSuite) Test_PhoneNotVerified(t provider.T) {
+	t.Title("LoginPhone returns PhoneNotVerified error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("LoginPhone")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.PhoneLoginInput{Phone: "+79990000003"}
+	userEntity := &entity.User{ID: uuid.New(), Phone: &req.Phone, IsEnabled: true}
+
+	userRepoMock.On("FindUserByPhone", ctx, req.Phone).Once().Return(userEntity, nil)
+
+	err := svc.LoginPhone(ctx, req, nil)
+
+	t.Require().Equal(domain.ErrPhoneNotVerified, err)
+}
+
+func (s *LoginPhoneSuite) Test_SendSMSError(t provider.T) {
+	t.Title("LoginPhone returns SendSMS error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("LoginPhone")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.PhoneLoginInput{Phone: "+79990000004"}
+	userEntity := &entity.User{ID: uuid.New(), Phone: &req.Phone, IsPhoneVerified: true, IsEnabled: true}
+
+	userRepoMock.On("FindUserByPhone", ctx, req.Phone).Once().Return(userEntity, nil)
+	otpServiceMock.On("GenerateAndSaveWithSubject", ctx, "login_phone", req.Phone, userEntity.ID.String()).
+		Once().Return("123456", nil)
+	smsSenderMock.On("SendMessage", ctx, req.Phone, mock.Anything).Once().Return(errors.New("provider error"))
+
+	err := svc.LoginPhone(ctx, req, nil)
+
+	t.Require().Equal(domain.ErrSendSMS, err)
+}
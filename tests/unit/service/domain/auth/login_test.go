@@ -5,6 +5,7 @@ import (
 	"github.com/mandarine-io/backend/internal/persistence/entity"
 	"github.com/mandarine-io/backend/internal/persistence/repo"
 	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
 	"github.com/mandarine-io/backend/internal/util/security"
 	"github.com/mandarine-io/backend/pkg/model/v0"
 	"github.com/ozontech/allure-go/pkg/allure"
@@ -13,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
+	"time"
 )
 
 type LoginSuite struct {
@@ -28,17 +30,20 @@ func (s *LoginSuite) Test_ErrUserNotFound(t provider.T) {
 
 	ctx := context.Background()
 	req := v0.LoginInput{Login: "test@example.com", Password: "password123"}
+	attempt := infrastructure.Attempt{Action: "login", Account: req.Login, IP: clientInfo.IP}
 
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
 	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
 	userRepoMock.On("WithRolePreload").Once().Return(scope)
 	userRepoMock.On("FindUserByUsernameOrEmail", ctx, req.Login, mock.Anything).
 		Once().Return(nil, nil)
+	bruteForceServiceMock.On("FailAttempt", ctx, attempt).Once().Return(nil)
 
-	resp, err := svc.Login(ctx, req)
+	resp, err := svc.Login(ctx, req, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(domain.ErrUserNotFound, err)
-	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+	t.Require().Equal(v0.LoginOutput{}, resp)
 }
 
 func (s *LoginSuite) Test_ErrorFindingUser(t provider.T) {
@@ -51,17 +56,19 @@ func (s *LoginSuite) Test_ErrorFindingUser(t provider.T) {
 	ctx := context.Background()
 	req := v0.LoginInput{Login: "test@example.com", Password: "password123"}
 	expectedErr := errors.New("database error")
+	attempt := infrastructure.Attempt{Action: "login", Account: req.Login, IP: clientInfo.IP}
 
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
 	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
 	userRepoMock.On("WithRolePreload").Once().Return(scope)
 	userRepoMock.On("FindUserByUsernameOrEmail", ctx, req.Login, mock.Anything).
 		Once().Return(nil, expectedErr)
 
-	resp, err := svc.Login(ctx, req)
+	resp, err := svc.Login(ctx, req, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(expectedErr, err)
-	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+	t.Require().Equal(v0.LoginOutput{}, resp)
 }
 
 func (s *LoginSuite) Test_ErrBadCredentials(t provider.T) {
@@ -77,17 +84,20 @@ func (s *LoginSuite) Test_ErrBadCredentials(t provider.T) {
 		Email:    req.Login,
 		Password: "hashedpassword",
 	}
+	attempt := infrastructure.Attempt{Action: "login", Account: req.Login, IP: clientInfo.IP}
 
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
 	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
 	userRepoMock.On("WithRolePreload").Once().Return(scope)
 	userRepoMock.On("FindUserByUsernameOrEmail", ctx, req.Login, mock.Anything).
 		Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("FailAttempt", ctx, attempt).Once().Return(nil)
 
-	resp, err := svc.Login(ctx, req)
+	resp, err := svc.Login(ctx, req, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(domain.ErrBadCredentials, err)
-	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+	t.Require().Equal(v0.LoginOutput{}, resp)
 }
 
 func (s *LoginSuite) Test_ErrUserIsBlocked(t provider.T) {
@@ -105,17 +115,20 @@ func (s *LoginSuite) Test_ErrUserIsBlocked(t provider.T) {
 		Password:  hashPassword,
 		IsEnabled: false,
 	}
+	attempt := infrastructure.Attempt{Action: "login", Account: req.Login, IP: clientInfo.IP}
 
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
 	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
 	userRepoMock.On("WithRolePreload").Once().Return(scope)
 	userRepoMock.On("FindUserByUsernameOrEmail", ctx, req.Login, mock.Anything).
 		Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, attempt).Once().Return(nil)
 
-	resp, err := svc.Login(ctx, req)
+	resp, err := svc.Login(ctx, req, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(domain.ErrUserIsBlocked, err)
-	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+	t.Require().Equal(v0.LoginOutput{}, resp)
 }
 
 func (s *LoginSuite) Test_Success(t provider.T) {
@@ -135,16 +148,103 @@ func (s *LoginSuite) Test_Success(t provider.T) {
 		Password:  hashPassword,
 		IsEnabled: true,
 	}
+	attempt := infrastructure.Attempt{Action: "login", Account: req.Login, IP: clientInfo.IP}
 
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
 	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
 	userRepoMock.On("WithRolePreload").Once().Return(scope)
 	userRepoMock.On("FindUserByUsernameOrEmail", ctx, req.Login, mock.Anything).
 		Once().Return(userEntity, nil)
-	jwtServiceMock.On("GenerateTokens", ctx, userEntity).Once().Return(accessToken, refreshToken, nil)
+	jwtServiceMock.On("GenerateTokens", ctx, userEntity, clientInfo).Once().Return(accessToken, refreshToken, nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, attempt).Once().Return(nil)
 
-	resp, err := svc.Login(ctx, req)
+	resp, err := svc.Login(ctx, req, clientInfo)
 
 	t.Require().NoError(err)
 	t.Require().Equal(accessToken, resp.AccessToken)
 	t.Require().Equal(refreshToken, resp.RefreshToken)
 }
+
+func (s *LoginSuite) Test_SuccessMFARequired(t provider.T) {
+	t.Title("Login returns MFA token for user with enabled 2FA")
+	t.Severity(allure.NORMAL)
+	t.Epic("Auth service")
+	t.Feature("Login")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	req := v0.LoginInput{Login: "test@example.com", Password: "password123"}
+	hashPassword, _ := security.HashPassword("password123")
+	mfaToken := "mfa_token"
+	userEntity := &entity.User{
+		Email:         req.Login,
+		Password:      hashPassword,
+		IsEnabled:     true,
+		IsTOTPEnabled: true,
+	}
+	attempt := infrastructure.Attempt{Action: "login", Account: req.Login, IP: clientInfo.IP}
+
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByUsernameOrEmail", ctx, req.Login, mock.Anything).
+		Once().Return(userEntity, nil)
+	jwtServiceMock.On("GenerateMFAToken", ctx, userEntity).Once().Return(mfaToken, nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, attempt).Once().Return(nil)
+
+	resp, err := svc.Login(ctx, req, clientInfo)
+
+	t.Require().NoError(err)
+	t.Require().Equal(v0.LoginOutput{MFARequired: true, MFAToken: mfaToken}, resp)
+}
+
+func (s *LoginSuite) Test_ErrAccountTemporarilyLocked(t provider.T) {
+	t.Title("Login returns AccountTemporarilyLocked error without checking password")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("Login")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.LoginInput{Login: "locked@example.com", Password: "password123"}
+	attempt := infrastructure.Attempt{Action: "login", Account: req.Login, IP: clientInfo.IP}
+	lockoutErr := infrastructure.LockoutError{Err: infrastructure.ErrAccountTemporarilyLocked, RetryAfter: time.Minute}
+
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(lockoutErr)
+
+	resp, err := svc.Login(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, infrastructure.ErrAccountTemporarilyLocked)
+	t.Require().Equal(v0.LoginOutput{}, resp)
+}
+
+func (s *LoginSuite) Test_ErrBadCredentialsLocksAccount(t provider.T) {
+	t.Title("Login returns AccountTemporarilyLocked error, when failed attempt exceeds limit")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("Login")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.LoginInput{Login: "lock@example.com", Password: "password123"}
+	attempt := infrastructure.Attempt{Action: "login", Account: req.Login, IP: clientInfo.IP}
+	lockoutErr := infrastructure.LockoutError{Err: infrastructure.ErrAccountTemporarilyLocked, RetryAfter: time.Minute}
+	userEntity := &entity.User{
+		Email:    req.Login,
+		Password: "hashedpassword",
+	}
+
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByUsernameOrEmail", ctx, req.Login, mock.Anything).
+		Once().Return(userEntity, nil)
+	bruteForceServiceMock.On("FailAttempt", ctx, attempt).Once().Return(lockoutErr)
+
+	resp, err := svc.Login(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().Equal(lockoutErr, err)
+	t.Require().Equal(v0.LoginOutput{}, resp)
+}
@@ -20,16 +20,56 @@ func (s *LogoutSuite) Test_Success(t provider.T) {
 	t.Feature("Logout")
 	t.Tags("Positive")
 
+	ctx := context.Background()
+	userID := uuid.New()
+	sessionID := uuid.New()
+	jti := uuid.New().String()
+
+	jwtServiceMock.On("RevokeSession", ctx, userID, sessionID).Once().Return(nil)
+
+	err := svc.Logout(ctx, userID, sessionID, jti)
+
+	t.Require().NoError(err)
+}
+
+func (s *LogoutSuite) Test_SuccessWithoutSession(t provider.T) {
+	t.Title("Logout returns success for token without session")
+	t.Severity(allure.NORMAL)
+	t.Epic("Auth service")
+	t.Feature("Logout")
+	t.Tags("Positive")
+
 	ctx := context.Background()
 	jti := uuid.New().String()
 
 	jwtServiceMock.On("BanToken", ctx, jti).Once().Return(nil)
 
-	err := svc.Logout(ctx, jti)
+	err := svc.Logout(ctx, uuid.New(), uuid.Nil, jti)
 
 	t.Require().NoError(err)
 }
 
+func (s *LogoutSuite) Test_ErrRevokeSession(t provider.T) {
+	t.Title("Logout returns RevokeSession error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("Logout")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	userID := uuid.New()
+	sessionID := uuid.New()
+	jti := uuid.New().String()
+
+	expectedErr := errors.New("database error")
+	jwtServiceMock.On("RevokeSession", ctx, userID, sessionID).Once().Return(expectedErr)
+
+	err := svc.Logout(ctx, userID, sessionID, jti)
+
+	t.Require().Error(err)
+	t.Require().Equal(expectedErr, err)
+}
+
 func (s *LogoutSuite) Test_ErrBanToken(t provider.T) {
 	t.Title("Logout returns BanToken error")
 	t.Severity(allure.CRITICAL)
@@ -43,7 +83,7 @@ func (s *LogoutSuite) Test_ErrBanToken(t provider.T) {
 	expectedErr := errors.New("database error")
 	jwtServiceMock.On("BanToken", ctx, jti).Once().Return(expectedErr)
 
-	err := svc.Logout(ctx, jti)
+	err := svc.Logout(ctx, uuid.New(), uuid.Nil, jti)
 
 	t.Require().Error(err)
 	t.Require().Equal(expectedErr, err)
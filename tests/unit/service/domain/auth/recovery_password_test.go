@@ -25,7 +25,7 @@ func (s *RecoveryPasswordSuite) Test_Success(t provider.T) {
 	input := v0.RecoveryPasswordInput{Email: "test@example.com"}
 
 	userRepoMock.On("ExistsUserByEmail", mock.Anything, input.Email).Return(true, nil).Once()
-	otpServiceMock.On("GenerateAndSaveWithCode", mock.Anything, mock.Anything, input.Email).Return("123456", nil).Once()
+	otpServiceMock.On("GenerateAndSaveWithSubject", mock.Anything, mock.Anything, input.Email, input.Email).Return("123456", nil).Once()
 	smtpSenderMock.On("SendHTMLMessage", mock.Anything, mock.Anything, mock.Anything, input.Email).Return(nil).Once()
 	templateEngineMock.On("RenderHTML", "recovery-password", mock.Anything).Return("email content", nil).Once()
 
@@ -59,7 +59,7 @@ func (s *RecoveryPasswordSuite) Test_ErrorSettingCache(t provider.T) {
 	input := v0.RecoveryPasswordInput{Email: "test@example.com"}
 	expectedErr := errors.New("cache error")
 
-	otpServiceMock.On("GenerateAndSaveWithCode", mock.Anything, mock.Anything, input.Email).Return(
+	otpServiceMock.On("GenerateAndSaveWithSubject", mock.Anything, mock.Anything, input.Email, input.Email).Return(
 		"",
 		expectedErr,
 	).Once()
@@ -82,7 +82,7 @@ func (s *RecoveryPasswordSuite) Test_ErrorSendingEmail(t provider.T) {
 	expectedErr := errors.New("smtp error")
 
 	userRepoMock.On("ExistsUserByEmail", mock.Anything, input.Email).Return(true, nil).Once()
-	otpServiceMock.On("GenerateAndSaveWithCode", mock.Anything, mock.Anything, input.Email).Return("123456", nil).Once()
+	otpServiceMock.On("GenerateAndSaveWithSubject", mock.Anything, mock.Anything, input.Email, input.Email).Return("123456", nil).Once()
 	templateEngineMock.On("RenderHTML", "recovery-password", mock.Anything).Return("email content", nil).Once()
 	smtpSenderMock.On(
 		"SendHTMLMessage",
@@ -35,7 +35,7 @@ func (s *RefreshTokensSuite) Test_InvalidJwtToken(t provider.T) {
 	req := v0.RefreshTokensInput{
 		RefreshToken: "invalid_refresh_token",
 	}
-	resp, err := svc.RefreshTokens(ctx, req)
+	resp, err := svc.RefreshTokens(ctx, req, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(infrastructure.ErrInvalidJWTToken, err)
@@ -64,7 +64,7 @@ func (s *RefreshTokensSuite) Test_UserNotFound(t provider.T) {
 	req := v0.RefreshTokensInput{
 		RefreshToken: "refreshToken",
 	}
-	resp, err := svc.RefreshTokens(ctx, req)
+	resp, err := svc.RefreshTokens(ctx, req, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(domain.ErrUserNotFound, err)
@@ -94,7 +94,7 @@ func (s *RefreshTokensSuite) Test_ErrFindingUser(t provider.T) {
 	req := v0.RefreshTokensInput{
 		RefreshToken: "refreshToken",
 	}
-	resp, err := svc.RefreshTokens(ctx, req)
+	resp, err := svc.RefreshTokens(ctx, req, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(expectedErr, err)
@@ -127,15 +127,15 @@ func (s *RefreshTokensSuite) Test_UserIsBanned(t provider.T) {
 	req := v0.RefreshTokensInput{
 		RefreshToken: "refreshToken",
 	}
-	resp, err := svc.RefreshTokens(ctx, req)
+	resp, err := svc.RefreshTokens(ctx, req, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(domain.ErrUserIsBlocked, err)
 	t.Require().Equal(v0.JwtTokensOutput{}, resp)
 }
 
-func (s *RefreshTokensSuite) Test_ErrGenerateTokens(t provider.T) {
-	t.Title("RefreshTokens returns GenerateTokens error")
+func (s *RefreshTokensSuite) Test_ErrRotateTokens(t provider.T) {
+	t.Title("RefreshTokens returns RotateTokens error")
 	t.Severity(allure.CRITICAL)
 	t.Epic("Auth service")
 	t.Feature("RefreshTokens")
@@ -157,18 +157,96 @@ func (s *RefreshTokensSuite) Test_ErrGenerateTokens(t provider.T) {
 	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
 	userRepoMock.On("WithRolePreload").Once().Return(scope)
 	userRepoMock.On("FindUserByID", ctx, mock.Anything, mock.Anything).Once().Return(userEntity, nil)
-	jwtServiceMock.On("GenerateTokens", ctx, userEntity).Once().Return("", "", expectedErr)
+	jwtServiceMock.On("RotateTokens", ctx, userEntity, claims, clientInfo).Once().Return("", "", expectedErr)
 
 	req := v0.RefreshTokensInput{
 		RefreshToken: "refreshToken",
 	}
-	resp, err := svc.RefreshTokens(ctx, req)
+	resp, err := svc.RefreshTokens(ctx, req, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(expectedErr, err)
 	t.Require().Equal(v0.JwtTokensOutput{}, resp)
 }
 
+func (s *RefreshTokensSuite) Test_ReusedJwtToken(t provider.T) {
+	t.Title("RefreshTokens returns ReusedJwtToken error and notifies user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("RefreshTokens")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	claims := infrastructure.RefreshTokenClaims{
+		UserID:    uuid.New(),
+		SessionID: uuid.New(),
+		JTI:       uuid.New().String(),
+		Exp:       time.Now().Unix(),
+	}
+	userEntity := &entity.User{
+		Email:     "test@example.com",
+		IsEnabled: true,
+	}
+	jwtServiceMock.On("GetRefreshTokenClaims", ctx, "refreshToken").Once().Return(claims, nil)
+
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, mock.Anything, mock.Anything).Once().Return(userEntity, nil)
+	jwtServiceMock.On("RotateTokens", ctx, userEntity, claims, clientInfo).
+		Once().Return("", "", infrastructure.ErrReusedJWTToken)
+	templateEngineMock.On("RenderHTML", "session-compromised", mock.Anything).Once().Return("email content", nil)
+	smtpSenderMock.On("SendHTMLMessage", mock.Anything, "email content", mock.Anything, userEntity.Email).
+		Once().Return(nil)
+
+	req := v0.RefreshTokensInput{
+		RefreshToken: "refreshToken",
+	}
+	resp, err := svc.RefreshTokens(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().Equal(infrastructure.ErrReusedJWTToken, err)
+	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+	smtpSenderMock.AssertCalled(t, "SendHTMLMessage", mock.Anything, "email content", mock.Anything, userEntity.Email)
+}
+
+func (s *RefreshTokensSuite) Test_ReusedJwtTokenWithEmailError(t provider.T) {
+	t.Title("RefreshTokens returns ReusedJwtToken error even if notification fails")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("RefreshTokens")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	claims := infrastructure.RefreshTokenClaims{
+		UserID:    uuid.New(),
+		SessionID: uuid.New(),
+		JTI:       uuid.New().String(),
+		Exp:       time.Now().Unix(),
+	}
+	userEntity := &entity.User{
+		Email:     "test@example.com",
+		IsEnabled: true,
+	}
+	jwtServiceMock.On("GetRefreshTokenClaims", ctx, "refreshToken").Once().Return(claims, nil)
+
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, mock.Anything, mock.Anything).Once().Return(userEntity, nil)
+	jwtServiceMock.On("RotateTokens", ctx, userEntity, claims, clientInfo).
+		Once().Return("", "", infrastructure.ErrReusedJWTToken)
+	templateEngineMock.On("RenderHTML", "session-compromised", mock.Anything).
+		Once().Return("", errors.New("template error"))
+
+	req := v0.RefreshTokensInput{
+		RefreshToken: "refreshToken",
+	}
+	resp, err := svc.RefreshTokens(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().Equal(infrastructure.ErrReusedJWTToken, err)
+	t.Require().Equal(v0.JwtTokensOutput{}, resp)
+}
+
 func (s *RefreshTokensSuite) Test_Success(t provider.T) {
 	t.Title("RefreshTokens returns success")
 	t.Severity(allure.NORMAL)
@@ -193,12 +271,12 @@ func (s *RefreshTokensSuite) Test_Success(t provider.T) {
 	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
 	userRepoMock.On("WithRolePreload").Once().Return(scope)
 	userRepoMock.On("FindUserByID", ctx, mock.Anything, mock.Anything).Once().Return(userEntity, nil)
-	jwtServiceMock.On("GenerateTokens", ctx, userEntity).Once().Return(accessToken, refreshToken, nil)
+	jwtServiceMock.On("RotateTokens", ctx, userEntity, claims, clientInfo).Once().Return(accessToken, refreshToken, nil)
 
 	req := v0.RefreshTokensInput{
 		RefreshToken: "refreshToken",
 	}
-	resp, err := svc.RefreshTokens(ctx, req)
+	resp, err := svc.RefreshTokens(ctx, req, clientInfo)
 
 	t.Require().NoError(err)
 	t.Require().Equal(accessToken, resp.AccessToken)
@@ -12,6 +12,7 @@ import (
 	"github.com/ozontech/allure-go/pkg/framework/suite"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
+	"time"
 )
 
 type RegisterConfirmSuite struct {
@@ -32,16 +33,18 @@ func (s *RegisterConfirmSuite) Test_Success(t provider.T) {
 	}
 
 	userEntity := &entity.User{Email: "test@example.com", Username: "testuser"}
-	otpServiceMock.On("GetDataByCode", ctx, "register", "123456", mock.Anything).Run(
+	bruteForceServiceMock.On("CheckAttempt", ctx, mock.Anything).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "register", req.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			args.Get(3).(*v0.RegisterInput).Email = "test@example.com"
+			args.Get(4).(*v0.RegisterInput).Email = "test@example.com"
 		},
 	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, mock.Anything).Once().Return(nil)
 	userRepoMock.On("ExistsUserByUsernameOrEmail", ctx, "", "test@example.com").Once().Return(false, nil)
 	userRepoMock.On("CreateUser", mock.Anything, mock.Anything).Once().Return(userEntity, nil)
-	otpServiceMock.On("DeleteDataByCode", ctx, "register", "123456").Once().Return(nil)
+	otpServiceMock.On("DeleteDataBySubject", ctx, "register", req.Email).Once().Return(nil)
 
-	err := svc.RegisterConfirm(ctx, req)
+	err := svc.RegisterConfirm(ctx, req, clientInfo)
 
 	t.Require().NoError(err)
 }
@@ -58,39 +61,18 @@ func (s *RegisterConfirmSuite) Test_InvalidOTP(t provider.T) {
 		OTP:   "123456",
 		Email: "test@example.com",
 	}
+	bruteForceServiceMock.On("CheckAttempt", ctx, mock.Anything).Once().Return(nil)
 	otpServiceMock.On(
-		"GetDataByCode",
+		"GetDataBySubjectAndCode",
 		ctx,
 		"register",
+		req.Email,
 		"123456",
 		mock.Anything,
 	).Once().Return(infrastructure.ErrInvalidOrExpiredOTP)
+	bruteForceServiceMock.On("FailAttempt", ctx, mock.Anything).Once().Return(nil)
 
-	err := svc.RegisterConfirm(ctx, req)
-
-	t.Require().Error(err)
-	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
-}
-
-func (s *RegisterConfirmSuite) Test_InvalidEmail(t provider.T) {
-	t.Title("RegisterConfirm returns InvalidEmail error")
-	t.Severity(allure.CRITICAL)
-	t.Epic("Auth service")
-	t.Feature("RegisterConfirm")
-	t.Tags("Negative")
-
-	ctx := context.Background()
-	req := v0.RegisterConfirmInput{
-		OTP:   "123456",
-		Email: "test@example.com",
-	}
-	otpServiceMock.On("GetDataByCode", ctx, "register", "123456", mock.Anything).Run(
-		func(args mock.Arguments) {
-			args.Get(3).(*v0.RegisterInput).Email = "invalid@example.com"
-		},
-	).Once().Return(nil)
-
-	err := svc.RegisterConfirm(ctx, req)
+	err := svc.RegisterConfirm(ctx, req, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
@@ -108,14 +90,16 @@ func (s *RegisterConfirmSuite) Test_ExistsUser(t provider.T) {
 		OTP:   "123456",
 		Email: "test@example.com",
 	}
-	otpServiceMock.On("GetDataByCode", ctx, "register", "123456", mock.Anything).Run(
+	bruteForceServiceMock.On("CheckAttempt", ctx, mock.Anything).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "register", req.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			args.Get(3).(*v0.RegisterInput).Email = "test@example.com"
+			args.Get(4).(*v0.RegisterInput).Email = "test@example.com"
 		},
 	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, mock.Anything).Once().Return(nil)
 	userRepoMock.On("ExistsUserByUsernameOrEmail", ctx, "", "test@example.com").Once().Return(true, nil)
 
-	err := svc.RegisterConfirm(ctx, req)
+	err := svc.RegisterConfirm(ctx, req, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(domain.ErrDuplicateUser, err)
@@ -134,14 +118,16 @@ func (s *RegisterConfirmSuite) Test_ErrorExistsUser(t provider.T) {
 		Email: "test@example.com",
 	}
 	dbError := errors.New("cache error")
-	otpServiceMock.On("GetDataByCode", ctx, "register", "123456", mock.Anything).Run(
+	bruteForceServiceMock.On("CheckAttempt", ctx, mock.Anything).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "register", req.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			args.Get(3).(*v0.RegisterInput).Email = "test@example.com"
+			args.Get(4).(*v0.RegisterInput).Email = "test@example.com"
 		},
 	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, mock.Anything).Once().Return(nil)
 	userRepoMock.On("ExistsUserByUsernameOrEmail", ctx, "", "test@example.com").Once().Return(false, dbError)
 
-	err := svc.RegisterConfirm(ctx, req)
+	err := svc.RegisterConfirm(ctx, req, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(dbError, err)
@@ -159,15 +145,17 @@ func (s *RegisterConfirmSuite) Test_DuplicateUser(t provider.T) {
 		OTP:   "123456",
 		Email: "test@example.com",
 	}
-	otpServiceMock.On("GetDataByCode", ctx, "register", "123456", mock.Anything).Run(
+	bruteForceServiceMock.On("CheckAttempt", ctx, mock.Anything).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "register", req.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			args.Get(3).(*v0.RegisterInput).Email = "test@example.com"
+			args.Get(4).(*v0.RegisterInput).Email = "test@example.com"
 		},
 	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, mock.Anything).Once().Return(nil)
 	userRepoMock.On("ExistsUserByUsernameOrEmail", ctx, "", "test@example.com").Once().Return(false, nil)
 	userRepoMock.On("CreateUser", ctx, mock.Anything).Once().Return(nil, repo.ErrDuplicateUser)
 
-	err := svc.RegisterConfirm(ctx, req)
+	err := svc.RegisterConfirm(ctx, req, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(domain.ErrDuplicateUser, err)
@@ -185,15 +173,17 @@ func (s *RegisterConfirmSuite) Test_ErrorSavingUser(t provider.T) {
 		OTP:   "123456",
 		Email: "test@example.com",
 	}
-	otpServiceMock.On("GetDataByCode", ctx, "register", "123456", mock.Anything).Run(
+	bruteForceServiceMock.On("CheckAttempt", ctx, mock.Anything).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "register", req.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			args.Get(3).(*v0.RegisterInput).Email = "test@example.com"
+			args.Get(4).(*v0.RegisterInput).Email = "test@example.com"
 		},
 	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, mock.Anything).Once().Return(nil)
 	userRepoMock.On("ExistsUserByUsernameOrEmail", ctx, "", "test@example.com").Once().Return(false, nil)
 	userRepoMock.On("CreateUser", mock.Anything, mock.Anything).Once().Return(nil, errors.New("db error"))
 
-	err := svc.RegisterConfirm(ctx, req)
+	err := svc.RegisterConfirm(ctx, req, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal("db error", err.Error())
@@ -214,16 +204,66 @@ func (s *RegisterConfirmSuite) Test_WarnDeleteCache(t provider.T) {
 	userEntity := &entity.User{Email: "test@example.com", Username: "testuser"}
 	cacheErr := errors.New("cache error")
 
-	otpServiceMock.On("GetDataByCode", ctx, "register", "123456", mock.Anything).Run(
+	bruteForceServiceMock.On("CheckAttempt", ctx, mock.Anything).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "register", req.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			args.Get(3).(*v0.RegisterInput).Email = "test@example.com"
+			args.Get(4).(*v0.RegisterInput).Email = "test@example.com"
 		},
 	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", ctx, mock.Anything).Once().Return(nil)
 	userRepoMock.On("ExistsUserByUsernameOrEmail", ctx, "", "test@example.com").Once().Return(false, nil)
 	userRepoMock.On("CreateUser", mock.Anything, mock.Anything).Once().Return(userEntity, nil)
-	otpServiceMock.On("DeleteDataByCode", ctx, "register", "123456").Once().Return(cacheErr)
+	otpServiceMock.On("DeleteDataBySubject", ctx, "register", req.Email).Once().Return(cacheErr)
 
-	err := svc.RegisterConfirm(ctx, req)
+	err := svc.RegisterConfirm(ctx, req, clientInfo)
 
 	t.Require().NoError(err)
 }
+
+func (s *RegisterConfirmSuite) Test_ErrOTPAttemptsExceeded(t provider.T) {
+	t.Title("RegisterConfirm returns OTPAttemptsExceeded error, when OTP is invalidated after wrong attempts")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("RegisterConfirm")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.RegisterConfirmInput{
+		OTP:   "000000",
+		Email: "exceeded@example.com",
+	}
+	attempt := infrastructure.Attempt{Action: "register_confirm", Account: req.Email, IP: clientInfo.IP}
+
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", ctx, "register", req.Email, req.OTP, mock.Anything).
+		Once().Return(infrastructure.ErrOTPAttemptsExceeded)
+	bruteForceServiceMock.On("FailAttempt", ctx, attempt).Once().Return(nil)
+
+	err := svc.RegisterConfirm(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().Equal(infrastructure.ErrOTPAttemptsExceeded, err)
+}
+
+func (s *RegisterConfirmSuite) Test_ErrTooManyAttempts(t provider.T) {
+	t.Title("RegisterConfirm returns TooManyAttempts error without checking OTP")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("RegisterConfirm")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.RegisterConfirmInput{
+		OTP:   "123456",
+		Email: "locked@example.com",
+	}
+	attempt := infrastructure.Attempt{Action: "register_confirm", Account: req.Email, IP: clientInfo.IP}
+	lockoutErr := infrastructure.LockoutError{Err: infrastructure.ErrTooManyAttempts, RetryAfter: time.Minute}
+
+	bruteForceServiceMock.On("CheckAttempt", ctx, attempt).Once().Return(lockoutErr)
+
+	err := svc.RegisterConfirm(ctx, req, clientInfo)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, infrastructure.ErrTooManyAttempts)
+}
@@ -2,8 +2,11 @@ package auth
 
 import (
 	"context"
+	"github.com/google/uuid"
 	"github.com/mandarine-io/backend/internal/persistence/entity"
 	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
 	"github.com/mandarine-io/backend/third_party/oauth"
 	"github.com/ozontech/allure-go/pkg/allure"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
@@ -11,8 +14,22 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 	"gorm.io/gorm"
+	"time"
 )
 
+func expectRolePreload() {
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+}
+
+func expectTransaction() {
+	transactorMock.On("Transaction", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	).Once()
+}
+
 type RegisterOrLoginSuite struct {
 	suite.Suite
 }
@@ -24,19 +41,24 @@ func (s *RegisterOrLoginSuite) Test_SuccessUniqueUsername(t provider.T) {
 	t.Feature("RegisterOrLogin")
 	t.Tags("Positive")
 
-	userInfo := oauth.UserInfo{Username: "test", Email: "test@example.com"}
-	userEntity := &entity.User{Email: "test@example.com"}
+	userInfo := oauth.UserInfo{ID: "subject_1", Username: "test", Email: "test1@example.com"}
+	userEntity := &entity.User{ID: uuid.New(), Email: userInfo.Email}
 	accessToken := "access_token"
 	refreshToken := "refresh_token"
 
-	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
-	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", mock.Anything, "mock", userInfo.ID).
+		Return(nil, nil).Once()
+	expectRolePreload()
 	userRepoMock.On("FindUserByEmail", mock.Anything, userInfo.Email, mock.Anything).Return(nil, nil).Once()
+	expectTransaction()
 	userRepoMock.On("CreateUser", mock.Anything, mock.Anything).Return(userEntity, nil).Once()
 	userRepoMock.On("ExistsUserByUsername", mock.Anything, userInfo.Username).Return(false, nil).Once()
-	jwtServiceMock.On("GenerateTokens", context.Background(), userEntity).Once().Return(accessToken, refreshToken, nil)
+	identityRepoMock.On("CreateUserIdentity", mock.Anything, mock.MatchedBy(func(identity *entity.UserIdentity) bool {
+		return identity.UserID == userEntity.ID && identity.Provider == "mock" && identity.Subject == userInfo.ID
+	})).Return(&entity.UserIdentity{}, nil).Once()
+	jwtServiceMock.On("GenerateTokens", context.Background(), userEntity, clientInfo).Once().Return(accessToken, refreshToken, nil)
 
-	result, err := svc.RegisterOrLogin(context.Background(), userInfo)
+	result, err := svc.RegisterOrLogin(context.Background(), "mock", userInfo, clientInfo)
 
 	t.Require().NoError(err)
 	t.Require().Equal(result.AccessToken, accessToken)
@@ -50,50 +72,121 @@ func (s *RegisterOrLoginSuite) Test_SuccessNotUniqueUsername(t provider.T) {
 	t.Feature("RegisterOrLogin")
 	t.Tags("Positive")
 
-	userInfo := oauth.UserInfo{Username: "test", Email: "test@example.com"}
-	userEntity := &entity.User{Email: "test@example.com"}
+	userInfo := oauth.UserInfo{ID: "subject_2", Username: "test", Email: "test2@example.com"}
+	userEntity := &entity.User{ID: uuid.New(), Email: userInfo.Email}
 	accessToken := "access_token"
 	refreshToken := "refresh_token"
 
-	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
-	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", mock.Anything, "mock", userInfo.ID).
+		Return(nil, nil).Once()
+	expectRolePreload()
 	userRepoMock.On("FindUserByEmail", mock.Anything, userInfo.Email, mock.Anything).Return(nil, nil).Once()
+	expectTransaction()
 	userRepoMock.On("CreateUser", mock.Anything, mock.Anything).Return(userEntity, nil).Once()
 	userRepoMock.On("ExistsUserByUsername", mock.Anything, userInfo.Username).Return(true, nil).Once()
 	userRepoMock.On("ExistsUserByUsername", mock.Anything, mock.Anything).Return(false, nil).Once()
-	jwtServiceMock.On("GenerateTokens", context.Background(), userEntity).Once().Return(accessToken, refreshToken, nil)
+	identityRepoMock.On("CreateUserIdentity", mock.Anything, mock.Anything).Return(&entity.UserIdentity{}, nil).Once()
+	jwtServiceMock.On("GenerateTokens", context.Background(), userEntity, clientInfo).Once().Return(accessToken, refreshToken, nil)
 
-	result, err := svc.RegisterOrLogin(context.Background(), userInfo)
+	result, err := svc.RegisterOrLogin(context.Background(), "mock", userInfo, clientInfo)
 
 	t.Require().NoError(err)
 	t.Require().Equal(result.AccessToken, accessToken)
 	t.Require().Equal(result.RefreshToken, refreshToken)
 }
 
-func (s *RegisterOrLoginSuite) Test_SuccessExistingUser(t provider.T) {
-	t.Title("RegisterOrLogin returns success with existent user")
+func (s *RegisterOrLoginSuite) Test_SuccessLinkedIdentity(t provider.T) {
+	t.Title("RegisterOrLogin logs in user of linked identity regardless of email")
 	t.Severity(allure.NORMAL)
 	t.Epic("Auth service")
 	t.Feature("RegisterOrLogin")
 	t.Tags("Positive")
 
-	userInfo := oauth.UserInfo{Email: "test@example.com"}
-	userEntity := &entity.User{Email: "test@example.com", IsEnabled: true}
+	userInfo := oauth.UserInfo{ID: "subject_3", Email: "other@example.com"}
+	userEntity := &entity.User{ID: uuid.New(), Email: "test3@example.com", IsEnabled: true}
+	identity := &entity.UserIdentity{UserID: userEntity.ID, Provider: "mock", Subject: userInfo.ID}
 	accessToken := "access_token"
 	refreshToken := "refresh_token"
 
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", mock.Anything, "mock", userInfo.ID).
+		Return(identity, nil).Once()
 	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
 	userRepoMock.On("WithRolePreload").Once().Return(scope)
-	userRepoMock.On("FindUserByEmail", mock.Anything, userInfo.Email, mock.Anything).Return(userEntity, nil).Once()
-	jwtServiceMock.On("GenerateTokens", context.Background(), userEntity).Once().Return(accessToken, refreshToken, nil)
+	userRepoMock.On("FindUserByID", mock.Anything, userEntity.ID, mock.Anything).Return(userEntity, nil).Once()
+	jwtServiceMock.On("GenerateTokens", context.Background(), userEntity, clientInfo).Once().Return(accessToken, refreshToken, nil)
 
-	result, err := svc.RegisterOrLogin(context.Background(), userInfo)
+	result, err := svc.RegisterOrLogin(context.Background(), "mock", userInfo, clientInfo)
 
 	t.Require().NoError(err)
 	t.Require().Equal(result.AccessToken, accessToken)
 	t.Require().Equal(result.RefreshToken, refreshToken)
 }
 
+func (s *RegisterOrLoginSuite) Test_SuccessLinkRequired(t provider.T) {
+	t.Title("RegisterOrLogin returns identity link token for existing user with the same unverified email")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("RegisterOrLogin")
+	t.Tags("Positive")
+
+	userInfo := oauth.UserInfo{ID: "subject_4", Email: "test4@example.com"}
+	userEntity := &entity.User{ID: uuid.New(), Email: userInfo.Email, IsEnabled: true}
+	linkData := v0.IdentityLinkData{
+		UserID:   userEntity.ID.String(),
+		Provider: "mock",
+		Subject:  userInfo.ID,
+		Email:    userInfo.Email,
+	}
+
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", mock.Anything, "mock", userInfo.ID).
+		Return(nil, nil).Once()
+	expectRolePreload()
+	userRepoMock.On("FindUserByEmail", mock.Anything, userInfo.Email, mock.Anything).Return(userEntity, nil).Once()
+	otpServiceMock.On("GenerateAndSaveWithToken", mock.Anything, "identity_link", linkData, 10*time.Minute).
+		Return("link_token", nil).Once()
+
+	result, err := svc.RegisterOrLogin(context.Background(), "mock", userInfo, clientInfo)
+
+	t.Require().NoError(err)
+	t.Require().Equal(v0.LoginOutput{IdentityLinkRequired: true, IdentityLinkToken: "link_token"}, result)
+}
+
+func (s *RegisterOrLoginSuite) Test_EmptySubject(t provider.T) {
+	t.Title("RegisterOrLogin returns UserInfoNotReceived error if provider does not return subject")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("RegisterOrLogin")
+	t.Tags("Negative")
+
+	userInfo := oauth.UserInfo{Email: "test5@example.com"}
+
+	_, err := svc.RegisterOrLogin(context.Background(), "mock", userInfo, clientInfo)
+
+	t.Require().Equal(domain.ErrUserInfoNotReceived, err)
+}
+
+func (s *RegisterOrLoginSuite) Test_LinkedUserIsBlocked(t provider.T) {
+	t.Title("RegisterOrLogin returns UserIsBlocked error for blocked user of linked identity")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("RegisterOrLogin")
+	t.Tags("Negative")
+
+	userInfo := oauth.UserInfo{ID: "subject_6"}
+	userEntity := &entity.User{ID: uuid.New(), IsEnabled: false}
+	identity := &entity.UserIdentity{UserID: userEntity.ID, Provider: "mock", Subject: userInfo.ID}
+
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", mock.Anything, "mock", userInfo.ID).
+		Return(identity, nil).Once()
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", mock.Anything, userEntity.ID, mock.Anything).Return(userEntity, nil).Once()
+
+	_, err := svc.RegisterOrLogin(context.Background(), "mock", userInfo, clientInfo)
+
+	t.Require().Equal(domain.ErrUserIsBlocked, err)
+}
+
 func (s *RegisterOrLoginSuite) Test_ErrorFindingUser(t provider.T) {
 	t.Title("RegisterOrLogin returns DB FindingUser error")
 	t.Severity(allure.CRITICAL)
@@ -101,14 +194,15 @@ func (s *RegisterOrLoginSuite) Test_ErrorFindingUser(t provider.T) {
 	t.Feature("RegisterOrLogin")
 	t.Tags("Negative")
 
-	userInfo := oauth.UserInfo{Email: "test@example.com"}
+	userInfo := oauth.UserInfo{ID: "subject_7", Email: "test7@example.com"}
 	expectedError := errors.New("repo error")
 
-	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
-	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", mock.Anything, "mock", userInfo.ID).
+		Return(nil, nil).Once()
+	expectRolePreload()
 	userRepoMock.On("FindUserByEmail", mock.Anything, userInfo.Email, mock.Anything).Return(nil, expectedError).Once()
 
-	_, err := svc.RegisterOrLogin(context.Background(), userInfo)
+	_, err := svc.RegisterOrLogin(context.Background(), "mock", userInfo, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(expectedError, err)
@@ -121,15 +215,16 @@ func (s *RegisterOrLoginSuite) Test_ErrorExistingUser(t provider.T) {
 	t.Feature("RegisterOrLogin")
 	t.Tags("Negative")
 
-	userInfo := oauth.UserInfo{Email: "test@example.com"}
+	userInfo := oauth.UserInfo{ID: "subject_8", Email: "test8@example.com"}
 	expectedError := errors.New("repo error")
 
-	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
-	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", mock.Anything, "mock", userInfo.ID).
+		Return(nil, nil).Once()
+	expectRolePreload()
 	userRepoMock.On("FindUserByEmail", mock.Anything, userInfo.Email, mock.Anything).Return(nil, nil).Once()
 	userRepoMock.On("ExistsUserByUsername", mock.Anything, mock.Anything).Return(false, expectedError).Once()
 
-	_, err := svc.RegisterOrLogin(context.Background(), userInfo)
+	_, err := svc.RegisterOrLogin(context.Background(), "mock", userInfo, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal(expectedError, err)
@@ -142,16 +237,124 @@ func (s *RegisterOrLoginSuite) Test_ErrorCreatingUser(t provider.T) {
 	t.Feature("RegisterOrLogin")
 	t.Tags("Negative")
 
-	userInfo := oauth.UserInfo{Email: "test@example.com"}
+	userInfo := oauth.UserInfo{ID: "subject_9", Email: "test9@example.com"}
 
-	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
-	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", mock.Anything, "mock", userInfo.ID).
+		Return(nil, nil).Once()
+	expectRolePreload()
 	userRepoMock.On("FindUserByEmail", mock.Anything, userInfo.Email, mock.Anything).Return(nil, nil).Once()
 	userRepoMock.On("ExistsUserByUsername", mock.Anything, mock.Anything).Return(false, nil).Once()
+	expectTransaction()
 	userRepoMock.On("CreateUser", mock.Anything, mock.Anything).Return(nil, errors.New("create error")).Once()
 
-	_, err := svc.RegisterOrLogin(context.Background(), userInfo)
+	_, err := svc.RegisterOrLogin(context.Background(), "mock", userInfo, clientInfo)
 
 	t.Require().Error(err)
 	t.Require().Equal("create error", err.Error())
 }
+
+func (s *RegisterOrLoginSuite) Test_SuccessWithoutEmail(t provider.T) {
+	t.Title("RegisterOrLogin creates user without email and username, if provider does not share them")
+	t.Severity(allure.NORMAL)
+	t.Epic("Auth service")
+	t.Feature("RegisterOrLogin")
+	t.Tags("Positive")
+
+	userInfo := oauth.UserInfo{ID: "subject_10"}
+	userEntity := &entity.User{ID: uuid.New(), Username: "mock_subject_10"}
+	accessToken := "access_token"
+	refreshToken := "refresh_token"
+
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", mock.Anything, "mock", userInfo.ID).
+		Return(nil, nil).Once()
+	userRepoMock.On("ExistsUserByUsername", mock.Anything, "mock_subject_10").Return(false, nil).Once()
+	expectTransaction()
+	userRepoMock.On("CreateUser", mock.Anything, mock.MatchedBy(func(user *entity.User) bool {
+		return user.Username == "mock_subject_10" && user.Email == "" && !user.IsEmailVerified
+	})).Return(userEntity, nil).Once()
+	identityRepoMock.On("CreateUserIdentity", mock.Anything, mock.MatchedBy(func(identity *entity.UserIdentity) bool {
+		return identity.Subject == userInfo.ID && identity.Email == nil
+	})).Return(&entity.UserIdentity{}, nil).Once()
+	jwtServiceMock.On("GenerateTokens", context.Background(), userEntity, clientInfo).Once().Return(accessToken, refreshToken, nil)
+
+	result, err := svc.RegisterOrLogin(context.Background(), "mock", userInfo, clientInfo)
+
+	t.Require().NoError(err)
+	t.Require().Equal(accessToken, result.AccessToken)
+	t.Require().Equal(refreshToken, result.RefreshToken)
+	userRepoMock.AssertNotCalled(t, "FindUserByEmail", mock.Anything, "")
+}
+
+func (s *RegisterOrLoginSuite) Test_SuccessVerifiedEmail(t provider.T) {
+	t.Title("RegisterOrLogin links identity to existing user with the same verified email and logs in")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("RegisterOrLogin")
+	t.Tags("Positive")
+
+	userInfo := oauth.UserInfo{ID: "subject_11", Email: "test11@example.com", IsEmailVerified: true}
+	userEntity := &entity.User{ID: uuid.New(), Email: userInfo.Email, IsEnabled: true}
+	accessToken := "access_token"
+	refreshToken := "refresh_token"
+
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", mock.Anything, "mock", userInfo.ID).
+		Return(nil, nil).Once()
+	expectRolePreload()
+	userRepoMock.On("FindUserByEmail", mock.Anything, userInfo.Email, mock.Anything).Return(userEntity, nil).Once()
+	identityRepoMock.On("CreateUserIdentity", mock.Anything, mock.MatchedBy(func(identity *entity.UserIdentity) bool {
+		return identity.UserID == userEntity.ID && identity.Provider == "mock" && identity.Subject == userInfo.ID
+	})).Return(&entity.UserIdentity{}, nil).Once()
+	jwtServiceMock.On("GenerateTokens", context.Background(), userEntity, clientInfo).Once().Return(accessToken, refreshToken, nil)
+
+	result, err := svc.RegisterOrLogin(context.Background(), "mock", userInfo, clientInfo)
+
+	t.Require().NoError(err)
+	t.Require().Equal(accessToken, result.AccessToken)
+	t.Require().Equal(refreshToken, result.RefreshToken)
+	t.Require().False(result.IdentityLinkRequired)
+}
+
+func (s *RegisterOrLoginSuite) Test_VerifiedEmailUserIsBlocked(t provider.T) {
+	t.Title("RegisterOrLogin returns UserIsBlocked error for blocked user with the same verified email")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("RegisterOrLogin")
+	t.Tags("Negative")
+
+	userInfo := oauth.UserInfo{ID: "subject_12", Email: "test12@example.com", IsEmailVerified: true}
+	userEntity := &entity.User{ID: uuid.New(), Email: userInfo.Email, IsEnabled: false}
+
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", mock.Anything, "mock", userInfo.ID).
+		Return(nil, nil).Once()
+	expectRolePreload()
+	userRepoMock.On("FindUserByEmail", mock.Anything, userInfo.Email, mock.Anything).Return(userEntity, nil).Once()
+
+	_, err := svc.RegisterOrLogin(context.Background(), "mock", userInfo, clientInfo)
+
+	t.Require().Equal(domain.ErrUserIsBlocked, err)
+}
+
+func (s *RegisterOrLoginSuite) Test_ErrorCreatingIdentity(t provider.T) {
+	t.Title("RegisterOrLogin returns CreateUserIdentity error, so transaction of user creation is rolled back")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("RegisterOrLogin")
+	t.Tags("Negative")
+
+	userInfo := oauth.UserInfo{ID: "subject_13", Email: "test13@example.com"}
+	userEntity := &entity.User{ID: uuid.New(), Email: userInfo.Email}
+	expectedError := errors.New("create identity error")
+
+	identityRepoMock.On("FindUserIdentityByProviderAndSubject", mock.Anything, "mock", userInfo.ID).
+		Return(nil, nil).Once()
+	expectRolePreload()
+	userRepoMock.On("FindUserByEmail", mock.Anything, userInfo.Email, mock.Anything).Return(nil, nil).Once()
+	userRepoMock.On("ExistsUserByUsername", mock.Anything, mock.Anything).Return(false, nil).Once()
+	expectTransaction()
+	userRepoMock.On("CreateUser", mock.Anything, mock.Anything).Return(userEntity, nil).Once()
+	identityRepoMock.On("CreateUserIdentity", mock.Anything, mock.Anything).Return(nil, expectedError).Once()
+
+	_, err := svc.RegisterOrLogin(context.Background(), "mock", userInfo, clientInfo)
+
+	t.Require().Equal(expectedError, err)
+}
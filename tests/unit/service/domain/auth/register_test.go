@@ -31,7 +31,7 @@ func (s *RegisterSuite) Test_Success(t provider.T) {
 	}
 
 	userRepoMock.On("ExistsUserByUsernameOrEmail", mock.Anything, req.Username, req.Email).Once().Return(false, nil)
-	otpServiceMock.On("GenerateAndSaveWithCode", mock.Anything, "register", mock.Anything).Once().Return("123456", nil)
+	otpServiceMock.On("GenerateAndSaveWithSubject", mock.Anything, "register", req.Email, mock.Anything).Once().Return("123456", nil)
 	templateEngineMock.On("RenderHTML", mock.Anything, mock.Anything).Once().Return("email content", nil)
 	smtpSenderMock.On("SendHTMLMessage", mock.Anything, mock.Anything, mock.Anything, req.Email).Once().Return(nil)
 
@@ -98,7 +98,7 @@ func (s *RegisterSuite) Test_ErrorSavingCache(t provider.T) {
 	cacheErr := errors.New("cache error")
 
 	userRepoMock.On("ExistsUserByUsernameOrEmail", mock.Anything, req.Username, req.Email).Once().Return(false, nil)
-	otpServiceMock.On("GenerateAndSaveWithCode", mock.Anything, "register", mock.Anything).Once().Return("", cacheErr)
+	otpServiceMock.On("GenerateAndSaveWithSubject", mock.Anything, "register", req.Email, mock.Anything).Once().Return("", cacheErr)
 
 	err := svc.Register(context.Background(), req, nil)
 
@@ -120,7 +120,7 @@ func (s *RegisterSuite) Test_ErrorSendingEmail(t provider.T) {
 	}
 
 	userRepoMock.On("ExistsUserByUsernameOrEmail", mock.Anything, req.Username, req.Email).Once().Return(false, nil)
-	otpServiceMock.On("GenerateAndSaveWithCode", mock.Anything, "register", mock.Anything).Once().Return("123456", nil)
+	otpServiceMock.On("GenerateAndSaveWithSubject", mock.Anything, "register", req.Email, mock.Anything).Once().Return("123456", nil)
 	templateEngineMock.On("RenderHTML", mock.Anything, mock.Anything).Once().Return("email content", nil)
 	smtpSenderMock.On(
 		"SendHTMLMessage",
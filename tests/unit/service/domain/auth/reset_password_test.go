@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"github.com/google/uuid"
 	"github.com/mandarine-io/backend/internal/persistence/entity"
 	"github.com/mandarine-io/backend/internal/persistence/repo"
 	"github.com/mandarine-io/backend/internal/service/domain"
@@ -29,54 +30,78 @@ func (s *ResetPasswordSuite) Test_Success(t provider.T) {
 	input := v0.ResetPasswordInput{Email: "test@example.com", OTP: "123456", Password: "newpassword"}
 	userEntity := &entity.User{Email: "test@example.com"}
 
-	otpServiceMock.On("GetDataByCode", mock.Anything, "recovery_password", "123456", mock.Anything).Run(
+	bruteForceServiceMock.On("CheckAttempt", mock.Anything, mock.Anything).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", mock.Anything, "recovery_password", input.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			email := args.Get(3).(*string)
+			email := args.Get(4).(*string)
 			*email = input.Email
 		},
 	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", mock.Anything, mock.Anything).Once().Return(nil)
 	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
 	userRepoMock.On("WithRolePreload").Once().Return(scope)
 	userRepoMock.On("FindUserByEmail", mock.Anything, input.Email, mock.Anything).Return(userEntity, nil).Once()
 	userRepoMock.On("UpdateUser", mock.Anything, userEntity).Return(userEntity, nil).Once()
-	otpServiceMock.On("DeleteDataByCode", mock.Anything, "recovery_password", "123456").Once().Return(nil)
+	otpServiceMock.On("DeleteDataBySubject", mock.Anything, "recovery_password", input.Email).Once().Return(nil)
 
-	err := svc.ResetPassword(context.Background(), input)
+	err := svc.ResetPassword(context.Background(), input, clientInfo)
 
 	t.Require().NoError(err)
 }
 
-func (s *ResetPasswordSuite) Test_InvalidOrExpiredOtp(t provider.T) {
-	t.Title("ResetPassword returns InvalidOrExpiredOtp error")
-	t.Severity(allure.CRITICAL)
+func (s *ResetPasswordSuite) Test_SuccessWithRevokeSessions(t provider.T) {
+	t.Title("ResetPassword returns Success and revokes all sessions")
+	t.Severity(allure.NORMAL)
 	t.Epic("Auth service")
 	t.Feature("ResetPassword")
-	t.Tags("Negative")
+	t.Tags("Positive")
 
-	input := v0.ResetPasswordInput{Email: "test@example.com", OTP: "wrong", Password: "newpassword"}
-	otpServiceMock.On("GetDataByCode", mock.Anything, "recovery_password", "wrong", mock.Anything).Run(
+	input := v0.ResetPasswordInput{
+		Email:          "test@example.com",
+		OTP:            "123456",
+		Password:       "newpassword",
+		RevokeSessions: true,
+	}
+	userEntity := &entity.User{ID: uuid.New(), Email: "test@example.com"}
+
+	bruteForceServiceMock.On("CheckAttempt", mock.Anything, mock.Anything).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", mock.Anything, "recovery_password", input.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			email := args.Get(3).(*string)
+			email := args.Get(4).(*string)
 			*email = input.Email
 		},
-	).Once().Return(infrastructure.ErrInvalidOrExpiredOTP)
+	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", mock.Anything, mock.Anything).Once().Return(nil)
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByEmail", mock.Anything, input.Email, mock.Anything).Return(userEntity, nil).Once()
+	userRepoMock.On("UpdateUser", mock.Anything, userEntity).Return(userEntity, nil).Once()
+	jwtServiceMock.On("RevokeSessions", mock.Anything, userEntity.ID, uuid.Nil).Once().Return(nil)
+	otpServiceMock.On("DeleteDataBySubject", mock.Anything, "recovery_password", input.Email).Once().Return(nil)
 
-	err := svc.ResetPassword(context.Background(), input)
+	err := svc.ResetPassword(context.Background(), input, clientInfo)
 
-	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
+	t.Require().NoError(err)
 }
 
-func (s *ResetPasswordSuite) Test_CacheEntryNotFound(t provider.T) {
-	t.Title("ResetPassword returns CacheEntryNotFound error")
+func (s *ResetPasswordSuite) Test_InvalidOrExpiredOtp(t provider.T) {
+	t.Title("ResetPassword returns InvalidOrExpiredOtp error")
 	t.Severity(allure.CRITICAL)
 	t.Epic("Auth service")
 	t.Feature("ResetPassword")
 	t.Tags("Negative")
 
-	input := v0.ResetPasswordInput{Email: "test@example.com", OTP: "123456", Password: "newpassword"}
-	otpServiceMock.On("GetDataByCode", mock.Anything, "recovery_password", "123456", mock.Anything).Once().Return(nil)
+	input := v0.ResetPasswordInput{Email: "test@example.com", OTP: "wrong", Password: "newpassword"}
+	bruteForceServiceMock.On("CheckAttempt", mock.Anything, mock.Anything).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", mock.Anything, "recovery_password", input.Email, "wrong", mock.Anything).Run(
+		func(args mock.Arguments) {
+			email := args.Get(4).(*string)
+			*email = input.Email
+		},
+	).Once().Return(infrastructure.ErrInvalidOrExpiredOTP)
+	bruteForceServiceMock.On("FailAttempt", mock.Anything, mock.Anything).Once().Return(nil)
 
-	err := svc.ResetPassword(context.Background(), input)
+	err := svc.ResetPassword(context.Background(), input, clientInfo)
 
 	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
 }
@@ -90,17 +115,19 @@ func (s *ResetPasswordSuite) Test_UserNotFound(t provider.T) {
 
 	input := v0.ResetPasswordInput{Email: "test@example.com", OTP: "123456", Password: "newpassword"}
 
-	otpServiceMock.On("GetDataByCode", mock.Anything, "recovery_password", "123456", mock.Anything).Run(
+	bruteForceServiceMock.On("CheckAttempt", mock.Anything, mock.Anything).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", mock.Anything, "recovery_password", input.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			email := args.Get(3).(*string)
+			email := args.Get(4).(*string)
 			*email = input.Email
 		},
 	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", mock.Anything, mock.Anything).Once().Return(nil)
 	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
 	userRepoMock.On("WithRolePreload").Once().Return(scope)
 	userRepoMock.On("FindUserByEmail", mock.Anything, input.Email, mock.Anything).Return(nil, nil).Once()
 
-	err := svc.ResetPassword(context.Background(), input)
+	err := svc.ResetPassword(context.Background(), input, clientInfo)
 
 	t.Require().Equal(domain.ErrUserNotFound, err)
 }
@@ -115,19 +142,21 @@ func (s *ResetPasswordSuite) Test_ErrorUpdatingUser(t provider.T) {
 	input := v0.ResetPasswordInput{Email: "test@example.com", OTP: "123456", Password: "newpassword"}
 	userEntity := &entity.User{Email: "test@example.com"}
 
-	otpServiceMock.On("GetDataByCode", mock.Anything, "recovery_password", "123456", mock.Anything).Run(
+	bruteForceServiceMock.On("CheckAttempt", mock.Anything, mock.Anything).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", mock.Anything, "recovery_password", input.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			email := args.Get(3).(*string)
+			email := args.Get(4).(*string)
 			*email = input.Email
 		},
 	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", mock.Anything, mock.Anything).Once().Return(nil)
 	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
 	userRepoMock.On("WithRolePreload").Once().Return(scope)
 	userRepoMock.On("FindUserByEmail", mock.Anything, input.Email, mock.Anything).Return(userEntity, nil).Once()
 	userRepoMock.On("UpdateUser", mock.Anything, userEntity).
 		Return(userEntity, errors.New("update error")).Once()
 
-	err := svc.ResetPassword(context.Background(), input)
+	err := svc.ResetPassword(context.Background(), input, clientInfo)
 
 	t.Require().Error(err)
 }
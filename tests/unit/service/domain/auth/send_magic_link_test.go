@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type SendMagicLinkSuite struct {
+	suite.Suite
+}
+
+func (s *SendMagicLinkSuite) Test_Success(t provider.T) {
+	t.Title("SendMagicLink sends email with link and returns device secret")
+	t.Severity(allure.NORMAL)
+	t.Epic("Auth service")
+	t.Feature("SendMagicLink")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	req := v0.MagicLinkInput{Email: "magic1@example.com"}
+	userEntity := &entity.User{ID: uuid.New(), Email: req.Email, IsEnabled: true}
+
+	userRepoMock.On("FindUserByEmail", ctx, req.Email).Once().Return(userEntity, nil)
+	otpServiceMock.On("GenerateAndSaveWithToken", ctx, "magic_link", mock.Anything, 15*time.Minute).
+		Once().Return("token", nil)
+	templateEngineMock.On("RenderHTML", "magic-link", v0.MagicLinkTemplateArgs{
+		Email: req.Email,
+		TTL:   15,
+		Link:  "http://localhost:8080/auth/magic-link?token=token",
+	}).Once().Return("email content", nil)
+	smtpSenderMock.On("SendHTMLMessage", "Sign in link", "email content", mock.Anything, req.Email).Once().Return(nil)
+
+	resp, err := svc.SendMagicLink(ctx, req, nil)
+
+	t.Require().NoError(err)
+	t.Require().NotEmpty(resp.DeviceSecret)
+}
+
+func (s *SendMagicLinkSuite) Test_UserNotFound(t provider.T) {
+	t.Title("SendMagicLink returns UserNotFound error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("SendMagicLink")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.MagicLinkInput{Email: "magic2@example.com"}
+
+	userRepoMock.On("FindUserByEmail", ctx, req.Email).Once().Return(nil, nil)
+
+	resp, err := svc.SendMagicLink(ctx, req, nil)
+
+	t.Require().Equal(domain.ErrUserNotFound, err)
+	t.Require().Empty(resp.DeviceSecret)
+}
+
+func (s *SendMagicLinkSuite) Test_UserIsBlocked(t provider.T) {
+	t.Title("SendMagicLink returns UserIsBlocked error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("SendMagicLink")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.MagicLinkInput{Email: "magic3@example.com"}
+	userEntity := &entity.User{ID: uuid.New(), Email: req.Email, IsEnabled: false}
+
+	userRepoMock.On("FindUserByEmail", ctx, req.Email).Once().Return(userEntity, nil)
+
+	resp, err := svc.SendMagicLink(ctx, req, nil)
+
+	t.Require().Equal(domain.ErrUserIsBlocked, err)
+	t.Require().Empty(resp.DeviceSecret)
+}
+
+func (s *SendMagicLinkSuite) Test_SendEmailError(t provider.T) {
+	t.Title("SendMagicLink returns SendEmail error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("SendMagicLink")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.MagicLinkInput{Email: "magic4@example.com"}
+	userEntity := &entity.User{ID: uuid.New(), Email: req.Email, IsEnabled: true}
+
+	userRepoMock.On("FindUserByEmail", ctx, req.Email).Once().Return(userEntity, nil)
+	otpServiceMock.On("GenerateAndSaveWithToken", ctx, "magic_link", mock.Anything, 15*time.Minute).
+		Once().Return("token", nil)
+	templateEngineMock.On("RenderHTML", "magic-link", mock.Anything).Once().Return("email content", nil)
+	smtpSenderMock.On("SendHTMLMessage", mock.Anything, mock.Anything, mock.Anything, req.Email).
+		Once().Return(errors.New("smtp error"))
+
+	resp, err := svc.SendMagicLink(ctx, req, nil)
+
+	t.Require().Equal(domain.ErrSendEmail, err)
+	t.Require().Empty(resp.DeviceSecret)
+}
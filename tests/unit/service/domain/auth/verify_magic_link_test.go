@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"gorm.io/gorm"
+)
+
+type VerifyMagicLinkSuite struct {
+	suite.Suite
+}
+
+// mockConsumeMagicLink makes OTP service return data of the link requested with device secret
+func mockConsumeMagicLink(ctx context.Context, token string, userID uuid.UUID, deviceSecret string) {
+	hash := sha256.Sum256([]byte(deviceSecret))
+	data, _ := json.Marshal(map[string]string{"userId": userID.String(), "deviceHash": hex.EncodeToString(hash[:])})
+
+	otpServiceMock.On("ConsumeDataByToken", ctx, "magic_link", token, mock.Anything).Run(
+		func(args mock.Arguments) {
+			_ = json.Unmarshal(data, args.Get(3))
+		},
+	).Once().Return(nil)
+}
+
+func (s *VerifyMagicLinkSuite) Test_Success(t provider.T) {
+	t.Title("VerifyMagicLink returns JWT tokens and verifies email")
+	t.Severity(allure.NORMAL)
+	t.Epic("Auth service")
+	t.Feature("VerifyMagicLink")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	req := v0.VerifyMagicLinkInput{Token: "magic_token_1"}
+	userEntity := &entity.User{ID: uuid.New(), IsEnabled: true}
+
+	mockConsumeMagicLink(ctx, req.Token, userEntity.ID, "secret")
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, userEntity.ID, mock.Anything).Once().Return(userEntity, nil)
+	userRepoMock.On("UpdateUser", ctx, userEntity).Once().Return(userEntity, nil)
+	jwtServiceMock.On("GenerateTokens", ctx, userEntity, clientInfo).Once().Return("access", "refresh", nil)
+
+	resp, err := svc.VerifyMagicLink(ctx, req, "secret", clientInfo)
+
+	t.Require().NoError(err)
+	t.Require().Equal(v0.LoginOutput{AccessToken: "access", RefreshToken: "refresh"}, resp)
+	t.Require().True(userEntity.IsEmailVerified)
+}
+
+func (s *VerifyMagicLinkSuite) Test_SuccessMFARequired(t provider.T) {
+	t.Title("VerifyMagicLink returns MFA token for user with enabled 2FA")
+	t.Severity(allure.NORMAL)
+	t.Epic("Auth service")
+	t.Feature("VerifyMagicLink")
+	t.Tags("Positive")
+
+	ctx := context.Background()
+	req := v0.VerifyMagicLinkInput{Token: "magic_token_2"}
+	userEntity := &entity.User{ID: uuid.New(), IsEnabled: true, IsEmailVerified: true, IsTOTPEnabled: true}
+
+	mockConsumeMagicLink(ctx, req.Token, userEntity.ID, "secret")
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, userEntity.ID, mock.Anything).Once().Return(userEntity, nil)
+	jwtServiceMock.On("GenerateMFAToken", ctx, userEntity).Once().Return("mfa_token", nil)
+
+	resp, err := svc.VerifyMagicLink(ctx, req, "secret", clientInfo)
+
+	t.Require().NoError(err)
+	t.Require().Equal(v0.LoginOutput{MFARequired: true, MFAToken: "mfa_token"}, resp)
+}
+
+func (s *VerifyMagicLinkSuite) Test_InvalidToken(t provider.T) {
+	t.Title("VerifyMagicLink returns InvalidOrExpiredOTP error for used or expired link")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("VerifyMagicLink")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.VerifyMagicLinkInput{Token: "magic_token_3"}
+
+	otpServiceMock.On("ConsumeDataByToken", ctx, "magic_link", req.Token, mock.Anything).
+		Once().Return(infrastructure.ErrInvalidOrExpiredOTP)
+
+	resp, err := svc.VerifyMagicLink(ctx, req, "secret", clientInfo)
+
+	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
+	t.Require().Equal(v0.LoginOutput{}, resp)
+}
+
+func (s *VerifyMagicLinkSuite) Test_AnotherDevice(t provider.T) {
+	t.Title("VerifyMagicLink returns MagicLinkDeviceMismatch error for another browser")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("VerifyMagicLink")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+
+	for i, deviceSecret := range []string{"another_secret", ""} {
+		req := v0.VerifyMagicLinkInput{Token: "magic_token_4_" + string(rune('a'+i))}
+		mockConsumeMagicLink(ctx, req.Token, uuid.New(), "secret")
+
+		resp, err := svc.VerifyMagicLink(ctx, req, deviceSecret, clientInfo)
+
+		t.Require().Equal(domain.ErrMagicLinkDeviceMismatch, err)
+		t.Require().Equal(v0.LoginOutput{}, resp)
+	}
+}
+
+func (s *VerifyMagicLinkSuite) Test_UserIsBlocked(t provider.T) {
+	t.Title("VerifyMagicLink returns UserIsBlocked error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Auth service")
+	t.Feature("VerifyMagicLink")
+	t.Tags("Negative")
+
+	ctx := context.Background()
+	req := v0.VerifyMagicLinkInput{Token: "magic_token_5"}
+	userEntity := &entity.User{ID: uuid.New(), IsEnabled: false}
+
+	mockConsumeMagicLink(ctx, req.Token, userEntity.ID, "secret")
+	var scope repo.Scope = func(db *gorm.DB) *gorm.DB { return db }
+	userRepoMock.On("WithRolePreload").Once().Return(scope)
+	userRepoMock.On("FindUserByID", ctx, userEntity.ID, mock.Anything).Once().Return(userEntity, nil)
+
+	resp, err := svc.VerifyMagicLink(ctx, req, "secret", clientInfo)
+
+	t.Require().Equal(domain.ErrUserIsBlocked, err)
+	t.Require().Equal(v0.LoginOutput{}, resp)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/suite"
 	"github.com/stretchr/testify/mock"
+	"time"
 )
 
 type VerifyRecoveryCodeSuite struct {
@@ -22,14 +23,16 @@ func (s *VerifyRecoveryCodeSuite) Test_Success(t provider.T) {
 	t.Tags("Positive")
 
 	input := v0.VerifyRecoveryCodeInput{Email: "test@example.com", OTP: "123456"}
-	otpServiceMock.On("GetDataByCode", mock.Anything, "recovery_password", "123456", mock.Anything).Run(
+	bruteForceServiceMock.On("CheckAttempt", mock.Anything, mock.Anything).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", mock.Anything, "recovery_password", input.Email, "123456", mock.Anything).Run(
 		func(args mock.Arguments) {
-			email := args.Get(3).(*string)
+			email := args.Get(4).(*string)
 			*email = input.Email
 		},
 	).Once().Return(nil)
+	bruteForceServiceMock.On("ResetAttempts", mock.Anything, mock.Anything).Once().Return(nil)
 
-	err := svc.VerifyRecoveryCode(context.Background(), input)
+	err := svc.VerifyRecoveryCode(context.Background(), input, clientInfo)
 
 	t.Require().NoError(err)
 }
@@ -42,30 +45,39 @@ func (s *VerifyRecoveryCodeSuite) Test_InvalidOrExpiredOtp(t provider.T) {
 	t.Tags("Negative")
 
 	input := v0.VerifyRecoveryCodeInput{Email: "test@example.com", OTP: "wrong"}
+	bruteForceServiceMock.On("CheckAttempt", mock.Anything, mock.Anything).Once().Return(nil)
 	otpServiceMock.On(
-		"GetDataByCode",
+		"GetDataBySubjectAndCode",
 		mock.Anything,
 		"recovery_password",
+		input.Email,
 		"wrong",
 		mock.Anything,
 	).Once().Return(infrastructure.ErrInvalidOrExpiredOTP)
+	bruteForceServiceMock.On("FailAttempt", mock.Anything, mock.Anything).Once().Return(nil)
 
-	err := svc.VerifyRecoveryCode(context.Background(), input)
+	err := svc.VerifyRecoveryCode(context.Background(), input, clientInfo)
 
 	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
 }
 
-func (s *VerifyRecoveryCodeSuite) Test_CacheEntryNotFound(t provider.T) {
-	t.Title("VerifyRecoveryCode returns CacheEntryNotFound error")
+func (s *VerifyRecoveryCodeSuite) Test_ErrAccountTemporarilyLocked(t provider.T) {
+	t.Title("VerifyRecoveryCode returns AccountTemporarilyLocked error, when failed attempt exceeds limit")
 	t.Severity(allure.CRITICAL)
 	t.Epic("Auth service")
 	t.Feature("VerifyRecoveryCode")
 	t.Tags("Negative")
 
-	input := v0.VerifyRecoveryCodeInput{Email: "test@example.com", OTP: "123456"}
-	otpServiceMock.On("GetDataByCode", mock.Anything, "recovery_password", "123456", mock.Anything).Once().Return(nil)
+	input := v0.VerifyRecoveryCodeInput{Email: "lock@example.com", OTP: "000000"}
+	attempt := infrastructure.Attempt{Action: "recovery_password", Account: input.Email, IP: clientInfo.IP}
+	lockoutErr := infrastructure.LockoutError{Err: infrastructure.ErrAccountTemporarilyLocked, RetryAfter: time.Minute}
 
-	err := svc.VerifyRecoveryCode(context.Background(), input)
+	bruteForceServiceMock.On("CheckAttempt", mock.Anything, attempt).Once().Return(nil)
+	otpServiceMock.On("GetDataBySubjectAndCode", mock.Anything, "recovery_password", input.Email, input.OTP, mock.Anything).
+		Once().Return(infrastructure.ErrInvalidOrExpiredOTP)
+	bruteForceServiceMock.On("FailAttempt", mock.Anything, attempt).Once().Return(lockoutErr)
 
-	t.Require().Equal(infrastructure.ErrInvalidOrExpiredOTP, err)
+	err := svc.VerifyRecoveryCode(context.Background(), input, clientInfo)
+
+	t.Require().Equal(lockoutErr, err)
 }
@@ -0,0 +1,86 @@
+package chat
+
+import (
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/config"
+	mock4 "github.com/mandarine-io/backend/internal/infrastructure/smtp/mock"
+	mock5 "github.com/mandarine-io/backend/internal/infrastructure/template/mock"
+	"github.com/mandarine-io/backend/internal/infrastructure/websocket"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	mock2 "github.com/mandarine-io/backend/internal/persistence/repo/mock"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/domain/chat"
+	mock3 "github.com/mandarine-io/backend/internal/service/domain/mock"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"testing"
+)
+
+var (
+	conversationRepoMock  *mock2.ConversationRepositoryMock
+	chatMessageRepoMock   *mock2.ChatMessageRepositoryMock
+	masterProfileRepoMock *mock2.MasterProfileRepositoryMock
+	resourceServiceMock   *mock3.ResourceServiceMock
+	smtpSenderMock        *mock4.SenderMock
+	templateEngineMock    *mock5.EngineMock
+	pool                  *websocket.Pool
+	svc                   domain.ChatService
+)
+
+func init() {
+	conversationRepoMock = &mock2.ConversationRepositoryMock{}
+	chatMessageRepoMock = &mock2.ChatMessageRepositoryMock{}
+	masterProfileRepoMock = &mock2.MasterProfileRepositoryMock{}
+	resourceServiceMock = &mock3.ResourceServiceMock{}
+	smtpSenderMock = &mock4.SenderMock{}
+	templateEngineMock = &mock5.EngineMock{}
+
+	var err error
+	pool, err = websocket.NewPool(10)
+	if err != nil {
+		panic(err)
+	}
+
+	svc = chat.NewService(
+		conversationRepoMock,
+		chatMessageRepoMock,
+		masterProfileRepoMock,
+		resourceServiceMock,
+		pool,
+		websocket.NewRouter(),
+		smtpSenderMock,
+		templateEngineMock,
+		config.SMTPConfig{From: "noreply@mandarine.io"},
+	)
+}
+
+func newConversation() *entity.Conversation {
+	clientID := uuid.New()
+	masterID := uuid.New()
+
+	return &entity.Conversation{
+		ID:       uuid.New(),
+		ClientID: clientID,
+		Client:   entity.User{ID: clientID, Username: "client", Email: "client@example.com"},
+		MasterID: masterID,
+		Master:   entity.User{ID: masterID, Username: "master", Email: "master@example.com"},
+	}
+}
+
+type ChatServiceSuite struct {
+	suite.Suite
+}
+
+func TestChatServiceSuite(t *testing.T) {
+	suite.RunSuite(t, new(ChatServiceSuite))
+}
+
+func (s *ChatServiceSuite) Test(t provider.T) {
+	s.RunSuite(t, new(CreateConversationSuite))
+	s.RunSuite(t, new(DownloadAttachmentSuite))
+	s.RunSuite(t, new(GetConversationsSuite))
+	s.RunSuite(t, new(GetMessagesSuite))
+	s.RunSuite(t, new(MarkReadSuite))
+	s.RunSuite(t, new(SendMessageSuite))
+	s.RunSuite(t, new(UploadAttachmentsSuite))
+}
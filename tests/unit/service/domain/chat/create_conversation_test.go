@@ -0,0 +1,203 @@
+package chat
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+)
+
+type CreateConversationSuite struct {
+	suite.Suite
+}
+
+func (s *CreateConversationSuite) Test_Success(t provider.T) {
+	t.Title("CreateConversation creates conversation of client with master about appointment")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Chat service")
+	t.Feature("CreateConversation")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	conversation := newConversation()
+	appointmentID := uuid.New()
+	conversation.AppointmentID = &appointmentID
+	input := v0.CreateConversationInput{
+		MasterUsername: "master-create",
+		AppointmentID:  lo.ToPtr(appointmentID.String()),
+	}
+
+	masterProfileRepoMock.On("FindEnabledMasterProfileByUsername", ctx, input.MasterUsername).
+		Once().
+		Return(&entity.MasterProfile{ID: uuid.New(), UserID: conversation.MasterID}, nil)
+	conversationRepoMock.On(
+		"FindConversationByParticipants",
+		ctx,
+		conversation.ClientID,
+		conversation.MasterID,
+		&appointmentID,
+	).Once().Return(nil, nil)
+	conversationRepoMock.On(
+		"CreateConversation",
+		ctx,
+		mock.MatchedBy(
+			func(c *entity.Conversation) bool {
+				return c.ClientID == conversation.ClientID &&
+					c.MasterID == conversation.MasterID &&
+					*c.AppointmentID == appointmentID
+			},
+		),
+	).Once().Return(conversation, nil)
+	conversationRepoMock.On(
+		"FindConversationByParticipants",
+		ctx,
+		conversation.ClientID,
+		conversation.MasterID,
+		&appointmentID,
+	).Once().Return(conversation, nil)
+	chatMessageRepoMock.On("CountUnreadChatMessages", ctx, conversation, conversation.ClientID).
+		Once().
+		Return(int64(0), nil)
+
+	// Act
+	res, err := svc.CreateConversation(ctx, conversation.ClientID, input)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(conversation.ID.String(), res.ID)
+	t.Require().Equal("master", res.Master.Username)
+	t.Require().Equal(appointmentID.String(), *res.AppointmentID)
+}
+
+func (s *CreateConversationSuite) Test_Existing(t provider.T) {
+	t.Title("CreateConversation returns already started conversation")
+	t.Severity(allure.NORMAL)
+	t.Epic("Chat service")
+	t.Feature("CreateConversation")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	conversation := newConversation()
+	input := v0.CreateConversationInput{MasterUsername: "master-existing"}
+
+	masterProfileRepoMock.On("FindEnabledMasterProfileByUsername", ctx, input.MasterUsername).
+		Once().
+		Return(&entity.MasterProfile{ID: uuid.New(), UserID: conversation.MasterID}, nil)
+	conversationRepoMock.On(
+		"FindConversationByParticipants",
+		ctx,
+		conversation.ClientID,
+		conversation.MasterID,
+		(*uuid.UUID)(nil),
+	).Once().Return(conversation, nil)
+	chatMessageRepoMock.On("CountUnreadChatMessages", ctx, conversation, conversation.ClientID).
+		Once().
+		Return(int64(3), nil)
+
+	// Act
+	res, err := svc.CreateConversation(ctx, conversation.ClientID, input)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(conversation.ID.String(), res.ID)
+	t.Require().Equal(int64(3), res.UnreadCount)
+	t.Require().Nil(res.AppointmentID)
+}
+
+func (s *CreateConversationSuite) Test_CreatedConcurrently(t provider.T) {
+	t.Title("CreateConversation returns conversation created by concurrent request")
+	t.Severity(allure.NORMAL)
+	t.Epic("Chat service")
+	t.Feature("CreateConversation")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	conversation := newConversation()
+	input := v0.CreateConversationInput{MasterUsername: "master-concurrent"}
+
+	masterProfileRepoMock.On("FindEnabledMasterProfileByUsername", ctx, input.MasterUsername).
+		Once().
+		Return(&entity.MasterProfile{ID: uuid.New(), UserID: conversation.MasterID}, nil)
+	conversationRepoMock.On(
+		"FindConversationByParticipants",
+		ctx,
+		conversation.ClientID,
+		conversation.MasterID,
+		(*uuid.UUID)(nil),
+	).Once().Return(nil, nil)
+	conversationRepoMock.On("CreateConversation", ctx, mock.Anything).
+		Once().
+		Return(nil, repo.ErrDuplicateConversation)
+	conversationRepoMock.On(
+		"FindConversationByParticipants",
+		ctx,
+		conversation.ClientID,
+		conversation.MasterID,
+		(*uuid.UUID)(nil),
+	).Once().Return(conversation, nil)
+	chatMessageRepoMock.On("CountUnreadChatMessages", ctx, conversation, conversation.ClientID).
+		Once().
+		Return(int64(0), nil)
+
+	// Act
+	res, err := svc.CreateConversation(ctx, conversation.ClientID, input)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(conversation.ID.String(), res.ID)
+}
+
+func (s *CreateConversationSuite) Test_MasterProfileNotFound(t provider.T) {
+	t.Title("CreateConversation returns MasterProfileNotFound error for unknown or disabled master")
+	t.Severity(allure.NORMAL)
+	t.Epic("Chat service")
+	t.Feature("CreateConversation")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	input := v0.CreateConversationInput{MasterUsername: "master-unknown"}
+
+	masterProfileRepoMock.On("FindEnabledMasterProfileByUsername", ctx, input.MasterUsername).
+		Once().
+		Return(nil, nil)
+
+	// Act
+	_, err := svc.CreateConversation(ctx, uuid.New(), input)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrMasterProfileNotFound)
+}
+
+func (s *CreateConversationSuite) Test_ChatWithSelf(t provider.T) {
+	t.Title("CreateConversation returns ChatWithSelf error for own master profile")
+	t.Severity(allure.NORMAL)
+	t.Epic("Chat service")
+	t.Feature("CreateConversation")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	input := v0.CreateConversationInput{MasterUsername: "master-self"}
+
+	masterProfileRepoMock.On("FindEnabledMasterProfileByUsername", ctx, input.MasterUsername).
+		Once().
+		Return(&entity.MasterProfile{ID: uuid.New(), UserID: userID}, nil)
+
+	// Act
+	_, err := svc.CreateConversation(ctx, userID, input)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrChatWithSelf)
+}
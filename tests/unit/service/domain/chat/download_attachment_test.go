@@ -0,0 +1,63 @@
+package chat
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/infrastructure/s3"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+type DownloadAttachmentSuite struct {
+	suite.Suite
+}
+
+func (s *DownloadAttachmentSuite) Test_Success(t provider.T) {
+	t.Title("DownloadAttachment returns attachment of conversation for participant")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Chat service")
+	t.Feature("DownloadAttachment")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	conversation := newConversation()
+	folder := "chat/" + conversation.ID.String()
+	objectID := folder + "/" + conversation.ClientID.String() + "/hash-photo.png"
+	data := &s3.FileData{ID: objectID}
+
+	conversationRepoMock.On("FindConversationByID", ctx, conversation.ID).Once().Return(conversation, nil)
+	resourceServiceMock.On("DownloadResourceFromFolder", ctx, folder, objectID).Once().Return(data, nil)
+
+	// Act
+	res, err := svc.DownloadAttachment(ctx, conversation.MasterID, conversation.ID, objectID)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(data, res)
+}
+
+func (s *DownloadAttachmentSuite) Test_ConversationNotFound(t provider.T) {
+	t.Title("DownloadAttachment returns ConversationNotFound error for non participant")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Chat service")
+	t.Feature("DownloadAttachment")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	conversation := newConversation()
+	objectID := "chat/" + conversation.ID.String() + "/" + conversation.ClientID.String() + "/hash-photo.png"
+
+	conversationRepoMock.On("FindConversationByID", ctx, conversation.ID).Once().Return(conversation, nil)
+
+	// Act
+	_, err := svc.DownloadAttachment(ctx, uuid.New(), conversation.ID, objectID)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrConversationNotFound)
+	resourceServiceMock.AssertNotCalled(t, "DownloadResourceFromFolder", ctx, mock.Anything, objectID)
+}
@@ -0,0 +1,66 @@
+package chat
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type GetConversationsSuite struct {
+	suite.Suite
+}
+
+func (s *GetConversationsSuite) Test_Success(t provider.T) {
+	t.Title("GetConversations returns conversations with unread counters and their total")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Chat service")
+	t.Feature("GetConversations")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	first := newConversation()
+	second := newConversation()
+	second.MasterID = first.ClientID
+	userID := first.ClientID
+
+	conversationRepoMock.On("FindConversationsByUserID", ctx, userID).
+		Once().
+		Return([]*entity.Conversation{first, second}, nil)
+	chatMessageRepoMock.On("CountUnreadChatMessages", ctx, first, userID).Once().Return(int64(2), nil)
+	chatMessageRepoMock.On("CountUnreadChatMessages", ctx, second, userID).Once().Return(int64(5), nil)
+
+	// Act
+	res, err := svc.GetConversations(ctx, userID)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(2, res.Count)
+	t.Require().Equal(int64(7), res.UnreadCount)
+	t.Require().Equal(int64(2), res.Data[0].UnreadCount)
+	t.Require().Equal(int64(5), res.Data[1].UnreadCount)
+}
+
+func (s *GetConversationsSuite) Test_ConversationOfOtherUsers(t provider.T) {
+	t.Title("GetConversation returns ConversationNotFound error for conversation of other users")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Chat service")
+	t.Feature("GetConversation")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	conversation := newConversation()
+
+	conversationRepoMock.On("FindConversationByID", ctx, conversation.ID).Once().Return(conversation, nil)
+
+	// Act
+	_, err := svc.GetConversation(ctx, uuid.New(), conversation.ID)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrConversationNotFound)
+}
@@ -0,0 +1,116 @@
+package chat
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type GetMessagesSuite struct {
+	suite.Suite
+}
+
+func (s *GetMessagesSuite) Test_Pagination(t provider.T) {
+	t.Title("GetMessages returns next cursor, which points to the last message of page")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Chat service")
+	t.Feature("GetMessages")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	conversation := newConversation()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	messages := []*entity.ChatMessage{
+		{ID: uuid.New(), ConversationID: conversation.ID, SenderID: conversation.ClientID, CreatedAt: now},
+		{
+			ID:             uuid.New(),
+			ConversationID: conversation.ID,
+			SenderID:       conversation.MasterID,
+			CreatedAt:      now.Add(-time.Minute),
+			Attachments:    []entity.ChatMessageAttachment{{ObjectID: "photo.png"}},
+		},
+		{ID: uuid.New(), ConversationID: conversation.ID, SenderID: conversation.ClientID, CreatedAt: now.Add(-time.Hour)},
+	}
+
+	conversationRepoMock.On("FindConversationByID", ctx, conversation.ID).Twice().Return(conversation, nil)
+	chatMessageRepoMock.On("FindChatMessages", ctx, conversation.ID, (*repo.ChatMessageCursor)(nil), 3).
+		Once().
+		Return(messages, nil)
+	chatMessageRepoMock.On(
+		"FindChatMessages",
+		ctx,
+		conversation.ID,
+		mock.MatchedBy(
+			func(cursor *repo.ChatMessageCursor) bool {
+				return cursor != nil && cursor.ID == messages[1].ID && cursor.CreatedAt.Equal(messages[1].CreatedAt)
+			},
+		),
+		3,
+	).Once().Return(messages[2:], nil)
+
+	// Act
+	firstPage, err := svc.GetMessages(ctx, conversation.ClientID, conversation.ID, v0.FindChatMessagesInput{Limit: 2})
+	t.Require().NoError(err)
+	t.Require().NotNil(firstPage.NextCursor)
+
+	secondPage, err := svc.GetMessages(
+		ctx,
+		conversation.ClientID,
+		conversation.ID,
+		v0.FindChatMessagesInput{Cursor: *firstPage.NextCursor, Limit: 2},
+	)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Len(firstPage.Data, 2)
+	t.Require().Equal([]string{"photo.png"}, firstPage.Data[1].Attachments)
+	t.Require().Len(secondPage.Data, 1)
+	t.Require().Equal(messages[2].ID.String(), secondPage.Data[0].ID)
+	t.Require().Nil(secondPage.NextCursor)
+}
+
+func (s *GetMessagesSuite) Test_InvalidCursor(t provider.T) {
+	t.Title("GetMessages returns InvalidCursor error for malformed cursor")
+	t.Severity(allure.NORMAL)
+	t.Epic("Chat service")
+	t.Feature("GetMessages")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+
+	// Act
+	_, err := svc.GetMessages(ctx, uuid.New(), uuid.New(), v0.FindChatMessagesInput{Cursor: "not-a-cursor", Limit: 20})
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrInvalidCursor)
+}
+
+func (s *GetMessagesSuite) Test_ConversationNotFound(t provider.T) {
+	t.Title("GetMessages returns ConversationNotFound error for unknown conversation")
+	t.Severity(allure.NORMAL)
+	t.Epic("Chat service")
+	t.Feature("GetMessages")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	conversationID := uuid.New()
+
+	conversationRepoMock.On("FindConversationByID", ctx, conversationID).Once().Return(nil, nil)
+
+	// Act
+	_, err := svc.GetMessages(ctx, uuid.New(), conversationID, v0.FindChatMessagesInput{Limit: 20})
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrConversationNotFound)
+}
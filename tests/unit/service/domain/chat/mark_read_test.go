@@ -0,0 +1,87 @@
+package chat
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/samber/lo"
+	"time"
+)
+
+type MarkReadSuite struct {
+	suite.Suite
+}
+
+func (s *MarkReadSuite) Test_UntilMessage(t provider.T) {
+	t.Title("MarkRead moves read marker to creation time of given message")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Chat service")
+	t.Feature("MarkRead")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	conversation := newConversation()
+	message := &entity.ChatMessage{
+		ID:             uuid.New(),
+		ConversationID: conversation.ID,
+		SenderID:       conversation.ClientID,
+		CreatedAt:      time.Now().Add(-time.Minute),
+	}
+	input := v0.MarkChatReadInput{MessageID: lo.ToPtr(message.ID.String())}
+
+	conversationRepoMock.On("FindConversationByID", ctx, conversation.ID).Twice().Return(conversation, nil)
+	chatMessageRepoMock.On("FindChatMessageByID", ctx, conversation.ID, message.ID).Once().Return(message, nil)
+	conversationRepoMock.On(
+		"UpdateConversationReadAt",
+		ctx,
+		conversation.ID,
+		conversation.MasterID,
+		message.CreatedAt,
+	).Once().Return(nil)
+	chatMessageRepoMock.On("CountUnreadChatMessages", ctx, conversation, conversation.MasterID).
+		Once().
+		Return(int64(0), nil)
+
+	// Act
+	err := svc.MarkRead(ctx, conversation.MasterID, conversation.ID, input)
+
+	// Assert
+	t.Require().NoError(err)
+	conversationRepoMock.AssertCalled(
+		t,
+		"UpdateConversationReadAt",
+		ctx,
+		conversation.ID,
+		conversation.MasterID,
+		message.CreatedAt,
+	)
+}
+
+func (s *MarkReadSuite) Test_MessageNotFound(t provider.T) {
+	t.Title("MarkRead returns ChatMessageNotFound error for message of another conversation")
+	t.Severity(allure.NORMAL)
+	t.Epic("Chat service")
+	t.Feature("MarkRead")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	conversation := newConversation()
+	messageID := uuid.New()
+	input := v0.MarkChatReadInput{MessageID: lo.ToPtr(messageID.String())}
+
+	conversationRepoMock.On("FindConversationByID", ctx, conversation.ID).Once().Return(conversation, nil)
+	chatMessageRepoMock.On("FindChatMessageByID", ctx, conversation.ID, messageID).Once().Return(nil, nil)
+
+	// Act
+	err := svc.MarkRead(ctx, conversation.ClientID, conversation.ID, input)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrChatMessageNotFound)
+}
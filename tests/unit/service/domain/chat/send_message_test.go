@@ -0,0 +1,170 @@
+package chat
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type SendMessageSuite struct {
+	suite.Suite
+}
+
+func (s *SendMessageSuite) Test_OfflineRecipient(t provider.T) {
+	t.Title("SendMessage stores message with attachments and notifies offline recipient by email")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Chat service")
+	t.Feature("SendMessage")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	conversation := newConversation()
+	attachment := "chat/" + conversation.ID.String() + "/" + conversation.ClientID.String() + "/hash-photo.png"
+	input := v0.SendChatMessageInput{Text: "Hello", Attachments: []string{attachment}}
+
+	conversationRepoMock.On("FindConversationByID", ctx, conversation.ID).Once().Return(conversation, nil)
+	chatMessageRepoMock.On("CountUnreadChatMessages", ctx, conversation, conversation.MasterID).
+		Once().
+		Return(int64(0), nil)
+	chatMessageRepoMock.On(
+		"CreateChatMessage",
+		ctx,
+		mock.MatchedBy(
+			func(message *entity.ChatMessage) bool {
+				return message.ConversationID == conversation.ID &&
+					message.SenderID == conversation.ClientID &&
+					message.Text == input.Text &&
+					len(message.Attachments) == 1 &&
+					message.Attachments[0].ObjectID == attachment
+			},
+		),
+	).Once().Return(
+		func(_ context.Context, message *entity.ChatMessage) (*entity.ChatMessage, error) {
+			message.ID = uuid.New()
+			message.CreatedAt = time.Now()
+			return message, nil
+		},
+	)
+	templateEngineMock.On(
+		"RenderHTML",
+		"chat-message",
+		v0.ChatMessageTemplateArgs{Email: "master@example.com", Username: "client", Text: input.Text},
+	).Once().Return("email content", nil)
+	smtpSenderMock.On("SendHTMLMessage", mock.Anything, "email content", mock.Anything, "master@example.com").
+		Once().
+		Return(nil)
+
+	// Act
+	res, err := svc.SendMessage(ctx, conversation.ClientID, conversation.ID, input)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(conversation.ClientID.String(), res.SenderID)
+	t.Require().Equal([]string{attachment}, res.Attachments)
+	smtpSenderMock.AssertCalled(t, "SendHTMLMessage", mock.Anything, "email content", mock.Anything, "master@example.com")
+}
+
+func (s *SendMessageSuite) Test_AlreadyUnread(t provider.T) {
+	t.Title("SendMessage does not send email again, if recipient has unread messages")
+	t.Severity(allure.NORMAL)
+	t.Epic("Chat service")
+	t.Feature("SendMessage")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	conversation := newConversation()
+	conversation.Client.Email = "client-unread@example.com"
+	input := v0.SendChatMessageInput{Text: "Are you there?"}
+
+	conversationRepoMock.On("FindConversationByID", ctx, conversation.ID).Once().Return(conversation, nil)
+	chatMessageRepoMock.On("CountUnreadChatMessages", ctx, conversation, conversation.ClientID).
+		Once().
+		Return(int64(1), nil)
+	chatMessageRepoMock.On(
+		"CreateChatMessage",
+		ctx,
+		mock.MatchedBy(
+			func(message *entity.ChatMessage) bool {
+				return message.ConversationID == conversation.ID && message.SenderID == conversation.MasterID
+			},
+		),
+	).Once().Return(
+		func(_ context.Context, message *entity.ChatMessage) (*entity.ChatMessage, error) {
+			message.ID = uuid.New()
+			return message, nil
+		},
+	)
+
+	// Act
+	_, err := svc.SendMessage(ctx, conversation.MasterID, conversation.ID, input)
+
+	// Assert
+	t.Require().NoError(err)
+	smtpSenderMock.AssertNotCalled(t, "SendHTMLMessage", mock.Anything, mock.Anything, mock.Anything, conversation.Client.Email)
+}
+
+func (s *SendMessageSuite) Test_ConversationNotFound(t provider.T) {
+	t.Title("SendMessage returns ConversationNotFound error for non participant")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Chat service")
+	t.Feature("SendMessage")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	conversation := newConversation()
+
+	conversationRepoMock.On("FindConversationByID", ctx, conversation.ID).Once().Return(conversation, nil)
+
+	// Act
+	_, err := svc.SendMessage(ctx, uuid.New(), conversation.ID, v0.SendChatMessageInput{Text: "Hello"})
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrConversationNotFound)
+}
+
+func (s *SendMessageSuite) Test_InvalidAttachment(t provider.T) {
+	t.Title("SendMessage returns InvalidAttachment error for attachment not uploaded by sender to conversation")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Chat service")
+	t.Feature("SendMessage")
+	t.Tags("Negative")
+
+	conversation := newConversation()
+
+	testCases := []struct {
+		name       string
+		attachment string
+	}{
+		{name: "Public resource", attachment: "hash-photo.png"},
+		{name: "Another conversation", attachment: "chat/" + uuid.New().String() + "/" + conversation.ClientID.String() + "/hash-photo.png"},
+		{name: "Another sender", attachment: "chat/" + conversation.ID.String() + "/" + conversation.MasterID.String() + "/hash-photo.png"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t provider.T) {
+				// Arrange
+				ctx := context.Background()
+				input := v0.SendChatMessageInput{Text: "Hello", Attachments: []string{tc.attachment}}
+
+				conversationRepoMock.On("FindConversationByID", ctx, conversation.ID).Once().Return(conversation, nil)
+
+				// Act
+				_, err := svc.SendMessage(ctx, conversation.ClientID, conversation.ID, input)
+
+				// Assert
+				t.Require().ErrorIs(err, domain.ErrInvalidAttachment)
+			},
+		)
+	}
+}
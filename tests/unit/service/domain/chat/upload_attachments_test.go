@@ -0,0 +1,67 @@
+package chat
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"mime/multipart"
+)
+
+type UploadAttachmentsSuite struct {
+	suite.Suite
+}
+
+func (s *UploadAttachmentsSuite) Test_Success(t provider.T) {
+	t.Title("UploadAttachments uploads files through resource service for participant")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Chat service")
+	t.Feature("UploadAttachments")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	conversation := newConversation()
+	input := &v0.UploadResourcesInput{}
+	output := v0.UploadResourcesOutput{
+		Count: 1,
+		Data:  map[string]v0.UploadResourceOutput{"photo.png": {ObjectID: "photo-object"}},
+	}
+
+	conversationRepoMock.On("FindConversationByID", ctx, conversation.ID).Once().Return(conversation, nil)
+	folder := "chat/" + conversation.ID.String() + "/" + conversation.ClientID.String()
+	resourceServiceMock.On("UploadResourcesToFolder", ctx, folder, input).Once().Return(output, nil)
+
+	// Act
+	res, err := svc.UploadAttachments(ctx, conversation.ClientID, conversation.ID, input)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(output, res)
+}
+
+func (s *UploadAttachmentsSuite) Test_ConversationNotFound(t provider.T) {
+	t.Title("UploadAttachments returns ConversationNotFound error for non participant")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Chat service")
+	t.Feature("UploadAttachments")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	conversation := newConversation()
+	input := &v0.UploadResourcesInput{Resources: []*multipart.FileHeader{{Filename: "foreign.png"}}}
+
+	conversationRepoMock.On("FindConversationByID", ctx, conversation.ID).Once().Return(conversation, nil)
+
+	// Act
+	_, err := svc.UploadAttachments(ctx, uuid.New(), conversation.ID, input)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrConversationNotFound)
+	resourceServiceMock.AssertNotCalled(t, "UploadResourcesToFolder", ctx, mock.Anything, input)
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/mandarine-io/backend/internal/persistence/repo/mock"
 	"github.com/mandarine-io/backend/internal/service/domain"
 	masterprofile "github.com/mandarine-io/backend/internal/service/domain/master/profile"
+	mock1 "github.com/mandarine-io/backend/internal/service/infrastructure/mock"
 	"testing"
 
 	"github.com/ozontech/allure-go/pkg/framework/provider"
@@ -15,12 +16,16 @@ var (
 	ctx = context.Background()
 
 	masterProfileRepoMock *mock.MasterProfileRepositoryMock
+	transactorMock        *mock.TransactorMock
+	outboxServiceMock     *mock1.OutboxServiceMock
 	svc                   domain.MasterProfileService
 )
 
 func init() {
 	masterProfileRepoMock = new(mock.MasterProfileRepositoryMock)
-	svc = masterprofile.NewService(masterProfileRepoMock)
+	transactorMock = new(mock.TransactorMock)
+	outboxServiceMock = new(mock1.OutboxServiceMock)
+	svc = masterprofile.NewService(masterProfileRepoMock, transactorMock, outboxServiceMock)
 }
 
 type MasterProfileServiceSuite struct {
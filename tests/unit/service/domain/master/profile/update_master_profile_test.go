@@ -1,6 +1,7 @@
 package masterprofile
 
 import (
+	"context"
 	"errors"
 	"github.com/google/uuid"
 	"github.com/mandarine-io/backend/internal/persistence/entity"
@@ -31,6 +32,14 @@ var (
 	}
 )
 
+func expectTransaction() {
+	transactorMock.On("Transaction", ctx, mock.Anything).Return(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	).Once()
+}
+
 type UpdateMasterProfileSuite struct {
 	suite.Suite
 }
@@ -74,10 +83,18 @@ func (s *UpdateMasterProfileSuite) Test_Success(t provider.T) {
 	}
 
 	masterProfileRepoMock.On("FindMasterProfileByUserID", ctx, userID).Return(e, nil).Once()
+	expectTransaction()
 	masterProfileRepoMock.On("UpdateMasterProfile", ctx, updateMasterProfileMatcherFactory(input)).Return(
 		updatedEntity,
 		nil,
 	).Once()
+	outboxServiceMock.On(
+		"Add", ctx, mock.MatchedBy(
+			func(e domain.MasterProfileUpdatedEvent) bool {
+				return e.UserID == userID && e.DisplayName == updatedEntity.DisplayName
+			},
+		),
+	).Return(nil).Once()
 
 	resp, err := svc.UpdateMasterProfile(ctx, userID, input)
 
@@ -141,6 +158,7 @@ func (s *UpdateMasterProfileSuite) Test_ErrUpdateMasterProfile(t provider.T) {
 	userID := uuid.New()
 	expectedErr := errors.New("failed to update master profile")
 	masterProfileRepoMock.On("FindMasterProfileByUserID", ctx, userID).Return(&entity.MasterProfile{}, nil).Once()
+	expectTransaction()
 	masterProfileRepoMock.On("UpdateMasterProfile", ctx, mock.Anything).Return(nil, expectedErr).Once()
 
 	_, err := svc.UpdateMasterProfile(ctx, userID, v0.UpdateMasterProfileInput{})
@@ -148,3 +166,26 @@ func (s *UpdateMasterProfileSuite) Test_ErrUpdateMasterProfile(t provider.T) {
 	t.Require().Error(err)
 	t.Require().Equal(expectedErr, err)
 }
+
+func (s *UpdateMasterProfileSuite) Test_ErrAddEvent(t provider.T) {
+	t.Title("Returns adding event to outbox error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Master profile service")
+	t.Feature("UpdateMasterProfile")
+	t.Tags("Negative")
+
+	userID := uuid.New()
+	expectedErr := errors.New("failed to add event")
+	masterProfileRepoMock.On("FindMasterProfileByUserID", ctx, userID).Return(&entity.MasterProfile{}, nil).Once()
+	expectTransaction()
+	masterProfileRepoMock.On("UpdateMasterProfile", ctx, mock.Anything).Return(
+		&entity.MasterProfile{UserID: userID},
+		nil,
+	).Once()
+	outboxServiceMock.On("Add", ctx, mock.Anything).Return(expectedErr).Once()
+
+	_, err := svc.UpdateMasterProfile(ctx, userID, v0.UpdateMasterProfileInput{})
+
+	t.Require().Error(err)
+	t.Require().Equal(expectedErr, err)
+}
@@ -44,3 +44,45 @@ func (s *DownloadResourceSuite) Test_Success(t provider.T) {
 	t.Require().NotNil(output)
 	t.Require().Equal(&s3.FileData{}, output)
 }
+
+func (s *DownloadResourceSuite) Test_NotFoundInFolder(t provider.T) {
+	t.Title("Returns NotFound for private resource in folder")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Resource service")
+	t.Feature("Resource")
+	t.Tags("Negative")
+
+	output, err := svc.DownloadResource(ctx, "private/test")
+
+	t.Require().ErrorIs(err, s3.ErrObjectNotFound)
+	t.Require().Nil(output)
+	s3ManagerMock.AssertNotCalled(t, "GetOne", ctx, "private/test")
+}
+
+func (s *DownloadResourceSuite) Test_SuccessFromFolder(t provider.T) {
+	t.Title("Returns Success for resource in folder")
+	t.Severity(allure.NORMAL)
+	t.Epic("Resource service")
+	t.Feature("Resource")
+	t.Tags("Positive")
+
+	s3ManagerMock.On("GetOne", ctx, "folder/test").Return(s3.GetResult{Data: &s3.FileData{}, Error: nil}).Once()
+
+	output, err := svc.DownloadResourceFromFolder(ctx, "folder", "folder/test")
+
+	t.Require().NoError(err)
+	t.Require().Equal(&s3.FileData{}, output)
+}
+
+func (s *DownloadResourceSuite) Test_NotFoundFromAnotherFolder(t provider.T) {
+	t.Title("Returns NotFound for resource outside of folder")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Resource service")
+	t.Feature("Resource")
+	t.Tags("Negative")
+
+	output, err := svc.DownloadResourceFromFolder(ctx, "folder", "another/test")
+
+	t.Require().ErrorIs(err, s3.ErrObjectNotFound)
+	t.Require().Nil(output)
+}
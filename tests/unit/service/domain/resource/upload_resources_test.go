@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"mime/multipart"
 	"os"
+	"strings"
 )
 
 type UploadResourcesSuite struct {
@@ -122,3 +123,37 @@ func (s *UploadResourcesSuite) Test_ErrUploadFiles(t provider.T) {
 		},
 	)
 }
+
+func (s *UploadResourcesSuite) Test_SuccessToFolder(t provider.T) {
+	t.Title("Returns Success for upload to folder")
+	t.Severity(allure.NORMAL)
+	t.Epic("Resource service")
+	t.Feature("Resource")
+	t.Tags("Positive")
+
+	file, err := os.CreateTemp("", "test-")
+	t.Require().Nil(err)
+	defer func() {
+		err = os.Remove(file.Name())
+		t.Require().Nil(err)
+	}()
+
+	fileHeader := createMultipartFileHeader(file.Name())
+	t.Require().NotNil(fileHeader)
+
+	s3ManagerMock.On(
+		"CreateMany", ctx, mock.MatchedBy(
+			func(fileDatas []*s3.FileData) bool {
+				return len(fileDatas) == 1 && strings.HasPrefix(fileDatas[0].ID, "folder/")
+			},
+		),
+	).Return(map[string]s3.CreateResult{fileHeader.Filename: {ObjectID: "folder/test"}}).Once()
+
+	output, err := svc.UploadResourcesToFolder(
+		ctx, "folder", &v0.UploadResourcesInput{Resources: []*multipart.FileHeader{fileHeader}},
+	)
+
+	t.Require().NoError(err)
+	t.Require().Equal(1, output.Count)
+	t.Require().Equal("folder/test", output.Data[fileHeader.Filename].ObjectID)
+}
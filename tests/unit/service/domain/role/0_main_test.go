@@ -0,0 +1,36 @@
+package role
+
+import (
+	"context"
+	mock1 "github.com/mandarine-io/backend/internal/persistence/repo/mock"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/domain/role"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"testing"
+)
+
+var (
+	ctx = context.Background()
+
+	roleRepoMock *mock1.RoleRepositoryMock
+	svc          domain.RoleService
+)
+
+func init() {
+	roleRepoMock = new(mock1.RoleRepositoryMock)
+	svc = role.NewService(roleRepoMock)
+}
+
+type RoleServiceSuite struct {
+	suite.Suite
+}
+
+func TestRoleServiceSuite(t *testing.T) {
+	suite.RunSuite(t, new(RoleServiceSuite))
+}
+
+func (s *RoleServiceSuite) Test(t provider.T) {
+	s.RunSuite(t, new(GetRolesSuite))
+	s.RunSuite(t, new(UpdateRoleSuite))
+}
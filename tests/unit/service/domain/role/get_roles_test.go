@@ -0,0 +1,59 @@
+package role
+
+import (
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/pkg/errors"
+)
+
+type GetRolesSuite struct {
+	suite.Suite
+}
+
+func (s *GetRolesSuite) Test_Success(t provider.T) {
+	t.Title("GetRoles returns all roles")
+	t.Severity(allure.NORMAL)
+	t.Epic("Role service")
+	t.Feature("GetRoles")
+	t.Tags("Positive")
+
+	roleEntities := []*entity.RoleEntity{
+		{ID: 1, Name: "admin", IsMFARequired: true},
+		{ID: 2, Name: "user"},
+	}
+
+	roleRepoMock.On("FindRoles", ctx).Once().Return(roleEntities, nil)
+
+	res, err := svc.GetRoles(ctx)
+
+	t.Require().NoError(err)
+	t.Require().Equal(
+		v0.RolesOutput{
+			Count: 2,
+			Data: []v0.RoleOutput{
+				{Name: "admin", IsMFARequired: true},
+				{Name: "user"},
+			},
+		},
+		res,
+	)
+}
+
+func (s *GetRolesSuite) Test_ErrorFindingRoles(t provider.T) {
+	t.Title("GetRoles returns error when finding roles fails")
+	t.Severity(allure.NORMAL)
+	t.Epic("Role service")
+	t.Feature("GetRoles")
+	t.Tags("Negative")
+
+	expectedErr := errors.New("database error")
+
+	roleRepoMock.On("FindRoles", ctx).Once().Return(nil, expectedErr)
+
+	_, err := svc.GetRoles(ctx)
+
+	t.Require().ErrorIs(err, expectedErr)
+}
@@ -0,0 +1,66 @@
+package role
+
+import (
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+type UpdateRoleSuite struct {
+	suite.Suite
+}
+
+func (s *UpdateRoleSuite) Test_Success(t provider.T) {
+	t.Title("UpdateRole enables MFA requirement")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Role service")
+	t.Feature("UpdateRole")
+	t.Tags("Positive")
+
+	roleEntity := &entity.RoleEntity{ID: 1, Name: "admin"}
+
+	roleRepoMock.On("FindRoleByName", ctx, "admin").Once().Return(roleEntity, nil)
+	roleRepoMock.On("UpdateRole", ctx, roleEntity).Once().Return(roleEntity, nil)
+
+	res, err := svc.UpdateRole(ctx, "admin", v0.UpdateRoleInput{IsMFARequired: lo.ToPtr(true)})
+
+	t.Require().NoError(err)
+	t.Require().Equal(v0.RoleOutput{Name: "admin", IsMFARequired: true}, res)
+}
+
+func (s *UpdateRoleSuite) Test_ErrRoleNotFound(t provider.T) {
+	t.Title("UpdateRole returns RoleNotFound error")
+	t.Severity(allure.NORMAL)
+	t.Epic("Role service")
+	t.Feature("UpdateRole")
+	t.Tags("Negative")
+
+	roleRepoMock.On("FindRoleByName", ctx, "unknown").Once().Return(nil, nil)
+
+	_, err := svc.UpdateRole(ctx, "unknown", v0.UpdateRoleInput{IsMFARequired: lo.ToPtr(true)})
+
+	t.Require().ErrorIs(err, domain.ErrRoleNotFound)
+}
+
+func (s *UpdateRoleSuite) Test_ErrorUpdatingRole(t provider.T) {
+	t.Title("UpdateRole returns error when updating role fails")
+	t.Severity(allure.NORMAL)
+	t.Epic("Role service")
+	t.Feature("UpdateRole")
+	t.Tags("Negative")
+
+	roleEntity := &entity.RoleEntity{ID: 2, Name: "user"}
+	expectedErr := errors.New("database error")
+
+	roleRepoMock.On("FindRoleByName", ctx, "user").Once().Return(roleEntity, nil)
+	roleRepoMock.On("UpdateRole", ctx, roleEntity).Once().Return(nil, expectedErr)
+
+	_, err := svc.UpdateRole(ctx, "user", v0.UpdateRoleInput{IsMFARequired: lo.ToPtr(false)})
+
+	t.Require().ErrorIs(err, expectedErr)
+}
@@ -0,0 +1,56 @@
+package serviceaccount
+
+import (
+	"github.com/mandarine-io/backend/config"
+	mock2 "github.com/mandarine-io/backend/internal/persistence/repo/mock"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/domain/serviceaccount"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/mock"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"testing"
+)
+
+const (
+	rotationGracePeriod = 3600
+)
+
+var (
+	serviceAccountRepoMock *mock2.ServiceAccountRepositoryMock
+	apiKeyRepoMock         *mock2.APIKeyRepositoryMock
+	apiKeyServiceMock      *mock.APIKeyServiceMock
+	svc                    domain.ServiceAccountService
+)
+
+func init() {
+	serviceAccountRepoMock = &mock2.ServiceAccountRepositoryMock{}
+	apiKeyRepoMock = &mock2.APIKeyRepositoryMock{}
+	apiKeyServiceMock = &mock.APIKeyServiceMock{}
+	svc = serviceaccount.NewService(
+		serviceAccountRepoMock,
+		apiKeyRepoMock,
+		apiKeyServiceMock,
+		config.APIKeyConfig{
+			Prefix:              "mnd",
+			RotationGracePeriod: rotationGracePeriod,
+		},
+	)
+}
+
+type ServiceAccountServiceSuite struct {
+	suite.Suite
+}
+
+func TestServiceAccountServiceSuite(t *testing.T) {
+	suite.RunSuite(t, new(ServiceAccountServiceSuite))
+}
+
+func (s *ServiceAccountServiceSuite) Test(t provider.T) {
+	s.RunSuite(t, new(CreateAPIKeySuite))
+	s.RunSuite(t, new(CreateServiceAccountSuite))
+	s.RunSuite(t, new(DeleteServiceAccountSuite))
+	s.RunSuite(t, new(GetAPIKeysSuite))
+	s.RunSuite(t, new(GetServiceAccountsSuite))
+	s.RunSuite(t, new(RevokeAPIKeySuite))
+	s.RunSuite(t, new(RotateAPIKeySuite))
+}
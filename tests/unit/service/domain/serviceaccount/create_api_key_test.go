@@ -0,0 +1,97 @@
+package serviceaccount
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type CreateAPIKeySuite struct {
+	suite.Suite
+}
+
+func (s *CreateAPIKeySuite) Test_Success(t provider.T) {
+	t.Title("CreateAPIKey stores hash of generated key and returns key only in response")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Service account service")
+	t.Feature("CreateAPIKey")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	serviceAccountID := uuid.New()
+	input := v0.CreateAPIKeyInput{
+		Name:      "production",
+		Scopes:    []string{"services:read", "services:write"},
+		ExpiresIn: lo.ToPtr(int64(3600)),
+	}
+	generatedKey := infrastructure.GeneratedAPIKey{
+		Key:    "mnd_0a1b2c3d_secret",
+		Prefix: "mnd_0a1b2c3d",
+		Hash:   "hash-create",
+	}
+
+	serviceAccountRepoMock.On("FindServiceAccountByID", ctx, userID, serviceAccountID).
+		Once().
+		Return(&entity.ServiceAccount{ID: serviceAccountID, OwnerID: userID}, nil)
+	apiKeyServiceMock.On("GenerateKey", ctx).Once().Return(generatedKey, nil)
+	apiKeyRepoMock.On(
+		"CreateAPIKey",
+		ctx,
+		mock.MatchedBy(
+			func(apiKey *entity.APIKey) bool {
+				return apiKey.KeyHash == "hash-create" &&
+					apiKey.Prefix == generatedKey.Prefix &&
+					apiKey.Scopes == "services:read services:write" &&
+					apiKey.ExpiresAt != nil &&
+					time.Until(*apiKey.ExpiresAt) > 59*time.Minute
+			},
+		),
+	).Once().Return(
+		func(_ context.Context, apiKey *entity.APIKey) (*entity.APIKey, error) {
+			apiKey.ID = uuid.New()
+			return apiKey, nil
+		},
+	)
+
+	// Act
+	res, err := svc.CreateAPIKey(ctx, userID, serviceAccountID, input)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(generatedKey.Key, res.Key)
+	t.Require().Equal(generatedKey.Prefix, res.APIKey.Prefix)
+	t.Require().Equal(input.Scopes, res.APIKey.Scopes)
+}
+
+func (s *CreateAPIKeySuite) Test_ServiceAccountNotFound(t provider.T) {
+	t.Title("CreateAPIKey returns ServiceAccountNotFound error for foreign service account")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Service account service")
+	t.Feature("CreateAPIKey")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	serviceAccountID := uuid.New()
+	input := v0.CreateAPIKeyInput{Name: "foreign", Scopes: []string{"services:read"}}
+
+	serviceAccountRepoMock.On("FindServiceAccountByID", ctx, userID, serviceAccountID).Once().Return(nil, nil)
+
+	// Act
+	_, err := svc.CreateAPIKey(ctx, userID, serviceAccountID, input)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrServiceAccountNotFound)
+}
@@ -0,0 +1,83 @@
+package serviceaccount
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+type CreateServiceAccountSuite struct {
+	suite.Suite
+}
+
+func (s *CreateServiceAccountSuite) Test_Success(t provider.T) {
+	t.Title("CreateServiceAccount creates service account owned by user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Service account service")
+	t.Feature("CreateServiceAccount")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	input := v0.CreateServiceAccountInput{Name: "crm"}
+
+	serviceAccountRepoMock.On(
+		"CreateServiceAccount",
+		ctx,
+		mock.MatchedBy(
+			func(serviceAccount *entity.ServiceAccount) bool {
+				return serviceAccount.OwnerID == userID && serviceAccount.Name == "crm"
+			},
+		),
+	).Once().Return(
+		func(_ context.Context, serviceAccount *entity.ServiceAccount) (*entity.ServiceAccount, error) {
+			serviceAccount.ID = uuid.New()
+			return serviceAccount, nil
+		},
+	)
+
+	// Act
+	res, err := svc.CreateServiceAccount(ctx, userID, input)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal("crm", res.Name)
+	t.Require().NotEmpty(res.ID)
+}
+
+func (s *CreateServiceAccountSuite) Test_Duplicate(t provider.T) {
+	t.Title("CreateServiceAccount returns DuplicateServiceAccount error for already used name")
+	t.Severity(allure.NORMAL)
+	t.Epic("Service account service")
+	t.Feature("CreateServiceAccount")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	input := v0.CreateServiceAccountInput{Name: "duplicate"}
+
+	serviceAccountRepoMock.On(
+		"CreateServiceAccount",
+		ctx,
+		mock.MatchedBy(
+			func(serviceAccount *entity.ServiceAccount) bool {
+				return serviceAccount.OwnerID == userID
+			},
+		),
+	).Once().Return(nil, repo.ErrDuplicateServiceAccount)
+
+	// Act
+	_, err := svc.CreateServiceAccount(ctx, userID, input)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrDuplicateServiceAccount)
+}
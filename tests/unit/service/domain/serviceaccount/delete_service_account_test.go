@@ -0,0 +1,56 @@
+package serviceaccount
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type DeleteServiceAccountSuite struct {
+	suite.Suite
+}
+
+func (s *DeleteServiceAccountSuite) Test_Success(t provider.T) {
+	t.Title("DeleteServiceAccount removes service account of the user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Service account service")
+	t.Feature("DeleteServiceAccount")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	serviceAccountID := uuid.New()
+
+	serviceAccountRepoMock.On("DeleteServiceAccount", ctx, userID, serviceAccountID).Once().Return(true, nil)
+
+	// Act
+	err := svc.DeleteServiceAccount(ctx, userID, serviceAccountID)
+
+	// Assert
+	t.Require().NoError(err)
+}
+
+func (s *DeleteServiceAccountSuite) Test_NotFound(t provider.T) {
+	t.Title("DeleteServiceAccount returns ServiceAccountNotFound error for foreign or missing service account")
+	t.Severity(allure.NORMAL)
+	t.Epic("Service account service")
+	t.Feature("DeleteServiceAccount")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	serviceAccountID := uuid.New()
+
+	serviceAccountRepoMock.On("DeleteServiceAccount", ctx, userID, serviceAccountID).Once().Return(false, nil)
+
+	// Act
+	err := svc.DeleteServiceAccount(ctx, userID, serviceAccountID)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrServiceAccountNotFound)
+}
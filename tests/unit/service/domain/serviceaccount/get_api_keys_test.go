@@ -0,0 +1,72 @@
+package serviceaccount
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type GetAPIKeysSuite struct {
+	suite.Suite
+}
+
+func (s *GetAPIKeysSuite) Test_Success(t provider.T) {
+	t.Title("GetAPIKeys returns API keys of service account with usage")
+	t.Severity(allure.NORMAL)
+	t.Epic("Service account service")
+	t.Feature("GetAPIKeys")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	serviceAccountID := uuid.New()
+	apiKeyEntity := &entity.APIKey{
+		ID:               uuid.New(),
+		ServiceAccountID: serviceAccountID,
+		Prefix:           "mnd_11223344",
+		Scopes:           "bookings:read",
+		UsageCount:       42,
+	}
+
+	serviceAccountRepoMock.On("FindServiceAccountByID", ctx, userID, serviceAccountID).
+		Once().
+		Return(&entity.ServiceAccount{ID: serviceAccountID, OwnerID: userID}, nil)
+	apiKeyRepoMock.On("FindAPIKeysByServiceAccountID", ctx, serviceAccountID).
+		Once().
+		Return([]*entity.APIKey{apiKeyEntity}, nil)
+
+	// Act
+	res, err := svc.GetAPIKeys(ctx, userID, serviceAccountID)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(1, res.Count)
+	t.Require().Equal([]string{"bookings:read"}, res.Data[0].Scopes)
+	t.Require().Equal(int64(42), res.Data[0].UsageCount)
+}
+
+func (s *GetAPIKeysSuite) Test_ServiceAccountNotFound(t provider.T) {
+	t.Title("GetAPIKeys returns ServiceAccountNotFound error for foreign service account")
+	t.Severity(allure.NORMAL)
+	t.Epic("Service account service")
+	t.Feature("GetAPIKeys")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	serviceAccountID := uuid.New()
+
+	serviceAccountRepoMock.On("FindServiceAccountByID", ctx, userID, serviceAccountID).Once().Return(nil, nil)
+
+	// Act
+	_, err := svc.GetAPIKeys(ctx, userID, serviceAccountID)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrServiceAccountNotFound)
+}
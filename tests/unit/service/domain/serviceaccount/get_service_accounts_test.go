@@ -0,0 +1,39 @@
+package serviceaccount
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type GetServiceAccountsSuite struct {
+	suite.Suite
+}
+
+func (s *GetServiceAccountsSuite) Test_Success(t provider.T) {
+	t.Title("GetServiceAccounts returns service accounts of the user")
+	t.Severity(allure.NORMAL)
+	t.Epic("Service account service")
+	t.Feature("GetServiceAccounts")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	serviceAccountEntity := &entity.ServiceAccount{ID: uuid.New(), OwnerID: userID, Name: "crm"}
+
+	serviceAccountRepoMock.On("FindServiceAccountsByOwnerID", ctx, userID).
+		Once().
+		Return([]*entity.ServiceAccount{serviceAccountEntity}, nil)
+
+	// Act
+	res, err := svc.GetServiceAccounts(ctx, userID)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(1, res.Count)
+	t.Require().Equal(serviceAccountEntity.ID.String(), res.Data[0].ID)
+}
@@ -0,0 +1,65 @@
+package serviceaccount
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type RevokeAPIKeySuite struct {
+	suite.Suite
+}
+
+func (s *RevokeAPIKeySuite) Test_Success(t provider.T) {
+	t.Title("RevokeAPIKey revokes API key of service account")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Service account service")
+	t.Feature("RevokeAPIKey")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	serviceAccountID := uuid.New()
+	apiKeyID := uuid.New()
+
+	serviceAccountRepoMock.On("FindServiceAccountByID", ctx, userID, serviceAccountID).
+		Once().
+		Return(&entity.ServiceAccount{ID: serviceAccountID, OwnerID: userID}, nil)
+	apiKeyRepoMock.On("RevokeAPIKey", ctx, serviceAccountID, apiKeyID).Once().Return(true, nil)
+
+	// Act
+	err := svc.RevokeAPIKey(ctx, userID, serviceAccountID, apiKeyID)
+
+	// Assert
+	t.Require().NoError(err)
+}
+
+func (s *RevokeAPIKeySuite) Test_APIKeyNotFound(t provider.T) {
+	t.Title("RevokeAPIKey returns APIKeyNotFound error for missing or already revoked API key")
+	t.Severity(allure.NORMAL)
+	t.Epic("Service account service")
+	t.Feature("RevokeAPIKey")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	serviceAccountID := uuid.New()
+	apiKeyID := uuid.New()
+
+	serviceAccountRepoMock.On("FindServiceAccountByID", ctx, userID, serviceAccountID).
+		Once().
+		Return(&entity.ServiceAccount{ID: serviceAccountID, OwnerID: userID}, nil)
+	apiKeyRepoMock.On("RevokeAPIKey", ctx, serviceAccountID, apiKeyID).Once().Return(false, nil)
+
+	// Act
+	err := svc.RevokeAPIKey(ctx, userID, serviceAccountID, apiKeyID)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrAPIKeyNotFound)
+}
@@ -0,0 +1,135 @@
+package serviceaccount
+
+import (
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type RotateAPIKeySuite struct {
+	suite.Suite
+}
+
+func (s *RotateAPIKeySuite) Test_Success(t provider.T) {
+	t.Title("RotateAPIKey issues new key with the same scopes and keeps old key during grace period")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Service account service")
+	t.Feature("RotateAPIKey")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	serviceAccountID := uuid.New()
+	oldAPIKeyEntity := &entity.APIKey{
+		ID:               uuid.New(),
+		ServiceAccountID: serviceAccountID,
+		Name:             "production",
+		Prefix:           "mnd_aaaaaaaa",
+		Scopes:           "services:write",
+		CreatedAt:        time.Now().Add(-time.Hour),
+	}
+	generatedKey := infrastructure.GeneratedAPIKey{
+		Key:    "mnd_bbbbbbbb_secret",
+		Prefix: "mnd_bbbbbbbb",
+		Hash:   "hash-rotate",
+	}
+
+	serviceAccountRepoMock.On("FindServiceAccountByID", ctx, userID, serviceAccountID).
+		Once().
+		Return(&entity.ServiceAccount{ID: serviceAccountID, OwnerID: userID}, nil)
+	apiKeyRepoMock.On("FindAPIKeyByID", ctx, serviceAccountID, oldAPIKeyEntity.ID).Once().Return(oldAPIKeyEntity, nil)
+	apiKeyServiceMock.On("GenerateKey", ctx).Once().Return(generatedKey, nil)
+	apiKeyRepoMock.On(
+		"RotateAPIKey",
+		ctx,
+		mock.MatchedBy(
+			func(apiKey *entity.APIKey) bool {
+				return apiKey.ID == oldAPIKeyEntity.ID &&
+					apiKey.ExpiresAt != nil &&
+					time.Until(*apiKey.ExpiresAt) <= rotationGracePeriod*time.Second
+			},
+		),
+		mock.MatchedBy(
+			func(apiKey *entity.APIKey) bool {
+				return apiKey.KeyHash == "hash-rotate" && apiKey.Scopes == "services:write" && apiKey.ExpiresAt == nil
+			},
+		),
+	).Once().Return(
+		func(_ context.Context, _ *entity.APIKey, apiKey *entity.APIKey) (*entity.APIKey, error) {
+			apiKey.ID = uuid.New()
+			return apiKey, nil
+		},
+	)
+
+	// Act
+	res, err := svc.RotateAPIKey(ctx, userID, serviceAccountID, oldAPIKeyEntity.ID)
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(generatedKey.Key, res.Key)
+	t.Require().Equal("production", res.APIKey.Name)
+	t.Require().NotEqual(oldAPIKeyEntity.ID.String(), res.APIKey.ID)
+}
+
+func (s *RotateAPIKeySuite) Test_RevokedAPIKey(t provider.T) {
+	t.Title("RotateAPIKey returns APIKeyNotActive error for revoked API key")
+	t.Severity(allure.NORMAL)
+	t.Epic("Service account service")
+	t.Feature("RotateAPIKey")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	serviceAccountID := uuid.New()
+	apiKeyEntity := &entity.APIKey{
+		ID:               uuid.New(),
+		ServiceAccountID: serviceAccountID,
+		RevokedAt:        lo.ToPtr(time.Now().Add(-time.Minute)),
+	}
+
+	serviceAccountRepoMock.On("FindServiceAccountByID", ctx, userID, serviceAccountID).
+		Once().
+		Return(&entity.ServiceAccount{ID: serviceAccountID, OwnerID: userID}, nil)
+	apiKeyRepoMock.On("FindAPIKeyByID", ctx, serviceAccountID, apiKeyEntity.ID).Once().Return(apiKeyEntity, nil)
+
+	// Act
+	_, err := svc.RotateAPIKey(ctx, userID, serviceAccountID, apiKeyEntity.ID)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrAPIKeyNotActive)
+}
+
+func (s *RotateAPIKeySuite) Test_APIKeyNotFound(t provider.T) {
+	t.Title("RotateAPIKey returns APIKeyNotFound error for missing API key")
+	t.Severity(allure.NORMAL)
+	t.Epic("Service account service")
+	t.Feature("RotateAPIKey")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	userID := uuid.New()
+	serviceAccountID := uuid.New()
+	apiKeyID := uuid.New()
+
+	serviceAccountRepoMock.On("FindServiceAccountByID", ctx, userID, serviceAccountID).
+		Once().
+		Return(&entity.ServiceAccount{ID: serviceAccountID, OwnerID: userID}, nil)
+	apiKeyRepoMock.On("FindAPIKeyByID", ctx, serviceAccountID, apiKeyID).Once().Return(nil, nil)
+
+	// Act
+	_, err := svc.RotateAPIKey(ctx, userID, serviceAccountID, apiKeyID)
+
+	// Assert
+	t.Require().ErrorIs(err, domain.ErrAPIKeyNotFound)
+}
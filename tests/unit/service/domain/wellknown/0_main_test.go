@@ -0,0 +1,35 @@
+package wellknown
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/domain/wellknown"
+	mock1 "github.com/mandarine-io/backend/internal/service/infrastructure/mock"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"testing"
+)
+
+var (
+	ctx = context.Background()
+
+	jwkServiceMock *mock1.JWKServiceMock
+	svc            domain.WellKnownService
+)
+
+func init() {
+	jwkServiceMock = new(mock1.JWKServiceMock)
+	svc = wellknown.NewService(jwkServiceMock)
+}
+
+type WellKnownServiceSuite struct {
+	suite.Suite
+}
+
+func TestWellKnownServiceSuite(t *testing.T) {
+	suite.RunSuite(t, new(WellKnownServiceSuite))
+}
+
+func (s *WellKnownServiceSuite) Test(t provider.T) {
+	s.RunSuite(t, new(GetJWKSSuite))
+}
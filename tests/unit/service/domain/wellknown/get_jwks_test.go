@@ -0,0 +1,96 @@
+package wellknown
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type GetJWKSSuite struct {
+	suite.Suite
+}
+
+func (s *GetJWKSSuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("Well-known service")
+	t.Feature("GetJWKS")
+	t.Tags("Positive")
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	t.Require().NoError(err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	t.Require().NoError(err)
+	ed25519PublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	t.Require().NoError(err)
+
+	keys := []infrastructure.SigningKey{
+		{ID: uuid.New().String(), Algorithm: "RS256", PublicKey: &rsaKey.PublicKey},
+		{ID: uuid.New().String(), Algorithm: "ES256", PublicKey: &ecdsaKey.PublicKey},
+		{ID: uuid.New().String(), Algorithm: "EdDSA", PublicKey: ed25519PublicKey},
+	}
+	jwkServiceMock.On("GetPublicKeys", ctx).Once().Return(keys, nil)
+
+	output, err := svc.GetJWKS(ctx)
+
+	t.Require().NoError(err)
+	t.Require().Len(output.Keys, 3)
+
+	t.Require().Equal(keys[0].ID, output.Keys[0].Kid)
+	t.Require().Equal("RSA", output.Keys[0].Kty)
+	t.Require().Equal("RS256", output.Keys[0].Alg)
+	t.Require().Equal("sig", output.Keys[0].Use)
+	t.Require().Equal("AQAB", output.Keys[0].E)
+	t.Require().NotEmpty(output.Keys[0].N)
+
+	t.Require().Equal(keys[1].ID, output.Keys[1].Kid)
+	t.Require().Equal("EC", output.Keys[1].Kty)
+	t.Require().Equal("P-256", output.Keys[1].Crv)
+	t.Require().Len(output.Keys[1].X, 43)
+	t.Require().Len(output.Keys[1].Y, 43)
+
+	t.Require().Equal(keys[2].ID, output.Keys[2].Kid)
+	t.Require().Equal("OKP", output.Keys[2].Kty)
+	t.Require().Equal("Ed25519", output.Keys[2].Crv)
+	t.Require().Len(output.Keys[2].X, 43)
+}
+
+func (s *GetJWKSSuite) Test_SuccessEmpty(t provider.T) {
+	t.Title("Returns success with empty key set")
+	t.Severity(allure.NORMAL)
+	t.Epic("Well-known service")
+	t.Feature("GetJWKS")
+	t.Tags("Positive")
+
+	jwkServiceMock.On("GetPublicKeys", ctx).Once().Return([]infrastructure.SigningKey{}, nil)
+
+	output, err := svc.GetJWKS(ctx)
+
+	t.Require().NoError(err)
+	t.Require().NotNil(output.Keys)
+	t.Require().Empty(output.Keys)
+}
+
+func (s *GetJWKSSuite) Test_ErrGetPublicKeys(t provider.T) {
+	t.Title("Returns getting public keys error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Well-known service")
+	t.Feature("GetJWKS")
+	t.Tags("Negative")
+
+	dbErr := errors.New("db error")
+	jwkServiceMock.On("GetPublicKeys", ctx).Once().Return(nil, dbErr)
+
+	_, err := svc.GetJWKS(ctx)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, dbErr)
+}
@@ -0,0 +1,36 @@
+package apikey
+
+import (
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/persistence/repo/mock"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/apikey"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"testing"
+)
+
+var (
+	apiKeyRepoMock *mock.APIKeyRepositoryMock
+	userRepoMock   *mock.UserRepositoryMock
+	svc            infrastructure.APIKeyService
+)
+
+func init() {
+	apiKeyRepoMock = &mock.APIKeyRepositoryMock{}
+	userRepoMock = &mock.UserRepositoryMock{}
+	svc = apikey.NewService(apiKeyRepoMock, userRepoMock, config.APIKeyConfig{Prefix: "mnd"})
+}
+
+type APIKeyServiceSuite struct {
+	suite.Suite
+}
+
+func TestAPIKeyServiceSuite(t *testing.T) {
+	suite.RunSuite(t, new(APIKeyServiceSuite))
+}
+
+func (s *APIKeyServiceSuite) Test(t provider.T) {
+	s.RunSuite(t, new(GenerateKeySuite))
+	s.RunSuite(t, new(GetAPIKeyClaimsSuite))
+}
@@ -0,0 +1,52 @@
+package apikey
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"strings"
+)
+
+type GenerateKeySuite struct {
+	suite.Suite
+}
+
+func (s *GenerateKeySuite) Test_Success(t provider.T) {
+	t.Title("GenerateKey returns prefixed key and its SHA-256 hash")
+	t.Severity(allure.CRITICAL)
+	t.Epic("API key service")
+	t.Feature("GenerateKey")
+	t.Tags("Positive")
+
+	// Act
+	generatedKey, err := svc.GenerateKey(context.Background())
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().True(strings.HasPrefix(generatedKey.Prefix, "mnd_"))
+	t.Require().True(strings.HasPrefix(generatedKey.Key, generatedKey.Prefix+"_"))
+
+	hash := sha256.Sum256([]byte(generatedKey.Key))
+	t.Require().Equal(hex.EncodeToString(hash[:]), generatedKey.Hash)
+}
+
+func (s *GenerateKeySuite) Test_Unique(t provider.T) {
+	t.Title("GenerateKey returns different keys on each call")
+	t.Severity(allure.NORMAL)
+	t.Epic("API key service")
+	t.Feature("GenerateKey")
+	t.Tags("Positive")
+
+	// Act
+	firstKey, err := svc.GenerateKey(context.Background())
+	t.Require().NoError(err)
+	secondKey, err := svc.GenerateKey(context.Background())
+	t.Require().NoError(err)
+
+	// Assert
+	t.Require().NotEqual(firstKey.Key, secondKey.Key)
+	t.Require().NotEqual(firstKey.Prefix, secondKey.Prefix)
+}
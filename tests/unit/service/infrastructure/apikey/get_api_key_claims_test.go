@@ -0,0 +1,174 @@
+package apikey
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type GetAPIKeyClaimsSuite struct {
+	suite.Suite
+}
+
+func hashKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+func (s *GetAPIKeyClaimsSuite) Test_Success(t provider.T) {
+	t.Title("GetAPIKeyClaims returns owner of service account with scopes of the key and tracks usage")
+	t.Severity(allure.CRITICAL)
+	t.Epic("API key service")
+	t.Feature("GetAPIKeyClaims")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	key := "mnd_00000001_secret"
+	ownerID := uuid.New()
+	apiKeyEntity := &entity.APIKey{
+		ID:               uuid.New(),
+		ServiceAccountID: uuid.New(),
+		ServiceAccount:   entity.ServiceAccount{OwnerID: ownerID},
+		Scopes:           "services:read services:write",
+		ExpiresAt:        lo.ToPtr(time.Now().Add(time.Hour)),
+	}
+	userEntity := &entity.User{
+		ID:        ownerID,
+		Username:  "owner",
+		Role:      entity.RoleEntity{Name: entity.RoleUser},
+		IsEnabled: true,
+	}
+
+	apiKeyRepoMock.On("FindAPIKeyByHash", ctx, hashKey(key)).Once().Return(apiKeyEntity, nil)
+	userRepoMock.On("WithRolePreload").Once().Return(nil)
+	userRepoMock.On("FindUserByID", ctx, ownerID, mock.Anything).Once().Return(userEntity, nil)
+	apiKeyRepoMock.On("TrackAPIKeyUsage", ctx, apiKeyEntity.ID, "10.0.0.1").Once().Return(nil)
+
+	// Act
+	claims, err := svc.GetAPIKeyClaims(ctx, key, "10.0.0.1")
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(ownerID, claims.UserID)
+	t.Require().Equal("owner", claims.Username)
+	t.Require().Equal(entity.RoleUser, claims.Role)
+	t.Require().Equal(apiKeyEntity.ID, claims.APIKeyID)
+	t.Require().Equal([]string{"services:read", "services:write"}, claims.Scopes)
+}
+
+func (s *GetAPIKeyClaimsSuite) Test_UsageTrackingFailed(t provider.T) {
+	t.Title("GetAPIKeyClaims authenticates service account even if usage is not tracked")
+	t.Severity(allure.NORMAL)
+	t.Epic("API key service")
+	t.Feature("GetAPIKeyClaims")
+	t.Tags("Positive")
+
+	// Arrange
+	ctx := context.Background()
+	key := "mnd_00000002_secret"
+	ownerID := uuid.New()
+	apiKeyEntity := &entity.APIKey{
+		ID:             uuid.New(),
+		ServiceAccount: entity.ServiceAccount{OwnerID: ownerID},
+		Scopes:         "bookings:read",
+	}
+
+	apiKeyRepoMock.On("FindAPIKeyByHash", ctx, hashKey(key)).Once().Return(apiKeyEntity, nil)
+	userRepoMock.On("WithRolePreload").Once().Return(nil)
+	userRepoMock.On("FindUserByID", ctx, ownerID, mock.Anything).Once().Return(&entity.User{ID: ownerID}, nil)
+	apiKeyRepoMock.On("TrackAPIKeyUsage", ctx, apiKeyEntity.ID, "10.0.0.2").Once().Return(errors.New("db error"))
+
+	// Act
+	claims, err := svc.GetAPIKeyClaims(ctx, key, "10.0.0.2")
+
+	// Assert
+	t.Require().NoError(err)
+	t.Require().Equal(ownerID, claims.UserID)
+}
+
+func (s *GetAPIKeyClaimsSuite) Test_UnknownPrefix(t provider.T) {
+	t.Title("GetAPIKeyClaims returns InvalidAPIKey error for key with unknown prefix without database lookup")
+	t.Severity(allure.NORMAL)
+	t.Epic("API key service")
+	t.Feature("GetAPIKeyClaims")
+	t.Tags("Negative")
+
+	// Act
+	_, err := svc.GetAPIKeyClaims(context.Background(), "foreign_key", "10.0.0.3")
+
+	// Assert
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidAPIKey)
+}
+
+func (s *GetAPIKeyClaimsSuite) Test_NotFound(t provider.T) {
+	t.Title("GetAPIKeyClaims returns InvalidAPIKey error for unknown key")
+	t.Severity(allure.CRITICAL)
+	t.Epic("API key service")
+	t.Feature("GetAPIKeyClaims")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	key := "mnd_00000004_secret"
+
+	apiKeyRepoMock.On("FindAPIKeyByHash", ctx, hashKey(key)).Once().Return(nil, nil)
+
+	// Act
+	_, err := svc.GetAPIKeyClaims(ctx, key, "10.0.0.4")
+
+	// Assert
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidAPIKey)
+}
+
+func (s *GetAPIKeyClaimsSuite) Test_Revoked(t provider.T) {
+	t.Title("GetAPIKeyClaims returns RevokedAPIKey error for revoked key")
+	t.Severity(allure.CRITICAL)
+	t.Epic("API key service")
+	t.Feature("GetAPIKeyClaims")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	key := "mnd_00000005_secret"
+	apiKeyEntity := &entity.APIKey{ID: uuid.New(), RevokedAt: lo.ToPtr(time.Now())}
+
+	apiKeyRepoMock.On("FindAPIKeyByHash", ctx, hashKey(key)).Once().Return(apiKeyEntity, nil)
+
+	// Act
+	_, err := svc.GetAPIKeyClaims(ctx, key, "10.0.0.5")
+
+	// Assert
+	t.Require().ErrorIs(err, infrastructure.ErrRevokedAPIKey)
+}
+
+func (s *GetAPIKeyClaimsSuite) Test_Expired(t provider.T) {
+	t.Title("GetAPIKeyClaims returns ExpiredAPIKey error for expired key")
+	t.Severity(allure.CRITICAL)
+	t.Epic("API key service")
+	t.Feature("GetAPIKeyClaims")
+	t.Tags("Negative")
+
+	// Arrange
+	ctx := context.Background()
+	key := "mnd_00000006_secret"
+	apiKeyEntity := &entity.APIKey{ID: uuid.New(), ExpiresAt: lo.ToPtr(time.Now().Add(-time.Second))}
+
+	apiKeyRepoMock.On("FindAPIKeyByHash", ctx, hashKey(key)).Once().Return(apiKeyEntity, nil)
+
+	// Act
+	_, err := svc.GetAPIKeyClaims(ctx, key, "10.0.0.6")
+
+	// Assert
+	t.Require().ErrorIs(err, infrastructure.ErrExpiredAPIKey)
+}
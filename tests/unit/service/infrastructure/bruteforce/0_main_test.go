@@ -0,0 +1,56 @@
+package bruteforce
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache/memory"
+	mock1 "github.com/mandarine-io/backend/internal/infrastructure/cache/mock"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/bruteforce"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"testing"
+)
+
+var (
+	ctx = context.Background()
+
+	managerMock *mock1.ManagerMock
+	cfg         config.BruteForceConfig
+	svc         infrastructure.BruteForceService
+)
+
+func init() {
+	managerMock = new(mock1.ManagerMock)
+	cfg = config.BruteForceConfig{
+		MaxAccountAttempts: 3,
+		MaxIPAttempts:      10,
+		AttemptWindow:      900,
+		BaseLockout:        60,
+		MaxLockout:         300,
+	}
+	svc = bruteforce.NewService(managerMock, cfg)
+}
+
+// newMemoryService creates service with own memory cache, so that counters are not shared between tests
+func newMemoryService() infrastructure.BruteForceService {
+	manager, err := memory.NewManager()
+	if err != nil {
+		panic(err)
+	}
+	return bruteforce.NewService(manager, cfg)
+}
+
+type BruteForceServiceSuite struct {
+	suite.Suite
+}
+
+func TestBruteForceServiceSuite(t *testing.T) {
+	suite.RunSuite(t, new(BruteForceServiceSuite))
+}
+
+func (s *BruteForceServiceSuite) Test(t provider.T) {
+	s.RunSuite(t, new(CheckAttemptSuite))
+	s.RunSuite(t, new(FailAttemptSuite))
+	s.RunSuite(t, new(ResetAttemptsSuite))
+}
@@ -0,0 +1,108 @@
+package bruteforce
+
+import (
+	"errors"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache"
+	infra "github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type CheckAttemptSuite struct {
+	suite.Suite
+}
+
+func (s *CheckAttemptSuite) Test_Success(t provider.T) {
+	t.Title("Returns success, when attempts are not locked")
+	t.Severity(allure.NORMAL)
+	t.Epic("Brute-force service")
+	t.Feature("CheckAttempt")
+	t.Tags("Positive")
+
+	attempt := infra.Attempt{Action: "login", Account: "Test@Example.com ", IP: "127.0.0.1"}
+
+	managerMock.On("Get", ctx, "bruteforce.login.ip.127.0.0.1.lock", mock.Anything).
+		Once().Return(cache.ErrCacheEntryNotFound)
+	managerMock.On("Get", ctx, "bruteforce.login.account.test@example.com.lock", mock.Anything).
+		Once().Return(cache.ErrCacheEntryNotFound)
+
+	err := svc.CheckAttempt(ctx, attempt)
+
+	t.Require().NoError(err)
+}
+
+func (s *CheckAttemptSuite) Test_ErrAccountTemporarilyLocked(t provider.T) {
+	t.Title("Returns account temporarily locked error with retry after")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Brute-force service")
+	t.Feature("CheckAttempt")
+	t.Tags("Negative")
+
+	memorySvc := newMemoryService()
+	attempt := infra.Attempt{Action: "login", Account: "locked@example.com", IP: "127.0.0.1"}
+	for i := 0; i < cfg.MaxAccountAttempts; i++ {
+		_ = memorySvc.FailAttempt(ctx, attempt)
+	}
+
+	// Lock is applied to account on all IP addresses
+	err := memorySvc.CheckAttempt(ctx, infra.Attempt{Action: "login", Account: attempt.Account, IP: "127.0.0.2"})
+
+	t.Require().ErrorIs(err, infra.ErrAccountTemporarilyLocked)
+	var lockoutErr infra.LockoutError
+	t.Require().ErrorAs(err, &lockoutErr)
+	t.Require().Greater(lockoutErr.RetryAfter, time.Duration(0))
+}
+
+func (s *CheckAttemptSuite) Test_ErrTooManyAttempts(t provider.T) {
+	t.Title("Returns too many attempts error, when IP is locked")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Brute-force service")
+	t.Feature("CheckAttempt")
+	t.Tags("Negative")
+
+	memorySvc := newMemoryService()
+	for i := 0; i < cfg.MaxIPAttempts; i++ {
+		_ = memorySvc.FailAttempt(ctx, infra.Attempt{Action: "login", IP: "10.0.0.1"})
+	}
+
+	err := memorySvc.CheckAttempt(ctx, infra.Attempt{Action: "login", Account: "another@example.com", IP: "10.0.0.1"})
+
+	t.Require().ErrorIs(err, infra.ErrTooManyAttempts)
+}
+
+func (s *CheckAttemptSuite) Test_SuccessAnotherAction(t provider.T) {
+	t.Title("Returns success, when another action is locked")
+	t.Severity(allure.NORMAL)
+	t.Epic("Brute-force service")
+	t.Feature("CheckAttempt")
+	t.Tags("Positive")
+
+	memorySvc := newMemoryService()
+	attempt := infra.Attempt{Action: "login", Account: "test@example.com", IP: "127.0.0.1"}
+	for i := 0; i < cfg.MaxAccountAttempts; i++ {
+		_ = memorySvc.FailAttempt(ctx, attempt)
+	}
+
+	err := memorySvc.CheckAttempt(ctx, infra.Attempt{Action: "recovery_password", Account: attempt.Account})
+
+	t.Require().NoError(err)
+}
+
+func (s *CheckAttemptSuite) Test_ErrGettingCache(t provider.T) {
+	t.Title("Returns getting cache error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Brute-force service")
+	t.Feature("CheckAttempt")
+	t.Tags("Negative")
+
+	cacheErr := errors.New("cache error")
+
+	managerMock.On("Get", ctx, "bruteforce.login.ip.127.0.0.3.lock", mock.Anything).Once().Return(cacheErr)
+
+	err := svc.CheckAttempt(ctx, infra.Attempt{Action: "login", Account: "test@example.com", IP: "127.0.0.3"})
+
+	t.Require().ErrorIs(err, cacheErr)
+}
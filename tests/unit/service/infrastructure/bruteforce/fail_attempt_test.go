@@ -0,0 +1,94 @@
+package bruteforce
+
+import (
+	"errors"
+	"fmt"
+	infra "github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"time"
+)
+
+type FailAttemptSuite struct {
+	suite.Suite
+}
+
+func (s *FailAttemptSuite) Test_SuccessUnderLimit(t provider.T) {
+	t.Title("Returns success, when failures are under limit")
+	t.Severity(allure.NORMAL)
+	t.Epic("Brute-force service")
+	t.Feature("FailAttempt")
+	t.Tags("Positive")
+
+	memorySvc := newMemoryService()
+	attempt := infra.Attempt{Action: "login", Account: "test@example.com", IP: "127.0.0.1"}
+
+	for i := 1; i < cfg.MaxAccountAttempts; i++ {
+		err := memorySvc.FailAttempt(ctx, attempt)
+		t.Require().NoError(err)
+	}
+
+	err := memorySvc.CheckAttempt(ctx, attempt)
+	t.Require().NoError(err)
+}
+
+func (s *FailAttemptSuite) Test_ErrAccountTemporarilyLocked(t provider.T) {
+	t.Title("Returns account temporarily locked error with exponential lockout")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Brute-force service")
+	t.Feature("FailAttempt")
+	t.Tags("Negative")
+
+	memorySvc := newMemoryService()
+	attempt := infra.Attempt{Action: "login", Account: "test@example.com", IP: "127.0.0.1"}
+	for i := 1; i < cfg.MaxAccountAttempts; i++ {
+		_ = memorySvc.FailAttempt(ctx, attempt)
+	}
+
+	expectedLockouts := []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute}
+	for _, expectedLockout := range expectedLockouts {
+		err := memorySvc.FailAttempt(ctx, attempt)
+
+		var lockoutErr infra.LockoutError
+		t.Require().ErrorAs(err, &lockoutErr)
+		t.Require().ErrorIs(err, infra.ErrAccountTemporarilyLocked)
+		t.Require().Equal(expectedLockout, lockoutErr.RetryAfter)
+	}
+}
+
+func (s *FailAttemptSuite) Test_ErrTooManyAttempts(t provider.T) {
+	t.Title("Returns too many attempts error, when failures from IP exceed limit")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Brute-force service")
+	t.Feature("FailAttempt")
+	t.Tags("Negative")
+
+	memorySvc := newMemoryService()
+
+	var err error
+	for i := 0; i < cfg.MaxIPAttempts; i++ {
+		// Different accounts from single IP address
+		err = memorySvc.FailAttempt(ctx, infra.Attempt{Action: "login", Account: fmt.Sprintf("user%d@example.com", i), IP: "127.0.0.1"})
+	}
+
+	t.Require().ErrorIs(err, infra.ErrTooManyAttempts)
+}
+
+func (s *FailAttemptSuite) Test_ErrIncrementingCache(t provider.T) {
+	t.Title("Returns incrementing cache error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Brute-force service")
+	t.Feature("FailAttempt")
+	t.Tags("Negative")
+
+	cacheErr := errors.New("cache error")
+	expiration := time.Duration(cfg.AttemptWindow+cfg.MaxLockout) * time.Second
+
+	managerMock.On("Increment", ctx, "bruteforce.login.account.error@example.com", expiration).
+		Once().Return(int64(0), cacheErr)
+
+	err := svc.FailAttempt(ctx, infra.Attempt{Action: "login", Account: "error@example.com"})
+
+	t.Require().ErrorIs(err, cacheErr)
+}
@@ -0,0 +1,69 @@
+package bruteforce
+
+import (
+	"errors"
+	infra "github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type ResetAttemptsSuite struct {
+	suite.Suite
+}
+
+func (s *ResetAttemptsSuite) Test_Success(t provider.T) {
+	t.Title("Returns success and resets account counter")
+	t.Severity(allure.NORMAL)
+	t.Epic("Brute-force service")
+	t.Feature("ResetAttempts")
+	t.Tags("Positive")
+
+	memorySvc := newMemoryService()
+	attempt := infra.Attempt{Action: "login", Account: "test@example.com", IP: "127.0.0.1"}
+	for i := 1; i < cfg.MaxAccountAttempts; i++ {
+		_ = memorySvc.FailAttempt(ctx, attempt)
+	}
+
+	err := memorySvc.ResetAttempts(ctx, attempt)
+	t.Require().NoError(err)
+
+	// Counter starts from zero after reset
+	for i := 1; i < cfg.MaxAccountAttempts; i++ {
+		err = memorySvc.FailAttempt(ctx, attempt)
+		t.Require().NoError(err)
+	}
+}
+
+func (s *ResetAttemptsSuite) Test_SuccessEmptyAccount(t provider.T) {
+	t.Title("Returns success without deleting, when account is empty")
+	t.Severity(allure.NORMAL)
+	t.Epic("Brute-force service")
+	t.Feature("ResetAttempts")
+	t.Tags("Positive")
+
+	err := svc.ResetAttempts(ctx, infra.Attempt{Action: "login", IP: "127.0.0.1"})
+
+	t.Require().NoError(err)
+}
+
+func (s *ResetAttemptsSuite) Test_ErrDeletingCache(t provider.T) {
+	t.Title("Returns deleting cache error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Brute-force service")
+	t.Feature("ResetAttempts")
+	t.Tags("Negative")
+
+	cacheErr := errors.New("cache error")
+
+	managerMock.On(
+		"Delete",
+		ctx,
+		"bruteforce.login.account.error@example.com",
+		"bruteforce.login.account.error@example.com.lock",
+	).Once().Return(cacheErr)
+
+	err := svc.ResetAttempts(ctx, infra.Attempt{Action: "login", Account: "error@example.com"})
+
+	t.Require().ErrorIs(err, cacheErr)
+}
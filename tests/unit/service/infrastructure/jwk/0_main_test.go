@@ -0,0 +1,58 @@
+package jwk
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/config"
+	mock1 "github.com/mandarine-io/backend/internal/persistence/repo/mock"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/jwk"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+var (
+	ctx = context.Background()
+
+	cfg config.JWTConfig
+)
+
+func init() {
+	cfg = config.JWTConfig{
+		Algorithm:         "ES256",
+		KeyRotationPeriod: 2592000,
+		KeyEncryptionKey:  "key_encryption_key",
+		AccessTokenTTL:    3600,
+		RefreshTokenTTL:   86400,
+	}
+}
+
+// newService creates service with own repository mock, because service caches loaded keys
+func newService(cfg config.JWTConfig) (infrastructure.JWKService, *mock1.SigningKeyRepositoryMock) {
+	signingKeyRepoMock := new(mock1.SigningKeyRepositoryMock)
+
+	transactorMock := new(mock1.TransactorMock)
+	transactorMock.On("Transaction", ctx, mock.Anything).Maybe().Return(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+
+	return jwk.NewService(signingKeyRepoMock, transactorMock, cfg), signingKeyRepoMock
+}
+
+type JWKServiceSuite struct {
+	suite.Suite
+}
+
+func TestJWKServiceSuite(t *testing.T) {
+	suite.RunSuite(t, new(JWKServiceSuite))
+}
+
+func (s *JWKServiceSuite) Test(t provider.T) {
+	s.RunSuite(t, new(GetPublicKeysSuite))
+	s.RunSuite(t, new(GetSigningKeySuite))
+	s.RunSuite(t, new(GetVerificationKeySuite))
+	s.RunSuite(t, new(RotateKeysSuite))
+}
@@ -0,0 +1,60 @@
+package jwk
+
+import (
+	"errors"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type GetPublicKeysSuite struct {
+	suite.Suite
+}
+
+func (s *GetPublicKeysSuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWK service")
+	t.Feature("GetPublicKeys")
+	t.Tags("Positive")
+
+	svc, signingKeyRepoMock := newService(cfg)
+	currentKey := createFreshSigningKeyEntity(t)
+	previousKey := createStaleSigningKeyEntity(t)
+	invalidKey := &entity.SigningKey{ID: "invalid", Algorithm: "ES256", PrivateKey: "invalid", PublicKey: "invalid"}
+
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return(
+		[]*entity.SigningKey{currentKey, previousKey, invalidKey},
+		nil,
+	)
+
+	keys, err := svc.GetPublicKeys(ctx)
+
+	t.Require().NoError(err)
+	t.Require().Len(keys, 2)
+	t.Require().Equal(currentKey.ID, keys[0].ID)
+	t.Require().Equal(previousKey.ID, keys[1].ID)
+	for _, key := range keys {
+		t.Require().Nil(key.PrivateKey)
+		t.Require().NotNil(key.PublicKey)
+	}
+}
+
+func (s *GetPublicKeysSuite) Test_ErrFindSigningKeys(t provider.T) {
+	t.Title("Returns finding signing keys error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWK service")
+	t.Feature("GetPublicKeys")
+	t.Tags("Negative")
+
+	svc, signingKeyRepoMock := newService(cfg)
+
+	dbErr := errors.New("db error")
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return(nil, dbErr)
+
+	_, err := svc.GetPublicKeys(ctx)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, dbErr)
+}
@@ -0,0 +1,135 @@
+package jwk
+
+import (
+	"context"
+	"errors"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type GetSigningKeySuite struct {
+	suite.Suite
+}
+
+func (s *GetSigningKeySuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWK service")
+	t.Feature("GetSigningKey")
+	t.Tags("Positive")
+
+	svc, signingKeyRepoMock := newService(cfg)
+	currentKey := createFreshSigningKeyEntity(t)
+	previousKey := createStaleSigningKeyEntity(t)
+
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return([]*entity.SigningKey{currentKey, previousKey}, nil)
+
+	key, err := svc.GetSigningKey(ctx)
+
+	t.Require().NoError(err)
+	t.Require().Equal(currentKey.ID, key.ID)
+	t.Require().Equal("ES256", key.Algorithm)
+	t.Require().NotNil(key.PrivateKey)
+	t.Require().NotNil(key.PublicKey)
+
+	// Keys are cached, so repository is not called again
+	key, err = svc.GetSigningKey(ctx)
+
+	t.Require().NoError(err)
+	t.Require().Equal(currentKey.ID, key.ID)
+}
+
+func (s *GetSigningKeySuite) Test_SuccessGenerateFirstKey(t provider.T) {
+	t.Title("Returns success with generation of first key")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWK service")
+	t.Feature("GetSigningKey")
+	t.Tags("Positive")
+
+	svc, signingKeyRepoMock := newService(cfg)
+
+	var createdKey *entity.SigningKey
+	signingKeyRepoMock.On("LockSigningKeys", ctx).Once().Return(nil)
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Twice().Return([]*entity.SigningKey{}, nil)
+	signingKeyRepoMock.On("CreateSigningKey", ctx, mock.Anything).Once().Return(
+		func(_ context.Context, signingKey *entity.SigningKey) (*entity.SigningKey, error) {
+			createdKey = signingKey
+			return signingKey, nil
+		},
+	)
+	signingKeyRepoMock.On("ExpireSigningKeys", ctx, mock.Anything, mock.Anything).Once().Return(nil)
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Twice().Return(
+		func(_ context.Context) ([]*entity.SigningKey, error) {
+			return []*entity.SigningKey{createdKey}, nil
+		},
+	)
+
+	key, err := svc.GetSigningKey(ctx)
+
+	t.Require().NoError(err)
+	t.Require().NotNil(createdKey)
+	t.Require().Equal(createdKey.ID, key.ID)
+}
+
+func (s *GetSigningKeySuite) Test_ErrFindSigningKeys(t provider.T) {
+	t.Title("Returns finding signing keys error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWK service")
+	t.Feature("GetSigningKey")
+	t.Tags("Negative")
+
+	svc, signingKeyRepoMock := newService(cfg)
+
+	dbErr := errors.New("db error")
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return(nil, dbErr)
+
+	_, err := svc.GetSigningKey(ctx)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, dbErr)
+}
+
+func (s *GetSigningKeySuite) Test_SuccessSkipExpiredKey(t provider.T) {
+	t.Title("Returns success without signing by expired key")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWK service")
+	t.Feature("GetSigningKey")
+	t.Tags("Positive")
+
+	svc, signingKeyRepoMock := newService(cfg)
+	expiredKey := createFreshSigningKeyEntity(t)
+	expiredKey.ExpiredAt = lo.ToPtr(time.Now().Add(time.Hour))
+	currentKey := createStaleSigningKeyEntity(t)
+
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return([]*entity.SigningKey{expiredKey, currentKey}, nil)
+
+	key, err := svc.GetSigningKey(ctx)
+
+	t.Require().NoError(err)
+	t.Require().Equal(currentKey.ID, key.ID)
+}
+
+func (s *GetSigningKeySuite) Test_SuccessLegacyPlainKey(t provider.T) {
+	t.Title("Returns success with key stored without encryption by previous versions")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWK service")
+	t.Feature("GetSigningKey")
+	t.Tags("Positive")
+
+	svc, signingKeyRepoMock := newService(cfg)
+	currentKey := createFreshSigningKeyEntity(t)
+	t.Require().Contains(currentKey.PrivateKey, "PRIVATE KEY")
+
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return([]*entity.SigningKey{currentKey}, nil)
+
+	key, err := svc.GetSigningKey(ctx)
+
+	t.Require().NoError(err)
+	t.Require().Equal(currentKey.ID, key.ID)
+	t.Require().NotNil(key.PrivateKey)
+}
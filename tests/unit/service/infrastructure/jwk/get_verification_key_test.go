@@ -0,0 +1,78 @@
+package jwk
+
+import (
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/jwk"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type GetVerificationKeySuite struct {
+	suite.Suite
+}
+
+func (s *GetVerificationKeySuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWK service")
+	t.Feature("GetVerificationKey")
+	t.Tags("Positive")
+
+	svc, signingKeyRepoMock := newService(cfg)
+	currentKey := createFreshSigningKeyEntity(t)
+	previousKey := createStaleSigningKeyEntity(t)
+
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return([]*entity.SigningKey{currentKey, previousKey}, nil)
+
+	key, err := svc.GetVerificationKey(ctx, previousKey.ID)
+
+	t.Require().NoError(err)
+	t.Require().Equal(previousKey.ID, key.ID)
+	t.Require().NotNil(key.PublicKey)
+}
+
+func (s *GetVerificationKeySuite) Test_SuccessReloadKeys(t provider.T) {
+	t.Title("Returns success with reloading of unknown key")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWK service")
+	t.Feature("GetVerificationKey")
+	t.Tags("Positive")
+
+	svc, signingKeyRepoMock := newService(cfg)
+	oldKey := createStaleSigningKeyEntity(t)
+	newKey := createFreshSigningKeyEntity(t)
+
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return([]*entity.SigningKey{oldKey}, nil)
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return([]*entity.SigningKey{newKey, oldKey}, nil)
+
+	key, err := svc.GetVerificationKey(ctx, newKey.ID)
+
+	t.Require().NoError(err)
+	t.Require().Equal(newKey.ID, key.ID)
+}
+
+func (s *GetVerificationKeySuite) Test_ErrSigningKeyNotFound(t provider.T) {
+	t.Title("Returns signing key not found error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWK service")
+	t.Feature("GetVerificationKey")
+	t.Tags("Negative")
+
+	svc, signingKeyRepoMock := newService(cfg)
+	currentKey := createFreshSigningKeyEntity(t)
+
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Twice().Return([]*entity.SigningKey{currentKey}, nil)
+
+	_, err := svc.GetVerificationKey(ctx, uuid.New().String())
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, jwk.ErrSigningKeyNotFound)
+
+	// Reloads forced by unknown kid are limited, so the next unknown kid does not query database
+	_, err = svc.GetVerificationKey(ctx, uuid.New().String())
+
+	t.Require().ErrorIs(err, jwk.ErrSigningKeyNotFound)
+	signingKeyRepoMock.AssertNumberOfCalls(t, "FindSigningKeys", 2)
+}
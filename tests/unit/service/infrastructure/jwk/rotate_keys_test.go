@@ -0,0 +1,213 @@
+package jwk
+
+import (
+	"context"
+	"errors"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/jwk"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type RotateKeysSuite struct {
+	suite.Suite
+}
+
+func (s *RotateKeysSuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWK service")
+	t.Feature("RotateKeys")
+	t.Tags("Positive")
+
+	svc, signingKeyRepoMock := newService(cfg)
+	staleKey := createStaleSigningKeyEntity(t)
+
+	var createdKey *entity.SigningKey
+	signingKeyRepoMock.On("LockSigningKeys", ctx).Once().Return(nil)
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return([]*entity.SigningKey{staleKey}, nil)
+	signingKeyRepoMock.On("CreateSigningKey", ctx, mock.Anything).Once().Return(
+		func(_ context.Context, signingKey *entity.SigningKey) (*entity.SigningKey, error) {
+			createdKey = signingKey
+			return signingKey, nil
+		},
+	)
+	signingKeyRepoMock.On("ExpireSigningKeys", ctx, mock.Anything, mock.Anything).Once().Return(nil)
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return(
+		func(_ context.Context) ([]*entity.SigningKey, error) {
+			return []*entity.SigningKey{createdKey, staleKey}, nil
+		},
+	)
+
+	err := svc.RotateKeys(ctx)
+
+	t.Require().NoError(err)
+	t.Require().NotNil(createdKey)
+	t.Require().Equal("ES256", createdKey.Algorithm)
+	t.Require().NotContains(createdKey.PrivateKey, "PRIVATE KEY")
+	t.Require().Contains(createdKey.PublicKey, "PUBLIC KEY")
+	signingKeyRepoMock.AssertCalled(t, "ExpireSigningKeys", ctx, createdKey.ID, mock.Anything)
+
+	// Encrypted key is loaded with the same key encryption key
+	key, err := svc.GetSigningKey(ctx)
+
+	t.Require().NoError(err)
+	t.Require().Equal(createdKey.ID, key.ID)
+	t.Require().NotNil(key.PrivateKey)
+}
+
+func (s *RotateKeysSuite) Test_SuccessFreshKey(t provider.T) {
+	t.Title("Returns success without rotation of fresh key")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWK service")
+	t.Feature("RotateKeys")
+	t.Tags("Positive")
+
+	svc, signingKeyRepoMock := newService(cfg)
+	freshKey := createFreshSigningKeyEntity(t)
+
+	signingKeyRepoMock.On("LockSigningKeys", ctx).Once().Return(nil)
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return([]*entity.SigningKey{freshKey}, nil)
+
+	err := svc.RotateKeys(ctx)
+
+	t.Require().NoError(err)
+	signingKeyRepoMock.AssertNotCalled(t, "CreateSigningKey", ctx, mock.Anything)
+}
+
+func (s *RotateKeysSuite) Test_SuccessSymmetricAlgorithm(t provider.T) {
+	t.Title("Returns success without rotation for symmetric algorithm")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWK service")
+	t.Feature("RotateKeys")
+	t.Tags("Positive")
+
+	symmetricCfg := cfg
+	symmetricCfg.Algorithm = "HS256"
+	svc, _ := newService(symmetricCfg)
+
+	err := svc.RotateKeys(ctx)
+
+	t.Require().NoError(err)
+}
+
+func (s *RotateKeysSuite) Test_ErrFindSigningKeys(t provider.T) {
+	t.Title("Returns finding signing keys error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWK service")
+	t.Feature("RotateKeys")
+	t.Tags("Negative")
+
+	svc, signingKeyRepoMock := newService(cfg)
+
+	dbErr := errors.New("db error")
+	signingKeyRepoMock.On("LockSigningKeys", ctx).Once().Return(nil)
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return(nil, dbErr)
+
+	err := svc.RotateKeys(ctx)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, dbErr)
+}
+
+func (s *RotateKeysSuite) Test_ErrCreateSigningKey(t provider.T) {
+	t.Title("Returns creating signing key error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWK service")
+	t.Feature("RotateKeys")
+	t.Tags("Negative")
+
+	svc, signingKeyRepoMock := newService(cfg)
+
+	dbErr := errors.New("db error")
+	signingKeyRepoMock.On("LockSigningKeys", ctx).Once().Return(nil)
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return([]*entity.SigningKey{}, nil)
+	signingKeyRepoMock.On("CreateSigningKey", ctx, mock.Anything).Once().Return(nil, dbErr)
+
+	err := svc.RotateKeys(ctx)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, dbErr)
+}
+
+func (s *RotateKeysSuite) Test_SuccessFreshKeyOfAnotherInstance(t provider.T) {
+	t.Title("Returns success without rotation, if another instance has rotated key while waiting for lock")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWK service")
+	t.Feature("RotateKeys")
+	t.Tags("Positive")
+
+	svc, signingKeyRepoMock := newService(cfg)
+	staleKey := createStaleSigningKeyEntity(t)
+	freshKey := createFreshSigningKeyEntity(t)
+	staleKey.ExpiredAt = lo.ToPtr(time.Now().Add(time.Hour))
+
+	signingKeyRepoMock.On("LockSigningKeys", ctx).Once().Return(nil)
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return([]*entity.SigningKey{freshKey, staleKey}, nil)
+
+	err := svc.RotateKeys(ctx)
+
+	t.Require().NoError(err)
+	signingKeyRepoMock.AssertNotCalled(t, "CreateSigningKey", ctx, mock.Anything)
+	signingKeyRepoMock.AssertNotCalled(t, "ExpireSigningKeys", ctx, mock.Anything, mock.Anything)
+}
+
+func (s *RotateKeysSuite) Test_ErrLockSigningKeys(t provider.T) {
+	t.Title("Returns locking signing keys error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWK service")
+	t.Feature("RotateKeys")
+	t.Tags("Negative")
+
+	svc, signingKeyRepoMock := newService(cfg)
+
+	dbErr := errors.New("db error")
+	signingKeyRepoMock.On("LockSigningKeys", ctx).Once().Return(dbErr)
+
+	err := svc.RotateKeys(ctx)
+
+	t.Require().ErrorIs(err, dbErr)
+	signingKeyRepoMock.AssertNotCalled(t, "FindSigningKeys", ctx)
+}
+
+func (s *RotateKeysSuite) Test_ErrWrongKeyEncryptionKey(t provider.T) {
+	t.Title("Skips key encrypted by another key encryption key")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWK service")
+	t.Feature("RotateKeys")
+	t.Tags("Negative")
+
+	svc, signingKeyRepoMock := newService(cfg)
+
+	var createdKey *entity.SigningKey
+	signingKeyRepoMock.On("LockSigningKeys", ctx).Once().Return(nil)
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return([]*entity.SigningKey{}, nil)
+	signingKeyRepoMock.On("CreateSigningKey", ctx, mock.Anything).Once().Return(
+		func(_ context.Context, signingKey *entity.SigningKey) (*entity.SigningKey, error) {
+			createdKey = signingKey
+			return signingKey, nil
+		},
+	)
+	signingKeyRepoMock.On("ExpireSigningKeys", ctx, mock.Anything, mock.Anything).Once().Return(nil)
+	signingKeyRepoMock.On("FindSigningKeys", ctx).Once().Return(
+		func(_ context.Context) ([]*entity.SigningKey, error) {
+			return []*entity.SigningKey{createdKey}, nil
+		},
+	)
+
+	err := svc.RotateKeys(ctx)
+	t.Require().NoError(err)
+
+	otherCfg := cfg
+	otherCfg.KeyEncryptionKey = "other_key_encryption_key"
+	otherSvc, otherSigningKeyRepoMock := newService(otherCfg)
+	otherSigningKeyRepoMock.On("FindSigningKeys", ctx).Twice().Return([]*entity.SigningKey{createdKey}, nil)
+
+	_, err = otherSvc.GetVerificationKey(ctx, createdKey.ID)
+
+	t.Require().ErrorIs(err, jwk.ErrSigningKeyNotFound)
+}
@@ -0,0 +1,40 @@
+package jwk
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"time"
+)
+
+func createSigningKeyEntity(t provider.T, createdAt time.Time) *entity.SigningKey {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	t.Require().NoError(err)
+
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	t.Require().NoError(err)
+
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	t.Require().NoError(err)
+
+	return &entity.SigningKey{
+		ID:         uuid.New().String(),
+		Algorithm:  "ES256",
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyBytes})),
+		PublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes})),
+		CreatedAt:  createdAt,
+	}
+}
+
+func createFreshSigningKeyEntity(t provider.T) *entity.SigningKey {
+	return createSigningKeyEntity(t, time.Now())
+}
+
+func createStaleSigningKeyEntity(t provider.T) *entity.SigningKey {
+	return createSigningKeyEntity(t, time.Now().Add(-time.Duration(cfg.KeyRotationPeriod+1)*time.Second))
+}
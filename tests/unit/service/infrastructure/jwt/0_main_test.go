@@ -4,8 +4,10 @@ import (
 	"context"
 	"github.com/mandarine-io/backend/config"
 	mock1 "github.com/mandarine-io/backend/internal/infrastructure/cache/mock"
+	mock2 "github.com/mandarine-io/backend/internal/persistence/repo/mock"
 	"github.com/mandarine-io/backend/internal/service/infrastructure"
 	"github.com/mandarine-io/backend/internal/service/infrastructure/jwt"
+	mock3 "github.com/mandarine-io/backend/internal/service/infrastructure/mock"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/suite"
 	"testing"
@@ -14,19 +16,28 @@ import (
 var (
 	ctx = context.Background()
 
-	managerMock *mock1.ManagerMock
-	cfg         config.JWTConfig
-	svc         infrastructure.JWTService
+	managerMock         *mock1.ManagerMock
+	jwkServiceMock      *mock3.JWKServiceMock
+	sessionRepoMock     *mock2.SessionRepositoryMock
+	bannedTokenRepoMock *mock2.BannedTokenRepositoryMock
+	cfg                 config.JWTConfig
+	svc                 infrastructure.JWTService
 )
 
 func init() {
 	managerMock = new(mock1.ManagerMock)
+	jwkServiceMock = new(mock3.JWKServiceMock)
+	sessionRepoMock = new(mock2.SessionRepositoryMock)
+	bannedTokenRepoMock = new(mock2.BannedTokenRepositoryMock)
 	cfg = config.JWTConfig{
-		Secret:          "8O9Es3ewUadZZ0Ia+EI8IrLfNg1KpltORZdJ1q0dBjY=",
-		AccessTokenTTL:  3600,
-		RefreshTokenTTL: 86400,
+		Algorithm:          "HS256",
+		Secret:             "8O9Es3ewUadZZ0Ia+EI8IrLfNg1KpltORZdJ1q0dBjY=",
+		AccessTokenTTL:     3600,
+		MFATokenTTL:        300,
+		RefreshTokenTTL:    86400,
+		RefreshGracePeriod: 10,
 	}
-	svc = jwt.NewService(managerMock, cfg)
+	svc = jwt.NewService(managerMock, jwkServiceMock, sessionRepoMock, bannedTokenRepoMock, cfg)
 }
 
 type JWTServiceSuite struct {
@@ -39,8 +50,13 @@ func TestJWTServiceSuite(t *testing.T) {
 
 func (s *JWTServiceSuite) Test(t provider.T) {
 	s.RunSuite(t, new(BanTokenSuite))
+	s.RunSuite(t, new(GenerateMFATokenSuite))
 	s.RunSuite(t, new(GenerateTokensSuite))
 	s.RunSuite(t, new(GetAccessTokenClaimsSuite))
+	s.RunSuite(t, new(GetMFATokenClaimsSuite))
 	s.RunSuite(t, new(GetRefreshTokenClaimsSuite))
 	s.RunSuite(t, new(GetTypeTokenSuite))
+	s.RunSuite(t, new(RevokeSessionSuite))
+	s.RunSuite(t, new(RevokeSessionsSuite))
+	s.RunSuite(t, new(RotateTokensSuite))
 }
@@ -3,6 +3,7 @@ package jwt
 import (
 	"errors"
 	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
 	"github.com/ozontech/allure-go/pkg/allure"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/suite"
@@ -23,6 +24,8 @@ func (s *BanTokenSuite) Test_Success(t provider.T) {
 
 	jti := uuid.New().String()
 
+	bannedTokenRepoMock.On("CreateBannedToken", ctx, mock.MatchedBy(matchBannedToken(jti))).
+		Once().Return(&entity.BannedToken{JTI: jti}, nil)
 	managerMock.On("SetWithExpiration", ctx, mock.Anything, jti, time.Duration(cfg.RefreshTokenTTL)*time.Second).
 		Once().Return(nil)
 
@@ -31,6 +34,25 @@ func (s *BanTokenSuite) Test_Success(t provider.T) {
 	t.Require().NoError(err)
 }
 
+func (s *BanTokenSuite) Test_ErrSavingDB(t provider.T) {
+	t.Title("Returns saving banned token error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("BanToken")
+	t.Tags("Negative")
+
+	jti := uuid.New().String()
+
+	dbErr := errors.New("db error")
+	bannedTokenRepoMock.On("CreateBannedToken", ctx, mock.MatchedBy(matchBannedToken(jti))).
+		Once().Return(nil, dbErr)
+
+	err := svc.BanToken(ctx, jti)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, dbErr)
+}
+
 func (s *BanTokenSuite) Test_ErrSettingCache(t provider.T) {
 	t.Title("Returns setting cache error")
 	t.Severity(allure.CRITICAL)
@@ -41,6 +63,8 @@ func (s *BanTokenSuite) Test_ErrSettingCache(t provider.T) {
 	jti := uuid.New().String()
 
 	cacheErr := errors.New("cache error")
+	bannedTokenRepoMock.On("CreateBannedToken", ctx, mock.MatchedBy(matchBannedToken(jti))).
+		Once().Return(&entity.BannedToken{JTI: jti}, nil)
 	managerMock.On("SetWithExpiration", ctx, mock.Anything, jti, time.Duration(cfg.RefreshTokenTTL)*time.Second).
 		Once().Return(cacheErr)
 
@@ -49,3 +73,9 @@ func (s *BanTokenSuite) Test_ErrSettingCache(t provider.T) {
 	t.Require().Error(err)
 	t.Require().ErrorIs(err, cacheErr)
 }
+
+func matchBannedToken(jti string) func(*entity.BannedToken) bool {
+	return func(bannedToken *entity.BannedToken) bool {
+		return bannedToken.JTI == jti && bannedToken.ExpiredAt > time.Now().Unix()
+	}
+}
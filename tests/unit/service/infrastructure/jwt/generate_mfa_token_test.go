@@ -0,0 +1,43 @@
+package jwt
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"time"
+)
+
+type GenerateMFATokenSuite struct {
+	suite.Suite
+}
+
+func (s *GenerateMFATokenSuite) Test_Success(t provider.T) {
+	t.Title("Returns short-lived MFA token")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("GenerateMFAToken")
+	t.Tags("Positive")
+
+	userEntity := &entity.User{ID: uuid.New()}
+
+	token, err := svc.GenerateMFAToken(ctx, userEntity)
+	t.Require().NoError(err)
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(
+		token, claims, func(_ *jwt.Token) (interface{}, error) {
+			return []byte(cfg.Secret), nil
+		},
+	)
+	t.Require().NoError(err)
+	t.Require().Equal("mfa", claims["type"])
+	t.Require().Equal(userEntity.ID.String(), claims["sub"])
+	t.Require().NotEmpty(claims["jti"])
+
+	exp, err := claims.GetExpirationTime()
+	t.Require().NoError(err)
+	t.Require().WithinDuration(time.Now().Add(time.Duration(cfg.MFATokenTTL)*time.Second), exp.Time, 5*time.Second)
+}
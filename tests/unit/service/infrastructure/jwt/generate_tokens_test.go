@@ -1,10 +1,23 @@
 package jwt
 
 import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/jwt"
 	"github.com/ozontech/allure-go/pkg/allure"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
 	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
 	"strings"
 )
 
@@ -19,11 +32,141 @@ func (s *GenerateTokensSuite) Test_Success(t provider.T) {
 	t.Feature("GenerateTokens")
 	t.Tags("Positive")
 
-	accessToken, refreshToken, err := svc.GenerateTokens(ctx, &entity.User{})
+	userEntity := &entity.User{ID: uuid.New()}
+	clientInfo := infrastructure.ClientInfo{IP: "127.0.0.1", UserAgent: "test", DeviceName: "phone"}
+
+	sessionRepoMock.On("CreateSession", ctx, mock.Anything).Once().Return(
+		func(_ context.Context, session *entity.Session) (*entity.Session, error) {
+			return session, nil
+		},
+	)
+
+	accessToken, refreshToken, err := svc.GenerateTokens(ctx, userEntity, clientInfo)
 
 	t.Require().NoError(err)
 	t.Require().NotEmpty(accessToken)
 	t.Require().Len(strings.Split(accessToken, "."), 3)
 	t.Require().NotEmpty(refreshToken)
 	t.Require().Len(strings.Split(refreshToken, "."), 3)
+
+	managerMock.On("Get", ctx, mock.Anything, mock.Anything).Once().Return(nil)
+	claims, err := svc.GetRefreshTokenClaims(ctx, refreshToken)
+	t.Require().NoError(err)
+	t.Require().Equal(userEntity.ID, claims.UserID)
+	t.Require().NotEqual(uuid.Nil, claims.SessionID)
+}
+
+func (s *GenerateTokensSuite) Test_SuccessAsymmetric(t provider.T) {
+	t.Title("Returns success with asymmetric signing key")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWT service")
+	t.Feature("GenerateTokens")
+	t.Tags("Positive")
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	t.Require().NoError(err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	t.Require().NoError(err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	t.Require().NoError(err)
+
+	type testCase struct {
+		algorithm  string
+		privateKey crypto.Signer
+	}
+
+	testCases := []testCase{
+		{algorithm: "RS256", privateKey: rsaKey},
+		{algorithm: "ES256", privateKey: ecdsaKey},
+		{algorithm: "EdDSA", privateKey: ed25519Key},
+	}
+
+	for _, tc := range testCases {
+		t.WithNewStep(
+			tc.algorithm, func(sCtx provider.StepCtx) {
+				asymmetricCfg := cfg
+				asymmetricCfg.Algorithm = tc.algorithm
+				asymmetricSvc := jwt.NewService(
+					managerMock,
+					jwkServiceMock,
+					sessionRepoMock,
+					bannedTokenRepoMock,
+					asymmetricCfg,
+				)
+
+				key := infrastructure.SigningKey{
+					ID:         uuid.New().String(),
+					Algorithm:  tc.algorithm,
+					PrivateKey: tc.privateKey,
+					PublicKey:  tc.privateKey.Public(),
+				}
+
+				sessionRepoMock.On("CreateSession", ctx, mock.Anything).Once().Return(
+					func(_ context.Context, session *entity.Session) (*entity.Session, error) {
+						return session, nil
+					},
+				)
+				jwkServiceMock.On("GetSigningKey", ctx).Once().Return(key, nil)
+
+				_, refreshToken, err := asymmetricSvc.GenerateTokens(
+					ctx,
+					&entity.User{ID: uuid.New()},
+					infrastructure.ClientInfo{},
+				)
+				sCtx.Require().NoError(err)
+
+				parsedToken, _, err := gojwt.NewParser().ParseUnverified(refreshToken, gojwt.MapClaims{})
+				sCtx.Require().NoError(err)
+				sCtx.Require().Equal(tc.algorithm, parsedToken.Method.Alg())
+				sCtx.Require().Equal(key.ID, parsedToken.Header["kid"])
+
+				jwkServiceMock.On("GetVerificationKey", ctx, key.ID).Once().Return(key, nil)
+				managerMock.On("Get", ctx, mock.Anything, mock.Anything).Once().Return(nil)
+
+				_, err = asymmetricSvc.GetRefreshTokenClaims(ctx, refreshToken)
+				sCtx.Require().NoError(err)
+			},
+		)
+	}
+}
+
+func (s *GenerateTokensSuite) Test_ErrGetSigningKey(t provider.T) {
+	t.Title("Returns getting signing key error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("GenerateTokens")
+	t.Tags("Negative")
+
+	asymmetricCfg := cfg
+	asymmetricCfg.Algorithm = "RS256"
+	asymmetricSvc := jwt.NewService(managerMock, jwkServiceMock, sessionRepoMock, bannedTokenRepoMock, asymmetricCfg)
+
+	dbErr := errors.New("db error")
+	sessionRepoMock.On("CreateSession", ctx, mock.Anything).Once().Return(
+		func(_ context.Context, session *entity.Session) (*entity.Session, error) {
+			return session, nil
+		},
+	)
+	jwkServiceMock.On("GetSigningKey", ctx).Once().Return(infrastructure.SigningKey{}, dbErr)
+
+	_, _, err := asymmetricSvc.GenerateTokens(ctx, &entity.User{ID: uuid.New()}, infrastructure.ClientInfo{})
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, dbErr)
+}
+
+func (s *GenerateTokensSuite) Test_ErrCreateSession(t provider.T) {
+	t.Title("Returns creating session error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("GenerateTokens")
+	t.Tags("Negative")
+
+	dbErr := errors.New("db error")
+	sessionRepoMock.On("CreateSession", ctx, mock.Anything).Once().Return(nil, dbErr)
+
+	_, _, err := svc.GenerateTokens(ctx, &entity.User{}, infrastructure.ClientInfo{})
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, dbErr)
 }
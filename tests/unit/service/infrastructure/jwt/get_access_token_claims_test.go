@@ -27,6 +27,7 @@ func (suite *GetAccessTokenClaimsSuite) Test_Success(t provider.T) {
 	accessToken, jti := createAccessToken(t)
 
 	managerMock.On("Get", ctx, mock.Anything, mock.Anything).Once().Return(cache.ErrCacheEntryNotFound)
+	bannedTokenRepoMock.On("ExistsBannedTokenByJTI", ctx, jti).Once().Return(false, nil)
 
 	claims, err := svc.GetAccessTokenClaims(ctx, accessToken)
 
@@ -401,3 +402,42 @@ func (suite *GetAccessTokenClaimsSuite) Test_ErrGetCache(t provider.T) {
 	t.Require().Error(err)
 	t.Require().ErrorIs(err, cacheErr)
 }
+
+func (suite *GetAccessTokenClaimsSuite) Test_ErrBannedJWTTokenInDB(t provider.T) {
+	t.Title("Returns banned JWT token error if token is banned only in DB")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("GetAccessTokenClaimsSuite")
+	t.Tags("Negative")
+
+	accessToken, jti := createAccessToken(t)
+
+	managerMock.On("Get", ctx, mock.Anything, mock.Anything).Once().Return(cache.ErrCacheEntryNotFound)
+	bannedTokenRepoMock.On("ExistsBannedTokenByJTI", ctx, jti).Once().Return(true, nil)
+	managerMock.On("SetWithExpiration", ctx, mock.Anything, jti, time.Duration(cfg.RefreshTokenTTL)*time.Second).
+		Once().Return(nil)
+
+	_, err := svc.GetAccessTokenClaims(ctx, accessToken)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, infrastructure.ErrBannedJWTToken)
+}
+
+func (suite *GetAccessTokenClaimsSuite) Test_ErrGetDB(t provider.T) {
+	t.Title("Returns checking banned token in DB error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("GetAccessTokenClaimsSuite")
+	t.Tags("Negative")
+
+	accessToken, jti := createAccessToken(t)
+
+	dbErr := errors.New("db error")
+	managerMock.On("Get", ctx, mock.Anything, mock.Anything).Once().Return(cache.ErrCacheEntryNotFound)
+	bannedTokenRepoMock.On("ExistsBannedTokenByJTI", ctx, jti).Once().Return(false, dbErr)
+
+	_, err := svc.GetAccessTokenClaims(ctx, accessToken)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, dbErr)
+}
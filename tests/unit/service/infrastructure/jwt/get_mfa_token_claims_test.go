@@ -0,0 +1,116 @@
+package jwt
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type GetMFATokenClaimsSuite struct {
+	suite.Suite
+}
+
+func (s *GetMFATokenClaimsSuite) Test_Success(t provider.T) {
+	t.Title("Returns MFA token claims")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("GetMFATokenClaims")
+	t.Tags("Positive")
+
+	userEntity := &entity.User{ID: uuid.New()}
+	token, err := svc.GenerateMFAToken(ctx, userEntity)
+	t.Require().NoError(err)
+
+	managerMock.On("Get", ctx, mock.Anything, mock.Anything).Once().Return(cache.ErrCacheEntryNotFound)
+	bannedTokenRepoMock.On("ExistsBannedTokenByJTI", ctx, mock.Anything).Once().Return(false, nil)
+
+	claims, err := svc.GetMFATokenClaims(ctx, token)
+
+	t.Require().NoError(err)
+	t.Require().Equal(userEntity.ID, claims.UserID)
+	t.Require().NotEmpty(claims.JTI)
+	t.Require().Less(time.Now().Unix(), claims.Exp)
+}
+
+func (s *GetMFATokenClaimsSuite) Test_ErrBannedJWTToken(t provider.T) {
+	t.Title("Returns banned JWT token error for already used MFA token")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("GetMFATokenClaims")
+	t.Tags("Negative")
+
+	userEntity := &entity.User{ID: uuid.New()}
+	token, err := svc.GenerateMFAToken(ctx, userEntity)
+	t.Require().NoError(err)
+
+	managerMock.On("Get", ctx, mock.Anything, mock.Anything).Once().Return(cache.ErrCacheEntryNotFound)
+	bannedTokenRepoMock.On("ExistsBannedTokenByJTI", ctx, mock.Anything).Once().Return(true, nil)
+	managerMock.On("SetWithExpiration", ctx, mock.Anything, mock.Anything, mock.Anything).Once().Return(nil)
+
+	_, err = svc.GetMFATokenClaims(ctx, token)
+
+	t.Require().ErrorIs(err, infrastructure.ErrBannedJWTToken)
+}
+
+func (s *GetMFATokenClaimsSuite) Test_ErrInvalidJWTToken(t provider.T) {
+	t.Title("Returns invalid JWT token error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("GetMFATokenClaims")
+	t.Tags("Negative")
+
+	accessToken, _ := createAccessToken(t)
+
+	testCases := map[string]string{
+		"Not JWT token": "invalid_token",
+		"Access token":  accessToken,
+		"Without sub": createTokenWithClaims(
+			t, jwt.MapClaims{
+				"iss":  "mandarine",
+				"iat":  time.Now().Unix(),
+				"exp":  time.Now().Add(time.Duration(cfg.MFATokenTTL) * time.Second).Unix(),
+				"jti":  uuid.New().String(),
+				"type": "mfa",
+			},
+		),
+	}
+
+	for name, token := range testCases {
+		t.Run(
+			name, func(t provider.T) {
+				_, err := svc.GetMFATokenClaims(ctx, token)
+				t.Require().ErrorIs(err, infrastructure.ErrInvalidJWTToken)
+			},
+		)
+	}
+}
+
+func (s *GetMFATokenClaimsSuite) Test_ErrExpiredJWTToken(t provider.T) {
+	t.Title("Returns expired JWT token error")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWT service")
+	t.Feature("GetMFATokenClaims")
+	t.Tags("Negative")
+
+	token := createTokenWithClaims(
+		t, jwt.MapClaims{
+			"iss":  "mandarine",
+			"sub":  uuid.New().String(),
+			"iat":  time.Now().Add(-time.Hour).Unix(),
+			"exp":  time.Now().Add(-time.Minute).Unix(),
+			"jti":  uuid.New().String(),
+			"type": "mfa",
+		},
+	)
+
+	_, err := svc.GetMFATokenClaims(ctx, token)
+
+	t.Require().ErrorIs(err, infrastructure.ErrExpiredJWTToken)
+}
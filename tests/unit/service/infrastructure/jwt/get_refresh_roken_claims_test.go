@@ -27,6 +27,7 @@ func (suite *GetRefreshTokenClaimsSuite) Test_Success(t provider.T) {
 	refreshToken, jti := createRefreshToken(t)
 
 	managerMock.On("Get", ctx, mock.Anything, mock.Anything).Once().Return(cache.ErrCacheEntryNotFound)
+	bannedTokenRepoMock.On("ExistsBannedTokenByJTI", ctx, jti).Once().Return(false, nil)
 
 	claims, err := svc.GetRefreshTokenClaims(ctx, refreshToken)
 
@@ -235,3 +236,42 @@ func (suite *GetRefreshTokenClaimsSuite) Test_ErrGetCache(t provider.T) {
 	t.Require().Error(err)
 	t.Require().ErrorIs(err, cacheErr)
 }
+
+func (suite *GetRefreshTokenClaimsSuite) Test_ErrBannedJWTTokenInDB(t provider.T) {
+	t.Title("Returns banned JWT token error if token is banned only in DB")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("GetRefreshTokenClaimsSuite")
+	t.Tags("Negative")
+
+	refreshToken, jti := createRefreshToken(t)
+
+	managerMock.On("Get", ctx, mock.Anything, mock.Anything).Once().Return(cache.ErrCacheEntryNotFound)
+	bannedTokenRepoMock.On("ExistsBannedTokenByJTI", ctx, jti).Once().Return(true, nil)
+	managerMock.On("SetWithExpiration", ctx, mock.Anything, jti, time.Duration(cfg.RefreshTokenTTL)*time.Second).
+		Once().Return(nil)
+
+	_, err := svc.GetRefreshTokenClaims(ctx, refreshToken)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, infrastructure.ErrBannedJWTToken)
+}
+
+func (suite *GetRefreshTokenClaimsSuite) Test_ErrGetDB(t provider.T) {
+	t.Title("Returns checking banned token in DB error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("GetRefreshTokenClaimsSuite")
+	t.Tags("Negative")
+
+	refreshToken, jti := createRefreshToken(t)
+
+	dbErr := errors.New("db error")
+	managerMock.On("Get", ctx, mock.Anything, mock.Anything).Once().Return(cache.ErrCacheEntryNotFound)
+	bannedTokenRepoMock.On("ExistsBannedTokenByJTI", ctx, jti).Once().Return(false, dbErr)
+
+	_, err := svc.GetRefreshTokenClaims(ctx, refreshToken)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, dbErr)
+}
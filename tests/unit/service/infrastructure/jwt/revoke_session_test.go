@@ -0,0 +1,120 @@
+package jwt
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type RevokeSessionSuite struct {
+	suite.Suite
+}
+
+func (s *RevokeSessionSuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWT service")
+	t.Feature("RevokeSession")
+	t.Tags("Positive")
+
+	session := &entity.Session{ID: uuid.New(), UserID: uuid.New(), JTI: uuid.New().String()}
+
+	sessionRepoMock.On("FindSessionByID", ctx, session.ID).Once().Return(session, nil)
+	bannedTokenRepoMock.On("CreateBannedToken", ctx, mock.MatchedBy(matchBannedToken(session.JTI))).
+		Once().Return(&entity.BannedToken{JTI: session.JTI}, nil)
+	managerMock.On("SetWithExpiration", ctx, mock.Anything, session.JTI, time.Duration(cfg.RefreshTokenTTL)*time.Second).
+		Once().Return(nil)
+	sessionRepoMock.On("DeleteSessionByID", ctx, session.ID).Once().Return(nil)
+
+	err := svc.RevokeSession(ctx, session.UserID, session.ID)
+
+	t.Require().NoError(err)
+}
+
+func (s *RevokeSessionSuite) Test_SuccessWithPreviousJTI(t provider.T) {
+	t.Title("Returns success and bans token pair issued before the last rotation")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWT service")
+	t.Feature("RevokeSession")
+	t.Tags("Positive")
+
+	previousJTI := uuid.New().String()
+	session := &entity.Session{ID: uuid.New(), UserID: uuid.New(), JTI: uuid.New().String(), PreviousJTI: &previousJTI}
+
+	sessionRepoMock.On("FindSessionByID", ctx, session.ID).Once().Return(session, nil)
+	for _, jti := range []string{session.JTI, previousJTI} {
+		bannedTokenRepoMock.On("CreateBannedToken", ctx, mock.MatchedBy(matchBannedToken(jti))).
+			Once().Return(&entity.BannedToken{JTI: jti}, nil)
+		managerMock.On("SetWithExpiration", ctx, mock.Anything, jti, time.Duration(cfg.RefreshTokenTTL)*time.Second).
+			Once().Return(nil)
+	}
+	sessionRepoMock.On("DeleteSessionByID", ctx, session.ID).Once().Return(nil)
+
+	err := svc.RevokeSession(ctx, session.UserID, session.ID)
+
+	t.Require().NoError(err)
+	bannedTokenRepoMock.AssertCalled(t, "CreateBannedToken", ctx, mock.MatchedBy(matchBannedToken(previousJTI)))
+}
+
+func (s *RevokeSessionSuite) Test_ErrSessionNotFound(t provider.T) {
+	t.Title("Returns session not found error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("RevokeSession")
+	t.Tags("Negative")
+
+	sessionID := uuid.New()
+
+	sessionRepoMock.On("FindSessionByID", ctx, sessionID).Once().Return(nil, nil)
+
+	err := svc.RevokeSession(ctx, uuid.New(), sessionID)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, infrastructure.ErrSessionNotFound)
+}
+
+func (s *RevokeSessionSuite) Test_ErrForeignSession(t provider.T) {
+	t.Title("Returns session not found error if session belongs to another user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("RevokeSession")
+	t.Tags("Negative")
+
+	session := &entity.Session{ID: uuid.New(), UserID: uuid.New(), JTI: uuid.New().String()}
+
+	sessionRepoMock.On("FindSessionByID", ctx, session.ID).Once().Return(session, nil)
+
+	err := svc.RevokeSession(ctx, uuid.New(), session.ID)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, infrastructure.ErrSessionNotFound)
+}
+
+func (s *RevokeSessionSuite) Test_ErrDeleteSession(t provider.T) {
+	t.Title("Returns deleting session error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("RevokeSession")
+	t.Tags("Negative")
+
+	session := &entity.Session{ID: uuid.New(), UserID: uuid.New(), JTI: uuid.New().String()}
+
+	dbErr := errors.New("db error")
+	sessionRepoMock.On("FindSessionByID", ctx, session.ID).Once().Return(session, nil)
+	bannedTokenRepoMock.On("CreateBannedToken", ctx, mock.MatchedBy(matchBannedToken(session.JTI))).
+		Once().Return(&entity.BannedToken{JTI: session.JTI}, nil)
+	managerMock.On("SetWithExpiration", ctx, mock.Anything, session.JTI, time.Duration(cfg.RefreshTokenTTL)*time.Second).
+		Once().Return(nil)
+	sessionRepoMock.On("DeleteSessionByID", ctx, session.ID).Once().Return(dbErr)
+
+	err := svc.RevokeSession(ctx, session.UserID, session.ID)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, dbErr)
+}
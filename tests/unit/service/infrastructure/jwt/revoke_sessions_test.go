@@ -0,0 +1,81 @@
+package jwt
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type RevokeSessionsSuite struct {
+	suite.Suite
+}
+
+func (s *RevokeSessionsSuite) Test_Success(t provider.T) {
+	t.Title("Returns success and keeps the excepted session")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWT service")
+	t.Feature("RevokeSessions")
+	t.Tags("Positive")
+
+	userID := uuid.New()
+	currentSession := &entity.Session{ID: uuid.New(), UserID: userID, JTI: uuid.New().String()}
+	otherSession := &entity.Session{ID: uuid.New(), UserID: userID, JTI: uuid.New().String()}
+
+	sessionRepoMock.On("FindSessionsByUserID", ctx, userID).
+		Once().Return([]*entity.Session{currentSession, otherSession}, nil)
+	bannedTokenRepoMock.On("CreateBannedToken", ctx, mock.MatchedBy(matchBannedToken(otherSession.JTI))).
+		Once().Return(&entity.BannedToken{JTI: otherSession.JTI}, nil)
+	managerMock.On(
+		"SetWithExpiration", ctx, mock.Anything, otherSession.JTI, time.Duration(cfg.RefreshTokenTTL)*time.Second,
+	).Once().Return(nil)
+	sessionRepoMock.On("DeleteSessionByID", ctx, otherSession.ID).Once().Return(nil)
+
+	err := svc.RevokeSessions(ctx, userID, currentSession.ID)
+
+	t.Require().NoError(err)
+	sessionRepoMock.AssertNotCalled(t, "DeleteSessionByID", ctx, currentSession.ID)
+}
+
+func (s *RevokeSessionsSuite) Test_ErrFindSessions(t provider.T) {
+	t.Title("Returns finding sessions error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("RevokeSessions")
+	t.Tags("Negative")
+
+	userID := uuid.New()
+
+	dbErr := errors.New("db error")
+	sessionRepoMock.On("FindSessionsByUserID", ctx, userID).Once().Return(nil, dbErr)
+
+	err := svc.RevokeSessions(ctx, userID, uuid.Nil)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, dbErr)
+}
+
+func (s *RevokeSessionsSuite) Test_ErrBanToken(t provider.T) {
+	t.Title("Returns banning session token error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("RevokeSessions")
+	t.Tags("Negative")
+
+	userID := uuid.New()
+	session := &entity.Session{ID: uuid.New(), UserID: userID, JTI: uuid.New().String()}
+
+	dbErr := errors.New("db error")
+	sessionRepoMock.On("FindSessionsByUserID", ctx, userID).Once().Return([]*entity.Session{session}, nil)
+	bannedTokenRepoMock.On("CreateBannedToken", ctx, mock.MatchedBy(matchBannedToken(session.JTI))).
+		Once().Return(nil, dbErr)
+
+	err := svc.RevokeSessions(ctx, userID, uuid.Nil)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, dbErr)
+}
@@ -0,0 +1,258 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"strings"
+	"time"
+)
+
+type RotateTokensSuite struct {
+	suite.Suite
+}
+
+func (s *RotateTokensSuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWT service")
+	t.Feature("RotateTokens")
+	t.Tags("Positive")
+
+	userEntity := &entity.User{ID: uuid.New()}
+	session := &entity.Session{
+		ID:        uuid.New(),
+		UserID:    userEntity.ID,
+		JTI:       uuid.New().String(),
+		ExpiredAt: time.Now().Add(time.Hour),
+	}
+	oldJTI := session.JTI
+	claims := infrastructure.RefreshTokenClaims{UserID: userEntity.ID, SessionID: session.ID, JTI: oldJTI}
+
+	sessionRepoMock.On("FindSessionByID", ctx, session.ID).Once().Return(session, nil)
+	sessionRepoMock.On("RotateSession", ctx, session, oldJTI).Once().Return(true, nil)
+
+	accessToken, refreshToken, err := svc.RotateTokens(ctx, userEntity, claims, infrastructure.ClientInfo{IP: "::1"})
+
+	t.Require().NoError(err)
+	t.Require().Len(strings.Split(accessToken, "."), 3)
+	t.Require().Len(strings.Split(refreshToken, "."), 3)
+	t.Require().NotEqual(oldJTI, session.JTI)
+	t.Require().Equal(oldJTI, *session.PreviousJTI)
+	t.Require().NotNil(session.RotatedAt)
+	t.Require().Equal("::1", *session.IP)
+}
+
+func (s *RotateTokensSuite) Test_SuccessWithoutSession(t provider.T) {
+	t.Title("Returns success for refresh token without session")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWT service")
+	t.Feature("RotateTokens")
+	t.Tags("Positive")
+
+	userEntity := &entity.User{ID: uuid.New()}
+	claims := infrastructure.RefreshTokenClaims{UserID: userEntity.ID, JTI: uuid.New().String()}
+
+	bannedTokenRepoMock.On("CreateBannedToken", ctx, mock.MatchedBy(matchBannedToken(claims.JTI))).
+		Once().Return(&entity.BannedToken{JTI: claims.JTI}, nil)
+	managerMock.On("SetWithExpiration", ctx, mock.Anything, claims.JTI, time.Duration(cfg.RefreshTokenTTL)*time.Second).
+		Once().Return(nil)
+	sessionRepoMock.On("CreateSession", ctx, mock.Anything).Once().Return(
+		func(_ context.Context, session *entity.Session) (*entity.Session, error) {
+			return session, nil
+		},
+	)
+
+	accessToken, refreshToken, err := svc.RotateTokens(ctx, userEntity, claims, infrastructure.ClientInfo{})
+
+	t.Require().NoError(err)
+	t.Require().NotEmpty(accessToken)
+	t.Require().NotEmpty(refreshToken)
+}
+
+func (s *RotateTokensSuite) Test_SuccessConcurrentRotation(t provider.T) {
+	t.Title("Returns success if session has been rotated by concurrent request")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWT service")
+	t.Feature("RotateTokens")
+	t.Tags("Positive")
+
+	userEntity := &entity.User{ID: uuid.New()}
+	oldJTI := uuid.New().String()
+	session := &entity.Session{ID: uuid.New(), UserID: userEntity.ID, JTI: oldJTI}
+	rotatedSession := &entity.Session{
+		ID:          session.ID,
+		UserID:      userEntity.ID,
+		JTI:         uuid.New().String(),
+		PreviousJTI: lo.ToPtr(oldJTI),
+		RotatedAt:   lo.ToPtr(time.Now()),
+		ExpiredAt:   time.Now().Add(time.Hour),
+	}
+	claims := infrastructure.RefreshTokenClaims{UserID: userEntity.ID, SessionID: session.ID, JTI: oldJTI}
+
+	sessionRepoMock.On("FindSessionByID", ctx, session.ID).Once().Return(session, nil)
+	sessionRepoMock.On("RotateSession", ctx, session, oldJTI).Once().Return(false, nil)
+	sessionRepoMock.On("FindSessionByID", ctx, session.ID).Once().Return(rotatedSession, nil)
+
+	accessToken, refreshToken, err := svc.RotateTokens(ctx, userEntity, claims, infrastructure.ClientInfo{})
+
+	t.Require().NoError(err)
+	t.Require().NotEmpty(accessToken)
+	t.Require().NotEmpty(refreshToken)
+}
+
+func (s *RotateTokensSuite) Test_SuccessWithinGracePeriod(t provider.T) {
+	t.Title("Returns success for just rotated refresh token")
+	t.Severity(allure.NORMAL)
+	t.Epic("JWT service")
+	t.Feature("RotateTokens")
+	t.Tags("Positive")
+
+	userEntity := &entity.User{ID: uuid.New()}
+	oldJTI := uuid.New().String()
+	session := &entity.Session{
+		ID:          uuid.New(),
+		UserID:      userEntity.ID,
+		JTI:         uuid.New().String(),
+		PreviousJTI: lo.ToPtr(oldJTI),
+		RotatedAt:   lo.ToPtr(time.Now().Add(-time.Second)),
+		ExpiredAt:   time.Now().Add(time.Hour),
+	}
+	claims := infrastructure.RefreshTokenClaims{UserID: userEntity.ID, SessionID: session.ID, JTI: oldJTI}
+
+	sessionRepoMock.On("FindSessionByID", ctx, session.ID).Once().Return(session, nil)
+
+	accessToken, refreshToken, err := svc.RotateTokens(ctx, userEntity, claims, infrastructure.ClientInfo{})
+
+	t.Require().NoError(err)
+	t.Require().NotEmpty(accessToken)
+	t.Require().NotEmpty(refreshToken)
+}
+
+func (s *RotateTokensSuite) Test_ErrReusedJWTToken(t provider.T) {
+	t.Title("Returns reused JWT token error and revokes session")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("RotateTokens")
+	t.Tags("Negative")
+
+	type testCase struct {
+		name        string
+		previousJTI *string
+		rotatedAt   *time.Time
+	}
+
+	oldJTI := uuid.New().String()
+	testCases := []testCase{
+		{
+			name:        "Token rotated after grace period",
+			previousJTI: lo.ToPtr(oldJTI),
+			rotatedAt:   lo.ToPtr(time.Now().Add(-time.Minute)),
+		},
+		{
+			name:        "Token rotated several times",
+			previousJTI: lo.ToPtr(uuid.New().String()),
+			rotatedAt:   lo.ToPtr(time.Now()),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(
+			tc.name, func(t provider.T) {
+				userEntity := &entity.User{ID: uuid.New()}
+				session := &entity.Session{
+					ID:          uuid.New(),
+					UserID:      userEntity.ID,
+					JTI:         uuid.New().String(),
+					PreviousJTI: tc.previousJTI,
+					RotatedAt:   tc.rotatedAt,
+				}
+				claims := infrastructure.RefreshTokenClaims{UserID: userEntity.ID, SessionID: session.ID, JTI: oldJTI}
+
+				sessionRepoMock.On("FindSessionByID", ctx, session.ID).Once().Return(session, nil)
+				bannedTokenRepoMock.On("CreateBannedToken", ctx, mock.MatchedBy(matchBannedToken(session.JTI))).
+					Once().Return(&entity.BannedToken{JTI: session.JTI}, nil)
+				managerMock.On(
+					"SetWithExpiration", ctx, mock.Anything, session.JTI, time.Duration(cfg.RefreshTokenTTL)*time.Second,
+				).Once().Return(nil)
+				bannedTokenRepoMock.On("CreateBannedToken", ctx, mock.MatchedBy(matchBannedToken(*tc.previousJTI))).
+					Once().Return(&entity.BannedToken{JTI: *tc.previousJTI}, nil)
+				managerMock.On(
+					"SetWithExpiration", ctx, mock.Anything, *tc.previousJTI, time.Duration(cfg.RefreshTokenTTL)*time.Second,
+				).Once().Return(nil)
+				sessionRepoMock.On("DeleteSessionByID", ctx, session.ID).Once().Return(nil)
+
+				_, _, err := svc.RotateTokens(ctx, userEntity, claims, infrastructure.ClientInfo{})
+
+				t.Require().Error(err)
+				t.Require().ErrorIs(err, infrastructure.ErrReusedJWTToken)
+			},
+		)
+	}
+}
+
+func (s *RotateTokensSuite) Test_ErrSessionNotFound(t provider.T) {
+	t.Title("Returns invalid JWT token error if session not found")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("RotateTokens")
+	t.Tags("Negative")
+
+	userEntity := &entity.User{ID: uuid.New()}
+	claims := infrastructure.RefreshTokenClaims{UserID: userEntity.ID, SessionID: uuid.New()}
+
+	sessionRepoMock.On("FindSessionByID", ctx, claims.SessionID).Once().Return(nil, nil)
+
+	_, _, err := svc.RotateTokens(ctx, userEntity, claims, infrastructure.ClientInfo{})
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidJWTToken)
+}
+
+func (s *RotateTokensSuite) Test_ErrForeignSession(t provider.T) {
+	t.Title("Returns invalid JWT token error if session belongs to another user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("RotateTokens")
+	t.Tags("Negative")
+
+	userEntity := &entity.User{ID: uuid.New()}
+	session := &entity.Session{ID: uuid.New(), UserID: uuid.New()}
+	claims := infrastructure.RefreshTokenClaims{UserID: userEntity.ID, SessionID: session.ID}
+
+	sessionRepoMock.On("FindSessionByID", ctx, session.ID).Once().Return(session, nil)
+
+	_, _, err := svc.RotateTokens(ctx, userEntity, claims, infrastructure.ClientInfo{})
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidJWTToken)
+}
+
+func (s *RotateTokensSuite) Test_ErrRotateSession(t provider.T) {
+	t.Title("Returns rotating session error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("JWT service")
+	t.Feature("RotateTokens")
+	t.Tags("Negative")
+
+	userEntity := &entity.User{ID: uuid.New()}
+	session := &entity.Session{ID: uuid.New(), UserID: userEntity.ID, JTI: uuid.New().String()}
+	oldJTI := session.JTI
+	claims := infrastructure.RefreshTokenClaims{UserID: userEntity.ID, SessionID: session.ID, JTI: oldJTI}
+
+	dbErr := errors.New("db error")
+	sessionRepoMock.On("FindSessionByID", ctx, session.ID).Once().Return(session, nil)
+	sessionRepoMock.On("RotateSession", ctx, session, oldJTI).Once().Return(false, dbErr)
+
+	_, _, err := svc.RotateTokens(ctx, userEntity, claims, infrastructure.ClientInfo{})
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, dbErr)
+}
@@ -3,6 +3,7 @@ package otp
 import (
 	"context"
 	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache/memory"
 	mock1 "github.com/mandarine-io/backend/internal/infrastructure/cache/mock"
 	"github.com/mandarine-io/backend/internal/service/infrastructure"
 	"github.com/mandarine-io/backend/internal/service/infrastructure/otp"
@@ -17,15 +18,25 @@ var (
 	managerMock *mock1.ManagerMock
 	cfg         config.OTPConfig
 	svc         infrastructure.OTPService
+
+	// memorySvc stores codes in memory cache, so that stored entries can be checked
+	memorySvc infrastructure.OTPService
 )
 
 func init() {
 	managerMock = new(mock1.ManagerMock)
 	cfg = config.OTPConfig{
-		Length: 6,
-		TTL:    120,
+		Length:      6,
+		TTL:         120,
+		MaxAttempts: 3,
 	}
 	svc = otp.NewService(managerMock, cfg)
+
+	memoryManager, err := memory.NewManager()
+	if err != nil {
+		panic(err)
+	}
+	memorySvc = otp.NewService(memoryManager, cfg)
 }
 
 type OTPServiceSuite struct {
@@ -37,9 +48,15 @@ func TestOTPServiceSuite(t *testing.T) {
 }
 
 func (s *OTPServiceSuite) Test(t provider.T) {
+	s.RunSuite(t, new(ConsumeDataByTokenSuite))
+	s.RunSuite(t, new(ConsumeTokenSuite))
 	s.RunSuite(t, new(DeleteDataByCodeSuite))
+	s.RunSuite(t, new(DeleteDataBySubjectSuite))
 	s.RunSuite(t, new(GenerateCodeSuite))
 	s.RunSuite(t, new(GenerateAndSaveWithCodeSuite))
+	s.RunSuite(t, new(GenerateAndSaveWithSubjectSuite))
+	s.RunSuite(t, new(GenerateAndSaveWithTokenSuite))
 	s.RunSuite(t, new(GetDataByCodeSuite))
+	s.RunSuite(t, new(GetDataBySubjectAndCodeSuite))
 	s.RunSuite(t, new(SaveWithCodeSuite))
 }
@@ -0,0 +1,101 @@
+package otp
+
+import (
+	infra "github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"sync"
+	"time"
+)
+
+type ConsumeDataByTokenSuite struct {
+	suite.Suite
+}
+
+func (s *ConsumeDataByTokenSuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("OTP service")
+	t.Feature("ConsumeDataByToken")
+	t.Tags("Positive")
+
+	token, err := memorySvc.GenerateAndSaveWithToken(ctx, "prefix", "data", time.Minute)
+	t.Require().NoError(err)
+
+	var data string
+	err = memorySvc.ConsumeDataByToken(ctx, "prefix", token, &data)
+
+	t.Require().NoError(err)
+	t.Require().Equal("data", data)
+}
+
+func (s *ConsumeDataByTokenSuite) Test_ErrTokenReused(t provider.T) {
+	t.Title("Returns invalid OTP error for already consumed token")
+	t.Severity(allure.CRITICAL)
+	t.Epic("OTP service")
+	t.Feature("ConsumeDataByToken")
+	t.Tags("Negative")
+
+	token, err := memorySvc.GenerateAndSaveWithToken(ctx, "prefix", "data", time.Minute)
+	t.Require().NoError(err)
+
+	var data string
+	err = memorySvc.ConsumeDataByToken(ctx, "prefix", token, &data)
+	t.Require().NoError(err)
+
+	err = memorySvc.ConsumeDataByToken(ctx, "prefix", token, &data)
+	t.Require().ErrorIs(err, infra.ErrInvalidOrExpiredOTP)
+}
+
+func (s *ConsumeDataByTokenSuite) Test_ErrConcurrentConsumption(t provider.T) {
+	t.Title("Returns success only for one of concurrent requests")
+	t.Severity(allure.CRITICAL)
+	t.Epic("OTP service")
+	t.Feature("ConsumeDataByToken")
+	t.Tags("Negative")
+
+	token, err := memorySvc.GenerateAndSaveWithToken(ctx, "prefix", "data", time.Minute)
+	t.Require().NoError(err)
+
+	const requests = 10
+	var (
+		wg        sync.WaitGroup
+		lock      sync.Mutex
+		successes int
+	)
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			var data string
+			if memorySvc.ConsumeDataByToken(ctx, "prefix", token, &data) == nil {
+				lock.Lock()
+				successes++
+				lock.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	t.Require().Equal(1, successes)
+}
+
+func (s *ConsumeDataByTokenSuite) Test_ErrInvalidToken(t provider.T) {
+	t.Title("Returns invalid OTP error for unknown token and token of another prefix")
+	t.Severity(allure.CRITICAL)
+	t.Epic("OTP service")
+	t.Feature("ConsumeDataByToken")
+	t.Tags("Negative")
+
+	token, err := memorySvc.GenerateAndSaveWithToken(ctx, "prefix", "data", time.Minute)
+	t.Require().NoError(err)
+
+	var data string
+	err = memorySvc.ConsumeDataByToken(ctx, "prefix", "unknown", &data)
+	t.Require().ErrorIs(err, infra.ErrInvalidOrExpiredOTP)
+
+	err = memorySvc.ConsumeDataByToken(ctx, "another_prefix", token, &data)
+	t.Require().ErrorIs(err, infra.ErrInvalidOrExpiredOTP)
+}
@@ -0,0 +1,42 @@
+package otp
+
+import (
+	infra "github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"time"
+)
+
+type ConsumeTokenSuite struct {
+	suite.Suite
+}
+
+func (s *ConsumeTokenSuite) Test_Success(t provider.T) {
+	t.Title("Returns success for the first consumption")
+	t.Severity(allure.NORMAL)
+	t.Epic("OTP service")
+	t.Feature("ConsumeToken")
+	t.Tags("Positive")
+
+	err := memorySvc.ConsumeToken(ctx, "prefix", "token-success", time.Minute)
+
+	t.Require().NoError(err)
+}
+
+func (s *ConsumeTokenSuite) Test_ErrTokenReused(t provider.T) {
+	t.Title("Returns invalid OTP error for already consumed token")
+	t.Severity(allure.CRITICAL)
+	t.Epic("OTP service")
+	t.Feature("ConsumeToken")
+	t.Tags("Negative")
+
+	err := memorySvc.ConsumeToken(ctx, "prefix", "token-reused", time.Minute)
+	t.Require().NoError(err)
+
+	err = memorySvc.ConsumeToken(ctx, "prefix", "token-reused", time.Minute)
+	t.Require().ErrorIs(err, infra.ErrInvalidOrExpiredOTP)
+
+	err = memorySvc.ConsumeToken(ctx, "another_prefix", "token-reused", time.Minute)
+	t.Require().NoError(err)
+}
@@ -0,0 +1,45 @@
+package otp
+
+import (
+	"errors"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type DeleteDataBySubjectSuite struct {
+	suite.Suite
+}
+
+func (s *DeleteDataBySubjectSuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("OTP service")
+	t.Feature("DeleteDataBySubject")
+	t.Tags("Positive")
+
+	managerMock.On("Delete", ctx, "prefix.delete@example.com", "otp_attempts.prefix.delete@example.com").
+		Once().Return(nil)
+
+	err := svc.DeleteDataBySubject(ctx, "prefix", "delete@example.com")
+
+	t.Require().NoError(err)
+}
+
+func (s *DeleteDataBySubjectSuite) Test_ErrDeletingCache(t provider.T) {
+	t.Title("Returns deleting cache error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("OTP service")
+	t.Feature("DeleteDataBySubject")
+	t.Tags("Negative")
+
+	cacheErr := errors.New("cache error")
+
+	managerMock.On("Delete", ctx, "prefix.error@example.com", "otp_attempts.prefix.error@example.com").
+		Once().Return(cacheErr)
+
+	err := svc.DeleteDataBySubject(ctx, "prefix", "error@example.com")
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, cacheErr)
+}
@@ -0,0 +1,87 @@
+package otp
+
+import (
+	"errors"
+	infra "github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type GenerateAndSaveWithSubjectSuite struct {
+	suite.Suite
+}
+
+func (s *GenerateAndSaveWithSubjectSuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("OTP service")
+	t.Feature("GenerateAndSaveWithSubject")
+	t.Tags("Positive")
+
+	subject := "generate@example.com"
+	managerMock.On(
+		"SetWithExpiration",
+		ctx,
+		"prefix."+subject,
+		mock.Anything,
+		time.Duration(cfg.TTL)*time.Second,
+	).Once().Return(nil)
+	managerMock.On("Delete", ctx, "otp_attempts.prefix."+subject).Once().Return(nil)
+
+	otp, err := svc.GenerateAndSaveWithSubject(ctx, "prefix", subject, "data")
+
+	t.Require().NoError(err)
+	t.Require().Len(otp, cfg.Length)
+	t.Require().Regexp("^\\d*$", otp)
+}
+
+func (s *GenerateAndSaveWithSubjectSuite) Test_SuccessReplacesPreviousCode(t provider.T) {
+	t.Title("Returns success and invalidates previous code of subject")
+	t.Severity(allure.NORMAL)
+	t.Epic("OTP service")
+	t.Feature("GenerateAndSaveWithSubject")
+	t.Tags("Positive")
+
+	subject := "replace@example.com"
+
+	oldOTP, err := memorySvc.GenerateAndSaveWithSubject(ctx, "prefix", subject, "old")
+	t.Require().NoError(err)
+	newOTP, err := memorySvc.GenerateAndSaveWithSubject(ctx, "prefix", subject, "new")
+	t.Require().NoError(err)
+
+	var data string
+	err = memorySvc.GetDataBySubjectAndCode(ctx, "prefix", subject, newOTP, &data)
+	t.Require().NoError(err)
+	t.Require().Equal("new", data)
+
+	if oldOTP != newOTP {
+		err = memorySvc.GetDataBySubjectAndCode(ctx, "prefix", subject, oldOTP, &data)
+		t.Require().ErrorIs(err, infra.ErrInvalidOrExpiredOTP)
+	}
+}
+
+func (s *GenerateAndSaveWithSubjectSuite) Test_ErrSettingCache(t provider.T) {
+	t.Title("Returns setting cache error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("OTP service")
+	t.Feature("GenerateAndSaveWithSubject")
+	t.Tags("Negative")
+
+	cacheErr := errors.New("cache error")
+
+	managerMock.On(
+		"SetWithExpiration",
+		ctx,
+		"prefix.error@example.com",
+		mock.Anything,
+		time.Duration(cfg.TTL)*time.Second,
+	).Once().Return(cacheErr)
+
+	_, err := svc.GenerateAndSaveWithSubject(ctx, "prefix", "error@example.com", "data")
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, cacheErr)
+}
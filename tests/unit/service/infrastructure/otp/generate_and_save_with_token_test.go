@@ -0,0 +1,67 @@
+package otp
+
+import (
+	"errors"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type GenerateAndSaveWithTokenSuite struct {
+	suite.Suite
+}
+
+func (s *GenerateAndSaveWithTokenSuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("OTP service")
+	t.Feature("GenerateAndSaveWithToken")
+	t.Tags("Positive")
+
+	managerMock.On(
+		"SetWithExpiration",
+		ctx,
+		mock.MatchedBy(func(key string) bool { return len(key) == len("token_prefix.")+64 }),
+		mock.Anything,
+		time.Minute,
+	).Once().Return(nil)
+
+	token, err := svc.GenerateAndSaveWithToken(ctx, "token_prefix", "data", time.Minute)
+
+	t.Require().NoError(err)
+	t.Require().Regexp("^[A-Za-z0-9_-]{43}$", token)
+}
+
+func (s *GenerateAndSaveWithTokenSuite) Test_SuccessUniqueTokens(t provider.T) {
+	t.Title("Returns different tokens for the same data")
+	t.Severity(allure.NORMAL)
+	t.Epic("OTP service")
+	t.Feature("GenerateAndSaveWithToken")
+	t.Tags("Positive")
+
+	token1, err := memorySvc.GenerateAndSaveWithToken(ctx, "prefix", "data", time.Minute)
+	t.Require().NoError(err)
+	token2, err := memorySvc.GenerateAndSaveWithToken(ctx, "prefix", "data", time.Minute)
+	t.Require().NoError(err)
+
+	t.Require().NotEqual(token1, token2)
+}
+
+func (s *GenerateAndSaveWithTokenSuite) Test_ErrSettingCache(t provider.T) {
+	t.Title("Returns setting cache error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("OTP service")
+	t.Feature("GenerateAndSaveWithToken")
+	t.Tags("Negative")
+
+	cacheErr := errors.New("cache error")
+
+	managerMock.On("SetWithExpiration", ctx, mock.Anything, mock.Anything, 2*time.Minute).Once().Return(cacheErr)
+
+	token, err := svc.GenerateAndSaveWithToken(ctx, "error_prefix", "data", 2*time.Minute)
+
+	t.Require().ErrorIs(err, cacheErr)
+	t.Require().Empty(token)
+}
@@ -0,0 +1,107 @@
+package otp
+
+import (
+	"errors"
+	infra "github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+type GetDataBySubjectAndCodeSuite struct {
+	suite.Suite
+}
+
+func (s *GetDataBySubjectAndCodeSuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("OTP service")
+	t.Feature("GetDataBySubjectAndCode")
+	t.Tags("Positive")
+
+	subject := "success@example.com"
+	otp, err := memorySvc.GenerateAndSaveWithSubject(ctx, "prefix", subject, "data")
+	t.Require().NoError(err)
+
+	var data string
+	err = memorySvc.GetDataBySubjectAndCode(ctx, "prefix", subject, otp, &data)
+
+	t.Require().NoError(err)
+	t.Require().Equal("data", data)
+}
+
+func (s *GetDataBySubjectAndCodeSuite) Test_ErrInvalidOTP(t provider.T) {
+	t.Title("Returns invalid OTP error for wrong code")
+	t.Severity(allure.CRITICAL)
+	t.Epic("OTP service")
+	t.Feature("GetDataBySubjectAndCode")
+	t.Tags("Negative")
+
+	subject := "wrong@example.com"
+	otp, err := memorySvc.GenerateAndSaveWithSubject(ctx, "prefix", subject, "data")
+	t.Require().NoError(err)
+
+	var data string
+	err = memorySvc.GetDataBySubjectAndCode(ctx, "prefix", subject, wrongCode(otp), &data)
+	t.Require().ErrorIs(err, infra.ErrInvalidOrExpiredOTP)
+
+	// Code of another subject is not accepted
+	err = memorySvc.GetDataBySubjectAndCode(ctx, "prefix", "another@example.com", otp, &data)
+	t.Require().ErrorIs(err, infra.ErrInvalidOrExpiredOTP)
+
+	// Valid code is still accepted before limit is reached
+	err = memorySvc.GetDataBySubjectAndCode(ctx, "prefix", subject, otp, &data)
+	t.Require().NoError(err)
+	t.Require().Equal("data", data)
+}
+
+func (s *GetDataBySubjectAndCodeSuite) Test_ErrOTPAttemptsExceeded(t provider.T) {
+	t.Title("Returns attempts exceeded error and invalidates code after N wrong attempts")
+	t.Severity(allure.CRITICAL)
+	t.Epic("OTP service")
+	t.Feature("GetDataBySubjectAndCode")
+	t.Tags("Negative")
+
+	subject := "exceeded@example.com"
+	otp, err := memorySvc.GenerateAndSaveWithSubject(ctx, "prefix", subject, "data")
+	t.Require().NoError(err)
+
+	var data string
+	for i := 1; i < cfg.MaxAttempts; i++ {
+		err = memorySvc.GetDataBySubjectAndCode(ctx, "prefix", subject, wrongCode(otp), &data)
+		t.Require().ErrorIs(err, infra.ErrInvalidOrExpiredOTP)
+	}
+
+	err = memorySvc.GetDataBySubjectAndCode(ctx, "prefix", subject, wrongCode(otp), &data)
+	t.Require().ErrorIs(err, infra.ErrOTPAttemptsExceeded)
+
+	// Valid code is rejected after invalidation
+	err = memorySvc.GetDataBySubjectAndCode(ctx, "prefix", subject, otp, &data)
+	t.Require().ErrorIs(err, infra.ErrInvalidOrExpiredOTP)
+}
+
+func (s *GetDataBySubjectAndCodeSuite) Test_ErrGettingCache(t provider.T) {
+	t.Title("Returns getting cache error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("OTP service")
+	t.Feature("GetDataBySubjectAndCode")
+	t.Tags("Negative")
+
+	var data string
+	cacheErr := errors.New("cache error")
+
+	managerMock.On("Get", ctx, "prefix.error@example.com", mock.Anything).Once().Return(cacheErr)
+
+	err := svc.GetDataBySubjectAndCode(ctx, "prefix", "error@example.com", "123456", &data)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, cacheErr)
+}
+
+func wrongCode(code string) string {
+	if code[0] == '0' {
+		return "1" + code[1:]
+	}
+	return "0" + code[1:]
+}
@@ -0,0 +1,68 @@
+package outbox
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/infrastructure/event"
+	mock2 "github.com/mandarine-io/backend/internal/infrastructure/pubsub/mock"
+	mock1 "github.com/mandarine-io/backend/internal/persistence/repo/mock"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/outbox"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"testing"
+)
+
+var (
+	ctx = context.Background()
+
+	outboxEventRepoMock *mock1.OutboxEventRepositoryMock
+	transactorMock      *mock1.TransactorMock
+	agentMock           *mock2.AgentMock
+	registry            *event.Registry
+	cfg                 config.OutboxConfig
+	svc                 infrastructure.OutboxService
+)
+
+func init() {
+	outboxEventRepoMock = new(mock1.OutboxEventRepositoryMock)
+	transactorMock = new(mock1.TransactorMock)
+	agentMock = new(mock2.AgentMock)
+
+	registry = event.NewRegistry()
+	if err := domain.RegisterEvents(registry); err != nil {
+		panic(err)
+	}
+
+	cfg = config.OutboxConfig{
+		BatchSize:   10,
+		MaxAttempts: 10,
+		MaxBackoff:  60,
+		Retention:   24,
+	}
+	svc = outbox.NewService(outboxEventRepoMock, transactorMock, agentMock, registry, cfg)
+}
+
+func expectTransaction() {
+	transactorMock.On("Transaction", ctx, mock.Anything).Return(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	).Once()
+}
+
+type OutboxServiceSuite struct {
+	suite.Suite
+}
+
+func TestOutboxServiceSuite(t *testing.T) {
+	suite.RunSuite(t, new(OutboxServiceSuite))
+}
+
+func (s *OutboxServiceSuite) Test(t provider.T) {
+	s.RunSuite(t, new(AddSuite))
+	s.RunSuite(t, new(CleanupSuite))
+	s.RunSuite(t, new(RelaySuite))
+}
@@ -0,0 +1,89 @@
+package outbox
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/infrastructure/event"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+type unknownEvent struct{}
+
+func (unknownEvent) EventType() string {
+	return "Unknown"
+}
+
+func (unknownEvent) AggregateType() string {
+	return "unknown"
+}
+
+func (unknownEvent) AggregateID() string {
+	return "unknown"
+}
+
+type AddSuite struct {
+	suite.Suite
+}
+
+func (s *AddSuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("Outbox service")
+	t.Feature("Add")
+	t.Tags("Positive")
+
+	e := domain.MasterProfileUpdatedEvent{UserID: uuid.New(), DisplayName: "test"}
+	outboxEventRepoMock.On(
+		"CreateOutboxEvents", ctx, mock.MatchedBy(
+			func(events []*entity.OutboxEvent) bool {
+				if len(events) != 1 {
+					return false
+				}
+
+				decoded, err := registry.Decode(events[0].EventType, events[0].Payload)
+				return err == nil &&
+					events[0].AggregateType == domain.MasterProfileAggregateType &&
+					events[0].AggregateID == e.UserID.String() &&
+					decoded == e
+			},
+		),
+	).Return(nil).Once()
+
+	err := svc.Add(ctx, e)
+
+	t.Require().NoError(err)
+}
+
+func (s *AddSuite) Test_ErrUnknownEventType(t provider.T) {
+	t.Title("Returns unknown event type error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Outbox service")
+	t.Feature("Add")
+	t.Tags("Negative")
+
+	err := svc.Add(ctx, unknownEvent{})
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, event.ErrUnknownEventType)
+}
+
+func (s *AddSuite) Test_ErrCreateOutboxEvents(t provider.T) {
+	t.Title("Returns creating outbox events error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Outbox service")
+	t.Feature("Add")
+	t.Tags("Negative")
+
+	expectedErr := errors.New("failed to create outbox events")
+	outboxEventRepoMock.On("CreateOutboxEvents", ctx, mock.Anything).Return(expectedErr).Once()
+
+	err := svc.Add(ctx, domain.MasterProfileUpdatedEvent{UserID: uuid.New()})
+
+	t.Require().Error(err)
+	t.Require().Equal(expectedErr, err)
+}
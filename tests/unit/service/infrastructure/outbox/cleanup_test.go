@@ -0,0 +1,52 @@
+package outbox
+
+import (
+	"errors"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type CleanupSuite struct {
+	suite.Suite
+}
+
+func (s *CleanupSuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("Outbox service")
+	t.Feature("Cleanup")
+	t.Tags("Positive")
+
+	retention := time.Duration(cfg.Retention) * time.Hour
+	outboxEventRepoMock.On(
+		"DeleteOutboxEventsPublishedBefore", ctx, mock.MatchedBy(
+			func(before time.Time) bool {
+				return time.Since(before) >= retention && time.Since(before) < retention+time.Minute
+			},
+		),
+	).Return(int64(2), nil).Once()
+
+	deleted, err := svc.Cleanup(ctx)
+
+	t.Require().NoError(err)
+	t.Require().Equal(int64(2), deleted)
+}
+
+func (s *CleanupSuite) Test_ErrDeleteOutboxEvents(t provider.T) {
+	t.Title("Returns deleting outbox events error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Outbox service")
+	t.Feature("Cleanup")
+	t.Tags("Negative")
+
+	expectedErr := errors.New("failed to delete outbox events")
+	outboxEventRepoMock.On("DeleteOutboxEventsPublishedBefore", ctx, mock.Anything).Return(int64(0), expectedErr).Once()
+
+	_, err := svc.Cleanup(ctx)
+
+	t.Require().Error(err)
+	t.Require().Equal(expectedErr, err)
+}
@@ -0,0 +1,209 @@
+package outbox
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/infrastructure/event"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/domain"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+func newOutboxEvent(t provider.T, attempts int) *entity.OutboxEvent {
+	e := domain.MasterProfileUpdatedEvent{UserID: uuid.New(), DisplayName: "test"}
+	payload, err := registry.Encode(e)
+	t.Require().NoError(err)
+
+	return &entity.OutboxEvent{
+		ID:            uuid.New(),
+		AggregateType: e.AggregateType(),
+		AggregateID:   e.AggregateID(),
+		EventType:     e.EventType(),
+		Payload:       payload,
+		Attempts:      attempts,
+		CreatedAt:     time.Now(),
+	}
+}
+
+type RelaySuite struct {
+	suite.Suite
+}
+
+func (s *RelaySuite) Test_Success(t provider.T) {
+	t.Title("Returns success")
+	t.Severity(allure.NORMAL)
+	t.Epic("Outbox service")
+	t.Feature("Relay")
+	t.Tags("Positive")
+
+	e := newOutboxEvent(t, 0)
+	topic := event.Topic(domain.MasterProfileUpdatedEventType)
+
+	expectTransaction()
+	outboxEventRepoMock.On("FindPendingOutboxEvents", ctx, cfg.BatchSize).Return([]*entity.OutboxEvent{e}, nil).Once()
+	agentMock.On(
+		"Publish", ctx, topic, mock.MatchedBy(
+			func(env event.Envelope) bool {
+				return env.ID == e.ID && env.Type == e.EventType && env.AggregateID == e.AggregateID
+			},
+		),
+	).Return(nil).Once()
+	outboxEventRepoMock.On("MarkOutboxEventPublished", ctx, e.ID, mock.Anything).Return(nil).Once()
+	expectTransaction()
+	outboxEventRepoMock.On("FindPendingOutboxEvents", ctx, cfg.BatchSize).Return([]*entity.OutboxEvent{}, nil).Once()
+
+	published, err := svc.Relay(ctx)
+
+	t.Require().NoError(err)
+	t.Require().Equal(1, published)
+}
+
+func (s *RelaySuite) Test_PublishFailed(t provider.T) {
+	t.Title("Returns success, failed event is retried with backoff")
+	t.Severity(allure.NORMAL)
+	t.Epic("Outbox service")
+	t.Feature("Relay")
+	t.Tags("Positive")
+
+	e := newOutboxEvent(t, 2)
+	publishErr := errors.New("failed to publish")
+
+	expectTransaction()
+	outboxEventRepoMock.On("FindPendingOutboxEvents", ctx, cfg.BatchSize).Return([]*entity.OutboxEvent{e}, nil).Once()
+	agentMock.On("Publish", ctx, mock.Anything, mock.Anything).Return(publishErr).Once()
+	outboxEventRepoMock.On(
+		"MarkOutboxEventFailed", ctx, e.ID, publishErr.Error(), mock.MatchedBy(
+			func(nextAttemptAt time.Time) bool {
+				// Third attempt is delayed by 4 seconds
+				delay := time.Until(nextAttemptAt)
+				return delay > 3*time.Second && delay <= 4*time.Second
+			},
+		),
+	).Return(nil).Once()
+	expectTransaction()
+	outboxEventRepoMock.On("FindPendingOutboxEvents", ctx, cfg.BatchSize).Return([]*entity.OutboxEvent{}, nil).Once()
+
+	published, err := svc.Relay(ctx)
+
+	t.Require().NoError(err)
+	t.Require().Equal(0, published)
+}
+
+func (s *RelaySuite) Test_MaxBackoff(t provider.T) {
+	t.Title("Returns success, backoff is limited by max backoff")
+	t.Severity(allure.NORMAL)
+	t.Epic("Outbox service")
+	t.Feature("Relay")
+	t.Tags("Positive")
+
+	e := newOutboxEvent(t, cfg.MaxAttempts-2)
+	maxBackoff := time.Duration(cfg.MaxBackoff) * time.Second
+
+	expectTransaction()
+	outboxEventRepoMock.On("FindPendingOutboxEvents", ctx, cfg.BatchSize).Return([]*entity.OutboxEvent{e}, nil).Once()
+	agentMock.On("Publish", ctx, mock.Anything, mock.Anything).Return(errors.New("failed to publish")).Once()
+	outboxEventRepoMock.On(
+		"MarkOutboxEventFailed", ctx, e.ID, mock.Anything, mock.MatchedBy(
+			func(nextAttemptAt time.Time) bool {
+				delay := time.Until(nextAttemptAt)
+				return delay > maxBackoff-time.Second && delay <= maxBackoff
+			},
+		),
+	).Return(nil).Once()
+	expectTransaction()
+	outboxEventRepoMock.On("FindPendingOutboxEvents", ctx, cfg.BatchSize).Return([]*entity.OutboxEvent{}, nil).Once()
+
+	_, err := svc.Relay(ctx)
+
+	t.Require().NoError(err)
+}
+
+func (s *RelaySuite) Test_MaxAttempts(t provider.T) {
+	t.Title("Returns success, event is failed permanently after max attempts")
+	t.Severity(allure.NORMAL)
+	t.Epic("Outbox service")
+	t.Feature("Relay")
+	t.Tags("Positive")
+
+	e := newOutboxEvent(t, cfg.MaxAttempts-1)
+	publishErr := errors.New("failed to publish")
+
+	expectTransaction()
+	outboxEventRepoMock.On("FindPendingOutboxEvents", ctx, cfg.BatchSize).Return([]*entity.OutboxEvent{e}, nil).Once()
+	agentMock.On("Publish", ctx, mock.Anything, mock.Anything).Return(publishErr).Once()
+	outboxEventRepoMock.On("MarkOutboxEventFailedPermanently", ctx, e.ID, publishErr.Error(), mock.Anything).
+		Return(nil).Once()
+	expectTransaction()
+	outboxEventRepoMock.On("FindPendingOutboxEvents", ctx, cfg.BatchSize).Return([]*entity.OutboxEvent{}, nil).Once()
+
+	published, err := svc.Relay(ctx)
+
+	t.Require().NoError(err)
+	t.Require().Equal(0, published)
+	outboxEventRepoMock.AssertNotCalled(t, "MarkOutboxEventFailed", ctx, e.ID, mock.Anything, mock.Anything)
+}
+
+func (s *RelaySuite) Test_ErrMarkOutboxEventFailedPermanently(t provider.T) {
+	t.Title("Returns marking outbox event as failed permanently error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Outbox service")
+	t.Feature("Relay")
+	t.Tags("Negative")
+
+	e := newOutboxEvent(t, cfg.MaxAttempts-1)
+	expectedErr := errors.New("failed to mark outbox event")
+
+	expectTransaction()
+	outboxEventRepoMock.On("FindPendingOutboxEvents", ctx, cfg.BatchSize).Return([]*entity.OutboxEvent{e}, nil).Once()
+	agentMock.On("Publish", ctx, mock.Anything, mock.Anything).Return(errors.New("failed to publish")).Once()
+	outboxEventRepoMock.On("MarkOutboxEventFailedPermanently", ctx, e.ID, mock.Anything, mock.Anything).
+		Return(expectedErr).Once()
+
+	_, err := svc.Relay(ctx)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, expectedErr)
+}
+
+func (s *RelaySuite) Test_ErrFindPendingOutboxEvents(t provider.T) {
+	t.Title("Returns finding pending outbox events error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Outbox service")
+	t.Feature("Relay")
+	t.Tags("Negative")
+
+	expectedErr := errors.New("failed to find pending outbox events")
+
+	expectTransaction()
+	outboxEventRepoMock.On("FindPendingOutboxEvents", ctx, cfg.BatchSize).Return(nil, expectedErr).Once()
+
+	_, err := svc.Relay(ctx)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, expectedErr)
+}
+
+func (s *RelaySuite) Test_ErrMarkOutboxEventPublished(t provider.T) {
+	t.Title("Returns marking outbox event as published error")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Outbox service")
+	t.Feature("Relay")
+	t.Tags("Negative")
+
+	e := newOutboxEvent(t, 0)
+	expectedErr := errors.New("failed to mark outbox event")
+
+	expectTransaction()
+	outboxEventRepoMock.On("FindPendingOutboxEvents", ctx, cfg.BatchSize).Return([]*entity.OutboxEvent{e}, nil).Once()
+	agentMock.On("Publish", ctx, mock.Anything, mock.Anything).Return(nil).Once()
+	outboxEventRepoMock.On("MarkOutboxEventPublished", ctx, e.ID, mock.Anything).Return(expectedErr).Once()
+
+	_, err := svc.Relay(ctx)
+
+	t.Require().Error(err)
+	t.Require().ErrorIs(err, expectedErr)
+}
@@ -0,0 +1,47 @@
+package totp
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/config"
+	mock1 "github.com/mandarine-io/backend/internal/persistence/repo/mock"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/totp"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"testing"
+)
+
+var (
+	ctx = context.Background()
+
+	userRepoMock         *mock1.UserRepositoryMock
+	recoveryCodeRepoMock *mock1.RecoveryCodeRepositoryMock
+	cfg                  config.MFAConfig
+	svc                  infrastructure.TOTPService
+)
+
+func init() {
+	userRepoMock = new(mock1.UserRepositoryMock)
+	recoveryCodeRepoMock = new(mock1.RecoveryCodeRepositoryMock)
+	cfg = config.MFAConfig{
+		Issuer:            "Mandarine",
+		RecoveryCodeCount: 3,
+	}
+	svc = totp.NewService(userRepoMock, recoveryCodeRepoMock, cfg)
+}
+
+type TOTPServiceSuite struct {
+	suite.Suite
+}
+
+func TestTOTPServiceSuite(t *testing.T) {
+	suite.RunSuite(t, new(TOTPServiceSuite))
+}
+
+func (s *TOTPServiceSuite) Test(t provider.T) {
+	s.RunSuite(t, new(GenerateCodeSuite))
+	s.RunSuite(t, new(GenerateRecoveryCodesSuite))
+	s.RunSuite(t, new(GenerateSecretSuite))
+	s.RunSuite(t, new(GetProvisioningURISuite))
+	s.RunSuite(t, new(VerifyCodeSuite))
+}
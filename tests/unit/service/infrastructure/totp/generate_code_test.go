@@ -0,0 +1,50 @@
+package totp
+
+import (
+	"github.com/mandarine-io/backend/internal/service/infrastructure/totp"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"time"
+)
+
+// rfcSecret is base32 encoded secret "12345678901234567890" from RFC 6238 test vectors
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+type GenerateCodeSuite struct {
+	suite.Suite
+}
+
+func (s *GenerateCodeSuite) Test_RFCTestVectors(t provider.T) {
+	t.Title("GenerateCode matches RFC 6238 test vectors")
+	t.Severity(allure.CRITICAL)
+	t.Epic("TOTP service")
+	t.Feature("GenerateCode")
+	t.Tags("Positive")
+
+	vectors := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+
+	for unix, expected := range vectors {
+		code, err := totp.GenerateCode(rfcSecret, time.Unix(unix, 0))
+		t.Require().NoError(err)
+		t.Require().Equal(expected, code)
+	}
+}
+
+func (s *GenerateCodeSuite) Test_InvalidSecret(t provider.T) {
+	t.Title("GenerateCode returns error for invalid secret")
+	t.Severity(allure.NORMAL)
+	t.Epic("TOTP service")
+	t.Feature("GenerateCode")
+	t.Tags("Negative")
+
+	_, err := totp.GenerateCode("not base32!", time.Now())
+	t.Require().Error(err)
+}
@@ -0,0 +1,63 @@
+package totp
+
+import (
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/util/security"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"strings"
+)
+
+type GenerateRecoveryCodesSuite struct {
+	suite.Suite
+}
+
+func (s *GenerateRecoveryCodesSuite) Test_Success(t provider.T) {
+	t.Title("GenerateRecoveryCodes replaces recovery codes with hashed new ones")
+	t.Severity(allure.CRITICAL)
+	t.Epic("TOTP service")
+	t.Feature("GenerateRecoveryCodes")
+	t.Tags("Positive")
+
+	userID := uuid.New()
+	var created []*entity.RecoveryCode
+
+	recoveryCodeRepoMock.On("DeleteRecoveryCodesByUserID", ctx, userID).Once().Return(nil)
+	recoveryCodeRepoMock.On("CreateRecoveryCodes", ctx, mock.Anything).Once().
+		Run(func(args mock.Arguments) { created = args.Get(1).([]*entity.RecoveryCode) }).
+		Return(nil)
+
+	codes, err := svc.GenerateRecoveryCodes(ctx, userID)
+
+	t.Require().NoError(err)
+	t.Require().Len(codes, cfg.RecoveryCodeCount)
+	t.Require().Len(created, cfg.RecoveryCodeCount)
+	for i, code := range codes {
+		t.Require().Len(code, 11)
+		t.Require().Equal("-", code[5:6])
+		t.Require().Equal(userID, created[i].UserID)
+		t.Require().True(security.CheckPasswordHash(strings.ReplaceAll(code, "-", ""), created[i].CodeHash))
+	}
+}
+
+func (s *GenerateRecoveryCodesSuite) Test_ErrorDeleting(t provider.T) {
+	t.Title("GenerateRecoveryCodes returns error when deleting old codes fails")
+	t.Severity(allure.NORMAL)
+	t.Epic("TOTP service")
+	t.Feature("GenerateRecoveryCodes")
+	t.Tags("Negative")
+
+	userID := uuid.New()
+	expectedErr := errors.New("database error")
+
+	recoveryCodeRepoMock.On("DeleteRecoveryCodesByUserID", ctx, userID).Once().Return(expectedErr)
+
+	codes, err := svc.GenerateRecoveryCodes(ctx, userID)
+
+	t.Require().ErrorIs(err, expectedErr)
+	t.Require().Nil(codes)
+}
@@ -0,0 +1,30 @@
+package totp
+
+import (
+	"encoding/base32"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+)
+
+type GenerateSecretSuite struct {
+	suite.Suite
+}
+
+func (s *GenerateSecretSuite) Test_Success(t provider.T) {
+	t.Title("GenerateSecret returns random 160-bit base32 secret")
+	t.Severity(allure.NORMAL)
+	t.Epic("TOTP service")
+	t.Feature("GenerateSecret")
+	t.Tags("Positive")
+
+	secret1, err := svc.GenerateSecret(ctx)
+	t.Require().NoError(err)
+	secret2, err := svc.GenerateSecret(ctx)
+	t.Require().NoError(err)
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret1)
+	t.Require().NoError(err)
+	t.Require().Len(key, 20)
+	t.Require().NotEqual(secret1, secret2)
+}
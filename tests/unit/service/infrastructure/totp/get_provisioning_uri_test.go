@@ -0,0 +1,32 @@
+package totp
+
+import (
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"net/url"
+)
+
+type GetProvisioningURISuite struct {
+	suite.Suite
+}
+
+func (s *GetProvisioningURISuite) Test_Success(t provider.T) {
+	t.Title("GetProvisioningURI returns otpauth URI")
+	t.Severity(allure.NORMAL)
+	t.Epic("TOTP service")
+	t.Feature("GetProvisioningURI")
+	t.Tags("Positive")
+
+	uri := svc.GetProvisioningURI(ctx, rfcSecret, "user@example.com")
+
+	parsed, err := url.Parse(uri)
+	t.Require().NoError(err)
+	t.Require().Equal("otpauth", parsed.Scheme)
+	t.Require().Equal("totp", parsed.Host)
+	t.Require().Equal("/Mandarine:user@example.com", parsed.Path)
+	t.Require().Equal(rfcSecret, parsed.Query().Get("secret"))
+	t.Require().Equal("Mandarine", parsed.Query().Get("issuer"))
+	t.Require().Equal("6", parsed.Query().Get("digits"))
+	t.Require().Equal("30", parsed.Query().Get("period"))
+}
@@ -0,0 +1,161 @@
+package totp
+
+import (
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/totp"
+	"github.com/mandarine-io/backend/internal/util/security"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type VerifyCodeSuite struct {
+	suite.Suite
+}
+
+func (s *VerifyCodeSuite) Test_SuccessTOTP(t provider.T) {
+	t.Title("VerifyCode accepts current TOTP code")
+	t.Severity(allure.CRITICAL)
+	t.Epic("TOTP service")
+	t.Feature("VerifyCode")
+	t.Tags("Positive")
+
+	userEntity := &entity.User{ID: uuid.New(), TOTPSecret: lo.ToPtr(rfcSecret)}
+	code, err := totp.GenerateCode(rfcSecret, time.Now())
+	t.Require().NoError(err)
+
+	userRepoMock.On("UpdateTOTPLastUsedStep", ctx, userEntity.ID, mock.Anything).Once().Return(true, nil)
+
+	err = svc.VerifyCode(ctx, userEntity, code)
+
+	t.Require().NoError(err)
+	t.Require().NotNil(userEntity.TOTPLastUsedStep)
+}
+
+func (s *VerifyCodeSuite) Test_SuccessTOTPPreviousStep(t provider.T) {
+	t.Title("VerifyCode accepts TOTP code from previous time step")
+	t.Severity(allure.NORMAL)
+	t.Epic("TOTP service")
+	t.Feature("VerifyCode")
+	t.Tags("Positive")
+
+	userEntity := &entity.User{ID: uuid.New(), TOTPSecret: lo.ToPtr(rfcSecret)}
+	code, err := totp.GenerateCode(rfcSecret, time.Now().Add(-30*time.Second))
+	t.Require().NoError(err)
+
+	userRepoMock.On("UpdateTOTPLastUsedStep", ctx, userEntity.ID, mock.Anything).Once().Return(true, nil)
+
+	err = svc.VerifyCode(ctx, userEntity, code)
+
+	t.Require().NoError(err)
+}
+
+func (s *VerifyCodeSuite) Test_ErrInvalidTOTP(t provider.T) {
+	t.Title("VerifyCode rejects outdated TOTP code")
+	t.Severity(allure.CRITICAL)
+	t.Epic("TOTP service")
+	t.Feature("VerifyCode")
+	t.Tags("Negative")
+
+	userEntity := &entity.User{ID: uuid.New(), TOTPSecret: lo.ToPtr(rfcSecret)}
+	code, err := totp.GenerateCode(rfcSecret, time.Now().Add(-5*time.Minute))
+	t.Require().NoError(err)
+
+	err = svc.VerifyCode(ctx, userEntity, code)
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidTOTPCode)
+}
+
+func (s *VerifyCodeSuite) Test_ErrReusedTOTP(t provider.T) {
+	t.Title("VerifyCode rejects already used TOTP code")
+	t.Severity(allure.CRITICAL)
+	t.Epic("TOTP service")
+	t.Feature("VerifyCode")
+	t.Tags("Negative")
+
+	userEntity := &entity.User{ID: uuid.New(), TOTPSecret: lo.ToPtr(rfcSecret)}
+	code, err := totp.GenerateCode(rfcSecret, time.Now())
+	t.Require().NoError(err)
+
+	userRepoMock.On("UpdateTOTPLastUsedStep", ctx, userEntity.ID, mock.Anything).Once().Return(false, nil)
+
+	err = svc.VerifyCode(ctx, userEntity, code)
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidTOTPCode)
+}
+
+func (s *VerifyCodeSuite) Test_ErrSecretNotSet(t provider.T) {
+	t.Title("VerifyCode rejects code when secret is not set")
+	t.Severity(allure.NORMAL)
+	t.Epic("TOTP service")
+	t.Feature("VerifyCode")
+	t.Tags("Negative")
+
+	err := svc.VerifyCode(ctx, &entity.User{ID: uuid.New()}, "123456")
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidTOTPCode)
+}
+
+func (s *VerifyCodeSuite) Test_SuccessRecoveryCode(t provider.T) {
+	t.Title("VerifyCode accepts unused recovery code")
+	t.Severity(allure.CRITICAL)
+	t.Epic("TOTP service")
+	t.Feature("VerifyCode")
+	t.Tags("Positive")
+
+	userEntity := &entity.User{ID: uuid.New(), TOTPSecret: lo.ToPtr(rfcSecret)}
+	otherHash, _ := security.HashPassword("zzzzzzzzzz")
+	codeHash, _ := security.HashPassword("abcdefghij")
+	recoveryCodes := []*entity.RecoveryCode{
+		{ID: uuid.New(), UserID: userEntity.ID, CodeHash: otherHash},
+		{ID: uuid.New(), UserID: userEntity.ID, CodeHash: codeHash},
+	}
+
+	recoveryCodeRepoMock.On("FindUnusedRecoveryCodesByUserID", ctx, userEntity.ID).Once().Return(recoveryCodes, nil)
+	recoveryCodeRepoMock.On("UseRecoveryCode", ctx, recoveryCodes[1].ID).Once().Return(true, nil)
+
+	err := svc.VerifyCode(ctx, userEntity, "ABCDE-fghij")
+
+	t.Require().NoError(err)
+}
+
+func (s *VerifyCodeSuite) Test_ErrUsedRecoveryCode(t provider.T) {
+	t.Title("VerifyCode rejects recovery code used concurrently")
+	t.Severity(allure.NORMAL)
+	t.Epic("TOTP service")
+	t.Feature("VerifyCode")
+	t.Tags("Negative")
+
+	userEntity := &entity.User{ID: uuid.New(), TOTPSecret: lo.ToPtr(rfcSecret)}
+	codeHash, _ := security.HashPassword("abcdefghij")
+	recoveryCodes := []*entity.RecoveryCode{{ID: uuid.New(), UserID: userEntity.ID, CodeHash: codeHash}}
+
+	recoveryCodeRepoMock.On("FindUnusedRecoveryCodesByUserID", ctx, userEntity.ID).Once().Return(recoveryCodes, nil)
+	recoveryCodeRepoMock.On("UseRecoveryCode", ctx, recoveryCodes[0].ID).Once().Return(false, nil)
+
+	err := svc.VerifyCode(ctx, userEntity, "abcde-fghij")
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidTOTPCode)
+}
+
+func (s *VerifyCodeSuite) Test_ErrInvalidRecoveryCode(t provider.T) {
+	t.Title("VerifyCode rejects unknown recovery code")
+	t.Severity(allure.CRITICAL)
+	t.Epic("TOTP service")
+	t.Feature("VerifyCode")
+	t.Tags("Negative")
+
+	userEntity := &entity.User{ID: uuid.New(), TOTPSecret: lo.ToPtr(rfcSecret)}
+
+	recoveryCodeRepoMock.On("FindUnusedRecoveryCodesByUserID", ctx, userEntity.ID).
+		Once().Return([]*entity.RecoveryCode{}, nil)
+
+	err := svc.VerifyCode(ctx, userEntity, "abcde-fghij")
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidTOTPCode)
+}
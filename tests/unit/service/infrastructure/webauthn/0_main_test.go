@@ -0,0 +1,61 @@
+package webauthn
+
+import (
+	"context"
+	"github.com/mandarine-io/backend/config"
+	mock1 "github.com/mandarine-io/backend/internal/infrastructure/cache/mock"
+	mock2 "github.com/mandarine-io/backend/internal/persistence/repo/mock"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/webauthn"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"testing"
+)
+
+const (
+	rpID   = "example.com"
+	origin = "https://example.com"
+)
+
+var (
+	ctx = context.Background()
+
+	cfg config.Config
+)
+
+func init() {
+	cfg = config.Config{
+		Server: config.ServerConfig{
+			ExternalURL: origin,
+		},
+		Security: config.SecurityConfig{
+			WebAuthn: config.WebAuthnConfig{
+				RPID:         rpID,
+				RPName:       "Example",
+				ChallengeTTL: 300,
+			},
+		},
+	}
+}
+
+// newService creates service with own mocks, because every test stores its own challenge in cache
+func newService() (infrastructure.WebAuthnService, *mock1.ManagerMock, *mock2.PasskeyRepositoryMock) {
+	managerMock := new(mock1.ManagerMock)
+	passkeyRepoMock := new(mock2.PasskeyRepositoryMock)
+	return webauthn.NewService(managerMock, passkeyRepoMock, cfg), managerMock, passkeyRepoMock
+}
+
+type WebAuthnServiceSuite struct {
+	suite.Suite
+}
+
+func TestWebAuthnServiceSuite(t *testing.T) {
+	suite.RunSuite(t, new(WebAuthnServiceSuite))
+}
+
+func (s *WebAuthnServiceSuite) Test(t provider.T) {
+	s.RunSuite(t, new(BeginLoginSuite))
+	s.RunSuite(t, new(BeginRegistrationSuite))
+	s.RunSuite(t, new(FinishLoginSuite))
+	s.RunSuite(t, new(FinishRegistrationSuite))
+}
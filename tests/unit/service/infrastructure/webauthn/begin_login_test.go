@@ -0,0 +1,60 @@
+package webauthn
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type BeginLoginSuite struct {
+	suite.Suite
+}
+
+func (s *BeginLoginSuite) Test_Success(t provider.T) {
+	t.Title("Returns request options for discoverable passkeys")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("BeginLogin")
+	t.Tags("Positive")
+
+	svc, managerMock, _ := newService()
+
+	var key string
+	managerMock.On("SetWithExpiration", ctx, mock.Anything, mock.Anything, 300*time.Second).
+		Run(func(args mock.Arguments) { key = args.String(1) }).
+		Once().Return(nil)
+
+	resp, err := svc.BeginLogin(ctx)
+
+	t.Require().NoError(err)
+	t.Require().NoError(uuid.Validate(resp.ChallengeID))
+	t.Require().Equal("webauthn.login."+resp.ChallengeID, key)
+	t.Require().Len(resp.PublicKey.Challenge, 43)
+	t.Require().Equal(rpID, resp.PublicKey.RPID)
+	t.Require().Equal(300000, resp.PublicKey.Timeout)
+	t.Require().Equal([]v0.PublicKeyCredentialDescriptor{}, resp.PublicKey.AllowCredentials)
+	t.Require().Equal("required", resp.PublicKey.UserVerification)
+}
+
+func (s *BeginLoginSuite) Test_ErrSaveChallenge(t provider.T) {
+	t.Title("Returns error when challenge is not saved")
+	t.Severity(allure.NORMAL)
+	t.Epic("WebAuthn service")
+	t.Feature("BeginLogin")
+	t.Tags("Negative")
+
+	svc, managerMock, _ := newService()
+	expectedErr := errors.New("cache error")
+
+	managerMock.On("SetWithExpiration", ctx, mock.Anything, mock.Anything, mock.Anything).Once().Return(expectedErr)
+
+	resp, err := svc.BeginLogin(ctx)
+
+	t.Require().ErrorIs(err, expectedErr)
+	t.Require().Equal(v0.PasskeyRequestOptionsOutput{}, resp)
+}
@@ -0,0 +1,114 @@
+package webauthn
+
+import (
+	"encoding/base64"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/webauthn"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"time"
+)
+
+type BeginRegistrationSuite struct {
+	suite.Suite
+}
+
+func (s *BeginRegistrationSuite) Test_Success(t provider.T) {
+	t.Title("Returns creation options with existing passkeys excluded")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("BeginRegistration")
+	t.Tags("Positive")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userEntity := &entity.User{ID: uuid.New(), Username: "user", Email: "user@example.com"}
+	existingPasskey := &entity.Passkey{
+		ID:           uuid.New(),
+		UserID:       userEntity.ID,
+		CredentialID: []byte("credential"),
+		Transports:   lo.ToPtr("usb,nfc"),
+	}
+
+	passkeyRepoMock.On("FindPasskeysByUserID", ctx, userEntity.ID).
+		Once().Return([]*entity.Passkey{existingPasskey}, nil)
+	managerMock.On("SetWithExpiration", ctx, "webauthn.registration."+userEntity.ID.String(), mock.Anything, 300*time.Second).
+		Once().Return(nil)
+
+	resp, err := svc.BeginRegistration(ctx, userEntity)
+
+	t.Require().NoError(err)
+	t.Require().Len(resp.PublicKey.Challenge, 43)
+	t.Require().Equal(v0.PublicKeyCredentialRPEntity{ID: rpID, Name: "Example"}, resp.PublicKey.RP)
+	t.Require().Equal(
+		v0.PublicKeyCredentialUserEntity{
+			ID:          base64.RawURLEncoding.EncodeToString(userEntity.ID[:]),
+			Name:        userEntity.Email,
+			DisplayName: userEntity.Username,
+		},
+		resp.PublicKey.User,
+	)
+	t.Require().Equal(
+		[]v0.PublicKeyCredentialParameters{
+			{Type: "public-key", Alg: webauthn.COSEAlgorithmES256},
+			{Type: "public-key", Alg: webauthn.COSEAlgorithmEdDSA},
+			{Type: "public-key", Alg: webauthn.COSEAlgorithmRS256},
+		},
+		resp.PublicKey.PubKeyCredParams,
+	)
+	t.Require().Equal(
+		[]v0.PublicKeyCredentialDescriptor{
+			{
+				Type:       "public-key",
+				ID:         base64.RawURLEncoding.EncodeToString([]byte("credential")),
+				Transports: []string{"usb", "nfc"},
+			},
+		},
+		resp.PublicKey.ExcludeCredentials,
+	)
+	t.Require().Equal(300000, resp.PublicKey.Timeout)
+	t.Require().Equal("required", resp.PublicKey.AuthenticatorSelection.UserVerification)
+	t.Require().Equal("none", resp.PublicKey.Attestation)
+}
+
+func (s *BeginRegistrationSuite) Test_ErrFindPasskeys(t provider.T) {
+	t.Title("Returns error when passkeys are not loaded")
+	t.Severity(allure.NORMAL)
+	t.Epic("WebAuthn service")
+	t.Feature("BeginRegistration")
+	t.Tags("Negative")
+
+	svc, _, passkeyRepoMock := newService()
+	userEntity := &entity.User{ID: uuid.New()}
+	expectedErr := errors.New("database error")
+
+	passkeyRepoMock.On("FindPasskeysByUserID", ctx, userEntity.ID).Once().Return(nil, expectedErr)
+
+	_, err := svc.BeginRegistration(ctx, userEntity)
+
+	t.Require().ErrorIs(err, expectedErr)
+}
+
+func (s *BeginRegistrationSuite) Test_ErrSaveChallenge(t provider.T) {
+	t.Title("Returns error when challenge is not saved")
+	t.Severity(allure.NORMAL)
+	t.Epic("WebAuthn service")
+	t.Feature("BeginRegistration")
+	t.Tags("Negative")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userEntity := &entity.User{ID: uuid.New()}
+	expectedErr := errors.New("cache error")
+
+	passkeyRepoMock.On("FindPasskeysByUserID", ctx, userEntity.ID).Once().Return([]*entity.Passkey{}, nil)
+	managerMock.On("SetWithExpiration", ctx, mock.Anything, mock.Anything, mock.Anything).Once().Return(expectedErr)
+
+	_, err := svc.BeginRegistration(ctx, userEntity)
+
+	t.Require().ErrorIs(err, expectedErr)
+}
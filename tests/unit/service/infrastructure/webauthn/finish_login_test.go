@@ -0,0 +1,345 @@
+package webauthn
+
+import (
+	"context"
+	"errors"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache/memory"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	mock2 "github.com/mandarine-io/backend/internal/persistence/repo/mock"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/webauthn"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type FinishLoginSuite struct {
+	suite.Suite
+}
+
+// slowDeleteManager delays deletion, so concurrent requests surely read the same challenge before it is deleted
+type slowDeleteManager struct {
+	cache.Manager
+}
+
+func (m slowDeleteManager) Delete(ctx context.Context, keys ...string) error {
+	time.Sleep(50 * time.Millisecond)
+	return m.Manager.Delete(ctx, keys...)
+}
+
+// beginLogin starts login and returns challenge ID with challenge for authenticator
+func beginLogin(t provider.T, svc infrastructure.WebAuthnService) (string, string) {
+	options, err := svc.BeginLogin(ctx)
+	t.Require().NoError(err)
+
+	return options.ChallengeID, options.PublicKey.Challenge
+}
+
+func (s *FinishLoginSuite) Test_Success(t provider.T) {
+	t.Title("Returns passkey for valid assertion")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishLogin")
+	t.Tags("Positive")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userID := uuid.New()
+	authenticator := newAuthenticator(t)
+	passkey := authenticator.passkey(userID)
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeyByCredentialID", ctx, authenticator.credentialID).Once().Return(passkey, nil)
+	passkeyRepoMock.On("UpdatePasskeySignCount", ctx, passkey.ID, uint32(0), uint32(1)).Once().Return(true, nil)
+
+	challengeID, challenge := beginLogin(t, svc)
+	input := v0.PasskeyLoginInput{ChallengeID: challengeID, Credential: authenticator.get(challenge, userID)}
+	resp, err := svc.FinishLogin(ctx, input)
+
+	t.Require().NoError(err)
+	t.Require().Equal(passkey.ID, resp.ID)
+	t.Require().Equal(userID, resp.UserID)
+	t.Require().Equal(uint32(1), resp.SignCount)
+	passkeyRepoMock.AssertExpectations(t)
+	managerMock.AssertExpectations(t)
+}
+
+func (s *FinishLoginSuite) Test_SuccessEd25519(t provider.T) {
+	t.Title("Returns passkey for valid Ed25519 assertion")
+	t.Severity(allure.NORMAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishLogin")
+	t.Tags("Positive")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userID := uuid.New()
+	authenticator := newEd25519Authenticator(t)
+	passkey := authenticator.passkey(userID)
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeyByCredentialID", ctx, authenticator.credentialID).Once().Return(passkey, nil)
+	passkeyRepoMock.On("UpdatePasskeySignCount", ctx, passkey.ID, uint32(0), uint32(1)).Once().Return(true, nil)
+
+	challengeID, challenge := beginLogin(t, svc)
+	input := v0.PasskeyLoginInput{ChallengeID: challengeID, Credential: authenticator.get(challenge, userID)}
+	_, err := svc.FinishLogin(ctx, input)
+
+	t.Require().NoError(err)
+}
+
+func (s *FinishLoginSuite) Test_ErrReplayedChallenge(t provider.T) {
+	t.Title("Returns InvalidOrExpiredChallenge error when challenge is already used")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishLogin")
+	t.Tags("Negative")
+
+	svc, managerMock, _ := newService()
+	userID := uuid.New()
+	authenticator := newAuthenticator(t)
+	challengeID := uuid.New().String()
+
+	managerMock.On("Get", ctx, "webauthn.login."+challengeID, mock.Anything).
+		Once().Return(cache.ErrCacheEntryNotFound)
+
+	input := v0.PasskeyLoginInput{ChallengeID: challengeID, Credential: authenticator.get("challenge", userID)}
+	_, err := svc.FinishLogin(ctx, input)
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidOrExpiredChallenge)
+}
+
+func (s *FinishLoginSuite) Test_ErrConsumedChallenge(t provider.T) {
+	t.Title("Returns InvalidOrExpiredChallenge error for challenge, which is consumed by concurrent request")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishLogin")
+	t.Tags("Negative")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userID := uuid.New()
+	authenticator := newAuthenticator(t)
+	challengeID := uuid.New().String()
+
+	managerMock.On("Get", ctx, "webauthn.login."+challengeID, mock.Anything).
+		Run(
+			func(args mock.Arguments) {
+				*args.Get(2).(*string) = "challenge"
+			},
+		).Once().Return(nil)
+	managerMock.On("Increment", ctx, "webauthn.consumed.challenge", mock.Anything).Once().Return(int64(2), nil)
+
+	input := v0.PasskeyLoginInput{ChallengeID: challengeID, Credential: authenticator.get("challenge", userID)}
+	_, err := svc.FinishLogin(ctx, input)
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidOrExpiredChallenge)
+	managerMock.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	passkeyRepoMock.AssertNotCalled(t, "FindPasskeyByCredentialID", mock.Anything, mock.Anything)
+}
+
+func (s *FinishLoginSuite) Test_ConcurrentChallengeUse(t provider.T) {
+	t.Title("Accepts only one of concurrent assertions for the same challenge")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishLogin")
+	t.Tags("Negative")
+
+	manager, err := memory.NewManager()
+	t.Require().NoError(err)
+
+	passkeyRepoMock := new(mock2.PasskeyRepositoryMock)
+	svc := webauthn.NewService(slowDeleteManager{Manager: manager}, passkeyRepoMock, cfg)
+	userID := uuid.New()
+	authenticator := newAuthenticator(t)
+	passkey := authenticator.passkey(userID)
+
+	// Every request reads its own passkey, so only challenge prevents replay of the same assertion
+	passkeyRepoMock.On("FindPasskeyByCredentialID", ctx, authenticator.credentialID).Maybe().Return(
+		func(context.Context, []byte) (*entity.Passkey, error) {
+			passkeyCopy := *passkey
+			return &passkeyCopy, nil
+		},
+	)
+	passkeyRepoMock.On("UpdatePasskeySignCount", ctx, passkey.ID, uint32(0), uint32(1)).Maybe().Return(true, nil)
+
+	challengeID, challenge := beginLogin(t, svc)
+	input := v0.PasskeyLoginInput{ChallengeID: challengeID, Credential: authenticator.get(challenge, userID)}
+
+	const requests = 10
+	var (
+		wg        sync.WaitGroup
+		successes atomic.Int32
+	)
+	for range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if _, err := svc.FinishLogin(ctx, input); err == nil {
+				successes.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	t.Require().Equal(int32(1), successes.Load())
+}
+
+func (s *FinishLoginSuite) Test_ErrUnknownCredential(t provider.T) {
+	t.Title("Returns InvalidPasskey error for unknown credential")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishLogin")
+	t.Tags("Negative")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	authenticator := newAuthenticator(t)
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeyByCredentialID", ctx, authenticator.credentialID).Once().Return(nil, nil)
+
+	challengeID, challenge := beginLogin(t, svc)
+	input := v0.PasskeyLoginInput{ChallengeID: challengeID, Credential: authenticator.get(challenge, uuid.New())}
+	_, err := svc.FinishLogin(ctx, input)
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidPasskey)
+}
+
+func (s *FinishLoginSuite) Test_ErrUserHandleMismatch(t provider.T) {
+	t.Title("Returns InvalidPasskey error when user handle belongs to another user")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishLogin")
+	t.Tags("Negative")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	authenticator := newAuthenticator(t)
+	passkey := authenticator.passkey(uuid.New())
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeyByCredentialID", ctx, authenticator.credentialID).Once().Return(passkey, nil)
+
+	challengeID, challenge := beginLogin(t, svc)
+	input := v0.PasskeyLoginInput{ChallengeID: challengeID, Credential: authenticator.get(challenge, uuid.New())}
+	_, err := svc.FinishLogin(ctx, input)
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidPasskey)
+}
+
+func (s *FinishLoginSuite) Test_ErrWrongChallenge(t provider.T) {
+	t.Title("Returns InvalidPasskey error when assertion is signed for another challenge")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishLogin")
+	t.Tags("Negative")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userID := uuid.New()
+	authenticator := newAuthenticator(t)
+	passkey := authenticator.passkey(userID)
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeyByCredentialID", ctx, authenticator.credentialID).Once().Return(passkey, nil)
+
+	challengeID, _ := beginLogin(t, svc)
+	input := v0.PasskeyLoginInput{ChallengeID: challengeID, Credential: authenticator.get("another-challenge", userID)}
+	_, err := svc.FinishLogin(ctx, input)
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidPasskey)
+}
+
+func (s *FinishLoginSuite) Test_ErrInvalidSignature(t provider.T) {
+	t.Title("Returns InvalidPasskey error when assertion is signed by another key")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishLogin")
+	t.Tags("Negative")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userID := uuid.New()
+	authenticator := newAuthenticator(t)
+	passkey := authenticator.passkey(userID)
+	passkey.PublicKey = newAuthenticator(t).publicKey()
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeyByCredentialID", ctx, authenticator.credentialID).Once().Return(passkey, nil)
+
+	challengeID, challenge := beginLogin(t, svc)
+	input := v0.PasskeyLoginInput{ChallengeID: challengeID, Credential: authenticator.get(challenge, userID)}
+	_, err := svc.FinishLogin(ctx, input)
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidPasskey)
+}
+
+func (s *FinishLoginSuite) Test_ErrSignCountRegression(t provider.T) {
+	t.Title("Returns InvalidPasskey error when signature counter is not increased")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishLogin")
+	t.Tags("Negative")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userID := uuid.New()
+	authenticator := newAuthenticator(t)
+	passkey := authenticator.passkey(userID)
+	passkey.SignCount = 5
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeyByCredentialID", ctx, authenticator.credentialID).Once().Return(passkey, nil)
+
+	challengeID, challenge := beginLogin(t, svc)
+	input := v0.PasskeyLoginInput{ChallengeID: challengeID, Credential: authenticator.get(challenge, userID)}
+	_, err := svc.FinishLogin(ctx, input)
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidPasskey)
+	passkeyRepoMock.AssertNotCalled(t, "UpdatePasskeySignCount", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *FinishLoginSuite) Test_ErrConcurrentUse(t provider.T) {
+	t.Title("Returns InvalidPasskey error when signature counter is changed concurrently")
+	t.Severity(allure.NORMAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishLogin")
+	t.Tags("Negative")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userID := uuid.New()
+	authenticator := newAuthenticator(t)
+	passkey := authenticator.passkey(userID)
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeyByCredentialID", ctx, authenticator.credentialID).Once().Return(passkey, nil)
+	passkeyRepoMock.On("UpdatePasskeySignCount", ctx, passkey.ID, uint32(0), uint32(1)).Once().Return(false, nil)
+
+	challengeID, challenge := beginLogin(t, svc)
+	input := v0.PasskeyLoginInput{ChallengeID: challengeID, Credential: authenticator.get(challenge, userID)}
+	_, err := svc.FinishLogin(ctx, input)
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidPasskey)
+}
+
+func (s *FinishLoginSuite) Test_ErrFindPasskey(t provider.T) {
+	t.Title("Returns repository error")
+	t.Severity(allure.NORMAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishLogin")
+	t.Tags("Negative")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	authenticator := newAuthenticator(t)
+	expectedErr := errors.New("database error")
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeyByCredentialID", ctx, authenticator.credentialID).Once().Return(nil, expectedErr)
+
+	challengeID, challenge := beginLogin(t, svc)
+	input := v0.PasskeyLoginInput{ChallengeID: challengeID, Credential: authenticator.get(challenge, uuid.New())}
+	_, err := svc.FinishLogin(ctx, input)
+
+	t.Require().ErrorIs(err, expectedErr)
+}
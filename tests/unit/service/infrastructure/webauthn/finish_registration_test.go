@@ -0,0 +1,206 @@
+package webauthn
+
+import (
+	"bytes"
+	"context"
+	"github.com/google/uuid"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/persistence/repo"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/webauthn"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+)
+
+type FinishRegistrationSuite struct {
+	suite.Suite
+}
+
+// beginRegistration starts registration and returns challenge for authenticator
+func beginRegistration(
+	t provider.T,
+	svc infrastructure.WebAuthnService,
+	userEntity *entity.User,
+) string {
+	options, err := svc.BeginRegistration(ctx, userEntity)
+	t.Require().NoError(err)
+
+	return options.PublicKey.Challenge
+}
+
+func (s *FinishRegistrationSuite) Test_Success(t provider.T) {
+	t.Title("Saves passkey created by authenticator")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishRegistration")
+	t.Tags("Positive")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userEntity := &entity.User{ID: uuid.New()}
+	authenticator := newAuthenticator(t)
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeysByUserID", ctx, userEntity.ID).Once().Return([]*entity.Passkey{}, nil)
+	passkeyRepoMock.On(
+		"CreatePasskey", ctx, mock.MatchedBy(
+			func(passkey *entity.Passkey) bool {
+				return passkey.UserID == userEntity.ID &&
+					bytes.Equal(passkey.CredentialID, authenticator.credentialID) &&
+					bytes.Equal(passkey.PublicKey, authenticator.publicKey()) &&
+					passkey.Algorithm == webauthn.COSEAlgorithmES256 &&
+					passkey.Name == "MacBook" &&
+					*passkey.AAGUID == authenticator.aaguid &&
+					*passkey.Transports == "internal,hybrid"
+			},
+		),
+	).Once().Return(func(_ context.Context, passkey *entity.Passkey) (*entity.Passkey, error) { return passkey, nil })
+
+	challenge := beginRegistration(t, svc, userEntity)
+	input := v0.PasskeyRegistrationInput{Name: "MacBook", Credential: authenticator.create(challenge)}
+	passkey, err := svc.FinishRegistration(ctx, userEntity, input)
+
+	t.Require().NoError(err)
+	t.Require().NotNil(passkey)
+	t.Require().Equal(uint32(0), passkey.SignCount)
+	passkeyRepoMock.AssertExpectations(t)
+	managerMock.AssertExpectations(t)
+}
+
+func (s *FinishRegistrationSuite) Test_SuccessEd25519(t provider.T) {
+	t.Title("Saves Ed25519 passkey with default name")
+	t.Severity(allure.NORMAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishRegistration")
+	t.Tags("Positive")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userEntity := &entity.User{ID: uuid.New()}
+	authenticator := newEd25519Authenticator(t)
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeysByUserID", ctx, userEntity.ID).Once().Return([]*entity.Passkey{}, nil)
+	passkeyRepoMock.On(
+		"CreatePasskey", ctx, mock.MatchedBy(
+			func(passkey *entity.Passkey) bool {
+				return passkey.Algorithm == webauthn.COSEAlgorithmEdDSA && passkey.Name == "Passkey"
+			},
+		),
+	).Once().Return(func(_ context.Context, passkey *entity.Passkey) (*entity.Passkey, error) { return passkey, nil })
+
+	challenge := beginRegistration(t, svc, userEntity)
+	input := v0.PasskeyRegistrationInput{Credential: authenticator.create(challenge)}
+	_, err := svc.FinishRegistration(ctx, userEntity, input)
+
+	t.Require().NoError(err)
+}
+
+func (s *FinishRegistrationSuite) Test_ErrExpiredChallenge(t provider.T) {
+	t.Title("Returns InvalidOrExpiredChallenge error when challenge is missing")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishRegistration")
+	t.Tags("Negative")
+
+	svc, managerMock, _ := newService()
+	userEntity := &entity.User{ID: uuid.New()}
+	authenticator := newAuthenticator(t)
+
+	managerMock.On("Get", ctx, "webauthn.registration."+userEntity.ID.String(), mock.Anything).
+		Once().Return(cache.ErrCacheEntryNotFound)
+
+	input := v0.PasskeyRegistrationInput{Credential: authenticator.create("challenge")}
+	_, err := svc.FinishRegistration(ctx, userEntity, input)
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidOrExpiredChallenge)
+}
+
+func (s *FinishRegistrationSuite) Test_ErrWrongChallenge(t provider.T) {
+	t.Title("Returns InvalidPasskey error when client data contains another challenge")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishRegistration")
+	t.Tags("Negative")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userEntity := &entity.User{ID: uuid.New()}
+	authenticator := newAuthenticator(t)
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeysByUserID", ctx, userEntity.ID).Once().Return([]*entity.Passkey{}, nil)
+
+	_ = beginRegistration(t, svc, userEntity)
+	input := v0.PasskeyRegistrationInput{Credential: authenticator.create("another-challenge")}
+	_, err := svc.FinishRegistration(ctx, userEntity, input)
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidPasskey)
+}
+
+func (s *FinishRegistrationSuite) Test_ErrWrongOrigin(t provider.T) {
+	t.Title("Returns InvalidPasskey error for unknown origin")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishRegistration")
+	t.Tags("Negative")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userEntity := &entity.User{ID: uuid.New()}
+	authenticator := newAuthenticator(t)
+	authenticator.origin = "https://evil.com"
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeysByUserID", ctx, userEntity.ID).Once().Return([]*entity.Passkey{}, nil)
+
+	challenge := beginRegistration(t, svc, userEntity)
+	input := v0.PasskeyRegistrationInput{Credential: authenticator.create(challenge)}
+	_, err := svc.FinishRegistration(ctx, userEntity, input)
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidPasskey)
+}
+
+func (s *FinishRegistrationSuite) Test_ErrWrongRPID(t provider.T) {
+	t.Title("Returns InvalidPasskey error when passkey is created for another relying party")
+	t.Severity(allure.CRITICAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishRegistration")
+	t.Tags("Negative")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userEntity := &entity.User{ID: uuid.New()}
+	authenticator := newAuthenticator(t)
+	authenticator.rpID = "evil.com"
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeysByUserID", ctx, userEntity.ID).Once().Return([]*entity.Passkey{}, nil)
+
+	challenge := beginRegistration(t, svc, userEntity)
+	input := v0.PasskeyRegistrationInput{Credential: authenticator.create(challenge)}
+	_, err := svc.FinishRegistration(ctx, userEntity, input)
+
+	t.Require().ErrorIs(err, infrastructure.ErrInvalidPasskey)
+}
+
+func (s *FinishRegistrationSuite) Test_ErrDuplicatePasskey(t provider.T) {
+	t.Title("Returns DuplicatePasskey error when credential is already registered")
+	t.Severity(allure.NORMAL)
+	t.Epic("WebAuthn service")
+	t.Feature("FinishRegistration")
+	t.Tags("Negative")
+
+	svc, managerMock, passkeyRepoMock := newService()
+	userEntity := &entity.User{ID: uuid.New()}
+	authenticator := newAuthenticator(t)
+
+	expectChallenge(managerMock)
+	passkeyRepoMock.On("FindPasskeysByUserID", ctx, userEntity.ID).Once().Return([]*entity.Passkey{}, nil)
+	passkeyRepoMock.On("CreatePasskey", ctx, mock.Anything).Once().Return(nil, repo.ErrDuplicatePasskey)
+
+	challenge := beginRegistration(t, svc, userEntity)
+	input := v0.PasskeyRegistrationInput{Credential: authenticator.create(challenge)}
+	_, err := svc.FinishRegistration(ctx, userEntity, input)
+
+	t.Require().ErrorIs(err, infrastructure.ErrDuplicatePasskey)
+}
@@ -0,0 +1,240 @@
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"github.com/google/uuid"
+	mock1 "github.com/mandarine-io/backend/internal/infrastructure/cache/mock"
+	"github.com/mandarine-io/backend/internal/persistence/entity"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/webauthn"
+	"github.com/mandarine-io/backend/pkg/model/v0"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/stretchr/testify/mock"
+	"github.com/ugorji/go/codec"
+	"time"
+)
+
+// authenticator is a software authenticator, which creates and uses passkeys like a browser does
+type authenticator struct {
+	t            provider.T
+	rpID         string
+	origin       string
+	credentialID []byte
+	aaguid       uuid.UUID
+	privateKey   crypto.Signer
+	signCount    uint32
+}
+
+func newAuthenticator(t provider.T) *authenticator {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	t.Require().NoError(err)
+
+	return newAuthenticatorWithKey(t, privateKey)
+}
+
+func newEd25519Authenticator(t provider.T) *authenticator {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	t.Require().NoError(err)
+
+	return newAuthenticatorWithKey(t, privateKey)
+}
+
+func newAuthenticatorWithKey(t provider.T, privateKey crypto.Signer) *authenticator {
+	credentialID := make([]byte, 16)
+	_, err := rand.Read(credentialID)
+	t.Require().NoError(err)
+
+	return &authenticator{
+		t:            t,
+		rpID:         rpID,
+		origin:       origin,
+		credentialID: credentialID,
+		aaguid:       uuid.New(),
+		privateKey:   privateKey,
+	}
+}
+
+func (a *authenticator) encodedCredentialID() string {
+	return base64.RawURLEncoding.EncodeToString(a.credentialID)
+}
+
+func (a *authenticator) publicKey() []byte {
+	var coseKey map[int]interface{}
+	switch key := a.privateKey.Public().(type) {
+	case *ecdsa.PublicKey:
+		coseKey = map[int]interface{}{
+			1:  2,
+			3:  webauthn.COSEAlgorithmES256,
+			-1: 1,
+			-2: key.X.FillBytes(make([]byte, 32)),
+			-3: key.Y.FillBytes(make([]byte, 32)),
+		}
+	case ed25519.PublicKey:
+		coseKey = map[int]interface{}{
+			1:  1,
+			3:  webauthn.COSEAlgorithmEdDSA,
+			-1: 6,
+			-2: []byte(key),
+		}
+	}
+
+	return encodeCBOR(a.t, coseKey)
+}
+
+func (a *authenticator) authenticatorData(withCredential bool) []byte {
+	rpIDHash := sha256.Sum256([]byte(a.rpID))
+
+	// User present and user verified
+	flags := byte(0x05)
+	if withCredential {
+		flags |= 0x40
+	}
+
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+	if withCredential {
+		data = append(data, a.aaguid[:]...)
+		data = binary.BigEndian.AppendUint16(data, uint16(len(a.credentialID)))
+		data = append(data, a.credentialID...)
+		data = append(data, a.publicKey()...)
+	}
+
+	return data
+}
+
+func (a *authenticator) clientData(typ string, challenge string) []byte {
+	data, err := json.Marshal(
+		map[string]interface{}{
+			"type":      typ,
+			"challenge": challenge,
+			"origin":    a.origin,
+		},
+	)
+	a.t.Require().NoError(err)
+
+	return data
+}
+
+// create emulates navigator.credentials.create() with attestation "none"
+func (a *authenticator) create(challenge string) v0.PasskeyAttestationCredential {
+	attestationObject := encodeCBOR(
+		a.t,
+		map[string]interface{}{
+			"fmt":      "none",
+			"attStmt":  map[string]interface{}{},
+			"authData": a.authenticatorData(true),
+		},
+	)
+
+	return v0.PasskeyAttestationCredential{
+		ID:    a.encodedCredentialID(),
+		RawID: a.encodedCredentialID(),
+		Type:  "public-key",
+		Response: v0.AuthenticatorAttestationResponse{
+			ClientDataJSON:    base64.RawURLEncoding.EncodeToString(a.clientData("webauthn.create", challenge)),
+			AttestationObject: base64.RawURLEncoding.EncodeToString(attestationObject),
+			Transports:        []string{"internal", "hybrid"},
+		},
+	}
+}
+
+// get emulates navigator.credentials.get() for discoverable credential
+func (a *authenticator) get(challenge string, userID uuid.UUID) v0.PasskeyAssertionCredential {
+	a.signCount++
+
+	authData := a.authenticatorData(false)
+	clientDataJSON := a.clientData("webauthn.get", challenge)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signedData := append(append([]byte{}, authData...), clientDataHash[:]...)
+
+	var (
+		signature []byte
+		err       error
+	)
+	switch key := a.privateKey.(type) {
+	case *ecdsa.PrivateKey:
+		digest := sha256.Sum256(signedData)
+		signature, err = ecdsa.SignASN1(rand.Reader, key, digest[:])
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(key, signedData)
+	}
+	a.t.Require().NoError(err)
+
+	return v0.PasskeyAssertionCredential{
+		ID:    a.encodedCredentialID(),
+		RawID: a.encodedCredentialID(),
+		Type:  "public-key",
+		Response: v0.AuthenticatorAssertionResponse{
+			ClientDataJSON:    base64.RawURLEncoding.EncodeToString(clientDataJSON),
+			AuthenticatorData: base64.RawURLEncoding.EncodeToString(authData),
+			Signature:         base64.RawURLEncoding.EncodeToString(signature),
+			UserHandle:        base64.RawURLEncoding.EncodeToString(userID[:]),
+		},
+	}
+}
+
+// passkey returns entity, which is stored after registration
+func (a *authenticator) passkey(userID uuid.UUID) *entity.Passkey {
+	alg := webauthn.COSEAlgorithmES256
+	if _, ok := a.privateKey.(ed25519.PrivateKey); ok {
+		alg = webauthn.COSEAlgorithmEdDSA
+	}
+
+	return &entity.Passkey{
+		ID:           uuid.New(),
+		UserID:       userID,
+		CredentialID: a.credentialID,
+		PublicKey:    a.publicKey(),
+		Algorithm:    alg,
+		SignCount:    a.signCount,
+		Name:         "Passkey",
+		CreatedAt:    time.Now(),
+	}
+}
+
+func encodeCBOR(t provider.T, v any) []byte {
+	// Canonical encoding makes bytes of the same key stable between calls
+	h := &codec.CborHandle{}
+	h.Canonical = true
+
+	var data []byte
+	err := codec.NewEncoderBytes(&data, h).Encode(v)
+	t.Require().NoError(err)
+
+	return data
+}
+
+// expectChallenge makes cache mock keep challenge, which is saved on begin and read once on finish
+func expectChallenge(managerMock *mock1.ManagerMock) {
+	var (
+		key       string
+		challenge string
+	)
+
+	ttl := time.Duration(cfg.Security.WebAuthn.ChallengeTTL) * time.Second
+	managerMock.On("SetWithExpiration", ctx, mock.Anything, mock.Anything, ttl).
+		Run(
+			func(args mock.Arguments) {
+				key = args.String(1)
+				challenge = args.String(2)
+			},
+		).Return(nil)
+	managerMock.On("Get", ctx, mock.Anything, mock.Anything).
+		Run(
+			func(args mock.Arguments) {
+				if args.String(1) == key {
+					*args.Get(2).(*string) = challenge
+				}
+			},
+		).Once().Return(nil)
+	managerMock.On("Increment", ctx, mock.Anything, ttl).Once().Return(int64(1), nil)
+	managerMock.On("Delete", ctx, mock.Anything).Once().Return(nil)
+}
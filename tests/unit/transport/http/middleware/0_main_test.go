@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"testing"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+type MiddlewareSuite struct {
+	suite.Suite
+}
+
+func TestMiddlewareSuite(t *testing.T) {
+	suite.RunSuite(t, new(MiddlewareSuite))
+}
+
+func (s *MiddlewareSuite) Test(t provider.T) {
+	s.RunSuite(t, new(MFAUserMiddlewareSuite))
+	s.RunSuite(t, new(RateLimitMiddlewareSuite))
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mandarine-io/backend/internal/transport/http/middleware"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"net/http"
+	"net/http/httptest"
+)
+
+type MFAUserMiddlewareSuite struct {
+	suite.Suite
+}
+
+// sendMFAUserRequest sends request on behalf of authUser to route protected by MFAUserMiddleware
+func sendMFAUserRequest(authUser middleware.AuthUser) int {
+	router := gin.New()
+	router.GET(
+		"/test",
+		func(c *gin.Context) {
+			c.Set(middleware.AuthUserKey, authUser)
+		},
+		middleware.MFAUserMiddleware(),
+		func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		},
+	)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+
+	return w.Code
+}
+
+func (s *MFAUserMiddlewareSuite) Test_MFASetupRequired(t provider.T) {
+	t.Title("MFAUserMiddleware denies access, if role of user requires 2FA, but it is not enabled")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Middleware")
+	t.Feature("MFAUserMiddleware")
+	t.Tags("Negative")
+
+	t.Require().Equal(http.StatusForbidden, sendMFAUserRequest(middleware.AuthUser{IsMFASetupRequired: true}))
+}
+
+func (s *MFAUserMiddlewareSuite) Test_MFASetupNotRequired(t provider.T) {
+	t.Title("MFAUserMiddleware allows access, if user does not have to set up 2FA")
+	t.Severity(allure.NORMAL)
+	t.Epic("Middleware")
+	t.Feature("MFAUserMiddleware")
+	t.Tags("Positive")
+
+	t.Require().Equal(http.StatusOK, sendMFAUserRequest(middleware.AuthUser{}))
+}
+
+func (s *MFAUserMiddlewareSuite) Test_NoAuthUser(t provider.T) {
+	t.Title("MFAUserMiddleware denies access, if user is not authenticated")
+	t.Severity(allure.NORMAL)
+	t.Epic("Middleware")
+	t.Feature("MFAUserMiddleware")
+	t.Tags("Negative")
+
+	router := gin.New()
+	router.GET("/test", middleware.MFAUserMiddleware())
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+
+	t.Require().Equal(http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/infrastructure/ratelimit/memory"
+	mock2 "github.com/mandarine-io/backend/internal/observability/mock"
+	mock1 "github.com/mandarine-io/backend/internal/service/infrastructure/mock"
+	http2 "github.com/mandarine-io/backend/internal/transport/http"
+	"github.com/mandarine-io/backend/internal/transport/http/middleware"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+)
+
+type RateLimitMiddlewareSuite struct {
+	suite.Suite
+}
+
+// newRateLimitRouter creates router, which allows one request per minute by client IP
+func newRateLimitRouter(t provider.T, trustedProxies []string) *gin.Engine {
+	limiter, err := memory.NewLimiter()
+	t.Require().NoError(err)
+
+	metricsAdapterMock := new(mock2.MetricsAdapterMock)
+	metricsAdapterMock.On("IncrementRateLimited", mock.Anything, mock.Anything, mock.Anything).Maybe()
+
+	router := gin.New()
+	t.Require().NoError(http2.SetupTrustedProxies(router, trustedProxies))
+	router.Use(
+		middleware.RateLimitMiddleware(
+			limiter,
+			config.RateLimitConfig{
+				Enable:  true,
+				Default: config.RateLimitPolicyConfig{Limit: 1, Period: 60, Key: middleware.IPRateLimitKey},
+			},
+			new(mock1.JWTServiceMock),
+			metricsAdapterMock,
+		),
+	)
+	router.GET(
+		"/test", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		},
+	)
+
+	return router
+}
+
+func sendRequest(router *gin.Engine, remoteAddr string, forwardedFor string) int {
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.RemoteAddr = remoteAddr
+	req.Header.Set("X-Forwarded-For", forwardedFor)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	return w.Code
+}
+
+func (s *RateLimitMiddlewareSuite) Test_SpoofedForwardedFor(t provider.T) {
+	t.Title("RateLimitMiddleware limits client by connection IP, if X-Forwarded-For is sent by untrusted client")
+	t.Severity(allure.CRITICAL)
+	t.Epic("Middleware")
+	t.Feature("RateLimitMiddleware")
+	t.Tags("Negative")
+
+	router := newRateLimitRouter(t, nil)
+
+	t.Require().Equal(http.StatusOK, sendRequest(router, "203.0.113.10:1234", "198.51.100.1"))
+	t.Require().Equal(http.StatusTooManyRequests, sendRequest(router, "203.0.113.10:1234", "198.51.100.2"))
+}
+
+func (s *RateLimitMiddlewareSuite) Test_TrustedProxy(t provider.T) {
+	t.Title("RateLimitMiddleware limits client by X-Forwarded-For, if it is sent by trusted proxy")
+	t.Severity(allure.NORMAL)
+	t.Epic("Middleware")
+	t.Feature("RateLimitMiddleware")
+	t.Tags("Positive")
+
+	router := newRateLimitRouter(t, []string{"10.0.0.0/8"})
+
+	t.Require().Equal(http.StatusOK, sendRequest(router, "10.0.0.2:1234", "198.51.100.1"))
+	t.Require().Equal(http.StatusOK, sendRequest(router, "10.0.0.2:1234", "198.51.100.2"))
+	t.Require().Equal(http.StatusTooManyRequests, sendRequest(router, "10.0.0.2:1234", "198.51.100.2"))
+}
@@ -0,0 +1,24 @@
+package util
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"testing"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+type UtilSuite struct {
+	suite.Suite
+}
+
+func TestUtilSuite(t *testing.T) {
+	suite.RunSuite(t, new(UtilSuite))
+}
+
+func (s *UtilSuite) Test(t provider.T) {
+	s.RunSuite(t, new(GetClientInfoSuite))
+}
@@ -0,0 +1,71 @@
+package util
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/mandarine-io/backend/config"
+	"github.com/mandarine-io/backend/internal/infrastructure/cache/memory"
+	"github.com/mandarine-io/backend/internal/service/infrastructure"
+	"github.com/mandarine-io/backend/internal/service/infrastructure/bruteforce"
+	http2 "github.com/mandarine-io/backend/internal/transport/http"
+	"github.com/mandarine-io/backend/internal/transport/http/util"
+	"github.com/ozontech/allure-go/pkg/allure"
+	"github.com/ozontech/allure-go/pkg/framework/provider"
+	"github.com/ozontech/allure-go/pkg/framework/suite"
+	"net/http"
+	"net/http/httptest"
+)
+
+type GetClientInfoSuite struct {
+	suite.Suite
+}
+
+func (s *GetClientInfoSuite) Test_SpoofedForwardedFor(t provider.T) {
+	t.Title("GetClientInfo ignores X-Forwarded-For of untrusted client, so IP attempts are not reset")
+	t.Severity(allure.CRITICAL)
+	t.Epic("HTTP util")
+	t.Feature("GetClientInfo")
+	t.Tags("Negative")
+
+	manager, err := memory.NewManager()
+	t.Require().NoError(err)
+	cfg := config.BruteForceConfig{
+		MaxAccountAttempts: 100,
+		MaxIPAttempts:      3,
+		AttemptWindow:      900,
+		BaseLockout:        60,
+		MaxLockout:         300,
+	}
+	bruteForceSvc := bruteforce.NewService(manager, cfg)
+
+	router := gin.New()
+	t.Require().NoError(http2.SetupTrustedProxies(router, nil))
+	router.POST(
+		"/login/:account", func(c *gin.Context) {
+			attempt := infrastructure.Attempt{
+				Action:  "login",
+				Account: c.Param("account"),
+				IP:      util.GetClientInfo(c).IP,
+			}
+			if err := bruteForceSvc.FailAttempt(c, attempt); err != nil {
+				c.Status(http.StatusTooManyRequests)
+				return
+			}
+			c.Status(http.StatusUnauthorized)
+		},
+	)
+
+	codes := make([]int, 0, cfg.MaxIPAttempts)
+	for i := 0; i < cfg.MaxIPAttempts; i++ {
+		// Different accounts and forwarded addresses from single connection address
+		req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/login/user%d@example.com", i), nil)
+		req.RemoteAddr = "203.0.113.10:1234"
+		req.Header.Set("X-Forwarded-For", fmt.Sprintf("198.51.100.%d", i))
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		codes = append(codes, w.Code)
+	}
+
+	t.Require().Equal([]int{http.StatusUnauthorized, http.StatusUnauthorized, http.StatusTooManyRequests}, codes)
+}
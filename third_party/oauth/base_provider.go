@@ -2,19 +2,31 @@ package oauth
 
 import (
 	"context"
-	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 	"golang.org/x/oauth2"
 	"io"
 	"net/http"
 )
 
+// Provider is OAuth 2.0 client of social login provider.
+// State and PKCE code verifier are generated by caller, which is responsible for storing and checking them
 type Provider interface {
-	GetConsentPageURL(redirectURL string) (string, string)
-	ExchangeCodeToToken(ctx context.Context, code string, redirectURL string) (*oauth2.Token, error)
+	GetConsentPageURL(ctx context.Context, redirectURL string, state string, codeVerifier string) (string, error)
+	ExchangeCodeToToken(
+		ctx context.Context,
+		code string,
+		redirectURL string,
+		codeVerifier string,
+		opts ...ExchangeOption,
+	) (*oauth2.Token, error)
 	GetUserInfo(ctx context.Context, token *oauth2.Token) (UserInfo, error)
 }
 
+// GenerateCodeVerifier returns new random PKCE code verifier, which must be used only for one authorization request
+func GenerateCodeVerifier() string {
+	return oauth2.GenerateVerifier()
+}
+
 type UnmarshallUserInfo = func(bytes []byte) (UserInfo, error)
 
 type baseProvider struct {
@@ -52,24 +64,31 @@ func NewBaseProvider(
 	return p
 }
 
-func (c *baseProvider) GetConsentPageURL(redirectURL string) (string, string) {
+func (c *baseProvider) GetConsentPageURL(
+	_ context.Context,
+	redirectURL string,
+	state string,
+	codeVerifier string,
+) (string, error) {
 	c.logger.Debug().Msg("get consent page url")
 
-	oauthState := uuid.New().String()
 	redirectURISetter := oauth2.SetAuthURLParam("redirect_uri", redirectURL)
 
-	return c.oauthConfig.AuthCodeURL(oauthState, redirectURISetter), oauthState
+	return c.oauthConfig.AuthCodeURL(state, redirectURISetter, oauth2.S256ChallengeOption(codeVerifier)), nil
 }
 
-func (c *baseProvider) ExchangeCodeToToken(ctx context.Context, code string, redirectURL string) (
-	*oauth2.Token,
-	error,
-) {
+func (c *baseProvider) ExchangeCodeToToken(
+	ctx context.Context,
+	code string,
+	redirectURL string,
+	codeVerifier string,
+	_ ...ExchangeOption,
+) (*oauth2.Token, error) {
 	c.logger.Debug().Msg("exchange code to token")
 
 	redirectURISetter := oauth2.SetAuthURLParam("redirect_uri", redirectURL)
 
-	return c.oauthConfig.Exchange(ctx, code, redirectURISetter)
+	return c.oauthConfig.Exchange(ctx, code, redirectURISetter, oauth2.VerifierOption(codeVerifier))
 }
 
 func (c *baseProvider) GetUserInfo(ctx context.Context, token *oauth2.Token) (UserInfo, error) {
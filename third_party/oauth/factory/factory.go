@@ -5,6 +5,9 @@ import (
 	"github.com/mandarine-io/backend/third_party/oauth"
 	"github.com/mandarine-io/backend/third_party/oauth/google"
 	"github.com/mandarine-io/backend/third_party/oauth/mailru"
+	"github.com/mandarine-io/backend/third_party/oauth/oidc"
+	"github.com/mandarine-io/backend/third_party/oauth/telegram"
+	"github.com/mandarine-io/backend/third_party/oauth/vk"
 	"github.com/mandarine-io/backend/third_party/oauth/yandex"
 )
 
@@ -12,14 +15,39 @@ var (
 	ErrUnsupportedOAuthProvider = errors.New("unsupported OAuth provider")
 )
 
-func NewProviderByKey(key string, clientID string, clientSecret string, opts ...oauth.Option) (oauth.Provider, error) {
+// ProviderConfig describes OAuth client. Issuer, Scopes and Claims are used only by OpenID Connect provider.
+// Telegram Login uses bot token as client secret
+type ProviderConfig struct {
+	ClientID     string
+	ClientSecret string
+	Issuer       string
+	Scopes       []string
+	Claims       oidc.ClaimNames
+}
+
+func NewProviderByKey(key string, cfg ProviderConfig, opts ...oauth.Option) (oauth.Provider, error) {
 	switch key {
 	case google.ProviderKey:
-		return google.NewProvider(clientID, clientSecret, opts...), nil
+		return google.NewProvider(cfg.ClientID, cfg.ClientSecret, opts...), nil
 	case yandex.ProviderKey:
-		return yandex.NewProvider(clientID, clientSecret, opts...), nil
+		return yandex.NewProvider(cfg.ClientID, cfg.ClientSecret, opts...), nil
 	case mailru.ProviderKey:
-		return mailru.NewProvider(clientID, clientSecret, opts...), nil
+		return mailru.NewProvider(cfg.ClientID, cfg.ClientSecret, opts...), nil
+	case vk.ProviderKey:
+		return vk.NewProvider(cfg.ClientID, cfg.ClientSecret, opts...), nil
+	case telegram.ProviderKey:
+		return telegram.NewProvider(cfg.ClientSecret, opts...), nil
+	case oidc.ProviderKey:
+		return oidc.NewProvider(
+			oidc.Config{
+				Issuer:       cfg.Issuer,
+				ClientID:     cfg.ClientID,
+				ClientSecret: cfg.ClientSecret,
+				Scopes:       cfg.Scopes,
+				Claims:       cfg.Claims,
+			},
+			opts...,
+		)
 	default:
 		return nil, ErrUnsupportedOAuthProvider
 	}
@@ -28,6 +28,7 @@ func NewProvider(clientID string, clientSecret string, opts ...oauth.Option) oau
 //////////////////// Marshall User Info ////////////////////
 
 type UserInfo struct {
+	ID            string `json:"id"`
 	Email         string `json:"email"`
 	VerifiedEmail bool   `json:"verified_email"`
 	Name          string `json:"name"`
@@ -40,6 +41,7 @@ func UnmarshalJSON(data []byte) (oauth.UserInfo, error) {
 	}
 
 	return oauth.UserInfo{
+		ID:              userInfo.ID,
 		Username:        userInfo.Name,
 		Email:           userInfo.Email,
 		IsEmailVerified: userInfo.VerifiedEmail,
@@ -24,6 +24,7 @@ func NewProvider(clientID string, clientSecret string, opts ...oauth.Option) oau
 //////////////////// Marshall User Info ////////////////////
 
 type UserInfo struct {
+	ID    string `json:"id"`
 	Email string `json:"email"`
 	Name  string `json:"name"`
 }
@@ -35,6 +36,7 @@ func UnmarshalJSON(data []byte) (oauth.UserInfo, error) {
 	}
 
 	return oauth.UserInfo{
+		ID:              userInfo.ID,
 		Username:        userInfo.Name,
 		Email:           userInfo.Email,
 		IsEmailVerified: true,
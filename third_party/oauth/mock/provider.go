@@ -24,9 +24,16 @@ func (_m *ProviderMock) EXPECT() *ProviderMock_Expecter {
 	return &ProviderMock_Expecter{mock: &_m.Mock}
 }
 
-// ExchangeCodeToToken provides a mock function with given fields: ctx, code, redirectURL
-func (_m *ProviderMock) ExchangeCodeToToken(ctx context.Context, code string, redirectURL string) (*oauth2.Token, error) {
-	ret := _m.Called(ctx, code, redirectURL)
+// ExchangeCodeToToken provides a mock function with given fields: ctx, code, redirectURL, codeVerifier, opts
+func (_m *ProviderMock) ExchangeCodeToToken(ctx context.Context, code string, redirectURL string, codeVerifier string, opts ...oauth.ExchangeOption) (*oauth2.Token, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, code, redirectURL, codeVerifier)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ExchangeCodeToToken")
@@ -34,19 +41,19 @@ func (_m *ProviderMock) ExchangeCodeToToken(ctx context.Context, code string, re
 
 	var r0 *oauth2.Token
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*oauth2.Token, error)); ok {
-		return rf(ctx, code, redirectURL)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, ...oauth.ExchangeOption) (*oauth2.Token, error)); ok {
+		return rf(ctx, code, redirectURL, codeVerifier, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *oauth2.Token); ok {
-		r0 = rf(ctx, code, redirectURL)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, ...oauth.ExchangeOption) *oauth2.Token); ok {
+		r0 = rf(ctx, code, redirectURL, codeVerifier, opts...)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*oauth2.Token)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, code, redirectURL)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, ...oauth.ExchangeOption) error); ok {
+		r1 = rf(ctx, code, redirectURL, codeVerifier, opts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -63,13 +70,22 @@ type ProviderMock_ExchangeCodeToToken_Call struct {
 //   - ctx context.Context
 //   - code string
 //   - redirectURL string
-func (_e *ProviderMock_Expecter) ExchangeCodeToToken(ctx interface{}, code interface{}, redirectURL interface{}) *ProviderMock_ExchangeCodeToToken_Call {
-	return &ProviderMock_ExchangeCodeToToken_Call{Call: _e.mock.On("ExchangeCodeToToken", ctx, code, redirectURL)}
+//   - codeVerifier string
+//   - opts ...oauth.ExchangeOption
+func (_e *ProviderMock_Expecter) ExchangeCodeToToken(ctx interface{}, code interface{}, redirectURL interface{}, codeVerifier interface{}, opts ...interface{}) *ProviderMock_ExchangeCodeToToken_Call {
+	return &ProviderMock_ExchangeCodeToToken_Call{Call: _e.mock.On("ExchangeCodeToToken",
+		append([]interface{}{ctx, code, redirectURL, codeVerifier}, opts...)...)}
 }
 
-func (_c *ProviderMock_ExchangeCodeToToken_Call) Run(run func(ctx context.Context, code string, redirectURL string)) *ProviderMock_ExchangeCodeToToken_Call {
+func (_c *ProviderMock_ExchangeCodeToToken_Call) Run(run func(ctx context.Context, code string, redirectURL string, codeVerifier string, opts ...oauth.ExchangeOption)) *ProviderMock_ExchangeCodeToToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string))
+		variadicArgs := make([]oauth.ExchangeOption, len(args)-4)
+		for i, a := range args[4:] {
+			if a != nil {
+				variadicArgs[i] = a.(oauth.ExchangeOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), variadicArgs...)
 	})
 	return _c
 }
@@ -79,34 +95,34 @@ func (_c *ProviderMock_ExchangeCodeToToken_Call) Return(_a0 *oauth2.Token, _a1 e
 	return _c
 }
 
-func (_c *ProviderMock_ExchangeCodeToToken_Call) RunAndReturn(run func(context.Context, string, string) (*oauth2.Token, error)) *ProviderMock_ExchangeCodeToToken_Call {
+func (_c *ProviderMock_ExchangeCodeToToken_Call) RunAndReturn(run func(context.Context, string, string, string, ...oauth.ExchangeOption) (*oauth2.Token, error)) *ProviderMock_ExchangeCodeToToken_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetConsentPageURL provides a mock function with given fields: redirectURL
-func (_m *ProviderMock) GetConsentPageURL(redirectURL string) (string, string) {
-	ret := _m.Called(redirectURL)
+// GetConsentPageURL provides a mock function with given fields: ctx, redirectURL, state, codeVerifier
+func (_m *ProviderMock) GetConsentPageURL(ctx context.Context, redirectURL string, state string, codeVerifier string) (string, error) {
+	ret := _m.Called(ctx, redirectURL, state, codeVerifier)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetConsentPageURL")
 	}
 
 	var r0 string
-	var r1 string
-	if rf, ok := ret.Get(0).(func(string) (string, string)); ok {
-		return rf(redirectURL)
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return rf(ctx, redirectURL, state, codeVerifier)
 	}
-	if rf, ok := ret.Get(0).(func(string) string); ok {
-		r0 = rf(redirectURL)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, redirectURL, state, codeVerifier)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) string); ok {
-		r1 = rf(redirectURL)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, redirectURL, state, codeVerifier)
 	} else {
-		r1 = ret.Get(1).(string)
+		r1 = ret.Error(1)
 	}
 
 	return r0, r1
@@ -118,24 +134,27 @@ type ProviderMock_GetConsentPageURL_Call struct {
 }
 
 // GetConsentPageURL is a helper method to define mock.On call
+//   - ctx context.Context
 //   - redirectURL string
-func (_e *ProviderMock_Expecter) GetConsentPageURL(redirectURL interface{}) *ProviderMock_GetConsentPageURL_Call {
-	return &ProviderMock_GetConsentPageURL_Call{Call: _e.mock.On("GetConsentPageURL", redirectURL)}
+//   - state string
+//   - codeVerifier string
+func (_e *ProviderMock_Expecter) GetConsentPageURL(ctx interface{}, redirectURL interface{}, state interface{}, codeVerifier interface{}) *ProviderMock_GetConsentPageURL_Call {
+	return &ProviderMock_GetConsentPageURL_Call{Call: _e.mock.On("GetConsentPageURL", ctx, redirectURL, state, codeVerifier)}
 }
 
-func (_c *ProviderMock_GetConsentPageURL_Call) Run(run func(redirectURL string)) *ProviderMock_GetConsentPageURL_Call {
+func (_c *ProviderMock_GetConsentPageURL_Call) Run(run func(ctx context.Context, redirectURL string, state string, codeVerifier string)) *ProviderMock_GetConsentPageURL_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *ProviderMock_GetConsentPageURL_Call) Return(_a0 string, _a1 string) *ProviderMock_GetConsentPageURL_Call {
+func (_c *ProviderMock_GetConsentPageURL_Call) Return(_a0 string, _a1 error) *ProviderMock_GetConsentPageURL_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ProviderMock_GetConsentPageURL_Call) RunAndReturn(run func(string) (string, string)) *ProviderMock_GetConsentPageURL_Call {
+func (_c *ProviderMock_GetConsentPageURL_Call) RunAndReturn(run func(context.Context, string, string, string) (string, error)) *ProviderMock_GetConsentPageURL_Call {
 	_c.Call.Return(run)
 	return _c
 }
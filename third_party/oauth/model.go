@@ -1,12 +1,60 @@
 package oauth
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
+
+// MaxCodeAge is the longest period, during which providers accept authorization code or signed auth data.
+// Caller keeps tokens of used codes at least for this period to reject their replay
+const MaxCodeAge = 20 * time.Minute
 
 var (
 	ErrUserInfoNotReceived = errors.New("user info not received")
 )
 
+// ExchangeParams are parameters of redirect to callback, which some providers require to exchange code to token
+type ExchangeParams struct {
+	State         string
+	StateIssuedAt time.Time
+	DeviceID      string
+}
+
+type ExchangeOption func(*ExchangeParams)
+
+func WithState(state string) ExchangeOption {
+	return func(p *ExchangeParams) {
+		p.State = state
+	}
+}
+
+// WithStateIssuedAt passes issuance time of state, providers without state in signed data
+// reject auth data created before it
+func WithStateIssuedAt(issuedAt time.Time) ExchangeOption {
+	return func(p *ExchangeParams) {
+		p.StateIssuedAt = issuedAt
+	}
+}
+
+func WithDeviceID(deviceID string) ExchangeOption {
+	return func(p *ExchangeParams) {
+		p.DeviceID = deviceID
+	}
+}
+
+func NewExchangeParams(opts ...ExchangeOption) ExchangeParams {
+	var params ExchangeParams
+	for _, opt := range opts {
+		opt(&params)
+	}
+
+	return params
+}
+
+// UserInfo describes user of OAuth provider, ID is the stable subject identifier within the provider.
+// Email is empty, if provider does not share it
 type UserInfo struct {
+	ID              string
 	Username        string
 	Email           string
 	IsEmailVerified bool
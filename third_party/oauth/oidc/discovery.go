@@ -0,0 +1,57 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const discoveryPath = "/.well-known/openid-configuration"
+
+// discoveryDocument is part of OpenID Provider Metadata, which is used by provider
+type discoveryDocument struct {
+	Issuer      string   `json:"issuer"`
+	AuthURL     string   `json:"authorization_endpoint"`
+	TokenURL    string   `json:"token_endpoint"`
+	UserInfoURL string   `json:"userinfo_endpoint"`
+	JWKSURL     string   `json:"jwks_uri"`
+	SigningAlgs []string `json:"id_token_signing_alg_values_supported"`
+}
+
+func discover(ctx context.Context, client *http.Client, issuer string) (discoveryDocument, error) {
+	wellKnownURL := strings.TrimSuffix(issuer, "/") + discoveryPath
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, wellKnownURL, nil)
+	if err != nil {
+		return discoveryDocument{}, err
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return discoveryDocument{}, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return discoveryDocument{}, fmt.Errorf("%w: status %d", ErrDiscoveryFailed, res.StatusCode)
+	}
+
+	var doc discoveryDocument
+	if err := json.NewDecoder(res.Body).Decode(&doc); err != nil {
+		return discoveryDocument{}, err
+	}
+
+	// Issuer must be exactly the same, otherwise tokens of another issuer could be accepted
+	if doc.Issuer != issuer {
+		return discoveryDocument{}, fmt.Errorf("%w: issuer %s does not match %s", ErrDiscoveryFailed, doc.Issuer, issuer)
+	}
+	if doc.AuthURL == "" || doc.TokenURL == "" || doc.JWKSURL == "" {
+		return discoveryDocument{}, fmt.Errorf("%w: required endpoints are missing", ErrDiscoveryFailed)
+	}
+
+	return doc, nil
+}
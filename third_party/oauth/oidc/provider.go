@@ -0,0 +1,323 @@
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/mandarine-io/backend/third_party/oauth"
+	"github.com/samber/lo"
+	"golang.org/x/oauth2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	ProviderKey = "oidc"
+
+	requestTimeout         = 10 * time.Second
+	discoveryRetryInterval = 10 * time.Second
+	leeway                 = time.Minute
+)
+
+var (
+	ErrDiscoveryFailed = errors.New("OpenID Connect discovery failed")
+	ErrJWKSNotReceived = errors.New("JWKS not received")
+	ErrInvalidIDToken  = errors.New("invalid ID token")
+
+	defaultScopes    = []string{"openid", "profile", "email"}
+	supportedMethods = []string{
+		"RS256", "RS384", "RS512",
+		"PS256", "PS384", "PS512",
+		"ES256", "ES384", "ES512",
+		"EdDSA",
+	}
+)
+
+// ClaimNames maps claims of ID token and userinfo response to user info fields.
+// Empty name is replaced by standard OpenID Connect claim
+type ClaimNames struct {
+	Subject       string
+	Username      string
+	Email         string
+	EmailVerified string
+}
+
+type Config struct {
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	Claims       ClaimNames
+}
+
+// provider uses base provider for authorization code flow, but user info is taken from verified ID token.
+// Userinfo endpoint is requested only if ID token does not contain username or email.
+// OpenID Provider Metadata is discovered on first use, so unavailable issuer does not disable provider until restart
+type provider struct {
+	cfg    Config
+	client *http.Client
+	opts   []oauth.Option
+	claims ClaimNames
+
+	mu          sync.Mutex
+	metadata    *metadata
+	attemptedAt time.Time
+}
+
+// metadata is state of provider, which is built by discovered OpenID Provider Metadata
+type metadata struct {
+	oauth.Provider
+	issuer       string
+	userInfoURL  string
+	validMethods []string
+	keys         *keySet
+}
+
+// NewProvider creates OpenID Connect provider. Issuer is not requested until the first authorization
+func NewProvider(cfg Config, opts ...oauth.Option) (oauth.Provider, error) {
+	if cfg.Issuer == "" {
+		return nil, fmt.Errorf("%w: issuer is empty", ErrDiscoveryFailed)
+	}
+
+	return &provider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: requestTimeout},
+		opts:   opts,
+		claims: withDefaultClaims(cfg.Claims),
+	}, nil
+}
+
+func (p *provider) GetConsentPageURL(
+	ctx context.Context,
+	redirectURL string,
+	state string,
+	codeVerifier string,
+) (string, error) {
+	md, err := p.getMetadata(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return md.GetConsentPageURL(ctx, redirectURL, state, codeVerifier)
+}
+
+func (p *provider) ExchangeCodeToToken(
+	ctx context.Context,
+	code string,
+	redirectURL string,
+	codeVerifier string,
+	opts ...oauth.ExchangeOption,
+) (*oauth2.Token, error) {
+	md, err := p.getMetadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return md.ExchangeCodeToToken(ctx, code, redirectURL, codeVerifier, opts...)
+}
+
+func (p *provider) GetUserInfo(ctx context.Context, token *oauth2.Token) (oauth.UserInfo, error) {
+	md, err := p.getMetadata(ctx)
+	if err != nil {
+		return oauth.UserInfo{}, err
+	}
+
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return oauth.UserInfo{}, fmt.Errorf("%w: token response does not contain ID token", ErrInvalidIDToken)
+	}
+
+	claims, err := p.verifyIDToken(ctx, md, rawIDToken)
+	if err != nil {
+		return oauth.UserInfo{}, err
+	}
+
+	userInfo := p.mapClaims(claims)
+	if userInfo.ID == "" {
+		return oauth.UserInfo{}, fmt.Errorf("%w: subject is empty", ErrInvalidIDToken)
+	}
+	if md.userInfoURL == "" || (userInfo.Username != "" && userInfo.Email != "") {
+		return userInfo, nil
+	}
+
+	// Claims of userinfo response are trusted only for the same subject
+	extraUserInfo, err := md.Provider.GetUserInfo(ctx, token)
+	if err != nil {
+		return oauth.UserInfo{}, err
+	}
+	if extraUserInfo.ID != userInfo.ID {
+		return oauth.UserInfo{}, fmt.Errorf("%w: userinfo subject does not match ID token", ErrInvalidIDToken)
+	}
+
+	if userInfo.Username == "" {
+		userInfo.Username = extraUserInfo.Username
+	}
+	if userInfo.Email == "" {
+		userInfo.Email = extraUserInfo.Email
+		userInfo.IsEmailVerified = extraUserInfo.IsEmailVerified
+	}
+
+	return userInfo, nil
+}
+
+// getMetadata returns discovered metadata. Failed discovery is retried not more often than discoveryRetryInterval,
+// so unavailable issuer is not flooded by requests
+func (p *provider) getMetadata(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+	if time.Since(p.attemptedAt) < discoveryRetryInterval {
+		return nil, fmt.Errorf("%w: issuer is unavailable", ErrDiscoveryFailed)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	p.attemptedAt = time.Now()
+	md, err := p.discoverMetadata(ctx)
+	if err != nil {
+		if errors.Is(err, ErrDiscoveryFailed) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %w", ErrDiscoveryFailed, err)
+	}
+	p.metadata = md
+
+	return md, nil
+}
+
+func (p *provider) discoverMetadata(ctx context.Context) (*metadata, error) {
+	doc, err := discover(ctx, p.client, p.cfg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only asymmetric algorithms are accepted, because client secret must not be used as verification key
+	validMethods := supportedMethods
+	if len(doc.SigningAlgs) > 0 {
+		validMethods = lo.Intersect(supportedMethods, doc.SigningAlgs)
+	}
+	if len(validMethods) == 0 {
+		return nil, fmt.Errorf("%w: no supported signing algorithms", ErrDiscoveryFailed)
+	}
+
+	scopes := p.cfg.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultScopes
+	}
+	if !lo.Contains(scopes, "openid") {
+		scopes = append([]string{"openid"}, scopes...)
+	}
+
+	oauthConfig := &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		Scopes:       scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  doc.AuthURL,
+			TokenURL: doc.TokenURL,
+		},
+	}
+
+	return &metadata{
+		Provider:     oauth.NewBaseProvider(oauthConfig, doc.UserInfoURL, p.unmarshalUserInfo, p.opts...),
+		issuer:       doc.Issuer,
+		userInfoURL:  doc.UserInfoURL,
+		validMethods: validMethods,
+		keys:         newKeySet(p.client, doc.JWKSURL),
+	}, nil
+}
+
+func (p *provider) verifyIDToken(ctx context.Context, md *metadata, rawIDToken string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(
+		rawIDToken,
+		claims,
+		func(token *jwt.Token) (any, error) {
+			kid, _ := token.Header["kid"].(string)
+			return md.keys.getKey(ctx, kid)
+		},
+		jwt.WithValidMethods(md.validMethods),
+		jwt.WithIssuer(md.issuer),
+		jwt.WithAudience(p.cfg.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(leeway),
+		jwt.WithJSONNumber(),
+	)
+	if err != nil {
+		if errors.Is(err, ErrInvalidIDToken) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	// Token issued for several audiences must be authorized for this client
+	if azp, ok := claims["azp"].(string); ok && azp != p.cfg.ClientID {
+		return nil, fmt.Errorf("%w: token is authorized for another party", ErrInvalidIDToken)
+	}
+
+	return claims, nil
+}
+
+func (p *provider) unmarshalUserInfo(data []byte) (oauth.UserInfo, error) {
+	var claims map[string]any
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&claims); err != nil {
+		return oauth.UserInfo{}, err
+	}
+
+	return p.mapClaims(claims), nil
+}
+
+func (p *provider) mapClaims(claims map[string]any) oauth.UserInfo {
+	return oauth.UserInfo{
+		ID:              stringClaim(claims, p.claims.Subject),
+		Username:        stringClaim(claims, p.claims.Username),
+		Email:           stringClaim(claims, p.claims.Email),
+		IsEmailVerified: boolClaim(claims, p.claims.EmailVerified),
+	}
+}
+
+func withDefaultClaims(claims ClaimNames) ClaimNames {
+	return ClaimNames{
+		Subject:       lo.CoalesceOrEmpty(claims.Subject, "sub"),
+		Username:      lo.CoalesceOrEmpty(claims.Username, "preferred_username"),
+		Email:         lo.CoalesceOrEmpty(claims.Email, "email"),
+		EmailVerified: lo.CoalesceOrEmpty(claims.EmailVerified, "email_verified"),
+	}
+}
+
+func stringClaim(claims map[string]any, name string) string {
+	switch value := claims[name].(type) {
+	case string:
+		return value
+	case json.Number:
+		return value.String()
+	default:
+		return ""
+	}
+}
+
+// boolClaim supports string values, because some providers return email_verified as "true"
+func boolClaim(claims map[string]any, name string) bool {
+	switch value := claims[name].(type) {
+	case bool:
+		return value
+	case string:
+		b, _ := strconv.ParseBool(value)
+		return b
+	default:
+		return false
+	}
+}
@@ -0,0 +1,209 @@
+package telegram
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/mandarine-io/backend/third_party/oauth"
+	"golang.org/x/oauth2"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	ProviderKey = "telegram"
+
+	authURL        = "https://oauth.telegram.org/auth"
+	userExtraKey   = "telegram_user"
+	maxAuthAge     = 15 * time.Minute
+	maxClockSkew   = time.Minute
+	hashField      = "hash"
+	authDateField  = "auth_date"
+	stateParameter = "state"
+)
+
+var (
+	ErrInvalidAuthData = errors.New("invalid Telegram auth data")
+)
+
+// provider implements Telegram Login. Telegram does not issue authorization code, it redirects to return_to URL
+// with user data signed by bot token in fragment (#tgAuthResult=...). Client sends this data as code,
+// which is verified by HMAC instead of exchanging
+type provider struct {
+	botID     string
+	secretKey []byte
+}
+
+// NewProvider creates Telegram Login provider by bot token, bot ID is the part of token before colon.
+// Options are accepted for compatibility with factory, Telegram Login does not send HTTP requests
+func NewProvider(botToken string, _ ...oauth.Option) oauth.Provider {
+	botID, _, _ := strings.Cut(botToken, ":")
+	secretKey := sha256.Sum256([]byte(botToken))
+
+	return &provider{
+		botID:     botID,
+		secretKey: secretKey[:],
+	}
+}
+
+// GetConsentPageURL returns URL of Telegram authorization page. Telegram does not pass state back,
+// so state is added to return_to URL. PKCE is not supported by Telegram, so code verifier is ignored
+func (p *provider) GetConsentPageURL(_ context.Context, redirectURL string, state string, _ string) (string, error) {
+	returnTo, origin := redirectURL, redirectURL
+	if u, err := url.Parse(redirectURL); err == nil {
+		query := u.Query()
+		query.Set(stateParameter, state)
+		u.RawQuery = query.Encode()
+		returnTo = u.String()
+		origin = fmt.Sprintf("%s://%s", u.Scheme, u.Host)
+	}
+
+	query := url.Values{}
+	query.Set("bot_id", p.botID)
+	query.Set("origin", origin)
+	query.Set("return_to", returnTo)
+	query.Set("request_access", "write")
+
+	return authURL + "?" + query.Encode(), nil
+}
+
+// ExchangeCodeToToken verifies signed auth data and returns token, which carries user data for GetUserInfo.
+// Telegram does not sign state, so auth data is bound to state by time: data created before state issuance
+// is rejected. Caller must accept the same auth data only once, token of auth data does not depend on its encoding
+func (p *provider) ExchangeCodeToToken(
+	_ context.Context,
+	code string,
+	_ string,
+	_ string,
+	opts ...oauth.ExchangeOption,
+) (*oauth2.Token, error) {
+	data, err := decodeAuthData(code)
+	if err != nil {
+		return nil, err
+	}
+
+	params := oauth.NewExchangeParams(opts...)
+	if err := p.verifyAuthData(data, params.StateIssuedAt); err != nil {
+		return nil, err
+	}
+
+	// Hash is normalized, so the same auth data has the same token regardless of its encoding
+	data[hashField] = strings.ToLower(data[hashField])
+	token := &oauth2.Token{AccessToken: data[hashField], TokenType: ProviderKey}
+	return token.WithExtra(map[string]any{userExtraKey: data}), nil
+}
+
+func (p *provider) GetUserInfo(_ context.Context, token *oauth2.Token) (oauth.UserInfo, error) {
+	data, ok := token.Extra(userExtraKey).(map[string]string)
+	if !ok {
+		return oauth.UserInfo{}, oauth.ErrUserInfoNotReceived
+	}
+
+	// Telegram does not share email, username is optional too
+	username := data["username"]
+	if username == "" {
+		username = strings.TrimSpace(data["first_name"] + " " + data["last_name"])
+	}
+
+	return oauth.UserInfo{
+		ID:       data["id"],
+		Username: username,
+	}, nil
+}
+
+// verifyAuthData checks hash of data-check-string (sorted key=value pairs, joined by line feed),
+// signed by SHA-256 of bot token, and rejects outdated data and data created before state issuance
+func (p *provider) verifyAuthData(data map[string]string, stateIssuedAt time.Time) error {
+	hash, err := hex.DecodeString(data[hashField])
+	if err != nil || len(hash) == 0 {
+		return ErrInvalidAuthData
+	}
+
+	pairs := make([]string, 0, len(data))
+	for key, value := range data {
+		if key == hashField {
+			continue
+		}
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+
+	mac := hmac.New(sha256.New, p.secretKey)
+	mac.Write([]byte(strings.Join(pairs, "\n")))
+	if !hmac.Equal(mac.Sum(nil), hash) {
+		return ErrInvalidAuthData
+	}
+
+	authDate, err := strconv.ParseInt(data[authDateField], 10, 64)
+	if err != nil {
+		return ErrInvalidAuthData
+	}
+	authTime := time.Unix(authDate, 0)
+	age := time.Since(authTime)
+	if age > maxAuthAge || age < -maxClockSkew {
+		return fmt.Errorf("%w: auth data is outdated", ErrInvalidAuthData)
+	}
+	if !stateIssuedAt.IsZero() && authTime.Before(stateIssuedAt.Add(-maxClockSkew)) {
+		return fmt.Errorf("%w: auth data is created before state", ErrInvalidAuthData)
+	}
+
+	return nil
+}
+
+// decodeAuthData accepts tgAuthResult (base64 of JSON) or JSON object with auth data.
+// Values are kept as strings, because hash is calculated over their textual representation
+func decodeAuthData(code string) (map[string]string, error) {
+	raw := []byte(code)
+	if !strings.HasPrefix(strings.TrimSpace(code), "{") {
+		var err error
+		raw, err = decodeBase64(code)
+		if err != nil {
+			return nil, ErrInvalidAuthData
+		}
+	}
+
+	var fields map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&fields); err != nil {
+		return nil, ErrInvalidAuthData
+	}
+
+	data := make(map[string]string, len(fields))
+	for key, value := range fields {
+		switch v := value.(type) {
+		case string:
+			data[key] = v
+		case json.Number:
+			data[key] = v.String()
+		case bool:
+			data[key] = strconv.FormatBool(v)
+		default:
+			return nil, ErrInvalidAuthData
+		}
+	}
+
+	if data["id"] == "" {
+		return nil, ErrInvalidAuthData
+	}
+
+	return data, nil
+}
+
+func decodeBase64(value string) ([]byte, error) {
+	value = strings.TrimRight(value, "=")
+	if decoded, err := base64.RawURLEncoding.DecodeString(value); err == nil {
+		return decoded, nil
+	}
+
+	return base64.RawStdEncoding.DecodeString(value)
+}
@@ -0,0 +1,131 @@
+package vk
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/mandarine-io/backend/third_party/oauth"
+	"golang.org/x/oauth2"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	ProviderKey = "vk"
+
+	userInfoURL = "https://id.vk.com/oauth2/user_info"
+)
+
+var (
+	Endpoint = oauth2.Endpoint{
+		AuthURL:   "https://id.vk.com/authorize",
+		TokenURL:  "https://id.vk.com/oauth2/auth",
+		AuthStyle: oauth2.AuthStyleInParams,
+	}
+)
+
+// provider implements VK ID authorization code flow (OAuth 2.1), which requires PKCE.
+// Token request must contain device_id and state, which VK ID passes to callback together with code
+type provider struct {
+	oauth.Provider
+	oauthConfig *oauth2.Config
+}
+
+func NewProvider(clientID string, clientSecret string, opts ...oauth.Option) oauth.Provider {
+	oauthConfig := &oauth2.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scopes:       []string{"vkid.personal_info", "email"},
+		Endpoint:     Endpoint,
+	}
+
+	return &provider{
+		Provider:    oauth.NewBaseProvider(oauthConfig, userInfoURL, UnmarshalJSON, opts...),
+		oauthConfig: oauthConfig,
+	}
+}
+
+func (p *provider) ExchangeCodeToToken(
+	ctx context.Context,
+	code string,
+	redirectURL string,
+	codeVerifier string,
+	opts ...oauth.ExchangeOption,
+) (*oauth2.Token, error) {
+	params := oauth.NewExchangeParams(opts...)
+
+	return p.oauthConfig.Exchange(
+		ctx,
+		code,
+		oauth2.SetAuthURLParam("redirect_uri", redirectURL),
+		oauth2.SetAuthURLParam("device_id", params.DeviceID),
+		oauth2.SetAuthURLParam("state", params.State),
+		oauth2.VerifierOption(codeVerifier),
+	)
+}
+
+// GetUserInfo requests user info by POST, because VK ID does not support GET request with access token
+func (p *provider) GetUserInfo(ctx context.Context, token *oauth2.Token) (oauth.UserInfo, error) {
+	form := url.Values{}
+	form.Set("client_id", p.oauthConfig.ClientID)
+	form.Set("access_token", token.AccessToken)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, userInfoURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return oauth.UserInfo{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return oauth.UserInfo{}, err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode >= 400 {
+		return oauth.UserInfo{}, oauth.ErrUserInfoNotReceived
+	}
+
+	var body json.RawMessage
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return oauth.UserInfo{}, err
+	}
+
+	return UnmarshalJSON(body)
+}
+
+//////////////////// Marshall User Info ////////////////////
+
+type UserInfoResponse struct {
+	User  *UserInfo `json:"user"`
+	Error string    `json:"error"`
+}
+
+type UserInfo struct {
+	UserID    string `json:"user_id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+}
+
+func UnmarshalJSON(data []byte) (oauth.UserInfo, error) {
+	var response UserInfoResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return oauth.UserInfo{}, err
+	}
+
+	// VK ID returns error in body with successful status
+	if response.User == nil || response.Error != "" {
+		return oauth.UserInfo{}, oauth.ErrUserInfoNotReceived
+	}
+
+	// Email is shared only if user has confirmed it in VK ID and allowed access to it
+	return oauth.UserInfo{
+		ID:              response.User.UserID,
+		Username:        strings.TrimSpace(response.User.FirstName + " " + response.User.LastName),
+		Email:           response.User.Email,
+		IsEmailVerified: response.User.Email != "",
+	}, nil
+}